@@ -105,6 +105,7 @@ const (
 	Srv6LocalAction_SRV6_LOCAL_ACTION_END_M_GTP4_E    Srv6LocalAction = 21 // End.M.GTP4.E (SRv6 => GTP-U/IPv4)
 	Srv6LocalAction_SRV6_LOCAL_ACTION_END_DX2V        Srv6LocalAction = 22 // End.DX2V (VLAN L2 cross-connect)
 	Srv6LocalAction_SRV6_LOCAL_ACTION_END_DT2M        Srv6LocalAction = 23 // End.DT2M (L2 table flooding for BUM, RFC 8986 Sec.4.12)
+	Srv6LocalAction_SRV6_LOCAL_ACTION_END_OP          Srv6LocalAction = 24 // End.OP (OAM endpoint with punt, RFC 9259 Sec.2.1.1)
 )
 
 // Enum value maps for Srv6LocalAction.
@@ -134,6 +135,7 @@ var (
 		21: "SRV6_LOCAL_ACTION_END_M_GTP4_E",
 		22: "SRV6_LOCAL_ACTION_END_DX2V",
 		23: "SRV6_LOCAL_ACTION_END_DT2M",
+		24: "SRV6_LOCAL_ACTION_END_OP",
 	}
 	Srv6LocalAction_value = map[string]int32{
 		"SRV6_LOCAL_ACTION_UNSPECIFIED":     0,
@@ -160,6 +162,7 @@ var (
 		"SRV6_LOCAL_ACTION_END_M_GTP4_E":    21,
 		"SRV6_LOCAL_ACTION_END_DX2V":        22,
 		"SRV6_LOCAL_ACTION_END_DT2M":        23,
+		"SRV6_LOCAL_ACTION_END_OP":          24,
 	}
 )
 
@@ -255,6 +258,209 @@ func (Srv6HeadendBehavior) EnumDescriptor() ([]byte, []int) {
 	return file_vinbero_v1_enums_proto_rawDescGZIP(), []int{2}
 }
 
+// Srv6EncapTcMode selects the outer IPv6 Traffic Class on encapsulation
+type Srv6EncapTcMode int32
+
+const (
+	Srv6EncapTcMode_SRV6_ENCAP_TC_MODE_UNSPECIFIED Srv6EncapTcMode = 0 // Traffic Class 0
+	Srv6EncapTcMode_SRV6_ENCAP_TC_MODE_COPY        Srv6EncapTcMode = 1 // Copy DSCP and ECN from the inner IPv4/IPv6 header (RFC 2983 uniform, RFC 6040 normal)
+	Srv6EncapTcMode_SRV6_ENCAP_TC_MODE_SET         Srv6EncapTcMode = 2 // Use the configured tc value
+)
+
+// Enum value maps for Srv6EncapTcMode.
+var (
+	Srv6EncapTcMode_name = map[int32]string{
+		0: "SRV6_ENCAP_TC_MODE_UNSPECIFIED",
+		1: "SRV6_ENCAP_TC_MODE_COPY",
+		2: "SRV6_ENCAP_TC_MODE_SET",
+	}
+	Srv6EncapTcMode_value = map[string]int32{
+		"SRV6_ENCAP_TC_MODE_UNSPECIFIED": 0,
+		"SRV6_ENCAP_TC_MODE_COPY":        1,
+		"SRV6_ENCAP_TC_MODE_SET":         2,
+	}
+)
+
+func (x Srv6EncapTcMode) Enum() *Srv6EncapTcMode {
+	p := new(Srv6EncapTcMode)
+	*p = x
+	return p
+}
+
+func (x Srv6EncapTcMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Srv6EncapTcMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_vinbero_v1_enums_proto_enumTypes[3].Descriptor()
+}
+
+func (Srv6EncapTcMode) Type() protoreflect.EnumType {
+	return &file_vinbero_v1_enums_proto_enumTypes[3]
+}
+
+func (x Srv6EncapTcMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Srv6EncapTcMode.Descriptor instead.
+func (Srv6EncapTcMode) EnumDescriptor() ([]byte, []int) {
+	return file_vinbero_v1_enums_proto_rawDescGZIP(), []int{3}
+}
+
+// Srv6EncapHopLimitMode selects the outer IPv6 Hop Limit on encapsulation
+type Srv6EncapHopLimitMode int32
+
+const (
+	Srv6EncapHopLimitMode_SRV6_ENCAP_HOP_LIMIT_MODE_UNSPECIFIED Srv6EncapHopLimitMode = 0 // Fixed: hop_limit, or 64 when hop_limit is 0
+	Srv6EncapHopLimitMode_SRV6_ENCAP_HOP_LIMIT_MODE_PROPAGATE   Srv6EncapHopLimitMode = 1 // Copy the inner TTL/Hop Limit
+)
+
+// Enum value maps for Srv6EncapHopLimitMode.
+var (
+	Srv6EncapHopLimitMode_name = map[int32]string{
+		0: "SRV6_ENCAP_HOP_LIMIT_MODE_UNSPECIFIED",
+		1: "SRV6_ENCAP_HOP_LIMIT_MODE_PROPAGATE",
+	}
+	Srv6EncapHopLimitMode_value = map[string]int32{
+		"SRV6_ENCAP_HOP_LIMIT_MODE_UNSPECIFIED": 0,
+		"SRV6_ENCAP_HOP_LIMIT_MODE_PROPAGATE":   1,
+	}
+)
+
+func (x Srv6EncapHopLimitMode) Enum() *Srv6EncapHopLimitMode {
+	p := new(Srv6EncapHopLimitMode)
+	*p = x
+	return p
+}
+
+func (x Srv6EncapHopLimitMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Srv6EncapHopLimitMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_vinbero_v1_enums_proto_enumTypes[4].Descriptor()
+}
+
+func (Srv6EncapHopLimitMode) Type() protoreflect.EnumType {
+	return &file_vinbero_v1_enums_proto_enumTypes[4]
+}
+
+func (x Srv6EncapHopLimitMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Srv6EncapHopLimitMode.Descriptor instead.
+func (Srv6EncapHopLimitMode) EnumDescriptor() ([]byte, []int) {
+	return file_vinbero_v1_enums_proto_rawDescGZIP(), []int{4}
+}
+
+// Srv6EncapFlowLabelMode selects the outer IPv6 Flow Label on encapsulation
+type Srv6EncapFlowLabelMode int32
+
+const (
+	Srv6EncapFlowLabelMode_SRV6_ENCAP_FLOW_LABEL_MODE_UNSPECIFIED Srv6EncapFlowLabelMode = 0 // Flow Label 0
+	Srv6EncapFlowLabelMode_SRV6_ENCAP_FLOW_LABEL_MODE_HASH        Srv6EncapFlowLabelMode = 1 // Hash of the inner 5-tuple (RFC 6437), for ECMP in the core
+)
+
+// Enum value maps for Srv6EncapFlowLabelMode.
+var (
+	Srv6EncapFlowLabelMode_name = map[int32]string{
+		0: "SRV6_ENCAP_FLOW_LABEL_MODE_UNSPECIFIED",
+		1: "SRV6_ENCAP_FLOW_LABEL_MODE_HASH",
+	}
+	Srv6EncapFlowLabelMode_value = map[string]int32{
+		"SRV6_ENCAP_FLOW_LABEL_MODE_UNSPECIFIED": 0,
+		"SRV6_ENCAP_FLOW_LABEL_MODE_HASH":        1,
+	}
+)
+
+func (x Srv6EncapFlowLabelMode) Enum() *Srv6EncapFlowLabelMode {
+	p := new(Srv6EncapFlowLabelMode)
+	*p = x
+	return p
+}
+
+func (x Srv6EncapFlowLabelMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Srv6EncapFlowLabelMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_vinbero_v1_enums_proto_enumTypes[5].Descriptor()
+}
+
+func (Srv6EncapFlowLabelMode) Type() protoreflect.EnumType {
+	return &file_vinbero_v1_enums_proto_enumTypes[5]
+}
+
+func (x Srv6EncapFlowLabelMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Srv6EncapFlowLabelMode.Descriptor instead.
+func (Srv6EncapFlowLabelMode) EnumDescriptor() ([]byte, []int) {
+	return file_vinbero_v1_enums_proto_rawDescGZIP(), []int{5}
+}
+
+// OamReplyType classifies the answer to one SRv6 OAM probe
+type OamReplyType int32
+
+const (
+	OamReplyType_OAM_REPLY_TYPE_UNSPECIFIED      OamReplyType = 0
+	OamReplyType_OAM_REPLY_TYPE_ECHO_REPLY       OamReplyType = 1 // ICMPv6 Echo Reply from the probed target
+	OamReplyType_OAM_REPLY_TYPE_TIME_EXCEEDED    OamReplyType = 2 // ICMPv6 Time Exceeded (hop limit expired on the path)
+	OamReplyType_OAM_REPLY_TYPE_DEST_UNREACHABLE OamReplyType = 3 // ICMPv6 Destination Unreachable
+	OamReplyType_OAM_REPLY_TYPE_PARAM_PROBLEM    OamReplyType = 4 // ICMPv6 Parameter Problem (e.g., SRH rejected)
+	OamReplyType_OAM_REPLY_TYPE_TIMEOUT          OamReplyType = 5 // No answer within the timeout
+)
+
+// Enum value maps for OamReplyType.
+var (
+	OamReplyType_name = map[int32]string{
+		0: "OAM_REPLY_TYPE_UNSPECIFIED",
+		1: "OAM_REPLY_TYPE_ECHO_REPLY",
+		2: "OAM_REPLY_TYPE_TIME_EXCEEDED",
+		3: "OAM_REPLY_TYPE_DEST_UNREACHABLE",
+		4: "OAM_REPLY_TYPE_PARAM_PROBLEM",
+		5: "OAM_REPLY_TYPE_TIMEOUT",
+	}
+	OamReplyType_value = map[string]int32{
+		"OAM_REPLY_TYPE_UNSPECIFIED":      0,
+		"OAM_REPLY_TYPE_ECHO_REPLY":       1,
+		"OAM_REPLY_TYPE_TIME_EXCEEDED":    2,
+		"OAM_REPLY_TYPE_DEST_UNREACHABLE": 3,
+		"OAM_REPLY_TYPE_PARAM_PROBLEM":    4,
+		"OAM_REPLY_TYPE_TIMEOUT":          5,
+	}
+)
+
+func (x OamReplyType) Enum() *OamReplyType {
+	p := new(OamReplyType)
+	*p = x
+	return p
+}
+
+func (x OamReplyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OamReplyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_vinbero_v1_enums_proto_enumTypes[6].Descriptor()
+}
+
+func (OamReplyType) Type() protoreflect.EnumType {
+	return &file_vinbero_v1_enums_proto_enumTypes[6]
+}
+
+func (x OamReplyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OamReplyType.Descriptor instead.
+func (OamReplyType) EnumDescriptor() ([]byte, []int) {
+	return file_vinbero_v1_enums_proto_rawDescGZIP(), []int{6}
+}
+
 // OperationError represents an error that occurred during a bulk operation
 type OperationError struct {
 	state         protoimpl.MessageState
@@ -331,7 +537,7 @@ var file_vinbero_v1_enums_proto_rawDesc = []byte{
 	0x50, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x52, 0x56, 0x36, 0x5f, 0x4c, 0x4f, 0x43, 0x41,
 	0x4c, 0x5f, 0x46, 0x4c, 0x41, 0x56, 0x4f, 0x52, 0x5f, 0x55, 0x53, 0x50, 0x10, 0x03, 0x12, 0x19,
 	0x0a, 0x15, 0x53, 0x52, 0x56, 0x36, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x5f, 0x46, 0x4c, 0x41,
-	0x56, 0x4f, 0x52, 0x5f, 0x55, 0x53, 0x44, 0x10, 0x04, 0x2a, 0x8f, 0x06, 0x0a, 0x0f, 0x53, 0x72,
+	0x56, 0x4f, 0x52, 0x5f, 0x55, 0x53, 0x44, 0x10, 0x04, 0x2a, 0xad, 0x06, 0x0a, 0x0f, 0x53, 0x72,
 	0x76, 0x36, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a,
 	0x1d, 0x53, 0x52, 0x56, 0x36, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x5f, 0x41, 0x43, 0x54, 0x49,
 	0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
@@ -380,38 +586,74 @@ var file_vinbero_v1_enums_proto_rawDesc = []byte{
 	0x52, 0x56, 0x36, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e,
 	0x5f, 0x45, 0x4e, 0x44, 0x5f, 0x44, 0x58, 0x32, 0x56, 0x10, 0x16, 0x12, 0x1e, 0x0a, 0x1a, 0x53,
 	0x52, 0x56, 0x36, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e,
-	0x5f, 0x45, 0x4e, 0x44, 0x5f, 0x44, 0x54, 0x32, 0x4d, 0x10, 0x17, 0x2a, 0xcc, 0x02, 0x0a, 0x13,
-	0x53, 0x72, 0x76, 0x36, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x42, 0x65, 0x68, 0x61, 0x76,
-	0x69, 0x6f, 0x72, 0x12, 0x25, 0x0a, 0x21, 0x53, 0x52, 0x56, 0x36, 0x5f, 0x48, 0x45, 0x41, 0x44,
-	0x45, 0x4e, 0x44, 0x5f, 0x42, 0x45, 0x48, 0x41, 0x56, 0x49, 0x4f, 0x52, 0x5f, 0x55, 0x4e, 0x53,
-	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x22, 0x0a, 0x1e, 0x53, 0x52,
-	0x56, 0x36, 0x5f, 0x48, 0x45, 0x41, 0x44, 0x45, 0x4e, 0x44, 0x5f, 0x42, 0x45, 0x48, 0x41, 0x56,
-	0x49, 0x4f, 0x52, 0x5f, 0x48, 0x5f, 0x49, 0x4e, 0x53, 0x45, 0x52, 0x54, 0x10, 0x01, 0x12, 0x22,
-	0x0a, 0x1e, 0x53, 0x52, 0x56, 0x36, 0x5f, 0x48, 0x45, 0x41, 0x44, 0x45, 0x4e, 0x44, 0x5f, 0x42,
-	0x45, 0x48, 0x41, 0x56, 0x49, 0x4f, 0x52, 0x5f, 0x48, 0x5f, 0x45, 0x4e, 0x43, 0x41, 0x50, 0x53,
-	0x10, 0x02, 0x12, 0x25, 0x0a, 0x21, 0x53, 0x52, 0x56, 0x36, 0x5f, 0x48, 0x45, 0x41, 0x44, 0x45,
-	0x4e, 0x44, 0x5f, 0x42, 0x45, 0x48, 0x41, 0x56, 0x49, 0x4f, 0x52, 0x5f, 0x48, 0x5f, 0x45, 0x4e,
-	0x43, 0x41, 0x50, 0x53, 0x5f, 0x4c, 0x32, 0x10, 0x03, 0x12, 0x24, 0x0a, 0x20, 0x53, 0x52, 0x56,
-	0x36, 0x5f, 0x48, 0x45, 0x41, 0x44, 0x45, 0x4e, 0x44, 0x5f, 0x42, 0x45, 0x48, 0x41, 0x56, 0x49,
-	0x4f, 0x52, 0x5f, 0x48, 0x5f, 0x4d, 0x5f, 0x47, 0x54, 0x50, 0x34, 0x5f, 0x44, 0x10, 0x04, 0x12,
-	0x26, 0x0a, 0x22, 0x53, 0x52, 0x56, 0x36, 0x5f, 0x48, 0x45, 0x41, 0x44, 0x45, 0x4e, 0x44, 0x5f,
-	0x42, 0x45, 0x48, 0x41, 0x56, 0x49, 0x4f, 0x52, 0x5f, 0x48, 0x5f, 0x45, 0x4e, 0x43, 0x41, 0x50,
-	0x53, 0x5f, 0x52, 0x45, 0x44, 0x10, 0x05, 0x12, 0x29, 0x0a, 0x25, 0x53, 0x52, 0x56, 0x36, 0x5f,
+	0x5f, 0x45, 0x4e, 0x44, 0x5f, 0x44, 0x54, 0x32, 0x4d, 0x10, 0x17, 0x12, 0x1c, 0x0a, 0x18, 0x53,
+	0x52, 0x56, 0x36, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x45, 0x4e, 0x44, 0x5f, 0x4f, 0x50, 0x10, 0x18, 0x2a, 0xcc, 0x02, 0x0a, 0x13, 0x53, 0x72,
+	0x76, 0x36, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f,
+	0x72, 0x12, 0x25, 0x0a, 0x21, 0x53, 0x52, 0x56, 0x36, 0x5f, 0x48, 0x45, 0x41, 0x44, 0x45, 0x4e,
+	0x44, 0x5f, 0x42, 0x45, 0x48, 0x41, 0x56, 0x49, 0x4f, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x22, 0x0a, 0x1e, 0x53, 0x52, 0x56, 0x36,
+	0x5f, 0x48, 0x45, 0x41, 0x44, 0x45, 0x4e, 0x44, 0x5f, 0x42, 0x45, 0x48, 0x41, 0x56, 0x49, 0x4f,
+	0x52, 0x5f, 0x48, 0x5f, 0x49, 0x4e, 0x53, 0x45, 0x52, 0x54, 0x10, 0x01, 0x12, 0x22, 0x0a, 0x1e,
+	0x53, 0x52, 0x56, 0x36, 0x5f, 0x48, 0x45, 0x41, 0x44, 0x45, 0x4e, 0x44, 0x5f, 0x42, 0x45, 0x48,
+	0x41, 0x56, 0x49, 0x4f, 0x52, 0x5f, 0x48, 0x5f, 0x45, 0x4e, 0x43, 0x41, 0x50, 0x53, 0x10, 0x02,
+	0x12, 0x25, 0x0a, 0x21, 0x53, 0x52, 0x56, 0x36, 0x5f, 0x48, 0x45, 0x41, 0x44, 0x45, 0x4e, 0x44,
+	0x5f, 0x42, 0x45, 0x48, 0x41, 0x56, 0x49, 0x4f, 0x52, 0x5f, 0x48, 0x5f, 0x45, 0x4e, 0x43, 0x41,
+	0x50, 0x53, 0x5f, 0x4c, 0x32, 0x10, 0x03, 0x12, 0x24, 0x0a, 0x20, 0x53, 0x52, 0x56, 0x36, 0x5f,
 	0x48, 0x45, 0x41, 0x44, 0x45, 0x4e, 0x44, 0x5f, 0x42, 0x45, 0x48, 0x41, 0x56, 0x49, 0x4f, 0x52,
-	0x5f, 0x48, 0x5f, 0x45, 0x4e, 0x43, 0x41, 0x50, 0x53, 0x5f, 0x4c, 0x32, 0x5f, 0x52, 0x45, 0x44,
-	0x10, 0x06, 0x12, 0x26, 0x0a, 0x22, 0x53, 0x52, 0x56, 0x36, 0x5f, 0x48, 0x45, 0x41, 0x44, 0x45,
-	0x4e, 0x44, 0x5f, 0x42, 0x45, 0x48, 0x41, 0x56, 0x49, 0x4f, 0x52, 0x5f, 0x48, 0x5f, 0x49, 0x4e,
-	0x53, 0x45, 0x52, 0x54, 0x5f, 0x52, 0x45, 0x44, 0x10, 0x07, 0x42, 0x9b, 0x01, 0x0a, 0x0e, 0x63,
-	0x6f, 0x6d, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x45,
-	0x6e, 0x75, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x34, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x61, 0x6b, 0x65, 0x68, 0x61, 0x79, 0x61,
-	0x2f, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x69, 0x6e,
-	0x62, 0x65, 0x72, 0x6f, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x76,
-	0x31, 0xa2, 0x02, 0x03, 0x56, 0x58, 0x58, 0xaa, 0x02, 0x0a, 0x56, 0x69, 0x6e, 0x62, 0x65, 0x72,
-	0x6f, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0a, 0x56, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x5c, 0x56,
-	0x31, 0xe2, 0x02, 0x16, 0x56, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x5c, 0x56, 0x31, 0x5c, 0x47,
-	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0b, 0x56, 0x69, 0x6e,
-	0x62, 0x65, 0x72, 0x6f, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x5f, 0x48, 0x5f, 0x4d, 0x5f, 0x47, 0x54, 0x50, 0x34, 0x5f, 0x44, 0x10, 0x04, 0x12, 0x26, 0x0a,
+	0x22, 0x53, 0x52, 0x56, 0x36, 0x5f, 0x48, 0x45, 0x41, 0x44, 0x45, 0x4e, 0x44, 0x5f, 0x42, 0x45,
+	0x48, 0x41, 0x56, 0x49, 0x4f, 0x52, 0x5f, 0x48, 0x5f, 0x45, 0x4e, 0x43, 0x41, 0x50, 0x53, 0x5f,
+	0x52, 0x45, 0x44, 0x10, 0x05, 0x12, 0x29, 0x0a, 0x25, 0x53, 0x52, 0x56, 0x36, 0x5f, 0x48, 0x45,
+	0x41, 0x44, 0x45, 0x4e, 0x44, 0x5f, 0x42, 0x45, 0x48, 0x41, 0x56, 0x49, 0x4f, 0x52, 0x5f, 0x48,
+	0x5f, 0x45, 0x4e, 0x43, 0x41, 0x50, 0x53, 0x5f, 0x4c, 0x32, 0x5f, 0x52, 0x45, 0x44, 0x10, 0x06,
+	0x12, 0x26, 0x0a, 0x22, 0x53, 0x52, 0x56, 0x36, 0x5f, 0x48, 0x45, 0x41, 0x44, 0x45, 0x4e, 0x44,
+	0x5f, 0x42, 0x45, 0x48, 0x41, 0x56, 0x49, 0x4f, 0x52, 0x5f, 0x48, 0x5f, 0x49, 0x4e, 0x53, 0x45,
+	0x52, 0x54, 0x5f, 0x52, 0x45, 0x44, 0x10, 0x07, 0x2a, 0x6e, 0x0a, 0x0f, 0x53, 0x72, 0x76, 0x36,
+	0x45, 0x6e, 0x63, 0x61, 0x70, 0x54, 0x63, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x22, 0x0a, 0x1e, 0x53,
+	0x52, 0x56, 0x36, 0x5f, 0x45, 0x4e, 0x43, 0x41, 0x50, 0x5f, 0x54, 0x43, 0x5f, 0x4d, 0x4f, 0x44,
+	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x1b, 0x0a, 0x17, 0x53, 0x52, 0x56, 0x36, 0x5f, 0x45, 0x4e, 0x43, 0x41, 0x50, 0x5f, 0x54, 0x43,
+	0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x43, 0x4f, 0x50, 0x59, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16,
+	0x53, 0x52, 0x56, 0x36, 0x5f, 0x45, 0x4e, 0x43, 0x41, 0x50, 0x5f, 0x54, 0x43, 0x5f, 0x4d, 0x4f,
+	0x44, 0x45, 0x5f, 0x53, 0x45, 0x54, 0x10, 0x02, 0x2a, 0x6b, 0x0a, 0x15, 0x53, 0x72, 0x76, 0x36,
+	0x45, 0x6e, 0x63, 0x61, 0x70, 0x48, 0x6f, 0x70, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4d, 0x6f, 0x64,
+	0x65, 0x12, 0x29, 0x0a, 0x25, 0x53, 0x52, 0x56, 0x36, 0x5f, 0x45, 0x4e, 0x43, 0x41, 0x50, 0x5f,
+	0x48, 0x4f, 0x50, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x27, 0x0a, 0x23,
+	0x53, 0x52, 0x56, 0x36, 0x5f, 0x45, 0x4e, 0x43, 0x41, 0x50, 0x5f, 0x48, 0x4f, 0x50, 0x5f, 0x4c,
+	0x49, 0x4d, 0x49, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x50, 0x41, 0x47,
+	0x41, 0x54, 0x45, 0x10, 0x01, 0x2a, 0x69, 0x0a, 0x16, 0x53, 0x72, 0x76, 0x36, 0x45, 0x6e, 0x63,
+	0x61, 0x70, 0x46, 0x6c, 0x6f, 0x77, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x12,
+	0x2a, 0x0a, 0x26, 0x53, 0x52, 0x56, 0x36, 0x5f, 0x45, 0x4e, 0x43, 0x41, 0x50, 0x5f, 0x46, 0x4c,
+	0x4f, 0x57, 0x5f, 0x4c, 0x41, 0x42, 0x45, 0x4c, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x23, 0x0a, 0x1f, 0x53,
+	0x52, 0x56, 0x36, 0x5f, 0x45, 0x4e, 0x43, 0x41, 0x50, 0x5f, 0x46, 0x4c, 0x4f, 0x57, 0x5f, 0x4c,
+	0x41, 0x42, 0x45, 0x4c, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x10, 0x01,
+	0x2a, 0xd2, 0x01, 0x0a, 0x0c, 0x4f, 0x61, 0x6d, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1e, 0x0a, 0x1a, 0x4f, 0x41, 0x4d, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x59, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x1d, 0x0a, 0x19, 0x4f, 0x41, 0x4d, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x59, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x45, 0x43, 0x48, 0x4f, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x59, 0x10, 0x01,
+	0x12, 0x20, 0x0a, 0x1c, 0x4f, 0x41, 0x4d, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x59, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x45, 0x58, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44,
+	0x10, 0x02, 0x12, 0x23, 0x0a, 0x1f, 0x4f, 0x41, 0x4d, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x59, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x45, 0x53, 0x54, 0x5f, 0x55, 0x4e, 0x52, 0x45, 0x41, 0x43,
+	0x48, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x03, 0x12, 0x20, 0x0a, 0x1c, 0x4f, 0x41, 0x4d, 0x5f, 0x52,
+	0x45, 0x50, 0x4c, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x4d, 0x5f,
+	0x50, 0x52, 0x4f, 0x42, 0x4c, 0x45, 0x4d, 0x10, 0x04, 0x12, 0x1a, 0x0a, 0x16, 0x4f, 0x41, 0x4d,
+	0x5f, 0x52, 0x45, 0x50, 0x4c, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x49, 0x4d, 0x45,
+	0x4f, 0x55, 0x54, 0x10, 0x05, 0x42, 0x9b, 0x01, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x2e, 0x76, 0x69,
+	0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x45, 0x6e, 0x75, 0x6d, 0x73, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x74, 0x61, 0x6b, 0x65, 0x68, 0x61, 0x79, 0x61, 0x2f, 0x76, 0x69, 0x6e, 0x62,
+	0x65, 0x72, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2f,
+	0x76, 0x31, 0x3b, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x56,
+	0x58, 0x58, 0xaa, 0x02, 0x0a, 0x56, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x56, 0x31, 0xca,
+	0x02, 0x0a, 0x56, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x16, 0x56,
+	0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0b, 0x56, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x3a,
+	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -426,13 +668,17 @@ func file_vinbero_v1_enums_proto_rawDescGZIP() []byte {
 	return file_vinbero_v1_enums_proto_rawDescData
 }
 
-var file_vinbero_v1_enums_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_vinbero_v1_enums_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
 var file_vinbero_v1_enums_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_vinbero_v1_enums_proto_goTypes = []interface{}{
-	(Srv6LocalFlavor)(0),     // 0: vinbero.v1.Srv6LocalFlavor
-	(Srv6LocalAction)(0),     // 1: vinbero.v1.Srv6LocalAction
-	(Srv6HeadendBehavior)(0), // 2: vinbero.v1.Srv6HeadendBehavior
-	(*OperationError)(nil),   // 3: vinbero.v1.OperationError
+	(Srv6LocalFlavor)(0),        // 0: vinbero.v1.Srv6LocalFlavor
+	(Srv6LocalAction)(0),        // 1: vinbero.v1.Srv6LocalAction
+	(Srv6HeadendBehavior)(0),    // 2: vinbero.v1.Srv6HeadendBehavior
+	(Srv6EncapTcMode)(0),        // 3: vinbero.v1.Srv6EncapTcMode
+	(Srv6EncapHopLimitMode)(0),  // 4: vinbero.v1.Srv6EncapHopLimitMode
+	(Srv6EncapFlowLabelMode)(0), // 5: vinbero.v1.Srv6EncapFlowLabelMode
+	(OamReplyType)(0),           // 6: vinbero.v1.OamReplyType
+	(*OperationError)(nil),      // 7: vinbero.v1.OperationError
 }
 var file_vinbero_v1_enums_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -466,7 +712,7 @@ func file_vinbero_v1_enums_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_vinbero_v1_enums_proto_rawDesc,
-			NumEnums:      3,
+			NumEnums:      7,
 			NumMessages:   1,
 			NumExtensions: 0,
 			NumServices:   0,
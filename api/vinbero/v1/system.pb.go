@@ -0,0 +1,1463 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        (unknown)
+// source: vinbero/v1/system.proto
+
+package vinberov1
+
+import (
+	reflect "reflect"
+	sync "sync"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ImportMode int32
+
+const (
+	ImportMode_IMPORT_MODE_UNSPECIFIED ImportMode = 0 // Same as MERGE
+	ImportMode_IMPORT_MODE_MERGE       ImportMode = 1 // Create or update the entries of the document; leave the others
+	ImportMode_IMPORT_MODE_REPLACE     ImportMode = 2 // Also delete installed entries the document doesn't list
+)
+
+// Enum value maps for ImportMode.
+var (
+	ImportMode_name = map[int32]string{
+		0: "IMPORT_MODE_UNSPECIFIED",
+		1: "IMPORT_MODE_MERGE",
+		2: "IMPORT_MODE_REPLACE",
+	}
+	ImportMode_value = map[string]int32{
+		"IMPORT_MODE_UNSPECIFIED": 0,
+		"IMPORT_MODE_MERGE":       1,
+		"IMPORT_MODE_REPLACE":     2,
+	}
+)
+
+func (x ImportMode) Enum() *ImportMode {
+	p := new(ImportMode)
+	*p = x
+	return p
+}
+
+func (x ImportMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_vinbero_v1_system_proto_enumTypes[0].Descriptor()
+}
+
+func (ImportMode) Type() protoreflect.EnumType {
+	return &file_vinbero_v1_system_proto_enumTypes[0]
+}
+
+func (x ImportMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportMode.Descriptor instead.
+func (ImportMode) EnumDescriptor() ([]byte, []int) {
+	return file_vinbero_v1_system_proto_rawDescGZIP(), []int{0}
+}
+
+type MapEventKind int32
+
+const (
+	MapEventKind_MAP_EVENT_KIND_UNSPECIFIED        MapEventKind = 0
+	MapEventKind_MAP_EVENT_KIND_THRESHOLD_EXCEEDED MapEventKind = 1 // Entries reached warn_percent of capacity
+	MapEventKind_MAP_EVENT_KIND_FULL               MapEventKind = 2 // Entries reached capacity; creates fail until entries are removed or the map is resized
+	MapEventKind_MAP_EVENT_KIND_THRESHOLD_CLEARED  MapEventKind = 3 // Entries dropped back below warn_percent
+)
+
+// Enum value maps for MapEventKind.
+var (
+	MapEventKind_name = map[int32]string{
+		0: "MAP_EVENT_KIND_UNSPECIFIED",
+		1: "MAP_EVENT_KIND_THRESHOLD_EXCEEDED",
+		2: "MAP_EVENT_KIND_FULL",
+		3: "MAP_EVENT_KIND_THRESHOLD_CLEARED",
+	}
+	MapEventKind_value = map[string]int32{
+		"MAP_EVENT_KIND_UNSPECIFIED":        0,
+		"MAP_EVENT_KIND_THRESHOLD_EXCEEDED": 1,
+		"MAP_EVENT_KIND_FULL":               2,
+		"MAP_EVENT_KIND_THRESHOLD_CLEARED":  3,
+	}
+)
+
+func (x MapEventKind) Enum() *MapEventKind {
+	p := new(MapEventKind)
+	*p = x
+	return p
+}
+
+func (x MapEventKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MapEventKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_vinbero_v1_system_proto_enumTypes[1].Descriptor()
+}
+
+func (MapEventKind) Type() protoreflect.EnumType {
+	return &file_vinbero_v1_system_proto_enumTypes[1]
+}
+
+func (x MapEventKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MapEventKind.Descriptor instead.
+func (MapEventKind) EnumDescriptor() ([]byte, []int) {
+	return file_vinbero_v1_system_proto_rawDescGZIP(), []int{1}
+}
+
+// NodeConfig is the control state of a node as one document. Lists that
+// the config: section of vinbero.yml also has use the same keys, so a
+// section of an export can be pasted there.
+type NodeConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vrfs             []*Vrf                   `protobuf:"bytes,1,rep,name=vrfs,proto3" json:"vrfs,omitempty"`
+	Bridges          []*Bridge                `protobuf:"bytes,2,rep,name=bridges,proto3" json:"bridges,omitempty"`                                           // Bridges of named BDs are under bridge_domains
+	EthernetSegments []*EthernetSegment       `protobuf:"bytes,3,rep,name=ethernet_segments,json=ethernetSegments,proto3" json:"ethernet_segments,omitempty"` // ESs created by named BDs are under bridge_domains
+	SidFunctions     []*SidFunction           `protobuf:"bytes,4,rep,name=sid_functions,json=sidFunctions,proto3" json:"sid_functions,omitempty"`             // Plugin aux as plugin_aux_json when the plugin declares its aux type
+	Headendv4S       []*Headendv4             `protobuf:"bytes,5,rep,name=headendv4s,proto3" json:"headendv4s,omitempty"`
+	Headendv6S       []*Headendv6             `protobuf:"bytes,6,rep,name=headendv6s,proto3" json:"headendv6s,omitempty"`
+	HeadendL2S       []*HeadendL2             `protobuf:"bytes,7,rep,name=headend_l2s,json=headendL2s,proto3" json:"headend_l2s,omitempty"`
+	BdPeers          []*BdPeer                `protobuf:"bytes,8,rep,name=bd_peers,json=bdPeers,proto3" json:"bd_peers,omitempty"`
+	VlanTable        []*VlanTableEntry        `protobuf:"bytes,9,rep,name=vlan_table,json=vlanTable,proto3" json:"vlan_table,omitempty"`
+	FdbEntries       []*FdbEntry              `protobuf:"bytes,10,rep,name=fdb_entries,json=fdbEntries,proto3" json:"fdb_entries,omitempty"` // Static local entries only
+	Plugins          []*PluginRegisterRequest `protobuf:"bytes,11,rep,name=plugins,proto3" json:"plugins,omitempty"`                         // Registered plugins with their ELF
+	SrDomains        []*SrDomainPolicy        `protobuf:"bytes,12,rep,name=sr_domains,json=srDomains,proto3" json:"sr_domains,omitempty"`
+	StampReflectors  []*StampReflector        `protobuf:"bytes,13,rep,name=stamp_reflectors,json=stampReflectors,proto3" json:"stamp_reflectors,omitempty"` // Without reflected_packets
+	// Named BDs with the bridge, ESs and IRB they own; their ACs, SIDs,
+	// peers, MAC limits, storm controls and neighbors are in the lists above
+	// and below, like entries created for the bd_id directly
+	BridgeDomains []*BridgeDomain `protobuf:"bytes,14,rep,name=bridge_domains,json=bridgeDomains,proto3" json:"bridge_domains,omitempty"`
+	MacLimits     []*MacLimit     `protobuf:"bytes,15,rep,name=mac_limits,json=macLimits,proto3" json:"mac_limits,omitempty"`             // Configured limits only, without usage
+	StormControls []*StormControl `protobuf:"bytes,16,rep,name=storm_controls,json=stormControls,proto3" json:"storm_controls,omitempty"` // Without drop counters
+	Neighbors     []*Neighbor     `protobuf:"bytes,17,rep,name=neighbors,proto3" json:"neighbors,omitempty"`                              // STATIC bindings only; snooped and EVPN ones are learned again
+	IrbRoutes     []*IrbRoute     `protobuf:"bytes,18,rep,name=irb_routes,json=irbRoutes,proto3" json:"irb_routes,omitempty"`             // Configured routes only; EVPN host routes are installed again
+	VniMappings   []*VniMapping   `protobuf:"bytes,19,rep,name=vni_mappings,json=vniMappings,proto3" json:"vni_mappings,omitempty"`
+}
+
+func (x *NodeConfig) Reset() {
+	*x = NodeConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_system_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeConfig) ProtoMessage() {}
+
+func (x *NodeConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_system_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeConfig.ProtoReflect.Descriptor instead.
+func (*NodeConfig) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_system_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *NodeConfig) GetVrfs() []*Vrf {
+	if x != nil {
+		return x.Vrfs
+	}
+	return nil
+}
+
+func (x *NodeConfig) GetBridges() []*Bridge {
+	if x != nil {
+		return x.Bridges
+	}
+	return nil
+}
+
+func (x *NodeConfig) GetEthernetSegments() []*EthernetSegment {
+	if x != nil {
+		return x.EthernetSegments
+	}
+	return nil
+}
+
+func (x *NodeConfig) GetSidFunctions() []*SidFunction {
+	if x != nil {
+		return x.SidFunctions
+	}
+	return nil
+}
+
+func (x *NodeConfig) GetHeadendv4S() []*Headendv4 {
+	if x != nil {
+		return x.Headendv4S
+	}
+	return nil
+}
+
+func (x *NodeConfig) GetHeadendv6S() []*Headendv6 {
+	if x != nil {
+		return x.Headendv6S
+	}
+	return nil
+}
+
+func (x *NodeConfig) GetHeadendL2S() []*HeadendL2 {
+	if x != nil {
+		return x.HeadendL2S
+	}
+	return nil
+}
+
+func (x *NodeConfig) GetBdPeers() []*BdPeer {
+	if x != nil {
+		return x.BdPeers
+	}
+	return nil
+}
+
+func (x *NodeConfig) GetVlanTable() []*VlanTableEntry {
+	if x != nil {
+		return x.VlanTable
+	}
+	return nil
+}
+
+func (x *NodeConfig) GetFdbEntries() []*FdbEntry {
+	if x != nil {
+		return x.FdbEntries
+	}
+	return nil
+}
+
+func (x *NodeConfig) GetPlugins() []*PluginRegisterRequest {
+	if x != nil {
+		return x.Plugins
+	}
+	return nil
+}
+
+func (x *NodeConfig) GetSrDomains() []*SrDomainPolicy {
+	if x != nil {
+		return x.SrDomains
+	}
+	return nil
+}
+
+func (x *NodeConfig) GetStampReflectors() []*StampReflector {
+	if x != nil {
+		return x.StampReflectors
+	}
+	return nil
+}
+
+func (x *NodeConfig) GetBridgeDomains() []*BridgeDomain {
+	if x != nil {
+		return x.BridgeDomains
+	}
+	return nil
+}
+
+func (x *NodeConfig) GetMacLimits() []*MacLimit {
+	if x != nil {
+		return x.MacLimits
+	}
+	return nil
+}
+
+func (x *NodeConfig) GetStormControls() []*StormControl {
+	if x != nil {
+		return x.StormControls
+	}
+	return nil
+}
+
+func (x *NodeConfig) GetNeighbors() []*Neighbor {
+	if x != nil {
+		return x.Neighbors
+	}
+	return nil
+}
+
+func (x *NodeConfig) GetIrbRoutes() []*IrbRoute {
+	if x != nil {
+		return x.IrbRoutes
+	}
+	return nil
+}
+
+func (x *NodeConfig) GetVniMappings() []*VniMapping {
+	if x != nil {
+		return x.VniMappings
+	}
+	return nil
+}
+
+type ExportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ExportRequest) Reset() {
+	*x = ExportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_system_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportRequest) ProtoMessage() {}
+
+func (x *ExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_system_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportRequest.ProtoReflect.Descriptor instead.
+func (*ExportRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_system_proto_rawDescGZIP(), []int{1}
+}
+
+type ExportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Config *NodeConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *ExportResponse) Reset() {
+	*x = ExportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_system_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportResponse) ProtoMessage() {}
+
+func (x *ExportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_system_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportResponse.ProtoReflect.Descriptor instead.
+func (*ExportResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_system_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ExportResponse) GetConfig() *NodeConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type ImportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Config *NodeConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	Mode   ImportMode  `protobuf:"varint,2,opt,name=mode,proto3,enum=vinbero.v1.ImportMode" json:"mode,omitempty"`
+}
+
+func (x *ImportRequest) Reset() {
+	*x = ImportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_system_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRequest) ProtoMessage() {}
+
+func (x *ImportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_system_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRequest.ProtoReflect.Descriptor instead.
+func (*ImportRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_system_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ImportRequest) GetConfig() *NodeConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *ImportRequest) GetMode() ImportMode {
+	if x != nil {
+		return x.Mode
+	}
+	return ImportMode_IMPORT_MODE_UNSPECIFIED
+}
+
+type ImportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sections []*ConfigSectionResult `protobuf:"bytes,1,rep,name=sections,proto3" json:"sections,omitempty"`
+}
+
+func (x *ImportResponse) Reset() {
+	*x = ImportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_system_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportResponse) ProtoMessage() {}
+
+func (x *ImportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_system_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportResponse.ProtoReflect.Descriptor instead.
+func (*ImportResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_system_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ImportResponse) GetSections() []*ConfigSectionResult {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+// BpfMapUsage is the fill level of one control map
+type BpfMapUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                         // ELF name, e.g. fdb_map
+	Type          string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`                                         // Hash, LRUHash, LPMTrie or Array
+	Entries       uint32 `protobuf:"varint,3,opt,name=entries,proto3" json:"entries,omitempty"`                                  // Array: non-zero slots; sid_aux_map: allocated aux indices
+	Capacity      uint32 `protobuf:"varint,4,opt,name=capacity,proto3" json:"capacity,omitempty"`                                // max_entries; sid_aux_map: max_entries - 1, slot 0 is reserved
+	Bytes         uint64 `protobuf:"varint,5,opt,name=bytes,proto3" json:"bytes,omitempty"`                                      // Memory the kernel charges for the map
+	HighWatermark uint32 `protobuf:"varint,6,opt,name=high_watermark,json=highWatermark,proto3" json:"high_watermark,omitempty"` // Most entries seen since vinberod started
+	WarnPercent   uint32 `protobuf:"varint,7,opt,name=warn_percent,json=warnPercent,proto3" json:"warn_percent,omitempty"`       // Threshold from settings.map_usage (0 = no warning)
+}
+
+func (x *BpfMapUsage) Reset() {
+	*x = BpfMapUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_system_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BpfMapUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BpfMapUsage) ProtoMessage() {}
+
+func (x *BpfMapUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_system_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BpfMapUsage.ProtoReflect.Descriptor instead.
+func (*BpfMapUsage) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_system_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BpfMapUsage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BpfMapUsage) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *BpfMapUsage) GetEntries() uint32 {
+	if x != nil {
+		return x.Entries
+	}
+	return 0
+}
+
+func (x *BpfMapUsage) GetCapacity() uint32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *BpfMapUsage) GetBytes() uint64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+func (x *BpfMapUsage) GetHighWatermark() uint32 {
+	if x != nil {
+		return x.HighWatermark
+	}
+	return 0
+}
+
+func (x *BpfMapUsage) GetWarnPercent() uint32 {
+	if x != nil {
+		return x.WarnPercent
+	}
+	return 0
+}
+
+type MapUsageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MapUsageRequest) Reset() {
+	*x = MapUsageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_system_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MapUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MapUsageRequest) ProtoMessage() {}
+
+func (x *MapUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_system_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MapUsageRequest.ProtoReflect.Descriptor instead.
+func (*MapUsageRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_system_proto_rawDescGZIP(), []int{6}
+}
+
+type MapUsageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Maps []*BpfMapUsage `protobuf:"bytes,1,rep,name=maps,proto3" json:"maps,omitempty"`
+}
+
+func (x *MapUsageResponse) Reset() {
+	*x = MapUsageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_system_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MapUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MapUsageResponse) ProtoMessage() {}
+
+func (x *MapUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_system_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MapUsageResponse.ProtoReflect.Descriptor instead.
+func (*MapUsageResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_system_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *MapUsageResponse) GetMaps() []*BpfMapUsage {
+	if x != nil {
+		return x.Maps
+	}
+	return nil
+}
+
+// MapEvent is one change of a map's fill level across its threshold,
+// found when vinberod samples the maps (settings.map_usage).
+type MapEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TimestampUnixNano int64        `protobuf:"varint,1,opt,name=timestamp_unix_nano,json=timestampUnixNano,proto3" json:"timestamp_unix_nano,omitempty"`
+	Map               string       `protobuf:"bytes,2,opt,name=map,proto3" json:"map,omitempty"`
+	Kind              MapEventKind `protobuf:"varint,3,opt,name=kind,proto3,enum=vinbero.v1.MapEventKind" json:"kind,omitempty"`
+	Entries           uint32       `protobuf:"varint,4,opt,name=entries,proto3" json:"entries,omitempty"`
+	Capacity          uint32       `protobuf:"varint,5,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	WarnPercent       uint32       `protobuf:"varint,6,opt,name=warn_percent,json=warnPercent,proto3" json:"warn_percent,omitempty"`
+}
+
+func (x *MapEvent) Reset() {
+	*x = MapEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_system_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MapEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MapEvent) ProtoMessage() {}
+
+func (x *MapEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_system_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MapEvent.ProtoReflect.Descriptor instead.
+func (*MapEvent) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_system_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MapEvent) GetTimestampUnixNano() int64 {
+	if x != nil {
+		return x.TimestampUnixNano
+	}
+	return 0
+}
+
+func (x *MapEvent) GetMap() string {
+	if x != nil {
+		return x.Map
+	}
+	return ""
+}
+
+func (x *MapEvent) GetKind() MapEventKind {
+	if x != nil {
+		return x.Kind
+	}
+	return MapEventKind_MAP_EVENT_KIND_UNSPECIFIED
+}
+
+func (x *MapEvent) GetEntries() uint32 {
+	if x != nil {
+		return x.Entries
+	}
+	return 0
+}
+
+func (x *MapEvent) GetCapacity() uint32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *MapEvent) GetWarnPercent() uint32 {
+	if x != nil {
+		return x.WarnPercent
+	}
+	return 0
+}
+
+type MapEventListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limit uint32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"` // Most recent N events (0 = all buffered)
+}
+
+func (x *MapEventListRequest) Reset() {
+	*x = MapEventListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_system_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MapEventListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MapEventListRequest) ProtoMessage() {}
+
+func (x *MapEventListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_system_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MapEventListRequest.ProtoReflect.Descriptor instead.
+func (*MapEventListRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_system_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *MapEventListRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type MapEventListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events []*MapEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"` // Oldest first
+}
+
+func (x *MapEventListResponse) Reset() {
+	*x = MapEventListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_system_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MapEventListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MapEventListResponse) ProtoMessage() {}
+
+func (x *MapEventListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_system_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MapEventListResponse.ProtoReflect.Descriptor instead.
+func (*MapEventListResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_system_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MapEventListResponse) GetEvents() []*MapEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// MapResizeRequest grows a map without losing its entries: vinberod
+// copies it into a map of the new capacity and reloads the programs
+// against it. With pin_maps the pinned map keeps the new size across
+// restarts until settings.entries is raised past it; without pins it
+// lasts until restart, so put it in settings.entries as well.
+type MapResizeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Capacity uint32 `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"` // Must exceed the current capacity
+}
+
+func (x *MapResizeRequest) Reset() {
+	*x = MapResizeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_system_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MapResizeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MapResizeRequest) ProtoMessage() {}
+
+func (x *MapResizeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_system_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MapResizeRequest.ProtoReflect.Descriptor instead.
+func (*MapResizeRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_system_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *MapResizeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MapResizeRequest) GetCapacity() uint32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+type MapResizeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Map             *BpfMapUsage `protobuf:"bytes,1,opt,name=map,proto3" json:"map,omitempty"`                                                // After the resize
+	ReloadedPlugins []string     `protobuf:"bytes,2,rep,name=reloaded_plugins,json=reloadedPlugins,proto3" json:"reloaded_plugins,omitempty"` // "<map_type>[<index>] <program>" of plugins registered again against the new map
+}
+
+func (x *MapResizeResponse) Reset() {
+	*x = MapResizeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_system_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MapResizeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MapResizeResponse) ProtoMessage() {}
+
+func (x *MapResizeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_system_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MapResizeResponse.ProtoReflect.Descriptor instead.
+func (*MapResizeResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_system_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MapResizeResponse) GetMap() *BpfMapUsage {
+	if x != nil {
+		return x.Map
+	}
+	return nil
+}
+
+func (x *MapResizeResponse) GetReloadedPlugins() []string {
+	if x != nil {
+		return x.ReloadedPlugins
+	}
+	return nil
+}
+
+var File_vinbero_v1_system_proto protoreflect.FileDescriptor
+
+var file_vinbero_v1_system_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x76, 0x69, 0x6e, 0x62, 0x65,
+	0x72, 0x6f, 0x2e, 0x76, 0x31, 0x1a, 0x17, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2f, 0x76,
+	0x31, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18,
+	0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x69, 0x6e, 0x62, 0x65,
+	0x72, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc8, 0x08, 0x0a, 0x0a, 0x4e, 0x6f, 0x64,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x23, 0x0a, 0x04, 0x76, 0x72, 0x66, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x56, 0x72, 0x66, 0x52, 0x04, 0x76, 0x72, 0x66, 0x73, 0x12, 0x2c, 0x0a, 0x07,
+	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x52, 0x07, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x73, 0x12, 0x48, 0x0a, 0x11, 0x65, 0x74,
+	0x68, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x5f, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x74, 0x68, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x10, 0x65, 0x74, 0x68, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x53, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x3c, 0x0a, 0x0d, 0x73, 0x69, 0x64, 0x5f, 0x66, 0x75, 0x6e, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x69,
+	0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x64, 0x46, 0x75, 0x6e, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x73, 0x69, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x35, 0x0a, 0x0a, 0x68, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x34, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f,
+	0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x34, 0x52, 0x0a, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x34, 0x73, 0x12, 0x35, 0x0a, 0x0a, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x6e, 0x64, 0x76, 0x36, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x6e, 0x64, 0x76, 0x36, 0x52, 0x0a, 0x68, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x36, 0x73,
+	0x12, 0x36, 0x0a, 0x0b, 0x68, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x5f, 0x6c, 0x32, 0x73, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x4c, 0x32, 0x52, 0x0a, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x6e, 0x64, 0x4c, 0x32, 0x73, 0x12, 0x2d, 0x0a, 0x08, 0x62, 0x64, 0x5f, 0x70,
+	0x65, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x76, 0x69, 0x6e,
+	0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x64, 0x50, 0x65, 0x65, 0x72, 0x52, 0x07,
+	0x62, 0x64, 0x50, 0x65, 0x65, 0x72, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x76, 0x6c, 0x61, 0x6e, 0x5f,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69,
+	0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x76, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x12, 0x35, 0x0a, 0x0b, 0x66, 0x64, 0x62, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72,
+	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x64, 0x62, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x66,
+	0x64, 0x62, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x07, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x76, 0x69, 0x6e,
+	0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x70,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x72, 0x5f, 0x64, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69, 0x6e,
+	0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x72, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x09, 0x73, 0x72, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x73, 0x12, 0x45, 0x0a, 0x10, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x72, 0x65, 0x66, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69,
+	0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x65,
+	0x66, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0f, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x65,
+	0x66, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x3f, 0x0a, 0x0e, 0x62, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x0d, 0x62, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x33, 0x0a, 0x0a, 0x6d, 0x61, 0x63,
+	0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x63, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x52, 0x09, 0x6d, 0x61, 0x63, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x3f,
+	0x0a, 0x0e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x73,
+	0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x52, 0x0d, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x73, 0x12,
+	0x32, 0x0a, 0x09, 0x6e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x18, 0x11, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
+	0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x52, 0x09, 0x6e, 0x65, 0x69, 0x67, 0x68, 0x62,
+	0x6f, 0x72, 0x73, 0x12, 0x33, 0x0a, 0x0a, 0x69, 0x72, 0x62, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72,
+	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x72, 0x62, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x09, 0x69,
+	0x72, 0x62, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x0c, 0x76, 0x6e, 0x69, 0x5f,
+	0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6e, 0x69, 0x4d,
+	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x0b, 0x76, 0x6e, 0x69, 0x4d, 0x61, 0x70, 0x70, 0x69,
+	0x6e, 0x67, 0x73, 0x22, 0x0f, 0x0a, 0x0d, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x40, 0x0a, 0x0e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f,
+	0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x6b, 0x0a, 0x0d, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72,
+	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2a, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d,
+	0x6f, 0x64, 0x65, 0x22, 0x4d, 0x0a, 0x0e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x08, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72,
+	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x08, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0xcb, 0x01, 0x0a, 0x0b, 0x42, 0x70, 0x66, 0x4d, 0x61, 0x70, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x65, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x68, 0x69, 0x67, 0x68, 0x5f, 0x77,
+	0x61, 0x74, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d,
+	0x68, 0x69, 0x67, 0x68, 0x57, 0x61, 0x74, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x21, 0x0a,
+	0x0c, 0x77, 0x61, 0x72, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0b, 0x77, 0x61, 0x72, 0x6e, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x22, 0x11, 0x0a, 0x0f, 0x4d, 0x61, 0x70, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x3f, 0x0a, 0x10, 0x4d, 0x61, 0x70, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x6d, 0x61, 0x70, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x70, 0x66, 0x4d, 0x61, 0x70, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x04,
+	0x6d, 0x61, 0x70, 0x73, 0x22, 0xd3, 0x01, 0x0a, 0x08, 0x4d, 0x61, 0x70, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x2e, 0x0a, 0x13, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x75,
+	0x6e, 0x69, 0x78, 0x5f, 0x6e, 0x61, 0x6e, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x55, 0x6e, 0x69, 0x78, 0x4e, 0x61, 0x6e,
+	0x6f, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6d, 0x61, 0x70, 0x12, 0x2c, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x18, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x61, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63,
+	0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x63,
+	0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x61, 0x72, 0x6e, 0x5f,
+	0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x77,
+	0x61, 0x72, 0x6e, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x22, 0x2b, 0x0a, 0x13, 0x4d, 0x61,
+	0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x44, 0x0a, 0x14, 0x4d, 0x61, 0x70, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2c, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x70,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x42, 0x0a,
+	0x10, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74,
+	0x79, 0x22, 0x69, 0x0a, 0x11, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x03, 0x6d, 0x61, 0x70, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x70, 0x66, 0x4d, 0x61, 0x70, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x03, 0x6d, 0x61,
+	0x70, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x5f, 0x70, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x6c,
+	0x6f, 0x61, 0x64, 0x65, 0x64, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2a, 0x59, 0x0a, 0x0a,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x49, 0x4d,
+	0x50, 0x4f, 0x52, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x49, 0x4d, 0x50, 0x4f, 0x52,
+	0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4d, 0x45, 0x52, 0x47, 0x45, 0x10, 0x01, 0x12, 0x17,
+	0x0a, 0x13, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x52, 0x45,
+	0x50, 0x4c, 0x41, 0x43, 0x45, 0x10, 0x02, 0x2a, 0x94, 0x01, 0x0a, 0x0c, 0x4d, 0x61, 0x70, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1e, 0x0a, 0x1a, 0x4d, 0x41, 0x50, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x25, 0x0a, 0x21, 0x4d, 0x41, 0x50, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x54, 0x48, 0x52, 0x45, 0x53,
+	0x48, 0x4f, 0x4c, 0x44, 0x5f, 0x45, 0x58, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x01, 0x12,
+	0x17, 0x0a, 0x13, 0x4d, 0x41, 0x50, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e,
+	0x44, 0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x10, 0x02, 0x12, 0x24, 0x0a, 0x20, 0x4d, 0x41, 0x50, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x54, 0x48, 0x52, 0x45, 0x53,
+	0x48, 0x4f, 0x4c, 0x44, 0x5f, 0x43, 0x4c, 0x45, 0x41, 0x52, 0x45, 0x44, 0x10, 0x03, 0x32, 0xf5,
+	0x02, 0x0a, 0x0d, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x3f, 0x0a, 0x06, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x19, 0x2e, 0x76, 0x69, 0x6e,
+	0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3f, 0x0a, 0x06, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x19, 0x2e, 0x76, 0x69,
+	0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f,
+	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x45, 0x0a, 0x08, 0x4d, 0x61, 0x70, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1b,
+	0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x70, 0x55,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x76, 0x69,
+	0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x70, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0c, 0x4d, 0x61, 0x70,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1f, 0x2e, 0x76, 0x69, 0x6e, 0x62,
+	0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x76, 0x69, 0x6e,
+	0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x09,
+	0x4d, 0x61, 0x70, 0x52, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x2e, 0x76, 0x69, 0x6e, 0x62,
+	0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x73, 0x69, 0x7a, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72,
+	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x9c, 0x01, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x2e, 0x76,
+	0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x53, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x61, 0x6b, 0x65, 0x68, 0x61, 0x79, 0x61, 0x2f, 0x76, 0x69,
+	0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72,
+	0x6f, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x76, 0x31, 0xa2, 0x02,
+	0x03, 0x56, 0x58, 0x58, 0xaa, 0x02, 0x0a, 0x56, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x56,
+	0x31, 0xca, 0x02, 0x0a, 0x56, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x5c, 0x56, 0x31, 0xe2, 0x02,
+	0x16, 0x56, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0b, 0x56, 0x69, 0x6e, 0x62, 0x65, 0x72,
+	0x6f, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_vinbero_v1_system_proto_rawDescOnce sync.Once
+	file_vinbero_v1_system_proto_rawDescData = file_vinbero_v1_system_proto_rawDesc
+)
+
+func file_vinbero_v1_system_proto_rawDescGZIP() []byte {
+	file_vinbero_v1_system_proto_rawDescOnce.Do(func() {
+		file_vinbero_v1_system_proto_rawDescData = protoimpl.X.CompressGZIP(file_vinbero_v1_system_proto_rawDescData)
+	})
+	return file_vinbero_v1_system_proto_rawDescData
+}
+
+var file_vinbero_v1_system_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_vinbero_v1_system_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_vinbero_v1_system_proto_goTypes = []interface{}{
+	(ImportMode)(0),               // 0: vinbero.v1.ImportMode
+	(MapEventKind)(0),             // 1: vinbero.v1.MapEventKind
+	(*NodeConfig)(nil),            // 2: vinbero.v1.NodeConfig
+	(*ExportRequest)(nil),         // 3: vinbero.v1.ExportRequest
+	(*ExportResponse)(nil),        // 4: vinbero.v1.ExportResponse
+	(*ImportRequest)(nil),         // 5: vinbero.v1.ImportRequest
+	(*ImportResponse)(nil),        // 6: vinbero.v1.ImportResponse
+	(*BpfMapUsage)(nil),           // 7: vinbero.v1.BpfMapUsage
+	(*MapUsageRequest)(nil),       // 8: vinbero.v1.MapUsageRequest
+	(*MapUsageResponse)(nil),      // 9: vinbero.v1.MapUsageResponse
+	(*MapEvent)(nil),              // 10: vinbero.v1.MapEvent
+	(*MapEventListRequest)(nil),   // 11: vinbero.v1.MapEventListRequest
+	(*MapEventListResponse)(nil),  // 12: vinbero.v1.MapEventListResponse
+	(*MapResizeRequest)(nil),      // 13: vinbero.v1.MapResizeRequest
+	(*MapResizeResponse)(nil),     // 14: vinbero.v1.MapResizeResponse
+	(*Vrf)(nil),                   // 15: vinbero.v1.Vrf
+	(*Bridge)(nil),                // 16: vinbero.v1.Bridge
+	(*EthernetSegment)(nil),       // 17: vinbero.v1.EthernetSegment
+	(*SidFunction)(nil),           // 18: vinbero.v1.SidFunction
+	(*Headendv4)(nil),             // 19: vinbero.v1.Headendv4
+	(*Headendv6)(nil),             // 20: vinbero.v1.Headendv6
+	(*HeadendL2)(nil),             // 21: vinbero.v1.HeadendL2
+	(*BdPeer)(nil),                // 22: vinbero.v1.BdPeer
+	(*VlanTableEntry)(nil),        // 23: vinbero.v1.VlanTableEntry
+	(*FdbEntry)(nil),              // 24: vinbero.v1.FdbEntry
+	(*PluginRegisterRequest)(nil), // 25: vinbero.v1.PluginRegisterRequest
+	(*SrDomainPolicy)(nil),        // 26: vinbero.v1.SrDomainPolicy
+	(*StampReflector)(nil),        // 27: vinbero.v1.StampReflector
+	(*BridgeDomain)(nil),          // 28: vinbero.v1.BridgeDomain
+	(*MacLimit)(nil),              // 29: vinbero.v1.MacLimit
+	(*StormControl)(nil),          // 30: vinbero.v1.StormControl
+	(*Neighbor)(nil),              // 31: vinbero.v1.Neighbor
+	(*IrbRoute)(nil),              // 32: vinbero.v1.IrbRoute
+	(*VniMapping)(nil),            // 33: vinbero.v1.VniMapping
+	(*ConfigSectionResult)(nil),   // 34: vinbero.v1.ConfigSectionResult
+}
+var file_vinbero_v1_system_proto_depIdxs = []int32{
+	15, // 0: vinbero.v1.NodeConfig.vrfs:type_name -> vinbero.v1.Vrf
+	16, // 1: vinbero.v1.NodeConfig.bridges:type_name -> vinbero.v1.Bridge
+	17, // 2: vinbero.v1.NodeConfig.ethernet_segments:type_name -> vinbero.v1.EthernetSegment
+	18, // 3: vinbero.v1.NodeConfig.sid_functions:type_name -> vinbero.v1.SidFunction
+	19, // 4: vinbero.v1.NodeConfig.headendv4s:type_name -> vinbero.v1.Headendv4
+	20, // 5: vinbero.v1.NodeConfig.headendv6s:type_name -> vinbero.v1.Headendv6
+	21, // 6: vinbero.v1.NodeConfig.headend_l2s:type_name -> vinbero.v1.HeadendL2
+	22, // 7: vinbero.v1.NodeConfig.bd_peers:type_name -> vinbero.v1.BdPeer
+	23, // 8: vinbero.v1.NodeConfig.vlan_table:type_name -> vinbero.v1.VlanTableEntry
+	24, // 9: vinbero.v1.NodeConfig.fdb_entries:type_name -> vinbero.v1.FdbEntry
+	25, // 10: vinbero.v1.NodeConfig.plugins:type_name -> vinbero.v1.PluginRegisterRequest
+	26, // 11: vinbero.v1.NodeConfig.sr_domains:type_name -> vinbero.v1.SrDomainPolicy
+	27, // 12: vinbero.v1.NodeConfig.stamp_reflectors:type_name -> vinbero.v1.StampReflector
+	28, // 13: vinbero.v1.NodeConfig.bridge_domains:type_name -> vinbero.v1.BridgeDomain
+	29, // 14: vinbero.v1.NodeConfig.mac_limits:type_name -> vinbero.v1.MacLimit
+	30, // 15: vinbero.v1.NodeConfig.storm_controls:type_name -> vinbero.v1.StormControl
+	31, // 16: vinbero.v1.NodeConfig.neighbors:type_name -> vinbero.v1.Neighbor
+	32, // 17: vinbero.v1.NodeConfig.irb_routes:type_name -> vinbero.v1.IrbRoute
+	33, // 18: vinbero.v1.NodeConfig.vni_mappings:type_name -> vinbero.v1.VniMapping
+	2,  // 19: vinbero.v1.ExportResponse.config:type_name -> vinbero.v1.NodeConfig
+	2,  // 20: vinbero.v1.ImportRequest.config:type_name -> vinbero.v1.NodeConfig
+	0,  // 21: vinbero.v1.ImportRequest.mode:type_name -> vinbero.v1.ImportMode
+	34, // 22: vinbero.v1.ImportResponse.sections:type_name -> vinbero.v1.ConfigSectionResult
+	7,  // 23: vinbero.v1.MapUsageResponse.maps:type_name -> vinbero.v1.BpfMapUsage
+	1,  // 24: vinbero.v1.MapEvent.kind:type_name -> vinbero.v1.MapEventKind
+	10, // 25: vinbero.v1.MapEventListResponse.events:type_name -> vinbero.v1.MapEvent
+	7,  // 26: vinbero.v1.MapResizeResponse.map:type_name -> vinbero.v1.BpfMapUsage
+	3,  // 27: vinbero.v1.SystemService.Export:input_type -> vinbero.v1.ExportRequest
+	5,  // 28: vinbero.v1.SystemService.Import:input_type -> vinbero.v1.ImportRequest
+	8,  // 29: vinbero.v1.SystemService.MapUsage:input_type -> vinbero.v1.MapUsageRequest
+	11, // 30: vinbero.v1.SystemService.MapEventList:input_type -> vinbero.v1.MapEventListRequest
+	13, // 31: vinbero.v1.SystemService.MapResize:input_type -> vinbero.v1.MapResizeRequest
+	4,  // 32: vinbero.v1.SystemService.Export:output_type -> vinbero.v1.ExportResponse
+	6,  // 33: vinbero.v1.SystemService.Import:output_type -> vinbero.v1.ImportResponse
+	9,  // 34: vinbero.v1.SystemService.MapUsage:output_type -> vinbero.v1.MapUsageResponse
+	12, // 35: vinbero.v1.SystemService.MapEventList:output_type -> vinbero.v1.MapEventListResponse
+	14, // 36: vinbero.v1.SystemService.MapResize:output_type -> vinbero.v1.MapResizeResponse
+	32, // [32:37] is the sub-list for method output_type
+	27, // [27:32] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
+}
+
+func init() { file_vinbero_v1_system_proto_init() }
+func file_vinbero_v1_system_proto_init() {
+	if File_vinbero_v1_system_proto != nil {
+		return
+	}
+	file_vinbero_v1_plugin_proto_init()
+	file_vinbero_v1_vinbero_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_vinbero_v1_system_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_system_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_system_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_system_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_system_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_system_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BpfMapUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_system_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MapUsageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_system_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MapUsageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_system_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MapEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_system_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MapEventListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_system_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MapEventListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_system_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MapResizeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_system_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MapResizeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_vinbero_v1_system_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_vinbero_v1_system_proto_goTypes,
+		DependencyIndexes: file_vinbero_v1_system_proto_depIdxs,
+		EnumInfos:         file_vinbero_v1_system_proto_enumTypes,
+		MessageInfos:      file_vinbero_v1_system_proto_msgTypes,
+	}.Build()
+	File_vinbero_v1_system_proto = out.File
+	file_vinbero_v1_system_proto_rawDesc = nil
+	file_vinbero_v1_system_proto_goTypes = nil
+	file_vinbero_v1_system_proto_depIdxs = nil
+}
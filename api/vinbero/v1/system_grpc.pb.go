@@ -0,0 +1,256 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: vinbero/v1/system.proto
+
+package vinberov1
+
+import (
+	context "context"
+
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	SystemService_Export_FullMethodName       = "/vinbero.v1.SystemService/Export"
+	SystemService_Import_FullMethodName       = "/vinbero.v1.SystemService/Import"
+	SystemService_MapUsage_FullMethodName     = "/vinbero.v1.SystemService/MapUsage"
+	SystemService_MapEventList_FullMethodName = "/vinbero.v1.SystemService/MapEventList"
+	SystemService_MapResize_FullMethodName    = "/vinbero.v1.SystemService/MapResize"
+)
+
+// SystemServiceClient is the client API for SystemService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SystemServiceClient interface {
+	Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ExportResponse, error)
+	Import(ctx context.Context, in *ImportRequest, opts ...grpc.CallOption) (*ImportResponse, error)
+	MapUsage(ctx context.Context, in *MapUsageRequest, opts ...grpc.CallOption) (*MapUsageResponse, error)
+	MapEventList(ctx context.Context, in *MapEventListRequest, opts ...grpc.CallOption) (*MapEventListResponse, error)
+	MapResize(ctx context.Context, in *MapResizeRequest, opts ...grpc.CallOption) (*MapResizeResponse, error)
+}
+
+type systemServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSystemServiceClient(cc grpc.ClientConnInterface) SystemServiceClient {
+	return &systemServiceClient{cc}
+}
+
+func (c *systemServiceClient) Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ExportResponse, error) {
+	out := new(ExportResponse)
+	err := c.cc.Invoke(ctx, SystemService_Export_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemServiceClient) Import(ctx context.Context, in *ImportRequest, opts ...grpc.CallOption) (*ImportResponse, error) {
+	out := new(ImportResponse)
+	err := c.cc.Invoke(ctx, SystemService_Import_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemServiceClient) MapUsage(ctx context.Context, in *MapUsageRequest, opts ...grpc.CallOption) (*MapUsageResponse, error) {
+	out := new(MapUsageResponse)
+	err := c.cc.Invoke(ctx, SystemService_MapUsage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemServiceClient) MapEventList(ctx context.Context, in *MapEventListRequest, opts ...grpc.CallOption) (*MapEventListResponse, error) {
+	out := new(MapEventListResponse)
+	err := c.cc.Invoke(ctx, SystemService_MapEventList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemServiceClient) MapResize(ctx context.Context, in *MapResizeRequest, opts ...grpc.CallOption) (*MapResizeResponse, error) {
+	out := new(MapResizeResponse)
+	err := c.cc.Invoke(ctx, SystemService_MapResize_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SystemServiceServer is the server API for SystemService service.
+// All implementations should embed UnimplementedSystemServiceServer
+// for forward compatibility
+type SystemServiceServer interface {
+	Export(context.Context, *ExportRequest) (*ExportResponse, error)
+	Import(context.Context, *ImportRequest) (*ImportResponse, error)
+	MapUsage(context.Context, *MapUsageRequest) (*MapUsageResponse, error)
+	MapEventList(context.Context, *MapEventListRequest) (*MapEventListResponse, error)
+	MapResize(context.Context, *MapResizeRequest) (*MapResizeResponse, error)
+}
+
+// UnimplementedSystemServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedSystemServiceServer struct {
+}
+
+func (UnimplementedSystemServiceServer) Export(context.Context, *ExportRequest) (*ExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+func (UnimplementedSystemServiceServer) Import(context.Context, *ImportRequest) (*ImportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Import not implemented")
+}
+func (UnimplementedSystemServiceServer) MapUsage(context.Context, *MapUsageRequest) (*MapUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MapUsage not implemented")
+}
+func (UnimplementedSystemServiceServer) MapEventList(context.Context, *MapEventListRequest) (*MapEventListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MapEventList not implemented")
+}
+func (UnimplementedSystemServiceServer) MapResize(context.Context, *MapResizeRequest) (*MapResizeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MapResize not implemented")
+}
+
+// UnsafeSystemServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SystemServiceServer will
+// result in compilation errors.
+type UnsafeSystemServiceServer interface {
+	mustEmbedUnimplementedSystemServiceServer()
+}
+
+func RegisterSystemServiceServer(s grpc.ServiceRegistrar, srv SystemServiceServer) {
+	s.RegisterService(&SystemService_ServiceDesc, srv)
+}
+
+func _SystemService_Export_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemServiceServer).Export(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SystemService_Export_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemServiceServer).Export(ctx, req.(*ExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SystemService_Import_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemServiceServer).Import(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SystemService_Import_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemServiceServer).Import(ctx, req.(*ImportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SystemService_MapUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MapUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemServiceServer).MapUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SystemService_MapUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemServiceServer).MapUsage(ctx, req.(*MapUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SystemService_MapEventList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MapEventListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemServiceServer).MapEventList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SystemService_MapEventList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemServiceServer).MapEventList(ctx, req.(*MapEventListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SystemService_MapResize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MapResizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemServiceServer).MapResize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SystemService_MapResize_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemServiceServer).MapResize(ctx, req.(*MapResizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SystemService_ServiceDesc is the grpc.ServiceDesc for SystemService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SystemService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "vinbero.v1.SystemService",
+	HandlerType: (*SystemServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Export",
+			Handler:    _SystemService_Export_Handler,
+		},
+		{
+			MethodName: "Import",
+			Handler:    _SystemService_Import_Handler,
+		},
+		{
+			MethodName: "MapUsage",
+			Handler:    _SystemService_MapUsage_Handler,
+		},
+		{
+			MethodName: "MapEventList",
+			Handler:    _SystemService_MapEventList_Handler,
+		},
+		{
+			MethodName: "MapResize",
+			Handler:    _SystemService_MapResize_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "vinbero/v1/system.proto",
+}
@@ -0,0 +1,601 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        (unknown)
+// source: vinbero/v1/transaction.proto
+
+package vinberov1
+
+import (
+	reflect "reflect"
+	sync "sync"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TransactionOp int32
+
+const (
+	TransactionOp_TRANSACTION_OP_UNSPECIFIED TransactionOp = 0
+	TransactionOp_TRANSACTION_OP_CREATE      TransactionOp = 1 // The key must not be installed yet
+	TransactionOp_TRANSACTION_OP_UPDATE      TransactionOp = 2 // The key must be installed; the entry replaces it
+	TransactionOp_TRANSACTION_OP_DELETE      TransactionOp = 3 // The key must be installed; only the key fields are read
+)
+
+// Enum value maps for TransactionOp.
+var (
+	TransactionOp_name = map[int32]string{
+		0: "TRANSACTION_OP_UNSPECIFIED",
+		1: "TRANSACTION_OP_CREATE",
+		2: "TRANSACTION_OP_UPDATE",
+		3: "TRANSACTION_OP_DELETE",
+	}
+	TransactionOp_value = map[string]int32{
+		"TRANSACTION_OP_UNSPECIFIED": 0,
+		"TRANSACTION_OP_CREATE":      1,
+		"TRANSACTION_OP_UPDATE":      2,
+		"TRANSACTION_OP_DELETE":      3,
+	}
+)
+
+func (x TransactionOp) Enum() *TransactionOp {
+	p := new(TransactionOp)
+	*p = x
+	return p
+}
+
+func (x TransactionOp) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TransactionOp) Descriptor() protoreflect.EnumDescriptor {
+	return file_vinbero_v1_transaction_proto_enumTypes[0].Descriptor()
+}
+
+func (TransactionOp) Type() protoreflect.EnumType {
+	return &file_vinbero_v1_transaction_proto_enumTypes[0]
+}
+
+func (x TransactionOp) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TransactionOp.Descriptor instead.
+func (TransactionOp) EnumDescriptor() ([]byte, []int) {
+	return file_vinbero_v1_transaction_proto_rawDescGZIP(), []int{0}
+}
+
+type TransactionOperation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Op TransactionOp `protobuf:"varint,1,opt,name=op,proto3,enum=vinbero.v1.TransactionOp" json:"op,omitempty"`
+	// Types that are assignable to Resource:
+	//	*TransactionOperation_Vrf
+	//	*TransactionOperation_Bridge
+	//	*TransactionOperation_EthernetSegment
+	//	*TransactionOperation_SidFunction
+	//	*TransactionOperation_Headendv4
+	//	*TransactionOperation_Headendv6
+	//	*TransactionOperation_HeadendL2
+	//	*TransactionOperation_BdPeer
+	//	*TransactionOperation_VlanTableEntry
+	Resource isTransactionOperation_Resource `protobuf_oneof:"resource"`
+}
+
+func (x *TransactionOperation) Reset() {
+	*x = TransactionOperation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_transaction_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionOperation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionOperation) ProtoMessage() {}
+
+func (x *TransactionOperation) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_transaction_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionOperation.ProtoReflect.Descriptor instead.
+func (*TransactionOperation) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_transaction_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TransactionOperation) GetOp() TransactionOp {
+	if x != nil {
+		return x.Op
+	}
+	return TransactionOp_TRANSACTION_OP_UNSPECIFIED
+}
+
+func (m *TransactionOperation) GetResource() isTransactionOperation_Resource {
+	if m != nil {
+		return m.Resource
+	}
+	return nil
+}
+
+func (x *TransactionOperation) GetVrf() *Vrf {
+	if x, ok := x.GetResource().(*TransactionOperation_Vrf); ok {
+		return x.Vrf
+	}
+	return nil
+}
+
+func (x *TransactionOperation) GetBridge() *Bridge {
+	if x, ok := x.GetResource().(*TransactionOperation_Bridge); ok {
+		return x.Bridge
+	}
+	return nil
+}
+
+func (x *TransactionOperation) GetEthernetSegment() *EthernetSegment {
+	if x, ok := x.GetResource().(*TransactionOperation_EthernetSegment); ok {
+		return x.EthernetSegment
+	}
+	return nil
+}
+
+func (x *TransactionOperation) GetSidFunction() *SidFunction {
+	if x, ok := x.GetResource().(*TransactionOperation_SidFunction); ok {
+		return x.SidFunction
+	}
+	return nil
+}
+
+func (x *TransactionOperation) GetHeadendv4() *Headendv4 {
+	if x, ok := x.GetResource().(*TransactionOperation_Headendv4); ok {
+		return x.Headendv4
+	}
+	return nil
+}
+
+func (x *TransactionOperation) GetHeadendv6() *Headendv6 {
+	if x, ok := x.GetResource().(*TransactionOperation_Headendv6); ok {
+		return x.Headendv6
+	}
+	return nil
+}
+
+func (x *TransactionOperation) GetHeadendL2() *HeadendL2 {
+	if x, ok := x.GetResource().(*TransactionOperation_HeadendL2); ok {
+		return x.HeadendL2
+	}
+	return nil
+}
+
+func (x *TransactionOperation) GetBdPeer() *BdPeer {
+	if x, ok := x.GetResource().(*TransactionOperation_BdPeer); ok {
+		return x.BdPeer
+	}
+	return nil
+}
+
+func (x *TransactionOperation) GetVlanTableEntry() *VlanTableEntry {
+	if x, ok := x.GetResource().(*TransactionOperation_VlanTableEntry); ok {
+		return x.VlanTableEntry
+	}
+	return nil
+}
+
+type isTransactionOperation_Resource interface {
+	isTransactionOperation_Resource()
+}
+
+type TransactionOperation_Vrf struct {
+	Vrf *Vrf `protobuf:"bytes,2,opt,name=vrf,proto3,oneof"`
+}
+
+type TransactionOperation_Bridge struct {
+	Bridge *Bridge `protobuf:"bytes,3,opt,name=bridge,proto3,oneof"`
+}
+
+type TransactionOperation_EthernetSegment struct {
+	EthernetSegment *EthernetSegment `protobuf:"bytes,4,opt,name=ethernet_segment,json=ethernetSegment,proto3,oneof"`
+}
+
+type TransactionOperation_SidFunction struct {
+	SidFunction *SidFunction `protobuf:"bytes,5,opt,name=sid_function,json=sidFunction,proto3,oneof"`
+}
+
+type TransactionOperation_Headendv4 struct {
+	Headendv4 *Headendv4 `protobuf:"bytes,6,opt,name=headendv4,proto3,oneof"`
+}
+
+type TransactionOperation_Headendv6 struct {
+	Headendv6 *Headendv6 `protobuf:"bytes,7,opt,name=headendv6,proto3,oneof"`
+}
+
+type TransactionOperation_HeadendL2 struct {
+	HeadendL2 *HeadendL2 `protobuf:"bytes,8,opt,name=headend_l2,json=headendL2,proto3,oneof"`
+}
+
+type TransactionOperation_BdPeer struct {
+	BdPeer *BdPeer `protobuf:"bytes,9,opt,name=bd_peer,json=bdPeer,proto3,oneof"`
+}
+
+type TransactionOperation_VlanTableEntry struct {
+	VlanTableEntry *VlanTableEntry `protobuf:"bytes,10,opt,name=vlan_table_entry,json=vlanTableEntry,proto3,oneof"`
+}
+
+func (*TransactionOperation_Vrf) isTransactionOperation_Resource() {}
+
+func (*TransactionOperation_Bridge) isTransactionOperation_Resource() {}
+
+func (*TransactionOperation_EthernetSegment) isTransactionOperation_Resource() {}
+
+func (*TransactionOperation_SidFunction) isTransactionOperation_Resource() {}
+
+func (*TransactionOperation_Headendv4) isTransactionOperation_Resource() {}
+
+func (*TransactionOperation_Headendv6) isTransactionOperation_Resource() {}
+
+func (*TransactionOperation_HeadendL2) isTransactionOperation_Resource() {}
+
+func (*TransactionOperation_BdPeer) isTransactionOperation_Resource() {}
+
+func (*TransactionOperation_VlanTableEntry) isTransactionOperation_Resource() {}
+
+type TransactionCommitRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Operations []*TransactionOperation `protobuf:"bytes,1,rep,name=operations,proto3" json:"operations,omitempty"`
+	DryRun     bool                    `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // Validate only; nothing is applied
+}
+
+func (x *TransactionCommitRequest) Reset() {
+	*x = TransactionCommitRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_transaction_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionCommitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionCommitRequest) ProtoMessage() {}
+
+func (x *TransactionCommitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_transaction_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionCommitRequest.ProtoReflect.Descriptor instead.
+func (*TransactionCommitRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_transaction_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TransactionCommitRequest) GetOperations() []*TransactionOperation {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+func (x *TransactionCommitRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type TransactionCommitResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Applied        bool              `protobuf:"varint,1,opt,name=applied,proto3" json:"applied,omitempty"`                                    // False when validation failed, a step failed, or dry_run was set
+	Errors         []*OperationError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`                                       // Validation errors, or the step that failed
+	RollbackErrors []*OperationError `protobuf:"bytes,3,rep,name=rollback_errors,json=rollbackErrors,proto3" json:"rollback_errors,omitempty"` // Steps that could not be undone
+}
+
+func (x *TransactionCommitResponse) Reset() {
+	*x = TransactionCommitResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_transaction_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionCommitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionCommitResponse) ProtoMessage() {}
+
+func (x *TransactionCommitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_transaction_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionCommitResponse.ProtoReflect.Descriptor instead.
+func (*TransactionCommitResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_transaction_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TransactionCommitResponse) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+func (x *TransactionCommitResponse) GetErrors() []*OperationError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *TransactionCommitResponse) GetRollbackErrors() []*OperationError {
+	if x != nil {
+		return x.RollbackErrors
+	}
+	return nil
+}
+
+var File_vinbero_v1_transaction_proto protoreflect.FileDescriptor
+
+var file_vinbero_v1_transaction_proto_rawDesc = []byte{
+	0x0a, 0x1c, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a,
+	0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x1a, 0x16, 0x76, 0x69, 0x6e, 0x62,
+	0x65, 0x72, 0x6f, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x18, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2f, 0x76, 0x31, 0x2f, 0x76,
+	0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc5, 0x04, 0x0a,
+	0x14, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x19, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x52, 0x02, 0x6f, 0x70,
+	0x12, 0x23, 0x0a, 0x03, 0x76, 0x72, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x72, 0x66, 0x48, 0x00,
+	0x52, 0x03, 0x76, 0x72, 0x66, 0x12, 0x2c, 0x0a, 0x06, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x48, 0x00, 0x52, 0x06, 0x62, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x12, 0x48, 0x0a, 0x10, 0x65, 0x74, 0x68, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x5f,
+	0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x74, 0x68, 0x65, 0x72,
+	0x6e, 0x65, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0f, 0x65, 0x74,
+	0x68, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x3c, 0x0a,
+	0x0c, 0x73, 0x69, 0x64, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x69, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0b,
+	0x73, 0x69, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x09, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x34, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x6e, 0x64, 0x76, 0x34, 0x48, 0x00, 0x52, 0x09, 0x68, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64,
+	0x76, 0x34, 0x12, 0x35, 0x0a, 0x09, 0x68, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x36, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x36, 0x48, 0x00, 0x52, 0x09,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x36, 0x12, 0x36, 0x0a, 0x0a, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x6e, 0x64, 0x5f, 0x6c, 0x32, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x6e, 0x64, 0x4c, 0x32, 0x48, 0x00, 0x52, 0x09, 0x68, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x4c,
+	0x32, 0x12, 0x2d, 0x0a, 0x07, 0x62, 0x64, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x64, 0x50, 0x65, 0x65, 0x72, 0x48, 0x00, 0x52, 0x06, 0x62, 0x64, 0x50, 0x65, 0x65, 0x72,
+	0x12, 0x46, 0x0a, 0x10, 0x76, 0x6c, 0x61, 0x6e, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x65,
+	0x6e, 0x74, 0x72, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69, 0x6e,
+	0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x48, 0x00, 0x52, 0x0e, 0x76, 0x6c, 0x61, 0x6e, 0x54, 0x61,
+	0x62, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x22, 0x75, 0x0a, 0x18, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x40, 0x0a, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0xae, 0x01, 0x0a, 0x19,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31,
+	0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52,
+	0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x43, 0x0a, 0x0f, 0x72, 0x6f, 0x6c, 0x6c, 0x62,
+	0x61, 0x63, 0x6b, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x0e, 0x72, 0x6f,
+	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2a, 0x80, 0x01, 0x0a,
+	0x0d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x12, 0x1e,
+	0x0a, 0x1a, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x50,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19,
+	0x0a, 0x15, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x50,
+	0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x54, 0x52, 0x41,
+	0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x50, 0x5f, 0x55, 0x50, 0x44, 0x41,
+	0x54, 0x45, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x50, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x03, 0x32,
+	0x76, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x60, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x24, 0x2e, 0x76, 0x69, 0x6e,
+	0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x25, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0xa1, 0x01, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x2e,
+	0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x42, 0x10, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x34,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x61, 0x6b, 0x65, 0x68,
+	0x61, 0x79, 0x61, 0x2f, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x69, 0x6e, 0x62, 0x65,
+	0x72, 0x6f, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x56, 0x58, 0x58, 0xaa, 0x02, 0x0a, 0x56, 0x69, 0x6e,
+	0x62, 0x65, 0x72, 0x6f, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0a, 0x56, 0x69, 0x6e, 0x62, 0x65, 0x72,
+	0x6f, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x16, 0x56, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x5c, 0x56,
+	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0b,
+	0x56, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_vinbero_v1_transaction_proto_rawDescOnce sync.Once
+	file_vinbero_v1_transaction_proto_rawDescData = file_vinbero_v1_transaction_proto_rawDesc
+)
+
+func file_vinbero_v1_transaction_proto_rawDescGZIP() []byte {
+	file_vinbero_v1_transaction_proto_rawDescOnce.Do(func() {
+		file_vinbero_v1_transaction_proto_rawDescData = protoimpl.X.CompressGZIP(file_vinbero_v1_transaction_proto_rawDescData)
+	})
+	return file_vinbero_v1_transaction_proto_rawDescData
+}
+
+var file_vinbero_v1_transaction_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_vinbero_v1_transaction_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_vinbero_v1_transaction_proto_goTypes = []interface{}{
+	(TransactionOp)(0),                // 0: vinbero.v1.TransactionOp
+	(*TransactionOperation)(nil),      // 1: vinbero.v1.TransactionOperation
+	(*TransactionCommitRequest)(nil),  // 2: vinbero.v1.TransactionCommitRequest
+	(*TransactionCommitResponse)(nil), // 3: vinbero.v1.TransactionCommitResponse
+	(*Vrf)(nil),                       // 4: vinbero.v1.Vrf
+	(*Bridge)(nil),                    // 5: vinbero.v1.Bridge
+	(*EthernetSegment)(nil),           // 6: vinbero.v1.EthernetSegment
+	(*SidFunction)(nil),               // 7: vinbero.v1.SidFunction
+	(*Headendv4)(nil),                 // 8: vinbero.v1.Headendv4
+	(*Headendv6)(nil),                 // 9: vinbero.v1.Headendv6
+	(*HeadendL2)(nil),                 // 10: vinbero.v1.HeadendL2
+	(*BdPeer)(nil),                    // 11: vinbero.v1.BdPeer
+	(*VlanTableEntry)(nil),            // 12: vinbero.v1.VlanTableEntry
+	(*OperationError)(nil),            // 13: vinbero.v1.OperationError
+}
+var file_vinbero_v1_transaction_proto_depIdxs = []int32{
+	0,  // 0: vinbero.v1.TransactionOperation.op:type_name -> vinbero.v1.TransactionOp
+	4,  // 1: vinbero.v1.TransactionOperation.vrf:type_name -> vinbero.v1.Vrf
+	5,  // 2: vinbero.v1.TransactionOperation.bridge:type_name -> vinbero.v1.Bridge
+	6,  // 3: vinbero.v1.TransactionOperation.ethernet_segment:type_name -> vinbero.v1.EthernetSegment
+	7,  // 4: vinbero.v1.TransactionOperation.sid_function:type_name -> vinbero.v1.SidFunction
+	8,  // 5: vinbero.v1.TransactionOperation.headendv4:type_name -> vinbero.v1.Headendv4
+	9,  // 6: vinbero.v1.TransactionOperation.headendv6:type_name -> vinbero.v1.Headendv6
+	10, // 7: vinbero.v1.TransactionOperation.headend_l2:type_name -> vinbero.v1.HeadendL2
+	11, // 8: vinbero.v1.TransactionOperation.bd_peer:type_name -> vinbero.v1.BdPeer
+	12, // 9: vinbero.v1.TransactionOperation.vlan_table_entry:type_name -> vinbero.v1.VlanTableEntry
+	1,  // 10: vinbero.v1.TransactionCommitRequest.operations:type_name -> vinbero.v1.TransactionOperation
+	13, // 11: vinbero.v1.TransactionCommitResponse.errors:type_name -> vinbero.v1.OperationError
+	13, // 12: vinbero.v1.TransactionCommitResponse.rollback_errors:type_name -> vinbero.v1.OperationError
+	2,  // 13: vinbero.v1.TransactionService.TransactionCommit:input_type -> vinbero.v1.TransactionCommitRequest
+	3,  // 14: vinbero.v1.TransactionService.TransactionCommit:output_type -> vinbero.v1.TransactionCommitResponse
+	14, // [14:15] is the sub-list for method output_type
+	13, // [13:14] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
+}
+
+func init() { file_vinbero_v1_transaction_proto_init() }
+func file_vinbero_v1_transaction_proto_init() {
+	if File_vinbero_v1_transaction_proto != nil {
+		return
+	}
+	file_vinbero_v1_enums_proto_init()
+	file_vinbero_v1_vinbero_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_vinbero_v1_transaction_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactionOperation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_transaction_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactionCommitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_transaction_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactionCommitResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_vinbero_v1_transaction_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*TransactionOperation_Vrf)(nil),
+		(*TransactionOperation_Bridge)(nil),
+		(*TransactionOperation_EthernetSegment)(nil),
+		(*TransactionOperation_SidFunction)(nil),
+		(*TransactionOperation_Headendv4)(nil),
+		(*TransactionOperation_Headendv6)(nil),
+		(*TransactionOperation_HeadendL2)(nil),
+		(*TransactionOperation_BdPeer)(nil),
+		(*TransactionOperation_VlanTableEntry)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_vinbero_v1_transaction_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_vinbero_v1_transaction_proto_goTypes,
+		DependencyIndexes: file_vinbero_v1_transaction_proto_depIdxs,
+		EnumInfos:         file_vinbero_v1_transaction_proto_enumTypes,
+		MessageInfos:      file_vinbero_v1_transaction_proto_msgTypes,
+	}.Build()
+	File_vinbero_v1_transaction_proto = out.File
+	file_vinbero_v1_transaction_proto_rawDesc = nil
+	file_vinbero_v1_transaction_proto_goTypes = nil
+	file_vinbero_v1_transaction_proto_depIdxs = nil
+}
@@ -12,6 +12,7 @@ import (
 
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 const (
@@ -21,6 +22,111 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// MacLimitAction selects what happens to a frame from a new source MAC
+// once its BD or AC is at max_macs.
+type MacLimitAction int32
+
+const (
+	MacLimitAction_MAC_LIMIT_ACTION_UNSPECIFIED MacLimitAction = 0 // Same as DROP
+	MacLimitAction_MAC_LIMIT_ACTION_DROP        MacLimitAction = 1 // Drop the frame
+	MacLimitAction_MAC_LIMIT_ACTION_FLOOD       MacLimitAction = 2 // Forward (flood) the frame without learning its source
+)
+
+// Enum value maps for MacLimitAction.
+var (
+	MacLimitAction_name = map[int32]string{
+		0: "MAC_LIMIT_ACTION_UNSPECIFIED",
+		1: "MAC_LIMIT_ACTION_DROP",
+		2: "MAC_LIMIT_ACTION_FLOOD",
+	}
+	MacLimitAction_value = map[string]int32{
+		"MAC_LIMIT_ACTION_UNSPECIFIED": 0,
+		"MAC_LIMIT_ACTION_DROP":        1,
+		"MAC_LIMIT_ACTION_FLOOD":       2,
+	}
+)
+
+func (x MacLimitAction) Enum() *MacLimitAction {
+	p := new(MacLimitAction)
+	*p = x
+	return p
+}
+
+func (x MacLimitAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MacLimitAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_vinbero_v1_vinbero_proto_enumTypes[0].Descriptor()
+}
+
+func (MacLimitAction) Type() protoreflect.EnumType {
+	return &file_vinbero_v1_vinbero_proto_enumTypes[0]
+}
+
+func (x MacLimitAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MacLimitAction.Descriptor instead.
+func (MacLimitAction) EnumDescriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{0}
+}
+
+// NeighborOrigin records where an IP-to-MAC binding came from. Snooped
+// entries never replace STATIC ones.
+type NeighborOrigin int32
+
+const (
+	NeighborOrigin_NEIGHBOR_ORIGIN_UNSPECIFIED NeighborOrigin = 0
+	NeighborOrigin_NEIGHBOR_ORIGIN_SNOOP       NeighborOrigin = 1 // Learned from a local ARP reply, GARP or NA
+	NeighborOrigin_NEIGHBOR_ORIGIN_EVPN        NeighborOrigin = 2 // Installed from an EVPN RT2 MAC+IP route
+	NeighborOrigin_NEIGHBOR_ORIGIN_STATIC      NeighborOrigin = 3 // Configured through NeighborCreate
+)
+
+// Enum value maps for NeighborOrigin.
+var (
+	NeighborOrigin_name = map[int32]string{
+		0: "NEIGHBOR_ORIGIN_UNSPECIFIED",
+		1: "NEIGHBOR_ORIGIN_SNOOP",
+		2: "NEIGHBOR_ORIGIN_EVPN",
+		3: "NEIGHBOR_ORIGIN_STATIC",
+	}
+	NeighborOrigin_value = map[string]int32{
+		"NEIGHBOR_ORIGIN_UNSPECIFIED": 0,
+		"NEIGHBOR_ORIGIN_SNOOP":       1,
+		"NEIGHBOR_ORIGIN_EVPN":        2,
+		"NEIGHBOR_ORIGIN_STATIC":      3,
+	}
+)
+
+func (x NeighborOrigin) Enum() *NeighborOrigin {
+	p := new(NeighborOrigin)
+	*p = x
+	return p
+}
+
+func (x NeighborOrigin) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NeighborOrigin) Descriptor() protoreflect.EnumDescriptor {
+	return file_vinbero_v1_vinbero_proto_enumTypes[1].Descriptor()
+}
+
+func (NeighborOrigin) Type() protoreflect.EnumType {
+	return &file_vinbero_v1_vinbero_proto_enumTypes[1]
+}
+
+func (x NeighborOrigin) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NeighborOrigin.Descriptor instead.
+func (NeighborOrigin) EnumDescriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{1}
+}
+
 // EsiRedundancyMode reflects RFC 7432 multi-homing modes.
 type EsiRedundancyMode int32
 
@@ -58,11 +164,11 @@ func (x EsiRedundancyMode) String() string {
 }
 
 func (EsiRedundancyMode) Descriptor() protoreflect.EnumDescriptor {
-	return file_vinbero_v1_vinbero_proto_enumTypes[0].Descriptor()
+	return file_vinbero_v1_vinbero_proto_enumTypes[2].Descriptor()
 }
 
 func (EsiRedundancyMode) Type() protoreflect.EnumType {
-	return &file_vinbero_v1_vinbero_proto_enumTypes[0]
+	return &file_vinbero_v1_vinbero_proto_enumTypes[2]
 }
 
 func (x EsiRedundancyMode) Number() protoreflect.EnumNumber {
@@ -71,7 +177,60 @@ func (x EsiRedundancyMode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use EsiRedundancyMode.Descriptor instead.
 func (EsiRedundancyMode) EnumDescriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{0}
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{2}
+}
+
+// VlanRewriteOp selects how the VLAN tags of an egress frame are rewritten.
+type VlanRewriteOp int32
+
+const (
+	VlanRewriteOp_VLAN_REWRITE_OP_UNSPECIFIED VlanRewriteOp = 0 // No rewrite
+	VlanRewriteOp_VLAN_REWRITE_OP_POP         VlanRewriteOp = 1 // Remove the outer tag
+	VlanRewriteOp_VLAN_REWRITE_OP_PUSH        VlanRewriteOp = 2 // Add an outer tag with vlan_id (802.1ad if the frame is already tagged)
+	VlanRewriteOp_VLAN_REWRITE_OP_TRANSLATE   VlanRewriteOp = 3 // Replace the outer VLAN ID with vlan_id, and the inner one with inner_vlan_id if set
+)
+
+// Enum value maps for VlanRewriteOp.
+var (
+	VlanRewriteOp_name = map[int32]string{
+		0: "VLAN_REWRITE_OP_UNSPECIFIED",
+		1: "VLAN_REWRITE_OP_POP",
+		2: "VLAN_REWRITE_OP_PUSH",
+		3: "VLAN_REWRITE_OP_TRANSLATE",
+	}
+	VlanRewriteOp_value = map[string]int32{
+		"VLAN_REWRITE_OP_UNSPECIFIED": 0,
+		"VLAN_REWRITE_OP_POP":         1,
+		"VLAN_REWRITE_OP_PUSH":        2,
+		"VLAN_REWRITE_OP_TRANSLATE":   3,
+	}
+)
+
+func (x VlanRewriteOp) Enum() *VlanRewriteOp {
+	p := new(VlanRewriteOp)
+	*p = x
+	return p
+}
+
+func (x VlanRewriteOp) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VlanRewriteOp) Descriptor() protoreflect.EnumDescriptor {
+	return file_vinbero_v1_vinbero_proto_enumTypes[3].Descriptor()
+}
+
+func (VlanRewriteOp) Type() protoreflect.EnumType {
+	return &file_vinbero_v1_vinbero_proto_enumTypes[3]
+}
+
+func (x VlanRewriteOp) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VlanRewriteOp.Descriptor instead.
+func (VlanRewriteOp) EnumDescriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{3}
 }
 
 // SidFunction represents a single SRv6 Endpoint function configuration.
@@ -104,8 +263,9 @@ type SidFunction struct {
 	ArgsOffset    uint32              `protobuf:"varint,15,opt,name=args_offset,json=argsOffset,proto3" json:"args_offset,omitempty"`                                        // Args.Mob.Session byte offset in SID (RFC 9433, for GTP functions)
 	GtpV4SrcAddr  string              `protobuf:"bytes,16,opt,name=gtp_v4_src_addr,json=gtpV4SrcAddr,proto3" json:"gtp_v4_src_addr,omitempty"`                               // GTP4 outer IPv4 source address (End.M.GTP4.E)
 	TableId       uint32              `protobuf:"varint,17,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`                                                 // VLAN table ID (End.DX2V: VLAN cross-connect scope)
-	PluginAuxRaw  []byte              `protobuf:"bytes,18,opt,name=plugin_aux_raw,json=pluginAuxRaw,proto3" json:"plugin_aux_raw,omitempty"`                                 // Plugin-defined auxiliary payload (<= 196 bytes, cast by plugin via VINBERO_PLUGIN_AUX_CAST)
+	PluginAuxRaw  []byte              `protobuf:"bytes,18,opt,name=plugin_aux_raw,json=pluginAuxRaw,proto3" json:"plugin_aux_raw,omitempty"`                                 // Plugin-defined auxiliary payload (<= 200 bytes, cast by plugin via VINBERO_PLUGIN_AUX_CAST)
 	PluginAuxJson string              `protobuf:"bytes,19,opt,name=plugin_aux_json,json=pluginAuxJson,proto3" json:"plugin_aux_json,omitempty"`                              // Plugin-defined auxiliary payload as JSON; server encodes via plugin BTF. Mutually exclusive with plugin_aux_raw.
+	EcnPropagate  bool                `protobuf:"varint,20,opt,name=ecn_propagate,json=ecnPropagate,proto3" json:"ecn_propagate,omitempty"`                                  // Decap (End.DX*/DT*, USD): copy outer ECN-CE into the inner header, drop CE over Not-ECT (RFC 6040)
 }
 
 func (x *SidFunction) Reset() {
@@ -273,6 +433,13 @@ func (x *SidFunction) GetPluginAuxJson() string {
 	return ""
 }
 
+func (x *SidFunction) GetEcnPropagate() bool {
+	if x != nil {
+		return x.EcnPropagate
+	}
+	return false
+}
+
 type SidFunctionCreateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -375,6 +542,116 @@ func (x *SidFunctionCreateResponse) GetErrors() []*OperationError {
 	return nil
 }
 
+type SidFunctionUpdateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SidFunctions []*SidFunction         `protobuf:"bytes,1,rep,name=sid_functions,json=sidFunctions,proto3" json:"sid_functions,omitempty"` // Matched to installed entries by trigger_prefix, which can't change
+	UpdateMask   *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`       // Fields taken from each entry; empty replaces every field
+}
+
+func (x *SidFunctionUpdateRequest) Reset() {
+	*x = SidFunctionUpdateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SidFunctionUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SidFunctionUpdateRequest) ProtoMessage() {}
+
+func (x *SidFunctionUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SidFunctionUpdateRequest.ProtoReflect.Descriptor instead.
+func (*SidFunctionUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SidFunctionUpdateRequest) GetSidFunctions() []*SidFunction {
+	if x != nil {
+		return x.SidFunctions
+	}
+	return nil
+}
+
+func (x *SidFunctionUpdateRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type SidFunctionUpdateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Updated []*SidFunction    `protobuf:"bytes,1,rep,name=updated,proto3" json:"updated,omitempty"` // Entries as installed after the update
+	Errors  []*OperationError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *SidFunctionUpdateResponse) Reset() {
+	*x = SidFunctionUpdateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SidFunctionUpdateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SidFunctionUpdateResponse) ProtoMessage() {}
+
+func (x *SidFunctionUpdateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SidFunctionUpdateResponse.ProtoReflect.Descriptor instead.
+func (*SidFunctionUpdateResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SidFunctionUpdateResponse) GetUpdated() []*SidFunction {
+	if x != nil {
+		return x.Updated
+	}
+	return nil
+}
+
+func (x *SidFunctionUpdateResponse) GetErrors() []*OperationError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
 type SidFunctionDeleteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -386,7 +663,7 @@ type SidFunctionDeleteRequest struct {
 func (x *SidFunctionDeleteRequest) Reset() {
 	*x = SidFunctionDeleteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[3]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -399,7 +676,7 @@ func (x *SidFunctionDeleteRequest) String() string {
 func (*SidFunctionDeleteRequest) ProtoMessage() {}
 
 func (x *SidFunctionDeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[3]
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -412,7 +689,7 @@ func (x *SidFunctionDeleteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SidFunctionDeleteRequest.ProtoReflect.Descriptor instead.
 func (*SidFunctionDeleteRequest) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{3}
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *SidFunctionDeleteRequest) GetTriggerPrefixes() []string {
@@ -434,7 +711,7 @@ type SidFunctionDeleteResponse struct {
 func (x *SidFunctionDeleteResponse) Reset() {
 	*x = SidFunctionDeleteResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[4]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -447,7 +724,7 @@ func (x *SidFunctionDeleteResponse) String() string {
 func (*SidFunctionDeleteResponse) ProtoMessage() {}
 
 func (x *SidFunctionDeleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[4]
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -460,7 +737,7 @@ func (x *SidFunctionDeleteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SidFunctionDeleteResponse.ProtoReflect.Descriptor instead.
 func (*SidFunctionDeleteResponse) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{4}
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *SidFunctionDeleteResponse) GetDeletedTriggerPrefixes() []string {
@@ -486,7 +763,7 @@ type SidFunctionListRequest struct {
 func (x *SidFunctionListRequest) Reset() {
 	*x = SidFunctionListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[5]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -499,7 +776,7 @@ func (x *SidFunctionListRequest) String() string {
 func (*SidFunctionListRequest) ProtoMessage() {}
 
 func (x *SidFunctionListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[5]
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -512,7 +789,7 @@ func (x *SidFunctionListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SidFunctionListRequest.ProtoReflect.Descriptor instead.
 func (*SidFunctionListRequest) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{5}
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{7}
 }
 
 type SidFunctionListResponse struct {
@@ -526,7 +803,7 @@ type SidFunctionListResponse struct {
 func (x *SidFunctionListResponse) Reset() {
 	*x = SidFunctionListResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[6]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -539,7 +816,7 @@ func (x *SidFunctionListResponse) String() string {
 func (*SidFunctionListResponse) ProtoMessage() {}
 
 func (x *SidFunctionListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[6]
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -552,7 +829,7 @@ func (x *SidFunctionListResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SidFunctionListResponse.ProtoReflect.Descriptor instead.
 func (*SidFunctionListResponse) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{6}
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *SidFunctionListResponse) GetSidFunctions() []*SidFunction {
@@ -573,7 +850,7 @@ type SidFunctionGetRequest struct {
 func (x *SidFunctionGetRequest) Reset() {
 	*x = SidFunctionGetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[7]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -586,7 +863,7 @@ func (x *SidFunctionGetRequest) String() string {
 func (*SidFunctionGetRequest) ProtoMessage() {}
 
 func (x *SidFunctionGetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[7]
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -599,7 +876,7 @@ func (x *SidFunctionGetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SidFunctionGetRequest.ProtoReflect.Descriptor instead.
 func (*SidFunctionGetRequest) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{7}
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *SidFunctionGetRequest) GetTriggerPrefix() string {
@@ -620,7 +897,7 @@ type SidFunctionGetResponse struct {
 func (x *SidFunctionGetResponse) Reset() {
 	*x = SidFunctionGetResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[8]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -633,7 +910,7 @@ func (x *SidFunctionGetResponse) String() string {
 func (*SidFunctionGetResponse) ProtoMessage() {}
 
 func (x *SidFunctionGetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[8]
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -646,7 +923,7 @@ func (x *SidFunctionGetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SidFunctionGetResponse.ProtoReflect.Descriptor instead.
 func (*SidFunctionGetResponse) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{8}
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *SidFunctionGetResponse) GetSidFunction() *SidFunction {
@@ -665,7 +942,7 @@ type SidFunctionFlushRequest struct {
 func (x *SidFunctionFlushRequest) Reset() {
 	*x = SidFunctionFlushRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[9]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -678,7 +955,7 @@ func (x *SidFunctionFlushRequest) String() string {
 func (*SidFunctionFlushRequest) ProtoMessage() {}
 
 func (x *SidFunctionFlushRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[9]
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -691,7 +968,7 @@ func (x *SidFunctionFlushRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SidFunctionFlushRequest.ProtoReflect.Descriptor instead.
 func (*SidFunctionFlushRequest) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{9}
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{11}
 }
 
 type SidFunctionFlushResponse struct {
@@ -705,7 +982,7 @@ type SidFunctionFlushResponse struct {
 func (x *SidFunctionFlushResponse) Reset() {
 	*x = SidFunctionFlushResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[10]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -718,7 +995,7 @@ func (x *SidFunctionFlushResponse) String() string {
 func (*SidFunctionFlushResponse) ProtoMessage() {}
 
 func (x *SidFunctionFlushResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[10]
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -731,7 +1008,7 @@ func (x *SidFunctionFlushResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SidFunctionFlushResponse.ProtoReflect.Descriptor instead.
 func (*SidFunctionFlushResponse) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{10}
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *SidFunctionFlushResponse) GetDeletedCount() uint32 {
@@ -747,18 +1024,24 @@ type Headendv4 struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Mode          Srv6HeadendBehavior `protobuf:"varint,1,opt,name=mode,proto3,enum=vinbero.v1.Srv6HeadendBehavior" json:"mode,omitempty"`   // H.Insert or H.Encaps
-	TriggerPrefix string              `protobuf:"bytes,2,opt,name=trigger_prefix,json=triggerPrefix,proto3" json:"trigger_prefix,omitempty"` // IPv4 CIDR (e.g., "10.0.0.0/24")
-	SrcAddr       string              `protobuf:"bytes,3,opt,name=src_addr,json=srcAddr,proto3" json:"src_addr,omitempty"`                   // Outer IPv6 source address
-	DstAddr       string              `protobuf:"bytes,4,opt,name=dst_addr,json=dstAddr,proto3" json:"dst_addr,omitempty"`                   // Outer IPv6 destination (optional, usually derived from segments)
-	Segments      []string            `protobuf:"bytes,5,rep,name=segments,proto3" json:"segments,omitempty"`                                // SRv6 segment list
-	ArgsOffset    uint32              `protobuf:"varint,6,opt,name=args_offset,json=argsOffset,proto3" json:"args_offset,omitempty"`         // Args.Mob.Session byte offset in SID (RFC 9433, for H.M.GTP4.D)
+	Mode          Srv6HeadendBehavior    `protobuf:"varint,1,opt,name=mode,proto3,enum=vinbero.v1.Srv6HeadendBehavior" json:"mode,omitempty"`                                              // H.Insert or H.Encaps
+	TriggerPrefix string                 `protobuf:"bytes,2,opt,name=trigger_prefix,json=triggerPrefix,proto3" json:"trigger_prefix,omitempty"`                                            // IPv4 CIDR (e.g., "10.0.0.0/24")
+	SrcAddr       string                 `protobuf:"bytes,3,opt,name=src_addr,json=srcAddr,proto3" json:"src_addr,omitempty"`                                                              // Outer IPv6 source address
+	DstAddr       string                 `protobuf:"bytes,4,opt,name=dst_addr,json=dstAddr,proto3" json:"dst_addr,omitempty"`                                                              // Outer IPv6 destination (optional, usually derived from segments)
+	Segments      []string               `protobuf:"bytes,5,rep,name=segments,proto3" json:"segments,omitempty"`                                                                           // SRv6 segment list
+	ArgsOffset    uint32                 `protobuf:"varint,6,opt,name=args_offset,json=argsOffset,proto3" json:"args_offset,omitempty"`                                                    // Args.Mob.Session byte offset in SID (RFC 9433, for H.M.GTP4.D)
+	Mtu           uint32                 `protobuf:"varint,7,opt,name=mtu,proto3" json:"mtu,omitempty"`                                                                                    // Egress MTU for H.Encaps/H.Encaps.Red (0 = interface MTU only, else 1280-65535)
+	TcMode        Srv6EncapTcMode        `protobuf:"varint,8,opt,name=tc_mode,json=tcMode,proto3,enum=vinbero.v1.Srv6EncapTcMode" json:"tc_mode,omitempty"`                                // Outer Traffic Class: zero, copied from inner, or tc
+	Tc            uint32                 `protobuf:"varint,9,opt,name=tc,proto3" json:"tc,omitempty"`                                                                                      // Outer Traffic Class (DSCP << 2 | ECN) for SRV6_ENCAP_TC_MODE_SET (0-255)
+	HopLimitMode  Srv6EncapHopLimitMode  `protobuf:"varint,10,opt,name=hop_limit_mode,json=hopLimitMode,proto3,enum=vinbero.v1.Srv6EncapHopLimitMode" json:"hop_limit_mode,omitempty"`     // Outer Hop Limit: fixed or propagated from inner
+	HopLimit      uint32                 `protobuf:"varint,11,opt,name=hop_limit,json=hopLimit,proto3" json:"hop_limit,omitempty"`                                                         // Fixed outer Hop Limit (0 = 64, else 1-255)
+	FlowLabelMode Srv6EncapFlowLabelMode `protobuf:"varint,12,opt,name=flow_label_mode,json=flowLabelMode,proto3,enum=vinbero.v1.Srv6EncapFlowLabelMode" json:"flow_label_mode,omitempty"` // Outer Flow Label: zero or inner 5-tuple hash
 }
 
 func (x *Headendv4) Reset() {
 	*x = Headendv4{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[11]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -771,7 +1054,7 @@ func (x *Headendv4) String() string {
 func (*Headendv4) ProtoMessage() {}
 
 func (x *Headendv4) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[11]
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -784,7 +1067,7 @@ func (x *Headendv4) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Headendv4.ProtoReflect.Descriptor instead.
 func (*Headendv4) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{11}
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *Headendv4) GetMode() Srv6HeadendBehavior {
@@ -829,6 +1112,48 @@ func (x *Headendv4) GetArgsOffset() uint32 {
 	return 0
 }
 
+func (x *Headendv4) GetMtu() uint32 {
+	if x != nil {
+		return x.Mtu
+	}
+	return 0
+}
+
+func (x *Headendv4) GetTcMode() Srv6EncapTcMode {
+	if x != nil {
+		return x.TcMode
+	}
+	return Srv6EncapTcMode_SRV6_ENCAP_TC_MODE_UNSPECIFIED
+}
+
+func (x *Headendv4) GetTc() uint32 {
+	if x != nil {
+		return x.Tc
+	}
+	return 0
+}
+
+func (x *Headendv4) GetHopLimitMode() Srv6EncapHopLimitMode {
+	if x != nil {
+		return x.HopLimitMode
+	}
+	return Srv6EncapHopLimitMode_SRV6_ENCAP_HOP_LIMIT_MODE_UNSPECIFIED
+}
+
+func (x *Headendv4) GetHopLimit() uint32 {
+	if x != nil {
+		return x.HopLimit
+	}
+	return 0
+}
+
+func (x *Headendv4) GetFlowLabelMode() Srv6EncapFlowLabelMode {
+	if x != nil {
+		return x.FlowLabelMode
+	}
+	return Srv6EncapFlowLabelMode_SRV6_ENCAP_FLOW_LABEL_MODE_UNSPECIFIED
+}
+
 type Headendv4CreateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -840,7 +1165,7 @@ type Headendv4CreateRequest struct {
 func (x *Headendv4CreateRequest) Reset() {
 	*x = Headendv4CreateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[12]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -853,7 +1178,7 @@ func (x *Headendv4CreateRequest) String() string {
 func (*Headendv4CreateRequest) ProtoMessage() {}
 
 func (x *Headendv4CreateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[12]
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -866,7 +1191,7 @@ func (x *Headendv4CreateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Headendv4CreateRequest.ProtoReflect.Descriptor instead.
 func (*Headendv4CreateRequest) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{12}
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *Headendv4CreateRequest) GetHeadendv4S() []*Headendv4 {
@@ -888,7 +1213,7 @@ type Headendv4CreateResponse struct {
 func (x *Headendv4CreateResponse) Reset() {
 	*x = Headendv4CreateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[13]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -901,7 +1226,7 @@ func (x *Headendv4CreateResponse) String() string {
 func (*Headendv4CreateResponse) ProtoMessage() {}
 
 func (x *Headendv4CreateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[13]
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -914,7 +1239,7 @@ func (x *Headendv4CreateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Headendv4CreateResponse.ProtoReflect.Descriptor instead.
 func (*Headendv4CreateResponse) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{13}
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *Headendv4CreateResponse) GetCreated() []*Headendv4 {
@@ -931,6 +1256,116 @@ func (x *Headendv4CreateResponse) GetErrors() []*OperationError {
 	return nil
 }
 
+type Headendv4UpdateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Headendv4S []*Headendv4           `protobuf:"bytes,1,rep,name=headendv4s,proto3" json:"headendv4s,omitempty"`                   // Matched to installed entries by trigger_prefix, which can't change
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"` // Fields taken from each entry; empty replaces every field
+}
+
+func (x *Headendv4UpdateRequest) Reset() {
+	*x = Headendv4UpdateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Headendv4UpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Headendv4UpdateRequest) ProtoMessage() {}
+
+func (x *Headendv4UpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Headendv4UpdateRequest.ProtoReflect.Descriptor instead.
+func (*Headendv4UpdateRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Headendv4UpdateRequest) GetHeadendv4S() []*Headendv4 {
+	if x != nil {
+		return x.Headendv4S
+	}
+	return nil
+}
+
+func (x *Headendv4UpdateRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type Headendv4UpdateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Updated []*Headendv4      `protobuf:"bytes,1,rep,name=updated,proto3" json:"updated,omitempty"` // Entries as installed after the update
+	Errors  []*OperationError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *Headendv4UpdateResponse) Reset() {
+	*x = Headendv4UpdateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Headendv4UpdateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Headendv4UpdateResponse) ProtoMessage() {}
+
+func (x *Headendv4UpdateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Headendv4UpdateResponse.ProtoReflect.Descriptor instead.
+func (*Headendv4UpdateResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Headendv4UpdateResponse) GetUpdated() []*Headendv4 {
+	if x != nil {
+		return x.Updated
+	}
+	return nil
+}
+
+func (x *Headendv4UpdateResponse) GetErrors() []*OperationError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
 type Headendv4DeleteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -942,7 +1377,7 @@ type Headendv4DeleteRequest struct {
 func (x *Headendv4DeleteRequest) Reset() {
 	*x = Headendv4DeleteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[14]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -955,7 +1390,7 @@ func (x *Headendv4DeleteRequest) String() string {
 func (*Headendv4DeleteRequest) ProtoMessage() {}
 
 func (x *Headendv4DeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[14]
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -968,7 +1403,7 @@ func (x *Headendv4DeleteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Headendv4DeleteRequest.ProtoReflect.Descriptor instead.
 func (*Headendv4DeleteRequest) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{14}
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *Headendv4DeleteRequest) GetTriggerPrefixes() []string {
@@ -990,7 +1425,7 @@ type Headendv4DeleteResponse struct {
 func (x *Headendv4DeleteResponse) Reset() {
 	*x = Headendv4DeleteResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[15]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1003,7 +1438,7 @@ func (x *Headendv4DeleteResponse) String() string {
 func (*Headendv4DeleteResponse) ProtoMessage() {}
 
 func (x *Headendv4DeleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[15]
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1016,7 +1451,7 @@ func (x *Headendv4DeleteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Headendv4DeleteResponse.ProtoReflect.Descriptor instead.
 func (*Headendv4DeleteResponse) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{15}
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *Headendv4DeleteResponse) GetDeletedTriggerPrefixes() []string {
@@ -1042,7 +1477,7 @@ type Headendv4ListRequest struct {
 func (x *Headendv4ListRequest) Reset() {
 	*x = Headendv4ListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[16]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1055,7 +1490,7 @@ func (x *Headendv4ListRequest) String() string {
 func (*Headendv4ListRequest) ProtoMessage() {}
 
 func (x *Headendv4ListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[16]
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1068,7 +1503,7 @@ func (x *Headendv4ListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Headendv4ListRequest.ProtoReflect.Descriptor instead.
 func (*Headendv4ListRequest) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{16}
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{20}
 }
 
 type Headendv4ListResponse struct {
@@ -1082,7 +1517,7 @@ type Headendv4ListResponse struct {
 func (x *Headendv4ListResponse) Reset() {
 	*x = Headendv4ListResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[17]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1095,7 +1530,7 @@ func (x *Headendv4ListResponse) String() string {
 func (*Headendv4ListResponse) ProtoMessage() {}
 
 func (x *Headendv4ListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[17]
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1108,7 +1543,7 @@ func (x *Headendv4ListResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Headendv4ListResponse.ProtoReflect.Descriptor instead.
 func (*Headendv4ListResponse) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{17}
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *Headendv4ListResponse) GetHeadendv4S() []*Headendv4 {
@@ -1129,7 +1564,7 @@ type Headendv4GetRequest struct {
 func (x *Headendv4GetRequest) Reset() {
 	*x = Headendv4GetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[18]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1142,7 +1577,7 @@ func (x *Headendv4GetRequest) String() string {
 func (*Headendv4GetRequest) ProtoMessage() {}
 
 func (x *Headendv4GetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[18]
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1155,7 +1590,7 @@ func (x *Headendv4GetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Headendv4GetRequest.ProtoReflect.Descriptor instead.
 func (*Headendv4GetRequest) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{18}
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *Headendv4GetRequest) GetTriggerPrefix() string {
@@ -1176,7 +1611,7 @@ type Headendv4GetResponse struct {
 func (x *Headendv4GetResponse) Reset() {
 	*x = Headendv4GetResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[19]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1189,7 +1624,7 @@ func (x *Headendv4GetResponse) String() string {
 func (*Headendv4GetResponse) ProtoMessage() {}
 
 func (x *Headendv4GetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[19]
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1202,7 +1637,7 @@ func (x *Headendv4GetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Headendv4GetResponse.ProtoReflect.Descriptor instead.
 func (*Headendv4GetResponse) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{19}
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *Headendv4GetResponse) GetHeadendv4() *Headendv4 {
@@ -1221,7 +1656,7 @@ type Headendv4FlushRequest struct {
 func (x *Headendv4FlushRequest) Reset() {
 	*x = Headendv4FlushRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[20]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1234,7 +1669,7 @@ func (x *Headendv4FlushRequest) String() string {
 func (*Headendv4FlushRequest) ProtoMessage() {}
 
 func (x *Headendv4FlushRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[20]
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1247,7 +1682,7 @@ func (x *Headendv4FlushRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Headendv4FlushRequest.ProtoReflect.Descriptor instead.
 func (*Headendv4FlushRequest) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{20}
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{24}
 }
 
 type Headendv4FlushResponse struct {
@@ -1261,7 +1696,7 @@ type Headendv4FlushResponse struct {
 func (x *Headendv4FlushResponse) Reset() {
 	*x = Headendv4FlushResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[21]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1274,7 +1709,7 @@ func (x *Headendv4FlushResponse) String() string {
 func (*Headendv4FlushResponse) ProtoMessage() {}
 
 func (x *Headendv4FlushResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[21]
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1287,7 +1722,7 @@ func (x *Headendv4FlushResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Headendv4FlushResponse.ProtoReflect.Descriptor instead.
 func (*Headendv4FlushResponse) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{21}
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *Headendv4FlushResponse) GetDeletedCount() uint32 {
@@ -1303,17 +1738,23 @@ type Headendv6 struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Mode          Srv6HeadendBehavior `protobuf:"varint,1,opt,name=mode,proto3,enum=vinbero.v1.Srv6HeadendBehavior" json:"mode,omitempty"`
-	TriggerPrefix string              `protobuf:"bytes,2,opt,name=trigger_prefix,json=triggerPrefix,proto3" json:"trigger_prefix,omitempty"` // IPv6 CIDR (e.g., "2001:db8::/32")
-	SrcAddr       string              `protobuf:"bytes,3,opt,name=src_addr,json=srcAddr,proto3" json:"src_addr,omitempty"`
-	DstAddr       string              `protobuf:"bytes,4,opt,name=dst_addr,json=dstAddr,proto3" json:"dst_addr,omitempty"`
-	Segments      []string            `protobuf:"bytes,5,rep,name=segments,proto3" json:"segments,omitempty"`
+	Mode          Srv6HeadendBehavior    `protobuf:"varint,1,opt,name=mode,proto3,enum=vinbero.v1.Srv6HeadendBehavior" json:"mode,omitempty"`
+	TriggerPrefix string                 `protobuf:"bytes,2,opt,name=trigger_prefix,json=triggerPrefix,proto3" json:"trigger_prefix,omitempty"` // IPv6 CIDR (e.g., "2001:db8::/32")
+	SrcAddr       string                 `protobuf:"bytes,3,opt,name=src_addr,json=srcAddr,proto3" json:"src_addr,omitempty"`
+	DstAddr       string                 `protobuf:"bytes,4,opt,name=dst_addr,json=dstAddr,proto3" json:"dst_addr,omitempty"`
+	Segments      []string               `protobuf:"bytes,5,rep,name=segments,proto3" json:"segments,omitempty"`
+	Mtu           uint32                 `protobuf:"varint,6,opt,name=mtu,proto3" json:"mtu,omitempty"`                                                                                    // Egress MTU for H.Encaps/H.Encaps.Red (0 = interface MTU only, else 1280-65535)
+	TcMode        Srv6EncapTcMode        `protobuf:"varint,7,opt,name=tc_mode,json=tcMode,proto3,enum=vinbero.v1.Srv6EncapTcMode" json:"tc_mode,omitempty"`                                // Outer Traffic Class: zero, copied from inner, or tc
+	Tc            uint32                 `protobuf:"varint,8,opt,name=tc,proto3" json:"tc,omitempty"`                                                                                      // Outer Traffic Class (DSCP << 2 | ECN) for SRV6_ENCAP_TC_MODE_SET (0-255)
+	HopLimitMode  Srv6EncapHopLimitMode  `protobuf:"varint,9,opt,name=hop_limit_mode,json=hopLimitMode,proto3,enum=vinbero.v1.Srv6EncapHopLimitMode" json:"hop_limit_mode,omitempty"`      // Outer Hop Limit: fixed or propagated from inner
+	HopLimit      uint32                 `protobuf:"varint,10,opt,name=hop_limit,json=hopLimit,proto3" json:"hop_limit,omitempty"`                                                         // Fixed outer Hop Limit (0 = 64, else 1-255)
+	FlowLabelMode Srv6EncapFlowLabelMode `protobuf:"varint,11,opt,name=flow_label_mode,json=flowLabelMode,proto3,enum=vinbero.v1.Srv6EncapFlowLabelMode" json:"flow_label_mode,omitempty"` // Outer Flow Label: zero or inner 5-tuple hash
 }
 
 func (x *Headendv6) Reset() {
 	*x = Headendv6{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[22]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1326,7 +1767,7 @@ func (x *Headendv6) String() string {
 func (*Headendv6) ProtoMessage() {}
 
 func (x *Headendv6) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[22]
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1339,7 +1780,7 @@ func (x *Headendv6) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Headendv6.ProtoReflect.Descriptor instead.
 func (*Headendv6) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{22}
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *Headendv6) GetMode() Srv6HeadendBehavior {
@@ -1377,6 +1818,48 @@ func (x *Headendv6) GetSegments() []string {
 	return nil
 }
 
+func (x *Headendv6) GetMtu() uint32 {
+	if x != nil {
+		return x.Mtu
+	}
+	return 0
+}
+
+func (x *Headendv6) GetTcMode() Srv6EncapTcMode {
+	if x != nil {
+		return x.TcMode
+	}
+	return Srv6EncapTcMode_SRV6_ENCAP_TC_MODE_UNSPECIFIED
+}
+
+func (x *Headendv6) GetTc() uint32 {
+	if x != nil {
+		return x.Tc
+	}
+	return 0
+}
+
+func (x *Headendv6) GetHopLimitMode() Srv6EncapHopLimitMode {
+	if x != nil {
+		return x.HopLimitMode
+	}
+	return Srv6EncapHopLimitMode_SRV6_ENCAP_HOP_LIMIT_MODE_UNSPECIFIED
+}
+
+func (x *Headendv6) GetHopLimit() uint32 {
+	if x != nil {
+		return x.HopLimit
+	}
+	return 0
+}
+
+func (x *Headendv6) GetFlowLabelMode() Srv6EncapFlowLabelMode {
+	if x != nil {
+		return x.FlowLabelMode
+	}
+	return Srv6EncapFlowLabelMode_SRV6_ENCAP_FLOW_LABEL_MODE_UNSPECIFIED
+}
+
 type Headendv6CreateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1388,7 +1871,7 @@ type Headendv6CreateRequest struct {
 func (x *Headendv6CreateRequest) Reset() {
 	*x = Headendv6CreateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[23]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1401,7 +1884,7 @@ func (x *Headendv6CreateRequest) String() string {
 func (*Headendv6CreateRequest) ProtoMessage() {}
 
 func (x *Headendv6CreateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[23]
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1414,7 +1897,7 @@ func (x *Headendv6CreateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Headendv6CreateRequest.ProtoReflect.Descriptor instead.
 func (*Headendv6CreateRequest) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{23}
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *Headendv6CreateRequest) GetHeadendv6S() []*Headendv6 {
@@ -1436,7 +1919,7 @@ type Headendv6CreateResponse struct {
 func (x *Headendv6CreateResponse) Reset() {
 	*x = Headendv6CreateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[24]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1449,7 +1932,7 @@ func (x *Headendv6CreateResponse) String() string {
 func (*Headendv6CreateResponse) ProtoMessage() {}
 
 func (x *Headendv6CreateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[24]
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1462,7 +1945,7 @@ func (x *Headendv6CreateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Headendv6CreateResponse.ProtoReflect.Descriptor instead.
 func (*Headendv6CreateResponse) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{24}
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *Headendv6CreateResponse) GetCreated() []*Headendv6 {
@@ -1479,31 +1962,32 @@ func (x *Headendv6CreateResponse) GetErrors() []*OperationError {
 	return nil
 }
 
-type Headendv6DeleteRequest struct {
+type Headendv6UpdateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TriggerPrefixes []string `protobuf:"bytes,1,rep,name=trigger_prefixes,json=triggerPrefixes,proto3" json:"trigger_prefixes,omitempty"`
+	Headendv6S []*Headendv6           `protobuf:"bytes,1,rep,name=headendv6s,proto3" json:"headendv6s,omitempty"`                   // Matched to installed entries by trigger_prefix, which can't change
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"` // Fields taken from each entry; empty replaces every field
 }
 
-func (x *Headendv6DeleteRequest) Reset() {
-	*x = Headendv6DeleteRequest{}
+func (x *Headendv6UpdateRequest) Reset() {
+	*x = Headendv6UpdateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[25]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Headendv6DeleteRequest) String() string {
+func (x *Headendv6UpdateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Headendv6DeleteRequest) ProtoMessage() {}
+func (*Headendv6UpdateRequest) ProtoMessage() {}
 
-func (x *Headendv6DeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[25]
+func (x *Headendv6UpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1514,44 +1998,51 @@ func (x *Headendv6DeleteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Headendv6DeleteRequest.ProtoReflect.Descriptor instead.
-func (*Headendv6DeleteRequest) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use Headendv6UpdateRequest.ProtoReflect.Descriptor instead.
+func (*Headendv6UpdateRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *Headendv6DeleteRequest) GetTriggerPrefixes() []string {
+func (x *Headendv6UpdateRequest) GetHeadendv6S() []*Headendv6 {
 	if x != nil {
-		return x.TriggerPrefixes
+		return x.Headendv6S
 	}
 	return nil
 }
 
-type Headendv6DeleteResponse struct {
+func (x *Headendv6UpdateRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type Headendv6UpdateResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DeletedTriggerPrefixes []string          `protobuf:"bytes,1,rep,name=deleted_trigger_prefixes,json=deletedTriggerPrefixes,proto3" json:"deleted_trigger_prefixes,omitempty"`
-	Errors                 []*OperationError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	Updated []*Headendv6      `protobuf:"bytes,1,rep,name=updated,proto3" json:"updated,omitempty"` // Entries as installed after the update
+	Errors  []*OperationError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
 }
 
-func (x *Headendv6DeleteResponse) Reset() {
-	*x = Headendv6DeleteResponse{}
+func (x *Headendv6UpdateResponse) Reset() {
+	*x = Headendv6UpdateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[26]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Headendv6DeleteResponse) String() string {
+func (x *Headendv6UpdateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Headendv6DeleteResponse) ProtoMessage() {}
+func (*Headendv6UpdateResponse) ProtoMessage() {}
 
-func (x *Headendv6DeleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[26]
+func (x *Headendv6UpdateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1562,48 +2053,50 @@ func (x *Headendv6DeleteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Headendv6DeleteResponse.ProtoReflect.Descriptor instead.
-func (*Headendv6DeleteResponse) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use Headendv6UpdateResponse.ProtoReflect.Descriptor instead.
+func (*Headendv6UpdateResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *Headendv6DeleteResponse) GetDeletedTriggerPrefixes() []string {
+func (x *Headendv6UpdateResponse) GetUpdated() []*Headendv6 {
 	if x != nil {
-		return x.DeletedTriggerPrefixes
+		return x.Updated
 	}
 	return nil
 }
 
-func (x *Headendv6DeleteResponse) GetErrors() []*OperationError {
+func (x *Headendv6UpdateResponse) GetErrors() []*OperationError {
 	if x != nil {
 		return x.Errors
 	}
 	return nil
 }
 
-type Headendv6ListRequest struct {
+type Headendv6DeleteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	TriggerPrefixes []string `protobuf:"bytes,1,rep,name=trigger_prefixes,json=triggerPrefixes,proto3" json:"trigger_prefixes,omitempty"`
 }
 
-func (x *Headendv6ListRequest) Reset() {
-	*x = Headendv6ListRequest{}
+func (x *Headendv6DeleteRequest) Reset() {
+	*x = Headendv6DeleteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[27]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Headendv6ListRequest) String() string {
+func (x *Headendv6DeleteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Headendv6ListRequest) ProtoMessage() {}
+func (*Headendv6DeleteRequest) ProtoMessage() {}
 
-func (x *Headendv6ListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[27]
+func (x *Headendv6DeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1614,11 +2107,111 @@ func (x *Headendv6ListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Headendv6ListRequest.ProtoReflect.Descriptor instead.
-func (*Headendv6ListRequest) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{27}
-}
-
+// Deprecated: Use Headendv6DeleteRequest.ProtoReflect.Descriptor instead.
+func (*Headendv6DeleteRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *Headendv6DeleteRequest) GetTriggerPrefixes() []string {
+	if x != nil {
+		return x.TriggerPrefixes
+	}
+	return nil
+}
+
+type Headendv6DeleteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeletedTriggerPrefixes []string          `protobuf:"bytes,1,rep,name=deleted_trigger_prefixes,json=deletedTriggerPrefixes,proto3" json:"deleted_trigger_prefixes,omitempty"`
+	Errors                 []*OperationError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *Headendv6DeleteResponse) Reset() {
+	*x = Headendv6DeleteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Headendv6DeleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Headendv6DeleteResponse) ProtoMessage() {}
+
+func (x *Headendv6DeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Headendv6DeleteResponse.ProtoReflect.Descriptor instead.
+func (*Headendv6DeleteResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Headendv6DeleteResponse) GetDeletedTriggerPrefixes() []string {
+	if x != nil {
+		return x.DeletedTriggerPrefixes
+	}
+	return nil
+}
+
+func (x *Headendv6DeleteResponse) GetErrors() []*OperationError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type Headendv6ListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Headendv6ListRequest) Reset() {
+	*x = Headendv6ListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Headendv6ListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Headendv6ListRequest) ProtoMessage() {}
+
+func (x *Headendv6ListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Headendv6ListRequest.ProtoReflect.Descriptor instead.
+func (*Headendv6ListRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{33}
+}
+
 type Headendv6ListResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1630,7 +2223,7 @@ type Headendv6ListResponse struct {
 func (x *Headendv6ListResponse) Reset() {
 	*x = Headendv6ListResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[28]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1643,7 +2236,7 @@ func (x *Headendv6ListResponse) String() string {
 func (*Headendv6ListResponse) ProtoMessage() {}
 
 func (x *Headendv6ListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[28]
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1656,7 +2249,7 @@ func (x *Headendv6ListResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Headendv6ListResponse.ProtoReflect.Descriptor instead.
 func (*Headendv6ListResponse) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{28}
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *Headendv6ListResponse) GetHeadendv6S() []*Headendv6 {
@@ -1677,7 +2270,7 @@ type Headendv6GetRequest struct {
 func (x *Headendv6GetRequest) Reset() {
 	*x = Headendv6GetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[29]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1690,7 +2283,7 @@ func (x *Headendv6GetRequest) String() string {
 func (*Headendv6GetRequest) ProtoMessage() {}
 
 func (x *Headendv6GetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[29]
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1703,7 +2296,7 @@ func (x *Headendv6GetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Headendv6GetRequest.ProtoReflect.Descriptor instead.
 func (*Headendv6GetRequest) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{29}
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *Headendv6GetRequest) GetTriggerPrefix() string {
@@ -1724,7 +2317,7 @@ type Headendv6GetResponse struct {
 func (x *Headendv6GetResponse) Reset() {
 	*x = Headendv6GetResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[30]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1737,7 +2330,7 @@ func (x *Headendv6GetResponse) String() string {
 func (*Headendv6GetResponse) ProtoMessage() {}
 
 func (x *Headendv6GetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[30]
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1750,7 +2343,7 @@ func (x *Headendv6GetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Headendv6GetResponse.ProtoReflect.Descriptor instead.
 func (*Headendv6GetResponse) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{30}
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *Headendv6GetResponse) GetHeadendv6() *Headendv6 {
@@ -1769,7 +2362,7 @@ type Headendv6FlushRequest struct {
 func (x *Headendv6FlushRequest) Reset() {
 	*x = Headendv6FlushRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[31]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1782,7 +2375,7 @@ func (x *Headendv6FlushRequest) String() string {
 func (*Headendv6FlushRequest) ProtoMessage() {}
 
 func (x *Headendv6FlushRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[31]
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1795,7 +2388,7 @@ func (x *Headendv6FlushRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Headendv6FlushRequest.ProtoReflect.Descriptor instead.
 func (*Headendv6FlushRequest) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{31}
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{37}
 }
 
 type Headendv6FlushResponse struct {
@@ -1809,7 +2402,7 @@ type Headendv6FlushResponse struct {
 func (x *Headendv6FlushResponse) Reset() {
 	*x = Headendv6FlushResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[32]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1822,7 +2415,7 @@ func (x *Headendv6FlushResponse) String() string {
 func (*Headendv6FlushResponse) ProtoMessage() {}
 
 func (x *Headendv6FlushResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[32]
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1835,7 +2428,7 @@ func (x *Headendv6FlushResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Headendv6FlushResponse.ProtoReflect.Descriptor instead.
 func (*Headendv6FlushResponse) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{32}
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *Headendv6FlushResponse) GetDeletedCount() uint32 {
@@ -1851,20 +2444,25 @@ type FdbEntry struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BdId     uint32 `protobuf:"varint,1,opt,name=bd_id,json=bdId,proto3" json:"bd_id,omitempty"`             // Bridge Domain ID that scopes this MAC entry
-	Mac      string `protobuf:"bytes,2,opt,name=mac,proto3" json:"mac,omitempty"`                            // MAC address (e.g., "aa:bb:cc:dd:ee:ff")
-	Oif      uint32 `protobuf:"varint,3,opt,name=oif,proto3" json:"oif,omitempty"`                           // Output interface index (for local entries)
-	IsUntag  bool   `protobuf:"varint,4,opt,name=is_untag,json=isUntag,proto3" json:"is_untag,omitempty"`    // Reserved for future VLAN tag stripping on egress
-	IsRemote bool   `protobuf:"varint,5,opt,name=is_remote,json=isRemote,proto3" json:"is_remote,omitempty"` // true if learned via SRv6 End.DT2 (remote PE)
-	IsStatic bool   `protobuf:"varint,6,opt,name=is_static,json=isStatic,proto3" json:"is_static,omitempty"` // true if user-configured (never aged out)
-	LastSeen uint64 `protobuf:"varint,7,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"` // bpf_ktime_get_ns() timestamp (0 for static entries)
-	Esi      string `protobuf:"bytes,8,opt,name=esi,proto3" json:"esi,omitempty"`                            // Remote only: RFC 7432 ESI (e.g., "00:11:22:33:44:55:66:77:88:99"), empty=single-homing
+	BdId        uint32       `protobuf:"varint,1,opt,name=bd_id,json=bdId,proto3" json:"bd_id,omitempty"`                         // Bridge Domain ID that scopes this MAC entry
+	Mac         string       `protobuf:"bytes,2,opt,name=mac,proto3" json:"mac,omitempty"`                                        // MAC address (e.g., "aa:bb:cc:dd:ee:ff")
+	Oif         uint32       `protobuf:"varint,3,opt,name=oif,proto3" json:"oif,omitempty"`                                       // Output interface index (for local entries)
+	IsUntag     bool         `protobuf:"varint,4,opt,name=is_untag,json=isUntag,proto3" json:"is_untag,omitempty"`                // true when vlan_rewrite pops the outer tag
+	IsRemote    bool         `protobuf:"varint,5,opt,name=is_remote,json=isRemote,proto3" json:"is_remote,omitempty"`             // true if learned via SRv6 End.DT2 (remote PE)
+	IsStatic    bool         `protobuf:"varint,6,opt,name=is_static,json=isStatic,proto3" json:"is_static,omitempty"`             // true if user-configured (never aged out)
+	LastSeen    uint64       `protobuf:"varint,7,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`             // bpf_ktime_get_ns() timestamp (0 for static entries)
+	Esi         string       `protobuf:"bytes,8,opt,name=esi,proto3" json:"esi,omitempty"`                                        // Remote only: RFC 7432 ESI (e.g., "00:11:22:33:44:55:66:77:88:99"), empty=single-homing
+	VlanId      uint32       `protobuf:"varint,9,opt,name=vlan_id,json=vlanId,proto3" json:"vlan_id,omitempty"`                   // Local only: VLAN of the AC the MAC is behind
+	InnerVlanId uint32       `protobuf:"varint,10,opt,name=inner_vlan_id,json=innerVlanId,proto3" json:"inner_vlan_id,omitempty"` // Local only: inner VLAN of that AC
+	VlanRewrite *VlanRewrite `protobuf:"bytes,11,opt,name=vlan_rewrite,json=vlanRewrite,proto3" json:"vlan_rewrite,omitempty"`    // Local only: egress rewrite for this MAC, overriding the AC's
+	PortMode    bool         `protobuf:"varint,12,opt,name=port_mode,json=portMode,proto3" json:"port_mode,omitempty"`            // Local only: the MAC is behind the port-mode AC of oif
+	Vtep        string       `protobuf:"bytes,13,opt,name=vtep,proto3" json:"vtep,omitempty"`                                     // Remote only: VXLAN VTEP the MAC was learned behind (VXLAN gateway); empty for SRv6 peers
 }
 
 func (x *FdbEntry) Reset() {
 	*x = FdbEntry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[33]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1877,7 +2475,7 @@ func (x *FdbEntry) String() string {
 func (*FdbEntry) ProtoMessage() {}
 
 func (x *FdbEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[33]
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1890,7 +2488,7 @@ func (x *FdbEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FdbEntry.ProtoReflect.Descriptor instead.
 func (*FdbEntry) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{33}
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *FdbEntry) GetBdId() uint32 {
@@ -1949,6 +2547,41 @@ func (x *FdbEntry) GetEsi() string {
 	return ""
 }
 
+func (x *FdbEntry) GetVlanId() uint32 {
+	if x != nil {
+		return x.VlanId
+	}
+	return 0
+}
+
+func (x *FdbEntry) GetInnerVlanId() uint32 {
+	if x != nil {
+		return x.InnerVlanId
+	}
+	return 0
+}
+
+func (x *FdbEntry) GetVlanRewrite() *VlanRewrite {
+	if x != nil {
+		return x.VlanRewrite
+	}
+	return nil
+}
+
+func (x *FdbEntry) GetPortMode() bool {
+	if x != nil {
+		return x.PortMode
+	}
+	return false
+}
+
+func (x *FdbEntry) GetVtep() string {
+	if x != nil {
+		return x.Vtep
+	}
+	return ""
+}
+
 type FdbListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1958,7 +2591,7 @@ type FdbListRequest struct {
 func (x *FdbListRequest) Reset() {
 	*x = FdbListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[34]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1971,7 +2604,7 @@ func (x *FdbListRequest) String() string {
 func (*FdbListRequest) ProtoMessage() {}
 
 func (x *FdbListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[34]
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1984,7 +2617,7 @@ func (x *FdbListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FdbListRequest.ProtoReflect.Descriptor instead.
 func (*FdbListRequest) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{34}
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{40}
 }
 
 type FdbListResponse struct {
@@ -1998,7 +2631,7 @@ type FdbListResponse struct {
 func (x *FdbListResponse) Reset() {
 	*x = FdbListResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[35]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2011,7 +2644,7 @@ func (x *FdbListResponse) String() string {
 func (*FdbListResponse) ProtoMessage() {}
 
 func (x *FdbListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[35]
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2024,7 +2657,7 @@ func (x *FdbListResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FdbListResponse.ProtoReflect.Descriptor instead.
 func (*FdbListResponse) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{35}
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *FdbListResponse) GetEntries() []*FdbEntry {
@@ -2039,15 +2672,19 @@ type FdbCreateRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BdId uint32 `protobuf:"varint,1,opt,name=bd_id,json=bdId,proto3" json:"bd_id,omitempty"`
-	Mac  string `protobuf:"bytes,2,opt,name=mac,proto3" json:"mac,omitempty"`  // MAC address (e.g., "aa:bb:cc:dd:ee:ff")
-	Oif  uint32 `protobuf:"varint,3,opt,name=oif,proto3" json:"oif,omitempty"` // Output interface index
+	BdId        uint32       `protobuf:"varint,1,opt,name=bd_id,json=bdId,proto3" json:"bd_id,omitempty"`
+	Mac         string       `protobuf:"bytes,2,opt,name=mac,proto3" json:"mac,omitempty"`                                       // MAC address (e.g., "aa:bb:cc:dd:ee:ff")
+	Oif         uint32       `protobuf:"varint,3,opt,name=oif,proto3" json:"oif,omitempty"`                                      // Output interface index
+	VlanId      uint32       `protobuf:"varint,4,opt,name=vlan_id,json=vlanId,proto3" json:"vlan_id,omitempty"`                  // VLAN of the AC on oif; its egress rewrite applies unless vlan_rewrite is set
+	InnerVlanId uint32       `protobuf:"varint,5,opt,name=inner_vlan_id,json=innerVlanId,proto3" json:"inner_vlan_id,omitempty"` // Inner VLAN of that AC
+	VlanRewrite *VlanRewrite `protobuf:"bytes,6,opt,name=vlan_rewrite,json=vlanRewrite,proto3" json:"vlan_rewrite,omitempty"`    // Egress rewrite for this MAC only (unset = the AC's)
+	PortMode    bool         `protobuf:"varint,7,opt,name=port_mode,json=portMode,proto3" json:"port_mode,omitempty"`            // The AC on oif is its port-mode AC (vlan_id and inner_vlan_id must be 0)
 }
 
 func (x *FdbCreateRequest) Reset() {
 	*x = FdbCreateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[36]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2060,7 +2697,7 @@ func (x *FdbCreateRequest) String() string {
 func (*FdbCreateRequest) ProtoMessage() {}
 
 func (x *FdbCreateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[36]
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2073,7 +2710,7 @@ func (x *FdbCreateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FdbCreateRequest.ProtoReflect.Descriptor instead.
 func (*FdbCreateRequest) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{36}
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *FdbCreateRequest) GetBdId() uint32 {
@@ -2097,6 +2734,34 @@ func (x *FdbCreateRequest) GetOif() uint32 {
 	return 0
 }
 
+func (x *FdbCreateRequest) GetVlanId() uint32 {
+	if x != nil {
+		return x.VlanId
+	}
+	return 0
+}
+
+func (x *FdbCreateRequest) GetInnerVlanId() uint32 {
+	if x != nil {
+		return x.InnerVlanId
+	}
+	return 0
+}
+
+func (x *FdbCreateRequest) GetVlanRewrite() *VlanRewrite {
+	if x != nil {
+		return x.VlanRewrite
+	}
+	return nil
+}
+
+func (x *FdbCreateRequest) GetPortMode() bool {
+	if x != nil {
+		return x.PortMode
+	}
+	return false
+}
+
 type FdbCreateResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2106,7 +2771,7 @@ type FdbCreateResponse struct {
 func (x *FdbCreateResponse) Reset() {
 	*x = FdbCreateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[37]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2119,7 +2784,7 @@ func (x *FdbCreateResponse) String() string {
 func (*FdbCreateResponse) ProtoMessage() {}
 
 func (x *FdbCreateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[37]
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2132,7 +2797,7 @@ func (x *FdbCreateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FdbCreateResponse.ProtoReflect.Descriptor instead.
 func (*FdbCreateResponse) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{37}
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{43}
 }
 
 type FdbDeleteRequest struct {
@@ -2147,7 +2812,7 @@ type FdbDeleteRequest struct {
 func (x *FdbDeleteRequest) Reset() {
 	*x = FdbDeleteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[38]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2160,7 +2825,7 @@ func (x *FdbDeleteRequest) String() string {
 func (*FdbDeleteRequest) ProtoMessage() {}
 
 func (x *FdbDeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[38]
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2173,7 +2838,7 @@ func (x *FdbDeleteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FdbDeleteRequest.ProtoReflect.Descriptor instead.
 func (*FdbDeleteRequest) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{38}
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *FdbDeleteRequest) GetBdId() uint32 {
@@ -2199,7 +2864,7 @@ type FdbDeleteResponse struct {
 func (x *FdbDeleteResponse) Reset() {
 	*x = FdbDeleteResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[39]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2212,7 +2877,7 @@ func (x *FdbDeleteResponse) String() string {
 func (*FdbDeleteResponse) ProtoMessage() {}
 
 func (x *FdbDeleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[39]
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2225,7 +2890,7 @@ func (x *FdbDeleteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FdbDeleteResponse.ProtoReflect.Descriptor instead.
 func (*FdbDeleteResponse) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{39}
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{45}
 }
 
 type FdbFlushRequest struct {
@@ -2240,7 +2905,7 @@ type FdbFlushRequest struct {
 func (x *FdbFlushRequest) Reset() {
 	*x = FdbFlushRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[40]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2253,7 +2918,7 @@ func (x *FdbFlushRequest) String() string {
 func (*FdbFlushRequest) ProtoMessage() {}
 
 func (x *FdbFlushRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[40]
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2266,7 +2931,7 @@ func (x *FdbFlushRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FdbFlushRequest.ProtoReflect.Descriptor instead.
 func (*FdbFlushRequest) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{40}
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *FdbFlushRequest) GetBdId() uint32 {
@@ -2294,7 +2959,7 @@ type FdbFlushResponse struct {
 func (x *FdbFlushResponse) Reset() {
 	*x = FdbFlushResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[41]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2307,7 +2972,7 @@ func (x *FdbFlushResponse) String() string {
 func (*FdbFlushResponse) ProtoMessage() {}
 
 func (x *FdbFlushResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[41]
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2320,7 +2985,7 @@ func (x *FdbFlushResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FdbFlushResponse.ProtoReflect.Descriptor instead.
 func (*FdbFlushResponse) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{41}
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *FdbFlushResponse) GetDeletedCount() uint32 {
@@ -2335,15 +3000,16 @@ type VlanTableEntry struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TableId       uint32 `protobuf:"varint,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`                  // VLAN table scope (shared across SIDs)
-	VlanId        uint32 `protobuf:"varint,2,opt,name=vlan_id,json=vlanId,proto3" json:"vlan_id,omitempty"`                     // VLAN ID (0-4095)
-	InterfaceName string `protobuf:"bytes,3,opt,name=interface_name,json=interfaceName,proto3" json:"interface_name,omitempty"` // Output interface name (resolved to ifindex by server)
+	TableId       uint32       `protobuf:"varint,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`                  // VLAN table scope (shared across SIDs)
+	VlanId        uint32       `protobuf:"varint,2,opt,name=vlan_id,json=vlanId,proto3" json:"vlan_id,omitempty"`                     // VLAN ID (0-4095)
+	InterfaceName string       `protobuf:"bytes,3,opt,name=interface_name,json=interfaceName,proto3" json:"interface_name,omitempty"` // Output interface name (resolved to ifindex by server)
+	VlanRewrite   *VlanRewrite `protobuf:"bytes,4,opt,name=vlan_rewrite,json=vlanRewrite,proto3" json:"vlan_rewrite,omitempty"`       // Rewrite of the decapsulated frame before it leaves (e.g. translate to the local VLAN)
 }
 
 func (x *VlanTableEntry) Reset() {
 	*x = VlanTableEntry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[42]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2356,7 +3022,7 @@ func (x *VlanTableEntry) String() string {
 func (*VlanTableEntry) ProtoMessage() {}
 
 func (x *VlanTableEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[42]
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2369,7 +3035,7 @@ func (x *VlanTableEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VlanTableEntry.ProtoReflect.Descriptor instead.
 func (*VlanTableEntry) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{42}
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *VlanTableEntry) GetTableId() uint32 {
@@ -2393,6 +3059,13 @@ func (x *VlanTableEntry) GetInterfaceName() string {
 	return ""
 }
 
+func (x *VlanTableEntry) GetVlanRewrite() *VlanRewrite {
+	if x != nil {
+		return x.VlanRewrite
+	}
+	return nil
+}
+
 type VlanTableCreateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2404,7 +3077,7 @@ type VlanTableCreateRequest struct {
 func (x *VlanTableCreateRequest) Reset() {
 	*x = VlanTableCreateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[43]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2417,7 +3090,7 @@ func (x *VlanTableCreateRequest) String() string {
 func (*VlanTableCreateRequest) ProtoMessage() {}
 
 func (x *VlanTableCreateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[43]
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2430,7 +3103,7 @@ func (x *VlanTableCreateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VlanTableCreateRequest.ProtoReflect.Descriptor instead.
 func (*VlanTableCreateRequest) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{43}
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *VlanTableCreateRequest) GetEntries() []*VlanTableEntry {
@@ -2452,7 +3125,7 @@ type VlanTableCreateResponse struct {
 func (x *VlanTableCreateResponse) Reset() {
 	*x = VlanTableCreateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[44]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2465,7 +3138,7 @@ func (x *VlanTableCreateResponse) String() string {
 func (*VlanTableCreateResponse) ProtoMessage() {}
 
 func (x *VlanTableCreateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[44]
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2478,7 +3151,7 @@ func (x *VlanTableCreateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VlanTableCreateResponse.ProtoReflect.Descriptor instead.
 func (*VlanTableCreateResponse) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{44}
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *VlanTableCreateResponse) GetCreated() []*VlanTableEntry {
@@ -2495,6 +3168,116 @@ func (x *VlanTableCreateResponse) GetErrors() []*OperationError {
 	return nil
 }
 
+type VlanTableUpdateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries    []*VlanTableEntry      `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`                         // Matched to installed entries by (table_id, vlan_id), which can't change
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"` // Fields taken from each entry; empty replaces every field
+}
+
+func (x *VlanTableUpdateRequest) Reset() {
+	*x = VlanTableUpdateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VlanTableUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VlanTableUpdateRequest) ProtoMessage() {}
+
+func (x *VlanTableUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VlanTableUpdateRequest.ProtoReflect.Descriptor instead.
+func (*VlanTableUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *VlanTableUpdateRequest) GetEntries() []*VlanTableEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *VlanTableUpdateRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type VlanTableUpdateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Updated []*VlanTableEntry `protobuf:"bytes,1,rep,name=updated,proto3" json:"updated,omitempty"` // Entries as installed after the update
+	Errors  []*OperationError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *VlanTableUpdateResponse) Reset() {
+	*x = VlanTableUpdateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VlanTableUpdateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VlanTableUpdateResponse) ProtoMessage() {}
+
+func (x *VlanTableUpdateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VlanTableUpdateResponse.ProtoReflect.Descriptor instead.
+func (*VlanTableUpdateResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *VlanTableUpdateResponse) GetUpdated() []*VlanTableEntry {
+	if x != nil {
+		return x.Updated
+	}
+	return nil
+}
+
+func (x *VlanTableUpdateResponse) GetErrors() []*OperationError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
 type VlanTableDeleteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2506,7 +3289,7 @@ type VlanTableDeleteRequest struct {
 func (x *VlanTableDeleteRequest) Reset() {
 	*x = VlanTableDeleteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[45]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2519,7 +3302,7 @@ func (x *VlanTableDeleteRequest) String() string {
 func (*VlanTableDeleteRequest) ProtoMessage() {}
 
 func (x *VlanTableDeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[45]
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2532,7 +3315,7 @@ func (x *VlanTableDeleteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VlanTableDeleteRequest.ProtoReflect.Descriptor instead.
 func (*VlanTableDeleteRequest) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{45}
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *VlanTableDeleteRequest) GetEntries() []*VlanTableEntry {
@@ -2554,7 +3337,7 @@ type VlanTableDeleteResponse struct {
 func (x *VlanTableDeleteResponse) Reset() {
 	*x = VlanTableDeleteResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[46]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2567,7 +3350,7 @@ func (x *VlanTableDeleteResponse) String() string {
 func (*VlanTableDeleteResponse) ProtoMessage() {}
 
 func (x *VlanTableDeleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[46]
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2580,7 +3363,7 @@ func (x *VlanTableDeleteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VlanTableDeleteResponse.ProtoReflect.Descriptor instead.
 func (*VlanTableDeleteResponse) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{46}
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *VlanTableDeleteResponse) GetDeleted() []*VlanTableEntry {
@@ -2608,7 +3391,7 @@ type VlanTableListRequest struct {
 func (x *VlanTableListRequest) Reset() {
 	*x = VlanTableListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[47]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2621,7 +3404,7 @@ func (x *VlanTableListRequest) String() string {
 func (*VlanTableListRequest) ProtoMessage() {}
 
 func (x *VlanTableListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[47]
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2634,7 +3417,7 @@ func (x *VlanTableListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VlanTableListRequest.ProtoReflect.Descriptor instead.
 func (*VlanTableListRequest) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{47}
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *VlanTableListRequest) GetTableId() uint32 {
@@ -2655,7 +3438,7 @@ type VlanTableListResponse struct {
 func (x *VlanTableListResponse) Reset() {
 	*x = VlanTableListResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[48]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2668,7 +3451,7 @@ func (x *VlanTableListResponse) String() string {
 func (*VlanTableListResponse) ProtoMessage() {}
 
 func (x *VlanTableListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[48]
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2681,7 +3464,7 @@ func (x *VlanTableListResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VlanTableListResponse.ProtoReflect.Descriptor instead.
 func (*VlanTableListResponse) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{48}
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *VlanTableListResponse) GetEntries() []*VlanTableEntry {
@@ -2702,7 +3485,7 @@ type VlanTableFlushRequest struct {
 func (x *VlanTableFlushRequest) Reset() {
 	*x = VlanTableFlushRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[49]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2715,7 +3498,7 @@ func (x *VlanTableFlushRequest) String() string {
 func (*VlanTableFlushRequest) ProtoMessage() {}
 
 func (x *VlanTableFlushRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[49]
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2728,7 +3511,7 @@ func (x *VlanTableFlushRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VlanTableFlushRequest.ProtoReflect.Descriptor instead.
 func (*VlanTableFlushRequest) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{49}
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *VlanTableFlushRequest) GetTableId() uint32 {
@@ -2749,7 +3532,7 @@ type VlanTableFlushResponse struct {
 func (x *VlanTableFlushResponse) Reset() {
 	*x = VlanTableFlushResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[50]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2762,7 +3545,7 @@ func (x *VlanTableFlushResponse) String() string {
 func (*VlanTableFlushResponse) ProtoMessage() {}
 
 func (x *VlanTableFlushResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[50]
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2775,7 +3558,7 @@ func (x *VlanTableFlushResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VlanTableFlushResponse.ProtoReflect.Descriptor instead.
 func (*VlanTableFlushResponse) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{50}
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *VlanTableFlushResponse) GetDeletedCount() uint32 {
@@ -2785,37 +3568,35 @@ func (x *VlanTableFlushResponse) GetDeletedCount() uint32 {
 	return 0
 }
 
-// BdPeer represents a remote PE that participates in a Bridge Domain.
-// Each peer has a segment list describing the SRv6 path to reach it.
-type BdPeer struct {
+// SrDomainPolicy is the trust list of one locator. Creating a policy for a
+// locator that already has one replaces both lists.
+type SrDomainPolicy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BdId     uint32              `protobuf:"varint,1,opt,name=bd_id,json=bdId,proto3" json:"bd_id,omitempty"`                         // Bridge Domain ID this peer belongs to
-	SrcAddr  string              `protobuf:"bytes,2,opt,name=src_addr,json=srcAddr,proto3" json:"src_addr,omitempty"`                 // Outer IPv6 source address for SRv6 encap
-	Segments []string            `protobuf:"bytes,3,rep,name=segments,proto3" json:"segments,omitempty"`                              // Segment list to reach this PE
-	Mode     Srv6HeadendBehavior `protobuf:"varint,4,opt,name=mode,proto3,enum=vinbero.v1.Srv6HeadendBehavior" json:"mode,omitempty"` // Headend mode (default: H_ENCAPS_L2, or H_ENCAPS_L2_RED for Reduced SRH)
-	Esi      string              `protobuf:"bytes,5,opt,name=esi,proto3" json:"esi,omitempty"`                                        // RFC 7432 Ethernet Segment Identifier this peer attaches to (empty=single-homing)
+	Locator            string   `protobuf:"bytes,1,opt,name=locator,proto3" json:"locator,omitempty"`                                                   // IPv6 locator prefix to protect (e.g., "fc00:1::/48")
+	TrustedSrcPrefixes []string `protobuf:"bytes,2,rep,name=trusted_src_prefixes,json=trustedSrcPrefixes,proto3" json:"trusted_src_prefixes,omitempty"` // IPv6 source prefixes inside the SR domain
+	TrustedInterfaces  []string `protobuf:"bytes,3,rep,name=trusted_interfaces,json=trustedInterfaces,proto3" json:"trusted_interfaces,omitempty"`      // Ingress interface names facing the SR domain
 }
 
-func (x *BdPeer) Reset() {
-	*x = BdPeer{}
+func (x *SrDomainPolicy) Reset() {
+	*x = SrDomainPolicy{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[51]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BdPeer) String() string {
+func (x *SrDomainPolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BdPeer) ProtoMessage() {}
+func (*SrDomainPolicy) ProtoMessage() {}
 
-func (x *BdPeer) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[51]
+func (x *SrDomainPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2826,71 +3607,57 @@ func (x *BdPeer) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BdPeer.ProtoReflect.Descriptor instead.
-func (*BdPeer) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{51}
-}
-
-func (x *BdPeer) GetBdId() uint32 {
-	if x != nil {
-		return x.BdId
-	}
-	return 0
+// Deprecated: Use SrDomainPolicy.ProtoReflect.Descriptor instead.
+func (*SrDomainPolicy) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *BdPeer) GetSrcAddr() string {
+func (x *SrDomainPolicy) GetLocator() string {
 	if x != nil {
-		return x.SrcAddr
+		return x.Locator
 	}
 	return ""
 }
 
-func (x *BdPeer) GetSegments() []string {
+func (x *SrDomainPolicy) GetTrustedSrcPrefixes() []string {
 	if x != nil {
-		return x.Segments
+		return x.TrustedSrcPrefixes
 	}
 	return nil
 }
 
-func (x *BdPeer) GetMode() Srv6HeadendBehavior {
-	if x != nil {
-		return x.Mode
-	}
-	return Srv6HeadendBehavior_SRV6_HEADEND_BEHAVIOR_UNSPECIFIED
-}
-
-func (x *BdPeer) GetEsi() string {
+func (x *SrDomainPolicy) GetTrustedInterfaces() []string {
 	if x != nil {
-		return x.Esi
+		return x.TrustedInterfaces
 	}
-	return ""
+	return nil
 }
 
-type BdPeerCreateRequest struct {
+type SrDomainCreateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Peers []*BdPeer `protobuf:"bytes,1,rep,name=peers,proto3" json:"peers,omitempty"`
+	Policies []*SrDomainPolicy `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies,omitempty"`
 }
 
-func (x *BdPeerCreateRequest) Reset() {
-	*x = BdPeerCreateRequest{}
+func (x *SrDomainCreateRequest) Reset() {
+	*x = SrDomainCreateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[52]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BdPeerCreateRequest) String() string {
+func (x *SrDomainCreateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BdPeerCreateRequest) ProtoMessage() {}
+func (*SrDomainCreateRequest) ProtoMessage() {}
 
-func (x *BdPeerCreateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[52]
+func (x *SrDomainCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2901,44 +3668,44 @@ func (x *BdPeerCreateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BdPeerCreateRequest.ProtoReflect.Descriptor instead.
-func (*BdPeerCreateRequest) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use SrDomainCreateRequest.ProtoReflect.Descriptor instead.
+func (*SrDomainCreateRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *BdPeerCreateRequest) GetPeers() []*BdPeer {
+func (x *SrDomainCreateRequest) GetPolicies() []*SrDomainPolicy {
 	if x != nil {
-		return x.Peers
+		return x.Policies
 	}
 	return nil
 }
 
-type BdPeerCreateResponse struct {
+type SrDomainCreateResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Created []*BdPeer         `protobuf:"bytes,1,rep,name=created,proto3" json:"created,omitempty"`
+	Created []*SrDomainPolicy `protobuf:"bytes,1,rep,name=created,proto3" json:"created,omitempty"`
 	Errors  []*OperationError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
 }
 
-func (x *BdPeerCreateResponse) Reset() {
-	*x = BdPeerCreateResponse{}
+func (x *SrDomainCreateResponse) Reset() {
+	*x = SrDomainCreateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[53]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BdPeerCreateResponse) String() string {
+func (x *SrDomainCreateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BdPeerCreateResponse) ProtoMessage() {}
+func (*SrDomainCreateResponse) ProtoMessage() {}
 
-func (x *BdPeerCreateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[53]
+func (x *SrDomainCreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2949,50 +3716,50 @@ func (x *BdPeerCreateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BdPeerCreateResponse.ProtoReflect.Descriptor instead.
-func (*BdPeerCreateResponse) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use SrDomainCreateResponse.ProtoReflect.Descriptor instead.
+func (*SrDomainCreateResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *BdPeerCreateResponse) GetCreated() []*BdPeer {
+func (x *SrDomainCreateResponse) GetCreated() []*SrDomainPolicy {
 	if x != nil {
 		return x.Created
 	}
 	return nil
 }
 
-func (x *BdPeerCreateResponse) GetErrors() []*OperationError {
+func (x *SrDomainCreateResponse) GetErrors() []*OperationError {
 	if x != nil {
 		return x.Errors
 	}
 	return nil
 }
 
-type BdPeerDeleteRequest struct {
+type SrDomainDeleteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BdIds []uint32 `protobuf:"varint,1,rep,packed,name=bd_ids,json=bdIds,proto3" json:"bd_ids,omitempty"` // Delete all peers for these BD IDs
+	Locators []string `protobuf:"bytes,1,rep,name=locators,proto3" json:"locators,omitempty"` // Locator prefixes whose policy is removed
 }
 
-func (x *BdPeerDeleteRequest) Reset() {
-	*x = BdPeerDeleteRequest{}
+func (x *SrDomainDeleteRequest) Reset() {
+	*x = SrDomainDeleteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[54]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BdPeerDeleteRequest) String() string {
+func (x *SrDomainDeleteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BdPeerDeleteRequest) ProtoMessage() {}
+func (*SrDomainDeleteRequest) ProtoMessage() {}
 
-func (x *BdPeerDeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[54]
+func (x *SrDomainDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3003,44 +3770,44 @@ func (x *BdPeerDeleteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BdPeerDeleteRequest.ProtoReflect.Descriptor instead.
-func (*BdPeerDeleteRequest) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use SrDomainDeleteRequest.ProtoReflect.Descriptor instead.
+func (*SrDomainDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *BdPeerDeleteRequest) GetBdIds() []uint32 {
+func (x *SrDomainDeleteRequest) GetLocators() []string {
 	if x != nil {
-		return x.BdIds
+		return x.Locators
 	}
 	return nil
 }
 
-type BdPeerDeleteResponse struct {
+type SrDomainDeleteResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DeletedBdIds []uint32          `protobuf:"varint,1,rep,packed,name=deleted_bd_ids,json=deletedBdIds,proto3" json:"deleted_bd_ids,omitempty"`
-	Errors       []*OperationError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	DeletedLocators []string          `protobuf:"bytes,1,rep,name=deleted_locators,json=deletedLocators,proto3" json:"deleted_locators,omitempty"`
+	Errors          []*OperationError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
 }
 
-func (x *BdPeerDeleteResponse) Reset() {
-	*x = BdPeerDeleteResponse{}
+func (x *SrDomainDeleteResponse) Reset() {
+	*x = SrDomainDeleteResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[55]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BdPeerDeleteResponse) String() string {
+func (x *SrDomainDeleteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BdPeerDeleteResponse) ProtoMessage() {}
+func (*SrDomainDeleteResponse) ProtoMessage() {}
 
-func (x *BdPeerDeleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[55]
+func (x *SrDomainDeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3051,50 +3818,48 @@ func (x *BdPeerDeleteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BdPeerDeleteResponse.ProtoReflect.Descriptor instead.
-func (*BdPeerDeleteResponse) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use SrDomainDeleteResponse.ProtoReflect.Descriptor instead.
+func (*SrDomainDeleteResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *BdPeerDeleteResponse) GetDeletedBdIds() []uint32 {
+func (x *SrDomainDeleteResponse) GetDeletedLocators() []string {
 	if x != nil {
-		return x.DeletedBdIds
+		return x.DeletedLocators
 	}
 	return nil
 }
 
-func (x *BdPeerDeleteResponse) GetErrors() []*OperationError {
+func (x *SrDomainDeleteResponse) GetErrors() []*OperationError {
 	if x != nil {
 		return x.Errors
 	}
 	return nil
 }
 
-type BdPeerListRequest struct {
+type SrDomainListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	BdId uint32 `protobuf:"varint,1,opt,name=bd_id,json=bdId,proto3" json:"bd_id,omitempty"` // Filter by BD ID; 0 returns all peers
 }
 
-func (x *BdPeerListRequest) Reset() {
-	*x = BdPeerListRequest{}
+func (x *SrDomainListRequest) Reset() {
+	*x = SrDomainListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[56]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BdPeerListRequest) String() string {
+func (x *SrDomainListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BdPeerListRequest) ProtoMessage() {}
+func (*SrDomainListRequest) ProtoMessage() {}
 
-func (x *BdPeerListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[56]
+func (x *SrDomainListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3105,43 +3870,36 @@ func (x *BdPeerListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BdPeerListRequest.ProtoReflect.Descriptor instead.
-func (*BdPeerListRequest) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use SrDomainListRequest.ProtoReflect.Descriptor instead.
+func (*SrDomainListRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *BdPeerListRequest) GetBdId() uint32 {
-	if x != nil {
-		return x.BdId
-	}
-	return 0
-}
-
-type BdPeerListResponse struct {
+type SrDomainListResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Peers []*BdPeer `protobuf:"bytes,1,rep,name=peers,proto3" json:"peers,omitempty"`
+	Policies []*SrDomainPolicy `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies,omitempty"`
 }
 
-func (x *BdPeerListResponse) Reset() {
-	*x = BdPeerListResponse{}
+func (x *SrDomainListResponse) Reset() {
+	*x = SrDomainListResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[57]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BdPeerListResponse) String() string {
+func (x *SrDomainListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BdPeerListResponse) ProtoMessage() {}
+func (*SrDomainListResponse) ProtoMessage() {}
 
-func (x *BdPeerListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[57]
+func (x *SrDomainListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3152,43 +3910,41 @@ func (x *BdPeerListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BdPeerListResponse.ProtoReflect.Descriptor instead.
-func (*BdPeerListResponse) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{57}
+// Deprecated: Use SrDomainListResponse.ProtoReflect.Descriptor instead.
+func (*SrDomainListResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *BdPeerListResponse) GetPeers() []*BdPeer {
+func (x *SrDomainListResponse) GetPolicies() []*SrDomainPolicy {
 	if x != nil {
-		return x.Peers
+		return x.Policies
 	}
 	return nil
 }
 
-type BdPeerFlushRequest struct {
+type SrDomainFlushRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	BdId uint32 `protobuf:"varint,1,opt,name=bd_id,json=bdId,proto3" json:"bd_id,omitempty"` // 0 = flush all BDs; otherwise only peers in this BD
 }
 
-func (x *BdPeerFlushRequest) Reset() {
-	*x = BdPeerFlushRequest{}
+func (x *SrDomainFlushRequest) Reset() {
+	*x = SrDomainFlushRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[58]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BdPeerFlushRequest) String() string {
+func (x *SrDomainFlushRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BdPeerFlushRequest) ProtoMessage() {}
+func (*SrDomainFlushRequest) ProtoMessage() {}
 
-func (x *BdPeerFlushRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[58]
+func (x *SrDomainFlushRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3199,19 +3955,12 @@ func (x *BdPeerFlushRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BdPeerFlushRequest.ProtoReflect.Descriptor instead.
-func (*BdPeerFlushRequest) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{58}
-}
-
-func (x *BdPeerFlushRequest) GetBdId() uint32 {
-	if x != nil {
-		return x.BdId
-	}
-	return 0
+// Deprecated: Use SrDomainFlushRequest.ProtoReflect.Descriptor instead.
+func (*SrDomainFlushRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{66}
 }
 
-type BdPeerFlushResponse struct {
+type SrDomainFlushResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -3219,23 +3968,23 @@ type BdPeerFlushResponse struct {
 	DeletedCount uint32 `protobuf:"varint,1,opt,name=deleted_count,json=deletedCount,proto3" json:"deleted_count,omitempty"`
 }
 
-func (x *BdPeerFlushResponse) Reset() {
-	*x = BdPeerFlushResponse{}
+func (x *SrDomainFlushResponse) Reset() {
+	*x = SrDomainFlushResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[59]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BdPeerFlushResponse) String() string {
+func (x *SrDomainFlushResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BdPeerFlushResponse) ProtoMessage() {}
+func (*SrDomainFlushResponse) ProtoMessage() {}
 
-func (x *BdPeerFlushResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[59]
+func (x *SrDomainFlushResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3246,53 +3995,49 @@ func (x *BdPeerFlushResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BdPeerFlushResponse.ProtoReflect.Descriptor instead.
-func (*BdPeerFlushResponse) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{59}
+// Deprecated: Use SrDomainFlushResponse.ProtoReflect.Descriptor instead.
+func (*SrDomainFlushResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *BdPeerFlushResponse) GetDeletedCount() uint32 {
+func (x *SrDomainFlushResponse) GetDeletedCount() uint32 {
 	if x != nil {
 		return x.DeletedCount
 	}
 	return 0
 }
 
-// EthernetSegment represents a single ES entry stored in the ESI master table.
-type EthernetSegment struct {
+// BdPeer represents a remote PE that participates in a Bridge Domain.
+// Each peer has a segment list describing the SRv6 path to reach it.
+type BdPeer struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Esi           string `protobuf:"bytes,1,opt,name=esi,proto3" json:"esi,omitempty"`                                           // 10-byte RFC 7432 ESI as colon-separated hex
-	LocalAttached bool   `protobuf:"varint,2,opt,name=local_attached,json=localAttached,proto3" json:"local_attached,omitempty"` // true if this PE attaches to the ES
-	// local_pe_src_addr is the IPv6 source this PE uses in H.Encaps.L2 for this
-	// ES. Required when local_attached=true so DF judgement can compare against
-	// df_pe_src_addr. Ignored otherwise.
-	LocalPeSrcAddr string `protobuf:"bytes,3,opt,name=local_pe_src_addr,json=localPeSrcAddr,proto3" json:"local_pe_src_addr,omitempty"`
-	// df_pe_src_addr names the current Designated Forwarder for this ES. Empty
-	// means "no DF configured yet" (all attached PEs forward BUM, DF=implicit).
-	DfPeSrcAddr    string            `protobuf:"bytes,4,opt,name=df_pe_src_addr,json=dfPeSrcAddr,proto3" json:"df_pe_src_addr,omitempty"`
-	RedundancyMode EsiRedundancyMode `protobuf:"varint,5,opt,name=redundancy_mode,json=redundancyMode,proto3,enum=vinbero.v1.EsiRedundancyMode" json:"redundancy_mode,omitempty"`
+	BdId     uint32              `protobuf:"varint,1,opt,name=bd_id,json=bdId,proto3" json:"bd_id,omitempty"`                         // Bridge Domain ID this peer belongs to
+	SrcAddr  string              `protobuf:"bytes,2,opt,name=src_addr,json=srcAddr,proto3" json:"src_addr,omitempty"`                 // Outer IPv6 source address for SRv6 encap
+	Segments []string            `protobuf:"bytes,3,rep,name=segments,proto3" json:"segments,omitempty"`                              // Segment list to reach this PE
+	Mode     Srv6HeadendBehavior `protobuf:"varint,4,opt,name=mode,proto3,enum=vinbero.v1.Srv6HeadendBehavior" json:"mode,omitempty"` // Headend mode (default: H_ENCAPS_L2, or H_ENCAPS_L2_RED for Reduced SRH)
+	Esi      string              `protobuf:"bytes,5,opt,name=esi,proto3" json:"esi,omitempty"`                                        // RFC 7432 Ethernet Segment Identifier this peer attaches to (empty=single-homing)
 }
 
-func (x *EthernetSegment) Reset() {
-	*x = EthernetSegment{}
+func (x *BdPeer) Reset() {
+	*x = BdPeer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[60]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EthernetSegment) String() string {
+func (x *BdPeer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EthernetSegment) ProtoMessage() {}
+func (*BdPeer) ProtoMessage() {}
 
-func (x *EthernetSegment) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[60]
+func (x *BdPeer) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3303,71 +4048,71 @@ func (x *EthernetSegment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EthernetSegment.ProtoReflect.Descriptor instead.
-func (*EthernetSegment) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{60}
+// Deprecated: Use BdPeer.ProtoReflect.Descriptor instead.
+func (*BdPeer) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *EthernetSegment) GetEsi() string {
+func (x *BdPeer) GetBdId() uint32 {
 	if x != nil {
-		return x.Esi
+		return x.BdId
 	}
-	return ""
+	return 0
 }
 
-func (x *EthernetSegment) GetLocalAttached() bool {
+func (x *BdPeer) GetSrcAddr() string {
 	if x != nil {
-		return x.LocalAttached
+		return x.SrcAddr
 	}
-	return false
+	return ""
 }
 
-func (x *EthernetSegment) GetLocalPeSrcAddr() string {
+func (x *BdPeer) GetSegments() []string {
 	if x != nil {
-		return x.LocalPeSrcAddr
+		return x.Segments
 	}
-	return ""
+	return nil
 }
 
-func (x *EthernetSegment) GetDfPeSrcAddr() string {
+func (x *BdPeer) GetMode() Srv6HeadendBehavior {
 	if x != nil {
-		return x.DfPeSrcAddr
+		return x.Mode
 	}
-	return ""
+	return Srv6HeadendBehavior_SRV6_HEADEND_BEHAVIOR_UNSPECIFIED
 }
 
-func (x *EthernetSegment) GetRedundancyMode() EsiRedundancyMode {
+func (x *BdPeer) GetEsi() string {
 	if x != nil {
-		return x.RedundancyMode
+		return x.Esi
 	}
-	return EsiRedundancyMode_ESI_REDUNDANCY_MODE_UNSPECIFIED
+	return ""
 }
 
-type EsCreateRequest struct {
+type BdPeerCreateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Entries []*EthernetSegment `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Peers []*BdPeer `protobuf:"bytes,1,rep,name=peers,proto3" json:"peers,omitempty"`
 }
 
-func (x *EsCreateRequest) Reset() {
-	*x = EsCreateRequest{}
+func (x *BdPeerCreateRequest) Reset() {
+	*x = BdPeerCreateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[61]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EsCreateRequest) String() string {
+func (x *BdPeerCreateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EsCreateRequest) ProtoMessage() {}
+func (*BdPeerCreateRequest) ProtoMessage() {}
 
-func (x *EsCreateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[61]
+func (x *BdPeerCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3378,44 +4123,44 @@ func (x *EsCreateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EsCreateRequest.ProtoReflect.Descriptor instead.
-func (*EsCreateRequest) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{61}
+// Deprecated: Use BdPeerCreateRequest.ProtoReflect.Descriptor instead.
+func (*BdPeerCreateRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *EsCreateRequest) GetEntries() []*EthernetSegment {
+func (x *BdPeerCreateRequest) GetPeers() []*BdPeer {
 	if x != nil {
-		return x.Entries
+		return x.Peers
 	}
 	return nil
 }
 
-type EsCreateResponse struct {
+type BdPeerCreateResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Created []*EthernetSegment `protobuf:"bytes,1,rep,name=created,proto3" json:"created,omitempty"`
-	Errors  []*OperationError  `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	Created []*BdPeer         `protobuf:"bytes,1,rep,name=created,proto3" json:"created,omitempty"`
+	Errors  []*OperationError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
 }
 
-func (x *EsCreateResponse) Reset() {
-	*x = EsCreateResponse{}
+func (x *BdPeerCreateResponse) Reset() {
+	*x = BdPeerCreateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[62]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EsCreateResponse) String() string {
+func (x *BdPeerCreateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EsCreateResponse) ProtoMessage() {}
+func (*BdPeerCreateResponse) ProtoMessage() {}
 
-func (x *EsCreateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[62]
+func (x *BdPeerCreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3426,50 +4171,51 @@ func (x *EsCreateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EsCreateResponse.ProtoReflect.Descriptor instead.
-func (*EsCreateResponse) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{62}
+// Deprecated: Use BdPeerCreateResponse.ProtoReflect.Descriptor instead.
+func (*BdPeerCreateResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *EsCreateResponse) GetCreated() []*EthernetSegment {
+func (x *BdPeerCreateResponse) GetCreated() []*BdPeer {
 	if x != nil {
 		return x.Created
 	}
 	return nil
 }
 
-func (x *EsCreateResponse) GetErrors() []*OperationError {
+func (x *BdPeerCreateResponse) GetErrors() []*OperationError {
 	if x != nil {
 		return x.Errors
 	}
 	return nil
 }
 
-type EsDeleteRequest struct {
+type BdPeerUpdateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Esis []string `protobuf:"bytes,1,rep,name=esis,proto3" json:"esis,omitempty"` // ESI strings to delete
+	Peers      []*BdPeer              `protobuf:"bytes,1,rep,name=peers,proto3" json:"peers,omitempty"`                             // Matched to installed entries by (bd_id, src_addr), which can't change
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"` // Fields taken from each entry; empty replaces every field
 }
 
-func (x *EsDeleteRequest) Reset() {
-	*x = EsDeleteRequest{}
+func (x *BdPeerUpdateRequest) Reset() {
+	*x = BdPeerUpdateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[63]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EsDeleteRequest) String() string {
+func (x *BdPeerUpdateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EsDeleteRequest) ProtoMessage() {}
+func (*BdPeerUpdateRequest) ProtoMessage() {}
 
-func (x *EsDeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[63]
+func (x *BdPeerUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3480,44 +4226,51 @@ func (x *EsDeleteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EsDeleteRequest.ProtoReflect.Descriptor instead.
-func (*EsDeleteRequest) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{63}
+// Deprecated: Use BdPeerUpdateRequest.ProtoReflect.Descriptor instead.
+func (*BdPeerUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *EsDeleteRequest) GetEsis() []string {
+func (x *BdPeerUpdateRequest) GetPeers() []*BdPeer {
 	if x != nil {
-		return x.Esis
+		return x.Peers
 	}
 	return nil
 }
 
-type EsDeleteResponse struct {
+func (x *BdPeerUpdateRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type BdPeerUpdateResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Deleted []string          `protobuf:"bytes,1,rep,name=deleted,proto3" json:"deleted,omitempty"`
+	Updated []*BdPeer         `protobuf:"bytes,1,rep,name=updated,proto3" json:"updated,omitempty"` // Entries as installed after the update
 	Errors  []*OperationError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
 }
 
-func (x *EsDeleteResponse) Reset() {
-	*x = EsDeleteResponse{}
+func (x *BdPeerUpdateResponse) Reset() {
+	*x = BdPeerUpdateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[64]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EsDeleteResponse) String() string {
+func (x *BdPeerUpdateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EsDeleteResponse) ProtoMessage() {}
+func (*BdPeerUpdateResponse) ProtoMessage() {}
 
-func (x *EsDeleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[64]
+func (x *BdPeerUpdateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3528,48 +4281,50 @@ func (x *EsDeleteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EsDeleteResponse.ProtoReflect.Descriptor instead.
-func (*EsDeleteResponse) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{64}
+// Deprecated: Use BdPeerUpdateResponse.ProtoReflect.Descriptor instead.
+func (*BdPeerUpdateResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *EsDeleteResponse) GetDeleted() []string {
+func (x *BdPeerUpdateResponse) GetUpdated() []*BdPeer {
 	if x != nil {
-		return x.Deleted
+		return x.Updated
 	}
 	return nil
 }
 
-func (x *EsDeleteResponse) GetErrors() []*OperationError {
+func (x *BdPeerUpdateResponse) GetErrors() []*OperationError {
 	if x != nil {
 		return x.Errors
 	}
 	return nil
 }
 
-type EsListRequest struct {
+type BdPeerDeleteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	BdIds []uint32 `protobuf:"varint,1,rep,packed,name=bd_ids,json=bdIds,proto3" json:"bd_ids,omitempty"` // Delete all peers for these BD IDs
 }
 
-func (x *EsListRequest) Reset() {
-	*x = EsListRequest{}
+func (x *BdPeerDeleteRequest) Reset() {
+	*x = BdPeerDeleteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[65]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EsListRequest) String() string {
+func (x *BdPeerDeleteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EsListRequest) ProtoMessage() {}
+func (*BdPeerDeleteRequest) ProtoMessage() {}
 
-func (x *EsListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[65]
+func (x *BdPeerDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3580,36 +4335,44 @@ func (x *EsListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EsListRequest.ProtoReflect.Descriptor instead.
-func (*EsListRequest) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{65}
+// Deprecated: Use BdPeerDeleteRequest.ProtoReflect.Descriptor instead.
+func (*BdPeerDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{73}
 }
 
-type EsListResponse struct {
+func (x *BdPeerDeleteRequest) GetBdIds() []uint32 {
+	if x != nil {
+		return x.BdIds
+	}
+	return nil
+}
+
+type BdPeerDeleteResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Entries []*EthernetSegment `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	DeletedBdIds []uint32          `protobuf:"varint,1,rep,packed,name=deleted_bd_ids,json=deletedBdIds,proto3" json:"deleted_bd_ids,omitempty"`
+	Errors       []*OperationError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
 }
 
-func (x *EsListResponse) Reset() {
-	*x = EsListResponse{}
+func (x *BdPeerDeleteResponse) Reset() {
+	*x = BdPeerDeleteResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[66]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EsListResponse) String() string {
+func (x *BdPeerDeleteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EsListResponse) ProtoMessage() {}
+func (*BdPeerDeleteResponse) ProtoMessage() {}
 
-func (x *EsListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[66]
+func (x *BdPeerDeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3620,44 +4383,50 @@ func (x *EsListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EsListResponse.ProtoReflect.Descriptor instead.
-func (*EsListResponse) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{66}
+// Deprecated: Use BdPeerDeleteResponse.ProtoReflect.Descriptor instead.
+func (*BdPeerDeleteResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *EsListResponse) GetEntries() []*EthernetSegment {
+func (x *BdPeerDeleteResponse) GetDeletedBdIds() []uint32 {
 	if x != nil {
-		return x.Entries
+		return x.DeletedBdIds
 	}
 	return nil
 }
 
-type EsSetDfRequest struct {
+func (x *BdPeerDeleteResponse) GetErrors() []*OperationError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type BdPeerListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Esi         string `protobuf:"bytes,1,opt,name=esi,proto3" json:"esi,omitempty"`
-	DfPeSrcAddr string `protobuf:"bytes,2,opt,name=df_pe_src_addr,json=dfPeSrcAddr,proto3" json:"df_pe_src_addr,omitempty"` // IPv6 of the PE promoted to DF
+	BdId uint32 `protobuf:"varint,1,opt,name=bd_id,json=bdId,proto3" json:"bd_id,omitempty"` // Filter by BD ID; 0 returns all peers
 }
 
-func (x *EsSetDfRequest) Reset() {
-	*x = EsSetDfRequest{}
+func (x *BdPeerListRequest) Reset() {
+	*x = BdPeerListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[67]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[75]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EsSetDfRequest) String() string {
+func (x *BdPeerListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EsSetDfRequest) ProtoMessage() {}
+func (*BdPeerListRequest) ProtoMessage() {}
 
-func (x *EsSetDfRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[67]
+func (x *BdPeerListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[75]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3668,50 +4437,43 @@ func (x *EsSetDfRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EsSetDfRequest.ProtoReflect.Descriptor instead.
-func (*EsSetDfRequest) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{67}
-}
-
-func (x *EsSetDfRequest) GetEsi() string {
-	if x != nil {
-		return x.Esi
-	}
-	return ""
+// Deprecated: Use BdPeerListRequest.ProtoReflect.Descriptor instead.
+func (*BdPeerListRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *EsSetDfRequest) GetDfPeSrcAddr() string {
+func (x *BdPeerListRequest) GetBdId() uint32 {
 	if x != nil {
-		return x.DfPeSrcAddr
+		return x.BdId
 	}
-	return ""
+	return 0
 }
 
-type EsSetDfResponse struct {
+type BdPeerListResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Updated *EthernetSegment `protobuf:"bytes,1,opt,name=updated,proto3" json:"updated,omitempty"`
+	Peers []*BdPeer `protobuf:"bytes,1,rep,name=peers,proto3" json:"peers,omitempty"`
 }
 
-func (x *EsSetDfResponse) Reset() {
-	*x = EsSetDfResponse{}
+func (x *BdPeerListResponse) Reset() {
+	*x = BdPeerListResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[68]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[76]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EsSetDfResponse) String() string {
+func (x *BdPeerListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EsSetDfResponse) ProtoMessage() {}
+func (*BdPeerListResponse) ProtoMessage() {}
 
-func (x *EsSetDfResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[68]
+func (x *BdPeerListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[76]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3722,43 +4484,43 @@ func (x *EsSetDfResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EsSetDfResponse.ProtoReflect.Descriptor instead.
-func (*EsSetDfResponse) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{68}
+// Deprecated: Use BdPeerListResponse.ProtoReflect.Descriptor instead.
+func (*BdPeerListResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *EsSetDfResponse) GetUpdated() *EthernetSegment {
+func (x *BdPeerListResponse) GetPeers() []*BdPeer {
 	if x != nil {
-		return x.Updated
+		return x.Peers
 	}
 	return nil
 }
 
-type EsClearDfRequest struct {
+type BdPeerFlushRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Esi string `protobuf:"bytes,1,opt,name=esi,proto3" json:"esi,omitempty"`
+	BdId uint32 `protobuf:"varint,1,opt,name=bd_id,json=bdId,proto3" json:"bd_id,omitempty"` // 0 = flush all BDs; otherwise only peers in this BD
 }
 
-func (x *EsClearDfRequest) Reset() {
-	*x = EsClearDfRequest{}
+func (x *BdPeerFlushRequest) Reset() {
+	*x = BdPeerFlushRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[69]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EsClearDfRequest) String() string {
+func (x *BdPeerFlushRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EsClearDfRequest) ProtoMessage() {}
+func (*BdPeerFlushRequest) ProtoMessage() {}
 
-func (x *EsClearDfRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[69]
+func (x *BdPeerFlushRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3769,43 +4531,43 @@ func (x *EsClearDfRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EsClearDfRequest.ProtoReflect.Descriptor instead.
-func (*EsClearDfRequest) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{69}
+// Deprecated: Use BdPeerFlushRequest.ProtoReflect.Descriptor instead.
+func (*BdPeerFlushRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *EsClearDfRequest) GetEsi() string {
+func (x *BdPeerFlushRequest) GetBdId() uint32 {
 	if x != nil {
-		return x.Esi
+		return x.BdId
 	}
-	return ""
+	return 0
 }
 
-type EsClearDfResponse struct {
+type BdPeerFlushResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Updated *EthernetSegment `protobuf:"bytes,1,opt,name=updated,proto3" json:"updated,omitempty"`
+	DeletedCount uint32 `protobuf:"varint,1,opt,name=deleted_count,json=deletedCount,proto3" json:"deleted_count,omitempty"`
 }
 
-func (x *EsClearDfResponse) Reset() {
-	*x = EsClearDfResponse{}
+func (x *BdPeerFlushResponse) Reset() {
+	*x = BdPeerFlushResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[70]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[78]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EsClearDfResponse) String() string {
+func (x *BdPeerFlushResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EsClearDfResponse) ProtoMessage() {}
+func (*BdPeerFlushResponse) ProtoMessage() {}
 
-func (x *EsClearDfResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[70]
+func (x *BdPeerFlushResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[78]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3816,48 +4578,49 @@ func (x *EsClearDfResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EsClearDfResponse.ProtoReflect.Descriptor instead.
-func (*EsClearDfResponse) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{70}
+// Deprecated: Use BdPeerFlushResponse.ProtoReflect.Descriptor instead.
+func (*BdPeerFlushResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *EsClearDfResponse) GetUpdated() *EthernetSegment {
+func (x *BdPeerFlushResponse) GetDeletedCount() uint32 {
 	if x != nil {
-		return x.Updated
+		return x.DeletedCount
 	}
-	return nil
+	return 0
 }
 
-// Vrf represents a Linux VRF device managed by Vinbero.
-// Used by End.DT4/DT6/DT46 for VRF-aware FIB lookup.
-type Vrf struct {
+// VniMapping bridges one VNI into one BD. Creating a mapping for a VNI that
+// already has one replaces its VTEP list (the bd_id must stay the same).
+type VniMapping struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name             string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                                    // VRF device name (e.g., "vrf100")
-	TableId          uint32   `protobuf:"varint,2,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`                              // Linux routing table ID
-	Members          []string `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`                                              // Interfaces to enslave to this VRF
-	EnableL3MdevRule bool     `protobuf:"varint,4,opt,name=enable_l3mdev_rule,json=enableL3mdevRule,proto3" json:"enable_l3mdev_rule,omitempty"` // Add "ip rule add l3mdev" if not already present
+	Vni         uint32   `protobuf:"varint,1,opt,name=vni,proto3" json:"vni,omitempty"`                                   // VXLAN Network Identifier (1-16777215)
+	BdId        uint32   `protobuf:"varint,2,opt,name=bd_id,json=bdId,proto3" json:"bd_id,omitempty"`                     // Bridge Domain the VNI is bridged into
+	LocalVtep   string   `protobuf:"bytes,3,opt,name=local_vtep,json=localVtep,proto3" json:"local_vtep,omitempty"`       // Local VTEP address (IPv4 or IPv6); VXLAN packets to it are decapsulated
+	RemoteVteps []string `protobuf:"bytes,4,rep,name=remote_vteps,json=remoteVteps,proto3" json:"remote_vteps,omitempty"` // Remote VTEPs receiving BUM, same family as local_vtep (max 64)
+	BridgeName  string   `protobuf:"bytes,5,opt,name=bridge_name,json=bridgeName,proto3" json:"bridge_name,omitempty"`    // Bridge of the BD's local ACs, flooded with gateway BUM (empty = none)
 }
 
-func (x *Vrf) Reset() {
-	*x = Vrf{}
+func (x *VniMapping) Reset() {
+	*x = VniMapping{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[71]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[79]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Vrf) String() string {
+func (x *VniMapping) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Vrf) ProtoMessage() {}
+func (*VniMapping) ProtoMessage() {}
 
-func (x *Vrf) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[71]
+func (x *VniMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[79]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3868,64 +4631,71 @@ func (x *Vrf) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Vrf.ProtoReflect.Descriptor instead.
-func (*Vrf) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{71}
+// Deprecated: Use VniMapping.ProtoReflect.Descriptor instead.
+func (*VniMapping) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *Vrf) GetName() string {
+func (x *VniMapping) GetVni() uint32 {
 	if x != nil {
-		return x.Name
+		return x.Vni
 	}
-	return ""
+	return 0
 }
 
-func (x *Vrf) GetTableId() uint32 {
+func (x *VniMapping) GetBdId() uint32 {
 	if x != nil {
-		return x.TableId
+		return x.BdId
 	}
 	return 0
 }
 
-func (x *Vrf) GetMembers() []string {
+func (x *VniMapping) GetLocalVtep() string {
 	if x != nil {
-		return x.Members
+		return x.LocalVtep
+	}
+	return ""
+}
+
+func (x *VniMapping) GetRemoteVteps() []string {
+	if x != nil {
+		return x.RemoteVteps
 	}
 	return nil
 }
 
-func (x *Vrf) GetEnableL3MdevRule() bool {
+func (x *VniMapping) GetBridgeName() string {
 	if x != nil {
-		return x.EnableL3MdevRule
+		return x.BridgeName
 	}
-	return false
+	return ""
 }
 
-type VrfCreateRequest struct {
+type VniMappingCreateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Vrfs []*Vrf `protobuf:"bytes,1,rep,name=vrfs,proto3" json:"vrfs,omitempty"`
+	Mappings []*VniMapping `protobuf:"bytes,1,rep,name=mappings,proto3" json:"mappings,omitempty"`
 }
 
-func (x *VrfCreateRequest) Reset() {
-	*x = VrfCreateRequest{}
+func (x *VniMappingCreateRequest) Reset() {
+	*x = VniMappingCreateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[72]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[80]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VrfCreateRequest) String() string {
+func (x *VniMappingCreateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VrfCreateRequest) ProtoMessage() {}
+func (*VniMappingCreateRequest) ProtoMessage() {}
 
-func (x *VrfCreateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[72]
+func (x *VniMappingCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[80]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3936,44 +4706,44 @@ func (x *VrfCreateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VrfCreateRequest.ProtoReflect.Descriptor instead.
-func (*VrfCreateRequest) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{72}
+// Deprecated: Use VniMappingCreateRequest.ProtoReflect.Descriptor instead.
+func (*VniMappingCreateRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *VrfCreateRequest) GetVrfs() []*Vrf {
+func (x *VniMappingCreateRequest) GetMappings() []*VniMapping {
 	if x != nil {
-		return x.Vrfs
+		return x.Mappings
 	}
 	return nil
 }
 
-type VrfCreateResponse struct {
+type VniMappingCreateResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Created []*Vrf            `protobuf:"bytes,1,rep,name=created,proto3" json:"created,omitempty"`
+	Created []*VniMapping     `protobuf:"bytes,1,rep,name=created,proto3" json:"created,omitempty"`
 	Errors  []*OperationError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
 }
 
-func (x *VrfCreateResponse) Reset() {
-	*x = VrfCreateResponse{}
+func (x *VniMappingCreateResponse) Reset() {
+	*x = VniMappingCreateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[73]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[81]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VrfCreateResponse) String() string {
+func (x *VniMappingCreateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VrfCreateResponse) ProtoMessage() {}
+func (*VniMappingCreateResponse) ProtoMessage() {}
 
-func (x *VrfCreateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[73]
+func (x *VniMappingCreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[81]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3984,50 +4754,51 @@ func (x *VrfCreateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VrfCreateResponse.ProtoReflect.Descriptor instead.
-func (*VrfCreateResponse) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{73}
+// Deprecated: Use VniMappingCreateResponse.ProtoReflect.Descriptor instead.
+func (*VniMappingCreateResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *VrfCreateResponse) GetCreated() []*Vrf {
+func (x *VniMappingCreateResponse) GetCreated() []*VniMapping {
 	if x != nil {
 		return x.Created
 	}
 	return nil
 }
 
-func (x *VrfCreateResponse) GetErrors() []*OperationError {
+func (x *VniMappingCreateResponse) GetErrors() []*OperationError {
 	if x != nil {
 		return x.Errors
 	}
 	return nil
 }
 
-type VrfDeleteRequest struct {
+type VniMappingDeleteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Names []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"` // VRF names to delete; fails if referenced by a SID
+	Vnis  []uint32 `protobuf:"varint,1,rep,packed,name=vnis,proto3" json:"vnis,omitempty"`                // Delete the mappings of these VNIs
+	BdIds []uint32 `protobuf:"varint,2,rep,packed,name=bd_ids,json=bdIds,proto3" json:"bd_ids,omitempty"` // Delete the mappings of these BDs
 }
 
-func (x *VrfDeleteRequest) Reset() {
-	*x = VrfDeleteRequest{}
+func (x *VniMappingDeleteRequest) Reset() {
+	*x = VniMappingDeleteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[74]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[82]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VrfDeleteRequest) String() string {
+func (x *VniMappingDeleteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VrfDeleteRequest) ProtoMessage() {}
+func (*VniMappingDeleteRequest) ProtoMessage() {}
 
-func (x *VrfDeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[74]
+func (x *VniMappingDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[82]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4038,44 +4809,51 @@ func (x *VrfDeleteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VrfDeleteRequest.ProtoReflect.Descriptor instead.
-func (*VrfDeleteRequest) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{74}
+// Deprecated: Use VniMappingDeleteRequest.ProtoReflect.Descriptor instead.
+func (*VniMappingDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *VrfDeleteRequest) GetNames() []string {
+func (x *VniMappingDeleteRequest) GetVnis() []uint32 {
 	if x != nil {
-		return x.Names
+		return x.Vnis
 	}
 	return nil
 }
 
-type VrfDeleteResponse struct {
+func (x *VniMappingDeleteRequest) GetBdIds() []uint32 {
+	if x != nil {
+		return x.BdIds
+	}
+	return nil
+}
+
+type VniMappingDeleteResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DeletedNames []string          `protobuf:"bytes,1,rep,name=deleted_names,json=deletedNames,proto3" json:"deleted_names,omitempty"`
-	Errors       []*OperationError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	DeletedVnis []uint32          `protobuf:"varint,1,rep,packed,name=deleted_vnis,json=deletedVnis,proto3" json:"deleted_vnis,omitempty"`
+	Errors      []*OperationError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
 }
 
-func (x *VrfDeleteResponse) Reset() {
-	*x = VrfDeleteResponse{}
+func (x *VniMappingDeleteResponse) Reset() {
+	*x = VniMappingDeleteResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[75]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[83]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VrfDeleteResponse) String() string {
+func (x *VniMappingDeleteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VrfDeleteResponse) ProtoMessage() {}
+func (*VniMappingDeleteResponse) ProtoMessage() {}
 
-func (x *VrfDeleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[75]
+func (x *VniMappingDeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[83]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4086,48 +4864,51 @@ func (x *VrfDeleteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VrfDeleteResponse.ProtoReflect.Descriptor instead.
-func (*VrfDeleteResponse) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{75}
+// Deprecated: Use VniMappingDeleteResponse.ProtoReflect.Descriptor instead.
+func (*VniMappingDeleteResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *VrfDeleteResponse) GetDeletedNames() []string {
+func (x *VniMappingDeleteResponse) GetDeletedVnis() []uint32 {
 	if x != nil {
-		return x.DeletedNames
+		return x.DeletedVnis
 	}
 	return nil
 }
 
-func (x *VrfDeleteResponse) GetErrors() []*OperationError {
+func (x *VniMappingDeleteResponse) GetErrors() []*OperationError {
 	if x != nil {
 		return x.Errors
 	}
 	return nil
 }
 
-type VrfListRequest struct {
+type VniMappingListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Vni  uint32 `protobuf:"varint,1,opt,name=vni,proto3" json:"vni,omitempty"`               // Filter by VNI; 0 matches all
+	BdId uint32 `protobuf:"varint,2,opt,name=bd_id,json=bdId,proto3" json:"bd_id,omitempty"` // Filter by BD ID; 0 matches all
 }
 
-func (x *VrfListRequest) Reset() {
-	*x = VrfListRequest{}
+func (x *VniMappingListRequest) Reset() {
+	*x = VniMappingListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[76]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[84]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VrfListRequest) String() string {
+func (x *VniMappingListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VrfListRequest) ProtoMessage() {}
+func (*VniMappingListRequest) ProtoMessage() {}
 
-func (x *VrfListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[76]
+func (x *VniMappingListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[84]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4138,36 +4919,50 @@ func (x *VrfListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VrfListRequest.ProtoReflect.Descriptor instead.
-func (*VrfListRequest) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{76}
+// Deprecated: Use VniMappingListRequest.ProtoReflect.Descriptor instead.
+func (*VniMappingListRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{84}
 }
 
-type VrfListResponse struct {
+func (x *VniMappingListRequest) GetVni() uint32 {
+	if x != nil {
+		return x.Vni
+	}
+	return 0
+}
+
+func (x *VniMappingListRequest) GetBdId() uint32 {
+	if x != nil {
+		return x.BdId
+	}
+	return 0
+}
+
+type VniMappingListResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Vrfs []*Vrf `protobuf:"bytes,1,rep,name=vrfs,proto3" json:"vrfs,omitempty"`
+	Mappings []*VniMapping `protobuf:"bytes,1,rep,name=mappings,proto3" json:"mappings,omitempty"`
 }
 
-func (x *VrfListResponse) Reset() {
-	*x = VrfListResponse{}
+func (x *VniMappingListResponse) Reset() {
+	*x = VniMappingListResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[77]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[85]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VrfListResponse) String() string {
+func (x *VniMappingListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VrfListResponse) ProtoMessage() {}
+func (*VniMappingListResponse) ProtoMessage() {}
 
-func (x *VrfListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[77]
+func (x *VniMappingListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[85]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4178,47 +4973,41 @@ func (x *VrfListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VrfListResponse.ProtoReflect.Descriptor instead.
-func (*VrfListResponse) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{77}
+// Deprecated: Use VniMappingListResponse.ProtoReflect.Descriptor instead.
+func (*VniMappingListResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *VrfListResponse) GetVrfs() []*Vrf {
+func (x *VniMappingListResponse) GetMappings() []*VniMapping {
 	if x != nil {
-		return x.Vrfs
+		return x.Mappings
 	}
 	return nil
 }
 
-// Bridge represents a Linux bridge device managed by Vinbero.
-// Used by End.DT2 for FDB miss flooding, and by FDBWatcher for MAC learning sync.
-type Bridge struct {
+type VniMappingFlushRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Name    string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`              // Bridge device name (e.g., "br100")
-	BdId    uint32   `protobuf:"varint,2,opt,name=bd_id,json=bdId,proto3" json:"bd_id,omitempty"` // Bridge Domain ID for FDB scoping and FDBWatcher registration
-	Members []string `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`        // Interfaces to enslave to this bridge
 }
 
-func (x *Bridge) Reset() {
-	*x = Bridge{}
+func (x *VniMappingFlushRequest) Reset() {
+	*x = VniMappingFlushRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[78]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[86]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Bridge) String() string {
+func (x *VniMappingFlushRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Bridge) ProtoMessage() {}
+func (*VniMappingFlushRequest) ProtoMessage() {}
 
-func (x *Bridge) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[78]
+func (x *VniMappingFlushRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[86]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4229,57 +5018,36 @@ func (x *Bridge) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Bridge.ProtoReflect.Descriptor instead.
-func (*Bridge) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{78}
-}
-
-func (x *Bridge) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *Bridge) GetBdId() uint32 {
-	if x != nil {
-		return x.BdId
-	}
-	return 0
-}
-
-func (x *Bridge) GetMembers() []string {
-	if x != nil {
-		return x.Members
-	}
-	return nil
+// Deprecated: Use VniMappingFlushRequest.ProtoReflect.Descriptor instead.
+func (*VniMappingFlushRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{86}
 }
 
-type BridgeCreateRequest struct {
+type VniMappingFlushResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Bridges []*Bridge `protobuf:"bytes,1,rep,name=bridges,proto3" json:"bridges,omitempty"`
+	DeletedCount uint32 `protobuf:"varint,1,opt,name=deleted_count,json=deletedCount,proto3" json:"deleted_count,omitempty"`
 }
 
-func (x *BridgeCreateRequest) Reset() {
-	*x = BridgeCreateRequest{}
+func (x *VniMappingFlushResponse) Reset() {
+	*x = VniMappingFlushResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[79]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[87]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BridgeCreateRequest) String() string {
+func (x *VniMappingFlushResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BridgeCreateRequest) ProtoMessage() {}
+func (*VniMappingFlushResponse) ProtoMessage() {}
 
-func (x *BridgeCreateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[79]
+func (x *VniMappingFlushResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[87]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4290,44 +5058,58 @@ func (x *BridgeCreateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BridgeCreateRequest.ProtoReflect.Descriptor instead.
-func (*BridgeCreateRequest) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{79}
+// Deprecated: Use VniMappingFlushResponse.ProtoReflect.Descriptor instead.
+func (*VniMappingFlushResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *BridgeCreateRequest) GetBridges() []*Bridge {
+func (x *VniMappingFlushResponse) GetDeletedCount() uint32 {
 	if x != nil {
-		return x.Bridges
+		return x.DeletedCount
 	}
-	return nil
+	return 0
 }
 
-type BridgeCreateResponse struct {
+// BridgeDomain is a named BD and everything that belongs to it. Members
+// leave bd_id at 0 (or set it to the BD's own bd_id); any other value is
+// rejected. On Get/List the members are read back from the data plane, so
+// entries created for the bd_id through the per-table services show up too.
+type BridgeDomain struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Created []*Bridge         `protobuf:"bytes,1,rep,name=created,proto3" json:"created,omitempty"`
-	Errors  []*OperationError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
-}
-
-func (x *BridgeCreateResponse) Reset() {
-	*x = BridgeCreateResponse{}
+	Name             string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                                 // Unique BD name
+	BdId             uint32             `protobuf:"varint,2,opt,name=bd_id,json=bdId,proto3" json:"bd_id,omitempty"`                                    // Bridge Domain ID (1-65535), unique among named BDs
+	Bridge           *Bridge            `protobuf:"bytes,3,opt,name=bridge,proto3" json:"bridge,omitempty"`                                             // Linux bridge for End.DT2 FDB misses and MAC sync (optional)
+	Acs              []*HeadendL2       `protobuf:"bytes,4,rep,name=acs,proto3" json:"acs,omitempty"`                                                   // Attachment circuits
+	Sids             []*SidFunction     `protobuf:"bytes,5,rep,name=sids,proto3" json:"sids,omitempty"`                                                 // End.DT2 / End.DT2M SIDs; bridge_name defaults to the BD's bridge
+	Peers            []*BdPeer          `protobuf:"bytes,6,rep,name=peers,proto3" json:"peers,omitempty"`                                               // Remote PEs for BUM flooding
+	EthernetSegments []*EthernetSegment `protobuf:"bytes,7,rep,name=ethernet_segments,json=ethernetSegments,proto3" json:"ethernet_segments,omitempty"` // ESs owned by the BD (deleted with it unless still in use)
+	MacLimits        []*MacLimit        `protobuf:"bytes,8,rep,name=mac_limits,json=macLimits,proto3" json:"mac_limits,omitempty"`                      // BD-scope (interface_name empty) and AC-scope learning controls
+	FdbEntries       uint32             `protobuf:"varint,9,opt,name=fdb_entries,json=fdbEntries,proto3" json:"fdb_entries,omitempty"`                  // Output only: FDB entries currently in the BD
+	Neighbors        []*Neighbor        `protobuf:"bytes,10,rep,name=neighbors,proto3" json:"neighbors,omitempty"`                                      // Static IP-to-MAC bindings; Get/List show all origins
+	Irb              *Irb               `protobuf:"bytes,11,opt,name=irb,proto3" json:"irb,omitempty"`                                                  // Routing into a VRF through an anycast gateway (optional, requires bridge)
+	StormControls    []*StormControl    `protobuf:"bytes,12,rep,name=storm_controls,json=stormControls,proto3" json:"storm_controls,omitempty"`         // BD-scope (interface_name empty) and AC-scope BUM rate limits
+}
+
+func (x *BridgeDomain) Reset() {
+	*x = BridgeDomain{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[80]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[88]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BridgeCreateResponse) String() string {
+func (x *BridgeDomain) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BridgeCreateResponse) ProtoMessage() {}
+func (*BridgeDomain) ProtoMessage() {}
 
-func (x *BridgeCreateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[80]
+func (x *BridgeDomain) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[88]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4338,50 +5120,120 @@ func (x *BridgeCreateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BridgeCreateResponse.ProtoReflect.Descriptor instead.
-func (*BridgeCreateResponse) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{80}
+// Deprecated: Use BridgeDomain.ProtoReflect.Descriptor instead.
+func (*BridgeDomain) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *BridgeCreateResponse) GetCreated() []*Bridge {
+func (x *BridgeDomain) GetName() string {
 	if x != nil {
-		return x.Created
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BridgeDomain) GetBdId() uint32 {
+	if x != nil {
+		return x.BdId
+	}
+	return 0
+}
+
+func (x *BridgeDomain) GetBridge() *Bridge {
+	if x != nil {
+		return x.Bridge
 	}
 	return nil
 }
 
-func (x *BridgeCreateResponse) GetErrors() []*OperationError {
+func (x *BridgeDomain) GetAcs() []*HeadendL2 {
 	if x != nil {
-		return x.Errors
+		return x.Acs
 	}
 	return nil
 }
 
-type BridgeDeleteRequest struct {
+func (x *BridgeDomain) GetSids() []*SidFunction {
+	if x != nil {
+		return x.Sids
+	}
+	return nil
+}
+
+func (x *BridgeDomain) GetPeers() []*BdPeer {
+	if x != nil {
+		return x.Peers
+	}
+	return nil
+}
+
+func (x *BridgeDomain) GetEthernetSegments() []*EthernetSegment {
+	if x != nil {
+		return x.EthernetSegments
+	}
+	return nil
+}
+
+func (x *BridgeDomain) GetMacLimits() []*MacLimit {
+	if x != nil {
+		return x.MacLimits
+	}
+	return nil
+}
+
+func (x *BridgeDomain) GetFdbEntries() uint32 {
+	if x != nil {
+		return x.FdbEntries
+	}
+	return 0
+}
+
+func (x *BridgeDomain) GetNeighbors() []*Neighbor {
+	if x != nil {
+		return x.Neighbors
+	}
+	return nil
+}
+
+func (x *BridgeDomain) GetIrb() *Irb {
+	if x != nil {
+		return x.Irb
+	}
+	return nil
+}
+
+func (x *BridgeDomain) GetStormControls() []*StormControl {
+	if x != nil {
+		return x.StormControls
+	}
+	return nil
+}
+
+type BridgeDomainCreateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Names []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"` // Bridge names to delete; fails if referenced by a SID
+	BridgeDomains []*BridgeDomain `protobuf:"bytes,1,rep,name=bridge_domains,json=bridgeDomains,proto3" json:"bridge_domains,omitempty"`
 }
 
-func (x *BridgeDeleteRequest) Reset() {
-	*x = BridgeDeleteRequest{}
+func (x *BridgeDomainCreateRequest) Reset() {
+	*x = BridgeDomainCreateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[81]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[89]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BridgeDeleteRequest) String() string {
+func (x *BridgeDomainCreateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BridgeDeleteRequest) ProtoMessage() {}
+func (*BridgeDomainCreateRequest) ProtoMessage() {}
 
-func (x *BridgeDeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[81]
+func (x *BridgeDomainCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[89]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4392,44 +5244,44 @@ func (x *BridgeDeleteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BridgeDeleteRequest.ProtoReflect.Descriptor instead.
-func (*BridgeDeleteRequest) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{81}
+// Deprecated: Use BridgeDomainCreateRequest.ProtoReflect.Descriptor instead.
+func (*BridgeDomainCreateRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *BridgeDeleteRequest) GetNames() []string {
+func (x *BridgeDomainCreateRequest) GetBridgeDomains() []*BridgeDomain {
 	if x != nil {
-		return x.Names
+		return x.BridgeDomains
 	}
 	return nil
 }
 
-type BridgeDeleteResponse struct {
+type BridgeDomainCreateResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DeletedNames []string          `protobuf:"bytes,1,rep,name=deleted_names,json=deletedNames,proto3" json:"deleted_names,omitempty"`
-	Errors       []*OperationError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	Created []*BridgeDomain   `protobuf:"bytes,1,rep,name=created,proto3" json:"created,omitempty"`
+	Errors  []*OperationError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"` // A BD that fails is rolled back as a whole
 }
 
-func (x *BridgeDeleteResponse) Reset() {
-	*x = BridgeDeleteResponse{}
+func (x *BridgeDomainCreateResponse) Reset() {
+	*x = BridgeDomainCreateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[82]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[90]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BridgeDeleteResponse) String() string {
+func (x *BridgeDomainCreateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BridgeDeleteResponse) ProtoMessage() {}
+func (*BridgeDomainCreateResponse) ProtoMessage() {}
 
-func (x *BridgeDeleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[82]
+func (x *BridgeDomainCreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[90]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4440,48 +5292,50 @@ func (x *BridgeDeleteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BridgeDeleteResponse.ProtoReflect.Descriptor instead.
-func (*BridgeDeleteResponse) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{82}
+// Deprecated: Use BridgeDomainCreateResponse.ProtoReflect.Descriptor instead.
+func (*BridgeDomainCreateResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *BridgeDeleteResponse) GetDeletedNames() []string {
+func (x *BridgeDomainCreateResponse) GetCreated() []*BridgeDomain {
 	if x != nil {
-		return x.DeletedNames
+		return x.Created
 	}
 	return nil
 }
 
-func (x *BridgeDeleteResponse) GetErrors() []*OperationError {
+func (x *BridgeDomainCreateResponse) GetErrors() []*OperationError {
 	if x != nil {
 		return x.Errors
 	}
 	return nil
 }
 
-type BridgeListRequest struct {
+type BridgeDomainDeleteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Names []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"` // BDs to delete, cascading to all their members
 }
 
-func (x *BridgeListRequest) Reset() {
-	*x = BridgeListRequest{}
+func (x *BridgeDomainDeleteRequest) Reset() {
+	*x = BridgeDomainDeleteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[83]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[91]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BridgeListRequest) String() string {
+func (x *BridgeDomainDeleteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BridgeListRequest) ProtoMessage() {}
+func (*BridgeDomainDeleteRequest) ProtoMessage() {}
 
-func (x *BridgeListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[83]
+func (x *BridgeDomainDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[91]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4492,36 +5346,44 @@ func (x *BridgeListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BridgeListRequest.ProtoReflect.Descriptor instead.
-func (*BridgeListRequest) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{83}
+// Deprecated: Use BridgeDomainDeleteRequest.ProtoReflect.Descriptor instead.
+func (*BridgeDomainDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{91}
 }
 
-type BridgeListResponse struct {
+func (x *BridgeDomainDeleteRequest) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+type BridgeDomainDeleteResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Bridges []*Bridge `protobuf:"bytes,1,rep,name=bridges,proto3" json:"bridges,omitempty"`
+	DeletedNames []string          `protobuf:"bytes,1,rep,name=deleted_names,json=deletedNames,proto3" json:"deleted_names,omitempty"`
+	Errors       []*OperationError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
 }
 
-func (x *BridgeListResponse) Reset() {
-	*x = BridgeListResponse{}
+func (x *BridgeDomainDeleteResponse) Reset() {
+	*x = BridgeDomainDeleteResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[84]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[92]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BridgeListResponse) String() string {
+func (x *BridgeDomainDeleteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BridgeListResponse) ProtoMessage() {}
+func (*BridgeDomainDeleteResponse) ProtoMessage() {}
 
-func (x *BridgeListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[84]
+func (x *BridgeDomainDeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[92]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4532,52 +5394,50 @@ func (x *BridgeListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BridgeListResponse.ProtoReflect.Descriptor instead.
-func (*BridgeListResponse) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{84}
+// Deprecated: Use BridgeDomainDeleteResponse.ProtoReflect.Descriptor instead.
+func (*BridgeDomainDeleteResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *BridgeListResponse) GetBridges() []*Bridge {
+func (x *BridgeDomainDeleteResponse) GetDeletedNames() []string {
 	if x != nil {
-		return x.Bridges
+		return x.DeletedNames
 	}
 	return nil
 }
 
-// HeadendL2 represents a port+VLAN → SRv6 L2 encapsulation rule.
-// When bd_id is 0, all frames are encapsulated directly (P2P mode, no MAC learning).
-// When bd_id > 0, MAC learning and FDB-based forwarding are enabled (P2MP mode).
-type HeadendL2 struct {
+func (x *BridgeDomainDeleteResponse) GetErrors() []*OperationError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type BridgeDomainGetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	VlanId        uint32              `protobuf:"varint,1,opt,name=vlan_id,json=vlanId,proto3" json:"vlan_id,omitempty"`                     // VLAN ID to match (0 for untagged traffic)
-	SrcAddr       string              `protobuf:"bytes,2,opt,name=src_addr,json=srcAddr,proto3" json:"src_addr,omitempty"`                   // Outer IPv6 source address for SRv6 encap
-	Segments      []string            `protobuf:"bytes,3,rep,name=segments,proto3" json:"segments,omitempty"`                                // SRv6 segment list
-	BdId          uint32              `protobuf:"varint,4,opt,name=bd_id,json=bdId,proto3" json:"bd_id,omitempty"`                           // Bridge Domain ID; 0 = direct encap (no BD), >0 = MAC learning enabled
-	InterfaceName string              `protobuf:"bytes,5,opt,name=interface_name,json=interfaceName,proto3" json:"interface_name,omitempty"` // Customer-facing interface name (resolved to ifindex internally)
-	Mode          Srv6HeadendBehavior `protobuf:"varint,6,opt,name=mode,proto3,enum=vinbero.v1.Srv6HeadendBehavior" json:"mode,omitempty"`   // Headend mode (default: H_ENCAPS_L2, or H_ENCAPS_L2_RED for Reduced SRH)
-	Esi           string              `protobuf:"bytes,7,opt,name=esi,proto3" json:"esi,omitempty"`                                          // RFC 7432 Ethernet Segment Identifier of the local AC (empty=single-homing)
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *HeadendL2) Reset() {
-	*x = HeadendL2{}
+func (x *BridgeDomainGetRequest) Reset() {
+	*x = BridgeDomainGetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[85]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[93]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *HeadendL2) String() string {
+func (x *BridgeDomainGetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HeadendL2) ProtoMessage() {}
+func (*BridgeDomainGetRequest) ProtoMessage() {}
 
-func (x *HeadendL2) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[85]
+func (x *BridgeDomainGetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[93]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4588,85 +5448,88 @@ func (x *HeadendL2) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HeadendL2.ProtoReflect.Descriptor instead.
-func (*HeadendL2) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{85}
+// Deprecated: Use BridgeDomainGetRequest.ProtoReflect.Descriptor instead.
+func (*BridgeDomainGetRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *HeadendL2) GetVlanId() uint32 {
+func (x *BridgeDomainGetRequest) GetName() string {
 	if x != nil {
-		return x.VlanId
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *HeadendL2) GetSrcAddr() string {
-	if x != nil {
-		return x.SrcAddr
-	}
-	return ""
+type BridgeDomainGetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BridgeDomain *BridgeDomain `protobuf:"bytes,1,opt,name=bridge_domain,json=bridgeDomain,proto3" json:"bridge_domain,omitempty"`
 }
 
-func (x *HeadendL2) GetSegments() []string {
-	if x != nil {
-		return x.Segments
+func (x *BridgeDomainGetResponse) Reset() {
+	*x = BridgeDomainGetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *HeadendL2) GetBdId() uint32 {
-	if x != nil {
-		return x.BdId
-	}
-	return 0
+func (x *BridgeDomainGetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *HeadendL2) GetInterfaceName() string {
-	if x != nil {
-		return x.InterfaceName
+func (*BridgeDomainGetResponse) ProtoMessage() {}
+
+func (x *BridgeDomainGetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *HeadendL2) GetMode() Srv6HeadendBehavior {
-	if x != nil {
-		return x.Mode
-	}
-	return Srv6HeadendBehavior_SRV6_HEADEND_BEHAVIOR_UNSPECIFIED
+// Deprecated: Use BridgeDomainGetResponse.ProtoReflect.Descriptor instead.
+func (*BridgeDomainGetResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *HeadendL2) GetEsi() string {
+func (x *BridgeDomainGetResponse) GetBridgeDomain() *BridgeDomain {
 	if x != nil {
-		return x.Esi
+		return x.BridgeDomain
 	}
-	return ""
+	return nil
 }
 
-type HeadendL2CreateRequest struct {
+type BridgeDomainListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	HeadendL2S []*HeadendL2 `protobuf:"bytes,1,rep,name=headend_l2s,json=headendL2s,proto3" json:"headend_l2s,omitempty"`
 }
 
-func (x *HeadendL2CreateRequest) Reset() {
-	*x = HeadendL2CreateRequest{}
+func (x *BridgeDomainListRequest) Reset() {
+	*x = BridgeDomainListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[86]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[95]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *HeadendL2CreateRequest) String() string {
+func (x *BridgeDomainListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HeadendL2CreateRequest) ProtoMessage() {}
+func (*BridgeDomainListRequest) ProtoMessage() {}
 
-func (x *HeadendL2CreateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[86]
+func (x *BridgeDomainListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[95]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4677,44 +5540,36 @@ func (x *HeadendL2CreateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HeadendL2CreateRequest.ProtoReflect.Descriptor instead.
-func (*HeadendL2CreateRequest) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{86}
-}
-
-func (x *HeadendL2CreateRequest) GetHeadendL2S() []*HeadendL2 {
-	if x != nil {
-		return x.HeadendL2S
-	}
-	return nil
+// Deprecated: Use BridgeDomainListRequest.ProtoReflect.Descriptor instead.
+func (*BridgeDomainListRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{95}
 }
 
-type HeadendL2CreateResponse struct {
+type BridgeDomainListResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Created []*HeadendL2      `protobuf:"bytes,1,rep,name=created,proto3" json:"created,omitempty"`
-	Errors  []*OperationError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	BridgeDomains []*BridgeDomain `protobuf:"bytes,1,rep,name=bridge_domains,json=bridgeDomains,proto3" json:"bridge_domains,omitempty"`
 }
 
-func (x *HeadendL2CreateResponse) Reset() {
-	*x = HeadendL2CreateResponse{}
+func (x *BridgeDomainListResponse) Reset() {
+	*x = BridgeDomainListResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[87]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[96]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *HeadendL2CreateResponse) String() string {
+func (x *BridgeDomainListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HeadendL2CreateResponse) ProtoMessage() {}
+func (*BridgeDomainListResponse) ProtoMessage() {}
 
-func (x *HeadendL2CreateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[87]
+func (x *BridgeDomainListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[96]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4725,51 +5580,53 @@ func (x *HeadendL2CreateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HeadendL2CreateResponse.ProtoReflect.Descriptor instead.
-func (*HeadendL2CreateResponse) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{87}
-}
-
-func (x *HeadendL2CreateResponse) GetCreated() []*HeadendL2 {
-	if x != nil {
-		return x.Created
-	}
-	return nil
+// Deprecated: Use BridgeDomainListResponse.ProtoReflect.Descriptor instead.
+func (*BridgeDomainListResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *HeadendL2CreateResponse) GetErrors() []*OperationError {
+func (x *BridgeDomainListResponse) GetBridgeDomains() []*BridgeDomain {
 	if x != nil {
-		return x.Errors
+		return x.BridgeDomains
 	}
 	return nil
 }
 
-type HeadendL2DeleteTarget struct {
+// MacLimit holds the learning controls of one BD (interface_name empty) or
+// one AC (interface_name + vlan_id), together with its current usage.
+type MacLimit struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InterfaceName string `protobuf:"bytes,1,opt,name=interface_name,json=interfaceName,proto3" json:"interface_name,omitempty"`
-	VlanId        uint32 `protobuf:"varint,2,opt,name=vlan_id,json=vlanId,proto3" json:"vlan_id,omitempty"`
+	BdId             uint32         `protobuf:"varint,1,opt,name=bd_id,json=bdId,proto3" json:"bd_id,omitempty"`                                                     // Bridge Domain ID (BD scope); for an AC, filled in from its HeadendL2 on list
+	InterfaceName    string         `protobuf:"bytes,2,opt,name=interface_name,json=interfaceName,proto3" json:"interface_name,omitempty"`                           // AC scope: customer-facing interface
+	VlanId           uint32         `protobuf:"varint,3,opt,name=vlan_id,json=vlanId,proto3" json:"vlan_id,omitempty"`                                               // AC scope: VLAN ID (0 for untagged)
+	MaxMacs          uint32         `protobuf:"varint,4,opt,name=max_macs,json=maxMacs,proto3" json:"max_macs,omitempty"`                                            // Maximum dynamic MACs (0 = unlimited)
+	LearningDisabled bool           `protobuf:"varint,5,opt,name=learning_disabled,json=learningDisabled,proto3" json:"learning_disabled,omitempty"`                 // Never learn source MACs
+	LimitAction      MacLimitAction `protobuf:"varint,6,opt,name=limit_action,json=limitAction,proto3,enum=vinbero.v1.MacLimitAction" json:"limit_action,omitempty"` // Action at max_macs
+	MacCount         uint64         `protobuf:"varint,7,opt,name=mac_count,json=macCount,proto3" json:"mac_count,omitempty"`                                         // Output only: dynamic MACs currently learned
+	Configured       bool           `protobuf:"varint,8,opt,name=configured,proto3" json:"configured,omitempty"`                                                     // Output only: false for BDs/ACs listed only for their usage
+	PortMode         bool           `protobuf:"varint,9,opt,name=port_mode,json=portMode,proto3" json:"port_mode,omitempty"`                                         // AC scope: the port-mode AC of interface_name (vlan_id must be 0)
 }
 
-func (x *HeadendL2DeleteTarget) Reset() {
-	*x = HeadendL2DeleteTarget{}
+func (x *MacLimit) Reset() {
+	*x = MacLimit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[88]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[97]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *HeadendL2DeleteTarget) String() string {
+func (x *MacLimit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HeadendL2DeleteTarget) ProtoMessage() {}
+func (*MacLimit) ProtoMessage() {}
 
-func (x *HeadendL2DeleteTarget) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[88]
+func (x *MacLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[97]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4780,50 +5637,99 @@ func (x *HeadendL2DeleteTarget) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HeadendL2DeleteTarget.ProtoReflect.Descriptor instead.
-func (*HeadendL2DeleteTarget) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{88}
+// Deprecated: Use MacLimit.ProtoReflect.Descriptor instead.
+func (*MacLimit) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *HeadendL2DeleteTarget) GetInterfaceName() string {
+func (x *MacLimit) GetBdId() uint32 {
+	if x != nil {
+		return x.BdId
+	}
+	return 0
+}
+
+func (x *MacLimit) GetInterfaceName() string {
 	if x != nil {
 		return x.InterfaceName
 	}
 	return ""
 }
 
-func (x *HeadendL2DeleteTarget) GetVlanId() uint32 {
+func (x *MacLimit) GetVlanId() uint32 {
 	if x != nil {
 		return x.VlanId
 	}
 	return 0
 }
 
-type HeadendL2DeleteRequest struct {
+func (x *MacLimit) GetMaxMacs() uint32 {
+	if x != nil {
+		return x.MaxMacs
+	}
+	return 0
+}
+
+func (x *MacLimit) GetLearningDisabled() bool {
+	if x != nil {
+		return x.LearningDisabled
+	}
+	return false
+}
+
+func (x *MacLimit) GetLimitAction() MacLimitAction {
+	if x != nil {
+		return x.LimitAction
+	}
+	return MacLimitAction_MAC_LIMIT_ACTION_UNSPECIFIED
+}
+
+func (x *MacLimit) GetMacCount() uint64 {
+	if x != nil {
+		return x.MacCount
+	}
+	return 0
+}
+
+func (x *MacLimit) GetConfigured() bool {
+	if x != nil {
+		return x.Configured
+	}
+	return false
+}
+
+func (x *MacLimit) GetPortMode() bool {
+	if x != nil {
+		return x.PortMode
+	}
+	return false
+}
+
+type MacLimitSetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Targets []*HeadendL2DeleteTarget `protobuf:"bytes,1,rep,name=targets,proto3" json:"targets,omitempty"`
+	Limits []*MacLimit `protobuf:"bytes,1,rep,name=limits,proto3" json:"limits,omitempty"`
 }
 
-func (x *HeadendL2DeleteRequest) Reset() {
-	*x = HeadendL2DeleteRequest{}
+func (x *MacLimitSetRequest) Reset() {
+	*x = MacLimitSetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[89]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[98]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *HeadendL2DeleteRequest) String() string {
+func (x *MacLimitSetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HeadendL2DeleteRequest) ProtoMessage() {}
+func (*MacLimitSetRequest) ProtoMessage() {}
 
-func (x *HeadendL2DeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[89]
+func (x *MacLimitSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[98]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4834,44 +5740,44 @@ func (x *HeadendL2DeleteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HeadendL2DeleteRequest.ProtoReflect.Descriptor instead.
-func (*HeadendL2DeleteRequest) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{89}
+// Deprecated: Use MacLimitSetRequest.ProtoReflect.Descriptor instead.
+func (*MacLimitSetRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *HeadendL2DeleteRequest) GetTargets() []*HeadendL2DeleteTarget {
+func (x *MacLimitSetRequest) GetLimits() []*MacLimit {
 	if x != nil {
-		return x.Targets
+		return x.Limits
 	}
 	return nil
 }
 
-type HeadendL2DeleteResponse struct {
+type MacLimitSetResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Deleted []*HeadendL2DeleteTarget `protobuf:"bytes,1,rep,name=deleted,proto3" json:"deleted,omitempty"`
-	Errors  []*OperationError        `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	Set    []*MacLimit       `protobuf:"bytes,1,rep,name=set,proto3" json:"set,omitempty"`
+	Errors []*OperationError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
 }
 
-func (x *HeadendL2DeleteResponse) Reset() {
-	*x = HeadendL2DeleteResponse{}
+func (x *MacLimitSetResponse) Reset() {
+	*x = MacLimitSetResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[90]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[99]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *HeadendL2DeleteResponse) String() string {
+func (x *MacLimitSetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HeadendL2DeleteResponse) ProtoMessage() {}
+func (*MacLimitSetResponse) ProtoMessage() {}
 
-func (x *HeadendL2DeleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[90]
+func (x *MacLimitSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[99]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4882,48 +5788,50 @@ func (x *HeadendL2DeleteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HeadendL2DeleteResponse.ProtoReflect.Descriptor instead.
-func (*HeadendL2DeleteResponse) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{90}
+// Deprecated: Use MacLimitSetResponse.ProtoReflect.Descriptor instead.
+func (*MacLimitSetResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *HeadendL2DeleteResponse) GetDeleted() []*HeadendL2DeleteTarget {
+func (x *MacLimitSetResponse) GetSet() []*MacLimit {
 	if x != nil {
-		return x.Deleted
+		return x.Set
 	}
 	return nil
 }
 
-func (x *HeadendL2DeleteResponse) GetErrors() []*OperationError {
+func (x *MacLimitSetResponse) GetErrors() []*OperationError {
 	if x != nil {
 		return x.Errors
 	}
 	return nil
 }
 
-type HeadendL2ListRequest struct {
+type MacLimitDeleteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Limits []*MacLimit `protobuf:"bytes,1,rep,name=limits,proto3" json:"limits,omitempty"` // Only the scope fields (bd_id or interface_name + vlan_id) are used
 }
 
-func (x *HeadendL2ListRequest) Reset() {
-	*x = HeadendL2ListRequest{}
+func (x *MacLimitDeleteRequest) Reset() {
+	*x = MacLimitDeleteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[91]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[100]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *HeadendL2ListRequest) String() string {
+func (x *MacLimitDeleteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HeadendL2ListRequest) ProtoMessage() {}
+func (*MacLimitDeleteRequest) ProtoMessage() {}
 
-func (x *HeadendL2ListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[91]
+func (x *MacLimitDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[100]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4934,36 +5842,44 @@ func (x *HeadendL2ListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HeadendL2ListRequest.ProtoReflect.Descriptor instead.
-func (*HeadendL2ListRequest) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{91}
+// Deprecated: Use MacLimitDeleteRequest.ProtoReflect.Descriptor instead.
+func (*MacLimitDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{100}
 }
 
-type HeadendL2ListResponse struct {
-	state         protoimpl.MessageState
+func (x *MacLimitDeleteRequest) GetLimits() []*MacLimit {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+type MacLimitDeleteResponse struct {
+	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	HeadendL2S []*HeadendL2 `protobuf:"bytes,1,rep,name=headend_l2s,json=headendL2s,proto3" json:"headend_l2s,omitempty"`
+	Deleted []*MacLimit       `protobuf:"bytes,1,rep,name=deleted,proto3" json:"deleted,omitempty"`
+	Errors  []*OperationError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
 }
 
-func (x *HeadendL2ListResponse) Reset() {
-	*x = HeadendL2ListResponse{}
+func (x *MacLimitDeleteResponse) Reset() {
+	*x = MacLimitDeleteResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[92]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[101]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *HeadendL2ListResponse) String() string {
+func (x *MacLimitDeleteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HeadendL2ListResponse) ProtoMessage() {}
+func (*MacLimitDeleteResponse) ProtoMessage() {}
 
-func (x *HeadendL2ListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[92]
+func (x *MacLimitDeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[101]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4974,44 +5890,50 @@ func (x *HeadendL2ListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HeadendL2ListResponse.ProtoReflect.Descriptor instead.
-func (*HeadendL2ListResponse) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{92}
+// Deprecated: Use MacLimitDeleteResponse.ProtoReflect.Descriptor instead.
+func (*MacLimitDeleteResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{101}
 }
 
-func (x *HeadendL2ListResponse) GetHeadendL2S() []*HeadendL2 {
+func (x *MacLimitDeleteResponse) GetDeleted() []*MacLimit {
 	if x != nil {
-		return x.HeadendL2S
+		return x.Deleted
 	}
 	return nil
 }
 
-type HeadendL2GetRequest struct {
+func (x *MacLimitDeleteResponse) GetErrors() []*OperationError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type MacLimitListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InterfaceName string `protobuf:"bytes,1,opt,name=interface_name,json=interfaceName,proto3" json:"interface_name,omitempty"`
-	VlanId        uint32 `protobuf:"varint,2,opt,name=vlan_id,json=vlanId,proto3" json:"vlan_id,omitempty"`
+	BdId uint32 `protobuf:"varint,1,opt,name=bd_id,json=bdId,proto3" json:"bd_id,omitempty"` // Filter by BD ID; 0 returns all
 }
 
-func (x *HeadendL2GetRequest) Reset() {
-	*x = HeadendL2GetRequest{}
+func (x *MacLimitListRequest) Reset() {
+	*x = MacLimitListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[93]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[102]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *HeadendL2GetRequest) String() string {
+func (x *MacLimitListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HeadendL2GetRequest) ProtoMessage() {}
+func (*MacLimitListRequest) ProtoMessage() {}
 
-func (x *HeadendL2GetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[93]
+func (x *MacLimitListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[102]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5022,50 +5944,43 @@ func (x *HeadendL2GetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HeadendL2GetRequest.ProtoReflect.Descriptor instead.
-func (*HeadendL2GetRequest) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{93}
-}
-
-func (x *HeadendL2GetRequest) GetInterfaceName() string {
-	if x != nil {
-		return x.InterfaceName
-	}
-	return ""
+// Deprecated: Use MacLimitListRequest.ProtoReflect.Descriptor instead.
+func (*MacLimitListRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{102}
 }
 
-func (x *HeadendL2GetRequest) GetVlanId() uint32 {
+func (x *MacLimitListRequest) GetBdId() uint32 {
 	if x != nil {
-		return x.VlanId
+		return x.BdId
 	}
 	return 0
 }
 
-type HeadendL2GetResponse struct {
+type MacLimitListResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	HeadendL2 *HeadendL2 `protobuf:"bytes,1,opt,name=headend_l2,json=headendL2,proto3" json:"headend_l2,omitempty"`
+	Limits []*MacLimit `protobuf:"bytes,1,rep,name=limits,proto3" json:"limits,omitempty"`
 }
 
-func (x *HeadendL2GetResponse) Reset() {
-	*x = HeadendL2GetResponse{}
+func (x *MacLimitListResponse) Reset() {
+	*x = MacLimitListResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[94]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[103]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *HeadendL2GetResponse) String() string {
+func (x *MacLimitListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HeadendL2GetResponse) ProtoMessage() {}
+func (*MacLimitListResponse) ProtoMessage() {}
 
-func (x *HeadendL2GetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[94]
+func (x *MacLimitListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[103]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5076,41 +5991,55 @@ func (x *HeadendL2GetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HeadendL2GetResponse.ProtoReflect.Descriptor instead.
-func (*HeadendL2GetResponse) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{94}
+// Deprecated: Use MacLimitListResponse.ProtoReflect.Descriptor instead.
+func (*MacLimitListResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{103}
 }
 
-func (x *HeadendL2GetResponse) GetHeadendL2() *HeadendL2 {
+func (x *MacLimitListResponse) GetLimits() []*MacLimit {
 	if x != nil {
-		return x.HeadendL2
+		return x.Limits
 	}
 	return nil
 }
 
-type HeadendL2FlushRequest struct {
+// StormControl holds the BUM rate limit of one BD (interface_name empty) or
+// one AC (interface_name + vlan_id), together with its drop counters. Each
+// rate is a token bucket holding one second of traffic; 0 leaves that
+// dimension unlimited. An AC's frames must pass both its own limit and the
+// BD's.
+type StormControl struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	BdId           uint32 `protobuf:"varint,1,opt,name=bd_id,json=bdId,proto3" json:"bd_id,omitempty"`                               // Bridge Domain ID (BD scope); for an AC, filled in from its HeadendL2 on list
+	InterfaceName  string `protobuf:"bytes,2,opt,name=interface_name,json=interfaceName,proto3" json:"interface_name,omitempty"`     // AC scope: customer-facing interface
+	VlanId         uint32 `protobuf:"varint,3,opt,name=vlan_id,json=vlanId,proto3" json:"vlan_id,omitempty"`                         // AC scope: VLAN ID (0 for untagged)
+	PortMode       bool   `protobuf:"varint,4,opt,name=port_mode,json=portMode,proto3" json:"port_mode,omitempty"`                   // AC scope: the port-mode AC of interface_name (vlan_id must be 0)
+	PacketsPerSec  uint64 `protobuf:"varint,5,opt,name=packets_per_sec,json=packetsPerSec,proto3" json:"packets_per_sec,omitempty"`  // BUM packets per second (0 = unlimited)
+	BytesPerSec    uint64 `protobuf:"varint,6,opt,name=bytes_per_sec,json=bytesPerSec,proto3" json:"bytes_per_sec,omitempty"`        // BUM bytes per second (0 = unlimited)
+	DroppedPackets uint64 `protobuf:"varint,7,opt,name=dropped_packets,json=droppedPackets,proto3" json:"dropped_packets,omitempty"` // Output only: BUM frames dropped by this limit
+	DroppedBytes   uint64 `protobuf:"varint,8,opt,name=dropped_bytes,json=droppedBytes,proto3" json:"dropped_bytes,omitempty"`       // Output only: bytes of those frames
 }
 
-func (x *HeadendL2FlushRequest) Reset() {
-	*x = HeadendL2FlushRequest{}
+func (x *StormControl) Reset() {
+	*x = StormControl{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[95]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[104]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *HeadendL2FlushRequest) String() string {
+func (x *StormControl) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HeadendL2FlushRequest) ProtoMessage() {}
+func (*StormControl) ProtoMessage() {}
 
-func (x *HeadendL2FlushRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[95]
+func (x *StormControl) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[104]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5121,36 +6050,92 @@ func (x *HeadendL2FlushRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HeadendL2FlushRequest.ProtoReflect.Descriptor instead.
-func (*HeadendL2FlushRequest) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{95}
+// Deprecated: Use StormControl.ProtoReflect.Descriptor instead.
+func (*StormControl) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{104}
 }
 
-type HeadendL2FlushResponse struct {
+func (x *StormControl) GetBdId() uint32 {
+	if x != nil {
+		return x.BdId
+	}
+	return 0
+}
+
+func (x *StormControl) GetInterfaceName() string {
+	if x != nil {
+		return x.InterfaceName
+	}
+	return ""
+}
+
+func (x *StormControl) GetVlanId() uint32 {
+	if x != nil {
+		return x.VlanId
+	}
+	return 0
+}
+
+func (x *StormControl) GetPortMode() bool {
+	if x != nil {
+		return x.PortMode
+	}
+	return false
+}
+
+func (x *StormControl) GetPacketsPerSec() uint64 {
+	if x != nil {
+		return x.PacketsPerSec
+	}
+	return 0
+}
+
+func (x *StormControl) GetBytesPerSec() uint64 {
+	if x != nil {
+		return x.BytesPerSec
+	}
+	return 0
+}
+
+func (x *StormControl) GetDroppedPackets() uint64 {
+	if x != nil {
+		return x.DroppedPackets
+	}
+	return 0
+}
+
+func (x *StormControl) GetDroppedBytes() uint64 {
+	if x != nil {
+		return x.DroppedBytes
+	}
+	return 0
+}
+
+type StormControlSetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DeletedCount uint32 `protobuf:"varint,1,opt,name=deleted_count,json=deletedCount,proto3" json:"deleted_count,omitempty"`
+	StormControls []*StormControl `protobuf:"bytes,1,rep,name=storm_controls,json=stormControls,proto3" json:"storm_controls,omitempty"`
 }
 
-func (x *HeadendL2FlushResponse) Reset() {
-	*x = HeadendL2FlushResponse{}
+func (x *StormControlSetRequest) Reset() {
+	*x = StormControlSetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[96]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[105]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *HeadendL2FlushResponse) String() string {
+func (x *StormControlSetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HeadendL2FlushResponse) ProtoMessage() {}
+func (*StormControlSetRequest) ProtoMessage() {}
 
-func (x *HeadendL2FlushResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[96]
+func (x *StormControlSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[105]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5161,45 +6146,44 @@ func (x *HeadendL2FlushResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HeadendL2FlushResponse.ProtoReflect.Descriptor instead.
-func (*HeadendL2FlushResponse) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{96}
+// Deprecated: Use StormControlSetRequest.ProtoReflect.Descriptor instead.
+func (*StormControlSetRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *HeadendL2FlushResponse) GetDeletedCount() uint32 {
+func (x *StormControlSetRequest) GetStormControls() []*StormControl {
 	if x != nil {
-		return x.DeletedCount
+		return x.StormControls
 	}
-	return 0
+	return nil
 }
 
-type StatsCounter struct {
+type StormControlSetResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Packets uint64 `protobuf:"varint,2,opt,name=packets,proto3" json:"packets,omitempty"`
-	Bytes   uint64 `protobuf:"varint,3,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	Set    []*StormControl   `protobuf:"bytes,1,rep,name=set,proto3" json:"set,omitempty"`
+	Errors []*OperationError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
 }
 
-func (x *StatsCounter) Reset() {
-	*x = StatsCounter{}
+func (x *StormControlSetResponse) Reset() {
+	*x = StormControlSetResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[97]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[106]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StatsCounter) String() string {
+func (x *StormControlSetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatsCounter) ProtoMessage() {}
+func (*StormControlSetResponse) ProtoMessage() {}
 
-func (x *StatsCounter) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[97]
+func (x *StormControlSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[106]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5210,55 +6194,50 @@ func (x *StatsCounter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatsCounter.ProtoReflect.Descriptor instead.
-func (*StatsCounter) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{97}
-}
-
-func (x *StatsCounter) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use StormControlSetResponse.ProtoReflect.Descriptor instead.
+func (*StormControlSetResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{106}
 }
 
-func (x *StatsCounter) GetPackets() uint64 {
+func (x *StormControlSetResponse) GetSet() []*StormControl {
 	if x != nil {
-		return x.Packets
+		return x.Set
 	}
-	return 0
+	return nil
 }
 
-func (x *StatsCounter) GetBytes() uint64 {
+func (x *StormControlSetResponse) GetErrors() []*OperationError {
 	if x != nil {
-		return x.Bytes
+		return x.Errors
 	}
-	return 0
+	return nil
 }
 
-type StatsShowRequest struct {
+type StormControlDeleteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	StormControls []*StormControl `protobuf:"bytes,1,rep,name=storm_controls,json=stormControls,proto3" json:"storm_controls,omitempty"` // Only the scope fields (bd_id or interface_name + vlan_id) are used
 }
 
-func (x *StatsShowRequest) Reset() {
-	*x = StatsShowRequest{}
+func (x *StormControlDeleteRequest) Reset() {
+	*x = StormControlDeleteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[98]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[107]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StatsShowRequest) String() string {
+func (x *StormControlDeleteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatsShowRequest) ProtoMessage() {}
+func (*StormControlDeleteRequest) ProtoMessage() {}
 
-func (x *StatsShowRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[98]
+func (x *StormControlDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[107]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5269,36 +6248,44 @@ func (x *StatsShowRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatsShowRequest.ProtoReflect.Descriptor instead.
-func (*StatsShowRequest) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{98}
+// Deprecated: Use StormControlDeleteRequest.ProtoReflect.Descriptor instead.
+func (*StormControlDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{107}
 }
 
-type StatsShowResponse struct {
+func (x *StormControlDeleteRequest) GetStormControls() []*StormControl {
+	if x != nil {
+		return x.StormControls
+	}
+	return nil
+}
+
+type StormControlDeleteResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Counters []*StatsCounter `protobuf:"bytes,1,rep,name=counters,proto3" json:"counters,omitempty"`
+	Deleted []*StormControl   `protobuf:"bytes,1,rep,name=deleted,proto3" json:"deleted,omitempty"`
+	Errors  []*OperationError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
 }
 
-func (x *StatsShowResponse) Reset() {
-	*x = StatsShowResponse{}
+func (x *StormControlDeleteResponse) Reset() {
+	*x = StormControlDeleteResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[99]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[108]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StatsShowResponse) String() string {
+func (x *StormControlDeleteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatsShowResponse) ProtoMessage() {}
+func (*StormControlDeleteResponse) ProtoMessage() {}
 
-func (x *StatsShowResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[99]
+func (x *StormControlDeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[108]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5309,41 +6296,50 @@ func (x *StatsShowResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatsShowResponse.ProtoReflect.Descriptor instead.
-func (*StatsShowResponse) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{99}
+// Deprecated: Use StormControlDeleteResponse.ProtoReflect.Descriptor instead.
+func (*StormControlDeleteResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{108}
 }
 
-func (x *StatsShowResponse) GetCounters() []*StatsCounter {
+func (x *StormControlDeleteResponse) GetDeleted() []*StormControl {
 	if x != nil {
-		return x.Counters
+		return x.Deleted
 	}
 	return nil
 }
 
-type StatsResetRequest struct {
+func (x *StormControlDeleteResponse) GetErrors() []*OperationError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type StormControlListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	BdId uint32 `protobuf:"varint,1,opt,name=bd_id,json=bdId,proto3" json:"bd_id,omitempty"` // Filter by BD ID; 0 returns all
 }
 
-func (x *StatsResetRequest) Reset() {
-	*x = StatsResetRequest{}
+func (x *StormControlListRequest) Reset() {
+	*x = StormControlListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[100]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[109]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StatsResetRequest) String() string {
+func (x *StormControlListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatsResetRequest) ProtoMessage() {}
+func (*StormControlListRequest) ProtoMessage() {}
 
-func (x *StatsResetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[100]
+func (x *StormControlListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[109]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5354,34 +6350,43 @@ func (x *StatsResetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatsResetRequest.ProtoReflect.Descriptor instead.
-func (*StatsResetRequest) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{100}
+// Deprecated: Use StormControlListRequest.ProtoReflect.Descriptor instead.
+func (*StormControlListRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{109}
 }
 
-type StatsResetResponse struct {
+func (x *StormControlListRequest) GetBdId() uint32 {
+	if x != nil {
+		return x.BdId
+	}
+	return 0
+}
+
+type StormControlListResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	StormControls []*StormControl `protobuf:"bytes,1,rep,name=storm_controls,json=stormControls,proto3" json:"storm_controls,omitempty"`
 }
 
-func (x *StatsResetResponse) Reset() {
-	*x = StatsResetResponse{}
+func (x *StormControlListResponse) Reset() {
+	*x = StormControlListResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[101]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[110]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StatsResetResponse) String() string {
+func (x *StormControlListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatsResetResponse) ProtoMessage() {}
+func (*StormControlListResponse) ProtoMessage() {}
 
-func (x *StatsResetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[101]
+func (x *StormControlListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[110]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5392,42 +6397,47 @@ func (x *StatsResetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatsResetResponse.ProtoReflect.Descriptor instead.
-func (*StatsResetResponse) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{101}
+// Deprecated: Use StormControlListResponse.ProtoReflect.Descriptor instead.
+func (*StormControlListResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{110}
 }
 
-// Per-slot invocation counter entry (one per PROG_ARRAY slot).
-// name: human-readable label (built-in enum name, or "plugin:<program>", or empty for reserved).
-type SlotStatsEntry struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+func (x *StormControlListResponse) GetStormControls() []*StormControl {
+	if x != nil {
+		return x.StormControls
+	}
+	return nil
+}
+
+// Neighbor is one IP-to-MAC binding of a bridge domain.
+type Neighbor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MapType string `protobuf:"bytes,1,opt,name=map_type,json=mapType,proto3" json:"map_type,omitempty"` // "endpoint" | "headend_v4" | "headend_v6"
-	Slot    uint32 `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`                     // 0-63 (endpoint) / 0-31 (headend)
-	Name    string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Packets uint64 `protobuf:"varint,4,opt,name=packets,proto3" json:"packets,omitempty"`
-	Bytes   uint64 `protobuf:"varint,5,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	BdId   uint32         `protobuf:"varint,1,opt,name=bd_id,json=bdId,proto3" json:"bd_id,omitempty"`                        // Bridge Domain ID
+	Ip     string         `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip,omitempty"`                                         // IPv4 or IPv6 address
+	Mac    string         `protobuf:"bytes,3,opt,name=mac,proto3" json:"mac,omitempty"`                                       // MAC address answered for ip
+	Origin NeighborOrigin `protobuf:"varint,4,opt,name=origin,proto3,enum=vinbero.v1.NeighborOrigin" json:"origin,omitempty"` // Output only: entries created through the API are STATIC
 }
 
-func (x *SlotStatsEntry) Reset() {
-	*x = SlotStatsEntry{}
+func (x *Neighbor) Reset() {
+	*x = Neighbor{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[102]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[111]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SlotStatsEntry) String() string {
+func (x *Neighbor) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SlotStatsEntry) ProtoMessage() {}
+func (*Neighbor) ProtoMessage() {}
 
-func (x *SlotStatsEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[102]
+func (x *Neighbor) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[111]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5438,72 +6448,112 @@ func (x *SlotStatsEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SlotStatsEntry.ProtoReflect.Descriptor instead.
-func (*SlotStatsEntry) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{102}
+// Deprecated: Use Neighbor.ProtoReflect.Descriptor instead.
+func (*Neighbor) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{111}
 }
 
-func (x *SlotStatsEntry) GetMapType() string {
+func (x *Neighbor) GetBdId() uint32 {
 	if x != nil {
-		return x.MapType
+		return x.BdId
 	}
-	return ""
+	return 0
 }
 
-func (x *SlotStatsEntry) GetSlot() uint32 {
+func (x *Neighbor) GetIp() string {
 	if x != nil {
-		return x.Slot
+		return x.Ip
 	}
-	return 0
+	return ""
 }
 
-func (x *SlotStatsEntry) GetName() string {
+func (x *Neighbor) GetMac() string {
 	if x != nil {
-		return x.Name
+		return x.Mac
 	}
 	return ""
 }
 
-func (x *SlotStatsEntry) GetPackets() uint64 {
+func (x *Neighbor) GetOrigin() NeighborOrigin {
 	if x != nil {
-		return x.Packets
+		return x.Origin
 	}
-	return 0
+	return NeighborOrigin_NEIGHBOR_ORIGIN_UNSPECIFIED
 }
 
-func (x *SlotStatsEntry) GetBytes() uint64 {
+type NeighborCreateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Neighbors []*Neighbor `protobuf:"bytes,1,rep,name=neighbors,proto3" json:"neighbors,omitempty"`
+}
+
+func (x *NeighborCreateRequest) Reset() {
+	*x = NeighborCreateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NeighborCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NeighborCreateRequest) ProtoMessage() {}
+
+func (x *NeighborCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NeighborCreateRequest.ProtoReflect.Descriptor instead.
+func (*NeighborCreateRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *NeighborCreateRequest) GetNeighbors() []*Neighbor {
 	if x != nil {
-		return x.Bytes
+		return x.Neighbors
 	}
-	return 0
+	return nil
 }
 
-type StatsSlotShowRequest struct {
+type NeighborCreateResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MapTypes     []string `protobuf:"bytes,1,rep,name=map_types,json=mapTypes,proto3" json:"map_types,omitempty"`              // empty = all three types
-	IncludeEmpty bool     `protobuf:"varint,2,opt,name=include_empty,json=includeEmpty,proto3" json:"include_empty,omitempty"` // include packets==0 slots (default: hide)
+	Created []*Neighbor       `protobuf:"bytes,1,rep,name=created,proto3" json:"created,omitempty"`
+	Errors  []*OperationError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
 }
 
-func (x *StatsSlotShowRequest) Reset() {
-	*x = StatsSlotShowRequest{}
+func (x *NeighborCreateResponse) Reset() {
+	*x = NeighborCreateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[103]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[113]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StatsSlotShowRequest) String() string {
+func (x *NeighborCreateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatsSlotShowRequest) ProtoMessage() {}
+func (*NeighborCreateResponse) ProtoMessage() {}
 
-func (x *StatsSlotShowRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[103]
+func (x *NeighborCreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[113]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5514,50 +6564,50 @@ func (x *StatsSlotShowRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatsSlotShowRequest.ProtoReflect.Descriptor instead.
-func (*StatsSlotShowRequest) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{103}
+// Deprecated: Use NeighborCreateResponse.ProtoReflect.Descriptor instead.
+func (*NeighborCreateResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{113}
 }
 
-func (x *StatsSlotShowRequest) GetMapTypes() []string {
+func (x *NeighborCreateResponse) GetCreated() []*Neighbor {
 	if x != nil {
-		return x.MapTypes
+		return x.Created
 	}
 	return nil
 }
 
-func (x *StatsSlotShowRequest) GetIncludeEmpty() bool {
+func (x *NeighborCreateResponse) GetErrors() []*OperationError {
 	if x != nil {
-		return x.IncludeEmpty
+		return x.Errors
 	}
-	return false
+	return nil
 }
 
-type StatsSlotShowResponse struct {
+type NeighborDeleteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Entries []*SlotStatsEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Neighbors []*Neighbor `protobuf:"bytes,1,rep,name=neighbors,proto3" json:"neighbors,omitempty"` // Only bd_id and ip are used
 }
 
-func (x *StatsSlotShowResponse) Reset() {
-	*x = StatsSlotShowResponse{}
+func (x *NeighborDeleteRequest) Reset() {
+	*x = NeighborDeleteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[104]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[114]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StatsSlotShowResponse) String() string {
+func (x *NeighborDeleteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatsSlotShowResponse) ProtoMessage() {}
+func (*NeighborDeleteRequest) ProtoMessage() {}
 
-func (x *StatsSlotShowResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[104]
+func (x *NeighborDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[114]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5568,43 +6618,44 @@ func (x *StatsSlotShowResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatsSlotShowResponse.ProtoReflect.Descriptor instead.
-func (*StatsSlotShowResponse) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{104}
+// Deprecated: Use NeighborDeleteRequest.ProtoReflect.Descriptor instead.
+func (*NeighborDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{114}
 }
 
-func (x *StatsSlotShowResponse) GetEntries() []*SlotStatsEntry {
+func (x *NeighborDeleteRequest) GetNeighbors() []*Neighbor {
 	if x != nil {
-		return x.Entries
+		return x.Neighbors
 	}
 	return nil
 }
 
-type StatsSlotResetRequest struct {
+type NeighborDeleteResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MapTypes []string `protobuf:"bytes,1,rep,name=map_types,json=mapTypes,proto3" json:"map_types,omitempty"` // empty = reset all three types
+	Deleted []*Neighbor       `protobuf:"bytes,1,rep,name=deleted,proto3" json:"deleted,omitempty"`
+	Errors  []*OperationError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
 }
 
-func (x *StatsSlotResetRequest) Reset() {
-	*x = StatsSlotResetRequest{}
+func (x *NeighborDeleteResponse) Reset() {
+	*x = NeighborDeleteResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[105]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[115]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StatsSlotResetRequest) String() string {
+func (x *NeighborDeleteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatsSlotResetRequest) ProtoMessage() {}
+func (*NeighborDeleteResponse) ProtoMessage() {}
 
-func (x *StatsSlotResetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[105]
+func (x *NeighborDeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[115]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5615,41 +6666,50 @@ func (x *StatsSlotResetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatsSlotResetRequest.ProtoReflect.Descriptor instead.
-func (*StatsSlotResetRequest) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{105}
+// Deprecated: Use NeighborDeleteResponse.ProtoReflect.Descriptor instead.
+func (*NeighborDeleteResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{115}
 }
 
-func (x *StatsSlotResetRequest) GetMapTypes() []string {
+func (x *NeighborDeleteResponse) GetDeleted() []*Neighbor {
 	if x != nil {
-		return x.MapTypes
+		return x.Deleted
 	}
 	return nil
 }
 
-type StatsSlotResetResponse struct {
+func (x *NeighborDeleteResponse) GetErrors() []*OperationError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type NeighborListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	BdId uint32 `protobuf:"varint,1,opt,name=bd_id,json=bdId,proto3" json:"bd_id,omitempty"` // Filter by BD ID; 0 returns all
 }
 
-func (x *StatsSlotResetResponse) Reset() {
-	*x = StatsSlotResetResponse{}
+func (x *NeighborListRequest) Reset() {
+	*x = NeighborListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vinbero_v1_vinbero_proto_msgTypes[106]
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[116]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StatsSlotResetResponse) String() string {
+func (x *NeighborListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatsSlotResetResponse) ProtoMessage() {}
+func (*NeighborListRequest) ProtoMessage() {}
 
-func (x *StatsSlotResetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_vinbero_v1_vinbero_proto_msgTypes[106]
+func (x *NeighborListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[116]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5660,1175 +6720,8426 @@ func (x *StatsSlotResetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatsSlotResetResponse.ProtoReflect.Descriptor instead.
-func (*StatsSlotResetResponse) Descriptor() ([]byte, []int) {
-	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{106}
+// Deprecated: Use NeighborListRequest.ProtoReflect.Descriptor instead.
+func (*NeighborListRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{116}
 }
 
-var File_vinbero_v1_vinbero_proto protoreflect.FileDescriptor
+func (x *NeighborListRequest) GetBdId() uint32 {
+	if x != nil {
+		return x.BdId
+	}
+	return 0
+}
 
-var file_vinbero_v1_vinbero_proto_rawDesc = []byte{
-	0x0a, 0x18, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x69, 0x6e,
-	0x62, 0x65, 0x72, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x76, 0x69, 0x6e, 0x62,
-	0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x1a, 0x16, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2f,
-	0x76, 0x31, 0x2f, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xae,
-	0x05, 0x0a, 0x0b, 0x53, 0x69, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33,
-	0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b,
-	0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x72, 0x76, 0x36,
-	0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x70,
-	0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x69,
-	0x67, 0x67, 0x65, 0x72, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x72,
-	0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x72,
-	0x63, 0x41, 0x64, 0x64, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x64, 0x73, 0x74, 0x5f, 0x61, 0x64, 0x64,
-	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72,
-	0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x78, 0x74, 0x68, 0x6f, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x6e, 0x65, 0x78, 0x74, 0x68, 0x6f, 0x70, 0x12, 0x33, 0x0a, 0x06, 0x66, 0x6c,
-	0x61, 0x76, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x76, 0x69, 0x6e,
-	0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x72, 0x76, 0x36, 0x4c, 0x6f, 0x63, 0x61,
-	0x6c, 0x46, 0x6c, 0x61, 0x76, 0x6f, 0x72, 0x52, 0x06, 0x66, 0x6c, 0x61, 0x76, 0x6f, 0x72, 0x12,
-	0x24, 0x0a, 0x0e, 0x61, 0x72, 0x67, 0x5f, 0x73, 0x72, 0x63, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65,
-	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x61, 0x72, 0x67, 0x53, 0x72, 0x63, 0x4f,
-	0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x61, 0x72, 0x67, 0x5f, 0x64, 0x73, 0x74,
-	0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x61,
-	0x72, 0x67, 0x44, 0x73, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6f,
-	0x69, 0x66, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6f, 0x69, 0x66, 0x12, 0x19, 0x0a,
-	0x08, 0x76, 0x72, 0x66, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x76, 0x72, 0x66, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x13, 0x0a, 0x05, 0x62, 0x64, 0x5f, 0x69,
-	0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x62, 0x64, 0x49, 0x64, 0x12, 0x1f, 0x0a,
-	0x0b, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a,
-	0x0a, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x42, 0x0a, 0x0c, 0x68, 0x65,
-	0x61, 0x64, 0x65, 0x6e, 0x64, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x1f, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x72,
-	0x76, 0x36, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f,
-	0x72, 0x52, 0x0b, 0x68, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1f,
-	0x0a, 0x0b, 0x61, 0x72, 0x67, 0x73, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x0f, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x61, 0x72, 0x67, 0x73, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12,
-	0x25, 0x0a, 0x0f, 0x67, 0x74, 0x70, 0x5f, 0x76, 0x34, 0x5f, 0x73, 0x72, 0x63, 0x5f, 0x61, 0x64,
-	0x64, 0x72, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x67, 0x74, 0x70, 0x56, 0x34, 0x53,
-	0x72, 0x63, 0x41, 0x64, 0x64, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f,
-	0x69, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49,
-	0x64, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x61, 0x75, 0x78, 0x5f,
-	0x72, 0x61, 0x77, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x70, 0x6c, 0x75, 0x67, 0x69,
-	0x6e, 0x41, 0x75, 0x78, 0x52, 0x61, 0x77, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x6c, 0x75, 0x67, 0x69,
-	0x6e, 0x5f, 0x61, 0x75, 0x78, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0d, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x41, 0x75, 0x78, 0x4a, 0x73, 0x6f, 0x6e, 0x22,
-	0x58, 0x0a, 0x18, 0x53, 0x69, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x0d, 0x73,
-	0x69, 0x64, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x69, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x73, 0x69, 0x64,
-	0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x82, 0x01, 0x0a, 0x19, 0x53, 0x69,
-	0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65,
-	0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x06, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69, 0x6e,
-	0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x45,
-	0x0a, 0x18, 0x53, 0x69, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x72,
-	0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x50, 0x72, 0x65,
-	0x66, 0x69, 0x78, 0x65, 0x73, 0x22, 0x89, 0x01, 0x0a, 0x19, 0x53, 0x69, 0x64, 0x46, 0x75, 0x6e,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x18, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x74,
-	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x16, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x54, 0x72,
-	0x69, 0x67, 0x67, 0x65, 0x72, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x12, 0x32, 0x0a,
-	0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x73, 0x22, 0x18, 0x0a, 0x16, 0x53, 0x69, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x57, 0x0a, 0x17, 0x53,
-	0x69, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0d, 0x73, 0x69, 0x64, 0x5f, 0x66, 0x75,
-	0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
-	0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x64, 0x46, 0x75,
-	0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x73, 0x69, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x3e, 0x0a, 0x15, 0x53, 0x69, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a,
-	0x0e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x50, 0x72,
-	0x65, 0x66, 0x69, 0x78, 0x22, 0x54, 0x0a, 0x16, 0x53, 0x69, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a,
-	0x0a, 0x0c, 0x73, 0x69, 0x64, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76,
-	0x31, 0x2e, 0x53, 0x69, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x73,
-	0x69, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x19, 0x0a, 0x17, 0x53, 0x69,
-	0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3f, 0x0a, 0x18, 0x53, 0x69, 0x64, 0x46, 0x75, 0x6e, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xda, 0x01, 0x0a, 0x09, 0x48, 0x65, 0x61, 0x64, 0x65,
-	0x6e, 0x64, 0x76, 0x34, 0x12, 0x33, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x72, 0x76, 0x36, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x42, 0x65, 0x68, 0x61, 0x76,
-	0x69, 0x6f, 0x72, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x69,
-	0x67, 0x67, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0d, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78,
-	0x12, 0x19, 0x0a, 0x08, 0x73, 0x72, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x73, 0x72, 0x63, 0x41, 0x64, 0x64, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x64,
-	0x73, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64,
-	0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x72, 0x67, 0x73, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65,
-	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x61, 0x72, 0x67, 0x73, 0x4f, 0x66, 0x66,
-	0x73, 0x65, 0x74, 0x22, 0x4f, 0x0a, 0x16, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x34,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a,
-	0x0a, 0x68, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x34, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x15, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48,
-	0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x34, 0x52, 0x0a, 0x68, 0x65, 0x61, 0x64, 0x65, 0x6e,
-	0x64, 0x76, 0x34, 0x73, 0x22, 0x7e, 0x0a, 0x17, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76,
-	0x34, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x2f, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x15, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65,
-	0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x34, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x12, 0x32, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x73, 0x22, 0x43, 0x0a, 0x16, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76,
-	0x34, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29,
-	0x0a, 0x10, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65,
-	0x72, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x22, 0x87, 0x01, 0x0a, 0x17, 0x48, 0x65,
-	0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x34, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x18, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
-	0x5f, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x16, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
-	0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x12,
-	0x32, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x73, 0x22, 0x16, 0x0a, 0x14, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x34,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4e, 0x0a, 0x15, 0x48,
-	0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x34, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x0a, 0x68, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76,
-	0x34, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65,
-	0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x34, 0x52,
-	0x0a, 0x68, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x34, 0x73, 0x22, 0x3c, 0x0a, 0x13, 0x48,
-	0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x34, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x70, 0x72,
-	0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x69, 0x67,
-	0x67, 0x65, 0x72, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x22, 0x4b, 0x0a, 0x14, 0x48, 0x65, 0x61,
-	0x64, 0x65, 0x6e, 0x64, 0x76, 0x34, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x33, 0x0a, 0x09, 0x68, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x34, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76,
-	0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x34, 0x52, 0x09, 0x68, 0x65, 0x61,
-	0x64, 0x65, 0x6e, 0x64, 0x76, 0x34, 0x22, 0x17, 0x0a, 0x15, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e,
-	0x64, 0x76, 0x34, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0x3d, 0x0a, 0x16, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x34, 0x46, 0x6c, 0x75, 0x73,
-	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xb9,
-	0x01, 0x0a, 0x09, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x36, 0x12, 0x33, 0x0a, 0x04,
-	0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x76, 0x69, 0x6e,
-	0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x72, 0x76, 0x36, 0x48, 0x65, 0x61, 0x64,
-	0x65, 0x6e, 0x64, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x52, 0x04, 0x6d, 0x6f, 0x64,
-	0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x65,
-	0x66, 0x69, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x69, 0x67, 0x67,
-	0x65, 0x72, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x72, 0x63, 0x5f,
-	0x61, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x72, 0x63, 0x41,
-	0x64, 0x64, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x64, 0x73, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1a,
-	0x0a, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x4f, 0x0a, 0x16, 0x48, 0x65,
-	0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x36, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x0a, 0x68, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76,
-	0x36, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65,
-	0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x36, 0x52,
-	0x0a, 0x68, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x36, 0x73, 0x22, 0x7e, 0x0a, 0x17, 0x48,
-	0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x36, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72,
-	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x36, 0x52, 0x07,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72,
-	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x43, 0x0a, 0x16, 0x48,
-	0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x36, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
-	0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x0f, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73,
-	0x22, 0x87, 0x01, 0x0a, 0x17, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x36, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x18,
-	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f,
-	0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x16,
-	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x50, 0x72,
-	0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f,
-	0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72,
-	0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x16, 0x0a, 0x14, 0x48, 0x65,
-	0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x36, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x4e, 0x0a, 0x15, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x36, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x0a, 0x68,
-	0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x36, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x15, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61,
-	0x64, 0x65, 0x6e, 0x64, 0x76, 0x36, 0x52, 0x0a, 0x68, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76,
-	0x36, 0x73, 0x22, 0x3c, 0x0a, 0x13, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x36, 0x47,
-	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x69,
-	0x67, 0x67, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0d, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78,
-	0x22, 0x4b, 0x0a, 0x14, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x36, 0x47, 0x65, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x09, 0x68, 0x65, 0x61, 0x64,
-	0x65, 0x6e, 0x64, 0x76, 0x36, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x76, 0x69,
-	0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64,
-	0x76, 0x36, 0x52, 0x09, 0x68, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x36, 0x22, 0x17, 0x0a,
-	0x15, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x36, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3d, 0x0a, 0x16, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e,
-	0x64, 0x76, 0x36, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xc7, 0x01, 0x0a, 0x08, 0x46, 0x64, 0x62, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x13, 0x0a, 0x05, 0x62, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x04, 0x62, 0x64, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x63, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x61, 0x63, 0x12, 0x10, 0x0a, 0x03, 0x6f, 0x69, 0x66,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6f, 0x69, 0x66, 0x12, 0x19, 0x0a, 0x08, 0x69,
-	0x73, 0x5f, 0x75, 0x6e, 0x74, 0x61, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69,
-	0x73, 0x55, 0x6e, 0x74, 0x61, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x6d,
-	0x6f, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x52, 0x65, 0x6d,
-	0x6f, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63,
-	0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x12, 0x10, 0x0a,
-	0x03, 0x65, 0x73, 0x69, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x65, 0x73, 0x69, 0x22,
-	0x10, 0x0a, 0x0e, 0x46, 0x64, 0x62, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x22, 0x41, 0x0a, 0x0f, 0x46, 0x64, 0x62, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e,
-	0x76, 0x31, 0x2e, 0x46, 0x64, 0x62, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74,
-	0x72, 0x69, 0x65, 0x73, 0x22, 0x4b, 0x0a, 0x10, 0x46, 0x64, 0x62, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x13, 0x0a, 0x05, 0x62, 0x64, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x62, 0x64, 0x49, 0x64, 0x12, 0x10, 0x0a,
-	0x03, 0x6d, 0x61, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x61, 0x63, 0x12,
-	0x10, 0x0a, 0x03, 0x6f, 0x69, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6f, 0x69,
-	0x66, 0x22, 0x13, 0x0a, 0x11, 0x46, 0x64, 0x62, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x0a, 0x10, 0x46, 0x64, 0x62, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x13, 0x0a, 0x05, 0x62, 0x64,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x62, 0x64, 0x49, 0x64, 0x12,
-	0x10, 0x0a, 0x03, 0x6d, 0x61, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x61,
-	0x63, 0x22, 0x13, 0x0a, 0x11, 0x46, 0x64, 0x62, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x47, 0x0a, 0x0f, 0x46, 0x64, 0x62, 0x46, 0x6c, 0x75,
-	0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x13, 0x0a, 0x05, 0x62, 0x64, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x62, 0x64, 0x49, 0x64, 0x12, 0x1f,
-	0x0a, 0x0b, 0x6b, 0x65, 0x65, 0x70, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0a, 0x6b, 0x65, 0x65, 0x70, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x22,
-	0x37, 0x0a, 0x10, 0x46, 0x64, 0x62, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x64, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x6b, 0x0a, 0x0e, 0x56, 0x6c, 0x61, 0x6e,
-	0x54, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x61,
-	0x62, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x61,
-	0x62, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x76, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x76, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x25,
-	0x0a, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
-	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x4e, 0x0a, 0x16, 0x56, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x62,
-	0x6c, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x34, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6c,
-	0x61, 0x6e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e,
-	0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x83, 0x01, 0x0a, 0x17, 0x56, 0x6c, 0x61, 0x6e, 0x54, 0x61,
-	0x62, 0x6c, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
-	0x56, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72,
-	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x4e, 0x0a, 0x16, 0x56,
-	0x6c, 0x61, 0x6e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f,
-	0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x83, 0x01, 0x0a, 0x17,
-	0x56, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65,
-	0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x32, 0x0a,
-	0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x73, 0x22, 0x31, 0x0a, 0x14, 0x56, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x61, 0x62,
-	0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x61, 0x62,
-	0x6c, 0x65, 0x49, 0x64, 0x22, 0x4d, 0x0a, 0x15, 0x56, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x62, 0x6c,
-	0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a,
-	0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6c, 0x61, 0x6e,
-	0x54, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72,
-	0x69, 0x65, 0x73, 0x22, 0x32, 0x0a, 0x15, 0x56, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x62, 0x6c, 0x65,
-	0x46, 0x6c, 0x75, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08,
-	0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
-	0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x3d, 0x0a, 0x16, 0x56, 0x6c, 0x61, 0x6e, 0x54,
-	0x61, 0x62, 0x6c, 0x65, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x9b, 0x01, 0x0a, 0x06, 0x42, 0x64, 0x50, 0x65, 0x65,
-	0x72, 0x12, 0x13, 0x0a, 0x05, 0x62, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x04, 0x62, 0x64, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x72, 0x63, 0x5f, 0x61, 0x64,
-	0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x72, 0x63, 0x41, 0x64, 0x64,
-	0x72, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x33, 0x0a,
-	0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x76, 0x69,
-	0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x72, 0x76, 0x36, 0x48, 0x65, 0x61,
-	0x64, 0x65, 0x6e, 0x64, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x52, 0x04, 0x6d, 0x6f,
-	0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x73, 0x69, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x65, 0x73, 0x69, 0x22, 0x3f, 0x0a, 0x13, 0x42, 0x64, 0x50, 0x65, 0x65, 0x72, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x05, 0x70,
-	0x65, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x76, 0x69, 0x6e,
-	0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x64, 0x50, 0x65, 0x65, 0x72, 0x52, 0x05,
-	0x70, 0x65, 0x65, 0x72, 0x73, 0x22, 0x78, 0x0a, 0x14, 0x42, 0x64, 0x50, 0x65, 0x65, 0x72, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a,
-	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12,
-	0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x64, 0x50, 0x65,
-	0x65, 0x72, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x06, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69,
-	0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22,
-	0x2c, 0x0a, 0x13, 0x42, 0x64, 0x50, 0x65, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x64, 0x5f, 0x69, 0x64, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x05, 0x62, 0x64, 0x49, 0x64, 0x73, 0x22, 0x70, 0x0a,
-	0x14, 0x42, 0x64, 0x50, 0x65, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
-	0x5f, 0x62, 0x64, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x0c, 0x64,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x42, 0x64, 0x49, 0x64, 0x73, 0x12, 0x32, 0x0a, 0x06, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69,
-	0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22,
-	0x28, 0x0a, 0x11, 0x42, 0x64, 0x50, 0x65, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x13, 0x0a, 0x05, 0x62, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x04, 0x62, 0x64, 0x49, 0x64, 0x22, 0x3e, 0x0a, 0x12, 0x42, 0x64, 0x50,
-	0x65, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x28, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12,
-	0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x64, 0x50, 0x65,
-	0x65, 0x72, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x22, 0x29, 0x0a, 0x12, 0x42, 0x64, 0x50,
-	0x65, 0x65, 0x72, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x13, 0x0a, 0x05, 0x62, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04,
-	0x62, 0x64, 0x49, 0x64, 0x22, 0x3a, 0x0a, 0x13, 0x42, 0x64, 0x50, 0x65, 0x65, 0x72, 0x46, 0x6c,
-	0x75, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x64,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x22, 0xe2, 0x01, 0x0a, 0x0f, 0x45, 0x74, 0x68, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x53, 0x65, 0x67,
-	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x73, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x65, 0x73, 0x69, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f,
-	0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d,
-	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x12, 0x29, 0x0a,
-	0x11, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x70, 0x65, 0x5f, 0x73, 0x72, 0x63, 0x5f, 0x61, 0x64,
-	0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x50,
-	0x65, 0x53, 0x72, 0x63, 0x41, 0x64, 0x64, 0x72, 0x12, 0x23, 0x0a, 0x0e, 0x64, 0x66, 0x5f, 0x70,
-	0x65, 0x5f, 0x73, 0x72, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x64, 0x66, 0x50, 0x65, 0x53, 0x72, 0x63, 0x41, 0x64, 0x64, 0x72, 0x12, 0x46, 0x0a,
-	0x0f, 0x72, 0x65, 0x64, 0x75, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x79, 0x5f, 0x6d, 0x6f, 0x64, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f,
-	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x73, 0x69, 0x52, 0x65, 0x64, 0x75, 0x6e, 0x64, 0x61, 0x6e, 0x63,
-	0x79, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0e, 0x72, 0x65, 0x64, 0x75, 0x6e, 0x64, 0x61, 0x6e, 0x63,
-	0x79, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0x48, 0x0a, 0x0f, 0x45, 0x73, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72,
-	0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x76, 0x69, 0x6e, 0x62,
-	0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x74, 0x68, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x53,
-	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22,
-	0x7d, 0x0a, 0x10, 0x45, 0x73, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76,
-	0x31, 0x2e, 0x45, 0x74, 0x68, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e,
-	0x74, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x06, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69, 0x6e,
-	0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x25,
-	0x0a, 0x0f, 0x45, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x73, 0x69, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x04, 0x65, 0x73, 0x69, 0x73, 0x22, 0x60, 0x0a, 0x10, 0x45, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31,
-	0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52,
-	0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x0f, 0x0a, 0x0d, 0x45, 0x73, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x47, 0x0a, 0x0e, 0x45, 0x73, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x65, 0x6e,
-	0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x76, 0x69,
-	0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x74, 0x68, 0x65, 0x72, 0x6e, 0x65,
-	0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
-	0x73, 0x22, 0x47, 0x0a, 0x0e, 0x45, 0x73, 0x53, 0x65, 0x74, 0x44, 0x66, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x73, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x65, 0x73, 0x69, 0x12, 0x23, 0x0a, 0x0e, 0x64, 0x66, 0x5f, 0x70, 0x65, 0x5f, 0x73,
-	0x72, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
-	0x66, 0x50, 0x65, 0x53, 0x72, 0x63, 0x41, 0x64, 0x64, 0x72, 0x22, 0x48, 0x0a, 0x0f, 0x45, 0x73,
-	0x53, 0x65, 0x74, 0x44, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a,
-	0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
-	0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x74, 0x68, 0x65,
-	0x72, 0x6e, 0x65, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x22, 0x24, 0x0a, 0x10, 0x45, 0x73, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x44,
-	0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x73, 0x69, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x65, 0x73, 0x69, 0x22, 0x4a, 0x0a, 0x11, 0x45, 0x73,
-	0x43, 0x6c, 0x65, 0x61, 0x72, 0x44, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x35, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1b, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x74,
-	0x68, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x22, 0x7c, 0x0a, 0x03, 0x56, 0x72, 0x66, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07,
-	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6d,
-	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x5f, 0x6c, 0x33, 0x6d, 0x64, 0x65, 0x76, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x10, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x4c, 0x33, 0x6d, 0x64, 0x65, 0x76,
-	0x52, 0x75, 0x6c, 0x65, 0x22, 0x37, 0x0a, 0x10, 0x56, 0x72, 0x66, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x04, 0x76, 0x72, 0x66, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f,
-	0x2e, 0x76, 0x31, 0x2e, 0x56, 0x72, 0x66, 0x52, 0x04, 0x76, 0x72, 0x66, 0x73, 0x22, 0x72, 0x0a,
-	0x11, 0x56, 0x72, 0x66, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31,
-	0x2e, 0x56, 0x72, 0x66, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x32, 0x0a,
-	0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x73, 0x22, 0x28, 0x0a, 0x10, 0x56, 0x72, 0x66, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x6c, 0x0a, 0x11, 0x56,
-	0x72, 0x66, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
-	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e,
-	0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x10, 0x0a, 0x0e, 0x56, 0x72, 0x66,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x36, 0x0a, 0x0f, 0x56,
-	0x72, 0x66, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23,
-	0x0a, 0x04, 0x76, 0x72, 0x66, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76,
-	0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x72, 0x66, 0x52, 0x04, 0x76,
-	0x72, 0x66, 0x73, 0x22, 0x4b, 0x0a, 0x06, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x13, 0x0a, 0x05, 0x62, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x04, 0x62, 0x64, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
-	0x22, 0x43, 0x0a, 0x13, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x07, 0x62, 0x72, 0x69, 0x64, 0x67,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65,
-	0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x52, 0x07, 0x62, 0x72,
-	0x69, 0x64, 0x67, 0x65, 0x73, 0x22, 0x78, 0x0a, 0x14, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a,
-	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12,
-	0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x72, 0x69, 0x64,
-	0x67, 0x65, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x06, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69,
-	0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22,
-	0x2b, 0x0a, 0x13, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x6f, 0x0a, 0x14,
-	0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x06, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62,
-	0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x13, 0x0a,
-	0x11, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x42, 0x0a, 0x12, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x62, 0x72, 0x69, 0x64,
-	0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x76, 0x69, 0x6e, 0x62,
-	0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x52, 0x07, 0x62,
-	0x72, 0x69, 0x64, 0x67, 0x65, 0x73, 0x22, 0xde, 0x01, 0x0a, 0x09, 0x48, 0x65, 0x61, 0x64, 0x65,
-	0x6e, 0x64, 0x4c, 0x32, 0x12, 0x17, 0x0a, 0x07, 0x76, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x76, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x19, 0x0a,
-	0x08, 0x73, 0x72, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x73, 0x72, 0x63, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x67, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x67, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x12, 0x13, 0x0a, 0x05, 0x62, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x04, 0x62, 0x64, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x74,
-	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x33, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f,
-	0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x72, 0x76, 0x36,
-	0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x52,
-	0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x73, 0x69, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x65, 0x73, 0x69, 0x22, 0x50, 0x0a, 0x16, 0x48, 0x65, 0x61, 0x64, 0x65,
-	0x6e, 0x64, 0x4c, 0x32, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x36, 0x0a, 0x0b, 0x68, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x5f, 0x6c, 0x32, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f,
-	0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x4c, 0x32, 0x52, 0x0a, 0x68,
-	0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x4c, 0x32, 0x73, 0x22, 0x7e, 0x0a, 0x17, 0x48, 0x65, 0x61,
-	0x64, 0x65, 0x6e, 0x64, 0x4c, 0x32, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e,
-	0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x4c, 0x32, 0x52, 0x07, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e,
-	0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x57, 0x0a, 0x15, 0x48, 0x65, 0x61,
-	0x64, 0x65, 0x6e, 0x64, 0x4c, 0x32, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x72, 0x67,
-	0x65, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x74, 0x65,
-	0x72, 0x66, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x76, 0x6c, 0x61,
-	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x76, 0x6c, 0x61, 0x6e,
-	0x49, 0x64, 0x22, 0x55, 0x0a, 0x16, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x4c, 0x32, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x07,
-	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e,
-	0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65,
-	0x6e, 0x64, 0x4c, 0x32, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74,
-	0x52, 0x07, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x22, 0x8a, 0x01, 0x0a, 0x17, 0x48, 0x65,
-	0x61, 0x64, 0x65, 0x6e, 0x64, 0x4c, 0x32, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f,
-	0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x4c, 0x32, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x64, 0x12, 0x32, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
-	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x16, 0x0a, 0x14, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e,
-	0x64, 0x4c, 0x32, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4f,
-	0x0a, 0x15, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x4c, 0x32, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0b, 0x68, 0x65, 0x61, 0x64, 0x65,
-	0x6e, 0x64, 0x5f, 0x6c, 0x32, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x76,
-	0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e,
-	0x64, 0x4c, 0x32, 0x52, 0x0a, 0x68, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x4c, 0x32, 0x73, 0x22,
-	0x55, 0x0a, 0x13, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x4c, 0x32, 0x47, 0x65, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
-	0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a,
-	0x07, 0x76, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06,
-	0x76, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x22, 0x4c, 0x0a, 0x14, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e,
-	0x64, 0x4c, 0x32, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34,
-	0x0a, 0x0a, 0x68, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x5f, 0x6c, 0x32, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
-	0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x4c, 0x32, 0x52, 0x09, 0x68, 0x65, 0x61, 0x64, 0x65,
-	0x6e, 0x64, 0x4c, 0x32, 0x22, 0x17, 0x0a, 0x15, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x4c,
-	0x32, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3d, 0x0a,
-	0x16, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x4c, 0x32, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c,
-	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x52, 0x0a, 0x0c,
-	0x53, 0x74, 0x61, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79,
-	0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73,
-	0x22, 0x12, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x74, 0x73, 0x53, 0x68, 0x6f, 0x77, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0x49, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x74, 0x73, 0x53, 0x68, 0x6f,
-	0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x76, 0x69,
-	0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x22,
-	0x13, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0x14, 0x0a, 0x12, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73,
-	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x83, 0x01, 0x0a, 0x0e, 0x53,
-	0x6c, 0x6f, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x19, 0x0a,
-	0x08, 0x6d, 0x61, 0x70, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x6d, 0x61, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79,
-	0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73,
-	0x22, 0x58, 0x0a, 0x14, 0x53, 0x74, 0x61, 0x74, 0x73, 0x53, 0x6c, 0x6f, 0x74, 0x53, 0x68, 0x6f,
-	0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x70, 0x5f,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x61, 0x70,
-	0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
-	0x5f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x6e,
-	0x63, 0x6c, 0x75, 0x64, 0x65, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x4d, 0x0a, 0x15, 0x53, 0x74,
-	0x61, 0x74, 0x73, 0x53, 0x6c, 0x6f, 0x74, 0x53, 0x68, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76,
-	0x31, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x34, 0x0a, 0x15, 0x53, 0x74, 0x61,
-	0x74, 0x73, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x70, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x61, 0x70, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22,
-	0x18, 0x0a, 0x16, 0x53, 0x74, 0x61, 0x74, 0x73, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x65,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0xaa, 0x01, 0x0a, 0x11, 0x45, 0x73,
-	0x69, 0x52, 0x65, 0x64, 0x75, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x12,
-	0x23, 0x0a, 0x1f, 0x45, 0x53, 0x49, 0x5f, 0x52, 0x45, 0x44, 0x55, 0x4e, 0x44, 0x41, 0x4e, 0x43,
-	0x59, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
-	0x45, 0x44, 0x10, 0x00, 0x12, 0x25, 0x0a, 0x21, 0x45, 0x53, 0x49, 0x5f, 0x52, 0x45, 0x44, 0x55,
-	0x4e, 0x44, 0x41, 0x4e, 0x43, 0x59, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x49, 0x4e, 0x47,
-	0x4c, 0x45, 0x5f, 0x48, 0x4f, 0x4d, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x22, 0x0a, 0x1e, 0x45,
-	0x53, 0x49, 0x5f, 0x52, 0x45, 0x44, 0x55, 0x4e, 0x44, 0x41, 0x4e, 0x43, 0x59, 0x5f, 0x4d, 0x4f,
-	0x44, 0x45, 0x5f, 0x41, 0x4c, 0x4c, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x02, 0x12,
-	0x25, 0x0a, 0x21, 0x45, 0x53, 0x49, 0x5f, 0x52, 0x45, 0x44, 0x55, 0x4e, 0x44, 0x41, 0x4e, 0x43,
-	0x59, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x49, 0x4e, 0x47, 0x4c, 0x45, 0x5f, 0x41, 0x43,
-	0x54, 0x49, 0x56, 0x45, 0x10, 0x03, 0x32, 0xec, 0x03, 0x0a, 0x12, 0x53, 0x69, 0x64, 0x46, 0x75,
-	0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x60, 0x0a,
-	0x11, 0x53, 0x69, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x12, 0x24, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x69, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65,
-	0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x60, 0x0a, 0x11, 0x53, 0x69, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x12, 0x24, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76,
-	0x31, 0x2e, 0x53, 0x69, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x76, 0x69, 0x6e,
-	0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x5a, 0x0a, 0x0f, 0x53, 0x69, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x4c, 0x69, 0x73, 0x74, 0x12, 0x22, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76,
-	0x31, 0x2e, 0x53, 0x69, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65,
-	0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a,
-	0x10, 0x53, 0x69, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6c, 0x75, 0x73,
-	0x68, 0x12, 0x23, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x69, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46,
-	0x6c, 0x75, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x0e,
-	0x53, 0x69, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x65, 0x74, 0x12, 0x21,
-	0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x64, 0x46,
-	0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x22, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x69, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xcc, 0x03, 0x0a, 0x10, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e,
-	0x64, 0x76, 0x34, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5a, 0x0a, 0x0f, 0x48, 0x65,
-	0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x34, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x22, 0x2e,
-	0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65,
-	0x6e, 0x64, 0x76, 0x34, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x23, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48,
-	0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x34, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x0f, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e,
-	0x64, 0x76, 0x34, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x22, 0x2e, 0x76, 0x69, 0x6e, 0x62,
-	0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x34,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
-	0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65,
-	0x6e, 0x64, 0x76, 0x34, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x54, 0x0a, 0x0d, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x34, 0x4c,
-	0x69, 0x73, 0x74, 0x12, 0x20, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31,
-	0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x34, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e,
-	0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x34, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x0e, 0x48, 0x65, 0x61, 0x64,
-	0x65, 0x6e, 0x64, 0x76, 0x34, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x12, 0x21, 0x2e, 0x76, 0x69, 0x6e,
-	0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76,
-	0x34, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e,
-	0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65,
-	0x6e, 0x64, 0x76, 0x34, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x51, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x34, 0x47, 0x65,
-	0x74, 0x12, 0x1f, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48,
-	0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x34, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
-	0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x34, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x32, 0xcc, 0x03, 0x0a, 0x10, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64,
-	0x76, 0x36, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5a, 0x0a, 0x0f, 0x48, 0x65, 0x61,
-	0x64, 0x65, 0x6e, 0x64, 0x76, 0x36, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x22, 0x2e, 0x76,
-	0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e,
-	0x64, 0x76, 0x36, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x23, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65,
-	0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x36, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x0f, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64,
-	0x76, 0x36, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x22, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65,
-	0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x36, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x76,
-	0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e,
-	0x64, 0x76, 0x36, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x54, 0x0a, 0x0d, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x36, 0x4c, 0x69,
-	0x73, 0x74, 0x12, 0x20, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
-	0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x36, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76,
-	0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x36, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x64, 0x65,
-	0x6e, 0x64, 0x76, 0x36, 0x47, 0x65, 0x74, 0x12, 0x1f, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72,
-	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x36, 0x47, 0x65,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65,
-	0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x36, 0x47,
-	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x0e, 0x48, 0x65,
-	0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x36, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x12, 0x21, 0x2e, 0x76,
-	0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e,
-	0x64, 0x76, 0x36, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x22, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61,
-	0x64, 0x65, 0x6e, 0x64, 0x76, 0x36, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x32, 0xab, 0x02, 0x0a, 0x0a, 0x46, 0x64, 0x62, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x12, 0x42, 0x0a, 0x07, 0x46, 0x64, 0x62, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1a, 0x2e,
-	0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x64, 0x62, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x76, 0x69, 0x6e, 0x62,
-	0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x64, 0x62, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x09, 0x46, 0x64, 0x62, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x12, 0x1c, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31,
-	0x2e, 0x46, 0x64, 0x62, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1d, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x46,
-	0x64, 0x62, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x48, 0x0a, 0x09, 0x46, 0x64, 0x62, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x1c, 0x2e,
-	0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x64, 0x62, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x76, 0x69,
-	0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x64, 0x62, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x08, 0x46, 0x64,
-	0x62, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x12, 0x1b, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f,
-	0x2e, 0x76, 0x31, 0x2e, 0x46, 0x64, 0x62, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31,
-	0x2e, 0x46, 0x64, 0x62, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x32, 0xf9, 0x02, 0x0a, 0x10, 0x56, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5a, 0x0a, 0x0f, 0x56, 0x6c, 0x61, 0x6e, 0x54, 0x61,
-	0x62, 0x6c, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x22, 0x2e, 0x76, 0x69, 0x6e, 0x62,
-	0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x62, 0x6c, 0x65,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
-	0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6c, 0x61, 0x6e, 0x54,
-	0x61, 0x62, 0x6c, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x5a, 0x0a, 0x0f, 0x56, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x22, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e,
-	0x76, 0x31, 0x2e, 0x56, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x76, 0x69, 0x6e, 0x62,
-	0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x62, 0x6c, 0x65,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54,
-	0x0a, 0x0d, 0x56, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12,
-	0x20, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6c, 0x61,
-	0x6e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x21, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56,
-	0x6c, 0x61, 0x6e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x0e, 0x56, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x62, 0x6c,
-	0x65, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x12, 0x21, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f,
-	0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x6c, 0x75,
-	0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x76, 0x69, 0x6e, 0x62,
-	0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x62, 0x6c, 0x65,
-	0x46, 0x6c, 0x75, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xd2, 0x02,
-	0x0a, 0x0d, 0x42, 0x64, 0x50, 0x65, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
-	0x51, 0x0a, 0x0c, 0x42, 0x64, 0x50, 0x65, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12,
-	0x1f, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x64, 0x50,
-	0x65, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x20, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x64,
-	0x50, 0x65, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x51, 0x0a, 0x0c, 0x42, 0x64, 0x50, 0x65, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x12, 0x1f, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
-	0x42, 0x64, 0x50, 0x65, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31,
-	0x2e, 0x42, 0x64, 0x50, 0x65, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0a, 0x42, 0x64, 0x50, 0x65, 0x65, 0x72, 0x4c,
-	0x69, 0x73, 0x74, 0x12, 0x1d, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31,
-	0x2e, 0x42, 0x64, 0x50, 0x65, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
-	0x42, 0x64, 0x50, 0x65, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0b, 0x42, 0x64, 0x50, 0x65, 0x65, 0x72, 0x46, 0x6c, 0x75, 0x73,
-	0x68, 0x12, 0x1e, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x42,
-	0x64, 0x50, 0x65, 0x65, 0x72, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1f, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x42,
-	0x64, 0x50, 0x65, 0x65, 0x72, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x32, 0xf5, 0x02, 0x0a, 0x16, 0x45, 0x74, 0x68, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x53,
-	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x45, 0x0a,
-	0x08, 0x45, 0x73, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x76, 0x69, 0x6e, 0x62,
-	0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x73, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f,
-	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x73, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x08, 0x45, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x12, 0x1b, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x73,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
-	0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x73, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x06, 0x45,
-	0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x19, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e,
-	0x76, 0x31, 0x2e, 0x45, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x73,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x07,
-	0x45, 0x73, 0x53, 0x65, 0x74, 0x44, 0x66, 0x12, 0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72,
-	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x73, 0x53, 0x65, 0x74, 0x44, 0x66, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31,
-	0x2e, 0x45, 0x73, 0x53, 0x65, 0x74, 0x44, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x48, 0x0a, 0x09, 0x45, 0x73, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x44, 0x66, 0x12, 0x1c, 0x2e,
-	0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x73, 0x43, 0x6c, 0x65,
-	0x61, 0x72, 0x44, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x76, 0x69,
-	0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x73, 0x43, 0x6c, 0x65, 0x61, 0x72,
-	0x44, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xe3, 0x03, 0x0a, 0x16, 0x4e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x48, 0x0a, 0x09, 0x56, 0x72, 0x66, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x12, 0x1c, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
-	0x56, 0x72, 0x66, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1d, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x72,
-	0x66, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x48, 0x0a, 0x09, 0x56, 0x72, 0x66, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x1c, 0x2e, 0x76,
-	0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x72, 0x66, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x76, 0x69, 0x6e,
-	0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x72, 0x66, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x07, 0x56, 0x72, 0x66,
-	0x4c, 0x69, 0x73, 0x74, 0x12, 0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76,
-	0x31, 0x2e, 0x56, 0x72, 0x66, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1b, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x72,
-	0x66, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a,
-	0x0c, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x2e,
-	0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x72, 0x69, 0x64, 0x67,
-	0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20,
-	0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x72, 0x69, 0x64,
-	0x67, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x51, 0x0a, 0x0c, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x12, 0x1f, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x72,
-	0x69, 0x64, 0x67, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x20, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x42,
-	0x72, 0x69, 0x64, 0x67, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0a, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x4c, 0x69, 0x73,
-	0x74, 0x12, 0x1d, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x42,
-	0x72, 0x69, 0x64, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1e, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x72,
-	0x69, 0x64, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x32, 0xcc, 0x03, 0x0a, 0x10, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x4c, 0x32, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5a, 0x0a, 0x0f, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64,
-	0x4c, 0x32, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x22, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65,
-	0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x4c, 0x32, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x76,
-	0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e,
-	0x64, 0x4c, 0x32, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x5a, 0x0a, 0x0f, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x4c, 0x32, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x12, 0x22, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76,
-	0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x4c, 0x32, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65,
-	0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x4c, 0x32, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a,
-	0x0d, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x4c, 0x32, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x20,
-	0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64,
-	0x65, 0x6e, 0x64, 0x4c, 0x32, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x21, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65,
-	0x61, 0x64, 0x65, 0x6e, 0x64, 0x4c, 0x32, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x4c, 0x32,
-	0x47, 0x65, 0x74, 0x12, 0x1f, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31,
-	0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x4c, 0x32, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76,
-	0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x4c, 0x32, 0x47, 0x65, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x0e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e,
-	0x64, 0x4c, 0x32, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x12, 0x21, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65,
-	0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x4c, 0x32, 0x46,
-	0x6c, 0x75, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x76, 0x69,
-	0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64,
-	0x4c, 0x32, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32,
-	0xd4, 0x02, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x12, 0x48, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x74, 0x73, 0x53, 0x68, 0x6f, 0x77, 0x12, 0x1c, 0x2e,
-	0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73,
-	0x53, 0x68, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x76, 0x69,
-	0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x53, 0x68,
-	0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0a, 0x53, 0x74,
-	0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x65, 0x74, 0x12, 0x1d, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65,
-	0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x65, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72,
-	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x73,
-	0x53, 0x6c, 0x6f, 0x74, 0x53, 0x68, 0x6f, 0x77, 0x12, 0x20, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65,
-	0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x53, 0x6c, 0x6f, 0x74, 0x53,
-	0x68, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x76, 0x69, 0x6e,
-	0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x53, 0x6c, 0x6f,
-	0x74, 0x53, 0x68, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a,
-	0x0e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x65, 0x74, 0x12,
-	0x21, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61,
-	0x74, 0x73, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x74, 0x61, 0x74, 0x73, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x9d, 0x01, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x2e, 0x76,
-	0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x56, 0x69, 0x6e, 0x62, 0x65,
-	0x72, 0x6f, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x61, 0x6b, 0x65, 0x68, 0x61, 0x79, 0x61, 0x2f, 0x76,
-	0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x69, 0x6e, 0x62, 0x65,
-	0x72, 0x6f, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x76, 0x31, 0xa2,
-	0x02, 0x03, 0x56, 0x58, 0x58, 0xaa, 0x02, 0x0a, 0x56, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e,
-	0x56, 0x31, 0xca, 0x02, 0x0a, 0x56, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x5c, 0x56, 0x31, 0xe2,
-	0x02, 0x16, 0x56, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0b, 0x56, 0x69, 0x6e, 0x62, 0x65,
-	0x72, 0x6f, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+type NeighborListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Neighbors []*Neighbor `protobuf:"bytes,1,rep,name=neighbors,proto3" json:"neighbors,omitempty"`
 }
 
-var (
-	file_vinbero_v1_vinbero_proto_rawDescOnce sync.Once
-	file_vinbero_v1_vinbero_proto_rawDescData = file_vinbero_v1_vinbero_proto_rawDesc
-)
+func (x *NeighborListResponse) Reset() {
+	*x = NeighborListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_vinbero_v1_vinbero_proto_rawDescGZIP() []byte {
-	file_vinbero_v1_vinbero_proto_rawDescOnce.Do(func() {
-		file_vinbero_v1_vinbero_proto_rawDescData = protoimpl.X.CompressGZIP(file_vinbero_v1_vinbero_proto_rawDescData)
-	})
-	return file_vinbero_v1_vinbero_proto_rawDescData
+func (x *NeighborListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_vinbero_v1_vinbero_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_vinbero_v1_vinbero_proto_msgTypes = make([]protoimpl.MessageInfo, 107)
-var file_vinbero_v1_vinbero_proto_goTypes = []interface{}{
-	(EsiRedundancyMode)(0),            // 0: vinbero.v1.EsiRedundancyMode
-	(*SidFunction)(nil),               // 1: vinbero.v1.SidFunction
-	(*SidFunctionCreateRequest)(nil),  // 2: vinbero.v1.SidFunctionCreateRequest
-	(*SidFunctionCreateResponse)(nil), // 3: vinbero.v1.SidFunctionCreateResponse
-	(*SidFunctionDeleteRequest)(nil),  // 4: vinbero.v1.SidFunctionDeleteRequest
-	(*SidFunctionDeleteResponse)(nil), // 5: vinbero.v1.SidFunctionDeleteResponse
-	(*SidFunctionListRequest)(nil),    // 6: vinbero.v1.SidFunctionListRequest
-	(*SidFunctionListResponse)(nil),   // 7: vinbero.v1.SidFunctionListResponse
-	(*SidFunctionGetRequest)(nil),     // 8: vinbero.v1.SidFunctionGetRequest
-	(*SidFunctionGetResponse)(nil),    // 9: vinbero.v1.SidFunctionGetResponse
-	(*SidFunctionFlushRequest)(nil),   // 10: vinbero.v1.SidFunctionFlushRequest
-	(*SidFunctionFlushResponse)(nil),  // 11: vinbero.v1.SidFunctionFlushResponse
-	(*Headendv4)(nil),                 // 12: vinbero.v1.Headendv4
-	(*Headendv4CreateRequest)(nil),    // 13: vinbero.v1.Headendv4CreateRequest
-	(*Headendv4CreateResponse)(nil),   // 14: vinbero.v1.Headendv4CreateResponse
-	(*Headendv4DeleteRequest)(nil),    // 15: vinbero.v1.Headendv4DeleteRequest
-	(*Headendv4DeleteResponse)(nil),   // 16: vinbero.v1.Headendv4DeleteResponse
-	(*Headendv4ListRequest)(nil),      // 17: vinbero.v1.Headendv4ListRequest
-	(*Headendv4ListResponse)(nil),     // 18: vinbero.v1.Headendv4ListResponse
-	(*Headendv4GetRequest)(nil),       // 19: vinbero.v1.Headendv4GetRequest
-	(*Headendv4GetResponse)(nil),      // 20: vinbero.v1.Headendv4GetResponse
-	(*Headendv4FlushRequest)(nil),     // 21: vinbero.v1.Headendv4FlushRequest
-	(*Headendv4FlushResponse)(nil),    // 22: vinbero.v1.Headendv4FlushResponse
-	(*Headendv6)(nil),                 // 23: vinbero.v1.Headendv6
-	(*Headendv6CreateRequest)(nil),    // 24: vinbero.v1.Headendv6CreateRequest
-	(*Headendv6CreateResponse)(nil),   // 25: vinbero.v1.Headendv6CreateResponse
-	(*Headendv6DeleteRequest)(nil),    // 26: vinbero.v1.Headendv6DeleteRequest
-	(*Headendv6DeleteResponse)(nil),   // 27: vinbero.v1.Headendv6DeleteResponse
-	(*Headendv6ListRequest)(nil),      // 28: vinbero.v1.Headendv6ListRequest
-	(*Headendv6ListResponse)(nil),     // 29: vinbero.v1.Headendv6ListResponse
-	(*Headendv6GetRequest)(nil),       // 30: vinbero.v1.Headendv6GetRequest
-	(*Headendv6GetResponse)(nil),      // 31: vinbero.v1.Headendv6GetResponse
-	(*Headendv6FlushRequest)(nil),     // 32: vinbero.v1.Headendv6FlushRequest
-	(*Headendv6FlushResponse)(nil),    // 33: vinbero.v1.Headendv6FlushResponse
-	(*FdbEntry)(nil),                  // 34: vinbero.v1.FdbEntry
-	(*FdbListRequest)(nil),            // 35: vinbero.v1.FdbListRequest
-	(*FdbListResponse)(nil),           // 36: vinbero.v1.FdbListResponse
-	(*FdbCreateRequest)(nil),          // 37: vinbero.v1.FdbCreateRequest
-	(*FdbCreateResponse)(nil),         // 38: vinbero.v1.FdbCreateResponse
-	(*FdbDeleteRequest)(nil),          // 39: vinbero.v1.FdbDeleteRequest
-	(*FdbDeleteResponse)(nil),         // 40: vinbero.v1.FdbDeleteResponse
-	(*FdbFlushRequest)(nil),           // 41: vinbero.v1.FdbFlushRequest
-	(*FdbFlushResponse)(nil),          // 42: vinbero.v1.FdbFlushResponse
-	(*VlanTableEntry)(nil),            // 43: vinbero.v1.VlanTableEntry
-	(*VlanTableCreateRequest)(nil),    // 44: vinbero.v1.VlanTableCreateRequest
-	(*VlanTableCreateResponse)(nil),   // 45: vinbero.v1.VlanTableCreateResponse
-	(*VlanTableDeleteRequest)(nil),    // 46: vinbero.v1.VlanTableDeleteRequest
-	(*VlanTableDeleteResponse)(nil),   // 47: vinbero.v1.VlanTableDeleteResponse
-	(*VlanTableListRequest)(nil),      // 48: vinbero.v1.VlanTableListRequest
-	(*VlanTableListResponse)(nil),     // 49: vinbero.v1.VlanTableListResponse
-	(*VlanTableFlushRequest)(nil),     // 50: vinbero.v1.VlanTableFlushRequest
-	(*VlanTableFlushResponse)(nil),    // 51: vinbero.v1.VlanTableFlushResponse
-	(*BdPeer)(nil),                    // 52: vinbero.v1.BdPeer
-	(*BdPeerCreateRequest)(nil),       // 53: vinbero.v1.BdPeerCreateRequest
-	(*BdPeerCreateResponse)(nil),      // 54: vinbero.v1.BdPeerCreateResponse
-	(*BdPeerDeleteRequest)(nil),       // 55: vinbero.v1.BdPeerDeleteRequest
-	(*BdPeerDeleteResponse)(nil),      // 56: vinbero.v1.BdPeerDeleteResponse
-	(*BdPeerListRequest)(nil),         // 57: vinbero.v1.BdPeerListRequest
-	(*BdPeerListResponse)(nil),        // 58: vinbero.v1.BdPeerListResponse
-	(*BdPeerFlushRequest)(nil),        // 59: vinbero.v1.BdPeerFlushRequest
-	(*BdPeerFlushResponse)(nil),       // 60: vinbero.v1.BdPeerFlushResponse
-	(*EthernetSegment)(nil),           // 61: vinbero.v1.EthernetSegment
-	(*EsCreateRequest)(nil),           // 62: vinbero.v1.EsCreateRequest
-	(*EsCreateResponse)(nil),          // 63: vinbero.v1.EsCreateResponse
-	(*EsDeleteRequest)(nil),           // 64: vinbero.v1.EsDeleteRequest
-	(*EsDeleteResponse)(nil),          // 65: vinbero.v1.EsDeleteResponse
-	(*EsListRequest)(nil),             // 66: vinbero.v1.EsListRequest
-	(*EsListResponse)(nil),            // 67: vinbero.v1.EsListResponse
-	(*EsSetDfRequest)(nil),            // 68: vinbero.v1.EsSetDfRequest
-	(*EsSetDfResponse)(nil),           // 69: vinbero.v1.EsSetDfResponse
-	(*EsClearDfRequest)(nil),          // 70: vinbero.v1.EsClearDfRequest
-	(*EsClearDfResponse)(nil),         // 71: vinbero.v1.EsClearDfResponse
-	(*Vrf)(nil),                       // 72: vinbero.v1.Vrf
-	(*VrfCreateRequest)(nil),          // 73: vinbero.v1.VrfCreateRequest
-	(*VrfCreateResponse)(nil),         // 74: vinbero.v1.VrfCreateResponse
-	(*VrfDeleteRequest)(nil),          // 75: vinbero.v1.VrfDeleteRequest
-	(*VrfDeleteResponse)(nil),         // 76: vinbero.v1.VrfDeleteResponse
-	(*VrfListRequest)(nil),            // 77: vinbero.v1.VrfListRequest
-	(*VrfListResponse)(nil),           // 78: vinbero.v1.VrfListResponse
-	(*Bridge)(nil),                    // 79: vinbero.v1.Bridge
-	(*BridgeCreateRequest)(nil),       // 80: vinbero.v1.BridgeCreateRequest
-	(*BridgeCreateResponse)(nil),      // 81: vinbero.v1.BridgeCreateResponse
-	(*BridgeDeleteRequest)(nil),       // 82: vinbero.v1.BridgeDeleteRequest
-	(*BridgeDeleteResponse)(nil),      // 83: vinbero.v1.BridgeDeleteResponse
-	(*BridgeListRequest)(nil),         // 84: vinbero.v1.BridgeListRequest
-	(*BridgeListResponse)(nil),        // 85: vinbero.v1.BridgeListResponse
-	(*HeadendL2)(nil),                 // 86: vinbero.v1.HeadendL2
-	(*HeadendL2CreateRequest)(nil),    // 87: vinbero.v1.HeadendL2CreateRequest
-	(*HeadendL2CreateResponse)(nil),   // 88: vinbero.v1.HeadendL2CreateResponse
-	(*HeadendL2DeleteTarget)(nil),     // 89: vinbero.v1.HeadendL2DeleteTarget
-	(*HeadendL2DeleteRequest)(nil),    // 90: vinbero.v1.HeadendL2DeleteRequest
-	(*HeadendL2DeleteResponse)(nil),   // 91: vinbero.v1.HeadendL2DeleteResponse
-	(*HeadendL2ListRequest)(nil),      // 92: vinbero.v1.HeadendL2ListRequest
-	(*HeadendL2ListResponse)(nil),     // 93: vinbero.v1.HeadendL2ListResponse
-	(*HeadendL2GetRequest)(nil),       // 94: vinbero.v1.HeadendL2GetRequest
-	(*HeadendL2GetResponse)(nil),      // 95: vinbero.v1.HeadendL2GetResponse
-	(*HeadendL2FlushRequest)(nil),     // 96: vinbero.v1.HeadendL2FlushRequest
-	(*HeadendL2FlushResponse)(nil),    // 97: vinbero.v1.HeadendL2FlushResponse
-	(*StatsCounter)(nil),              // 98: vinbero.v1.StatsCounter
-	(*StatsShowRequest)(nil),          // 99: vinbero.v1.StatsShowRequest
-	(*StatsShowResponse)(nil),         // 100: vinbero.v1.StatsShowResponse
-	(*StatsResetRequest)(nil),         // 101: vinbero.v1.StatsResetRequest
-	(*StatsResetResponse)(nil),        // 102: vinbero.v1.StatsResetResponse
-	(*SlotStatsEntry)(nil),            // 103: vinbero.v1.SlotStatsEntry
-	(*StatsSlotShowRequest)(nil),      // 104: vinbero.v1.StatsSlotShowRequest
-	(*StatsSlotShowResponse)(nil),     // 105: vinbero.v1.StatsSlotShowResponse
-	(*StatsSlotResetRequest)(nil),     // 106: vinbero.v1.StatsSlotResetRequest
-	(*StatsSlotResetResponse)(nil),    // 107: vinbero.v1.StatsSlotResetResponse
-	(Srv6LocalAction)(0),              // 108: vinbero.v1.Srv6LocalAction
-	(Srv6LocalFlavor)(0),              // 109: vinbero.v1.Srv6LocalFlavor
-	(Srv6HeadendBehavior)(0),          // 110: vinbero.v1.Srv6HeadendBehavior
-	(*OperationError)(nil),            // 111: vinbero.v1.OperationError
+func (*NeighborListResponse) ProtoMessage() {}
+
+func (x *NeighborListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_vinbero_v1_vinbero_proto_depIdxs = []int32{
-	108, // 0: vinbero.v1.SidFunction.action:type_name -> vinbero.v1.Srv6LocalAction
-	109, // 1: vinbero.v1.SidFunction.flavor:type_name -> vinbero.v1.Srv6LocalFlavor
-	110, // 2: vinbero.v1.SidFunction.headend_mode:type_name -> vinbero.v1.Srv6HeadendBehavior
-	1,   // 3: vinbero.v1.SidFunctionCreateRequest.sid_functions:type_name -> vinbero.v1.SidFunction
-	1,   // 4: vinbero.v1.SidFunctionCreateResponse.created:type_name -> vinbero.v1.SidFunction
-	111, // 5: vinbero.v1.SidFunctionCreateResponse.errors:type_name -> vinbero.v1.OperationError
-	111, // 6: vinbero.v1.SidFunctionDeleteResponse.errors:type_name -> vinbero.v1.OperationError
-	1,   // 7: vinbero.v1.SidFunctionListResponse.sid_functions:type_name -> vinbero.v1.SidFunction
-	1,   // 8: vinbero.v1.SidFunctionGetResponse.sid_function:type_name -> vinbero.v1.SidFunction
-	110, // 9: vinbero.v1.Headendv4.mode:type_name -> vinbero.v1.Srv6HeadendBehavior
-	12,  // 10: vinbero.v1.Headendv4CreateRequest.headendv4s:type_name -> vinbero.v1.Headendv4
-	12,  // 11: vinbero.v1.Headendv4CreateResponse.created:type_name -> vinbero.v1.Headendv4
-	111, // 12: vinbero.v1.Headendv4CreateResponse.errors:type_name -> vinbero.v1.OperationError
-	111, // 13: vinbero.v1.Headendv4DeleteResponse.errors:type_name -> vinbero.v1.OperationError
-	12,  // 14: vinbero.v1.Headendv4ListResponse.headendv4s:type_name -> vinbero.v1.Headendv4
-	12,  // 15: vinbero.v1.Headendv4GetResponse.headendv4:type_name -> vinbero.v1.Headendv4
-	110, // 16: vinbero.v1.Headendv6.mode:type_name -> vinbero.v1.Srv6HeadendBehavior
-	23,  // 17: vinbero.v1.Headendv6CreateRequest.headendv6s:type_name -> vinbero.v1.Headendv6
-	23,  // 18: vinbero.v1.Headendv6CreateResponse.created:type_name -> vinbero.v1.Headendv6
-	111, // 19: vinbero.v1.Headendv6CreateResponse.errors:type_name -> vinbero.v1.OperationError
-	111, // 20: vinbero.v1.Headendv6DeleteResponse.errors:type_name -> vinbero.v1.OperationError
-	23,  // 21: vinbero.v1.Headendv6ListResponse.headendv6s:type_name -> vinbero.v1.Headendv6
-	23,  // 22: vinbero.v1.Headendv6GetResponse.headendv6:type_name -> vinbero.v1.Headendv6
-	34,  // 23: vinbero.v1.FdbListResponse.entries:type_name -> vinbero.v1.FdbEntry
-	43,  // 24: vinbero.v1.VlanTableCreateRequest.entries:type_name -> vinbero.v1.VlanTableEntry
-	43,  // 25: vinbero.v1.VlanTableCreateResponse.created:type_name -> vinbero.v1.VlanTableEntry
-	111, // 26: vinbero.v1.VlanTableCreateResponse.errors:type_name -> vinbero.v1.OperationError
-	43,  // 27: vinbero.v1.VlanTableDeleteRequest.entries:type_name -> vinbero.v1.VlanTableEntry
-	43,  // 28: vinbero.v1.VlanTableDeleteResponse.deleted:type_name -> vinbero.v1.VlanTableEntry
-	111, // 29: vinbero.v1.VlanTableDeleteResponse.errors:type_name -> vinbero.v1.OperationError
-	43,  // 30: vinbero.v1.VlanTableListResponse.entries:type_name -> vinbero.v1.VlanTableEntry
-	110, // 31: vinbero.v1.BdPeer.mode:type_name -> vinbero.v1.Srv6HeadendBehavior
-	52,  // 32: vinbero.v1.BdPeerCreateRequest.peers:type_name -> vinbero.v1.BdPeer
-	52,  // 33: vinbero.v1.BdPeerCreateResponse.created:type_name -> vinbero.v1.BdPeer
-	111, // 34: vinbero.v1.BdPeerCreateResponse.errors:type_name -> vinbero.v1.OperationError
-	111, // 35: vinbero.v1.BdPeerDeleteResponse.errors:type_name -> vinbero.v1.OperationError
-	52,  // 36: vinbero.v1.BdPeerListResponse.peers:type_name -> vinbero.v1.BdPeer
-	0,   // 37: vinbero.v1.EthernetSegment.redundancy_mode:type_name -> vinbero.v1.EsiRedundancyMode
-	61,  // 38: vinbero.v1.EsCreateRequest.entries:type_name -> vinbero.v1.EthernetSegment
-	61,  // 39: vinbero.v1.EsCreateResponse.created:type_name -> vinbero.v1.EthernetSegment
-	111, // 40: vinbero.v1.EsCreateResponse.errors:type_name -> vinbero.v1.OperationError
-	111, // 41: vinbero.v1.EsDeleteResponse.errors:type_name -> vinbero.v1.OperationError
-	61,  // 42: vinbero.v1.EsListResponse.entries:type_name -> vinbero.v1.EthernetSegment
-	61,  // 43: vinbero.v1.EsSetDfResponse.updated:type_name -> vinbero.v1.EthernetSegment
-	61,  // 44: vinbero.v1.EsClearDfResponse.updated:type_name -> vinbero.v1.EthernetSegment
-	72,  // 45: vinbero.v1.VrfCreateRequest.vrfs:type_name -> vinbero.v1.Vrf
-	72,  // 46: vinbero.v1.VrfCreateResponse.created:type_name -> vinbero.v1.Vrf
-	111, // 47: vinbero.v1.VrfCreateResponse.errors:type_name -> vinbero.v1.OperationError
-	111, // 48: vinbero.v1.VrfDeleteResponse.errors:type_name -> vinbero.v1.OperationError
-	72,  // 49: vinbero.v1.VrfListResponse.vrfs:type_name -> vinbero.v1.Vrf
-	79,  // 50: vinbero.v1.BridgeCreateRequest.bridges:type_name -> vinbero.v1.Bridge
-	79,  // 51: vinbero.v1.BridgeCreateResponse.created:type_name -> vinbero.v1.Bridge
-	111, // 52: vinbero.v1.BridgeCreateResponse.errors:type_name -> vinbero.v1.OperationError
-	111, // 53: vinbero.v1.BridgeDeleteResponse.errors:type_name -> vinbero.v1.OperationError
-	79,  // 54: vinbero.v1.BridgeListResponse.bridges:type_name -> vinbero.v1.Bridge
-	110, // 55: vinbero.v1.HeadendL2.mode:type_name -> vinbero.v1.Srv6HeadendBehavior
-	86,  // 56: vinbero.v1.HeadendL2CreateRequest.headend_l2s:type_name -> vinbero.v1.HeadendL2
-	86,  // 57: vinbero.v1.HeadendL2CreateResponse.created:type_name -> vinbero.v1.HeadendL2
-	111, // 58: vinbero.v1.HeadendL2CreateResponse.errors:type_name -> vinbero.v1.OperationError
-	89,  // 59: vinbero.v1.HeadendL2DeleteRequest.targets:type_name -> vinbero.v1.HeadendL2DeleteTarget
-	89,  // 60: vinbero.v1.HeadendL2DeleteResponse.deleted:type_name -> vinbero.v1.HeadendL2DeleteTarget
-	111, // 61: vinbero.v1.HeadendL2DeleteResponse.errors:type_name -> vinbero.v1.OperationError
-	86,  // 62: vinbero.v1.HeadendL2ListResponse.headend_l2s:type_name -> vinbero.v1.HeadendL2
-	86,  // 63: vinbero.v1.HeadendL2GetResponse.headend_l2:type_name -> vinbero.v1.HeadendL2
-	98,  // 64: vinbero.v1.StatsShowResponse.counters:type_name -> vinbero.v1.StatsCounter
-	103, // 65: vinbero.v1.StatsSlotShowResponse.entries:type_name -> vinbero.v1.SlotStatsEntry
-	2,   // 66: vinbero.v1.SidFunctionService.SidFunctionCreate:input_type -> vinbero.v1.SidFunctionCreateRequest
-	4,   // 67: vinbero.v1.SidFunctionService.SidFunctionDelete:input_type -> vinbero.v1.SidFunctionDeleteRequest
-	6,   // 68: vinbero.v1.SidFunctionService.SidFunctionList:input_type -> vinbero.v1.SidFunctionListRequest
-	10,  // 69: vinbero.v1.SidFunctionService.SidFunctionFlush:input_type -> vinbero.v1.SidFunctionFlushRequest
-	8,   // 70: vinbero.v1.SidFunctionService.SidFunctionGet:input_type -> vinbero.v1.SidFunctionGetRequest
-	13,  // 71: vinbero.v1.Headendv4Service.Headendv4Create:input_type -> vinbero.v1.Headendv4CreateRequest
-	15,  // 72: vinbero.v1.Headendv4Service.Headendv4Delete:input_type -> vinbero.v1.Headendv4DeleteRequest
-	17,  // 73: vinbero.v1.Headendv4Service.Headendv4List:input_type -> vinbero.v1.Headendv4ListRequest
-	21,  // 74: vinbero.v1.Headendv4Service.Headendv4Flush:input_type -> vinbero.v1.Headendv4FlushRequest
-	19,  // 75: vinbero.v1.Headendv4Service.Headendv4Get:input_type -> vinbero.v1.Headendv4GetRequest
-	24,  // 76: vinbero.v1.Headendv6Service.Headendv6Create:input_type -> vinbero.v1.Headendv6CreateRequest
-	26,  // 77: vinbero.v1.Headendv6Service.Headendv6Delete:input_type -> vinbero.v1.Headendv6DeleteRequest
-	28,  // 78: vinbero.v1.Headendv6Service.Headendv6List:input_type -> vinbero.v1.Headendv6ListRequest
-	30,  // 79: vinbero.v1.Headendv6Service.Headendv6Get:input_type -> vinbero.v1.Headendv6GetRequest
-	32,  // 80: vinbero.v1.Headendv6Service.Headendv6Flush:input_type -> vinbero.v1.Headendv6FlushRequest
-	35,  // 81: vinbero.v1.FdbService.FdbList:input_type -> vinbero.v1.FdbListRequest
-	37,  // 82: vinbero.v1.FdbService.FdbCreate:input_type -> vinbero.v1.FdbCreateRequest
-	39,  // 83: vinbero.v1.FdbService.FdbDelete:input_type -> vinbero.v1.FdbDeleteRequest
-	41,  // 84: vinbero.v1.FdbService.FdbFlush:input_type -> vinbero.v1.FdbFlushRequest
-	44,  // 85: vinbero.v1.VlanTableService.VlanTableCreate:input_type -> vinbero.v1.VlanTableCreateRequest
-	46,  // 86: vinbero.v1.VlanTableService.VlanTableDelete:input_type -> vinbero.v1.VlanTableDeleteRequest
-	48,  // 87: vinbero.v1.VlanTableService.VlanTableList:input_type -> vinbero.v1.VlanTableListRequest
-	50,  // 88: vinbero.v1.VlanTableService.VlanTableFlush:input_type -> vinbero.v1.VlanTableFlushRequest
-	53,  // 89: vinbero.v1.BdPeerService.BdPeerCreate:input_type -> vinbero.v1.BdPeerCreateRequest
-	55,  // 90: vinbero.v1.BdPeerService.BdPeerDelete:input_type -> vinbero.v1.BdPeerDeleteRequest
-	57,  // 91: vinbero.v1.BdPeerService.BdPeerList:input_type -> vinbero.v1.BdPeerListRequest
-	59,  // 92: vinbero.v1.BdPeerService.BdPeerFlush:input_type -> vinbero.v1.BdPeerFlushRequest
-	62,  // 93: vinbero.v1.EthernetSegmentService.EsCreate:input_type -> vinbero.v1.EsCreateRequest
-	64,  // 94: vinbero.v1.EthernetSegmentService.EsDelete:input_type -> vinbero.v1.EsDeleteRequest
-	66,  // 95: vinbero.v1.EthernetSegmentService.EsList:input_type -> vinbero.v1.EsListRequest
-	68,  // 96: vinbero.v1.EthernetSegmentService.EsSetDf:input_type -> vinbero.v1.EsSetDfRequest
-	70,  // 97: vinbero.v1.EthernetSegmentService.EsClearDf:input_type -> vinbero.v1.EsClearDfRequest
-	73,  // 98: vinbero.v1.NetworkResourceService.VrfCreate:input_type -> vinbero.v1.VrfCreateRequest
-	75,  // 99: vinbero.v1.NetworkResourceService.VrfDelete:input_type -> vinbero.v1.VrfDeleteRequest
-	77,  // 100: vinbero.v1.NetworkResourceService.VrfList:input_type -> vinbero.v1.VrfListRequest
-	80,  // 101: vinbero.v1.NetworkResourceService.BridgeCreate:input_type -> vinbero.v1.BridgeCreateRequest
-	82,  // 102: vinbero.v1.NetworkResourceService.BridgeDelete:input_type -> vinbero.v1.BridgeDeleteRequest
-	84,  // 103: vinbero.v1.NetworkResourceService.BridgeList:input_type -> vinbero.v1.BridgeListRequest
-	87,  // 104: vinbero.v1.HeadendL2Service.HeadendL2Create:input_type -> vinbero.v1.HeadendL2CreateRequest
-	90,  // 105: vinbero.v1.HeadendL2Service.HeadendL2Delete:input_type -> vinbero.v1.HeadendL2DeleteRequest
-	92,  // 106: vinbero.v1.HeadendL2Service.HeadendL2List:input_type -> vinbero.v1.HeadendL2ListRequest
-	94,  // 107: vinbero.v1.HeadendL2Service.HeadendL2Get:input_type -> vinbero.v1.HeadendL2GetRequest
-	96,  // 108: vinbero.v1.HeadendL2Service.HeadendL2Flush:input_type -> vinbero.v1.HeadendL2FlushRequest
-	99,  // 109: vinbero.v1.StatsService.StatsShow:input_type -> vinbero.v1.StatsShowRequest
-	101, // 110: vinbero.v1.StatsService.StatsReset:input_type -> vinbero.v1.StatsResetRequest
-	104, // 111: vinbero.v1.StatsService.StatsSlotShow:input_type -> vinbero.v1.StatsSlotShowRequest
-	106, // 112: vinbero.v1.StatsService.StatsSlotReset:input_type -> vinbero.v1.StatsSlotResetRequest
-	3,   // 113: vinbero.v1.SidFunctionService.SidFunctionCreate:output_type -> vinbero.v1.SidFunctionCreateResponse
-	5,   // 114: vinbero.v1.SidFunctionService.SidFunctionDelete:output_type -> vinbero.v1.SidFunctionDeleteResponse
-	7,   // 115: vinbero.v1.SidFunctionService.SidFunctionList:output_type -> vinbero.v1.SidFunctionListResponse
-	11,  // 116: vinbero.v1.SidFunctionService.SidFunctionFlush:output_type -> vinbero.v1.SidFunctionFlushResponse
-	9,   // 117: vinbero.v1.SidFunctionService.SidFunctionGet:output_type -> vinbero.v1.SidFunctionGetResponse
-	14,  // 118: vinbero.v1.Headendv4Service.Headendv4Create:output_type -> vinbero.v1.Headendv4CreateResponse
-	16,  // 119: vinbero.v1.Headendv4Service.Headendv4Delete:output_type -> vinbero.v1.Headendv4DeleteResponse
-	18,  // 120: vinbero.v1.Headendv4Service.Headendv4List:output_type -> vinbero.v1.Headendv4ListResponse
-	22,  // 121: vinbero.v1.Headendv4Service.Headendv4Flush:output_type -> vinbero.v1.Headendv4FlushResponse
-	20,  // 122: vinbero.v1.Headendv4Service.Headendv4Get:output_type -> vinbero.v1.Headendv4GetResponse
-	25,  // 123: vinbero.v1.Headendv6Service.Headendv6Create:output_type -> vinbero.v1.Headendv6CreateResponse
-	27,  // 124: vinbero.v1.Headendv6Service.Headendv6Delete:output_type -> vinbero.v1.Headendv6DeleteResponse
-	29,  // 125: vinbero.v1.Headendv6Service.Headendv6List:output_type -> vinbero.v1.Headendv6ListResponse
-	31,  // 126: vinbero.v1.Headendv6Service.Headendv6Get:output_type -> vinbero.v1.Headendv6GetResponse
-	33,  // 127: vinbero.v1.Headendv6Service.Headendv6Flush:output_type -> vinbero.v1.Headendv6FlushResponse
-	36,  // 128: vinbero.v1.FdbService.FdbList:output_type -> vinbero.v1.FdbListResponse
-	38,  // 129: vinbero.v1.FdbService.FdbCreate:output_type -> vinbero.v1.FdbCreateResponse
-	40,  // 130: vinbero.v1.FdbService.FdbDelete:output_type -> vinbero.v1.FdbDeleteResponse
-	42,  // 131: vinbero.v1.FdbService.FdbFlush:output_type -> vinbero.v1.FdbFlushResponse
-	45,  // 132: vinbero.v1.VlanTableService.VlanTableCreate:output_type -> vinbero.v1.VlanTableCreateResponse
-	47,  // 133: vinbero.v1.VlanTableService.VlanTableDelete:output_type -> vinbero.v1.VlanTableDeleteResponse
-	49,  // 134: vinbero.v1.VlanTableService.VlanTableList:output_type -> vinbero.v1.VlanTableListResponse
-	51,  // 135: vinbero.v1.VlanTableService.VlanTableFlush:output_type -> vinbero.v1.VlanTableFlushResponse
-	54,  // 136: vinbero.v1.BdPeerService.BdPeerCreate:output_type -> vinbero.v1.BdPeerCreateResponse
-	56,  // 137: vinbero.v1.BdPeerService.BdPeerDelete:output_type -> vinbero.v1.BdPeerDeleteResponse
-	58,  // 138: vinbero.v1.BdPeerService.BdPeerList:output_type -> vinbero.v1.BdPeerListResponse
-	60,  // 139: vinbero.v1.BdPeerService.BdPeerFlush:output_type -> vinbero.v1.BdPeerFlushResponse
-	63,  // 140: vinbero.v1.EthernetSegmentService.EsCreate:output_type -> vinbero.v1.EsCreateResponse
-	65,  // 141: vinbero.v1.EthernetSegmentService.EsDelete:output_type -> vinbero.v1.EsDeleteResponse
-	67,  // 142: vinbero.v1.EthernetSegmentService.EsList:output_type -> vinbero.v1.EsListResponse
-	69,  // 143: vinbero.v1.EthernetSegmentService.EsSetDf:output_type -> vinbero.v1.EsSetDfResponse
-	71,  // 144: vinbero.v1.EthernetSegmentService.EsClearDf:output_type -> vinbero.v1.EsClearDfResponse
-	74,  // 145: vinbero.v1.NetworkResourceService.VrfCreate:output_type -> vinbero.v1.VrfCreateResponse
-	76,  // 146: vinbero.v1.NetworkResourceService.VrfDelete:output_type -> vinbero.v1.VrfDeleteResponse
-	78,  // 147: vinbero.v1.NetworkResourceService.VrfList:output_type -> vinbero.v1.VrfListResponse
-	81,  // 148: vinbero.v1.NetworkResourceService.BridgeCreate:output_type -> vinbero.v1.BridgeCreateResponse
-	83,  // 149: vinbero.v1.NetworkResourceService.BridgeDelete:output_type -> vinbero.v1.BridgeDeleteResponse
-	85,  // 150: vinbero.v1.NetworkResourceService.BridgeList:output_type -> vinbero.v1.BridgeListResponse
-	88,  // 151: vinbero.v1.HeadendL2Service.HeadendL2Create:output_type -> vinbero.v1.HeadendL2CreateResponse
-	91,  // 152: vinbero.v1.HeadendL2Service.HeadendL2Delete:output_type -> vinbero.v1.HeadendL2DeleteResponse
-	93,  // 153: vinbero.v1.HeadendL2Service.HeadendL2List:output_type -> vinbero.v1.HeadendL2ListResponse
-	95,  // 154: vinbero.v1.HeadendL2Service.HeadendL2Get:output_type -> vinbero.v1.HeadendL2GetResponse
-	97,  // 155: vinbero.v1.HeadendL2Service.HeadendL2Flush:output_type -> vinbero.v1.HeadendL2FlushResponse
-	100, // 156: vinbero.v1.StatsService.StatsShow:output_type -> vinbero.v1.StatsShowResponse
-	102, // 157: vinbero.v1.StatsService.StatsReset:output_type -> vinbero.v1.StatsResetResponse
-	105, // 158: vinbero.v1.StatsService.StatsSlotShow:output_type -> vinbero.v1.StatsSlotShowResponse
-	107, // 159: vinbero.v1.StatsService.StatsSlotReset:output_type -> vinbero.v1.StatsSlotResetResponse
-	113, // [113:160] is the sub-list for method output_type
-	66,  // [66:113] is the sub-list for method input_type
-	66,  // [66:66] is the sub-list for extension type_name
-	66,  // [66:66] is the sub-list for extension extendee
-	0,   // [0:66] is the sub-list for field type_name
+
+// Deprecated: Use NeighborListResponse.ProtoReflect.Descriptor instead.
+func (*NeighborListResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{117}
 }
 
-func init() { file_vinbero_v1_vinbero_proto_init() }
-func file_vinbero_v1_vinbero_proto_init() {
-	if File_vinbero_v1_vinbero_proto != nil {
-		return
+func (x *NeighborListResponse) GetNeighbors() []*Neighbor {
+	if x != nil {
+		return x.Neighbors
 	}
-	file_vinbero_v1_enums_proto_init()
-	if !protoimpl.UnsafeEnabled {
-		file_vinbero_v1_vinbero_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SidFunction); i {
+	return nil
+}
+
+// Irb binds a bridge domain to a VRF. The gateway MAC and addresses are the
+// same on every PE (anycast gateway).
+type Irb struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VrfName    string   `protobuf:"bytes,1,opt,name=vrf_name,json=vrfName,proto3" json:"vrf_name,omitempty"`          // VRF the BD routes into (see NetworkResourceService)
+	GatewayMac string   `protobuf:"bytes,2,opt,name=gateway_mac,json=gatewayMac,proto3" json:"gateway_mac,omitempty"` // Anycast gateway MAC, also set on the BD's bridge
+	GatewayIps []string `protobuf:"bytes,3,rep,name=gateway_ips,json=gatewayIps,proto3" json:"gateway_ips,omitempty"` // Gateway addresses with prefix length (e.g., "192.0.2.1/24")
+}
+
+func (x *Irb) Reset() {
+	*x = Irb{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Irb) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Irb) ProtoMessage() {}
+
+func (x *Irb) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Irb.ProtoReflect.Descriptor instead.
+func (*Irb) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *Irb) GetVrfName() string {
+	if x != nil {
+		return x.VrfName
+	}
+	return ""
+}
+
+func (x *Irb) GetGatewayMac() string {
+	if x != nil {
+		return x.GatewayMac
+	}
+	return ""
+}
+
+func (x *Irb) GetGatewayIps() []string {
+	if x != nil {
+		return x.GatewayIps
+	}
+	return nil
+}
+
+// IrbRoute encapsulates traffic routed from a BD to prefix in vrf_name
+// towards a remote PE. The last segment is the remote End.DT4/DT6 SID.
+type IrbRoute struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VrfName  string              `protobuf:"bytes,1,opt,name=vrf_name,json=vrfName,proto3" json:"vrf_name,omitempty"`                 // VRF of the route
+	Prefix   string              `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`                                  // Destination prefix (IPv4 or IPv6)
+	SrcAddr  string              `protobuf:"bytes,3,opt,name=src_addr,json=srcAddr,proto3" json:"src_addr,omitempty"`                 // Outer IPv6 source address
+	Segments []string            `protobuf:"bytes,4,rep,name=segments,proto3" json:"segments,omitempty"`                              // SRv6 segment list, ending with the End.DT4/DT6 SID
+	Mode     Srv6HeadendBehavior `protobuf:"varint,5,opt,name=mode,proto3,enum=vinbero.v1.Srv6HeadendBehavior" json:"mode,omitempty"` // H_ENCAPS (default) or H_ENCAPS_RED
+	BdId     uint32              `protobuf:"varint,6,opt,name=bd_id,json=bdId,proto3" json:"bd_id,omitempty"`                         // Output only: BD whose RT2 MAC+IP route installed this host route (0 = configured)
+}
+
+func (x *IrbRoute) Reset() {
+	*x = IrbRoute{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IrbRoute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IrbRoute) ProtoMessage() {}
+
+func (x *IrbRoute) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IrbRoute.ProtoReflect.Descriptor instead.
+func (*IrbRoute) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *IrbRoute) GetVrfName() string {
+	if x != nil {
+		return x.VrfName
+	}
+	return ""
+}
+
+func (x *IrbRoute) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *IrbRoute) GetSrcAddr() string {
+	if x != nil {
+		return x.SrcAddr
+	}
+	return ""
+}
+
+func (x *IrbRoute) GetSegments() []string {
+	if x != nil {
+		return x.Segments
+	}
+	return nil
+}
+
+func (x *IrbRoute) GetMode() Srv6HeadendBehavior {
+	if x != nil {
+		return x.Mode
+	}
+	return Srv6HeadendBehavior_SRV6_HEADEND_BEHAVIOR_UNSPECIFIED
+}
+
+func (x *IrbRoute) GetBdId() uint32 {
+	if x != nil {
+		return x.BdId
+	}
+	return 0
+}
+
+type IrbRouteCreateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Routes []*IrbRoute `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes,omitempty"`
+}
+
+func (x *IrbRouteCreateRequest) Reset() {
+	*x = IrbRouteCreateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IrbRouteCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IrbRouteCreateRequest) ProtoMessage() {}
+
+func (x *IrbRouteCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IrbRouteCreateRequest.ProtoReflect.Descriptor instead.
+func (*IrbRouteCreateRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *IrbRouteCreateRequest) GetRoutes() []*IrbRoute {
+	if x != nil {
+		return x.Routes
+	}
+	return nil
+}
+
+type IrbRouteCreateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Created []*IrbRoute       `protobuf:"bytes,1,rep,name=created,proto3" json:"created,omitempty"`
+	Errors  []*OperationError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *IrbRouteCreateResponse) Reset() {
+	*x = IrbRouteCreateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IrbRouteCreateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IrbRouteCreateResponse) ProtoMessage() {}
+
+func (x *IrbRouteCreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IrbRouteCreateResponse.ProtoReflect.Descriptor instead.
+func (*IrbRouteCreateResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *IrbRouteCreateResponse) GetCreated() []*IrbRoute {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *IrbRouteCreateResponse) GetErrors() []*OperationError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type IrbRouteDeleteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Routes []*IrbRoute `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes,omitempty"` // Only vrf_name and prefix are used
+}
+
+func (x *IrbRouteDeleteRequest) Reset() {
+	*x = IrbRouteDeleteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IrbRouteDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IrbRouteDeleteRequest) ProtoMessage() {}
+
+func (x *IrbRouteDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IrbRouteDeleteRequest.ProtoReflect.Descriptor instead.
+func (*IrbRouteDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *IrbRouteDeleteRequest) GetRoutes() []*IrbRoute {
+	if x != nil {
+		return x.Routes
+	}
+	return nil
+}
+
+type IrbRouteDeleteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Deleted []*IrbRoute       `protobuf:"bytes,1,rep,name=deleted,proto3" json:"deleted,omitempty"`
+	Errors  []*OperationError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *IrbRouteDeleteResponse) Reset() {
+	*x = IrbRouteDeleteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IrbRouteDeleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IrbRouteDeleteResponse) ProtoMessage() {}
+
+func (x *IrbRouteDeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IrbRouteDeleteResponse.ProtoReflect.Descriptor instead.
+func (*IrbRouteDeleteResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *IrbRouteDeleteResponse) GetDeleted() []*IrbRoute {
+	if x != nil {
+		return x.Deleted
+	}
+	return nil
+}
+
+func (x *IrbRouteDeleteResponse) GetErrors() []*OperationError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type IrbRouteListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VrfName string `protobuf:"bytes,1,opt,name=vrf_name,json=vrfName,proto3" json:"vrf_name,omitempty"` // Filter by VRF; empty returns all
+}
+
+func (x *IrbRouteListRequest) Reset() {
+	*x = IrbRouteListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IrbRouteListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IrbRouteListRequest) ProtoMessage() {}
+
+func (x *IrbRouteListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IrbRouteListRequest.ProtoReflect.Descriptor instead.
+func (*IrbRouteListRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *IrbRouteListRequest) GetVrfName() string {
+	if x != nil {
+		return x.VrfName
+	}
+	return ""
+}
+
+type IrbRouteListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Routes []*IrbRoute `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes,omitempty"`
+}
+
+func (x *IrbRouteListResponse) Reset() {
+	*x = IrbRouteListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IrbRouteListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IrbRouteListResponse) ProtoMessage() {}
+
+func (x *IrbRouteListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IrbRouteListResponse.ProtoReflect.Descriptor instead.
+func (*IrbRouteListResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *IrbRouteListResponse) GetRoutes() []*IrbRoute {
+	if x != nil {
+		return x.Routes
+	}
+	return nil
+}
+
+// EthernetSegment represents a single ES entry stored in the ESI master table.
+type EthernetSegment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Esi           string `protobuf:"bytes,1,opt,name=esi,proto3" json:"esi,omitempty"`                                           // 10-byte RFC 7432 ESI as colon-separated hex
+	LocalAttached bool   `protobuf:"varint,2,opt,name=local_attached,json=localAttached,proto3" json:"local_attached,omitempty"` // true if this PE attaches to the ES
+	// local_pe_src_addr is the IPv6 source this PE uses in H.Encaps.L2 for this
+	// ES. Required when local_attached=true so DF judgement can compare against
+	// df_pe_src_addr. Ignored otherwise.
+	LocalPeSrcAddr string `protobuf:"bytes,3,opt,name=local_pe_src_addr,json=localPeSrcAddr,proto3" json:"local_pe_src_addr,omitempty"`
+	// df_pe_src_addr names the current Designated Forwarder for this ES. Empty
+	// means "no DF configured yet" (all attached PEs forward BUM, DF=implicit).
+	DfPeSrcAddr    string            `protobuf:"bytes,4,opt,name=df_pe_src_addr,json=dfPeSrcAddr,proto3" json:"df_pe_src_addr,omitempty"`
+	RedundancyMode EsiRedundancyMode `protobuf:"varint,5,opt,name=redundancy_mode,json=redundancyMode,proto3,enum=vinbero.v1.EsiRedundancyMode" json:"redundancy_mode,omitempty"`
+}
+
+func (x *EthernetSegment) Reset() {
+	*x = EthernetSegment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EthernetSegment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EthernetSegment) ProtoMessage() {}
+
+func (x *EthernetSegment) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EthernetSegment.ProtoReflect.Descriptor instead.
+func (*EthernetSegment) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *EthernetSegment) GetEsi() string {
+	if x != nil {
+		return x.Esi
+	}
+	return ""
+}
+
+func (x *EthernetSegment) GetLocalAttached() bool {
+	if x != nil {
+		return x.LocalAttached
+	}
+	return false
+}
+
+func (x *EthernetSegment) GetLocalPeSrcAddr() string {
+	if x != nil {
+		return x.LocalPeSrcAddr
+	}
+	return ""
+}
+
+func (x *EthernetSegment) GetDfPeSrcAddr() string {
+	if x != nil {
+		return x.DfPeSrcAddr
+	}
+	return ""
+}
+
+func (x *EthernetSegment) GetRedundancyMode() EsiRedundancyMode {
+	if x != nil {
+		return x.RedundancyMode
+	}
+	return EsiRedundancyMode_ESI_REDUNDANCY_MODE_UNSPECIFIED
+}
+
+type EsCreateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*EthernetSegment `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *EsCreateRequest) Reset() {
+	*x = EsCreateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EsCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EsCreateRequest) ProtoMessage() {}
+
+func (x *EsCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EsCreateRequest.ProtoReflect.Descriptor instead.
+func (*EsCreateRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *EsCreateRequest) GetEntries() []*EthernetSegment {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type EsCreateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Created []*EthernetSegment `protobuf:"bytes,1,rep,name=created,proto3" json:"created,omitempty"`
+	Errors  []*OperationError  `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *EsCreateResponse) Reset() {
+	*x = EsCreateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EsCreateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EsCreateResponse) ProtoMessage() {}
+
+func (x *EsCreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EsCreateResponse.ProtoReflect.Descriptor instead.
+func (*EsCreateResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *EsCreateResponse) GetCreated() []*EthernetSegment {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *EsCreateResponse) GetErrors() []*OperationError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type EsUpdateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries    []*EthernetSegment     `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`                         // Matched to installed entries by esi, which can't change
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"` // Fields taken from each entry; empty replaces every field
+}
+
+func (x *EsUpdateRequest) Reset() {
+	*x = EsUpdateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EsUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EsUpdateRequest) ProtoMessage() {}
+
+func (x *EsUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EsUpdateRequest.ProtoReflect.Descriptor instead.
+func (*EsUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *EsUpdateRequest) GetEntries() []*EthernetSegment {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *EsUpdateRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type EsUpdateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Updated []*EthernetSegment `protobuf:"bytes,1,rep,name=updated,proto3" json:"updated,omitempty"` // Entries as installed after the update
+	Errors  []*OperationError  `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *EsUpdateResponse) Reset() {
+	*x = EsUpdateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[130]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EsUpdateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EsUpdateResponse) ProtoMessage() {}
+
+func (x *EsUpdateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[130]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EsUpdateResponse.ProtoReflect.Descriptor instead.
+func (*EsUpdateResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *EsUpdateResponse) GetUpdated() []*EthernetSegment {
+	if x != nil {
+		return x.Updated
+	}
+	return nil
+}
+
+func (x *EsUpdateResponse) GetErrors() []*OperationError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type EsDeleteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Esis []string `protobuf:"bytes,1,rep,name=esis,proto3" json:"esis,omitempty"` // ESI strings to delete
+}
+
+func (x *EsDeleteRequest) Reset() {
+	*x = EsDeleteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[131]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EsDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EsDeleteRequest) ProtoMessage() {}
+
+func (x *EsDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[131]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EsDeleteRequest.ProtoReflect.Descriptor instead.
+func (*EsDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *EsDeleteRequest) GetEsis() []string {
+	if x != nil {
+		return x.Esis
+	}
+	return nil
+}
+
+type EsDeleteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Deleted []string          `protobuf:"bytes,1,rep,name=deleted,proto3" json:"deleted,omitempty"`
+	Errors  []*OperationError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *EsDeleteResponse) Reset() {
+	*x = EsDeleteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[132]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EsDeleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EsDeleteResponse) ProtoMessage() {}
+
+func (x *EsDeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[132]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EsDeleteResponse.ProtoReflect.Descriptor instead.
+func (*EsDeleteResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *EsDeleteResponse) GetDeleted() []string {
+	if x != nil {
+		return x.Deleted
+	}
+	return nil
+}
+
+func (x *EsDeleteResponse) GetErrors() []*OperationError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type EsListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *EsListRequest) Reset() {
+	*x = EsListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[133]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EsListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EsListRequest) ProtoMessage() {}
+
+func (x *EsListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[133]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EsListRequest.ProtoReflect.Descriptor instead.
+func (*EsListRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{133}
+}
+
+type EsListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*EthernetSegment `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *EsListResponse) Reset() {
+	*x = EsListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[134]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EsListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EsListResponse) ProtoMessage() {}
+
+func (x *EsListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[134]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EsListResponse.ProtoReflect.Descriptor instead.
+func (*EsListResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *EsListResponse) GetEntries() []*EthernetSegment {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type EsSetDfRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Esi         string `protobuf:"bytes,1,opt,name=esi,proto3" json:"esi,omitempty"`
+	DfPeSrcAddr string `protobuf:"bytes,2,opt,name=df_pe_src_addr,json=dfPeSrcAddr,proto3" json:"df_pe_src_addr,omitempty"` // IPv6 of the PE promoted to DF
+}
+
+func (x *EsSetDfRequest) Reset() {
+	*x = EsSetDfRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[135]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EsSetDfRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EsSetDfRequest) ProtoMessage() {}
+
+func (x *EsSetDfRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[135]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EsSetDfRequest.ProtoReflect.Descriptor instead.
+func (*EsSetDfRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *EsSetDfRequest) GetEsi() string {
+	if x != nil {
+		return x.Esi
+	}
+	return ""
+}
+
+func (x *EsSetDfRequest) GetDfPeSrcAddr() string {
+	if x != nil {
+		return x.DfPeSrcAddr
+	}
+	return ""
+}
+
+type EsSetDfResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Updated *EthernetSegment `protobuf:"bytes,1,opt,name=updated,proto3" json:"updated,omitempty"`
+}
+
+func (x *EsSetDfResponse) Reset() {
+	*x = EsSetDfResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[136]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EsSetDfResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EsSetDfResponse) ProtoMessage() {}
+
+func (x *EsSetDfResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[136]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EsSetDfResponse.ProtoReflect.Descriptor instead.
+func (*EsSetDfResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *EsSetDfResponse) GetUpdated() *EthernetSegment {
+	if x != nil {
+		return x.Updated
+	}
+	return nil
+}
+
+type EsClearDfRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Esi string `protobuf:"bytes,1,opt,name=esi,proto3" json:"esi,omitempty"`
+}
+
+func (x *EsClearDfRequest) Reset() {
+	*x = EsClearDfRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[137]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EsClearDfRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EsClearDfRequest) ProtoMessage() {}
+
+func (x *EsClearDfRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[137]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EsClearDfRequest.ProtoReflect.Descriptor instead.
+func (*EsClearDfRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *EsClearDfRequest) GetEsi() string {
+	if x != nil {
+		return x.Esi
+	}
+	return ""
+}
+
+type EsClearDfResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Updated *EthernetSegment `protobuf:"bytes,1,opt,name=updated,proto3" json:"updated,omitempty"`
+}
+
+func (x *EsClearDfResponse) Reset() {
+	*x = EsClearDfResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[138]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EsClearDfResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EsClearDfResponse) ProtoMessage() {}
+
+func (x *EsClearDfResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[138]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EsClearDfResponse.ProtoReflect.Descriptor instead.
+func (*EsClearDfResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *EsClearDfResponse) GetUpdated() *EthernetSegment {
+	if x != nil {
+		return x.Updated
+	}
+	return nil
+}
+
+// Vrf represents a Linux VRF device managed by Vinbero.
+// Used by End.DT4/DT6/DT46 for VRF-aware FIB lookup.
+type Vrf struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name             string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                                    // VRF device name (e.g., "vrf100")
+	TableId          uint32   `protobuf:"varint,2,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`                              // Linux routing table ID
+	Members          []string `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`                                              // Interfaces to enslave to this VRF
+	EnableL3MdevRule bool     `protobuf:"varint,4,opt,name=enable_l3mdev_rule,json=enableL3mdevRule,proto3" json:"enable_l3mdev_rule,omitempty"` // Add "ip rule add l3mdev" if not already present
+}
+
+func (x *Vrf) Reset() {
+	*x = Vrf{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[139]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Vrf) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Vrf) ProtoMessage() {}
+
+func (x *Vrf) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[139]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Vrf.ProtoReflect.Descriptor instead.
+func (*Vrf) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *Vrf) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Vrf) GetTableId() uint32 {
+	if x != nil {
+		return x.TableId
+	}
+	return 0
+}
+
+func (x *Vrf) GetMembers() []string {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *Vrf) GetEnableL3MdevRule() bool {
+	if x != nil {
+		return x.EnableL3MdevRule
+	}
+	return false
+}
+
+type VrfCreateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vrfs []*Vrf `protobuf:"bytes,1,rep,name=vrfs,proto3" json:"vrfs,omitempty"`
+}
+
+func (x *VrfCreateRequest) Reset() {
+	*x = VrfCreateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[140]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VrfCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VrfCreateRequest) ProtoMessage() {}
+
+func (x *VrfCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[140]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VrfCreateRequest.ProtoReflect.Descriptor instead.
+func (*VrfCreateRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *VrfCreateRequest) GetVrfs() []*Vrf {
+	if x != nil {
+		return x.Vrfs
+	}
+	return nil
+}
+
+type VrfCreateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Created []*Vrf            `protobuf:"bytes,1,rep,name=created,proto3" json:"created,omitempty"`
+	Errors  []*OperationError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *VrfCreateResponse) Reset() {
+	*x = VrfCreateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[141]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VrfCreateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VrfCreateResponse) ProtoMessage() {}
+
+func (x *VrfCreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[141]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VrfCreateResponse.ProtoReflect.Descriptor instead.
+func (*VrfCreateResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *VrfCreateResponse) GetCreated() []*Vrf {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *VrfCreateResponse) GetErrors() []*OperationError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type VrfDeleteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Names []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"` // VRF names to delete; fails if referenced by a SID
+}
+
+func (x *VrfDeleteRequest) Reset() {
+	*x = VrfDeleteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[142]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VrfDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VrfDeleteRequest) ProtoMessage() {}
+
+func (x *VrfDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[142]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VrfDeleteRequest.ProtoReflect.Descriptor instead.
+func (*VrfDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *VrfDeleteRequest) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+type VrfDeleteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeletedNames []string          `protobuf:"bytes,1,rep,name=deleted_names,json=deletedNames,proto3" json:"deleted_names,omitempty"`
+	Errors       []*OperationError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *VrfDeleteResponse) Reset() {
+	*x = VrfDeleteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[143]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VrfDeleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VrfDeleteResponse) ProtoMessage() {}
+
+func (x *VrfDeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[143]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VrfDeleteResponse.ProtoReflect.Descriptor instead.
+func (*VrfDeleteResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *VrfDeleteResponse) GetDeletedNames() []string {
+	if x != nil {
+		return x.DeletedNames
+	}
+	return nil
+}
+
+func (x *VrfDeleteResponse) GetErrors() []*OperationError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type VrfListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *VrfListRequest) Reset() {
+	*x = VrfListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[144]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VrfListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VrfListRequest) ProtoMessage() {}
+
+func (x *VrfListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[144]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VrfListRequest.ProtoReflect.Descriptor instead.
+func (*VrfListRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{144}
+}
+
+type VrfListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vrfs []*Vrf `protobuf:"bytes,1,rep,name=vrfs,proto3" json:"vrfs,omitempty"`
+}
+
+func (x *VrfListResponse) Reset() {
+	*x = VrfListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[145]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VrfListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VrfListResponse) ProtoMessage() {}
+
+func (x *VrfListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[145]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VrfListResponse.ProtoReflect.Descriptor instead.
+func (*VrfListResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *VrfListResponse) GetVrfs() []*Vrf {
+	if x != nil {
+		return x.Vrfs
+	}
+	return nil
+}
+
+// Bridge represents a Linux bridge device managed by Vinbero.
+// Used by End.DT2 for FDB miss flooding, and by FDBWatcher for MAC learning sync.
+type Bridge struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`              // Bridge device name (e.g., "br100")
+	BdId    uint32   `protobuf:"varint,2,opt,name=bd_id,json=bdId,proto3" json:"bd_id,omitempty"` // Bridge Domain ID for FDB scoping and FDBWatcher registration
+	Members []string `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`        // Interfaces to enslave to this bridge
+}
+
+func (x *Bridge) Reset() {
+	*x = Bridge{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[146]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Bridge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Bridge) ProtoMessage() {}
+
+func (x *Bridge) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[146]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Bridge.ProtoReflect.Descriptor instead.
+func (*Bridge) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *Bridge) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Bridge) GetBdId() uint32 {
+	if x != nil {
+		return x.BdId
+	}
+	return 0
+}
+
+func (x *Bridge) GetMembers() []string {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type BridgeCreateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bridges []*Bridge `protobuf:"bytes,1,rep,name=bridges,proto3" json:"bridges,omitempty"`
+}
+
+func (x *BridgeCreateRequest) Reset() {
+	*x = BridgeCreateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[147]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BridgeCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BridgeCreateRequest) ProtoMessage() {}
+
+func (x *BridgeCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[147]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BridgeCreateRequest.ProtoReflect.Descriptor instead.
+func (*BridgeCreateRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *BridgeCreateRequest) GetBridges() []*Bridge {
+	if x != nil {
+		return x.Bridges
+	}
+	return nil
+}
+
+type BridgeCreateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Created []*Bridge         `protobuf:"bytes,1,rep,name=created,proto3" json:"created,omitempty"`
+	Errors  []*OperationError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *BridgeCreateResponse) Reset() {
+	*x = BridgeCreateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[148]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BridgeCreateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BridgeCreateResponse) ProtoMessage() {}
+
+func (x *BridgeCreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[148]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BridgeCreateResponse.ProtoReflect.Descriptor instead.
+func (*BridgeCreateResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *BridgeCreateResponse) GetCreated() []*Bridge {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *BridgeCreateResponse) GetErrors() []*OperationError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type BridgeDeleteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Names []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"` // Bridge names to delete; fails if referenced by a SID
+}
+
+func (x *BridgeDeleteRequest) Reset() {
+	*x = BridgeDeleteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[149]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BridgeDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BridgeDeleteRequest) ProtoMessage() {}
+
+func (x *BridgeDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[149]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BridgeDeleteRequest.ProtoReflect.Descriptor instead.
+func (*BridgeDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *BridgeDeleteRequest) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+type BridgeDeleteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeletedNames []string          `protobuf:"bytes,1,rep,name=deleted_names,json=deletedNames,proto3" json:"deleted_names,omitempty"`
+	Errors       []*OperationError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *BridgeDeleteResponse) Reset() {
+	*x = BridgeDeleteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[150]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BridgeDeleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BridgeDeleteResponse) ProtoMessage() {}
+
+func (x *BridgeDeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[150]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BridgeDeleteResponse.ProtoReflect.Descriptor instead.
+func (*BridgeDeleteResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *BridgeDeleteResponse) GetDeletedNames() []string {
+	if x != nil {
+		return x.DeletedNames
+	}
+	return nil
+}
+
+func (x *BridgeDeleteResponse) GetErrors() []*OperationError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type BridgeListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *BridgeListRequest) Reset() {
+	*x = BridgeListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[151]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BridgeListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BridgeListRequest) ProtoMessage() {}
+
+func (x *BridgeListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[151]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BridgeListRequest.ProtoReflect.Descriptor instead.
+func (*BridgeListRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{151}
+}
+
+type BridgeListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bridges []*Bridge `protobuf:"bytes,1,rep,name=bridges,proto3" json:"bridges,omitempty"`
+}
+
+func (x *BridgeListResponse) Reset() {
+	*x = BridgeListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[152]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BridgeListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BridgeListResponse) ProtoMessage() {}
+
+func (x *BridgeListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[152]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BridgeListResponse.ProtoReflect.Descriptor instead.
+func (*BridgeListResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *BridgeListResponse) GetBridges() []*Bridge {
+	if x != nil {
+		return x.Bridges
+	}
+	return nil
+}
+
+// HeadendL2 represents a port+VLAN → SRv6 L2 encapsulation rule.
+// When bd_id is 0, all frames are encapsulated directly (P2P mode, no MAC learning).
+// When bd_id > 0, MAC learning and FDB-based forwarding are enabled (P2MP mode).
+type HeadendL2 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VlanId            uint32                 `protobuf:"varint,1,opt,name=vlan_id,json=vlanId,proto3" json:"vlan_id,omitempty"`                                                                // VLAN ID to match (0 for untagged traffic)
+	SrcAddr           string                 `protobuf:"bytes,2,opt,name=src_addr,json=srcAddr,proto3" json:"src_addr,omitempty"`                                                              // Outer IPv6 source address for SRv6 encap
+	Segments          []string               `protobuf:"bytes,3,rep,name=segments,proto3" json:"segments,omitempty"`                                                                           // SRv6 segment list
+	BdId              uint32                 `protobuf:"varint,4,opt,name=bd_id,json=bdId,proto3" json:"bd_id,omitempty"`                                                                      // Bridge Domain ID; 0 = direct encap (no BD), >0 = MAC learning enabled
+	InterfaceName     string                 `protobuf:"bytes,5,opt,name=interface_name,json=interfaceName,proto3" json:"interface_name,omitempty"`                                            // Customer-facing interface name (resolved to ifindex internally)
+	Mode              Srv6HeadendBehavior    `protobuf:"varint,6,opt,name=mode,proto3,enum=vinbero.v1.Srv6HeadendBehavior" json:"mode,omitempty"`                                              // Headend mode (default: H_ENCAPS_L2, or H_ENCAPS_L2_RED for Reduced SRH)
+	Esi               string                 `protobuf:"bytes,7,opt,name=esi,proto3" json:"esi,omitempty"`                                                                                     // RFC 7432 Ethernet Segment Identifier of the local AC (empty=single-homing)
+	TcMode            Srv6EncapTcMode        `protobuf:"varint,8,opt,name=tc_mode,json=tcMode,proto3,enum=vinbero.v1.Srv6EncapTcMode" json:"tc_mode,omitempty"`                                // Outer Traffic Class: zero, copied from inner, or tc
+	Tc                uint32                 `protobuf:"varint,9,opt,name=tc,proto3" json:"tc,omitempty"`                                                                                      // Outer Traffic Class (DSCP << 2 | ECN) for SRV6_ENCAP_TC_MODE_SET (0-255)
+	HopLimitMode      Srv6EncapHopLimitMode  `protobuf:"varint,10,opt,name=hop_limit_mode,json=hopLimitMode,proto3,enum=vinbero.v1.Srv6EncapHopLimitMode" json:"hop_limit_mode,omitempty"`     // Outer Hop Limit: fixed or propagated from inner
+	HopLimit          uint32                 `protobuf:"varint,11,opt,name=hop_limit,json=hopLimit,proto3" json:"hop_limit,omitempty"`                                                         // Fixed outer Hop Limit (0 = 64, else 1-255)
+	FlowLabelMode     Srv6EncapFlowLabelMode `protobuf:"varint,12,opt,name=flow_label_mode,json=flowLabelMode,proto3,enum=vinbero.v1.Srv6EncapFlowLabelMode" json:"flow_label_mode,omitempty"` // Outer Flow Label: zero or inner 5-tuple hash
+	InnerVlanId       uint32                 `protobuf:"varint,13,opt,name=inner_vlan_id,json=innerVlanId,proto3" json:"inner_vlan_id,omitempty"`                                              // C-tag to match under vlan_id (0 = any or none)
+	VlanIdEnd         uint32                 `protobuf:"varint,14,opt,name=vlan_id_end,json=vlanIdEnd,proto3" json:"vlan_id_end,omitempty"`                                                    // Last VLAN of a range starting at vlan_id (0 = vlan_id only); ranges take no inner_vlan_id
+	EgressVlanRewrite *VlanRewrite           `protobuf:"bytes,15,opt,name=egress_vlan_rewrite,json=egressVlanRewrite,proto3" json:"egress_vlan_rewrite,omitempty"`                             // Rewrite of frames leaving through this AC (unset = none)
+	PortMode          bool                   `protobuf:"varint,16,opt,name=port_mode,json=portMode,proto3" json:"port_mode,omitempty"`                                                         // Match all frames on the interface; vlan_id, inner_vlan_id and vlan_id_end must be 0
+}
+
+func (x *HeadendL2) Reset() {
+	*x = HeadendL2{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[153]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeadendL2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeadendL2) ProtoMessage() {}
+
+func (x *HeadendL2) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[153]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeadendL2.ProtoReflect.Descriptor instead.
+func (*HeadendL2) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *HeadendL2) GetVlanId() uint32 {
+	if x != nil {
+		return x.VlanId
+	}
+	return 0
+}
+
+func (x *HeadendL2) GetSrcAddr() string {
+	if x != nil {
+		return x.SrcAddr
+	}
+	return ""
+}
+
+func (x *HeadendL2) GetSegments() []string {
+	if x != nil {
+		return x.Segments
+	}
+	return nil
+}
+
+func (x *HeadendL2) GetBdId() uint32 {
+	if x != nil {
+		return x.BdId
+	}
+	return 0
+}
+
+func (x *HeadendL2) GetInterfaceName() string {
+	if x != nil {
+		return x.InterfaceName
+	}
+	return ""
+}
+
+func (x *HeadendL2) GetMode() Srv6HeadendBehavior {
+	if x != nil {
+		return x.Mode
+	}
+	return Srv6HeadendBehavior_SRV6_HEADEND_BEHAVIOR_UNSPECIFIED
+}
+
+func (x *HeadendL2) GetEsi() string {
+	if x != nil {
+		return x.Esi
+	}
+	return ""
+}
+
+func (x *HeadendL2) GetTcMode() Srv6EncapTcMode {
+	if x != nil {
+		return x.TcMode
+	}
+	return Srv6EncapTcMode_SRV6_ENCAP_TC_MODE_UNSPECIFIED
+}
+
+func (x *HeadendL2) GetTc() uint32 {
+	if x != nil {
+		return x.Tc
+	}
+	return 0
+}
+
+func (x *HeadendL2) GetHopLimitMode() Srv6EncapHopLimitMode {
+	if x != nil {
+		return x.HopLimitMode
+	}
+	return Srv6EncapHopLimitMode_SRV6_ENCAP_HOP_LIMIT_MODE_UNSPECIFIED
+}
+
+func (x *HeadendL2) GetHopLimit() uint32 {
+	if x != nil {
+		return x.HopLimit
+	}
+	return 0
+}
+
+func (x *HeadendL2) GetFlowLabelMode() Srv6EncapFlowLabelMode {
+	if x != nil {
+		return x.FlowLabelMode
+	}
+	return Srv6EncapFlowLabelMode_SRV6_ENCAP_FLOW_LABEL_MODE_UNSPECIFIED
+}
+
+func (x *HeadendL2) GetInnerVlanId() uint32 {
+	if x != nil {
+		return x.InnerVlanId
+	}
+	return 0
+}
+
+func (x *HeadendL2) GetVlanIdEnd() uint32 {
+	if x != nil {
+		return x.VlanIdEnd
+	}
+	return 0
+}
+
+func (x *HeadendL2) GetEgressVlanRewrite() *VlanRewrite {
+	if x != nil {
+		return x.EgressVlanRewrite
+	}
+	return nil
+}
+
+func (x *HeadendL2) GetPortMode() bool {
+	if x != nil {
+		return x.PortMode
+	}
+	return false
+}
+
+// VlanRewrite is an egress VLAN tag rewrite. PUSH and TRANSLATE keep the
+// priority bits of the tag they build on; frames without a tag are left
+// alone by POP and TRANSLATE.
+type VlanRewrite struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Op          VlanRewriteOp `protobuf:"varint,1,opt,name=op,proto3,enum=vinbero.v1.VlanRewriteOp" json:"op,omitempty"`
+	VlanId      uint32        `protobuf:"varint,2,opt,name=vlan_id,json=vlanId,proto3" json:"vlan_id,omitempty"`                  // PUSH / TRANSLATE: new outer VLAN ID (1-4094)
+	InnerVlanId uint32        `protobuf:"varint,3,opt,name=inner_vlan_id,json=innerVlanId,proto3" json:"inner_vlan_id,omitempty"` // TRANSLATE: new inner VLAN ID (0 = keep)
+}
+
+func (x *VlanRewrite) Reset() {
+	*x = VlanRewrite{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[154]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VlanRewrite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VlanRewrite) ProtoMessage() {}
+
+func (x *VlanRewrite) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[154]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VlanRewrite.ProtoReflect.Descriptor instead.
+func (*VlanRewrite) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *VlanRewrite) GetOp() VlanRewriteOp {
+	if x != nil {
+		return x.Op
+	}
+	return VlanRewriteOp_VLAN_REWRITE_OP_UNSPECIFIED
+}
+
+func (x *VlanRewrite) GetVlanId() uint32 {
+	if x != nil {
+		return x.VlanId
+	}
+	return 0
+}
+
+func (x *VlanRewrite) GetInnerVlanId() uint32 {
+	if x != nil {
+		return x.InnerVlanId
+	}
+	return 0
+}
+
+type HeadendL2CreateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HeadendL2S []*HeadendL2 `protobuf:"bytes,1,rep,name=headend_l2s,json=headendL2s,proto3" json:"headend_l2s,omitempty"`
+}
+
+func (x *HeadendL2CreateRequest) Reset() {
+	*x = HeadendL2CreateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[155]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeadendL2CreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeadendL2CreateRequest) ProtoMessage() {}
+
+func (x *HeadendL2CreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[155]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeadendL2CreateRequest.ProtoReflect.Descriptor instead.
+func (*HeadendL2CreateRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *HeadendL2CreateRequest) GetHeadendL2S() []*HeadendL2 {
+	if x != nil {
+		return x.HeadendL2S
+	}
+	return nil
+}
+
+type HeadendL2CreateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Created []*HeadendL2      `protobuf:"bytes,1,rep,name=created,proto3" json:"created,omitempty"`
+	Errors  []*OperationError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *HeadendL2CreateResponse) Reset() {
+	*x = HeadendL2CreateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[156]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeadendL2CreateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeadendL2CreateResponse) ProtoMessage() {}
+
+func (x *HeadendL2CreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[156]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeadendL2CreateResponse.ProtoReflect.Descriptor instead.
+func (*HeadendL2CreateResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *HeadendL2CreateResponse) GetCreated() []*HeadendL2 {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *HeadendL2CreateResponse) GetErrors() []*OperationError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type HeadendL2UpdateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HeadendL2S []*HeadendL2           `protobuf:"bytes,1,rep,name=headend_l2s,json=headendL2s,proto3" json:"headend_l2s,omitempty"` // Matched to installed entries by (interface_name, vlan_id, inner_vlan_id, port_mode), which can't change
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"` // Fields taken from each entry; empty replaces every field
+}
+
+func (x *HeadendL2UpdateRequest) Reset() {
+	*x = HeadendL2UpdateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[157]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeadendL2UpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeadendL2UpdateRequest) ProtoMessage() {}
+
+func (x *HeadendL2UpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[157]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeadendL2UpdateRequest.ProtoReflect.Descriptor instead.
+func (*HeadendL2UpdateRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *HeadendL2UpdateRequest) GetHeadendL2S() []*HeadendL2 {
+	if x != nil {
+		return x.HeadendL2S
+	}
+	return nil
+}
+
+func (x *HeadendL2UpdateRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type HeadendL2UpdateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Updated []*HeadendL2      `protobuf:"bytes,1,rep,name=updated,proto3" json:"updated,omitempty"` // Entries as installed after the update
+	Errors  []*OperationError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *HeadendL2UpdateResponse) Reset() {
+	*x = HeadendL2UpdateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[158]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeadendL2UpdateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeadendL2UpdateResponse) ProtoMessage() {}
+
+func (x *HeadendL2UpdateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[158]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeadendL2UpdateResponse.ProtoReflect.Descriptor instead.
+func (*HeadendL2UpdateResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *HeadendL2UpdateResponse) GetUpdated() []*HeadendL2 {
+	if x != nil {
+		return x.Updated
+	}
+	return nil
+}
+
+func (x *HeadendL2UpdateResponse) GetErrors() []*OperationError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type HeadendL2DeleteTarget struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InterfaceName string `protobuf:"bytes,1,opt,name=interface_name,json=interfaceName,proto3" json:"interface_name,omitempty"`
+	VlanId        uint32 `protobuf:"varint,2,opt,name=vlan_id,json=vlanId,proto3" json:"vlan_id,omitempty"` // First VLAN for a range AC
+	InnerVlanId   uint32 `protobuf:"varint,3,opt,name=inner_vlan_id,json=innerVlanId,proto3" json:"inner_vlan_id,omitempty"`
+	PortMode      bool   `protobuf:"varint,4,opt,name=port_mode,json=portMode,proto3" json:"port_mode,omitempty"` // The port-mode AC of interface_name
+}
+
+func (x *HeadendL2DeleteTarget) Reset() {
+	*x = HeadendL2DeleteTarget{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[159]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeadendL2DeleteTarget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeadendL2DeleteTarget) ProtoMessage() {}
+
+func (x *HeadendL2DeleteTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[159]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeadendL2DeleteTarget.ProtoReflect.Descriptor instead.
+func (*HeadendL2DeleteTarget) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *HeadendL2DeleteTarget) GetInterfaceName() string {
+	if x != nil {
+		return x.InterfaceName
+	}
+	return ""
+}
+
+func (x *HeadendL2DeleteTarget) GetVlanId() uint32 {
+	if x != nil {
+		return x.VlanId
+	}
+	return 0
+}
+
+func (x *HeadendL2DeleteTarget) GetInnerVlanId() uint32 {
+	if x != nil {
+		return x.InnerVlanId
+	}
+	return 0
+}
+
+func (x *HeadendL2DeleteTarget) GetPortMode() bool {
+	if x != nil {
+		return x.PortMode
+	}
+	return false
+}
+
+type HeadendL2DeleteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Targets []*HeadendL2DeleteTarget `protobuf:"bytes,1,rep,name=targets,proto3" json:"targets,omitempty"`
+}
+
+func (x *HeadendL2DeleteRequest) Reset() {
+	*x = HeadendL2DeleteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[160]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeadendL2DeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeadendL2DeleteRequest) ProtoMessage() {}
+
+func (x *HeadendL2DeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[160]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeadendL2DeleteRequest.ProtoReflect.Descriptor instead.
+func (*HeadendL2DeleteRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *HeadendL2DeleteRequest) GetTargets() []*HeadendL2DeleteTarget {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+type HeadendL2DeleteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Deleted []*HeadendL2DeleteTarget `protobuf:"bytes,1,rep,name=deleted,proto3" json:"deleted,omitempty"`
+	Errors  []*OperationError        `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *HeadendL2DeleteResponse) Reset() {
+	*x = HeadendL2DeleteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[161]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeadendL2DeleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeadendL2DeleteResponse) ProtoMessage() {}
+
+func (x *HeadendL2DeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[161]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeadendL2DeleteResponse.ProtoReflect.Descriptor instead.
+func (*HeadendL2DeleteResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *HeadendL2DeleteResponse) GetDeleted() []*HeadendL2DeleteTarget {
+	if x != nil {
+		return x.Deleted
+	}
+	return nil
+}
+
+func (x *HeadendL2DeleteResponse) GetErrors() []*OperationError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type HeadendL2ListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *HeadendL2ListRequest) Reset() {
+	*x = HeadendL2ListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[162]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeadendL2ListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeadendL2ListRequest) ProtoMessage() {}
+
+func (x *HeadendL2ListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[162]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeadendL2ListRequest.ProtoReflect.Descriptor instead.
+func (*HeadendL2ListRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{162}
+}
+
+type HeadendL2ListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HeadendL2S []*HeadendL2 `protobuf:"bytes,1,rep,name=headend_l2s,json=headendL2s,proto3" json:"headend_l2s,omitempty"`
+}
+
+func (x *HeadendL2ListResponse) Reset() {
+	*x = HeadendL2ListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[163]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeadendL2ListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeadendL2ListResponse) ProtoMessage() {}
+
+func (x *HeadendL2ListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[163]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeadendL2ListResponse.ProtoReflect.Descriptor instead.
+func (*HeadendL2ListResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{163}
+}
+
+func (x *HeadendL2ListResponse) GetHeadendL2S() []*HeadendL2 {
+	if x != nil {
+		return x.HeadendL2S
+	}
+	return nil
+}
+
+type HeadendL2GetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InterfaceName string `protobuf:"bytes,1,opt,name=interface_name,json=interfaceName,proto3" json:"interface_name,omitempty"`
+	VlanId        uint32 `protobuf:"varint,2,opt,name=vlan_id,json=vlanId,proto3" json:"vlan_id,omitempty"` // First VLAN for a range AC
+	InnerVlanId   uint32 `protobuf:"varint,3,opt,name=inner_vlan_id,json=innerVlanId,proto3" json:"inner_vlan_id,omitempty"`
+	PortMode      bool   `protobuf:"varint,4,opt,name=port_mode,json=portMode,proto3" json:"port_mode,omitempty"` // The port-mode AC of interface_name
+}
+
+func (x *HeadendL2GetRequest) Reset() {
+	*x = HeadendL2GetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[164]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeadendL2GetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeadendL2GetRequest) ProtoMessage() {}
+
+func (x *HeadendL2GetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[164]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeadendL2GetRequest.ProtoReflect.Descriptor instead.
+func (*HeadendL2GetRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{164}
+}
+
+func (x *HeadendL2GetRequest) GetInterfaceName() string {
+	if x != nil {
+		return x.InterfaceName
+	}
+	return ""
+}
+
+func (x *HeadendL2GetRequest) GetVlanId() uint32 {
+	if x != nil {
+		return x.VlanId
+	}
+	return 0
+}
+
+func (x *HeadendL2GetRequest) GetInnerVlanId() uint32 {
+	if x != nil {
+		return x.InnerVlanId
+	}
+	return 0
+}
+
+func (x *HeadendL2GetRequest) GetPortMode() bool {
+	if x != nil {
+		return x.PortMode
+	}
+	return false
+}
+
+type HeadendL2GetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HeadendL2 *HeadendL2 `protobuf:"bytes,1,opt,name=headend_l2,json=headendL2,proto3" json:"headend_l2,omitempty"`
+}
+
+func (x *HeadendL2GetResponse) Reset() {
+	*x = HeadendL2GetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[165]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeadendL2GetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeadendL2GetResponse) ProtoMessage() {}
+
+func (x *HeadendL2GetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[165]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeadendL2GetResponse.ProtoReflect.Descriptor instead.
+func (*HeadendL2GetResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{165}
+}
+
+func (x *HeadendL2GetResponse) GetHeadendL2() *HeadendL2 {
+	if x != nil {
+		return x.HeadendL2
+	}
+	return nil
+}
+
+type HeadendL2FlushRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *HeadendL2FlushRequest) Reset() {
+	*x = HeadendL2FlushRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[166]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeadendL2FlushRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeadendL2FlushRequest) ProtoMessage() {}
+
+func (x *HeadendL2FlushRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[166]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeadendL2FlushRequest.ProtoReflect.Descriptor instead.
+func (*HeadendL2FlushRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{166}
+}
+
+type HeadendL2FlushResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeletedCount uint32 `protobuf:"varint,1,opt,name=deleted_count,json=deletedCount,proto3" json:"deleted_count,omitempty"`
+}
+
+func (x *HeadendL2FlushResponse) Reset() {
+	*x = HeadendL2FlushResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[167]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeadendL2FlushResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeadendL2FlushResponse) ProtoMessage() {}
+
+func (x *HeadendL2FlushResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[167]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeadendL2FlushResponse.ProtoReflect.Descriptor instead.
+func (*HeadendL2FlushResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{167}
+}
+
+func (x *HeadendL2FlushResponse) GetDeletedCount() uint32 {
+	if x != nil {
+		return x.DeletedCount
+	}
+	return 0
+}
+
+type StatsCounter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Packets uint64 `protobuf:"varint,2,opt,name=packets,proto3" json:"packets,omitempty"`
+	Bytes   uint64 `protobuf:"varint,3,opt,name=bytes,proto3" json:"bytes,omitempty"`
+}
+
+func (x *StatsCounter) Reset() {
+	*x = StatsCounter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[168]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsCounter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsCounter) ProtoMessage() {}
+
+func (x *StatsCounter) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[168]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsCounter.ProtoReflect.Descriptor instead.
+func (*StatsCounter) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{168}
+}
+
+func (x *StatsCounter) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StatsCounter) GetPackets() uint64 {
+	if x != nil {
+		return x.Packets
+	}
+	return 0
+}
+
+func (x *StatsCounter) GetBytes() uint64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+type StatsShowRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StatsShowRequest) Reset() {
+	*x = StatsShowRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[169]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsShowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsShowRequest) ProtoMessage() {}
+
+func (x *StatsShowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[169]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsShowRequest.ProtoReflect.Descriptor instead.
+func (*StatsShowRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{169}
+}
+
+type StatsShowResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Counters []*StatsCounter `protobuf:"bytes,1,rep,name=counters,proto3" json:"counters,omitempty"`
+}
+
+func (x *StatsShowResponse) Reset() {
+	*x = StatsShowResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[170]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsShowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsShowResponse) ProtoMessage() {}
+
+func (x *StatsShowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[170]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsShowResponse.ProtoReflect.Descriptor instead.
+func (*StatsShowResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{170}
+}
+
+func (x *StatsShowResponse) GetCounters() []*StatsCounter {
+	if x != nil {
+		return x.Counters
+	}
+	return nil
+}
+
+type StatsResetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StatsResetRequest) Reset() {
+	*x = StatsResetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[171]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsResetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsResetRequest) ProtoMessage() {}
+
+func (x *StatsResetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[171]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsResetRequest.ProtoReflect.Descriptor instead.
+func (*StatsResetRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{171}
+}
+
+type StatsResetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StatsResetResponse) Reset() {
+	*x = StatsResetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[172]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsResetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsResetResponse) ProtoMessage() {}
+
+func (x *StatsResetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[172]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsResetResponse.ProtoReflect.Descriptor instead.
+func (*StatsResetResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{172}
+}
+
+// Per-slot invocation counter entry (one per PROG_ARRAY slot).
+// name: human-readable label (built-in enum name, or "plugin:<program>", or empty for reserved).
+type SlotStatsEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MapType string `protobuf:"bytes,1,opt,name=map_type,json=mapType,proto3" json:"map_type,omitempty"` // "endpoint" | "headend_v4" | "headend_v6"
+	Slot    uint32 `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`                     // 0-63 (endpoint) / 0-31 (headend)
+	Name    string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Packets uint64 `protobuf:"varint,4,opt,name=packets,proto3" json:"packets,omitempty"`
+	Bytes   uint64 `protobuf:"varint,5,opt,name=bytes,proto3" json:"bytes,omitempty"`
+}
+
+func (x *SlotStatsEntry) Reset() {
+	*x = SlotStatsEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[173]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SlotStatsEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlotStatsEntry) ProtoMessage() {}
+
+func (x *SlotStatsEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[173]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlotStatsEntry.ProtoReflect.Descriptor instead.
+func (*SlotStatsEntry) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{173}
+}
+
+func (x *SlotStatsEntry) GetMapType() string {
+	if x != nil {
+		return x.MapType
+	}
+	return ""
+}
+
+func (x *SlotStatsEntry) GetSlot() uint32 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *SlotStatsEntry) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SlotStatsEntry) GetPackets() uint64 {
+	if x != nil {
+		return x.Packets
+	}
+	return 0
+}
+
+func (x *SlotStatsEntry) GetBytes() uint64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+type StatsSlotShowRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MapTypes     []string `protobuf:"bytes,1,rep,name=map_types,json=mapTypes,proto3" json:"map_types,omitempty"`              // empty = all three types
+	IncludeEmpty bool     `protobuf:"varint,2,opt,name=include_empty,json=includeEmpty,proto3" json:"include_empty,omitempty"` // include packets==0 slots (default: hide)
+}
+
+func (x *StatsSlotShowRequest) Reset() {
+	*x = StatsSlotShowRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[174]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsSlotShowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsSlotShowRequest) ProtoMessage() {}
+
+func (x *StatsSlotShowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[174]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsSlotShowRequest.ProtoReflect.Descriptor instead.
+func (*StatsSlotShowRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{174}
+}
+
+func (x *StatsSlotShowRequest) GetMapTypes() []string {
+	if x != nil {
+		return x.MapTypes
+	}
+	return nil
+}
+
+func (x *StatsSlotShowRequest) GetIncludeEmpty() bool {
+	if x != nil {
+		return x.IncludeEmpty
+	}
+	return false
+}
+
+type StatsSlotShowResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*SlotStatsEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *StatsSlotShowResponse) Reset() {
+	*x = StatsSlotShowResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[175]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsSlotShowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsSlotShowResponse) ProtoMessage() {}
+
+func (x *StatsSlotShowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[175]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsSlotShowResponse.ProtoReflect.Descriptor instead.
+func (*StatsSlotShowResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{175}
+}
+
+func (x *StatsSlotShowResponse) GetEntries() []*SlotStatsEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type StatsSlotResetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MapTypes []string `protobuf:"bytes,1,rep,name=map_types,json=mapTypes,proto3" json:"map_types,omitempty"` // empty = reset all three types
+}
+
+func (x *StatsSlotResetRequest) Reset() {
+	*x = StatsSlotResetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[176]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsSlotResetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsSlotResetRequest) ProtoMessage() {}
+
+func (x *StatsSlotResetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[176]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsSlotResetRequest.ProtoReflect.Descriptor instead.
+func (*StatsSlotResetRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{176}
+}
+
+func (x *StatsSlotResetRequest) GetMapTypes() []string {
+	if x != nil {
+		return x.MapTypes
+	}
+	return nil
+}
+
+type StatsSlotResetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StatsSlotResetResponse) Reset() {
+	*x = StatsSlotResetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[177]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsSlotResetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsSlotResetResponse) ProtoMessage() {}
+
+func (x *StatsSlotResetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[177]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsSlotResetResponse.ProtoReflect.Descriptor instead.
+func (*StatsSlotResetResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{177}
+}
+
+// OamProbeResult is the outcome of one probe.
+type OamProbeResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index     uint32       `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`        // Segment index (ping) or hop limit (traceroute)
+	Target    string       `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`       // Address the probe was sent to
+	Responder string       `protobuf:"bytes,3,opt,name=responder,proto3" json:"responder,omitempty"` // Source of the answer (empty on timeout)
+	Reply     OamReplyType `protobuf:"varint,4,opt,name=reply,proto3,enum=vinbero.v1.OamReplyType" json:"reply,omitempty"`
+	IcmpCode  uint32       `protobuf:"varint,5,opt,name=icmp_code,json=icmpCode,proto3" json:"icmp_code,omitempty"` // ICMPv6 code of the answer
+	RttUs     uint64       `protobuf:"varint,6,opt,name=rtt_us,json=rttUs,proto3" json:"rtt_us,omitempty"`          // Round-trip time in microseconds
+}
+
+func (x *OamProbeResult) Reset() {
+	*x = OamProbeResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[178]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OamProbeResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OamProbeResult) ProtoMessage() {}
+
+func (x *OamProbeResult) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[178]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OamProbeResult.ProtoReflect.Descriptor instead.
+func (*OamProbeResult) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{178}
+}
+
+func (x *OamProbeResult) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *OamProbeResult) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *OamProbeResult) GetResponder() string {
+	if x != nil {
+		return x.Responder
+	}
+	return ""
+}
+
+func (x *OamProbeResult) GetReply() OamReplyType {
+	if x != nil {
+		return x.Reply
+	}
+	return OamReplyType_OAM_REPLY_TYPE_UNSPECIFIED
+}
+
+func (x *OamProbeResult) GetIcmpCode() uint32 {
+	if x != nil {
+		return x.IcmpCode
+	}
+	return 0
+}
+
+func (x *OamProbeResult) GetRttUs() uint64 {
+	if x != nil {
+		return x.RttUs
+	}
+	return 0
+}
+
+type OamPingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Segments  []string `protobuf:"bytes,1,rep,name=segments,proto3" json:"segments,omitempty"`                     // SID list in traversal order; the last entry is the target
+	Source    string   `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`                         // Optional source address (default: chosen by the kernel)
+	Count     uint32   `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`                          // Probes per segment (default: 1)
+	TimeoutMs uint32   `protobuf:"varint,4,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"` // Per-probe timeout (default: 1000)
+	OamFlag   bool     `protobuf:"varint,5,opt,name=oam_flag,json=oamFlag,proto3" json:"oam_flag,omitempty"`       // Set the SRH O-flag so every SID punts a timestamped copy
+}
+
+func (x *OamPingRequest) Reset() {
+	*x = OamPingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[179]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OamPingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OamPingRequest) ProtoMessage() {}
+
+func (x *OamPingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[179]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OamPingRequest.ProtoReflect.Descriptor instead.
+func (*OamPingRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{179}
+}
+
+func (x *OamPingRequest) GetSegments() []string {
+	if x != nil {
+		return x.Segments
+	}
+	return nil
+}
+
+func (x *OamPingRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *OamPingRequest) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *OamPingRequest) GetTimeoutMs() uint32 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+func (x *OamPingRequest) GetOamFlag() bool {
+	if x != nil {
+		return x.OamFlag
+	}
+	return false
+}
+
+// Results are ordered by segment: the probe for segment i travels
+// segments[0..i] and targets segments[i].
+type OamPingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*OamProbeResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *OamPingResponse) Reset() {
+	*x = OamPingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[180]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OamPingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OamPingResponse) ProtoMessage() {}
+
+func (x *OamPingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[180]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OamPingResponse.ProtoReflect.Descriptor instead.
+func (*OamPingResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{180}
+}
+
+func (x *OamPingResponse) GetResults() []*OamProbeResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type OamTracerouteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Segments  []string `protobuf:"bytes,1,rep,name=segments,proto3" json:"segments,omitempty"`                     // SID list in traversal order; the last entry is the target
+	Source    string   `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`                         // Optional source address (default: chosen by the kernel)
+	MaxHops   uint32   `protobuf:"varint,3,opt,name=max_hops,json=maxHops,proto3" json:"max_hops,omitempty"`       // Highest hop limit probed (default: 30)
+	TimeoutMs uint32   `protobuf:"varint,4,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"` // Per-probe timeout (default: 1000)
+	OamFlag   bool     `protobuf:"varint,5,opt,name=oam_flag,json=oamFlag,proto3" json:"oam_flag,omitempty"`       // Set the SRH O-flag so every SID punts a timestamped copy
+}
+
+func (x *OamTracerouteRequest) Reset() {
+	*x = OamTracerouteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[181]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OamTracerouteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OamTracerouteRequest) ProtoMessage() {}
+
+func (x *OamTracerouteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[181]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OamTracerouteRequest.ProtoReflect.Descriptor instead.
+func (*OamTracerouteRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{181}
+}
+
+func (x *OamTracerouteRequest) GetSegments() []string {
+	if x != nil {
+		return x.Segments
+	}
+	return nil
+}
+
+func (x *OamTracerouteRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *OamTracerouteRequest) GetMaxHops() uint32 {
+	if x != nil {
+		return x.MaxHops
+	}
+	return 0
+}
+
+func (x *OamTracerouteRequest) GetTimeoutMs() uint32 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+func (x *OamTracerouteRequest) GetOamFlag() bool {
+	if x != nil {
+		return x.OamFlag
+	}
+	return false
+}
+
+// One result per hop limit, ending at the first Echo Reply or max_hops.
+type OamTracerouteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hops []*OamProbeResult `protobuf:"bytes,1,rep,name=hops,proto3" json:"hops,omitempty"`
+}
+
+func (x *OamTracerouteResponse) Reset() {
+	*x = OamTracerouteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[182]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OamTracerouteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OamTracerouteResponse) ProtoMessage() {}
+
+func (x *OamTracerouteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[182]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OamTracerouteResponse.ProtoReflect.Descriptor instead.
+func (*OamTracerouteResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{182}
+}
+
+func (x *OamTracerouteResponse) GetHops() []*OamProbeResult {
+	if x != nil {
+		return x.Hops
+	}
+	return nil
+}
+
+// OamPunt is one timestamped copy received from the data plane.
+type OamPunt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TimestampUnixNano int64           `protobuf:"varint,1,opt,name=timestamp_unix_nano,json=timestampUnixNano,proto3" json:"timestamp_unix_nano,omitempty"` // Data plane timestamp converted to wall clock
+	InterfaceName     string          `protobuf:"bytes,2,opt,name=interface_name,json=interfaceName,proto3" json:"interface_name,omitempty"`                // Ingress interface
+	Reason            string          `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`                                                   // "o-flag" | "end-op"
+	Action            Srv6LocalAction `protobuf:"varint,4,opt,name=action,proto3,enum=vinbero.v1.Srv6LocalAction" json:"action,omitempty"`                  // Behavior of the matched SID
+	Src               string          `protobuf:"bytes,5,opt,name=src,proto3" json:"src,omitempty"`                                                         // IPv6 source address
+	Dst               string          `protobuf:"bytes,6,opt,name=dst,proto3" json:"dst,omitempty"`                                                         // IPv6 destination address (the local SID)
+	SegmentsLeft      uint32          `protobuf:"varint,7,opt,name=segments_left,json=segmentsLeft,proto3" json:"segments_left,omitempty"`
+	Segments          []string        `protobuf:"bytes,8,rep,name=segments,proto3" json:"segments,omitempty"`                              // SRH segment list in traversal order
+	PacketLength      uint32          `protobuf:"varint,9,opt,name=packet_length,json=packetLength,proto3" json:"packet_length,omitempty"` // Original frame length
+}
+
+func (x *OamPunt) Reset() {
+	*x = OamPunt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[183]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OamPunt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OamPunt) ProtoMessage() {}
+
+func (x *OamPunt) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[183]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OamPunt.ProtoReflect.Descriptor instead.
+func (*OamPunt) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{183}
+}
+
+func (x *OamPunt) GetTimestampUnixNano() int64 {
+	if x != nil {
+		return x.TimestampUnixNano
+	}
+	return 0
+}
+
+func (x *OamPunt) GetInterfaceName() string {
+	if x != nil {
+		return x.InterfaceName
+	}
+	return ""
+}
+
+func (x *OamPunt) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *OamPunt) GetAction() Srv6LocalAction {
+	if x != nil {
+		return x.Action
+	}
+	return Srv6LocalAction_SRV6_LOCAL_ACTION_UNSPECIFIED
+}
+
+func (x *OamPunt) GetSrc() string {
+	if x != nil {
+		return x.Src
+	}
+	return ""
+}
+
+func (x *OamPunt) GetDst() string {
+	if x != nil {
+		return x.Dst
+	}
+	return ""
+}
+
+func (x *OamPunt) GetSegmentsLeft() uint32 {
+	if x != nil {
+		return x.SegmentsLeft
+	}
+	return 0
+}
+
+func (x *OamPunt) GetSegments() []string {
+	if x != nil {
+		return x.Segments
+	}
+	return nil
+}
+
+func (x *OamPunt) GetPacketLength() uint32 {
+	if x != nil {
+		return x.PacketLength
+	}
+	return 0
+}
+
+type OamPuntListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limit uint32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"` // Most recent N punts (0 = all buffered)
+}
+
+func (x *OamPuntListRequest) Reset() {
+	*x = OamPuntListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[184]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OamPuntListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OamPuntListRequest) ProtoMessage() {}
+
+func (x *OamPuntListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[184]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OamPuntListRequest.ProtoReflect.Descriptor instead.
+func (*OamPuntListRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{184}
+}
+
+func (x *OamPuntListRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type OamPuntListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Punts []*OamPunt `protobuf:"bytes,1,rep,name=punts,proto3" json:"punts,omitempty"`
+}
+
+func (x *OamPuntListResponse) Reset() {
+	*x = OamPuntListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[185]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OamPuntListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OamPuntListResponse) ProtoMessage() {}
+
+func (x *OamPuntListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[185]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OamPuntListResponse.ProtoReflect.Descriptor instead.
+func (*OamPuntListResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{185}
+}
+
+func (x *OamPuntListResponse) GetPunts() []*OamPunt {
+	if x != nil {
+		return x.Punts
+	}
+	return nil
+}
+
+// StampReflector answers test packets sent to address:port. Test packets
+// that arrive over an SR policy (SRH with SL=0) are answered too.
+type StampReflector struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address          string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`                                            // Local IPv6 address (e.g., a loopback or the policy's last SID)
+	Port             uint32 `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`                                                 // UDP port (default: 862)
+	ReflectedPackets uint64 `protobuf:"varint,3,opt,name=reflected_packets,json=reflectedPackets,proto3" json:"reflected_packets,omitempty"` // Output only
+}
+
+func (x *StampReflector) Reset() {
+	*x = StampReflector{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[186]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StampReflector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StampReflector) ProtoMessage() {}
+
+func (x *StampReflector) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[186]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StampReflector.ProtoReflect.Descriptor instead.
+func (*StampReflector) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{186}
+}
+
+func (x *StampReflector) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *StampReflector) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *StampReflector) GetReflectedPackets() uint64 {
+	if x != nil {
+		return x.ReflectedPackets
+	}
+	return 0
+}
+
+type StampReflectorCreateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reflectors []*StampReflector `protobuf:"bytes,1,rep,name=reflectors,proto3" json:"reflectors,omitempty"`
+}
+
+func (x *StampReflectorCreateRequest) Reset() {
+	*x = StampReflectorCreateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[187]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StampReflectorCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StampReflectorCreateRequest) ProtoMessage() {}
+
+func (x *StampReflectorCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[187]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StampReflectorCreateRequest.ProtoReflect.Descriptor instead.
+func (*StampReflectorCreateRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{187}
+}
+
+func (x *StampReflectorCreateRequest) GetReflectors() []*StampReflector {
+	if x != nil {
+		return x.Reflectors
+	}
+	return nil
+}
+
+type StampReflectorCreateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Created []*StampReflector `protobuf:"bytes,1,rep,name=created,proto3" json:"created,omitempty"`
+	Errors  []*OperationError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *StampReflectorCreateResponse) Reset() {
+	*x = StampReflectorCreateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[188]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StampReflectorCreateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StampReflectorCreateResponse) ProtoMessage() {}
+
+func (x *StampReflectorCreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[188]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StampReflectorCreateResponse.ProtoReflect.Descriptor instead.
+func (*StampReflectorCreateResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{188}
+}
+
+func (x *StampReflectorCreateResponse) GetCreated() []*StampReflector {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *StampReflectorCreateResponse) GetErrors() []*OperationError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type StampReflectorDeleteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reflectors []*StampReflector `protobuf:"bytes,1,rep,name=reflectors,proto3" json:"reflectors,omitempty"` // Matched by address and port
+}
+
+func (x *StampReflectorDeleteRequest) Reset() {
+	*x = StampReflectorDeleteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[189]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StampReflectorDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StampReflectorDeleteRequest) ProtoMessage() {}
+
+func (x *StampReflectorDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[189]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StampReflectorDeleteRequest.ProtoReflect.Descriptor instead.
+func (*StampReflectorDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{189}
+}
+
+func (x *StampReflectorDeleteRequest) GetReflectors() []*StampReflector {
+	if x != nil {
+		return x.Reflectors
+	}
+	return nil
+}
+
+type StampReflectorDeleteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Deleted []*StampReflector `protobuf:"bytes,1,rep,name=deleted,proto3" json:"deleted,omitempty"`
+	Errors  []*OperationError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *StampReflectorDeleteResponse) Reset() {
+	*x = StampReflectorDeleteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[190]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StampReflectorDeleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StampReflectorDeleteResponse) ProtoMessage() {}
+
+func (x *StampReflectorDeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[190]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StampReflectorDeleteResponse.ProtoReflect.Descriptor instead.
+func (*StampReflectorDeleteResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{190}
+}
+
+func (x *StampReflectorDeleteResponse) GetDeleted() []*StampReflector {
+	if x != nil {
+		return x.Deleted
+	}
+	return nil
+}
+
+func (x *StampReflectorDeleteResponse) GetErrors() []*OperationError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type StampReflectorListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StampReflectorListRequest) Reset() {
+	*x = StampReflectorListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[191]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StampReflectorListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StampReflectorListRequest) ProtoMessage() {}
+
+func (x *StampReflectorListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[191]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StampReflectorListRequest.ProtoReflect.Descriptor instead.
+func (*StampReflectorListRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{191}
+}
+
+type StampReflectorListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reflectors []*StampReflector `protobuf:"bytes,1,rep,name=reflectors,proto3" json:"reflectors,omitempty"`
+}
+
+func (x *StampReflectorListResponse) Reset() {
+	*x = StampReflectorListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[192]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StampReflectorListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StampReflectorListResponse) ProtoMessage() {}
+
+func (x *StampReflectorListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[192]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StampReflectorListResponse.ProtoReflect.Descriptor instead.
+func (*StampReflectorListResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{192}
+}
+
+func (x *StampReflectorListResponse) GetReflectors() []*StampReflector {
+	if x != nil {
+		return x.Reflectors
+	}
+	return nil
+}
+
+// StampSession is a Session-Sender. The path is either an explicit
+// segment list or the segment list and source of an existing SR policy.
+type StampSession struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                // Unique session name
+	Segments   []string `protobuf:"bytes,2,rep,name=segments,proto3" json:"segments,omitempty"`                        // SID list in traversal order; the last entry is the reflector
+	Policy     string   `protobuf:"bytes,3,opt,name=policy,proto3" json:"policy,omitempty"`                            // Alternative to segments: trigger prefix of a Headendv6/Headendv4 entry
+	Source     string   `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`                            // Optional source address (default: policy source or chosen by the kernel)
+	Port       uint32   `protobuf:"varint,5,opt,name=port,proto3" json:"port,omitempty"`                               // Reflector UDP port (default: 862)
+	IntervalMs uint32   `protobuf:"varint,6,opt,name=interval_ms,json=intervalMs,proto3" json:"interval_ms,omitempty"` // Time between test packets (default: 1000)
+	TimeoutMs  uint32   `protobuf:"varint,7,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`    // A test packet without reply after this long is lost (default: 1000)
+	Window     uint32   `protobuf:"varint,8,opt,name=window,proto3" json:"window,omitempty"`                           // Test packets covered by the rolling statistics (default: 100)
+}
+
+func (x *StampSession) Reset() {
+	*x = StampSession{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[193]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StampSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StampSession) ProtoMessage() {}
+
+func (x *StampSession) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[193]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StampSession.ProtoReflect.Descriptor instead.
+func (*StampSession) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{193}
+}
+
+func (x *StampSession) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StampSession) GetSegments() []string {
+	if x != nil {
+		return x.Segments
+	}
+	return nil
+}
+
+func (x *StampSession) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+func (x *StampSession) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *StampSession) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *StampSession) GetIntervalMs() uint32 {
+	if x != nil {
+		return x.IntervalMs
+	}
+	return 0
+}
+
+func (x *StampSession) GetTimeoutMs() uint32 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+func (x *StampSession) GetWindow() uint32 {
+	if x != nil {
+		return x.Window
+	}
+	return 0
+}
+
+// StampSessionStats are lifetime counters plus rolling figures over the
+// last `window` test packets. Delays are round-trip with the reflector
+// residence time removed, so they do not need synchronized clocks.
+type StampSessionStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sent              uint64  `protobuf:"varint,1,opt,name=sent,proto3" json:"sent,omitempty"`
+	Received          uint64  `protobuf:"varint,2,opt,name=received,proto3" json:"received,omitempty"`
+	Lost              uint64  `protobuf:"varint,3,opt,name=lost,proto3" json:"lost,omitempty"`
+	Window            uint32  `protobuf:"varint,4,opt,name=window,proto3" json:"window,omitempty"` // Test packets in the rolling window
+	WindowLost        uint32  `protobuf:"varint,5,opt,name=window_lost,json=windowLost,proto3" json:"window_lost,omitempty"`
+	LossRatio         float64 `protobuf:"fixed64,6,opt,name=loss_ratio,json=lossRatio,proto3" json:"loss_ratio,omitempty"` // window_lost / window
+	LastDelayNs       uint64  `protobuf:"varint,7,opt,name=last_delay_ns,json=lastDelayNs,proto3" json:"last_delay_ns,omitempty"`
+	MinDelayNs        uint64  `protobuf:"varint,8,opt,name=min_delay_ns,json=minDelayNs,proto3" json:"min_delay_ns,omitempty"`
+	MaxDelayNs        uint64  `protobuf:"varint,9,opt,name=max_delay_ns,json=maxDelayNs,proto3" json:"max_delay_ns,omitempty"`
+	AvgDelayNs        uint64  `protobuf:"varint,10,opt,name=avg_delay_ns,json=avgDelayNs,proto3" json:"avg_delay_ns,omitempty"`
+	JitterNs          uint64  `protobuf:"varint,11,opt,name=jitter_ns,json=jitterNs,proto3" json:"jitter_ns,omitempty"` // Mean absolute delay variation between consecutive replies
+	LastReplyUnixNano int64   `protobuf:"varint,12,opt,name=last_reply_unix_nano,json=lastReplyUnixNano,proto3" json:"last_reply_unix_nano,omitempty"`
+}
+
+func (x *StampSessionStats) Reset() {
+	*x = StampSessionStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[194]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StampSessionStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StampSessionStats) ProtoMessage() {}
+
+func (x *StampSessionStats) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[194]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StampSessionStats.ProtoReflect.Descriptor instead.
+func (*StampSessionStats) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{194}
+}
+
+func (x *StampSessionStats) GetSent() uint64 {
+	if x != nil {
+		return x.Sent
+	}
+	return 0
+}
+
+func (x *StampSessionStats) GetReceived() uint64 {
+	if x != nil {
+		return x.Received
+	}
+	return 0
+}
+
+func (x *StampSessionStats) GetLost() uint64 {
+	if x != nil {
+		return x.Lost
+	}
+	return 0
+}
+
+func (x *StampSessionStats) GetWindow() uint32 {
+	if x != nil {
+		return x.Window
+	}
+	return 0
+}
+
+func (x *StampSessionStats) GetWindowLost() uint32 {
+	if x != nil {
+		return x.WindowLost
+	}
+	return 0
+}
+
+func (x *StampSessionStats) GetLossRatio() float64 {
+	if x != nil {
+		return x.LossRatio
+	}
+	return 0
+}
+
+func (x *StampSessionStats) GetLastDelayNs() uint64 {
+	if x != nil {
+		return x.LastDelayNs
+	}
+	return 0
+}
+
+func (x *StampSessionStats) GetMinDelayNs() uint64 {
+	if x != nil {
+		return x.MinDelayNs
+	}
+	return 0
+}
+
+func (x *StampSessionStats) GetMaxDelayNs() uint64 {
+	if x != nil {
+		return x.MaxDelayNs
+	}
+	return 0
+}
+
+func (x *StampSessionStats) GetAvgDelayNs() uint64 {
+	if x != nil {
+		return x.AvgDelayNs
+	}
+	return 0
+}
+
+func (x *StampSessionStats) GetJitterNs() uint64 {
+	if x != nil {
+		return x.JitterNs
+	}
+	return 0
+}
+
+func (x *StampSessionStats) GetLastReplyUnixNano() int64 {
+	if x != nil {
+		return x.LastReplyUnixNano
+	}
+	return 0
+}
+
+type StampSessionStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Session *StampSession      `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"` // Resolved configuration (segments filled in for policies)
+	Stats   *StampSessionStats `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats,omitempty"`
+}
+
+func (x *StampSessionStatus) Reset() {
+	*x = StampSessionStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[195]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StampSessionStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StampSessionStatus) ProtoMessage() {}
+
+func (x *StampSessionStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[195]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StampSessionStatus.ProtoReflect.Descriptor instead.
+func (*StampSessionStatus) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{195}
+}
+
+func (x *StampSessionStatus) GetSession() *StampSession {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+func (x *StampSessionStatus) GetStats() *StampSessionStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type StampSessionCreateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sessions []*StampSession `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+}
+
+func (x *StampSessionCreateRequest) Reset() {
+	*x = StampSessionCreateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[196]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StampSessionCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StampSessionCreateRequest) ProtoMessage() {}
+
+func (x *StampSessionCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[196]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StampSessionCreateRequest.ProtoReflect.Descriptor instead.
+func (*StampSessionCreateRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{196}
+}
+
+func (x *StampSessionCreateRequest) GetSessions() []*StampSession {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type StampSessionCreateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Created []*StampSession   `protobuf:"bytes,1,rep,name=created,proto3" json:"created,omitempty"`
+	Errors  []*OperationError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *StampSessionCreateResponse) Reset() {
+	*x = StampSessionCreateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[197]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StampSessionCreateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StampSessionCreateResponse) ProtoMessage() {}
+
+func (x *StampSessionCreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[197]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StampSessionCreateResponse.ProtoReflect.Descriptor instead.
+func (*StampSessionCreateResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{197}
+}
+
+func (x *StampSessionCreateResponse) GetCreated() []*StampSession {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *StampSessionCreateResponse) GetErrors() []*OperationError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type StampSessionDeleteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Names []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+}
+
+func (x *StampSessionDeleteRequest) Reset() {
+	*x = StampSessionDeleteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[198]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StampSessionDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StampSessionDeleteRequest) ProtoMessage() {}
+
+func (x *StampSessionDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[198]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StampSessionDeleteRequest.ProtoReflect.Descriptor instead.
+func (*StampSessionDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{198}
+}
+
+func (x *StampSessionDeleteRequest) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+type StampSessionDeleteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeletedNames []string          `protobuf:"bytes,1,rep,name=deleted_names,json=deletedNames,proto3" json:"deleted_names,omitempty"`
+	Errors       []*OperationError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *StampSessionDeleteResponse) Reset() {
+	*x = StampSessionDeleteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[199]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StampSessionDeleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StampSessionDeleteResponse) ProtoMessage() {}
+
+func (x *StampSessionDeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[199]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StampSessionDeleteResponse.ProtoReflect.Descriptor instead.
+func (*StampSessionDeleteResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{199}
+}
+
+func (x *StampSessionDeleteResponse) GetDeletedNames() []string {
+	if x != nil {
+		return x.DeletedNames
+	}
+	return nil
+}
+
+func (x *StampSessionDeleteResponse) GetErrors() []*OperationError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type StampSessionListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StampSessionListRequest) Reset() {
+	*x = StampSessionListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[200]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StampSessionListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StampSessionListRequest) ProtoMessage() {}
+
+func (x *StampSessionListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[200]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StampSessionListRequest.ProtoReflect.Descriptor instead.
+func (*StampSessionListRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{200}
+}
+
+type StampSessionListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sessions []*StampSessionStatus `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+}
+
+func (x *StampSessionListResponse) Reset() {
+	*x = StampSessionListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[201]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StampSessionListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StampSessionListResponse) ProtoMessage() {}
+
+func (x *StampSessionListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[201]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StampSessionListResponse.ProtoReflect.Descriptor instead.
+func (*StampSessionListResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{201}
+}
+
+func (x *StampSessionListResponse) GetSessions() []*StampSessionStatus {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+// ConfigSectionResult is the outcome of one list of the config: section.
+type ConfigSectionResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // Section key in vinbero.yml (e.g., "sid_functions")
+	Created   uint32            `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	Updated   uint32            `protobuf:"varint,3,opt,name=updated,proto3" json:"updated,omitempty"`
+	Deleted   uint32            `protobuf:"varint,4,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	Unchanged uint32            `protobuf:"varint,5,opt,name=unchanged,proto3" json:"unchanged,omitempty"`
+	Errors    []*OperationError `protobuf:"bytes,6,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *ConfigSectionResult) Reset() {
+	*x = ConfigSectionResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[202]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigSectionResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigSectionResult) ProtoMessage() {}
+
+func (x *ConfigSectionResult) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[202]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigSectionResult.ProtoReflect.Descriptor instead.
+func (*ConfigSectionResult) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{202}
+}
+
+func (x *ConfigSectionResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ConfigSectionResult) GetCreated() uint32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *ConfigSectionResult) GetUpdated() uint32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *ConfigSectionResult) GetDeleted() uint32 {
+	if x != nil {
+		return x.Deleted
+	}
+	return 0
+}
+
+func (x *ConfigSectionResult) GetUnchanged() uint32 {
+	if x != nil {
+		return x.Unchanged
+	}
+	return 0
+}
+
+func (x *ConfigSectionResult) GetErrors() []*OperationError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type ReloadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ReloadRequest) Reset() {
+	*x = ReloadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[203]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReloadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadRequest) ProtoMessage() {}
+
+func (x *ReloadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[203]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloadRequest.ProtoReflect.Descriptor instead.
+func (*ReloadRequest) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{203}
+}
+
+type ReloadResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sections        []*ConfigSectionResult `protobuf:"bytes,1,rep,name=sections,proto3" json:"sections,omitempty"`
+	RestartRequired []string               `protobuf:"bytes,2,rep,name=restart_required,json=restartRequired,proto3" json:"restart_required,omitempty"` // Changed keys outside config: (e.g., "internal.devices", "settings.entries.fdb.capacity")
+}
+
+func (x *ReloadResponse) Reset() {
+	*x = ReloadResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vinbero_v1_vinbero_proto_msgTypes[204]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReloadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadResponse) ProtoMessage() {}
+
+func (x *ReloadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vinbero_v1_vinbero_proto_msgTypes[204]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloadResponse.ProtoReflect.Descriptor instead.
+func (*ReloadResponse) Descriptor() ([]byte, []int) {
+	return file_vinbero_v1_vinbero_proto_rawDescGZIP(), []int{204}
+}
+
+func (x *ReloadResponse) GetSections() []*ConfigSectionResult {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+func (x *ReloadResponse) GetRestartRequired() []string {
+	if x != nil {
+		return x.RestartRequired
+	}
+	return nil
+}
+
+var File_vinbero_v1_vinbero_proto protoreflect.FileDescriptor
+
+var file_vinbero_v1_vinbero_proto_rawDesc = []byte{
+	0x0a, 0x18, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x69, 0x6e,
+	0x62, 0x65, 0x72, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x76, 0x69, 0x6e, 0x62,
+	0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61,
+	0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72,
+	0x6f, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0xd3, 0x05, 0x0a, 0x0b, 0x53, 0x69, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x33, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1b, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x72,
+	0x76, 0x36, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
+	0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74,
+	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x19, 0x0a, 0x08,
+	0x73, 0x72, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x73, 0x72, 0x63, 0x41, 0x64, 0x64, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x64, 0x73, 0x74, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x73, 0x74, 0x41, 0x64,
+	0x64, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x78, 0x74, 0x68, 0x6f, 0x70, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x78, 0x74, 0x68, 0x6f, 0x70, 0x12, 0x33, 0x0a, 0x06,
+	0x66, 0x6c, 0x61, 0x76, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x76,
+	0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x72, 0x76, 0x36, 0x4c, 0x6f,
+	0x63, 0x61, 0x6c, 0x46, 0x6c, 0x61, 0x76, 0x6f, 0x72, 0x52, 0x06, 0x66, 0x6c, 0x61, 0x76, 0x6f,
+	0x72, 0x12, 0x24, 0x0a, 0x0e, 0x61, 0x72, 0x67, 0x5f, 0x73, 0x72, 0x63, 0x5f, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x61, 0x72, 0x67, 0x53, 0x72,
+	0x63, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x61, 0x72, 0x67, 0x5f, 0x64,
+	0x73, 0x74, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0c, 0x61, 0x72, 0x67, 0x44, 0x73, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x6f, 0x69, 0x66, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6f, 0x69, 0x66, 0x12,
+	0x19, 0x0a, 0x08, 0x76, 0x72, 0x66, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x76, 0x72, 0x66, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x13, 0x0a, 0x05, 0x62, 0x64,
+	0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x62, 0x64, 0x49, 0x64, 0x12,
+	0x1f, 0x0a, 0x0b, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0d, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x42, 0x0a, 0x0c,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x72, 0x76, 0x36, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x42, 0x65, 0x68, 0x61, 0x76,
+	0x69, 0x6f, 0x72, 0x52, 0x0b, 0x68, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x4d, 0x6f, 0x64, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x72, 0x67, 0x73, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x61, 0x72, 0x67, 0x73, 0x4f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x12, 0x25, 0x0a, 0x0f, 0x67, 0x74, 0x70, 0x5f, 0x76, 0x34, 0x5f, 0x73, 0x72, 0x63, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x67, 0x74, 0x70, 0x56,
+	0x34, 0x53, 0x72, 0x63, 0x41, 0x64, 0x64, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x61, 0x75,
+	0x78, 0x5f, 0x72, 0x61, 0x77, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x41, 0x75, 0x78, 0x52, 0x61, 0x77, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x5f, 0x61, 0x75, 0x78, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x13, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x41, 0x75, 0x78, 0x4a, 0x73, 0x6f,
+	0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x63, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x61, 0x67, 0x61,
+	0x74, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x65, 0x63, 0x6e, 0x50, 0x72, 0x6f,
+	0x70, 0x61, 0x67, 0x61, 0x74, 0x65, 0x22, 0x58, 0x0a, 0x18, 0x53, 0x69, 0x64, 0x46, 0x75, 0x6e,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x3c, 0x0a, 0x0d, 0x73, 0x69, 0x64, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x69, 0x6e, 0x62,
+	0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0c, 0x73, 0x69, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0x82, 0x01, 0x0a, 0x19, 0x53, 0x69, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31,
+	0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x64,
+	0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x12, 0x32, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x95, 0x01, 0x0a, 0x18, 0x53, 0x69, 0x64, 0x46, 0x75, 0x6e,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x3c, 0x0a, 0x0d, 0x73, 0x69, 0x64, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x69, 0x6e, 0x62,
+	0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0c, 0x73, 0x69, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73,
+	0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0x82, 0x01,
+	0x0a, 0x19, 0x53, 0x69, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76,
+	0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x64, 0x46, 0x75, 0x6e,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x32,
+	0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x22, 0x45, 0x0a, 0x18, 0x53, 0x69, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29,
+	0x0a, 0x10, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65,
+	0x72, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x22, 0x89, 0x01, 0x0a, 0x19, 0x53, 0x69,
+	0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x18, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x5f, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x16, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65,
+	0x73, 0x12, 0x32, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x18, 0x0a, 0x16, 0x53, 0x69, 0x64, 0x46, 0x75, 0x6e, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x57, 0x0a, 0x17, 0x53, 0x69, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0d, 0x73, 0x69,
+	0x64, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x69, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x73, 0x69, 0x64, 0x46,
+	0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x3e, 0x0a, 0x15, 0x53, 0x69, 0x64, 0x46,
+	0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x69, 0x67, 0x67,
+	0x65, 0x72, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x22, 0x54, 0x0a, 0x16, 0x53, 0x69, 0x64, 0x46,
+	0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x73, 0x69, 0x64, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65,
+	0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0b, 0x73, 0x69, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x19,
+	0x0a, 0x17, 0x53, 0x69, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6c, 0x75,
+	0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3f, 0x0a, 0x18, 0x53, 0x69, 0x64,
+	0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xe4, 0x03, 0x0a, 0x09, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x34, 0x12, 0x33, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x72, 0x76, 0x36, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x42,
+	0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x25, 0x0a,
+	0x0e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x50, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x72, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x72, 0x63, 0x41, 0x64, 0x64, 0x72, 0x12,
+	0x19, 0x0a, 0x08, 0x64, 0x73, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x64, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65,
+	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65,
+	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x72, 0x67, 0x73, 0x5f, 0x6f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x61, 0x72, 0x67,
+	0x73, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x74, 0x75, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6d, 0x74, 0x75, 0x12, 0x34, 0x0a, 0x07, 0x74, 0x63, 0x5f,
+	0x6d, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x76, 0x69, 0x6e,
+	0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x72, 0x76, 0x36, 0x45, 0x6e, 0x63, 0x61,
+	0x70, 0x54, 0x63, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x06, 0x74, 0x63, 0x4d, 0x6f, 0x64, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x74, 0x63, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x74, 0x63, 0x12,
+	0x47, 0x0a, 0x0e, 0x68, 0x6f, 0x70, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x6d, 0x6f, 0x64,
+	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72,
+	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x72, 0x76, 0x36, 0x45, 0x6e, 0x63, 0x61, 0x70, 0x48, 0x6f,
+	0x70, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0c, 0x68, 0x6f, 0x70, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x68, 0x6f, 0x70, 0x5f,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x68, 0x6f, 0x70,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x4a, 0x0a, 0x0f, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22,
+	0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x72, 0x76, 0x36,
+	0x45, 0x6e, 0x63, 0x61, 0x70, 0x46, 0x6c, 0x6f, 0x77, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x4d, 0x6f,
+	0x64, 0x65, 0x52, 0x0d, 0x66, 0x6c, 0x6f, 0x77, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x4d, 0x6f, 0x64,
+	0x65, 0x22, 0x4f, 0x0a, 0x16, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x34, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x0a, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x34, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x6e, 0x64, 0x76, 0x34, 0x52, 0x0a, 0x68, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76,
+	0x34, 0x73, 0x22, 0x7e, 0x0a, 0x17, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x34, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a,
+	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x6e, 0x64, 0x76, 0x34, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x32,
+	0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x22, 0x8c, 0x01, 0x0a, 0x16, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x34,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a,
+	0x0a, 0x68, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x34, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x34, 0x52, 0x0a, 0x68, 0x65, 0x61, 0x64, 0x65, 0x6e,
+	0x64, 0x76, 0x34, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d,
+	0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73,
+	0x6b, 0x22, 0x7e, 0x0a, 0x17, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x34, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x07,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x6e, 0x64, 0x76, 0x34, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x32, 0x0a,
+	0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x22, 0x43, 0x0a, 0x16, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x34, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x74,
+	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x50, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x22, 0x87, 0x01, 0x0a, 0x17, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x6e, 0x64, 0x76, 0x34, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x38, 0x0a, 0x18, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x16, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x54, 0x72, 0x69,
+	0x67, 0x67, 0x65, 0x72, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x06,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76,
+	0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73,
+	0x22, 0x16, 0x0a, 0x14, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x34, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4e, 0x0a, 0x15, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x6e, 0x64, 0x76, 0x34, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x35, 0x0a, 0x0a, 0x68, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x34, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x34, 0x52, 0x0a, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x34, 0x73, 0x22, 0x3c, 0x0a, 0x13, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x6e, 0x64, 0x76, 0x34, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x25, 0x0a, 0x0e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
+	0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x22, 0x4b, 0x0a, 0x14, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e,
+	0x64, 0x76, 0x34, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33,
+	0x0a, 0x09, 0x68, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x34, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x34, 0x52, 0x09, 0x68, 0x65, 0x61, 0x64, 0x65, 0x6e,
+	0x64, 0x76, 0x34, 0x22, 0x17, 0x0a, 0x15, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x34,
+	0x46, 0x6c, 0x75, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3d, 0x0a, 0x16,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x34, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xc3, 0x03, 0x0a, 0x09,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x36, 0x12, 0x33, 0x0a, 0x04, 0x6d, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72,
+	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x72, 0x76, 0x36, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64,
+	0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x25,
+	0x0a, 0x0e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x50,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x72, 0x63, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x72, 0x63, 0x41, 0x64, 0x64, 0x72,
+	0x12, 0x19, 0x0a, 0x08, 0x64, 0x73, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x64, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x74, 0x75, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6d, 0x74, 0x75, 0x12, 0x34, 0x0a, 0x07, 0x74, 0x63, 0x5f,
+	0x6d, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x76, 0x69, 0x6e,
+	0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x72, 0x76, 0x36, 0x45, 0x6e, 0x63, 0x61,
+	0x70, 0x54, 0x63, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x06, 0x74, 0x63, 0x4d, 0x6f, 0x64, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x74, 0x63, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x74, 0x63, 0x12,
+	0x47, 0x0a, 0x0e, 0x68, 0x6f, 0x70, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x6d, 0x6f, 0x64,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72,
+	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x72, 0x76, 0x36, 0x45, 0x6e, 0x63, 0x61, 0x70, 0x48, 0x6f,
+	0x70, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0c, 0x68, 0x6f, 0x70, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x68, 0x6f, 0x70, 0x5f,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x68, 0x6f, 0x70,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x4a, 0x0a, 0x0f, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22,
+	0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x72, 0x76, 0x36,
+	0x45, 0x6e, 0x63, 0x61, 0x70, 0x46, 0x6c, 0x6f, 0x77, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x4d, 0x6f,
+	0x64, 0x65, 0x52, 0x0d, 0x66, 0x6c, 0x6f, 0x77, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x4d, 0x6f, 0x64,
+	0x65, 0x22, 0x4f, 0x0a, 0x16, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x36, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x0a, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x36, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x6e, 0x64, 0x76, 0x36, 0x52, 0x0a, 0x68, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76,
+	0x36, 0x73, 0x22, 0x7e, 0x0a, 0x17, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x36, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a,
+	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x6e, 0x64, 0x76, 0x36, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x32,
+	0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x22, 0x8c, 0x01, 0x0a, 0x16, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x36,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a,
+	0x0a, 0x68, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x36, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x36, 0x52, 0x0a, 0x68, 0x65, 0x61, 0x64, 0x65, 0x6e,
+	0x64, 0x76, 0x36, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d,
+	0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73,
+	0x6b, 0x22, 0x7e, 0x0a, 0x17, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x36, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x07,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x6e, 0x64, 0x76, 0x36, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x32, 0x0a,
+	0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x22, 0x43, 0x0a, 0x16, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x36, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x74,
+	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x50, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x22, 0x87, 0x01, 0x0a, 0x17, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x6e, 0x64, 0x76, 0x36, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x38, 0x0a, 0x18, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x16, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x54, 0x72, 0x69,
+	0x67, 0x67, 0x65, 0x72, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x06,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76,
+	0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73,
+	0x22, 0x16, 0x0a, 0x14, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x36, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4e, 0x0a, 0x15, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x6e, 0x64, 0x76, 0x36, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x35, 0x0a, 0x0a, 0x68, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x36, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x36, 0x52, 0x0a, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x36, 0x73, 0x22, 0x3c, 0x0a, 0x13, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x6e, 0x64, 0x76, 0x36, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x25, 0x0a, 0x0e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
+	0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x22, 0x4b, 0x0a, 0x14, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e,
+	0x64, 0x76, 0x36, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33,
+	0x0a, 0x09, 0x68, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x36, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x36, 0x52, 0x09, 0x68, 0x65, 0x61, 0x64, 0x65, 0x6e,
+	0x64, 0x76, 0x36, 0x22, 0x17, 0x0a, 0x15, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x36,
+	0x46, 0x6c, 0x75, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3d, 0x0a, 0x16,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x36, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xf1, 0x02, 0x0a, 0x08,
+	0x46, 0x64, 0x62, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x13, 0x0a, 0x05, 0x62, 0x64, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x62, 0x64, 0x49, 0x64, 0x12, 0x10, 0x0a,
+	0x03, 0x6d, 0x61, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x61, 0x63, 0x12,
+	0x10, 0x0a, 0x03, 0x6f, 0x69, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6f, 0x69,
+	0x66, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x75, 0x6e, 0x74, 0x61, 0x67, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x55, 0x6e, 0x74, 0x61, 0x67, 0x12, 0x1b, 0x0a, 0x09,
+	0x69, 0x73, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x69, 0x73, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73,
+	0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73,
+	0x65, 0x65, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x53,
+	0x65, 0x65, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x73, 0x69, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x65, 0x73, 0x69, 0x12, 0x17, 0x0a, 0x07, 0x76, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x76, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x22,
+	0x0a, 0x0d, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x76, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x56, 0x6c, 0x61, 0x6e,
+	0x49, 0x64, 0x12, 0x3a, 0x0a, 0x0c, 0x76, 0x6c, 0x61, 0x6e, 0x5f, 0x72, 0x65, 0x77, 0x72, 0x69,
+	0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65,
+	0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x77, 0x72, 0x69, 0x74,
+	0x65, 0x52, 0x0b, 0x76, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x76,
+	0x74, 0x65, 0x70, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x76, 0x74, 0x65, 0x70, 0x22,
+	0x10, 0x0a, 0x0e, 0x46, 0x64, 0x62, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x41, 0x0a, 0x0f, 0x46, 0x64, 0x62, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x46, 0x64, 0x62, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x22, 0xe1, 0x01, 0x0a, 0x10, 0x46, 0x64, 0x62, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x13, 0x0a, 0x05, 0x62, 0x64, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x62, 0x64, 0x49, 0x64, 0x12, 0x10,
+	0x0a, 0x03, 0x6d, 0x61, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x61, 0x63,
+	0x12, 0x10, 0x0a, 0x03, 0x6f, 0x69, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6f,
+	0x69, 0x66, 0x12, 0x17, 0x0a, 0x07, 0x76, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x06, 0x76, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x69,
+	0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x76, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0b, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x56, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x12,
+	0x3a, 0x0a, 0x0c, 0x76, 0x6c, 0x61, 0x6e, 0x5f, 0x72, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x56, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x52, 0x0b,
+	0x76, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x6f, 0x72, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x70, 0x6f, 0x72, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0x13, 0x0a, 0x11, 0x46, 0x64, 0x62, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x0a,
+	0x10, 0x46, 0x64, 0x62, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x13, 0x0a, 0x05, 0x62, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x04, 0x62, 0x64, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x63, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x61, 0x63, 0x22, 0x13, 0x0a, 0x11, 0x46, 0x64, 0x62, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x47, 0x0a,
+	0x0f, 0x46, 0x64, 0x62, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x13, 0x0a, 0x05, 0x62, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x04, 0x62, 0x64, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6b, 0x65, 0x65, 0x70, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6b, 0x65, 0x65, 0x70,
+	0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x22, 0x37, 0x0a, 0x10, 0x46, 0x64, 0x62, 0x46, 0x6c, 0x75,
+	0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0xa7, 0x01, 0x0a, 0x0e, 0x56, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a,
+	0x07, 0x76, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06,
+	0x76, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a,
+	0x0c, 0x76, 0x6c, 0x61, 0x6e, 0x5f, 0x72, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31,
+	0x2e, 0x56, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x52, 0x0b, 0x76, 0x6c,
+	0x61, 0x6e, 0x52, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x22, 0x4e, 0x0a, 0x16, 0x56, 0x6c, 0x61,
+	0x6e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76,
+	0x31, 0x2e, 0x56, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x83, 0x01, 0x0a, 0x17, 0x56, 0x6c,
+	0x61, 0x6e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f,
+	0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x06, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69,
+	0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22,
+	0x8b, 0x01, 0x0a, 0x16, 0x56, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x07, 0x65, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69,
+	0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73,
+	0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0x83, 0x01,
+	0x0a, 0x17, 0x56, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69, 0x6e,
+	0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12,
+	0x32, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x73, 0x22, 0x4e, 0x0a, 0x16, 0x56, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x62, 0x6c, 0x65,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a,
+	0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6c, 0x61, 0x6e,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x22, 0x83, 0x01, 0x0a, 0x17, 0x56, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x34, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6c,
+	0x61, 0x6e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x31, 0x0a, 0x14, 0x56, 0x6c, 0x61,
+	0x6e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x4d, 0x0a, 0x15,
+	0x56, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f,
+	0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x32, 0x0a, 0x15, 0x56,
+	0x6c, 0x61, 0x6e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x22,
+	0x3d, 0x0a, 0x16, 0x56, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x6c, 0x75, 0x73,
+	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x8b,
+	0x01, 0x0a, 0x0e, 0x53, 0x72, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x14, 0x74,
+	0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x72, 0x63, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x74, 0x72, 0x75, 0x73, 0x74,
+	0x65, 0x64, 0x53, 0x72, 0x63, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x12, 0x2d, 0x0a,
+	0x12, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x74, 0x72, 0x75, 0x73, 0x74,
+	0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x22, 0x4f, 0x0a, 0x15,
+	0x53, 0x72, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72,
+	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x72, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x52, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x22, 0x82, 0x01,
+	0x0a, 0x16, 0x53, 0x72, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62,
+	0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x72, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x32,
+	0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x22, 0x33, 0x0a, 0x15, 0x53, 0x72, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x22, 0x77, 0x0a, 0x16, 0x53, 0x72, 0x44, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x32, 0x0a, 0x06,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76,
+	0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73,
+	0x22, 0x15, 0x0a, 0x13, 0x53, 0x72, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4e, 0x0a, 0x14, 0x53, 0x72, 0x44, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x36, 0x0a, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x72, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x08, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x22, 0x16, 0x0a, 0x14, 0x53, 0x72, 0x44, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x3c, 0x0a, 0x15, 0x53, 0x72, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x46, 0x6c, 0x75, 0x73, 0x68,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0c, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x9b, 0x01,
+	0x0a, 0x06, 0x42, 0x64, 0x50, 0x65, 0x65, 0x72, 0x12, 0x13, 0x0a, 0x05, 0x62, 0x64, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x62, 0x64, 0x49, 0x64, 0x12, 0x19, 0x0a,
+	0x08, 0x73, 0x72, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x73, 0x72, 0x63, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x33, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x72, 0x76, 0x36, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x42, 0x65, 0x68, 0x61, 0x76,
+	0x69, 0x6f, 0x72, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x73, 0x69,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x65, 0x73, 0x69, 0x22, 0x3f, 0x0a, 0x13, 0x42,
+	0x64, 0x50, 0x65, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x28, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x42,
+	0x64, 0x50, 0x65, 0x65, 0x72, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x22, 0x78, 0x0a, 0x14,
+	0x42, 0x64, 0x50, 0x65, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x64, 0x50, 0x65, 0x65, 0x72, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x12, 0x32, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x7c, 0x0a, 0x13, 0x42, 0x64, 0x50, 0x65, 0x65, 0x72,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a,
+	0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x76,
+	0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x64, 0x50, 0x65, 0x65, 0x72,
+	0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4d, 0x61, 0x73, 0x6b, 0x22, 0x78, 0x0a, 0x14, 0x42, 0x64, 0x50, 0x65, 0x65, 0x72, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x07,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x64, 0x50, 0x65, 0x65,
+	0x72, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x06, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69, 0x6e,
+	0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x2c,
+	0x0a, 0x13, 0x42, 0x64, 0x50, 0x65, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x64, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x05, 0x62, 0x64, 0x49, 0x64, 0x73, 0x22, 0x70, 0x0a, 0x14,
+	0x42, 0x64, 0x50, 0x65, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f,
+	0x62, 0x64, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x0c, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x42, 0x64, 0x49, 0x64, 0x73, 0x12, 0x32, 0x0a, 0x06, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69, 0x6e,
+	0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x28,
+	0x0a, 0x11, 0x42, 0x64, 0x50, 0x65, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x13, 0x0a, 0x05, 0x62, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x04, 0x62, 0x64, 0x49, 0x64, 0x22, 0x3e, 0x0a, 0x12, 0x42, 0x64, 0x50, 0x65,
+	0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28,
+	0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x64, 0x50, 0x65, 0x65,
+	0x72, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x22, 0x29, 0x0a, 0x12, 0x42, 0x64, 0x50, 0x65,
+	0x65, 0x72, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x13,
+	0x0a, 0x05, 0x62, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x62,
+	0x64, 0x49, 0x64, 0x22, 0x3a, 0x0a, 0x13, 0x42, 0x64, 0x50, 0x65, 0x65, 0x72, 0x46, 0x6c, 0x75,
+	0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x96, 0x01, 0x0a, 0x0a, 0x56, 0x6e, 0x69, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x10,
+	0x0a, 0x03, 0x76, 0x6e, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x76, 0x6e, 0x69,
+	0x12, 0x13, 0x0a, 0x05, 0x62, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x04, 0x62, 0x64, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x76,
+	0x74, 0x65, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x56, 0x74, 0x65, 0x70, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x76,
+	0x74, 0x65, 0x70, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x6d, 0x6f,
+	0x74, 0x65, 0x56, 0x74, 0x65, 0x70, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x4d, 0x0a, 0x17, 0x56, 0x6e, 0x69, 0x4d,
+	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x08, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x56, 0x6e, 0x69, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x6d,
+	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x80, 0x01, 0x0a, 0x18, 0x56, 0x6e, 0x69, 0x4d,
+	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x56, 0x6e, 0x69, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f,
+	0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x44, 0x0a, 0x17, 0x56, 0x6e,
+	0x69, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x6e, 0x69, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0d, 0x52, 0x04, 0x76, 0x6e, 0x69, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x64, 0x5f,
+	0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x05, 0x62, 0x64, 0x49, 0x64, 0x73,
+	0x22, 0x71, 0x0a, 0x18, 0x56, 0x6e, 0x69, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x6e, 0x69, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0d, 0x52, 0x0b, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x56, 0x6e, 0x69, 0x73, 0x12,
+	0x32, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x73, 0x22, 0x3e, 0x0a, 0x15, 0x56, 0x6e, 0x69, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e,
+	0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x76, 0x6e, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x76, 0x6e, 0x69, 0x12, 0x13,
+	0x0a, 0x05, 0x62, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x62,
+	0x64, 0x49, 0x64, 0x22, 0x4c, 0x0a, 0x16, 0x56, 0x6e, 0x69, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e,
+	0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a,
+	0x08, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6e, 0x69,
+	0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67,
+	0x73, 0x22, 0x18, 0x0a, 0x16, 0x56, 0x6e, 0x69, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x46,
+	0x6c, 0x75, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3e, 0x0a, 0x17, 0x56,
+	0x6e, 0x69, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x9b, 0x04, 0x0a, 0x0c,
+	0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x13, 0x0a, 0x05, 0x62, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x04, 0x62, 0x64, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x06, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x52, 0x06, 0x62, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x12, 0x27, 0x0a, 0x03, 0x61, 0x63, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x6e, 0x64, 0x4c, 0x32, 0x52, 0x03, 0x61, 0x63, 0x73, 0x12, 0x2b, 0x0a, 0x04, 0x73, 0x69,
+	0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65,
+	0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x04, 0x73, 0x69, 0x64, 0x73, 0x12, 0x28, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x64, 0x50, 0x65, 0x65, 0x72, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72,
+	0x73, 0x12, 0x48, 0x0a, 0x11, 0x65, 0x74, 0x68, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x5f, 0x73, 0x65,
+	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x76,
+	0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x74, 0x68, 0x65, 0x72, 0x6e,
+	0x65, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x10, 0x65, 0x74, 0x68, 0x65, 0x72,
+	0x6e, 0x65, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x33, 0x0a, 0x0a, 0x6d,
+	0x61, 0x63, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x63,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x09, 0x6d, 0x61, 0x63, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x64, 0x62, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x66, 0x64, 0x62, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x32, 0x0a, 0x09, 0x6e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x18, 0x0a,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76,
+	0x31, 0x2e, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x52, 0x09, 0x6e, 0x65, 0x69, 0x67,
+	0x68, 0x62, 0x6f, 0x72, 0x73, 0x12, 0x21, 0x0a, 0x03, 0x69, 0x72, 0x62, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
+	0x49, 0x72, 0x62, 0x52, 0x03, 0x69, 0x72, 0x62, 0x12, 0x3f, 0x0a, 0x0e, 0x73, 0x74, 0x6f, 0x72,
+	0x6d, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
+	0x6f, 0x72, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x0d, 0x73, 0x74, 0x6f, 0x72,
+	0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x73, 0x22, 0x5c, 0x0a, 0x19, 0x42, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3f, 0x0a, 0x0e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x0d, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x22, 0x84, 0x01, 0x0a, 0x1a, 0x42, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72,
+	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69,
+	0x6e, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x06, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69, 0x6e,
+	0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x31,
+	0x0a, 0x19, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x22, 0x75, 0x0a, 0x1a, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69,
+	0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x23, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x4e,
+	0x61, 0x6d, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76,
+	0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x2c, 0x0a, 0x16, 0x42, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x58, 0x0a, 0x17, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x64, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65,
+	0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x52, 0x0c, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x22, 0x19, 0x0a, 0x17, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5b, 0x0a, 0x18, 0x42,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0e, 0x62, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x0d, 0x62, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x22, 0xc0, 0x02, 0x0a, 0x08, 0x4d, 0x61, 0x63,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x13, 0x0a, 0x05, 0x62, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x62, 0x64, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x17, 0x0a, 0x07, 0x76, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x06, 0x76, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61,
+	0x78, 0x5f, 0x6d, 0x61, 0x63, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6d, 0x61,
+	0x78, 0x4d, 0x61, 0x63, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e,
+	0x67, 0x5f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x10, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x12, 0x3d, 0x0a, 0x0c, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65,
+	0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x63, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x63, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x61, 0x63, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e,
+	0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0x42, 0x0a, 0x12, 0x4d,
+	0x61, 0x63, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x2c, 0x0a, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x61, 0x63, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x22,
+	0x71, 0x0a, 0x13, 0x4d, 0x61, 0x63, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x03, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x61, 0x63, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x03, 0x73, 0x65, 0x74, 0x12, 0x32,
+	0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x22, 0x45, 0x0a, 0x15, 0x4d, 0x61, 0x63, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x06, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x69,
+	0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x63, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x52, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x22, 0x7c, 0x0a, 0x16, 0x4d, 0x61, 0x63,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x61, 0x63, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31,
+	0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52,
+	0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x2a, 0x0a, 0x13, 0x4d, 0x61, 0x63, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x13,
+	0x0a, 0x05, 0x62, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x62,
+	0x64, 0x49, 0x64, 0x22, 0x44, 0x0a, 0x14, 0x4d, 0x61, 0x63, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x69,
+	0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x63, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x52, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x22, 0x9a, 0x02, 0x0a, 0x0c, 0x53, 0x74,
+	0x6f, 0x72, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x13, 0x0a, 0x05, 0x62, 0x64,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x62, 0x64, 0x49, 0x64, 0x12,
+	0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x76, 0x6c, 0x61, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x76, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x26, 0x0a, 0x0f,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x50, 0x65,
+	0x72, 0x53, 0x65, 0x63, 0x12, 0x22, 0x0a, 0x0d, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x70, 0x65,
+	0x72, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x72, 0x6f, 0x70,
+	0x70, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0e, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65,
+	0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x59, 0x0a, 0x16, 0x53, 0x74, 0x6f, 0x72, 0x6d, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x3f, 0x0a, 0x0e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65,
+	0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x52, 0x0d, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x73, 0x22, 0x79, 0x0a, 0x17, 0x53, 0x74, 0x6f, 0x72, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x03,
+	0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x76, 0x69, 0x6e, 0x62,
+	0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x6d, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x52, 0x03, 0x73, 0x65, 0x74, 0x12, 0x32, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65,
+	0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x5c, 0x0a, 0x19,
+	0x53, 0x74, 0x6f, 0x72, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3f, 0x0a, 0x0e, 0x73, 0x74, 0x6f,
+	0x72, 0x6d, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x74, 0x6f, 0x72, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x0d, 0x73, 0x74, 0x6f,
+	0x72, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x73, 0x22, 0x84, 0x01, 0x0a, 0x1a, 0x53,
+	0x74, 0x6f, 0x72, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x76, 0x69, 0x6e,
+	0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x6d, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x32, 0x0a,
+	0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x22, 0x2e, 0x0a, 0x17, 0x53, 0x74, 0x6f, 0x72, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x13, 0x0a, 0x05,
+	0x62, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x62, 0x64, 0x49,
+	0x64, 0x22, 0x5b, 0x0a, 0x18, 0x53, 0x74, 0x6f, 0x72, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a,
+	0x0e, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52,
+	0x0d, 0x73, 0x74, 0x6f, 0x72, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x73, 0x22, 0x75,
+	0x0a, 0x08, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x12, 0x13, 0x0a, 0x05, 0x62, 0x64,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x62, 0x64, 0x49, 0x64, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12,
+	0x10, 0x0a, 0x03, 0x6d, 0x61, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x61,
+	0x63, 0x12, 0x32, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4e,
+	0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x6f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x22, 0x4b, 0x0a, 0x15, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f,
+	0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32,
+	0x0a, 0x09, 0x6e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4e,
+	0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x52, 0x09, 0x6e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f,
+	0x72, 0x73, 0x22, 0x7c, 0x0a, 0x16, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x07,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x69, 0x67, 0x68,
+	0x62, 0x6f, 0x72, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x06,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76,
+	0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73,
+	0x22, 0x4b, 0x0a, 0x15, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x09, 0x6e, 0x65, 0x69,
+	0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76,
+	0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62,
+	0x6f, 0x72, 0x52, 0x09, 0x6e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x22, 0x7c, 0x0a,
+	0x16, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65,
+	0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x52, 0x07,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72,
+	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x2a, 0x0a, 0x13, 0x4e,
+	0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x13, 0x0a, 0x05, 0x62, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x04, 0x62, 0x64, 0x49, 0x64, 0x22, 0x4a, 0x0a, 0x14, 0x4e, 0x65, 0x69, 0x67, 0x68,
+	0x62, 0x6f, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x32, 0x0a, 0x09, 0x6e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
+	0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x52, 0x09, 0x6e, 0x65, 0x69, 0x67, 0x68, 0x62,
+	0x6f, 0x72, 0x73, 0x22, 0x62, 0x0a, 0x03, 0x49, 0x72, 0x62, 0x12, 0x19, 0x0a, 0x08, 0x76, 0x72,
+	0x66, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x72,
+	0x66, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x5f, 0x6d, 0x61, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x4d, 0x61, 0x63, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x5f, 0x69, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x49, 0x70, 0x73, 0x22, 0xbe, 0x01, 0x0a, 0x08, 0x49, 0x72, 0x62, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x76, 0x72, 0x66, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x72, 0x66, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x72, 0x63, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x72, 0x63, 0x41, 0x64,
+	0x64, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x33,
+	0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x76,
+	0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x72, 0x76, 0x36, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x6e, 0x64, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x52, 0x04, 0x6d,
+	0x6f, 0x64, 0x65, 0x12, 0x13, 0x0a, 0x05, 0x62, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x04, 0x62, 0x64, 0x49, 0x64, 0x22, 0x45, 0x0a, 0x15, 0x49, 0x72, 0x62, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x2c, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x49,
+	0x72, 0x62, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x22,
+	0x7c, 0x0a, 0x16, 0x49, 0x72, 0x62, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x69, 0x6e,
+	0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x72, 0x62, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x06, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62,
+	0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x45, 0x0a,
+	0x15, 0x49, 0x72, 0x62, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f,
+	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x72, 0x62, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x06, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x73, 0x22, 0x7c, 0x0a, 0x16, 0x49, 0x72, 0x62, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e,
+	0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x72, 0x62,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x32,
+	0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x22, 0x30, 0x0a, 0x13, 0x49, 0x72, 0x62, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x76, 0x72, 0x66,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x72, 0x66,
+	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x44, 0x0a, 0x14, 0x49, 0x72, 0x62, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76,
+	0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x72, 0x62, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x22, 0xe2, 0x01, 0x0a, 0x0f, 0x45,
+	0x74, 0x68, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x65, 0x73, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x65, 0x73, 0x69,
+	0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x41,
+	0x74, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x11, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x5f, 0x70, 0x65, 0x5f, 0x73, 0x72, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x50, 0x65, 0x53, 0x72, 0x63, 0x41, 0x64,
+	0x64, 0x72, 0x12, 0x23, 0x0a, 0x0e, 0x64, 0x66, 0x5f, 0x70, 0x65, 0x5f, 0x73, 0x72, 0x63, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x66, 0x50, 0x65,
+	0x53, 0x72, 0x63, 0x41, 0x64, 0x64, 0x72, 0x12, 0x46, 0x0a, 0x0f, 0x72, 0x65, 0x64, 0x75, 0x6e,
+	0x64, 0x61, 0x6e, 0x63, 0x79, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1d, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x73,
+	0x69, 0x52, 0x65, 0x64, 0x75, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x52,
+	0x0e, 0x72, 0x65, 0x64, 0x75, 0x6e, 0x64, 0x61, 0x6e, 0x63, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x22,
+	0x48, 0x0a, 0x0f, 0x45, 0x73, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x35, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x74, 0x68, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x7d, 0x0a, 0x10, 0x45, 0x73, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a,
+	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x74, 0x68, 0x65,
+	0x72, 0x6e, 0x65, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76,
+	0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x85, 0x01, 0x0a, 0x0f, 0x45, 0x73, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x07,
+	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x74, 0x68, 0x65, 0x72,
+	0x6e, 0x65, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61,
+	0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b,
+	0x22, 0x7d, 0x0a, 0x10, 0x45, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x74, 0x68, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x06, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69,
+	0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22,
+	0x25, 0x0a, 0x0f, 0x45, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x73, 0x69, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x04, 0x65, 0x73, 0x69, 0x73, 0x22, 0x60, 0x0a, 0x10, 0x45, 0x73, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76,
+	0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x0f, 0x0a, 0x0d, 0x45, 0x73, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x47, 0x0a, 0x0e, 0x45, 0x73, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x65,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x76,
+	0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x74, 0x68, 0x65, 0x72, 0x6e,
+	0x65, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x22, 0x47, 0x0a, 0x0e, 0x45, 0x73, 0x53, 0x65, 0x74, 0x44, 0x66, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x73, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x65, 0x73, 0x69, 0x12, 0x23, 0x0a, 0x0e, 0x64, 0x66, 0x5f, 0x70, 0x65, 0x5f,
+	0x73, 0x72, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x66, 0x50, 0x65, 0x53, 0x72, 0x63, 0x41, 0x64, 0x64, 0x72, 0x22, 0x48, 0x0a, 0x0f, 0x45,
+	0x73, 0x53, 0x65, 0x74, 0x44, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35,
+	0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x74, 0x68,
+	0x65, 0x72, 0x6e, 0x65, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x22, 0x24, 0x0a, 0x10, 0x45, 0x73, 0x43, 0x6c, 0x65, 0x61, 0x72,
+	0x44, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x73, 0x69,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x65, 0x73, 0x69, 0x22, 0x4a, 0x0a, 0x11, 0x45,
+	0x73, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x44, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x35, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x74, 0x68, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x07,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x22, 0x7c, 0x0a, 0x03, 0x56, 0x72, 0x66, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x5f, 0x6c, 0x33, 0x6d, 0x64, 0x65, 0x76, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x10, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x4c, 0x33, 0x6d, 0x64, 0x65,
+	0x76, 0x52, 0x75, 0x6c, 0x65, 0x22, 0x37, 0x0a, 0x10, 0x56, 0x72, 0x66, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x04, 0x76, 0x72, 0x66,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72,
+	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x72, 0x66, 0x52, 0x04, 0x76, 0x72, 0x66, 0x73, 0x22, 0x72,
+	0x0a, 0x11, 0x56, 0x72, 0x66, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76,
+	0x31, 0x2e, 0x56, 0x72, 0x66, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x32,
+	0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x22, 0x28, 0x0a, 0x10, 0x56, 0x72, 0x66, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x6c, 0x0a, 0x11,
+	0x56, 0x72, 0x66, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f,
+	0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x10, 0x0a, 0x0e, 0x56, 0x72,
+	0x66, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x36, 0x0a, 0x0f,
+	0x56, 0x72, 0x66, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x23, 0x0a, 0x04, 0x76, 0x72, 0x66, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x72, 0x66, 0x52, 0x04,
+	0x76, 0x72, 0x66, 0x73, 0x22, 0x4b, 0x0a, 0x06, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x13, 0x0a, 0x05, 0x62, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x04, 0x62, 0x64, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x73, 0x22, 0x43, 0x0a, 0x13, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x07, 0x62, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x76, 0x69, 0x6e, 0x62,
+	0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x52, 0x07, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x73, 0x22, 0x78, 0x0a, 0x14, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c,
+	0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x06,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76,
+	0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73,
+	0x22, 0x2b, 0x0a, 0x13, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x6f, 0x0a,
+	0x14, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x06, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69, 0x6e,
+	0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x13,
+	0x0a, 0x11, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x42, 0x0a, 0x12, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x62, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x76, 0x69, 0x6e,
+	0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x52, 0x07,
+	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x73, 0x22, 0x80, 0x05, 0x0a, 0x09, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x6e, 0x64, 0x4c, 0x32, 0x12, 0x17, 0x0a, 0x07, 0x76, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x76, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x19,
+	0x0a, 0x08, 0x73, 0x72, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x73, 0x72, 0x63, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x13, 0x0a, 0x05, 0x62, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x62, 0x64, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x33, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1f, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x72, 0x76,
+	0x36, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72,
+	0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x73, 0x69, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x65, 0x73, 0x69, 0x12, 0x34, 0x0a, 0x07, 0x74, 0x63, 0x5f, 0x6d,
+	0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x76, 0x69, 0x6e, 0x62,
+	0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x72, 0x76, 0x36, 0x45, 0x6e, 0x63, 0x61, 0x70,
+	0x54, 0x63, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x06, 0x74, 0x63, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0e,
+	0x0a, 0x02, 0x74, 0x63, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x74, 0x63, 0x12, 0x47,
+	0x0a, 0x0e, 0x68, 0x6f, 0x70, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x72, 0x76, 0x36, 0x45, 0x6e, 0x63, 0x61, 0x70, 0x48, 0x6f, 0x70,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0c, 0x68, 0x6f, 0x70, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x68, 0x6f, 0x70, 0x5f, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x68, 0x6f, 0x70, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x12, 0x4a, 0x0a, 0x0f, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e,
+	0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x72, 0x76, 0x36, 0x45,
+	0x6e, 0x63, 0x61, 0x70, 0x46, 0x6c, 0x6f, 0x77, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x4d, 0x6f, 0x64,
+	0x65, 0x52, 0x0d, 0x66, 0x6c, 0x6f, 0x77, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x4d, 0x6f, 0x64, 0x65,
+	0x12, 0x22, 0x0a, 0x0d, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x76, 0x6c, 0x61, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x56, 0x6c,
+	0x61, 0x6e, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0b, 0x76, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x5f,
+	0x65, 0x6e, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x76, 0x6c, 0x61, 0x6e, 0x49,
+	0x64, 0x45, 0x6e, 0x64, 0x12, 0x47, 0x0a, 0x13, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x76,
+	0x6c, 0x61, 0x6e, 0x5f, 0x72, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56,
+	0x6c, 0x61, 0x6e, 0x52, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x52, 0x11, 0x65, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x56, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0x75, 0x0a, 0x0b, 0x56, 0x6c,
+	0x61, 0x6e, 0x52, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x12, 0x29, 0x0a, 0x02, 0x6f, 0x70, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x56, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x4f, 0x70,
+	0x52, 0x02, 0x6f, 0x70, 0x12, 0x17, 0x0a, 0x07, 0x76, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x76, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x22, 0x0a,
+	0x0d, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x76, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x56, 0x6c, 0x61, 0x6e, 0x49,
+	0x64, 0x22, 0x50, 0x0a, 0x16, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x4c, 0x32, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x0b, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x5f, 0x6c, 0x32, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x6e, 0x64, 0x4c, 0x32, 0x52, 0x0a, 0x68, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64,
+	0x4c, 0x32, 0x73, 0x22, 0x7e, 0x0a, 0x17, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x4c, 0x32,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f,
+	0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x6e, 0x64, 0x4c, 0x32, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12,
+	0x32, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x73, 0x22, 0x8d, 0x01, 0x0a, 0x16, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x4c,
+	0x32, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36,
+	0x0a, 0x0b, 0x68, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x5f, 0x6c, 0x32, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31,
+	0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x4c, 0x32, 0x52, 0x0a, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x6e, 0x64, 0x4c, 0x32, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d,
+	0x61, 0x73, 0x6b, 0x22, 0x7e, 0x0a, 0x17, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x4c, 0x32,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f,
+	0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x6e, 0x64, 0x4c, 0x32, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12,
+	0x32, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x73, 0x22, 0x98, 0x01, 0x0a, 0x15, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x4c,
+	0x32, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x25, 0x0a,
+	0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x76, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x76, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x22, 0x0a,
+	0x0d, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x76, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x56, 0x6c, 0x61, 0x6e, 0x49,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0x55,
+	0x0a, 0x16, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x4c, 0x32, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x07, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x76, 0x69, 0x6e, 0x62,
+	0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x4c, 0x32,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x07, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x73, 0x22, 0x8a, 0x01, 0x0a, 0x17, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e,
+	0x64, 0x4c, 0x32, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3b, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x4c, 0x32, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x32,
+	0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x22, 0x16, 0x0a, 0x14, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x4c, 0x32, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4f, 0x0a, 0x15, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x6e, 0x64, 0x4c, 0x32, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0b, 0x68, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x5f, 0x6c,
+	0x32, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65,
+	0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x4c, 0x32, 0x52,
+	0x0a, 0x68, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x4c, 0x32, 0x73, 0x22, 0x96, 0x01, 0x0a, 0x13,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x4c, 0x32, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x76, 0x6c,
+	0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x76, 0x6c, 0x61,
+	0x6e, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x76, 0x6c, 0x61,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x69, 0x6e, 0x6e, 0x65,
+	0x72, 0x56, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x72, 0x74, 0x5f,
+	0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x70, 0x6f, 0x72, 0x74,
+	0x4d, 0x6f, 0x64, 0x65, 0x22, 0x4c, 0x0a, 0x14, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x4c,
+	0x32, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0a,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x5f, 0x6c, 0x32, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x6e, 0x64, 0x4c, 0x32, 0x52, 0x09, 0x68, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64,
+	0x4c, 0x32, 0x22, 0x17, 0x0a, 0x15, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x4c, 0x32, 0x46,
+	0x6c, 0x75, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3d, 0x0a, 0x16, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x4c, 0x32, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x52, 0x0a, 0x0c, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x22, 0x12,
+	0x0a, 0x10, 0x53, 0x74, 0x61, 0x74, 0x73, 0x53, 0x68, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x49, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x74, 0x73, 0x53, 0x68, 0x6f, 0x77, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x76, 0x69, 0x6e, 0x62,
+	0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x65, 0x72, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x22, 0x13, 0x0a,
+	0x11, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x14, 0x0a, 0x12, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x83, 0x01, 0x0a, 0x0e, 0x53, 0x6c, 0x6f,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x6d,
+	0x61, 0x70, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x61, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x22, 0x58,
+	0x0a, 0x14, 0x53, 0x74, 0x61, 0x74, 0x73, 0x53, 0x6c, 0x6f, 0x74, 0x53, 0x68, 0x6f, 0x77, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x70, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x61, 0x70, 0x54, 0x79,
+	0x70, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x65,
+	0x6d, 0x70, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x4d, 0x0a, 0x15, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x53, 0x6c, 0x6f, 0x74, 0x53, 0x68, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x34, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x6c, 0x6f, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07,
+	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x34, 0x0a, 0x15, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x53, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x70, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x61, 0x70, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0x18, 0x0a,
+	0x16, 0x53, 0x74, 0x61, 0x74, 0x73, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc0, 0x01, 0x0a, 0x0e, 0x4f, 0x61, 0x6d, 0x50,
+	0x72, 0x6f, 0x62, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x4f, 0x61, 0x6d, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x63, 0x6d, 0x70, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x69, 0x63, 0x6d, 0x70, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x74, 0x74, 0x5f, 0x75, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x72, 0x74, 0x74, 0x55, 0x73, 0x22, 0x94, 0x01, 0x0a, 0x0e, 0x4f,
+	0x61, 0x6d, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x61, 0x6d, 0x5f, 0x66, 0x6c,
+	0x61, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6f, 0x61, 0x6d, 0x46, 0x6c, 0x61,
+	0x67, 0x22, 0x47, 0x0a, 0x0f, 0x4f, 0x61, 0x6d, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x4f, 0x61, 0x6d, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x9f, 0x01, 0x0a, 0x14, 0x4f,
+	0x61, 0x6d, 0x54, 0x72, 0x61, 0x63, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x68,
+	0x6f, 0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x48, 0x6f,
+	0x70, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x6d, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d,
+	0x73, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x61, 0x6d, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x6f, 0x61, 0x6d, 0x46, 0x6c, 0x61, 0x67, 0x22, 0x47, 0x0a, 0x15,
+	0x4f, 0x61, 0x6d, 0x54, 0x72, 0x61, 0x63, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x68, 0x6f, 0x70, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31,
+	0x2e, 0x4f, 0x61, 0x6d, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
+	0x04, 0x68, 0x6f, 0x70, 0x73, 0x22, 0xb7, 0x02, 0x0a, 0x07, 0x4f, 0x61, 0x6d, 0x50, 0x75, 0x6e,
+	0x74, 0x12, 0x2e, 0x0a, 0x13, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x75,
+	0x6e, 0x69, 0x78, 0x5f, 0x6e, 0x61, 0x6e, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x55, 0x6e, 0x69, 0x78, 0x4e, 0x61, 0x6e,
+	0x6f, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x33, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1b, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x72,
+	0x76, 0x36, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x72, 0x63, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x73, 0x72, 0x63, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x73, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0c, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x4c, 0x65, 0x66, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22,
+	0x2a, 0x0a, 0x12, 0x4f, 0x61, 0x6d, 0x50, 0x75, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x40, 0x0a, 0x13, 0x4f,
+	0x61, 0x6d, 0x50, 0x75, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x70, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4f,
+	0x61, 0x6d, 0x50, 0x75, 0x6e, 0x74, 0x52, 0x05, 0x70, 0x75, 0x6e, 0x74, 0x73, 0x22, 0x6b, 0x0a,
+	0x0e, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x2b, 0x0a,
+	0x11, 0x72, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x72, 0x65, 0x66, 0x6c, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x22, 0x59, 0x0a, 0x1b, 0x53, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x0a, 0x72, 0x65, 0x66,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0a, 0x72, 0x65, 0x66, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x73, 0x22, 0x88, 0x01, 0x0a, 0x1c, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72,
+	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x65, 0x66, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x06,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76,
+	0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73,
+	0x22, 0x59, 0x0a, 0x1b, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x3a, 0x0a, 0x0a, 0x72, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52,
+	0x0a, 0x72, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x22, 0x88, 0x01, 0x0a, 0x1c,
+	0x53, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x07,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x12, 0x32, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x1b, 0x0a, 0x19, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x58, 0x0a, 0x1a, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x65, 0x66, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x72, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x52, 0x0a, 0x72, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x22, 0xda, 0x01,
+	0x0a, 0x0c, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f,
+	0x72, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x6d,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x4d, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x6d,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x4d, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0x87, 0x03, 0x0a, 0x11, 0x53,
+	0x74, 0x61, 0x6d, 0x70, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04,
+	0x73, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04,
+	0x6c, 0x6f, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x1f, 0x0a, 0x0b,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x6c, 0x6f, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0a, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x4c, 0x6f, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x6c, 0x6f, 0x73, 0x73, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x09, 0x6c, 0x6f, 0x73, 0x73, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x22, 0x0a, 0x0d,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x4e, 0x73,
+	0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x73,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x6c, 0x61, 0x79,
+	0x4e, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f,
+	0x6e, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x6c,
+	0x61, 0x79, 0x4e, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x61, 0x76, 0x67, 0x5f, 0x64, 0x65, 0x6c, 0x61,
+	0x79, 0x5f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x61, 0x76, 0x67, 0x44,
+	0x65, 0x6c, 0x61, 0x79, 0x4e, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6a, 0x69, 0x74, 0x74, 0x65, 0x72,
+	0x5f, 0x6e, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6a, 0x69, 0x74, 0x74, 0x65,
+	0x72, 0x4e, 0x73, 0x12, 0x2f, 0x0a, 0x14, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x70, 0x6c,
+	0x79, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x6e, 0x61, 0x6e, 0x6f, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x55, 0x6e, 0x69, 0x78,
+	0x4e, 0x61, 0x6e, 0x6f, 0x22, 0x7d, 0x0a, 0x12, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x32, 0x0a, 0x07, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x76, 0x69,
+	0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x33,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x6d, 0x70,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x73, 0x22, 0x51, 0x0a, 0x19, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x34, 0x0a, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x74, 0x61, 0x6d, 0x70, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x84, 0x01, 0x0a, 0x1a, 0x53, 0x74, 0x61, 0x6d, 0x70,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x06, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62,
+	0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x31, 0x0a,
+	0x19, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x22, 0x75, 0x0a, 0x1a, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23,
+	0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31,
+	0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52,
+	0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x19, 0x0a, 0x17, 0x53, 0x74, 0x61, 0x6d, 0x70,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x56, 0x0a, 0x18, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a,
+	0x0a, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
+	0x61, 0x6d, 0x70, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xc9, 0x01, 0x0a, 0x13, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x75, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x64, 0x12, 0x32, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x0f, 0x0a, 0x0d, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x78, 0x0a, 0x0e, 0x52, 0x65, 0x6c, 0x6f, 0x61,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x08, 0x73, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x76, 0x69,
+	0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x08, 0x73, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0f, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x64, 0x2a, 0x69, 0x0a, 0x0e, 0x4d, 0x61, 0x63, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x1c, 0x4d, 0x41, 0x43, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54,
+	0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x4d, 0x41, 0x43, 0x5f, 0x4c, 0x49, 0x4d,
+	0x49, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x10, 0x01,
+	0x12, 0x1a, 0x0a, 0x16, 0x4d, 0x41, 0x43, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x5f, 0x41, 0x43,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x4c, 0x4f, 0x4f, 0x44, 0x10, 0x02, 0x2a, 0x82, 0x01, 0x0a,
+	0x0e, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12,
+	0x1f, 0x0a, 0x1b, 0x4e, 0x45, 0x49, 0x47, 0x48, 0x42, 0x4f, 0x52, 0x5f, 0x4f, 0x52, 0x49, 0x47,
+	0x49, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x19, 0x0a, 0x15, 0x4e, 0x45, 0x49, 0x47, 0x48, 0x42, 0x4f, 0x52, 0x5f, 0x4f, 0x52, 0x49,
+	0x47, 0x49, 0x4e, 0x5f, 0x53, 0x4e, 0x4f, 0x4f, 0x50, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x4e,
+	0x45, 0x49, 0x47, 0x48, 0x42, 0x4f, 0x52, 0x5f, 0x4f, 0x52, 0x49, 0x47, 0x49, 0x4e, 0x5f, 0x45,
+	0x56, 0x50, 0x4e, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x4e, 0x45, 0x49, 0x47, 0x48, 0x42, 0x4f,
+	0x52, 0x5f, 0x4f, 0x52, 0x49, 0x47, 0x49, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x49, 0x43, 0x10,
+	0x03, 0x2a, 0xaa, 0x01, 0x0a, 0x11, 0x45, 0x73, 0x69, 0x52, 0x65, 0x64, 0x75, 0x6e, 0x64, 0x61,
+	0x6e, 0x63, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x1f, 0x45, 0x53, 0x49, 0x5f, 0x52,
+	0x45, 0x44, 0x55, 0x4e, 0x44, 0x41, 0x4e, 0x43, 0x59, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x25, 0x0a, 0x21,
+	0x45, 0x53, 0x49, 0x5f, 0x52, 0x45, 0x44, 0x55, 0x4e, 0x44, 0x41, 0x4e, 0x43, 0x59, 0x5f, 0x4d,
+	0x4f, 0x44, 0x45, 0x5f, 0x53, 0x49, 0x4e, 0x47, 0x4c, 0x45, 0x5f, 0x48, 0x4f, 0x4d, 0x49, 0x4e,
+	0x47, 0x10, 0x01, 0x12, 0x22, 0x0a, 0x1e, 0x45, 0x53, 0x49, 0x5f, 0x52, 0x45, 0x44, 0x55, 0x4e,
+	0x44, 0x41, 0x4e, 0x43, 0x59, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x41, 0x4c, 0x4c, 0x5f, 0x41,
+	0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x02, 0x12, 0x25, 0x0a, 0x21, 0x45, 0x53, 0x49, 0x5f, 0x52,
+	0x45, 0x44, 0x55, 0x4e, 0x44, 0x41, 0x4e, 0x43, 0x59, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x53,
+	0x49, 0x4e, 0x47, 0x4c, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x03, 0x2a, 0x82,
+	0x01, 0x0a, 0x0d, 0x56, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x4f, 0x70,
+	0x12, 0x1f, 0x0a, 0x1b, 0x56, 0x4c, 0x41, 0x4e, 0x5f, 0x52, 0x45, 0x57, 0x52, 0x49, 0x54, 0x45,
+	0x5f, 0x4f, 0x50, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x17, 0x0a, 0x13, 0x56, 0x4c, 0x41, 0x4e, 0x5f, 0x52, 0x45, 0x57, 0x52, 0x49, 0x54,
+	0x45, 0x5f, 0x4f, 0x50, 0x5f, 0x50, 0x4f, 0x50, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x56, 0x4c,
+	0x41, 0x4e, 0x5f, 0x52, 0x45, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x4f, 0x50, 0x5f, 0x50, 0x55,
+	0x53, 0x48, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x56, 0x4c, 0x41, 0x4e, 0x5f, 0x52, 0x45, 0x57,
+	0x52, 0x49, 0x54, 0x45, 0x5f, 0x4f, 0x50, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x4c, 0x41, 0x54,
+	0x45, 0x10, 0x03, 0x32, 0xce, 0x04, 0x0a, 0x12, 0x53, 0x69, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x60, 0x0a, 0x11, 0x53, 0x69,
+	0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12,
+	0x24, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x64,
+	0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x69, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x11,
+	0x53, 0x69, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x12, 0x24, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x69, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72,
+	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60,
+	0x0a, 0x11, 0x53, 0x69, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x12, 0x24, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x69, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x76, 0x69, 0x6e, 0x62,
+	0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x5a, 0x0a, 0x0f, 0x53, 0x69, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x22, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x69, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72,
+	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x10,
+	0x53, 0x69, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6c, 0x75, 0x73, 0x68,
+	0x12, 0x23, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69,
+	0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x69, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6c,
+	0x75, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x0e, 0x53,
+	0x69, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x65, 0x74, 0x12, 0x21, 0x2e,
+	0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x64, 0x46, 0x75,
+	0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x22, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69,
+	0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x32, 0xa8, 0x04, 0x0a, 0x10, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64,
+	0x76, 0x34, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5a, 0x0a, 0x0f, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x6e, 0x64, 0x76, 0x34, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x22, 0x2e, 0x76,
+	0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e,
+	0x64, 0x76, 0x34, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x23, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x34, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x0f, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64,
+	0x76, 0x34, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x22, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65,
+	0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x34, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x76,
+	0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e,
+	0x64, 0x76, 0x34, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x5a, 0x0a, 0x0f, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x34, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x12, 0x22, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76,
+	0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x34, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65,
+	0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x34, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a,
+	0x0d, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x34, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x20,
+	0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x6e, 0x64, 0x76, 0x34, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x21, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x34, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x0e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x34,
+	0x46, 0x6c, 0x75, 0x73, 0x68, 0x12, 0x21, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x34, 0x46, 0x6c, 0x75, 0x73,
+	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65,
+	0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x34, 0x46,
+	0x6c, 0x75, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0c,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x34, 0x47, 0x65, 0x74, 0x12, 0x1f, 0x2e, 0x76,
+	0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e,
+	0x64, 0x76, 0x34, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x6e, 0x64, 0x76, 0x34, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32,
+	0xa8, 0x04, 0x0a, 0x10, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x36, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x5a, 0x0a, 0x0f, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76,
+	0x36, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x22, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72,
+	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x36, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x76, 0x69,
+	0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64,
+	0x76, 0x36, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x5a, 0x0a, 0x0f, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x36, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x12, 0x22, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31,
+	0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x36, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72,
+	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x36, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x0f,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x36, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12,
+	0x22, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x6e, 0x64, 0x76, 0x36, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31,
+	0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x36, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0d, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x6e, 0x64, 0x76, 0x36, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x20, 0x2e, 0x76, 0x69, 0x6e, 0x62,
+	0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x36,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x76, 0x69,
+	0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64,
+	0x76, 0x36, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51,
+	0x0a, 0x0c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x36, 0x47, 0x65, 0x74, 0x12, 0x1f,
+	0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x6e, 0x64, 0x76, 0x36, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x20, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x6e, 0x64, 0x76, 0x36, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x57, 0x0a, 0x0e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x36, 0x46, 0x6c,
+	0x75, 0x73, 0x68, 0x12, 0x21, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31,
+	0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x36, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f,
+	0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x76, 0x36, 0x46, 0x6c, 0x75,
+	0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xab, 0x02, 0x0a, 0x0a, 0x46,
+	0x64, 0x62, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x07, 0x46, 0x64, 0x62,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76,
+	0x31, 0x2e, 0x46, 0x64, 0x62, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x64,
+	0x62, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a,
+	0x09, 0x46, 0x64, 0x62, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x2e, 0x76, 0x69, 0x6e,
+	0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x64, 0x62, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65,
+	0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x64, 0x62, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x09, 0x46, 0x64, 0x62, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x12, 0x1c, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76,
+	0x31, 0x2e, 0x46, 0x64, 0x62, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
+	0x46, 0x64, 0x62, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x45, 0x0a, 0x08, 0x46, 0x64, 0x62, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x12, 0x1b, 0x2e,
+	0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x64, 0x62, 0x46, 0x6c,
+	0x75, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x76, 0x69, 0x6e,
+	0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x64, 0x62, 0x46, 0x6c, 0x75, 0x73, 0x68,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xd5, 0x03, 0x0a, 0x10, 0x56, 0x6c, 0x61,
+	0x6e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5a, 0x0a,
+	0x0f, 0x56, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x12, 0x22, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6c,
+	0x61, 0x6e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76,
+	0x31, 0x2e, 0x56, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x0f, 0x56, 0x6c, 0x61,
+	0x6e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x22, 0x2e, 0x76,
+	0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6c, 0x61, 0x6e, 0x54, 0x61,
+	0x62, 0x6c, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x23, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6c,
+	0x61, 0x6e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x0f, 0x56, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x22, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65,
+	0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x76,
+	0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6c, 0x61, 0x6e, 0x54, 0x61,
+	0x62, 0x6c, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x54, 0x0a, 0x0d, 0x56, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x20, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
+	0x56, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76,
+	0x31, 0x2e, 0x56, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x0e, 0x56, 0x6c, 0x61, 0x6e, 0x54,
+	0x61, 0x62, 0x6c, 0x65, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x12, 0x21, 0x2e, 0x76, 0x69, 0x6e, 0x62,
+	0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x62, 0x6c, 0x65,
+	0x46, 0x6c, 0x75, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x76,
+	0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6c, 0x61, 0x6e, 0x54, 0x61,
+	0x62, 0x6c, 0x65, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x32, 0xec, 0x02, 0x0a, 0x0f, 0x53, 0x72, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x57, 0x0a, 0x0e, 0x53, 0x72, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x21, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x72, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x76, 0x69, 0x6e, 0x62,
+	0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x72, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a,
+	0x0e, 0x53, 0x72, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12,
+	0x21, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x72, 0x44,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x72, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0c, 0x53, 0x72, 0x44, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1f, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x72, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72,
+	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x72, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0d, 0x53, 0x72, 0x44,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x12, 0x20, 0x2e, 0x76, 0x69, 0x6e,
+	0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x72, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x46, 0x6c, 0x75, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x76,
+	0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x72, 0x44, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32,
+	0xa5, 0x03, 0x0a, 0x0d, 0x42, 0x64, 0x50, 0x65, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x51, 0x0a, 0x0c, 0x42, 0x64, 0x50, 0x65, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x12, 0x1f, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x42,
+	0x64, 0x50, 0x65, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x64, 0x50, 0x65, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0c, 0x42, 0x64, 0x50, 0x65, 0x65, 0x72, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x64, 0x50, 0x65, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x64, 0x50, 0x65, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0c, 0x42, 0x64, 0x50, 0x65, 0x65,
+	0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x1f, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72,
+	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x64, 0x50, 0x65, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65,
+	0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x64, 0x50, 0x65, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0a, 0x42, 0x64,
+	0x50, 0x65, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65,
+	0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x64, 0x50, 0x65, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72,
+	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x64, 0x50, 0x65, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0b, 0x42, 0x64, 0x50, 0x65, 0x65,
+	0x72, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x12, 0x1e, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x64, 0x50, 0x65, 0x65, 0x72, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x64, 0x50, 0x65, 0x65, 0x72, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x86, 0x03, 0x0a, 0x11, 0x56, 0x6e, 0x69, 0x4d,
+	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5d, 0x0a,
+	0x10, 0x56, 0x6e, 0x69, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x12, 0x23, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56,
+	0x6e, 0x69, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f,
+	0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6e, 0x69, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x10,
+	0x56, 0x6e, 0x69, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x12, 0x23, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6e,
+	0x69, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x56, 0x6e, 0x69, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x0e, 0x56,
+	0x6e, 0x69, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x21, 0x2e,
+	0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6e, 0x69, 0x4d, 0x61,
+	0x70, 0x70, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x22, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6e,
+	0x69, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x0f, 0x56, 0x6e, 0x69, 0x4d, 0x61, 0x70, 0x70, 0x69,
+	0x6e, 0x67, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x12, 0x22, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72,
+	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6e, 0x69, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x46,
+	0x6c, 0x75, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x76, 0x69,
+	0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6e, 0x69, 0x4d, 0x61, 0x70, 0x70,
+	0x69, 0x6e, 0x67, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x32, 0xc0, 0x0b, 0x0a, 0x13, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69,
+	0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x63, 0x0a, 0x12, 0x42, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x25,
+	0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a,
+	0x12, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x12, 0x25, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x76, 0x69, 0x6e,
+	0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x44, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x5a, 0x0a, 0x0f, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x47, 0x65, 0x74, 0x12, 0x22, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x76, 0x69, 0x6e, 0x62,
+	0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d,
+	0x0a, 0x10, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x23, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72,
+	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69,
+	0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a,
+	0x0b, 0x4d, 0x61, 0x63, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53, 0x65, 0x74, 0x12, 0x1e, 0x2e, 0x76,
+	0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x63, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x76,
+	0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x63, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a,
+	0x0e, 0x4d, 0x61, 0x63, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12,
+	0x21, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x63,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x61, 0x63, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0c, 0x4d, 0x61, 0x63, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1f, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x63, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72,
+	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x63, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x0f, 0x53, 0x74, 0x6f,
+	0x72, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x53, 0x65, 0x74, 0x12, 0x22, 0x2e, 0x76,
+	0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x6d, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x23, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
+	0x6f, 0x72, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x12, 0x53, 0x74, 0x6f, 0x72, 0x6d, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x25, 0x2e, 0x76, 0x69,
+	0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x6d, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x26, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x74, 0x6f, 0x72, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x10, 0x53, 0x74,
+	0x6f, 0x72, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x23,
+	0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72,
+	0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x74, 0x6f, 0x72, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x0e, 0x4e, 0x65, 0x69,
+	0x67, 0x68, 0x62, 0x6f, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x21, 0x2e, 0x76, 0x69,
+	0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f,
+	0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
+	0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x69, 0x67,
+	0x68, 0x62, 0x6f, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x57, 0x0a, 0x0e, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x12, 0x21, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76,
+	0x31, 0x2e, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72,
+	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0c, 0x4e,
+	0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1f, 0x2e, 0x76, 0x69,
+	0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f,
+	0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x76,
+	0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62,
+	0x6f, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57,
+	0x0a, 0x0e, 0x49, 0x72, 0x62, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x12, 0x21, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x72,
+	0x62, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31,
+	0x2e, 0x49, 0x72, 0x62, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x0e, 0x49, 0x72, 0x62, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x21, 0x2e, 0x76, 0x69, 0x6e, 0x62,
+	0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x72, 0x62, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x76,
+	0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x72, 0x62, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x51, 0x0a, 0x0c, 0x49, 0x72, 0x62, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x1f, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x72,
+	0x62, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x20, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x49,
+	0x72, 0x62, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x32, 0xbc, 0x03, 0x0a, 0x16, 0x45, 0x74, 0x68, 0x65, 0x72, 0x6e, 0x65, 0x74,
+	0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x45,
+	0x0a, 0x08, 0x45, 0x73, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x76, 0x69, 0x6e,
+	0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x73, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72,
+	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x73, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x08, 0x45, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x12, 0x1b, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x73, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x08,
+	0x45, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65,
+	0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x06, 0x45, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x19, 0x2e,
+	0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x73, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65,
+	0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x07, 0x45, 0x73, 0x53, 0x65, 0x74, 0x44, 0x66, 0x12,
+	0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x73, 0x53,
+	0x65, 0x74, 0x44, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x76, 0x69,
+	0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x73, 0x53, 0x65, 0x74, 0x44, 0x66,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x09, 0x45, 0x73, 0x43, 0x6c,
+	0x65, 0x61, 0x72, 0x44, 0x66, 0x12, 0x1c, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x73, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x44, 0x66, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x73, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x44, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x32, 0xe3, 0x03, 0x0a, 0x16, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x48, 0x0a,
+	0x09, 0x56, 0x72, 0x66, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x2e, 0x76, 0x69, 0x6e,
+	0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x72, 0x66, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65,
+	0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x72, 0x66, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x09, 0x56, 0x72, 0x66, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x12, 0x1c, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76,
+	0x31, 0x2e, 0x56, 0x72, 0x66, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
+	0x56, 0x72, 0x66, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x42, 0x0a, 0x07, 0x56, 0x72, 0x66, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1a, 0x2e, 0x76,
+	0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x72, 0x66, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65,
+	0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x72, 0x66, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0c, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0c, 0x42, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x1f, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65,
+	0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x76, 0x69, 0x6e, 0x62,
+	0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0a, 0x42,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x2e, 0x76, 0x69, 0x6e, 0x62,
+	0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65,
+	0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xa8, 0x04, 0x0a, 0x10, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x6e, 0x64, 0x4c, 0x32, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5a, 0x0a,
+	0x0f, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x4c, 0x32, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x12, 0x22, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x6e, 0x64, 0x4c, 0x32, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76,
+	0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x4c, 0x32, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x0f, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x6e, 0x64, 0x4c, 0x32, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x22, 0x2e, 0x76,
+	0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e,
+	0x64, 0x4c, 0x32, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x23, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x6e, 0x64, 0x4c, 0x32, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x0f, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64,
+	0x4c, 0x32, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x22, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65,
+	0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x4c, 0x32, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x76,
+	0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e,
+	0x64, 0x4c, 0x32, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x54, 0x0a, 0x0d, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x4c, 0x32, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x20, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x4c, 0x32, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76,
+	0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x4c, 0x32, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x6e, 0x64, 0x4c, 0x32, 0x47, 0x65, 0x74, 0x12, 0x1f, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72,
+	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x4c, 0x32, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65,
+	0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e, 0x64, 0x4c, 0x32, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x0e, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x6e, 0x64, 0x4c, 0x32, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x12, 0x21, 0x2e, 0x76,
+	0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x6e,
+	0x64, 0x4c, 0x32, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x22, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x6e, 0x64, 0x4c, 0x32, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x32, 0xd4, 0x02, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x48, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x74, 0x73, 0x53, 0x68, 0x6f,
+	0x77, 0x12, 0x1c, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x53, 0x68, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1d, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x53, 0x68, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b,
+	0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x65, 0x74, 0x12, 0x1d, 0x2e, 0x76,
+	0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x76, 0x69,
+	0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0d, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x53, 0x6c, 0x6f, 0x74, 0x53, 0x68, 0x6f, 0x77, 0x12, 0x20, 0x2e, 0x76,
+	0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x53,
+	0x6c, 0x6f, 0x74, 0x53, 0x68, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
+	0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x53, 0x6c, 0x6f, 0x74, 0x53, 0x68, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x57, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x65,
+	0x73, 0x65, 0x74, 0x12, 0x21, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x73,
+	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xf6, 0x01, 0x0a, 0x0a, 0x4f,
+	0x61, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x07, 0x4f, 0x61, 0x6d,
+	0x50, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76,
+	0x31, 0x2e, 0x4f, 0x61, 0x6d, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x61,
+	0x6d, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a,
+	0x0d, 0x4f, 0x61, 0x6d, 0x54, 0x72, 0x61, 0x63, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x20,
+	0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x61, 0x6d, 0x54,
+	0x72, 0x61, 0x63, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x21, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x61,
+	0x6d, 0x54, 0x72, 0x61, 0x63, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0b, 0x4f, 0x61, 0x6d, 0x50, 0x75, 0x6e, 0x74, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x1e, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
+	0x4f, 0x61, 0x6d, 0x50, 0x75, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
+	0x4f, 0x61, 0x6d, 0x50, 0x75, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x32, 0xf8, 0x04, 0x0a, 0x12, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x6e, 0x63, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x69, 0x0a, 0x14, 0x53, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x12, 0x27, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x76, 0x69,
+	0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x65,
+	0x66, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x14, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x65,
+	0x66, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x27, 0x2e,
+	0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x63, 0x0a, 0x12, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x25, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
+	0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x12, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x25, 0x2e, 0x76, 0x69,
+	0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x26, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x74, 0x61, 0x6d, 0x70, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x12, 0x53, 0x74,
+	0x61, 0x6d, 0x70, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x12, 0x25, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
+	0x61, 0x6d, 0x70, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72,
+	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x5d, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x23, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65,
+	0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x50,
+	0x0a, 0x0d, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x3f, 0x0a, 0x06, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x19, 0x2e, 0x76, 0x69, 0x6e, 0x62,
+	0x65, 0x72, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x42, 0x9d, 0x01, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x2e, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f,
+	0x2e, 0x76, 0x31, 0x42, 0x0c, 0x56, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x50, 0x01, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x74, 0x61, 0x6b, 0x65, 0x68, 0x61, 0x79, 0x61, 0x2f, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2f, 0x76, 0x31, 0x3b,
+	0x76, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x56, 0x58, 0x58, 0xaa,
+	0x02, 0x0a, 0x56, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0a, 0x56,
+	0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x16, 0x56, 0x69, 0x6e, 0x62,
+	0x65, 0x72, 0x6f, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0xea, 0x02, 0x0b, 0x56, 0x69, 0x6e, 0x62, 0x65, 0x72, 0x6f, 0x3a, 0x3a, 0x56, 0x31,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_vinbero_v1_vinbero_proto_rawDescOnce sync.Once
+	file_vinbero_v1_vinbero_proto_rawDescData = file_vinbero_v1_vinbero_proto_rawDesc
+)
+
+func file_vinbero_v1_vinbero_proto_rawDescGZIP() []byte {
+	file_vinbero_v1_vinbero_proto_rawDescOnce.Do(func() {
+		file_vinbero_v1_vinbero_proto_rawDescData = protoimpl.X.CompressGZIP(file_vinbero_v1_vinbero_proto_rawDescData)
+	})
+	return file_vinbero_v1_vinbero_proto_rawDescData
+}
+
+var file_vinbero_v1_vinbero_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_vinbero_v1_vinbero_proto_msgTypes = make([]protoimpl.MessageInfo, 205)
+var file_vinbero_v1_vinbero_proto_goTypes = []interface{}{
+	(MacLimitAction)(0),                  // 0: vinbero.v1.MacLimitAction
+	(NeighborOrigin)(0),                  // 1: vinbero.v1.NeighborOrigin
+	(EsiRedundancyMode)(0),               // 2: vinbero.v1.EsiRedundancyMode
+	(VlanRewriteOp)(0),                   // 3: vinbero.v1.VlanRewriteOp
+	(*SidFunction)(nil),                  // 4: vinbero.v1.SidFunction
+	(*SidFunctionCreateRequest)(nil),     // 5: vinbero.v1.SidFunctionCreateRequest
+	(*SidFunctionCreateResponse)(nil),    // 6: vinbero.v1.SidFunctionCreateResponse
+	(*SidFunctionUpdateRequest)(nil),     // 7: vinbero.v1.SidFunctionUpdateRequest
+	(*SidFunctionUpdateResponse)(nil),    // 8: vinbero.v1.SidFunctionUpdateResponse
+	(*SidFunctionDeleteRequest)(nil),     // 9: vinbero.v1.SidFunctionDeleteRequest
+	(*SidFunctionDeleteResponse)(nil),    // 10: vinbero.v1.SidFunctionDeleteResponse
+	(*SidFunctionListRequest)(nil),       // 11: vinbero.v1.SidFunctionListRequest
+	(*SidFunctionListResponse)(nil),      // 12: vinbero.v1.SidFunctionListResponse
+	(*SidFunctionGetRequest)(nil),        // 13: vinbero.v1.SidFunctionGetRequest
+	(*SidFunctionGetResponse)(nil),       // 14: vinbero.v1.SidFunctionGetResponse
+	(*SidFunctionFlushRequest)(nil),      // 15: vinbero.v1.SidFunctionFlushRequest
+	(*SidFunctionFlushResponse)(nil),     // 16: vinbero.v1.SidFunctionFlushResponse
+	(*Headendv4)(nil),                    // 17: vinbero.v1.Headendv4
+	(*Headendv4CreateRequest)(nil),       // 18: vinbero.v1.Headendv4CreateRequest
+	(*Headendv4CreateResponse)(nil),      // 19: vinbero.v1.Headendv4CreateResponse
+	(*Headendv4UpdateRequest)(nil),       // 20: vinbero.v1.Headendv4UpdateRequest
+	(*Headendv4UpdateResponse)(nil),      // 21: vinbero.v1.Headendv4UpdateResponse
+	(*Headendv4DeleteRequest)(nil),       // 22: vinbero.v1.Headendv4DeleteRequest
+	(*Headendv4DeleteResponse)(nil),      // 23: vinbero.v1.Headendv4DeleteResponse
+	(*Headendv4ListRequest)(nil),         // 24: vinbero.v1.Headendv4ListRequest
+	(*Headendv4ListResponse)(nil),        // 25: vinbero.v1.Headendv4ListResponse
+	(*Headendv4GetRequest)(nil),          // 26: vinbero.v1.Headendv4GetRequest
+	(*Headendv4GetResponse)(nil),         // 27: vinbero.v1.Headendv4GetResponse
+	(*Headendv4FlushRequest)(nil),        // 28: vinbero.v1.Headendv4FlushRequest
+	(*Headendv4FlushResponse)(nil),       // 29: vinbero.v1.Headendv4FlushResponse
+	(*Headendv6)(nil),                    // 30: vinbero.v1.Headendv6
+	(*Headendv6CreateRequest)(nil),       // 31: vinbero.v1.Headendv6CreateRequest
+	(*Headendv6CreateResponse)(nil),      // 32: vinbero.v1.Headendv6CreateResponse
+	(*Headendv6UpdateRequest)(nil),       // 33: vinbero.v1.Headendv6UpdateRequest
+	(*Headendv6UpdateResponse)(nil),      // 34: vinbero.v1.Headendv6UpdateResponse
+	(*Headendv6DeleteRequest)(nil),       // 35: vinbero.v1.Headendv6DeleteRequest
+	(*Headendv6DeleteResponse)(nil),      // 36: vinbero.v1.Headendv6DeleteResponse
+	(*Headendv6ListRequest)(nil),         // 37: vinbero.v1.Headendv6ListRequest
+	(*Headendv6ListResponse)(nil),        // 38: vinbero.v1.Headendv6ListResponse
+	(*Headendv6GetRequest)(nil),          // 39: vinbero.v1.Headendv6GetRequest
+	(*Headendv6GetResponse)(nil),         // 40: vinbero.v1.Headendv6GetResponse
+	(*Headendv6FlushRequest)(nil),        // 41: vinbero.v1.Headendv6FlushRequest
+	(*Headendv6FlushResponse)(nil),       // 42: vinbero.v1.Headendv6FlushResponse
+	(*FdbEntry)(nil),                     // 43: vinbero.v1.FdbEntry
+	(*FdbListRequest)(nil),               // 44: vinbero.v1.FdbListRequest
+	(*FdbListResponse)(nil),              // 45: vinbero.v1.FdbListResponse
+	(*FdbCreateRequest)(nil),             // 46: vinbero.v1.FdbCreateRequest
+	(*FdbCreateResponse)(nil),            // 47: vinbero.v1.FdbCreateResponse
+	(*FdbDeleteRequest)(nil),             // 48: vinbero.v1.FdbDeleteRequest
+	(*FdbDeleteResponse)(nil),            // 49: vinbero.v1.FdbDeleteResponse
+	(*FdbFlushRequest)(nil),              // 50: vinbero.v1.FdbFlushRequest
+	(*FdbFlushResponse)(nil),             // 51: vinbero.v1.FdbFlushResponse
+	(*VlanTableEntry)(nil),               // 52: vinbero.v1.VlanTableEntry
+	(*VlanTableCreateRequest)(nil),       // 53: vinbero.v1.VlanTableCreateRequest
+	(*VlanTableCreateResponse)(nil),      // 54: vinbero.v1.VlanTableCreateResponse
+	(*VlanTableUpdateRequest)(nil),       // 55: vinbero.v1.VlanTableUpdateRequest
+	(*VlanTableUpdateResponse)(nil),      // 56: vinbero.v1.VlanTableUpdateResponse
+	(*VlanTableDeleteRequest)(nil),       // 57: vinbero.v1.VlanTableDeleteRequest
+	(*VlanTableDeleteResponse)(nil),      // 58: vinbero.v1.VlanTableDeleteResponse
+	(*VlanTableListRequest)(nil),         // 59: vinbero.v1.VlanTableListRequest
+	(*VlanTableListResponse)(nil),        // 60: vinbero.v1.VlanTableListResponse
+	(*VlanTableFlushRequest)(nil),        // 61: vinbero.v1.VlanTableFlushRequest
+	(*VlanTableFlushResponse)(nil),       // 62: vinbero.v1.VlanTableFlushResponse
+	(*SrDomainPolicy)(nil),               // 63: vinbero.v1.SrDomainPolicy
+	(*SrDomainCreateRequest)(nil),        // 64: vinbero.v1.SrDomainCreateRequest
+	(*SrDomainCreateResponse)(nil),       // 65: vinbero.v1.SrDomainCreateResponse
+	(*SrDomainDeleteRequest)(nil),        // 66: vinbero.v1.SrDomainDeleteRequest
+	(*SrDomainDeleteResponse)(nil),       // 67: vinbero.v1.SrDomainDeleteResponse
+	(*SrDomainListRequest)(nil),          // 68: vinbero.v1.SrDomainListRequest
+	(*SrDomainListResponse)(nil),         // 69: vinbero.v1.SrDomainListResponse
+	(*SrDomainFlushRequest)(nil),         // 70: vinbero.v1.SrDomainFlushRequest
+	(*SrDomainFlushResponse)(nil),        // 71: vinbero.v1.SrDomainFlushResponse
+	(*BdPeer)(nil),                       // 72: vinbero.v1.BdPeer
+	(*BdPeerCreateRequest)(nil),          // 73: vinbero.v1.BdPeerCreateRequest
+	(*BdPeerCreateResponse)(nil),         // 74: vinbero.v1.BdPeerCreateResponse
+	(*BdPeerUpdateRequest)(nil),          // 75: vinbero.v1.BdPeerUpdateRequest
+	(*BdPeerUpdateResponse)(nil),         // 76: vinbero.v1.BdPeerUpdateResponse
+	(*BdPeerDeleteRequest)(nil),          // 77: vinbero.v1.BdPeerDeleteRequest
+	(*BdPeerDeleteResponse)(nil),         // 78: vinbero.v1.BdPeerDeleteResponse
+	(*BdPeerListRequest)(nil),            // 79: vinbero.v1.BdPeerListRequest
+	(*BdPeerListResponse)(nil),           // 80: vinbero.v1.BdPeerListResponse
+	(*BdPeerFlushRequest)(nil),           // 81: vinbero.v1.BdPeerFlushRequest
+	(*BdPeerFlushResponse)(nil),          // 82: vinbero.v1.BdPeerFlushResponse
+	(*VniMapping)(nil),                   // 83: vinbero.v1.VniMapping
+	(*VniMappingCreateRequest)(nil),      // 84: vinbero.v1.VniMappingCreateRequest
+	(*VniMappingCreateResponse)(nil),     // 85: vinbero.v1.VniMappingCreateResponse
+	(*VniMappingDeleteRequest)(nil),      // 86: vinbero.v1.VniMappingDeleteRequest
+	(*VniMappingDeleteResponse)(nil),     // 87: vinbero.v1.VniMappingDeleteResponse
+	(*VniMappingListRequest)(nil),        // 88: vinbero.v1.VniMappingListRequest
+	(*VniMappingListResponse)(nil),       // 89: vinbero.v1.VniMappingListResponse
+	(*VniMappingFlushRequest)(nil),       // 90: vinbero.v1.VniMappingFlushRequest
+	(*VniMappingFlushResponse)(nil),      // 91: vinbero.v1.VniMappingFlushResponse
+	(*BridgeDomain)(nil),                 // 92: vinbero.v1.BridgeDomain
+	(*BridgeDomainCreateRequest)(nil),    // 93: vinbero.v1.BridgeDomainCreateRequest
+	(*BridgeDomainCreateResponse)(nil),   // 94: vinbero.v1.BridgeDomainCreateResponse
+	(*BridgeDomainDeleteRequest)(nil),    // 95: vinbero.v1.BridgeDomainDeleteRequest
+	(*BridgeDomainDeleteResponse)(nil),   // 96: vinbero.v1.BridgeDomainDeleteResponse
+	(*BridgeDomainGetRequest)(nil),       // 97: vinbero.v1.BridgeDomainGetRequest
+	(*BridgeDomainGetResponse)(nil),      // 98: vinbero.v1.BridgeDomainGetResponse
+	(*BridgeDomainListRequest)(nil),      // 99: vinbero.v1.BridgeDomainListRequest
+	(*BridgeDomainListResponse)(nil),     // 100: vinbero.v1.BridgeDomainListResponse
+	(*MacLimit)(nil),                     // 101: vinbero.v1.MacLimit
+	(*MacLimitSetRequest)(nil),           // 102: vinbero.v1.MacLimitSetRequest
+	(*MacLimitSetResponse)(nil),          // 103: vinbero.v1.MacLimitSetResponse
+	(*MacLimitDeleteRequest)(nil),        // 104: vinbero.v1.MacLimitDeleteRequest
+	(*MacLimitDeleteResponse)(nil),       // 105: vinbero.v1.MacLimitDeleteResponse
+	(*MacLimitListRequest)(nil),          // 106: vinbero.v1.MacLimitListRequest
+	(*MacLimitListResponse)(nil),         // 107: vinbero.v1.MacLimitListResponse
+	(*StormControl)(nil),                 // 108: vinbero.v1.StormControl
+	(*StormControlSetRequest)(nil),       // 109: vinbero.v1.StormControlSetRequest
+	(*StormControlSetResponse)(nil),      // 110: vinbero.v1.StormControlSetResponse
+	(*StormControlDeleteRequest)(nil),    // 111: vinbero.v1.StormControlDeleteRequest
+	(*StormControlDeleteResponse)(nil),   // 112: vinbero.v1.StormControlDeleteResponse
+	(*StormControlListRequest)(nil),      // 113: vinbero.v1.StormControlListRequest
+	(*StormControlListResponse)(nil),     // 114: vinbero.v1.StormControlListResponse
+	(*Neighbor)(nil),                     // 115: vinbero.v1.Neighbor
+	(*NeighborCreateRequest)(nil),        // 116: vinbero.v1.NeighborCreateRequest
+	(*NeighborCreateResponse)(nil),       // 117: vinbero.v1.NeighborCreateResponse
+	(*NeighborDeleteRequest)(nil),        // 118: vinbero.v1.NeighborDeleteRequest
+	(*NeighborDeleteResponse)(nil),       // 119: vinbero.v1.NeighborDeleteResponse
+	(*NeighborListRequest)(nil),          // 120: vinbero.v1.NeighborListRequest
+	(*NeighborListResponse)(nil),         // 121: vinbero.v1.NeighborListResponse
+	(*Irb)(nil),                          // 122: vinbero.v1.Irb
+	(*IrbRoute)(nil),                     // 123: vinbero.v1.IrbRoute
+	(*IrbRouteCreateRequest)(nil),        // 124: vinbero.v1.IrbRouteCreateRequest
+	(*IrbRouteCreateResponse)(nil),       // 125: vinbero.v1.IrbRouteCreateResponse
+	(*IrbRouteDeleteRequest)(nil),        // 126: vinbero.v1.IrbRouteDeleteRequest
+	(*IrbRouteDeleteResponse)(nil),       // 127: vinbero.v1.IrbRouteDeleteResponse
+	(*IrbRouteListRequest)(nil),          // 128: vinbero.v1.IrbRouteListRequest
+	(*IrbRouteListResponse)(nil),         // 129: vinbero.v1.IrbRouteListResponse
+	(*EthernetSegment)(nil),              // 130: vinbero.v1.EthernetSegment
+	(*EsCreateRequest)(nil),              // 131: vinbero.v1.EsCreateRequest
+	(*EsCreateResponse)(nil),             // 132: vinbero.v1.EsCreateResponse
+	(*EsUpdateRequest)(nil),              // 133: vinbero.v1.EsUpdateRequest
+	(*EsUpdateResponse)(nil),             // 134: vinbero.v1.EsUpdateResponse
+	(*EsDeleteRequest)(nil),              // 135: vinbero.v1.EsDeleteRequest
+	(*EsDeleteResponse)(nil),             // 136: vinbero.v1.EsDeleteResponse
+	(*EsListRequest)(nil),                // 137: vinbero.v1.EsListRequest
+	(*EsListResponse)(nil),               // 138: vinbero.v1.EsListResponse
+	(*EsSetDfRequest)(nil),               // 139: vinbero.v1.EsSetDfRequest
+	(*EsSetDfResponse)(nil),              // 140: vinbero.v1.EsSetDfResponse
+	(*EsClearDfRequest)(nil),             // 141: vinbero.v1.EsClearDfRequest
+	(*EsClearDfResponse)(nil),            // 142: vinbero.v1.EsClearDfResponse
+	(*Vrf)(nil),                          // 143: vinbero.v1.Vrf
+	(*VrfCreateRequest)(nil),             // 144: vinbero.v1.VrfCreateRequest
+	(*VrfCreateResponse)(nil),            // 145: vinbero.v1.VrfCreateResponse
+	(*VrfDeleteRequest)(nil),             // 146: vinbero.v1.VrfDeleteRequest
+	(*VrfDeleteResponse)(nil),            // 147: vinbero.v1.VrfDeleteResponse
+	(*VrfListRequest)(nil),               // 148: vinbero.v1.VrfListRequest
+	(*VrfListResponse)(nil),              // 149: vinbero.v1.VrfListResponse
+	(*Bridge)(nil),                       // 150: vinbero.v1.Bridge
+	(*BridgeCreateRequest)(nil),          // 151: vinbero.v1.BridgeCreateRequest
+	(*BridgeCreateResponse)(nil),         // 152: vinbero.v1.BridgeCreateResponse
+	(*BridgeDeleteRequest)(nil),          // 153: vinbero.v1.BridgeDeleteRequest
+	(*BridgeDeleteResponse)(nil),         // 154: vinbero.v1.BridgeDeleteResponse
+	(*BridgeListRequest)(nil),            // 155: vinbero.v1.BridgeListRequest
+	(*BridgeListResponse)(nil),           // 156: vinbero.v1.BridgeListResponse
+	(*HeadendL2)(nil),                    // 157: vinbero.v1.HeadendL2
+	(*VlanRewrite)(nil),                  // 158: vinbero.v1.VlanRewrite
+	(*HeadendL2CreateRequest)(nil),       // 159: vinbero.v1.HeadendL2CreateRequest
+	(*HeadendL2CreateResponse)(nil),      // 160: vinbero.v1.HeadendL2CreateResponse
+	(*HeadendL2UpdateRequest)(nil),       // 161: vinbero.v1.HeadendL2UpdateRequest
+	(*HeadendL2UpdateResponse)(nil),      // 162: vinbero.v1.HeadendL2UpdateResponse
+	(*HeadendL2DeleteTarget)(nil),        // 163: vinbero.v1.HeadendL2DeleteTarget
+	(*HeadendL2DeleteRequest)(nil),       // 164: vinbero.v1.HeadendL2DeleteRequest
+	(*HeadendL2DeleteResponse)(nil),      // 165: vinbero.v1.HeadendL2DeleteResponse
+	(*HeadendL2ListRequest)(nil),         // 166: vinbero.v1.HeadendL2ListRequest
+	(*HeadendL2ListResponse)(nil),        // 167: vinbero.v1.HeadendL2ListResponse
+	(*HeadendL2GetRequest)(nil),          // 168: vinbero.v1.HeadendL2GetRequest
+	(*HeadendL2GetResponse)(nil),         // 169: vinbero.v1.HeadendL2GetResponse
+	(*HeadendL2FlushRequest)(nil),        // 170: vinbero.v1.HeadendL2FlushRequest
+	(*HeadendL2FlushResponse)(nil),       // 171: vinbero.v1.HeadendL2FlushResponse
+	(*StatsCounter)(nil),                 // 172: vinbero.v1.StatsCounter
+	(*StatsShowRequest)(nil),             // 173: vinbero.v1.StatsShowRequest
+	(*StatsShowResponse)(nil),            // 174: vinbero.v1.StatsShowResponse
+	(*StatsResetRequest)(nil),            // 175: vinbero.v1.StatsResetRequest
+	(*StatsResetResponse)(nil),           // 176: vinbero.v1.StatsResetResponse
+	(*SlotStatsEntry)(nil),               // 177: vinbero.v1.SlotStatsEntry
+	(*StatsSlotShowRequest)(nil),         // 178: vinbero.v1.StatsSlotShowRequest
+	(*StatsSlotShowResponse)(nil),        // 179: vinbero.v1.StatsSlotShowResponse
+	(*StatsSlotResetRequest)(nil),        // 180: vinbero.v1.StatsSlotResetRequest
+	(*StatsSlotResetResponse)(nil),       // 181: vinbero.v1.StatsSlotResetResponse
+	(*OamProbeResult)(nil),               // 182: vinbero.v1.OamProbeResult
+	(*OamPingRequest)(nil),               // 183: vinbero.v1.OamPingRequest
+	(*OamPingResponse)(nil),              // 184: vinbero.v1.OamPingResponse
+	(*OamTracerouteRequest)(nil),         // 185: vinbero.v1.OamTracerouteRequest
+	(*OamTracerouteResponse)(nil),        // 186: vinbero.v1.OamTracerouteResponse
+	(*OamPunt)(nil),                      // 187: vinbero.v1.OamPunt
+	(*OamPuntListRequest)(nil),           // 188: vinbero.v1.OamPuntListRequest
+	(*OamPuntListResponse)(nil),          // 189: vinbero.v1.OamPuntListResponse
+	(*StampReflector)(nil),               // 190: vinbero.v1.StampReflector
+	(*StampReflectorCreateRequest)(nil),  // 191: vinbero.v1.StampReflectorCreateRequest
+	(*StampReflectorCreateResponse)(nil), // 192: vinbero.v1.StampReflectorCreateResponse
+	(*StampReflectorDeleteRequest)(nil),  // 193: vinbero.v1.StampReflectorDeleteRequest
+	(*StampReflectorDeleteResponse)(nil), // 194: vinbero.v1.StampReflectorDeleteResponse
+	(*StampReflectorListRequest)(nil),    // 195: vinbero.v1.StampReflectorListRequest
+	(*StampReflectorListResponse)(nil),   // 196: vinbero.v1.StampReflectorListResponse
+	(*StampSession)(nil),                 // 197: vinbero.v1.StampSession
+	(*StampSessionStats)(nil),            // 198: vinbero.v1.StampSessionStats
+	(*StampSessionStatus)(nil),           // 199: vinbero.v1.StampSessionStatus
+	(*StampSessionCreateRequest)(nil),    // 200: vinbero.v1.StampSessionCreateRequest
+	(*StampSessionCreateResponse)(nil),   // 201: vinbero.v1.StampSessionCreateResponse
+	(*StampSessionDeleteRequest)(nil),    // 202: vinbero.v1.StampSessionDeleteRequest
+	(*StampSessionDeleteResponse)(nil),   // 203: vinbero.v1.StampSessionDeleteResponse
+	(*StampSessionListRequest)(nil),      // 204: vinbero.v1.StampSessionListRequest
+	(*StampSessionListResponse)(nil),     // 205: vinbero.v1.StampSessionListResponse
+	(*ConfigSectionResult)(nil),          // 206: vinbero.v1.ConfigSectionResult
+	(*ReloadRequest)(nil),                // 207: vinbero.v1.ReloadRequest
+	(*ReloadResponse)(nil),               // 208: vinbero.v1.ReloadResponse
+	(Srv6LocalAction)(0),                 // 209: vinbero.v1.Srv6LocalAction
+	(Srv6LocalFlavor)(0),                 // 210: vinbero.v1.Srv6LocalFlavor
+	(Srv6HeadendBehavior)(0),             // 211: vinbero.v1.Srv6HeadendBehavior
+	(*OperationError)(nil),               // 212: vinbero.v1.OperationError
+	(*fieldmaskpb.FieldMask)(nil),        // 213: google.protobuf.FieldMask
+	(Srv6EncapTcMode)(0),                 // 214: vinbero.v1.Srv6EncapTcMode
+	(Srv6EncapHopLimitMode)(0),           // 215: vinbero.v1.Srv6EncapHopLimitMode
+	(Srv6EncapFlowLabelMode)(0),          // 216: vinbero.v1.Srv6EncapFlowLabelMode
+	(OamReplyType)(0),                    // 217: vinbero.v1.OamReplyType
+}
+var file_vinbero_v1_vinbero_proto_depIdxs = []int32{
+	209, // 0: vinbero.v1.SidFunction.action:type_name -> vinbero.v1.Srv6LocalAction
+	210, // 1: vinbero.v1.SidFunction.flavor:type_name -> vinbero.v1.Srv6LocalFlavor
+	211, // 2: vinbero.v1.SidFunction.headend_mode:type_name -> vinbero.v1.Srv6HeadendBehavior
+	4,   // 3: vinbero.v1.SidFunctionCreateRequest.sid_functions:type_name -> vinbero.v1.SidFunction
+	4,   // 4: vinbero.v1.SidFunctionCreateResponse.created:type_name -> vinbero.v1.SidFunction
+	212, // 5: vinbero.v1.SidFunctionCreateResponse.errors:type_name -> vinbero.v1.OperationError
+	4,   // 6: vinbero.v1.SidFunctionUpdateRequest.sid_functions:type_name -> vinbero.v1.SidFunction
+	213, // 7: vinbero.v1.SidFunctionUpdateRequest.update_mask:type_name -> google.protobuf.FieldMask
+	4,   // 8: vinbero.v1.SidFunctionUpdateResponse.updated:type_name -> vinbero.v1.SidFunction
+	212, // 9: vinbero.v1.SidFunctionUpdateResponse.errors:type_name -> vinbero.v1.OperationError
+	212, // 10: vinbero.v1.SidFunctionDeleteResponse.errors:type_name -> vinbero.v1.OperationError
+	4,   // 11: vinbero.v1.SidFunctionListResponse.sid_functions:type_name -> vinbero.v1.SidFunction
+	4,   // 12: vinbero.v1.SidFunctionGetResponse.sid_function:type_name -> vinbero.v1.SidFunction
+	211, // 13: vinbero.v1.Headendv4.mode:type_name -> vinbero.v1.Srv6HeadendBehavior
+	214, // 14: vinbero.v1.Headendv4.tc_mode:type_name -> vinbero.v1.Srv6EncapTcMode
+	215, // 15: vinbero.v1.Headendv4.hop_limit_mode:type_name -> vinbero.v1.Srv6EncapHopLimitMode
+	216, // 16: vinbero.v1.Headendv4.flow_label_mode:type_name -> vinbero.v1.Srv6EncapFlowLabelMode
+	17,  // 17: vinbero.v1.Headendv4CreateRequest.headendv4s:type_name -> vinbero.v1.Headendv4
+	17,  // 18: vinbero.v1.Headendv4CreateResponse.created:type_name -> vinbero.v1.Headendv4
+	212, // 19: vinbero.v1.Headendv4CreateResponse.errors:type_name -> vinbero.v1.OperationError
+	17,  // 20: vinbero.v1.Headendv4UpdateRequest.headendv4s:type_name -> vinbero.v1.Headendv4
+	213, // 21: vinbero.v1.Headendv4UpdateRequest.update_mask:type_name -> google.protobuf.FieldMask
+	17,  // 22: vinbero.v1.Headendv4UpdateResponse.updated:type_name -> vinbero.v1.Headendv4
+	212, // 23: vinbero.v1.Headendv4UpdateResponse.errors:type_name -> vinbero.v1.OperationError
+	212, // 24: vinbero.v1.Headendv4DeleteResponse.errors:type_name -> vinbero.v1.OperationError
+	17,  // 25: vinbero.v1.Headendv4ListResponse.headendv4s:type_name -> vinbero.v1.Headendv4
+	17,  // 26: vinbero.v1.Headendv4GetResponse.headendv4:type_name -> vinbero.v1.Headendv4
+	211, // 27: vinbero.v1.Headendv6.mode:type_name -> vinbero.v1.Srv6HeadendBehavior
+	214, // 28: vinbero.v1.Headendv6.tc_mode:type_name -> vinbero.v1.Srv6EncapTcMode
+	215, // 29: vinbero.v1.Headendv6.hop_limit_mode:type_name -> vinbero.v1.Srv6EncapHopLimitMode
+	216, // 30: vinbero.v1.Headendv6.flow_label_mode:type_name -> vinbero.v1.Srv6EncapFlowLabelMode
+	30,  // 31: vinbero.v1.Headendv6CreateRequest.headendv6s:type_name -> vinbero.v1.Headendv6
+	30,  // 32: vinbero.v1.Headendv6CreateResponse.created:type_name -> vinbero.v1.Headendv6
+	212, // 33: vinbero.v1.Headendv6CreateResponse.errors:type_name -> vinbero.v1.OperationError
+	30,  // 34: vinbero.v1.Headendv6UpdateRequest.headendv6s:type_name -> vinbero.v1.Headendv6
+	213, // 35: vinbero.v1.Headendv6UpdateRequest.update_mask:type_name -> google.protobuf.FieldMask
+	30,  // 36: vinbero.v1.Headendv6UpdateResponse.updated:type_name -> vinbero.v1.Headendv6
+	212, // 37: vinbero.v1.Headendv6UpdateResponse.errors:type_name -> vinbero.v1.OperationError
+	212, // 38: vinbero.v1.Headendv6DeleteResponse.errors:type_name -> vinbero.v1.OperationError
+	30,  // 39: vinbero.v1.Headendv6ListResponse.headendv6s:type_name -> vinbero.v1.Headendv6
+	30,  // 40: vinbero.v1.Headendv6GetResponse.headendv6:type_name -> vinbero.v1.Headendv6
+	158, // 41: vinbero.v1.FdbEntry.vlan_rewrite:type_name -> vinbero.v1.VlanRewrite
+	43,  // 42: vinbero.v1.FdbListResponse.entries:type_name -> vinbero.v1.FdbEntry
+	158, // 43: vinbero.v1.FdbCreateRequest.vlan_rewrite:type_name -> vinbero.v1.VlanRewrite
+	158, // 44: vinbero.v1.VlanTableEntry.vlan_rewrite:type_name -> vinbero.v1.VlanRewrite
+	52,  // 45: vinbero.v1.VlanTableCreateRequest.entries:type_name -> vinbero.v1.VlanTableEntry
+	52,  // 46: vinbero.v1.VlanTableCreateResponse.created:type_name -> vinbero.v1.VlanTableEntry
+	212, // 47: vinbero.v1.VlanTableCreateResponse.errors:type_name -> vinbero.v1.OperationError
+	52,  // 48: vinbero.v1.VlanTableUpdateRequest.entries:type_name -> vinbero.v1.VlanTableEntry
+	213, // 49: vinbero.v1.VlanTableUpdateRequest.update_mask:type_name -> google.protobuf.FieldMask
+	52,  // 50: vinbero.v1.VlanTableUpdateResponse.updated:type_name -> vinbero.v1.VlanTableEntry
+	212, // 51: vinbero.v1.VlanTableUpdateResponse.errors:type_name -> vinbero.v1.OperationError
+	52,  // 52: vinbero.v1.VlanTableDeleteRequest.entries:type_name -> vinbero.v1.VlanTableEntry
+	52,  // 53: vinbero.v1.VlanTableDeleteResponse.deleted:type_name -> vinbero.v1.VlanTableEntry
+	212, // 54: vinbero.v1.VlanTableDeleteResponse.errors:type_name -> vinbero.v1.OperationError
+	52,  // 55: vinbero.v1.VlanTableListResponse.entries:type_name -> vinbero.v1.VlanTableEntry
+	63,  // 56: vinbero.v1.SrDomainCreateRequest.policies:type_name -> vinbero.v1.SrDomainPolicy
+	63,  // 57: vinbero.v1.SrDomainCreateResponse.created:type_name -> vinbero.v1.SrDomainPolicy
+	212, // 58: vinbero.v1.SrDomainCreateResponse.errors:type_name -> vinbero.v1.OperationError
+	212, // 59: vinbero.v1.SrDomainDeleteResponse.errors:type_name -> vinbero.v1.OperationError
+	63,  // 60: vinbero.v1.SrDomainListResponse.policies:type_name -> vinbero.v1.SrDomainPolicy
+	211, // 61: vinbero.v1.BdPeer.mode:type_name -> vinbero.v1.Srv6HeadendBehavior
+	72,  // 62: vinbero.v1.BdPeerCreateRequest.peers:type_name -> vinbero.v1.BdPeer
+	72,  // 63: vinbero.v1.BdPeerCreateResponse.created:type_name -> vinbero.v1.BdPeer
+	212, // 64: vinbero.v1.BdPeerCreateResponse.errors:type_name -> vinbero.v1.OperationError
+	72,  // 65: vinbero.v1.BdPeerUpdateRequest.peers:type_name -> vinbero.v1.BdPeer
+	213, // 66: vinbero.v1.BdPeerUpdateRequest.update_mask:type_name -> google.protobuf.FieldMask
+	72,  // 67: vinbero.v1.BdPeerUpdateResponse.updated:type_name -> vinbero.v1.BdPeer
+	212, // 68: vinbero.v1.BdPeerUpdateResponse.errors:type_name -> vinbero.v1.OperationError
+	212, // 69: vinbero.v1.BdPeerDeleteResponse.errors:type_name -> vinbero.v1.OperationError
+	72,  // 70: vinbero.v1.BdPeerListResponse.peers:type_name -> vinbero.v1.BdPeer
+	83,  // 71: vinbero.v1.VniMappingCreateRequest.mappings:type_name -> vinbero.v1.VniMapping
+	83,  // 72: vinbero.v1.VniMappingCreateResponse.created:type_name -> vinbero.v1.VniMapping
+	212, // 73: vinbero.v1.VniMappingCreateResponse.errors:type_name -> vinbero.v1.OperationError
+	212, // 74: vinbero.v1.VniMappingDeleteResponse.errors:type_name -> vinbero.v1.OperationError
+	83,  // 75: vinbero.v1.VniMappingListResponse.mappings:type_name -> vinbero.v1.VniMapping
+	150, // 76: vinbero.v1.BridgeDomain.bridge:type_name -> vinbero.v1.Bridge
+	157, // 77: vinbero.v1.BridgeDomain.acs:type_name -> vinbero.v1.HeadendL2
+	4,   // 78: vinbero.v1.BridgeDomain.sids:type_name -> vinbero.v1.SidFunction
+	72,  // 79: vinbero.v1.BridgeDomain.peers:type_name -> vinbero.v1.BdPeer
+	130, // 80: vinbero.v1.BridgeDomain.ethernet_segments:type_name -> vinbero.v1.EthernetSegment
+	101, // 81: vinbero.v1.BridgeDomain.mac_limits:type_name -> vinbero.v1.MacLimit
+	115, // 82: vinbero.v1.BridgeDomain.neighbors:type_name -> vinbero.v1.Neighbor
+	122, // 83: vinbero.v1.BridgeDomain.irb:type_name -> vinbero.v1.Irb
+	108, // 84: vinbero.v1.BridgeDomain.storm_controls:type_name -> vinbero.v1.StormControl
+	92,  // 85: vinbero.v1.BridgeDomainCreateRequest.bridge_domains:type_name -> vinbero.v1.BridgeDomain
+	92,  // 86: vinbero.v1.BridgeDomainCreateResponse.created:type_name -> vinbero.v1.BridgeDomain
+	212, // 87: vinbero.v1.BridgeDomainCreateResponse.errors:type_name -> vinbero.v1.OperationError
+	212, // 88: vinbero.v1.BridgeDomainDeleteResponse.errors:type_name -> vinbero.v1.OperationError
+	92,  // 89: vinbero.v1.BridgeDomainGetResponse.bridge_domain:type_name -> vinbero.v1.BridgeDomain
+	92,  // 90: vinbero.v1.BridgeDomainListResponse.bridge_domains:type_name -> vinbero.v1.BridgeDomain
+	0,   // 91: vinbero.v1.MacLimit.limit_action:type_name -> vinbero.v1.MacLimitAction
+	101, // 92: vinbero.v1.MacLimitSetRequest.limits:type_name -> vinbero.v1.MacLimit
+	101, // 93: vinbero.v1.MacLimitSetResponse.set:type_name -> vinbero.v1.MacLimit
+	212, // 94: vinbero.v1.MacLimitSetResponse.errors:type_name -> vinbero.v1.OperationError
+	101, // 95: vinbero.v1.MacLimitDeleteRequest.limits:type_name -> vinbero.v1.MacLimit
+	101, // 96: vinbero.v1.MacLimitDeleteResponse.deleted:type_name -> vinbero.v1.MacLimit
+	212, // 97: vinbero.v1.MacLimitDeleteResponse.errors:type_name -> vinbero.v1.OperationError
+	101, // 98: vinbero.v1.MacLimitListResponse.limits:type_name -> vinbero.v1.MacLimit
+	108, // 99: vinbero.v1.StormControlSetRequest.storm_controls:type_name -> vinbero.v1.StormControl
+	108, // 100: vinbero.v1.StormControlSetResponse.set:type_name -> vinbero.v1.StormControl
+	212, // 101: vinbero.v1.StormControlSetResponse.errors:type_name -> vinbero.v1.OperationError
+	108, // 102: vinbero.v1.StormControlDeleteRequest.storm_controls:type_name -> vinbero.v1.StormControl
+	108, // 103: vinbero.v1.StormControlDeleteResponse.deleted:type_name -> vinbero.v1.StormControl
+	212, // 104: vinbero.v1.StormControlDeleteResponse.errors:type_name -> vinbero.v1.OperationError
+	108, // 105: vinbero.v1.StormControlListResponse.storm_controls:type_name -> vinbero.v1.StormControl
+	1,   // 106: vinbero.v1.Neighbor.origin:type_name -> vinbero.v1.NeighborOrigin
+	115, // 107: vinbero.v1.NeighborCreateRequest.neighbors:type_name -> vinbero.v1.Neighbor
+	115, // 108: vinbero.v1.NeighborCreateResponse.created:type_name -> vinbero.v1.Neighbor
+	212, // 109: vinbero.v1.NeighborCreateResponse.errors:type_name -> vinbero.v1.OperationError
+	115, // 110: vinbero.v1.NeighborDeleteRequest.neighbors:type_name -> vinbero.v1.Neighbor
+	115, // 111: vinbero.v1.NeighborDeleteResponse.deleted:type_name -> vinbero.v1.Neighbor
+	212, // 112: vinbero.v1.NeighborDeleteResponse.errors:type_name -> vinbero.v1.OperationError
+	115, // 113: vinbero.v1.NeighborListResponse.neighbors:type_name -> vinbero.v1.Neighbor
+	211, // 114: vinbero.v1.IrbRoute.mode:type_name -> vinbero.v1.Srv6HeadendBehavior
+	123, // 115: vinbero.v1.IrbRouteCreateRequest.routes:type_name -> vinbero.v1.IrbRoute
+	123, // 116: vinbero.v1.IrbRouteCreateResponse.created:type_name -> vinbero.v1.IrbRoute
+	212, // 117: vinbero.v1.IrbRouteCreateResponse.errors:type_name -> vinbero.v1.OperationError
+	123, // 118: vinbero.v1.IrbRouteDeleteRequest.routes:type_name -> vinbero.v1.IrbRoute
+	123, // 119: vinbero.v1.IrbRouteDeleteResponse.deleted:type_name -> vinbero.v1.IrbRoute
+	212, // 120: vinbero.v1.IrbRouteDeleteResponse.errors:type_name -> vinbero.v1.OperationError
+	123, // 121: vinbero.v1.IrbRouteListResponse.routes:type_name -> vinbero.v1.IrbRoute
+	2,   // 122: vinbero.v1.EthernetSegment.redundancy_mode:type_name -> vinbero.v1.EsiRedundancyMode
+	130, // 123: vinbero.v1.EsCreateRequest.entries:type_name -> vinbero.v1.EthernetSegment
+	130, // 124: vinbero.v1.EsCreateResponse.created:type_name -> vinbero.v1.EthernetSegment
+	212, // 125: vinbero.v1.EsCreateResponse.errors:type_name -> vinbero.v1.OperationError
+	130, // 126: vinbero.v1.EsUpdateRequest.entries:type_name -> vinbero.v1.EthernetSegment
+	213, // 127: vinbero.v1.EsUpdateRequest.update_mask:type_name -> google.protobuf.FieldMask
+	130, // 128: vinbero.v1.EsUpdateResponse.updated:type_name -> vinbero.v1.EthernetSegment
+	212, // 129: vinbero.v1.EsUpdateResponse.errors:type_name -> vinbero.v1.OperationError
+	212, // 130: vinbero.v1.EsDeleteResponse.errors:type_name -> vinbero.v1.OperationError
+	130, // 131: vinbero.v1.EsListResponse.entries:type_name -> vinbero.v1.EthernetSegment
+	130, // 132: vinbero.v1.EsSetDfResponse.updated:type_name -> vinbero.v1.EthernetSegment
+	130, // 133: vinbero.v1.EsClearDfResponse.updated:type_name -> vinbero.v1.EthernetSegment
+	143, // 134: vinbero.v1.VrfCreateRequest.vrfs:type_name -> vinbero.v1.Vrf
+	143, // 135: vinbero.v1.VrfCreateResponse.created:type_name -> vinbero.v1.Vrf
+	212, // 136: vinbero.v1.VrfCreateResponse.errors:type_name -> vinbero.v1.OperationError
+	212, // 137: vinbero.v1.VrfDeleteResponse.errors:type_name -> vinbero.v1.OperationError
+	143, // 138: vinbero.v1.VrfListResponse.vrfs:type_name -> vinbero.v1.Vrf
+	150, // 139: vinbero.v1.BridgeCreateRequest.bridges:type_name -> vinbero.v1.Bridge
+	150, // 140: vinbero.v1.BridgeCreateResponse.created:type_name -> vinbero.v1.Bridge
+	212, // 141: vinbero.v1.BridgeCreateResponse.errors:type_name -> vinbero.v1.OperationError
+	212, // 142: vinbero.v1.BridgeDeleteResponse.errors:type_name -> vinbero.v1.OperationError
+	150, // 143: vinbero.v1.BridgeListResponse.bridges:type_name -> vinbero.v1.Bridge
+	211, // 144: vinbero.v1.HeadendL2.mode:type_name -> vinbero.v1.Srv6HeadendBehavior
+	214, // 145: vinbero.v1.HeadendL2.tc_mode:type_name -> vinbero.v1.Srv6EncapTcMode
+	215, // 146: vinbero.v1.HeadendL2.hop_limit_mode:type_name -> vinbero.v1.Srv6EncapHopLimitMode
+	216, // 147: vinbero.v1.HeadendL2.flow_label_mode:type_name -> vinbero.v1.Srv6EncapFlowLabelMode
+	158, // 148: vinbero.v1.HeadendL2.egress_vlan_rewrite:type_name -> vinbero.v1.VlanRewrite
+	3,   // 149: vinbero.v1.VlanRewrite.op:type_name -> vinbero.v1.VlanRewriteOp
+	157, // 150: vinbero.v1.HeadendL2CreateRequest.headend_l2s:type_name -> vinbero.v1.HeadendL2
+	157, // 151: vinbero.v1.HeadendL2CreateResponse.created:type_name -> vinbero.v1.HeadendL2
+	212, // 152: vinbero.v1.HeadendL2CreateResponse.errors:type_name -> vinbero.v1.OperationError
+	157, // 153: vinbero.v1.HeadendL2UpdateRequest.headend_l2s:type_name -> vinbero.v1.HeadendL2
+	213, // 154: vinbero.v1.HeadendL2UpdateRequest.update_mask:type_name -> google.protobuf.FieldMask
+	157, // 155: vinbero.v1.HeadendL2UpdateResponse.updated:type_name -> vinbero.v1.HeadendL2
+	212, // 156: vinbero.v1.HeadendL2UpdateResponse.errors:type_name -> vinbero.v1.OperationError
+	163, // 157: vinbero.v1.HeadendL2DeleteRequest.targets:type_name -> vinbero.v1.HeadendL2DeleteTarget
+	163, // 158: vinbero.v1.HeadendL2DeleteResponse.deleted:type_name -> vinbero.v1.HeadendL2DeleteTarget
+	212, // 159: vinbero.v1.HeadendL2DeleteResponse.errors:type_name -> vinbero.v1.OperationError
+	157, // 160: vinbero.v1.HeadendL2ListResponse.headend_l2s:type_name -> vinbero.v1.HeadendL2
+	157, // 161: vinbero.v1.HeadendL2GetResponse.headend_l2:type_name -> vinbero.v1.HeadendL2
+	172, // 162: vinbero.v1.StatsShowResponse.counters:type_name -> vinbero.v1.StatsCounter
+	177, // 163: vinbero.v1.StatsSlotShowResponse.entries:type_name -> vinbero.v1.SlotStatsEntry
+	217, // 164: vinbero.v1.OamProbeResult.reply:type_name -> vinbero.v1.OamReplyType
+	182, // 165: vinbero.v1.OamPingResponse.results:type_name -> vinbero.v1.OamProbeResult
+	182, // 166: vinbero.v1.OamTracerouteResponse.hops:type_name -> vinbero.v1.OamProbeResult
+	209, // 167: vinbero.v1.OamPunt.action:type_name -> vinbero.v1.Srv6LocalAction
+	187, // 168: vinbero.v1.OamPuntListResponse.punts:type_name -> vinbero.v1.OamPunt
+	190, // 169: vinbero.v1.StampReflectorCreateRequest.reflectors:type_name -> vinbero.v1.StampReflector
+	190, // 170: vinbero.v1.StampReflectorCreateResponse.created:type_name -> vinbero.v1.StampReflector
+	212, // 171: vinbero.v1.StampReflectorCreateResponse.errors:type_name -> vinbero.v1.OperationError
+	190, // 172: vinbero.v1.StampReflectorDeleteRequest.reflectors:type_name -> vinbero.v1.StampReflector
+	190, // 173: vinbero.v1.StampReflectorDeleteResponse.deleted:type_name -> vinbero.v1.StampReflector
+	212, // 174: vinbero.v1.StampReflectorDeleteResponse.errors:type_name -> vinbero.v1.OperationError
+	190, // 175: vinbero.v1.StampReflectorListResponse.reflectors:type_name -> vinbero.v1.StampReflector
+	197, // 176: vinbero.v1.StampSessionStatus.session:type_name -> vinbero.v1.StampSession
+	198, // 177: vinbero.v1.StampSessionStatus.stats:type_name -> vinbero.v1.StampSessionStats
+	197, // 178: vinbero.v1.StampSessionCreateRequest.sessions:type_name -> vinbero.v1.StampSession
+	197, // 179: vinbero.v1.StampSessionCreateResponse.created:type_name -> vinbero.v1.StampSession
+	212, // 180: vinbero.v1.StampSessionCreateResponse.errors:type_name -> vinbero.v1.OperationError
+	212, // 181: vinbero.v1.StampSessionDeleteResponse.errors:type_name -> vinbero.v1.OperationError
+	199, // 182: vinbero.v1.StampSessionListResponse.sessions:type_name -> vinbero.v1.StampSessionStatus
+	212, // 183: vinbero.v1.ConfigSectionResult.errors:type_name -> vinbero.v1.OperationError
+	206, // 184: vinbero.v1.ReloadResponse.sections:type_name -> vinbero.v1.ConfigSectionResult
+	5,   // 185: vinbero.v1.SidFunctionService.SidFunctionCreate:input_type -> vinbero.v1.SidFunctionCreateRequest
+	7,   // 186: vinbero.v1.SidFunctionService.SidFunctionUpdate:input_type -> vinbero.v1.SidFunctionUpdateRequest
+	9,   // 187: vinbero.v1.SidFunctionService.SidFunctionDelete:input_type -> vinbero.v1.SidFunctionDeleteRequest
+	11,  // 188: vinbero.v1.SidFunctionService.SidFunctionList:input_type -> vinbero.v1.SidFunctionListRequest
+	15,  // 189: vinbero.v1.SidFunctionService.SidFunctionFlush:input_type -> vinbero.v1.SidFunctionFlushRequest
+	13,  // 190: vinbero.v1.SidFunctionService.SidFunctionGet:input_type -> vinbero.v1.SidFunctionGetRequest
+	18,  // 191: vinbero.v1.Headendv4Service.Headendv4Create:input_type -> vinbero.v1.Headendv4CreateRequest
+	20,  // 192: vinbero.v1.Headendv4Service.Headendv4Update:input_type -> vinbero.v1.Headendv4UpdateRequest
+	22,  // 193: vinbero.v1.Headendv4Service.Headendv4Delete:input_type -> vinbero.v1.Headendv4DeleteRequest
+	24,  // 194: vinbero.v1.Headendv4Service.Headendv4List:input_type -> vinbero.v1.Headendv4ListRequest
+	28,  // 195: vinbero.v1.Headendv4Service.Headendv4Flush:input_type -> vinbero.v1.Headendv4FlushRequest
+	26,  // 196: vinbero.v1.Headendv4Service.Headendv4Get:input_type -> vinbero.v1.Headendv4GetRequest
+	31,  // 197: vinbero.v1.Headendv6Service.Headendv6Create:input_type -> vinbero.v1.Headendv6CreateRequest
+	33,  // 198: vinbero.v1.Headendv6Service.Headendv6Update:input_type -> vinbero.v1.Headendv6UpdateRequest
+	35,  // 199: vinbero.v1.Headendv6Service.Headendv6Delete:input_type -> vinbero.v1.Headendv6DeleteRequest
+	37,  // 200: vinbero.v1.Headendv6Service.Headendv6List:input_type -> vinbero.v1.Headendv6ListRequest
+	39,  // 201: vinbero.v1.Headendv6Service.Headendv6Get:input_type -> vinbero.v1.Headendv6GetRequest
+	41,  // 202: vinbero.v1.Headendv6Service.Headendv6Flush:input_type -> vinbero.v1.Headendv6FlushRequest
+	44,  // 203: vinbero.v1.FdbService.FdbList:input_type -> vinbero.v1.FdbListRequest
+	46,  // 204: vinbero.v1.FdbService.FdbCreate:input_type -> vinbero.v1.FdbCreateRequest
+	48,  // 205: vinbero.v1.FdbService.FdbDelete:input_type -> vinbero.v1.FdbDeleteRequest
+	50,  // 206: vinbero.v1.FdbService.FdbFlush:input_type -> vinbero.v1.FdbFlushRequest
+	53,  // 207: vinbero.v1.VlanTableService.VlanTableCreate:input_type -> vinbero.v1.VlanTableCreateRequest
+	55,  // 208: vinbero.v1.VlanTableService.VlanTableUpdate:input_type -> vinbero.v1.VlanTableUpdateRequest
+	57,  // 209: vinbero.v1.VlanTableService.VlanTableDelete:input_type -> vinbero.v1.VlanTableDeleteRequest
+	59,  // 210: vinbero.v1.VlanTableService.VlanTableList:input_type -> vinbero.v1.VlanTableListRequest
+	61,  // 211: vinbero.v1.VlanTableService.VlanTableFlush:input_type -> vinbero.v1.VlanTableFlushRequest
+	64,  // 212: vinbero.v1.SrDomainService.SrDomainCreate:input_type -> vinbero.v1.SrDomainCreateRequest
+	66,  // 213: vinbero.v1.SrDomainService.SrDomainDelete:input_type -> vinbero.v1.SrDomainDeleteRequest
+	68,  // 214: vinbero.v1.SrDomainService.SrDomainList:input_type -> vinbero.v1.SrDomainListRequest
+	70,  // 215: vinbero.v1.SrDomainService.SrDomainFlush:input_type -> vinbero.v1.SrDomainFlushRequest
+	73,  // 216: vinbero.v1.BdPeerService.BdPeerCreate:input_type -> vinbero.v1.BdPeerCreateRequest
+	75,  // 217: vinbero.v1.BdPeerService.BdPeerUpdate:input_type -> vinbero.v1.BdPeerUpdateRequest
+	77,  // 218: vinbero.v1.BdPeerService.BdPeerDelete:input_type -> vinbero.v1.BdPeerDeleteRequest
+	79,  // 219: vinbero.v1.BdPeerService.BdPeerList:input_type -> vinbero.v1.BdPeerListRequest
+	81,  // 220: vinbero.v1.BdPeerService.BdPeerFlush:input_type -> vinbero.v1.BdPeerFlushRequest
+	84,  // 221: vinbero.v1.VniMappingService.VniMappingCreate:input_type -> vinbero.v1.VniMappingCreateRequest
+	86,  // 222: vinbero.v1.VniMappingService.VniMappingDelete:input_type -> vinbero.v1.VniMappingDeleteRequest
+	88,  // 223: vinbero.v1.VniMappingService.VniMappingList:input_type -> vinbero.v1.VniMappingListRequest
+	90,  // 224: vinbero.v1.VniMappingService.VniMappingFlush:input_type -> vinbero.v1.VniMappingFlushRequest
+	93,  // 225: vinbero.v1.BridgeDomainService.BridgeDomainCreate:input_type -> vinbero.v1.BridgeDomainCreateRequest
+	95,  // 226: vinbero.v1.BridgeDomainService.BridgeDomainDelete:input_type -> vinbero.v1.BridgeDomainDeleteRequest
+	97,  // 227: vinbero.v1.BridgeDomainService.BridgeDomainGet:input_type -> vinbero.v1.BridgeDomainGetRequest
+	99,  // 228: vinbero.v1.BridgeDomainService.BridgeDomainList:input_type -> vinbero.v1.BridgeDomainListRequest
+	102, // 229: vinbero.v1.BridgeDomainService.MacLimitSet:input_type -> vinbero.v1.MacLimitSetRequest
+	104, // 230: vinbero.v1.BridgeDomainService.MacLimitDelete:input_type -> vinbero.v1.MacLimitDeleteRequest
+	106, // 231: vinbero.v1.BridgeDomainService.MacLimitList:input_type -> vinbero.v1.MacLimitListRequest
+	109, // 232: vinbero.v1.BridgeDomainService.StormControlSet:input_type -> vinbero.v1.StormControlSetRequest
+	111, // 233: vinbero.v1.BridgeDomainService.StormControlDelete:input_type -> vinbero.v1.StormControlDeleteRequest
+	113, // 234: vinbero.v1.BridgeDomainService.StormControlList:input_type -> vinbero.v1.StormControlListRequest
+	116, // 235: vinbero.v1.BridgeDomainService.NeighborCreate:input_type -> vinbero.v1.NeighborCreateRequest
+	118, // 236: vinbero.v1.BridgeDomainService.NeighborDelete:input_type -> vinbero.v1.NeighborDeleteRequest
+	120, // 237: vinbero.v1.BridgeDomainService.NeighborList:input_type -> vinbero.v1.NeighborListRequest
+	124, // 238: vinbero.v1.BridgeDomainService.IrbRouteCreate:input_type -> vinbero.v1.IrbRouteCreateRequest
+	126, // 239: vinbero.v1.BridgeDomainService.IrbRouteDelete:input_type -> vinbero.v1.IrbRouteDeleteRequest
+	128, // 240: vinbero.v1.BridgeDomainService.IrbRouteList:input_type -> vinbero.v1.IrbRouteListRequest
+	131, // 241: vinbero.v1.EthernetSegmentService.EsCreate:input_type -> vinbero.v1.EsCreateRequest
+	133, // 242: vinbero.v1.EthernetSegmentService.EsUpdate:input_type -> vinbero.v1.EsUpdateRequest
+	135, // 243: vinbero.v1.EthernetSegmentService.EsDelete:input_type -> vinbero.v1.EsDeleteRequest
+	137, // 244: vinbero.v1.EthernetSegmentService.EsList:input_type -> vinbero.v1.EsListRequest
+	139, // 245: vinbero.v1.EthernetSegmentService.EsSetDf:input_type -> vinbero.v1.EsSetDfRequest
+	141, // 246: vinbero.v1.EthernetSegmentService.EsClearDf:input_type -> vinbero.v1.EsClearDfRequest
+	144, // 247: vinbero.v1.NetworkResourceService.VrfCreate:input_type -> vinbero.v1.VrfCreateRequest
+	146, // 248: vinbero.v1.NetworkResourceService.VrfDelete:input_type -> vinbero.v1.VrfDeleteRequest
+	148, // 249: vinbero.v1.NetworkResourceService.VrfList:input_type -> vinbero.v1.VrfListRequest
+	151, // 250: vinbero.v1.NetworkResourceService.BridgeCreate:input_type -> vinbero.v1.BridgeCreateRequest
+	153, // 251: vinbero.v1.NetworkResourceService.BridgeDelete:input_type -> vinbero.v1.BridgeDeleteRequest
+	155, // 252: vinbero.v1.NetworkResourceService.BridgeList:input_type -> vinbero.v1.BridgeListRequest
+	159, // 253: vinbero.v1.HeadendL2Service.HeadendL2Create:input_type -> vinbero.v1.HeadendL2CreateRequest
+	161, // 254: vinbero.v1.HeadendL2Service.HeadendL2Update:input_type -> vinbero.v1.HeadendL2UpdateRequest
+	164, // 255: vinbero.v1.HeadendL2Service.HeadendL2Delete:input_type -> vinbero.v1.HeadendL2DeleteRequest
+	166, // 256: vinbero.v1.HeadendL2Service.HeadendL2List:input_type -> vinbero.v1.HeadendL2ListRequest
+	168, // 257: vinbero.v1.HeadendL2Service.HeadendL2Get:input_type -> vinbero.v1.HeadendL2GetRequest
+	170, // 258: vinbero.v1.HeadendL2Service.HeadendL2Flush:input_type -> vinbero.v1.HeadendL2FlushRequest
+	173, // 259: vinbero.v1.StatsService.StatsShow:input_type -> vinbero.v1.StatsShowRequest
+	175, // 260: vinbero.v1.StatsService.StatsReset:input_type -> vinbero.v1.StatsResetRequest
+	178, // 261: vinbero.v1.StatsService.StatsSlotShow:input_type -> vinbero.v1.StatsSlotShowRequest
+	180, // 262: vinbero.v1.StatsService.StatsSlotReset:input_type -> vinbero.v1.StatsSlotResetRequest
+	183, // 263: vinbero.v1.OamService.OamPing:input_type -> vinbero.v1.OamPingRequest
+	185, // 264: vinbero.v1.OamService.OamTraceroute:input_type -> vinbero.v1.OamTracerouteRequest
+	188, // 265: vinbero.v1.OamService.OamPuntList:input_type -> vinbero.v1.OamPuntListRequest
+	191, // 266: vinbero.v1.PerformanceService.StampReflectorCreate:input_type -> vinbero.v1.StampReflectorCreateRequest
+	193, // 267: vinbero.v1.PerformanceService.StampReflectorDelete:input_type -> vinbero.v1.StampReflectorDeleteRequest
+	195, // 268: vinbero.v1.PerformanceService.StampReflectorList:input_type -> vinbero.v1.StampReflectorListRequest
+	200, // 269: vinbero.v1.PerformanceService.StampSessionCreate:input_type -> vinbero.v1.StampSessionCreateRequest
+	202, // 270: vinbero.v1.PerformanceService.StampSessionDelete:input_type -> vinbero.v1.StampSessionDeleteRequest
+	204, // 271: vinbero.v1.PerformanceService.StampSessionList:input_type -> vinbero.v1.StampSessionListRequest
+	207, // 272: vinbero.v1.DaemonService.Reload:input_type -> vinbero.v1.ReloadRequest
+	6,   // 273: vinbero.v1.SidFunctionService.SidFunctionCreate:output_type -> vinbero.v1.SidFunctionCreateResponse
+	8,   // 274: vinbero.v1.SidFunctionService.SidFunctionUpdate:output_type -> vinbero.v1.SidFunctionUpdateResponse
+	10,  // 275: vinbero.v1.SidFunctionService.SidFunctionDelete:output_type -> vinbero.v1.SidFunctionDeleteResponse
+	12,  // 276: vinbero.v1.SidFunctionService.SidFunctionList:output_type -> vinbero.v1.SidFunctionListResponse
+	16,  // 277: vinbero.v1.SidFunctionService.SidFunctionFlush:output_type -> vinbero.v1.SidFunctionFlushResponse
+	14,  // 278: vinbero.v1.SidFunctionService.SidFunctionGet:output_type -> vinbero.v1.SidFunctionGetResponse
+	19,  // 279: vinbero.v1.Headendv4Service.Headendv4Create:output_type -> vinbero.v1.Headendv4CreateResponse
+	21,  // 280: vinbero.v1.Headendv4Service.Headendv4Update:output_type -> vinbero.v1.Headendv4UpdateResponse
+	23,  // 281: vinbero.v1.Headendv4Service.Headendv4Delete:output_type -> vinbero.v1.Headendv4DeleteResponse
+	25,  // 282: vinbero.v1.Headendv4Service.Headendv4List:output_type -> vinbero.v1.Headendv4ListResponse
+	29,  // 283: vinbero.v1.Headendv4Service.Headendv4Flush:output_type -> vinbero.v1.Headendv4FlushResponse
+	27,  // 284: vinbero.v1.Headendv4Service.Headendv4Get:output_type -> vinbero.v1.Headendv4GetResponse
+	32,  // 285: vinbero.v1.Headendv6Service.Headendv6Create:output_type -> vinbero.v1.Headendv6CreateResponse
+	34,  // 286: vinbero.v1.Headendv6Service.Headendv6Update:output_type -> vinbero.v1.Headendv6UpdateResponse
+	36,  // 287: vinbero.v1.Headendv6Service.Headendv6Delete:output_type -> vinbero.v1.Headendv6DeleteResponse
+	38,  // 288: vinbero.v1.Headendv6Service.Headendv6List:output_type -> vinbero.v1.Headendv6ListResponse
+	40,  // 289: vinbero.v1.Headendv6Service.Headendv6Get:output_type -> vinbero.v1.Headendv6GetResponse
+	42,  // 290: vinbero.v1.Headendv6Service.Headendv6Flush:output_type -> vinbero.v1.Headendv6FlushResponse
+	45,  // 291: vinbero.v1.FdbService.FdbList:output_type -> vinbero.v1.FdbListResponse
+	47,  // 292: vinbero.v1.FdbService.FdbCreate:output_type -> vinbero.v1.FdbCreateResponse
+	49,  // 293: vinbero.v1.FdbService.FdbDelete:output_type -> vinbero.v1.FdbDeleteResponse
+	51,  // 294: vinbero.v1.FdbService.FdbFlush:output_type -> vinbero.v1.FdbFlushResponse
+	54,  // 295: vinbero.v1.VlanTableService.VlanTableCreate:output_type -> vinbero.v1.VlanTableCreateResponse
+	56,  // 296: vinbero.v1.VlanTableService.VlanTableUpdate:output_type -> vinbero.v1.VlanTableUpdateResponse
+	58,  // 297: vinbero.v1.VlanTableService.VlanTableDelete:output_type -> vinbero.v1.VlanTableDeleteResponse
+	60,  // 298: vinbero.v1.VlanTableService.VlanTableList:output_type -> vinbero.v1.VlanTableListResponse
+	62,  // 299: vinbero.v1.VlanTableService.VlanTableFlush:output_type -> vinbero.v1.VlanTableFlushResponse
+	65,  // 300: vinbero.v1.SrDomainService.SrDomainCreate:output_type -> vinbero.v1.SrDomainCreateResponse
+	67,  // 301: vinbero.v1.SrDomainService.SrDomainDelete:output_type -> vinbero.v1.SrDomainDeleteResponse
+	69,  // 302: vinbero.v1.SrDomainService.SrDomainList:output_type -> vinbero.v1.SrDomainListResponse
+	71,  // 303: vinbero.v1.SrDomainService.SrDomainFlush:output_type -> vinbero.v1.SrDomainFlushResponse
+	74,  // 304: vinbero.v1.BdPeerService.BdPeerCreate:output_type -> vinbero.v1.BdPeerCreateResponse
+	76,  // 305: vinbero.v1.BdPeerService.BdPeerUpdate:output_type -> vinbero.v1.BdPeerUpdateResponse
+	78,  // 306: vinbero.v1.BdPeerService.BdPeerDelete:output_type -> vinbero.v1.BdPeerDeleteResponse
+	80,  // 307: vinbero.v1.BdPeerService.BdPeerList:output_type -> vinbero.v1.BdPeerListResponse
+	82,  // 308: vinbero.v1.BdPeerService.BdPeerFlush:output_type -> vinbero.v1.BdPeerFlushResponse
+	85,  // 309: vinbero.v1.VniMappingService.VniMappingCreate:output_type -> vinbero.v1.VniMappingCreateResponse
+	87,  // 310: vinbero.v1.VniMappingService.VniMappingDelete:output_type -> vinbero.v1.VniMappingDeleteResponse
+	89,  // 311: vinbero.v1.VniMappingService.VniMappingList:output_type -> vinbero.v1.VniMappingListResponse
+	91,  // 312: vinbero.v1.VniMappingService.VniMappingFlush:output_type -> vinbero.v1.VniMappingFlushResponse
+	94,  // 313: vinbero.v1.BridgeDomainService.BridgeDomainCreate:output_type -> vinbero.v1.BridgeDomainCreateResponse
+	96,  // 314: vinbero.v1.BridgeDomainService.BridgeDomainDelete:output_type -> vinbero.v1.BridgeDomainDeleteResponse
+	98,  // 315: vinbero.v1.BridgeDomainService.BridgeDomainGet:output_type -> vinbero.v1.BridgeDomainGetResponse
+	100, // 316: vinbero.v1.BridgeDomainService.BridgeDomainList:output_type -> vinbero.v1.BridgeDomainListResponse
+	103, // 317: vinbero.v1.BridgeDomainService.MacLimitSet:output_type -> vinbero.v1.MacLimitSetResponse
+	105, // 318: vinbero.v1.BridgeDomainService.MacLimitDelete:output_type -> vinbero.v1.MacLimitDeleteResponse
+	107, // 319: vinbero.v1.BridgeDomainService.MacLimitList:output_type -> vinbero.v1.MacLimitListResponse
+	110, // 320: vinbero.v1.BridgeDomainService.StormControlSet:output_type -> vinbero.v1.StormControlSetResponse
+	112, // 321: vinbero.v1.BridgeDomainService.StormControlDelete:output_type -> vinbero.v1.StormControlDeleteResponse
+	114, // 322: vinbero.v1.BridgeDomainService.StormControlList:output_type -> vinbero.v1.StormControlListResponse
+	117, // 323: vinbero.v1.BridgeDomainService.NeighborCreate:output_type -> vinbero.v1.NeighborCreateResponse
+	119, // 324: vinbero.v1.BridgeDomainService.NeighborDelete:output_type -> vinbero.v1.NeighborDeleteResponse
+	121, // 325: vinbero.v1.BridgeDomainService.NeighborList:output_type -> vinbero.v1.NeighborListResponse
+	125, // 326: vinbero.v1.BridgeDomainService.IrbRouteCreate:output_type -> vinbero.v1.IrbRouteCreateResponse
+	127, // 327: vinbero.v1.BridgeDomainService.IrbRouteDelete:output_type -> vinbero.v1.IrbRouteDeleteResponse
+	129, // 328: vinbero.v1.BridgeDomainService.IrbRouteList:output_type -> vinbero.v1.IrbRouteListResponse
+	132, // 329: vinbero.v1.EthernetSegmentService.EsCreate:output_type -> vinbero.v1.EsCreateResponse
+	134, // 330: vinbero.v1.EthernetSegmentService.EsUpdate:output_type -> vinbero.v1.EsUpdateResponse
+	136, // 331: vinbero.v1.EthernetSegmentService.EsDelete:output_type -> vinbero.v1.EsDeleteResponse
+	138, // 332: vinbero.v1.EthernetSegmentService.EsList:output_type -> vinbero.v1.EsListResponse
+	140, // 333: vinbero.v1.EthernetSegmentService.EsSetDf:output_type -> vinbero.v1.EsSetDfResponse
+	142, // 334: vinbero.v1.EthernetSegmentService.EsClearDf:output_type -> vinbero.v1.EsClearDfResponse
+	145, // 335: vinbero.v1.NetworkResourceService.VrfCreate:output_type -> vinbero.v1.VrfCreateResponse
+	147, // 336: vinbero.v1.NetworkResourceService.VrfDelete:output_type -> vinbero.v1.VrfDeleteResponse
+	149, // 337: vinbero.v1.NetworkResourceService.VrfList:output_type -> vinbero.v1.VrfListResponse
+	152, // 338: vinbero.v1.NetworkResourceService.BridgeCreate:output_type -> vinbero.v1.BridgeCreateResponse
+	154, // 339: vinbero.v1.NetworkResourceService.BridgeDelete:output_type -> vinbero.v1.BridgeDeleteResponse
+	156, // 340: vinbero.v1.NetworkResourceService.BridgeList:output_type -> vinbero.v1.BridgeListResponse
+	160, // 341: vinbero.v1.HeadendL2Service.HeadendL2Create:output_type -> vinbero.v1.HeadendL2CreateResponse
+	162, // 342: vinbero.v1.HeadendL2Service.HeadendL2Update:output_type -> vinbero.v1.HeadendL2UpdateResponse
+	165, // 343: vinbero.v1.HeadendL2Service.HeadendL2Delete:output_type -> vinbero.v1.HeadendL2DeleteResponse
+	167, // 344: vinbero.v1.HeadendL2Service.HeadendL2List:output_type -> vinbero.v1.HeadendL2ListResponse
+	169, // 345: vinbero.v1.HeadendL2Service.HeadendL2Get:output_type -> vinbero.v1.HeadendL2GetResponse
+	171, // 346: vinbero.v1.HeadendL2Service.HeadendL2Flush:output_type -> vinbero.v1.HeadendL2FlushResponse
+	174, // 347: vinbero.v1.StatsService.StatsShow:output_type -> vinbero.v1.StatsShowResponse
+	176, // 348: vinbero.v1.StatsService.StatsReset:output_type -> vinbero.v1.StatsResetResponse
+	179, // 349: vinbero.v1.StatsService.StatsSlotShow:output_type -> vinbero.v1.StatsSlotShowResponse
+	181, // 350: vinbero.v1.StatsService.StatsSlotReset:output_type -> vinbero.v1.StatsSlotResetResponse
+	184, // 351: vinbero.v1.OamService.OamPing:output_type -> vinbero.v1.OamPingResponse
+	186, // 352: vinbero.v1.OamService.OamTraceroute:output_type -> vinbero.v1.OamTracerouteResponse
+	189, // 353: vinbero.v1.OamService.OamPuntList:output_type -> vinbero.v1.OamPuntListResponse
+	192, // 354: vinbero.v1.PerformanceService.StampReflectorCreate:output_type -> vinbero.v1.StampReflectorCreateResponse
+	194, // 355: vinbero.v1.PerformanceService.StampReflectorDelete:output_type -> vinbero.v1.StampReflectorDeleteResponse
+	196, // 356: vinbero.v1.PerformanceService.StampReflectorList:output_type -> vinbero.v1.StampReflectorListResponse
+	201, // 357: vinbero.v1.PerformanceService.StampSessionCreate:output_type -> vinbero.v1.StampSessionCreateResponse
+	203, // 358: vinbero.v1.PerformanceService.StampSessionDelete:output_type -> vinbero.v1.StampSessionDeleteResponse
+	205, // 359: vinbero.v1.PerformanceService.StampSessionList:output_type -> vinbero.v1.StampSessionListResponse
+	208, // 360: vinbero.v1.DaemonService.Reload:output_type -> vinbero.v1.ReloadResponse
+	273, // [273:361] is the sub-list for method output_type
+	185, // [185:273] is the sub-list for method input_type
+	185, // [185:185] is the sub-list for extension type_name
+	185, // [185:185] is the sub-list for extension extendee
+	0,   // [0:185] is the sub-list for field type_name
+}
+
+func init() { file_vinbero_v1_vinbero_proto_init() }
+func file_vinbero_v1_vinbero_proto_init() {
+	if File_vinbero_v1_vinbero_proto != nil {
+		return
+	}
+	file_vinbero_v1_enums_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_vinbero_v1_vinbero_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SidFunction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SidFunctionCreateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SidFunctionCreateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SidFunctionUpdateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SidFunctionUpdateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SidFunctionDeleteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SidFunctionDeleteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SidFunctionListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SidFunctionListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SidFunctionGetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SidFunctionGetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SidFunctionFlushRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SidFunctionFlushResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Headendv4); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Headendv4CreateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Headendv4CreateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Headendv4UpdateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Headendv4UpdateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Headendv4DeleteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Headendv4DeleteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Headendv4ListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Headendv4ListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Headendv4GetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Headendv4GetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Headendv4FlushRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Headendv4FlushResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Headendv6); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Headendv6CreateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Headendv6CreateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Headendv6UpdateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Headendv6UpdateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Headendv6DeleteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Headendv6DeleteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Headendv6ListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Headendv6ListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Headendv6GetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Headendv6GetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Headendv6FlushRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Headendv6FlushResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FdbEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FdbListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FdbListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FdbCreateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FdbCreateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FdbDeleteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FdbDeleteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FdbFlushRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FdbFlushResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VlanTableEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VlanTableCreateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VlanTableCreateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VlanTableUpdateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VlanTableUpdateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VlanTableDeleteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VlanTableDeleteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VlanTableListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VlanTableListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VlanTableFlushRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VlanTableFlushResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SrDomainPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SrDomainCreateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SrDomainCreateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SrDomainDeleteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SrDomainDeleteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SrDomainListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SrDomainListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SrDomainFlushRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SrDomainFlushResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BdPeer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BdPeerCreateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BdPeerCreateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BdPeerUpdateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BdPeerUpdateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BdPeerDeleteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BdPeerDeleteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BdPeerListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BdPeerListResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6839,8 +15150,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SidFunctionCreateRequest); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BdPeerFlushRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6851,8 +15162,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SidFunctionCreateResponse); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BdPeerFlushResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6863,8 +15174,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SidFunctionDeleteRequest); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VniMapping); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6875,8 +15186,272 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SidFunctionDeleteResponse); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VniMappingCreateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VniMappingCreateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VniMappingDeleteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VniMappingDeleteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VniMappingListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VniMappingListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VniMappingFlushRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VniMappingFlushResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BridgeDomain); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BridgeDomainCreateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BridgeDomainCreateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BridgeDomainDeleteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BridgeDomainDeleteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BridgeDomainGetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BridgeDomainGetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BridgeDomainListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BridgeDomainListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MacLimit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MacLimitSetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MacLimitSetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MacLimitDeleteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MacLimitDeleteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vinbero_v1_vinbero_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MacLimitListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6887,8 +15462,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SidFunctionListRequest); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MacLimitListResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6899,8 +15474,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SidFunctionListResponse); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StormControl); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6911,8 +15486,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SidFunctionGetRequest); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StormControlSetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6923,8 +15498,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SidFunctionGetResponse); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StormControlSetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6935,8 +15510,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SidFunctionFlushRequest); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StormControlDeleteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6947,8 +15522,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SidFunctionFlushResponse); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StormControlDeleteResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6959,8 +15534,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Headendv4); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StormControlListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6971,8 +15546,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Headendv4CreateRequest); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StormControlListResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6983,8 +15558,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Headendv4CreateResponse); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Neighbor); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6995,8 +15570,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Headendv4DeleteRequest); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NeighborCreateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7007,8 +15582,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Headendv4DeleteResponse); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NeighborCreateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7019,8 +15594,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Headendv4ListRequest); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NeighborDeleteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7031,8 +15606,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Headendv4ListResponse); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NeighborDeleteResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7043,8 +15618,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Headendv4GetRequest); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NeighborListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7055,8 +15630,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Headendv4GetResponse); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NeighborListResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7067,8 +15642,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Headendv4FlushRequest); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Irb); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7079,8 +15654,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Headendv4FlushResponse); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IrbRoute); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7091,8 +15666,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Headendv6); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IrbRouteCreateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7103,8 +15678,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Headendv6CreateRequest); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IrbRouteCreateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7115,8 +15690,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Headendv6CreateResponse); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IrbRouteDeleteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7127,8 +15702,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Headendv6DeleteRequest); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IrbRouteDeleteResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7139,8 +15714,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Headendv6DeleteResponse); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IrbRouteListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7151,8 +15726,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Headendv6ListRequest); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IrbRouteListResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7163,8 +15738,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Headendv6ListResponse); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EthernetSegment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7175,8 +15750,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Headendv6GetRequest); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EsCreateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7187,8 +15762,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Headendv6GetResponse); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EsCreateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7199,8 +15774,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Headendv6FlushRequest); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EsUpdateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7211,8 +15786,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Headendv6FlushResponse); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EsUpdateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7223,8 +15798,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FdbEntry); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EsDeleteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7235,8 +15810,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FdbListRequest); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EsDeleteResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7247,8 +15822,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FdbListResponse); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EsListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7259,8 +15834,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FdbCreateRequest); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EsListResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7271,8 +15846,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FdbCreateResponse); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EsSetDfRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7283,8 +15858,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FdbDeleteRequest); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EsSetDfResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7295,8 +15870,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FdbDeleteResponse); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EsClearDfRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7307,8 +15882,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FdbFlushRequest); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[138].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EsClearDfResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7319,8 +15894,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FdbFlushResponse); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[139].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Vrf); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7331,8 +15906,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VlanTableEntry); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[140].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VrfCreateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7343,8 +15918,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VlanTableCreateRequest); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[141].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VrfCreateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7355,8 +15930,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VlanTableCreateResponse); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[142].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VrfDeleteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7367,8 +15942,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VlanTableDeleteRequest); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[143].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VrfDeleteResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7379,8 +15954,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VlanTableDeleteResponse); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[144].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VrfListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7391,8 +15966,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VlanTableListRequest); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[145].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VrfListResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7403,8 +15978,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VlanTableListResponse); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[146].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Bridge); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7415,8 +15990,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VlanTableFlushRequest); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[147].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BridgeCreateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7427,8 +16002,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VlanTableFlushResponse); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[148].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BridgeCreateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7439,8 +16014,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BdPeer); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[149].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BridgeDeleteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7451,8 +16026,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BdPeerCreateRequest); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[150].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BridgeDeleteResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7463,8 +16038,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BdPeerCreateResponse); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[151].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BridgeListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7475,8 +16050,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BdPeerDeleteRequest); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[152].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BridgeListResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7487,8 +16062,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BdPeerDeleteResponse); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[153].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeadendL2); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7499,8 +16074,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BdPeerListRequest); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[154].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VlanRewrite); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7511,8 +16086,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BdPeerListResponse); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[155].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeadendL2CreateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7523,8 +16098,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BdPeerFlushRequest); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[156].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeadendL2CreateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7535,8 +16110,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BdPeerFlushResponse); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[157].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeadendL2UpdateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7547,8 +16122,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EthernetSegment); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[158].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeadendL2UpdateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7559,8 +16134,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EsCreateRequest); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[159].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeadendL2DeleteTarget); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7571,8 +16146,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EsCreateResponse); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[160].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeadendL2DeleteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7583,8 +16158,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EsDeleteRequest); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[161].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeadendL2DeleteResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7595,8 +16170,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EsDeleteResponse); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[162].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeadendL2ListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7607,8 +16182,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EsListRequest); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[163].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeadendL2ListResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7619,8 +16194,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EsListResponse); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[164].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeadendL2GetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7631,8 +16206,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EsSetDfRequest); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[165].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeadendL2GetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7643,8 +16218,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EsSetDfResponse); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[166].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeadendL2FlushRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7655,8 +16230,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EsClearDfRequest); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[167].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeadendL2FlushResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7667,8 +16242,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EsClearDfResponse); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[168].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsCounter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7679,8 +16254,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Vrf); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[169].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsShowRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7691,8 +16266,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VrfCreateRequest); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[170].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsShowResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7703,8 +16278,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VrfCreateResponse); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[171].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsResetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7715,8 +16290,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VrfDeleteRequest); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[172].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsResetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7727,8 +16302,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VrfDeleteResponse); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[173].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SlotStatsEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7739,8 +16314,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VrfListRequest); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[174].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsSlotShowRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7751,8 +16326,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VrfListResponse); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[175].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsSlotShowResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7763,8 +16338,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Bridge); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[176].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsSlotResetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7775,8 +16350,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BridgeCreateRequest); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[177].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsSlotResetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7787,8 +16362,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BridgeCreateResponse); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[178].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OamProbeResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7799,8 +16374,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BridgeDeleteRequest); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[179].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OamPingRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7811,8 +16386,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BridgeDeleteResponse); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[180].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OamPingResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7823,8 +16398,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BridgeListRequest); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[181].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OamTracerouteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7835,8 +16410,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BridgeListResponse); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[182].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OamTracerouteResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7847,8 +16422,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HeadendL2); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[183].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OamPunt); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7859,8 +16434,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HeadendL2CreateRequest); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[184].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OamPuntListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7871,8 +16446,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HeadendL2CreateResponse); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[185].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OamPuntListResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7883,8 +16458,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HeadendL2DeleteTarget); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[186].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StampReflector); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7895,8 +16470,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HeadendL2DeleteRequest); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[187].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StampReflectorCreateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7907,8 +16482,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HeadendL2DeleteResponse); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[188].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StampReflectorCreateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7919,8 +16494,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HeadendL2ListRequest); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[189].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StampReflectorDeleteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7931,8 +16506,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HeadendL2ListResponse); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[190].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StampReflectorDeleteResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7943,8 +16518,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HeadendL2GetRequest); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[191].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StampReflectorListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7955,8 +16530,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HeadendL2GetResponse); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[192].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StampReflectorListResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7967,8 +16542,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HeadendL2FlushRequest); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[193].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StampSession); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7979,8 +16554,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HeadendL2FlushResponse); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[194].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StampSessionStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7991,8 +16566,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatsCounter); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[195].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StampSessionStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8003,8 +16578,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatsShowRequest); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[196].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StampSessionCreateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8015,8 +16590,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatsShowResponse); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[197].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StampSessionCreateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8027,8 +16602,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatsResetRequest); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[198].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StampSessionDeleteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8039,8 +16614,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatsResetResponse); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[199].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StampSessionDeleteResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8051,8 +16626,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SlotStatsEntry); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[200].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StampSessionListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8063,8 +16638,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatsSlotShowRequest); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[201].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StampSessionListResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8075,8 +16650,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatsSlotShowResponse); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[202].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigSectionResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8087,8 +16662,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatsSlotResetRequest); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[203].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReloadRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8099,8 +16674,8 @@ func file_vinbero_v1_vinbero_proto_init() {
 				return nil
 			}
 		}
-		file_vinbero_v1_vinbero_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatsSlotResetResponse); i {
+		file_vinbero_v1_vinbero_proto_msgTypes[204].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReloadResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8117,10 +16692,10 @@ func file_vinbero_v1_vinbero_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_vinbero_v1_vinbero_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   107,
+			NumEnums:      4,
+			NumMessages:   205,
 			NumExtensions: 0,
-			NumServices:   10,
+			NumServices:   16,
 		},
 		GoTypes:           file_vinbero_v1_vinbero_proto_goTypes,
 		DependencyIndexes: file_vinbero_v1_vinbero_proto_depIdxs,
@@ -21,6 +21,7 @@ const _ = grpc.SupportPackageIsVersion7
 
 const (
 	SidFunctionService_SidFunctionCreate_FullMethodName = "/vinbero.v1.SidFunctionService/SidFunctionCreate"
+	SidFunctionService_SidFunctionUpdate_FullMethodName = "/vinbero.v1.SidFunctionService/SidFunctionUpdate"
 	SidFunctionService_SidFunctionDelete_FullMethodName = "/vinbero.v1.SidFunctionService/SidFunctionDelete"
 	SidFunctionService_SidFunctionList_FullMethodName   = "/vinbero.v1.SidFunctionService/SidFunctionList"
 	SidFunctionService_SidFunctionFlush_FullMethodName  = "/vinbero.v1.SidFunctionService/SidFunctionFlush"
@@ -32,6 +33,7 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type SidFunctionServiceClient interface {
 	SidFunctionCreate(ctx context.Context, in *SidFunctionCreateRequest, opts ...grpc.CallOption) (*SidFunctionCreateResponse, error)
+	SidFunctionUpdate(ctx context.Context, in *SidFunctionUpdateRequest, opts ...grpc.CallOption) (*SidFunctionUpdateResponse, error)
 	SidFunctionDelete(ctx context.Context, in *SidFunctionDeleteRequest, opts ...grpc.CallOption) (*SidFunctionDeleteResponse, error)
 	SidFunctionList(ctx context.Context, in *SidFunctionListRequest, opts ...grpc.CallOption) (*SidFunctionListResponse, error)
 	SidFunctionFlush(ctx context.Context, in *SidFunctionFlushRequest, opts ...grpc.CallOption) (*SidFunctionFlushResponse, error)
@@ -55,6 +57,15 @@ func (c *sidFunctionServiceClient) SidFunctionCreate(ctx context.Context, in *Si
 	return out, nil
 }
 
+func (c *sidFunctionServiceClient) SidFunctionUpdate(ctx context.Context, in *SidFunctionUpdateRequest, opts ...grpc.CallOption) (*SidFunctionUpdateResponse, error) {
+	out := new(SidFunctionUpdateResponse)
+	err := c.cc.Invoke(ctx, SidFunctionService_SidFunctionUpdate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sidFunctionServiceClient) SidFunctionDelete(ctx context.Context, in *SidFunctionDeleteRequest, opts ...grpc.CallOption) (*SidFunctionDeleteResponse, error) {
 	out := new(SidFunctionDeleteResponse)
 	err := c.cc.Invoke(ctx, SidFunctionService_SidFunctionDelete_FullMethodName, in, out, opts...)
@@ -96,6 +107,7 @@ func (c *sidFunctionServiceClient) SidFunctionGet(ctx context.Context, in *SidFu
 // for forward compatibility
 type SidFunctionServiceServer interface {
 	SidFunctionCreate(context.Context, *SidFunctionCreateRequest) (*SidFunctionCreateResponse, error)
+	SidFunctionUpdate(context.Context, *SidFunctionUpdateRequest) (*SidFunctionUpdateResponse, error)
 	SidFunctionDelete(context.Context, *SidFunctionDeleteRequest) (*SidFunctionDeleteResponse, error)
 	SidFunctionList(context.Context, *SidFunctionListRequest) (*SidFunctionListResponse, error)
 	SidFunctionFlush(context.Context, *SidFunctionFlushRequest) (*SidFunctionFlushResponse, error)
@@ -109,6 +121,9 @@ type UnimplementedSidFunctionServiceServer struct {
 func (UnimplementedSidFunctionServiceServer) SidFunctionCreate(context.Context, *SidFunctionCreateRequest) (*SidFunctionCreateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SidFunctionCreate not implemented")
 }
+func (UnimplementedSidFunctionServiceServer) SidFunctionUpdate(context.Context, *SidFunctionUpdateRequest) (*SidFunctionUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SidFunctionUpdate not implemented")
+}
 func (UnimplementedSidFunctionServiceServer) SidFunctionDelete(context.Context, *SidFunctionDeleteRequest) (*SidFunctionDeleteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SidFunctionDelete not implemented")
 }
@@ -151,6 +166,24 @@ func _SidFunctionService_SidFunctionCreate_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SidFunctionService_SidFunctionUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SidFunctionUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SidFunctionServiceServer).SidFunctionUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SidFunctionService_SidFunctionUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SidFunctionServiceServer).SidFunctionUpdate(ctx, req.(*SidFunctionUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SidFunctionService_SidFunctionDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SidFunctionDeleteRequest)
 	if err := dec(in); err != nil {
@@ -234,6 +267,10 @@ var SidFunctionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SidFunctionCreate",
 			Handler:    _SidFunctionService_SidFunctionCreate_Handler,
 		},
+		{
+			MethodName: "SidFunctionUpdate",
+			Handler:    _SidFunctionService_SidFunctionUpdate_Handler,
+		},
 		{
 			MethodName: "SidFunctionDelete",
 			Handler:    _SidFunctionService_SidFunctionDelete_Handler,
@@ -257,6 +294,7 @@ var SidFunctionService_ServiceDesc = grpc.ServiceDesc{
 
 const (
 	Headendv4Service_Headendv4Create_FullMethodName = "/vinbero.v1.Headendv4Service/Headendv4Create"
+	Headendv4Service_Headendv4Update_FullMethodName = "/vinbero.v1.Headendv4Service/Headendv4Update"
 	Headendv4Service_Headendv4Delete_FullMethodName = "/vinbero.v1.Headendv4Service/Headendv4Delete"
 	Headendv4Service_Headendv4List_FullMethodName   = "/vinbero.v1.Headendv4Service/Headendv4List"
 	Headendv4Service_Headendv4Flush_FullMethodName  = "/vinbero.v1.Headendv4Service/Headendv4Flush"
@@ -268,6 +306,7 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type Headendv4ServiceClient interface {
 	Headendv4Create(ctx context.Context, in *Headendv4CreateRequest, opts ...grpc.CallOption) (*Headendv4CreateResponse, error)
+	Headendv4Update(ctx context.Context, in *Headendv4UpdateRequest, opts ...grpc.CallOption) (*Headendv4UpdateResponse, error)
 	Headendv4Delete(ctx context.Context, in *Headendv4DeleteRequest, opts ...grpc.CallOption) (*Headendv4DeleteResponse, error)
 	Headendv4List(ctx context.Context, in *Headendv4ListRequest, opts ...grpc.CallOption) (*Headendv4ListResponse, error)
 	Headendv4Flush(ctx context.Context, in *Headendv4FlushRequest, opts ...grpc.CallOption) (*Headendv4FlushResponse, error)
@@ -291,6 +330,15 @@ func (c *headendv4ServiceClient) Headendv4Create(ctx context.Context, in *Headen
 	return out, nil
 }
 
+func (c *headendv4ServiceClient) Headendv4Update(ctx context.Context, in *Headendv4UpdateRequest, opts ...grpc.CallOption) (*Headendv4UpdateResponse, error) {
+	out := new(Headendv4UpdateResponse)
+	err := c.cc.Invoke(ctx, Headendv4Service_Headendv4Update_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *headendv4ServiceClient) Headendv4Delete(ctx context.Context, in *Headendv4DeleteRequest, opts ...grpc.CallOption) (*Headendv4DeleteResponse, error) {
 	out := new(Headendv4DeleteResponse)
 	err := c.cc.Invoke(ctx, Headendv4Service_Headendv4Delete_FullMethodName, in, out, opts...)
@@ -332,6 +380,7 @@ func (c *headendv4ServiceClient) Headendv4Get(ctx context.Context, in *Headendv4
 // for forward compatibility
 type Headendv4ServiceServer interface {
 	Headendv4Create(context.Context, *Headendv4CreateRequest) (*Headendv4CreateResponse, error)
+	Headendv4Update(context.Context, *Headendv4UpdateRequest) (*Headendv4UpdateResponse, error)
 	Headendv4Delete(context.Context, *Headendv4DeleteRequest) (*Headendv4DeleteResponse, error)
 	Headendv4List(context.Context, *Headendv4ListRequest) (*Headendv4ListResponse, error)
 	Headendv4Flush(context.Context, *Headendv4FlushRequest) (*Headendv4FlushResponse, error)
@@ -345,6 +394,9 @@ type UnimplementedHeadendv4ServiceServer struct {
 func (UnimplementedHeadendv4ServiceServer) Headendv4Create(context.Context, *Headendv4CreateRequest) (*Headendv4CreateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Headendv4Create not implemented")
 }
+func (UnimplementedHeadendv4ServiceServer) Headendv4Update(context.Context, *Headendv4UpdateRequest) (*Headendv4UpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Headendv4Update not implemented")
+}
 func (UnimplementedHeadendv4ServiceServer) Headendv4Delete(context.Context, *Headendv4DeleteRequest) (*Headendv4DeleteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Headendv4Delete not implemented")
 }
@@ -387,6 +439,24 @@ func _Headendv4Service_Headendv4Create_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Headendv4Service_Headendv4Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Headendv4UpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Headendv4ServiceServer).Headendv4Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Headendv4Service_Headendv4Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Headendv4ServiceServer).Headendv4Update(ctx, req.(*Headendv4UpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Headendv4Service_Headendv4Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Headendv4DeleteRequest)
 	if err := dec(in); err != nil {
@@ -470,6 +540,10 @@ var Headendv4Service_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Headendv4Create",
 			Handler:    _Headendv4Service_Headendv4Create_Handler,
 		},
+		{
+			MethodName: "Headendv4Update",
+			Handler:    _Headendv4Service_Headendv4Update_Handler,
+		},
 		{
 			MethodName: "Headendv4Delete",
 			Handler:    _Headendv4Service_Headendv4Delete_Handler,
@@ -493,6 +567,7 @@ var Headendv4Service_ServiceDesc = grpc.ServiceDesc{
 
 const (
 	Headendv6Service_Headendv6Create_FullMethodName = "/vinbero.v1.Headendv6Service/Headendv6Create"
+	Headendv6Service_Headendv6Update_FullMethodName = "/vinbero.v1.Headendv6Service/Headendv6Update"
 	Headendv6Service_Headendv6Delete_FullMethodName = "/vinbero.v1.Headendv6Service/Headendv6Delete"
 	Headendv6Service_Headendv6List_FullMethodName   = "/vinbero.v1.Headendv6Service/Headendv6List"
 	Headendv6Service_Headendv6Get_FullMethodName    = "/vinbero.v1.Headendv6Service/Headendv6Get"
@@ -504,6 +579,7 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type Headendv6ServiceClient interface {
 	Headendv6Create(ctx context.Context, in *Headendv6CreateRequest, opts ...grpc.CallOption) (*Headendv6CreateResponse, error)
+	Headendv6Update(ctx context.Context, in *Headendv6UpdateRequest, opts ...grpc.CallOption) (*Headendv6UpdateResponse, error)
 	Headendv6Delete(ctx context.Context, in *Headendv6DeleteRequest, opts ...grpc.CallOption) (*Headendv6DeleteResponse, error)
 	Headendv6List(ctx context.Context, in *Headendv6ListRequest, opts ...grpc.CallOption) (*Headendv6ListResponse, error)
 	Headendv6Get(ctx context.Context, in *Headendv6GetRequest, opts ...grpc.CallOption) (*Headendv6GetResponse, error)
@@ -527,6 +603,15 @@ func (c *headendv6ServiceClient) Headendv6Create(ctx context.Context, in *Headen
 	return out, nil
 }
 
+func (c *headendv6ServiceClient) Headendv6Update(ctx context.Context, in *Headendv6UpdateRequest, opts ...grpc.CallOption) (*Headendv6UpdateResponse, error) {
+	out := new(Headendv6UpdateResponse)
+	err := c.cc.Invoke(ctx, Headendv6Service_Headendv6Update_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *headendv6ServiceClient) Headendv6Delete(ctx context.Context, in *Headendv6DeleteRequest, opts ...grpc.CallOption) (*Headendv6DeleteResponse, error) {
 	out := new(Headendv6DeleteResponse)
 	err := c.cc.Invoke(ctx, Headendv6Service_Headendv6Delete_FullMethodName, in, out, opts...)
@@ -568,6 +653,7 @@ func (c *headendv6ServiceClient) Headendv6Flush(ctx context.Context, in *Headend
 // for forward compatibility
 type Headendv6ServiceServer interface {
 	Headendv6Create(context.Context, *Headendv6CreateRequest) (*Headendv6CreateResponse, error)
+	Headendv6Update(context.Context, *Headendv6UpdateRequest) (*Headendv6UpdateResponse, error)
 	Headendv6Delete(context.Context, *Headendv6DeleteRequest) (*Headendv6DeleteResponse, error)
 	Headendv6List(context.Context, *Headendv6ListRequest) (*Headendv6ListResponse, error)
 	Headendv6Get(context.Context, *Headendv6GetRequest) (*Headendv6GetResponse, error)
@@ -581,6 +667,9 @@ type UnimplementedHeadendv6ServiceServer struct {
 func (UnimplementedHeadendv6ServiceServer) Headendv6Create(context.Context, *Headendv6CreateRequest) (*Headendv6CreateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Headendv6Create not implemented")
 }
+func (UnimplementedHeadendv6ServiceServer) Headendv6Update(context.Context, *Headendv6UpdateRequest) (*Headendv6UpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Headendv6Update not implemented")
+}
 func (UnimplementedHeadendv6ServiceServer) Headendv6Delete(context.Context, *Headendv6DeleteRequest) (*Headendv6DeleteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Headendv6Delete not implemented")
 }
@@ -623,6 +712,24 @@ func _Headendv6Service_Headendv6Create_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Headendv6Service_Headendv6Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Headendv6UpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Headendv6ServiceServer).Headendv6Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Headendv6Service_Headendv6Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Headendv6ServiceServer).Headendv6Update(ctx, req.(*Headendv6UpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Headendv6Service_Headendv6Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Headendv6DeleteRequest)
 	if err := dec(in); err != nil {
@@ -706,6 +813,10 @@ var Headendv6Service_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Headendv6Create",
 			Handler:    _Headendv6Service_Headendv6Create_Handler,
 		},
+		{
+			MethodName: "Headendv6Update",
+			Handler:    _Headendv6Service_Headendv6Update_Handler,
+		},
 		{
 			MethodName: "Headendv6Delete",
 			Handler:    _Headendv6Service_Headendv6Delete_Handler,
@@ -928,6 +1039,7 @@ var FdbService_ServiceDesc = grpc.ServiceDesc{
 
 const (
 	VlanTableService_VlanTableCreate_FullMethodName = "/vinbero.v1.VlanTableService/VlanTableCreate"
+	VlanTableService_VlanTableUpdate_FullMethodName = "/vinbero.v1.VlanTableService/VlanTableUpdate"
 	VlanTableService_VlanTableDelete_FullMethodName = "/vinbero.v1.VlanTableService/VlanTableDelete"
 	VlanTableService_VlanTableList_FullMethodName   = "/vinbero.v1.VlanTableService/VlanTableList"
 	VlanTableService_VlanTableFlush_FullMethodName  = "/vinbero.v1.VlanTableService/VlanTableFlush"
@@ -938,6 +1050,7 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type VlanTableServiceClient interface {
 	VlanTableCreate(ctx context.Context, in *VlanTableCreateRequest, opts ...grpc.CallOption) (*VlanTableCreateResponse, error)
+	VlanTableUpdate(ctx context.Context, in *VlanTableUpdateRequest, opts ...grpc.CallOption) (*VlanTableUpdateResponse, error)
 	VlanTableDelete(ctx context.Context, in *VlanTableDeleteRequest, opts ...grpc.CallOption) (*VlanTableDeleteResponse, error)
 	VlanTableList(ctx context.Context, in *VlanTableListRequest, opts ...grpc.CallOption) (*VlanTableListResponse, error)
 	VlanTableFlush(ctx context.Context, in *VlanTableFlushRequest, opts ...grpc.CallOption) (*VlanTableFlushResponse, error)
@@ -960,6 +1073,15 @@ func (c *vlanTableServiceClient) VlanTableCreate(ctx context.Context, in *VlanTa
 	return out, nil
 }
 
+func (c *vlanTableServiceClient) VlanTableUpdate(ctx context.Context, in *VlanTableUpdateRequest, opts ...grpc.CallOption) (*VlanTableUpdateResponse, error) {
+	out := new(VlanTableUpdateResponse)
+	err := c.cc.Invoke(ctx, VlanTableService_VlanTableUpdate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vlanTableServiceClient) VlanTableDelete(ctx context.Context, in *VlanTableDeleteRequest, opts ...grpc.CallOption) (*VlanTableDeleteResponse, error) {
 	out := new(VlanTableDeleteResponse)
 	err := c.cc.Invoke(ctx, VlanTableService_VlanTableDelete_FullMethodName, in, out, opts...)
@@ -992,6 +1114,7 @@ func (c *vlanTableServiceClient) VlanTableFlush(ctx context.Context, in *VlanTab
 // for forward compatibility
 type VlanTableServiceServer interface {
 	VlanTableCreate(context.Context, *VlanTableCreateRequest) (*VlanTableCreateResponse, error)
+	VlanTableUpdate(context.Context, *VlanTableUpdateRequest) (*VlanTableUpdateResponse, error)
 	VlanTableDelete(context.Context, *VlanTableDeleteRequest) (*VlanTableDeleteResponse, error)
 	VlanTableList(context.Context, *VlanTableListRequest) (*VlanTableListResponse, error)
 	VlanTableFlush(context.Context, *VlanTableFlushRequest) (*VlanTableFlushResponse, error)
@@ -1004,6 +1127,9 @@ type UnimplementedVlanTableServiceServer struct {
 func (UnimplementedVlanTableServiceServer) VlanTableCreate(context.Context, *VlanTableCreateRequest) (*VlanTableCreateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VlanTableCreate not implemented")
 }
+func (UnimplementedVlanTableServiceServer) VlanTableUpdate(context.Context, *VlanTableUpdateRequest) (*VlanTableUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VlanTableUpdate not implemented")
+}
 func (UnimplementedVlanTableServiceServer) VlanTableDelete(context.Context, *VlanTableDeleteRequest) (*VlanTableDeleteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VlanTableDelete not implemented")
 }
@@ -1043,6 +1169,24 @@ func _VlanTableService_VlanTableCreate_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VlanTableService_VlanTableUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VlanTableUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VlanTableServiceServer).VlanTableUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VlanTableService_VlanTableUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VlanTableServiceServer).VlanTableUpdate(ctx, req.(*VlanTableUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _VlanTableService_VlanTableDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(VlanTableDeleteRequest)
 	if err := dec(in); err != nil {
@@ -1108,6 +1252,10 @@ var VlanTableService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "VlanTableCreate",
 			Handler:    _VlanTableService_VlanTableCreate_Handler,
 		},
+		{
+			MethodName: "VlanTableUpdate",
+			Handler:    _VlanTableService_VlanTableUpdate_Handler,
+		},
 		{
 			MethodName: "VlanTableDelete",
 			Handler:    _VlanTableService_VlanTableDelete_Handler,
@@ -1125,8 +1273,208 @@ var VlanTableService_ServiceDesc = grpc.ServiceDesc{
 	Metadata: "vinbero/v1/vinbero.proto",
 }
 
+const (
+	SrDomainService_SrDomainCreate_FullMethodName = "/vinbero.v1.SrDomainService/SrDomainCreate"
+	SrDomainService_SrDomainDelete_FullMethodName = "/vinbero.v1.SrDomainService/SrDomainDelete"
+	SrDomainService_SrDomainList_FullMethodName   = "/vinbero.v1.SrDomainService/SrDomainList"
+	SrDomainService_SrDomainFlush_FullMethodName  = "/vinbero.v1.SrDomainService/SrDomainFlush"
+)
+
+// SrDomainServiceClient is the client API for SrDomainService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SrDomainServiceClient interface {
+	SrDomainCreate(ctx context.Context, in *SrDomainCreateRequest, opts ...grpc.CallOption) (*SrDomainCreateResponse, error)
+	SrDomainDelete(ctx context.Context, in *SrDomainDeleteRequest, opts ...grpc.CallOption) (*SrDomainDeleteResponse, error)
+	SrDomainList(ctx context.Context, in *SrDomainListRequest, opts ...grpc.CallOption) (*SrDomainListResponse, error)
+	SrDomainFlush(ctx context.Context, in *SrDomainFlushRequest, opts ...grpc.CallOption) (*SrDomainFlushResponse, error)
+}
+
+type srDomainServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSrDomainServiceClient(cc grpc.ClientConnInterface) SrDomainServiceClient {
+	return &srDomainServiceClient{cc}
+}
+
+func (c *srDomainServiceClient) SrDomainCreate(ctx context.Context, in *SrDomainCreateRequest, opts ...grpc.CallOption) (*SrDomainCreateResponse, error) {
+	out := new(SrDomainCreateResponse)
+	err := c.cc.Invoke(ctx, SrDomainService_SrDomainCreate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *srDomainServiceClient) SrDomainDelete(ctx context.Context, in *SrDomainDeleteRequest, opts ...grpc.CallOption) (*SrDomainDeleteResponse, error) {
+	out := new(SrDomainDeleteResponse)
+	err := c.cc.Invoke(ctx, SrDomainService_SrDomainDelete_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *srDomainServiceClient) SrDomainList(ctx context.Context, in *SrDomainListRequest, opts ...grpc.CallOption) (*SrDomainListResponse, error) {
+	out := new(SrDomainListResponse)
+	err := c.cc.Invoke(ctx, SrDomainService_SrDomainList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *srDomainServiceClient) SrDomainFlush(ctx context.Context, in *SrDomainFlushRequest, opts ...grpc.CallOption) (*SrDomainFlushResponse, error) {
+	out := new(SrDomainFlushResponse)
+	err := c.cc.Invoke(ctx, SrDomainService_SrDomainFlush_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SrDomainServiceServer is the server API for SrDomainService service.
+// All implementations should embed UnimplementedSrDomainServiceServer
+// for forward compatibility
+type SrDomainServiceServer interface {
+	SrDomainCreate(context.Context, *SrDomainCreateRequest) (*SrDomainCreateResponse, error)
+	SrDomainDelete(context.Context, *SrDomainDeleteRequest) (*SrDomainDeleteResponse, error)
+	SrDomainList(context.Context, *SrDomainListRequest) (*SrDomainListResponse, error)
+	SrDomainFlush(context.Context, *SrDomainFlushRequest) (*SrDomainFlushResponse, error)
+}
+
+// UnimplementedSrDomainServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedSrDomainServiceServer struct {
+}
+
+func (UnimplementedSrDomainServiceServer) SrDomainCreate(context.Context, *SrDomainCreateRequest) (*SrDomainCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SrDomainCreate not implemented")
+}
+func (UnimplementedSrDomainServiceServer) SrDomainDelete(context.Context, *SrDomainDeleteRequest) (*SrDomainDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SrDomainDelete not implemented")
+}
+func (UnimplementedSrDomainServiceServer) SrDomainList(context.Context, *SrDomainListRequest) (*SrDomainListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SrDomainList not implemented")
+}
+func (UnimplementedSrDomainServiceServer) SrDomainFlush(context.Context, *SrDomainFlushRequest) (*SrDomainFlushResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SrDomainFlush not implemented")
+}
+
+// UnsafeSrDomainServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SrDomainServiceServer will
+// result in compilation errors.
+type UnsafeSrDomainServiceServer interface {
+	mustEmbedUnimplementedSrDomainServiceServer()
+}
+
+func RegisterSrDomainServiceServer(s grpc.ServiceRegistrar, srv SrDomainServiceServer) {
+	s.RegisterService(&SrDomainService_ServiceDesc, srv)
+}
+
+func _SrDomainService_SrDomainCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SrDomainCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SrDomainServiceServer).SrDomainCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SrDomainService_SrDomainCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SrDomainServiceServer).SrDomainCreate(ctx, req.(*SrDomainCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SrDomainService_SrDomainDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SrDomainDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SrDomainServiceServer).SrDomainDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SrDomainService_SrDomainDelete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SrDomainServiceServer).SrDomainDelete(ctx, req.(*SrDomainDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SrDomainService_SrDomainList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SrDomainListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SrDomainServiceServer).SrDomainList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SrDomainService_SrDomainList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SrDomainServiceServer).SrDomainList(ctx, req.(*SrDomainListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SrDomainService_SrDomainFlush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SrDomainFlushRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SrDomainServiceServer).SrDomainFlush(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SrDomainService_SrDomainFlush_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SrDomainServiceServer).SrDomainFlush(ctx, req.(*SrDomainFlushRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SrDomainService_ServiceDesc is the grpc.ServiceDesc for SrDomainService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SrDomainService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "vinbero.v1.SrDomainService",
+	HandlerType: (*SrDomainServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SrDomainCreate",
+			Handler:    _SrDomainService_SrDomainCreate_Handler,
+		},
+		{
+			MethodName: "SrDomainDelete",
+			Handler:    _SrDomainService_SrDomainDelete_Handler,
+		},
+		{
+			MethodName: "SrDomainList",
+			Handler:    _SrDomainService_SrDomainList_Handler,
+		},
+		{
+			MethodName: "SrDomainFlush",
+			Handler:    _SrDomainService_SrDomainFlush_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "vinbero/v1/vinbero.proto",
+}
+
 const (
 	BdPeerService_BdPeerCreate_FullMethodName = "/vinbero.v1.BdPeerService/BdPeerCreate"
+	BdPeerService_BdPeerUpdate_FullMethodName = "/vinbero.v1.BdPeerService/BdPeerUpdate"
 	BdPeerService_BdPeerDelete_FullMethodName = "/vinbero.v1.BdPeerService/BdPeerDelete"
 	BdPeerService_BdPeerList_FullMethodName   = "/vinbero.v1.BdPeerService/BdPeerList"
 	BdPeerService_BdPeerFlush_FullMethodName  = "/vinbero.v1.BdPeerService/BdPeerFlush"
@@ -1137,6 +1485,7 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type BdPeerServiceClient interface {
 	BdPeerCreate(ctx context.Context, in *BdPeerCreateRequest, opts ...grpc.CallOption) (*BdPeerCreateResponse, error)
+	BdPeerUpdate(ctx context.Context, in *BdPeerUpdateRequest, opts ...grpc.CallOption) (*BdPeerUpdateResponse, error)
 	BdPeerDelete(ctx context.Context, in *BdPeerDeleteRequest, opts ...grpc.CallOption) (*BdPeerDeleteResponse, error)
 	BdPeerList(ctx context.Context, in *BdPeerListRequest, opts ...grpc.CallOption) (*BdPeerListResponse, error)
 	BdPeerFlush(ctx context.Context, in *BdPeerFlushRequest, opts ...grpc.CallOption) (*BdPeerFlushResponse, error)
@@ -1159,6 +1508,15 @@ func (c *bdPeerServiceClient) BdPeerCreate(ctx context.Context, in *BdPeerCreate
 	return out, nil
 }
 
+func (c *bdPeerServiceClient) BdPeerUpdate(ctx context.Context, in *BdPeerUpdateRequest, opts ...grpc.CallOption) (*BdPeerUpdateResponse, error) {
+	out := new(BdPeerUpdateResponse)
+	err := c.cc.Invoke(ctx, BdPeerService_BdPeerUpdate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bdPeerServiceClient) BdPeerDelete(ctx context.Context, in *BdPeerDeleteRequest, opts ...grpc.CallOption) (*BdPeerDeleteResponse, error) {
 	out := new(BdPeerDeleteResponse)
 	err := c.cc.Invoke(ctx, BdPeerService_BdPeerDelete_FullMethodName, in, out, opts...)
@@ -1191,6 +1549,7 @@ func (c *bdPeerServiceClient) BdPeerFlush(ctx context.Context, in *BdPeerFlushRe
 // for forward compatibility
 type BdPeerServiceServer interface {
 	BdPeerCreate(context.Context, *BdPeerCreateRequest) (*BdPeerCreateResponse, error)
+	BdPeerUpdate(context.Context, *BdPeerUpdateRequest) (*BdPeerUpdateResponse, error)
 	BdPeerDelete(context.Context, *BdPeerDeleteRequest) (*BdPeerDeleteResponse, error)
 	BdPeerList(context.Context, *BdPeerListRequest) (*BdPeerListResponse, error)
 	BdPeerFlush(context.Context, *BdPeerFlushRequest) (*BdPeerFlushResponse, error)
@@ -1203,6 +1562,9 @@ type UnimplementedBdPeerServiceServer struct {
 func (UnimplementedBdPeerServiceServer) BdPeerCreate(context.Context, *BdPeerCreateRequest) (*BdPeerCreateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BdPeerCreate not implemented")
 }
+func (UnimplementedBdPeerServiceServer) BdPeerUpdate(context.Context, *BdPeerUpdateRequest) (*BdPeerUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BdPeerUpdate not implemented")
+}
 func (UnimplementedBdPeerServiceServer) BdPeerDelete(context.Context, *BdPeerDeleteRequest) (*BdPeerDeleteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BdPeerDelete not implemented")
 }
@@ -1242,6 +1604,24 @@ func _BdPeerService_BdPeerCreate_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BdPeerService_BdPeerUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BdPeerUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BdPeerServiceServer).BdPeerUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BdPeerService_BdPeerUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BdPeerServiceServer).BdPeerUpdate(ctx, req.(*BdPeerUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BdPeerService_BdPeerDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BdPeerDeleteRequest)
 	if err := dec(in); err != nil {
@@ -1307,6 +1687,10 @@ var BdPeerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BdPeerCreate",
 			Handler:    _BdPeerService_BdPeerCreate_Handler,
 		},
+		{
+			MethodName: "BdPeerUpdate",
+			Handler:    _BdPeerService_BdPeerUpdate_Handler,
+		},
 		{
 			MethodName: "BdPeerDelete",
 			Handler:    _BdPeerService_BdPeerDelete_Handler,
@@ -1325,235 +1709,198 @@ var BdPeerService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	EthernetSegmentService_EsCreate_FullMethodName  = "/vinbero.v1.EthernetSegmentService/EsCreate"
-	EthernetSegmentService_EsDelete_FullMethodName  = "/vinbero.v1.EthernetSegmentService/EsDelete"
-	EthernetSegmentService_EsList_FullMethodName    = "/vinbero.v1.EthernetSegmentService/EsList"
-	EthernetSegmentService_EsSetDf_FullMethodName   = "/vinbero.v1.EthernetSegmentService/EsSetDf"
-	EthernetSegmentService_EsClearDf_FullMethodName = "/vinbero.v1.EthernetSegmentService/EsClearDf"
+	VniMappingService_VniMappingCreate_FullMethodName = "/vinbero.v1.VniMappingService/VniMappingCreate"
+	VniMappingService_VniMappingDelete_FullMethodName = "/vinbero.v1.VniMappingService/VniMappingDelete"
+	VniMappingService_VniMappingList_FullMethodName   = "/vinbero.v1.VniMappingService/VniMappingList"
+	VniMappingService_VniMappingFlush_FullMethodName  = "/vinbero.v1.VniMappingService/VniMappingFlush"
 )
 
-// EthernetSegmentServiceClient is the client API for EthernetSegmentService service.
+// VniMappingServiceClient is the client API for VniMappingService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-type EthernetSegmentServiceClient interface {
-	EsCreate(ctx context.Context, in *EsCreateRequest, opts ...grpc.CallOption) (*EsCreateResponse, error)
-	EsDelete(ctx context.Context, in *EsDeleteRequest, opts ...grpc.CallOption) (*EsDeleteResponse, error)
-	EsList(ctx context.Context, in *EsListRequest, opts ...grpc.CallOption) (*EsListResponse, error)
-	EsSetDf(ctx context.Context, in *EsSetDfRequest, opts ...grpc.CallOption) (*EsSetDfResponse, error)
-	EsClearDf(ctx context.Context, in *EsClearDfRequest, opts ...grpc.CallOption) (*EsClearDfResponse, error)
+type VniMappingServiceClient interface {
+	VniMappingCreate(ctx context.Context, in *VniMappingCreateRequest, opts ...grpc.CallOption) (*VniMappingCreateResponse, error)
+	VniMappingDelete(ctx context.Context, in *VniMappingDeleteRequest, opts ...grpc.CallOption) (*VniMappingDeleteResponse, error)
+	VniMappingList(ctx context.Context, in *VniMappingListRequest, opts ...grpc.CallOption) (*VniMappingListResponse, error)
+	VniMappingFlush(ctx context.Context, in *VniMappingFlushRequest, opts ...grpc.CallOption) (*VniMappingFlushResponse, error)
 }
 
-type ethernetSegmentServiceClient struct {
+type vniMappingServiceClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewEthernetSegmentServiceClient(cc grpc.ClientConnInterface) EthernetSegmentServiceClient {
-	return &ethernetSegmentServiceClient{cc}
+func NewVniMappingServiceClient(cc grpc.ClientConnInterface) VniMappingServiceClient {
+	return &vniMappingServiceClient{cc}
 }
 
-func (c *ethernetSegmentServiceClient) EsCreate(ctx context.Context, in *EsCreateRequest, opts ...grpc.CallOption) (*EsCreateResponse, error) {
-	out := new(EsCreateResponse)
-	err := c.cc.Invoke(ctx, EthernetSegmentService_EsCreate_FullMethodName, in, out, opts...)
+func (c *vniMappingServiceClient) VniMappingCreate(ctx context.Context, in *VniMappingCreateRequest, opts ...grpc.CallOption) (*VniMappingCreateResponse, error) {
+	out := new(VniMappingCreateResponse)
+	err := c.cc.Invoke(ctx, VniMappingService_VniMappingCreate_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *ethernetSegmentServiceClient) EsDelete(ctx context.Context, in *EsDeleteRequest, opts ...grpc.CallOption) (*EsDeleteResponse, error) {
-	out := new(EsDeleteResponse)
-	err := c.cc.Invoke(ctx, EthernetSegmentService_EsDelete_FullMethodName, in, out, opts...)
+func (c *vniMappingServiceClient) VniMappingDelete(ctx context.Context, in *VniMappingDeleteRequest, opts ...grpc.CallOption) (*VniMappingDeleteResponse, error) {
+	out := new(VniMappingDeleteResponse)
+	err := c.cc.Invoke(ctx, VniMappingService_VniMappingDelete_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *ethernetSegmentServiceClient) EsList(ctx context.Context, in *EsListRequest, opts ...grpc.CallOption) (*EsListResponse, error) {
-	out := new(EsListResponse)
-	err := c.cc.Invoke(ctx, EthernetSegmentService_EsList_FullMethodName, in, out, opts...)
+func (c *vniMappingServiceClient) VniMappingList(ctx context.Context, in *VniMappingListRequest, opts ...grpc.CallOption) (*VniMappingListResponse, error) {
+	out := new(VniMappingListResponse)
+	err := c.cc.Invoke(ctx, VniMappingService_VniMappingList_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *ethernetSegmentServiceClient) EsSetDf(ctx context.Context, in *EsSetDfRequest, opts ...grpc.CallOption) (*EsSetDfResponse, error) {
-	out := new(EsSetDfResponse)
-	err := c.cc.Invoke(ctx, EthernetSegmentService_EsSetDf_FullMethodName, in, out, opts...)
+func (c *vniMappingServiceClient) VniMappingFlush(ctx context.Context, in *VniMappingFlushRequest, opts ...grpc.CallOption) (*VniMappingFlushResponse, error) {
+	out := new(VniMappingFlushResponse)
+	err := c.cc.Invoke(ctx, VniMappingService_VniMappingFlush_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *ethernetSegmentServiceClient) EsClearDf(ctx context.Context, in *EsClearDfRequest, opts ...grpc.CallOption) (*EsClearDfResponse, error) {
-	out := new(EsClearDfResponse)
-	err := c.cc.Invoke(ctx, EthernetSegmentService_EsClearDf_FullMethodName, in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-// EthernetSegmentServiceServer is the server API for EthernetSegmentService service.
-// All implementations should embed UnimplementedEthernetSegmentServiceServer
+// VniMappingServiceServer is the server API for VniMappingService service.
+// All implementations should embed UnimplementedVniMappingServiceServer
 // for forward compatibility
-type EthernetSegmentServiceServer interface {
-	EsCreate(context.Context, *EsCreateRequest) (*EsCreateResponse, error)
-	EsDelete(context.Context, *EsDeleteRequest) (*EsDeleteResponse, error)
-	EsList(context.Context, *EsListRequest) (*EsListResponse, error)
-	EsSetDf(context.Context, *EsSetDfRequest) (*EsSetDfResponse, error)
-	EsClearDf(context.Context, *EsClearDfRequest) (*EsClearDfResponse, error)
+type VniMappingServiceServer interface {
+	VniMappingCreate(context.Context, *VniMappingCreateRequest) (*VniMappingCreateResponse, error)
+	VniMappingDelete(context.Context, *VniMappingDeleteRequest) (*VniMappingDeleteResponse, error)
+	VniMappingList(context.Context, *VniMappingListRequest) (*VniMappingListResponse, error)
+	VniMappingFlush(context.Context, *VniMappingFlushRequest) (*VniMappingFlushResponse, error)
 }
 
-// UnimplementedEthernetSegmentServiceServer should be embedded to have forward compatible implementations.
-type UnimplementedEthernetSegmentServiceServer struct {
+// UnimplementedVniMappingServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedVniMappingServiceServer struct {
 }
 
-func (UnimplementedEthernetSegmentServiceServer) EsCreate(context.Context, *EsCreateRequest) (*EsCreateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EsCreate not implemented")
-}
-func (UnimplementedEthernetSegmentServiceServer) EsDelete(context.Context, *EsDeleteRequest) (*EsDeleteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EsDelete not implemented")
+func (UnimplementedVniMappingServiceServer) VniMappingCreate(context.Context, *VniMappingCreateRequest) (*VniMappingCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VniMappingCreate not implemented")
 }
-func (UnimplementedEthernetSegmentServiceServer) EsList(context.Context, *EsListRequest) (*EsListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EsList not implemented")
+func (UnimplementedVniMappingServiceServer) VniMappingDelete(context.Context, *VniMappingDeleteRequest) (*VniMappingDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VniMappingDelete not implemented")
 }
-func (UnimplementedEthernetSegmentServiceServer) EsSetDf(context.Context, *EsSetDfRequest) (*EsSetDfResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EsSetDf not implemented")
+func (UnimplementedVniMappingServiceServer) VniMappingList(context.Context, *VniMappingListRequest) (*VniMappingListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VniMappingList not implemented")
 }
-func (UnimplementedEthernetSegmentServiceServer) EsClearDf(context.Context, *EsClearDfRequest) (*EsClearDfResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EsClearDf not implemented")
+func (UnimplementedVniMappingServiceServer) VniMappingFlush(context.Context, *VniMappingFlushRequest) (*VniMappingFlushResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VniMappingFlush not implemented")
 }
 
-// UnsafeEthernetSegmentServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to EthernetSegmentServiceServer will
+// UnsafeVniMappingServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to VniMappingServiceServer will
 // result in compilation errors.
-type UnsafeEthernetSegmentServiceServer interface {
-	mustEmbedUnimplementedEthernetSegmentServiceServer()
-}
-
-func RegisterEthernetSegmentServiceServer(s grpc.ServiceRegistrar, srv EthernetSegmentServiceServer) {
-	s.RegisterService(&EthernetSegmentService_ServiceDesc, srv)
+type UnsafeVniMappingServiceServer interface {
+	mustEmbedUnimplementedVniMappingServiceServer()
 }
 
-func _EthernetSegmentService_EsCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EsCreateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(EthernetSegmentServiceServer).EsCreate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: EthernetSegmentService_EsCreate_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EthernetSegmentServiceServer).EsCreate(ctx, req.(*EsCreateRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func RegisterVniMappingServiceServer(s grpc.ServiceRegistrar, srv VniMappingServiceServer) {
+	s.RegisterService(&VniMappingService_ServiceDesc, srv)
 }
 
-func _EthernetSegmentService_EsDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EsDeleteRequest)
+func _VniMappingService_VniMappingCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VniMappingCreateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EthernetSegmentServiceServer).EsDelete(ctx, in)
+		return srv.(VniMappingServiceServer).VniMappingCreate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EthernetSegmentService_EsDelete_FullMethodName,
+		FullMethod: VniMappingService_VniMappingCreate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EthernetSegmentServiceServer).EsDelete(ctx, req.(*EsDeleteRequest))
+		return srv.(VniMappingServiceServer).VniMappingCreate(ctx, req.(*VniMappingCreateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EthernetSegmentService_EsList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EsListRequest)
+func _VniMappingService_VniMappingDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VniMappingDeleteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EthernetSegmentServiceServer).EsList(ctx, in)
+		return srv.(VniMappingServiceServer).VniMappingDelete(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EthernetSegmentService_EsList_FullMethodName,
+		FullMethod: VniMappingService_VniMappingDelete_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EthernetSegmentServiceServer).EsList(ctx, req.(*EsListRequest))
+		return srv.(VniMappingServiceServer).VniMappingDelete(ctx, req.(*VniMappingDeleteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EthernetSegmentService_EsSetDf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EsSetDfRequest)
+func _VniMappingService_VniMappingList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VniMappingListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EthernetSegmentServiceServer).EsSetDf(ctx, in)
+		return srv.(VniMappingServiceServer).VniMappingList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EthernetSegmentService_EsSetDf_FullMethodName,
+		FullMethod: VniMappingService_VniMappingList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EthernetSegmentServiceServer).EsSetDf(ctx, req.(*EsSetDfRequest))
+		return srv.(VniMappingServiceServer).VniMappingList(ctx, req.(*VniMappingListRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EthernetSegmentService_EsClearDf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EsClearDfRequest)
+func _VniMappingService_VniMappingFlush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VniMappingFlushRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EthernetSegmentServiceServer).EsClearDf(ctx, in)
+		return srv.(VniMappingServiceServer).VniMappingFlush(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EthernetSegmentService_EsClearDf_FullMethodName,
+		FullMethod: VniMappingService_VniMappingFlush_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EthernetSegmentServiceServer).EsClearDf(ctx, req.(*EsClearDfRequest))
+		return srv.(VniMappingServiceServer).VniMappingFlush(ctx, req.(*VniMappingFlushRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// EthernetSegmentService_ServiceDesc is the grpc.ServiceDesc for EthernetSegmentService service.
+// VniMappingService_ServiceDesc is the grpc.ServiceDesc for VniMappingService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var EthernetSegmentService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "vinbero.v1.EthernetSegmentService",
-	HandlerType: (*EthernetSegmentServiceServer)(nil),
+var VniMappingService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "vinbero.v1.VniMappingService",
+	HandlerType: (*VniMappingServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "EsCreate",
-			Handler:    _EthernetSegmentService_EsCreate_Handler,
-		},
-		{
-			MethodName: "EsDelete",
-			Handler:    _EthernetSegmentService_EsDelete_Handler,
+			MethodName: "VniMappingCreate",
+			Handler:    _VniMappingService_VniMappingCreate_Handler,
 		},
 		{
-			MethodName: "EsList",
-			Handler:    _EthernetSegmentService_EsList_Handler,
+			MethodName: "VniMappingDelete",
+			Handler:    _VniMappingService_VniMappingDelete_Handler,
 		},
 		{
-			MethodName: "EsSetDf",
-			Handler:    _EthernetSegmentService_EsSetDf_Handler,
+			MethodName: "VniMappingList",
+			Handler:    _VniMappingService_VniMappingList_Handler,
 		},
 		{
-			MethodName: "EsClearDf",
-			Handler:    _EthernetSegmentService_EsClearDf_Handler,
+			MethodName: "VniMappingFlush",
+			Handler:    _VniMappingService_VniMappingFlush_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -1561,272 +1908,642 @@ var EthernetSegmentService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	NetworkResourceService_VrfCreate_FullMethodName    = "/vinbero.v1.NetworkResourceService/VrfCreate"
-	NetworkResourceService_VrfDelete_FullMethodName    = "/vinbero.v1.NetworkResourceService/VrfDelete"
-	NetworkResourceService_VrfList_FullMethodName      = "/vinbero.v1.NetworkResourceService/VrfList"
-	NetworkResourceService_BridgeCreate_FullMethodName = "/vinbero.v1.NetworkResourceService/BridgeCreate"
-	NetworkResourceService_BridgeDelete_FullMethodName = "/vinbero.v1.NetworkResourceService/BridgeDelete"
-	NetworkResourceService_BridgeList_FullMethodName   = "/vinbero.v1.NetworkResourceService/BridgeList"
+	BridgeDomainService_BridgeDomainCreate_FullMethodName = "/vinbero.v1.BridgeDomainService/BridgeDomainCreate"
+	BridgeDomainService_BridgeDomainDelete_FullMethodName = "/vinbero.v1.BridgeDomainService/BridgeDomainDelete"
+	BridgeDomainService_BridgeDomainGet_FullMethodName    = "/vinbero.v1.BridgeDomainService/BridgeDomainGet"
+	BridgeDomainService_BridgeDomainList_FullMethodName   = "/vinbero.v1.BridgeDomainService/BridgeDomainList"
+	BridgeDomainService_MacLimitSet_FullMethodName        = "/vinbero.v1.BridgeDomainService/MacLimitSet"
+	BridgeDomainService_MacLimitDelete_FullMethodName     = "/vinbero.v1.BridgeDomainService/MacLimitDelete"
+	BridgeDomainService_MacLimitList_FullMethodName       = "/vinbero.v1.BridgeDomainService/MacLimitList"
+	BridgeDomainService_StormControlSet_FullMethodName    = "/vinbero.v1.BridgeDomainService/StormControlSet"
+	BridgeDomainService_StormControlDelete_FullMethodName = "/vinbero.v1.BridgeDomainService/StormControlDelete"
+	BridgeDomainService_StormControlList_FullMethodName   = "/vinbero.v1.BridgeDomainService/StormControlList"
+	BridgeDomainService_NeighborCreate_FullMethodName     = "/vinbero.v1.BridgeDomainService/NeighborCreate"
+	BridgeDomainService_NeighborDelete_FullMethodName     = "/vinbero.v1.BridgeDomainService/NeighborDelete"
+	BridgeDomainService_NeighborList_FullMethodName       = "/vinbero.v1.BridgeDomainService/NeighborList"
+	BridgeDomainService_IrbRouteCreate_FullMethodName     = "/vinbero.v1.BridgeDomainService/IrbRouteCreate"
+	BridgeDomainService_IrbRouteDelete_FullMethodName     = "/vinbero.v1.BridgeDomainService/IrbRouteDelete"
+	BridgeDomainService_IrbRouteList_FullMethodName       = "/vinbero.v1.BridgeDomainService/IrbRouteList"
 )
 
-// NetworkResourceServiceClient is the client API for NetworkResourceService service.
+// BridgeDomainServiceClient is the client API for BridgeDomainService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-type NetworkResourceServiceClient interface {
-	VrfCreate(ctx context.Context, in *VrfCreateRequest, opts ...grpc.CallOption) (*VrfCreateResponse, error)
-	VrfDelete(ctx context.Context, in *VrfDeleteRequest, opts ...grpc.CallOption) (*VrfDeleteResponse, error)
-	VrfList(ctx context.Context, in *VrfListRequest, opts ...grpc.CallOption) (*VrfListResponse, error)
-	BridgeCreate(ctx context.Context, in *BridgeCreateRequest, opts ...grpc.CallOption) (*BridgeCreateResponse, error)
-	BridgeDelete(ctx context.Context, in *BridgeDeleteRequest, opts ...grpc.CallOption) (*BridgeDeleteResponse, error)
-	BridgeList(ctx context.Context, in *BridgeListRequest, opts ...grpc.CallOption) (*BridgeListResponse, error)
+type BridgeDomainServiceClient interface {
+	BridgeDomainCreate(ctx context.Context, in *BridgeDomainCreateRequest, opts ...grpc.CallOption) (*BridgeDomainCreateResponse, error)
+	BridgeDomainDelete(ctx context.Context, in *BridgeDomainDeleteRequest, opts ...grpc.CallOption) (*BridgeDomainDeleteResponse, error)
+	BridgeDomainGet(ctx context.Context, in *BridgeDomainGetRequest, opts ...grpc.CallOption) (*BridgeDomainGetResponse, error)
+	BridgeDomainList(ctx context.Context, in *BridgeDomainListRequest, opts ...grpc.CallOption) (*BridgeDomainListResponse, error)
+	MacLimitSet(ctx context.Context, in *MacLimitSetRequest, opts ...grpc.CallOption) (*MacLimitSetResponse, error)
+	MacLimitDelete(ctx context.Context, in *MacLimitDeleteRequest, opts ...grpc.CallOption) (*MacLimitDeleteResponse, error)
+	MacLimitList(ctx context.Context, in *MacLimitListRequest, opts ...grpc.CallOption) (*MacLimitListResponse, error)
+	StormControlSet(ctx context.Context, in *StormControlSetRequest, opts ...grpc.CallOption) (*StormControlSetResponse, error)
+	StormControlDelete(ctx context.Context, in *StormControlDeleteRequest, opts ...grpc.CallOption) (*StormControlDeleteResponse, error)
+	StormControlList(ctx context.Context, in *StormControlListRequest, opts ...grpc.CallOption) (*StormControlListResponse, error)
+	NeighborCreate(ctx context.Context, in *NeighborCreateRequest, opts ...grpc.CallOption) (*NeighborCreateResponse, error)
+	NeighborDelete(ctx context.Context, in *NeighborDeleteRequest, opts ...grpc.CallOption) (*NeighborDeleteResponse, error)
+	NeighborList(ctx context.Context, in *NeighborListRequest, opts ...grpc.CallOption) (*NeighborListResponse, error)
+	IrbRouteCreate(ctx context.Context, in *IrbRouteCreateRequest, opts ...grpc.CallOption) (*IrbRouteCreateResponse, error)
+	IrbRouteDelete(ctx context.Context, in *IrbRouteDeleteRequest, opts ...grpc.CallOption) (*IrbRouteDeleteResponse, error)
+	IrbRouteList(ctx context.Context, in *IrbRouteListRequest, opts ...grpc.CallOption) (*IrbRouteListResponse, error)
+}
+
+type bridgeDomainServiceClient struct {
+	cc grpc.ClientConnInterface
 }
 
-type networkResourceServiceClient struct {
-	cc grpc.ClientConnInterface
+func NewBridgeDomainServiceClient(cc grpc.ClientConnInterface) BridgeDomainServiceClient {
+	return &bridgeDomainServiceClient{cc}
 }
 
-func NewNetworkResourceServiceClient(cc grpc.ClientConnInterface) NetworkResourceServiceClient {
-	return &networkResourceServiceClient{cc}
+func (c *bridgeDomainServiceClient) BridgeDomainCreate(ctx context.Context, in *BridgeDomainCreateRequest, opts ...grpc.CallOption) (*BridgeDomainCreateResponse, error) {
+	out := new(BridgeDomainCreateResponse)
+	err := c.cc.Invoke(ctx, BridgeDomainService_BridgeDomainCreate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (c *networkResourceServiceClient) VrfCreate(ctx context.Context, in *VrfCreateRequest, opts ...grpc.CallOption) (*VrfCreateResponse, error) {
-	out := new(VrfCreateResponse)
-	err := c.cc.Invoke(ctx, NetworkResourceService_VrfCreate_FullMethodName, in, out, opts...)
+func (c *bridgeDomainServiceClient) BridgeDomainDelete(ctx context.Context, in *BridgeDomainDeleteRequest, opts ...grpc.CallOption) (*BridgeDomainDeleteResponse, error) {
+	out := new(BridgeDomainDeleteResponse)
+	err := c.cc.Invoke(ctx, BridgeDomainService_BridgeDomainDelete_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *networkResourceServiceClient) VrfDelete(ctx context.Context, in *VrfDeleteRequest, opts ...grpc.CallOption) (*VrfDeleteResponse, error) {
-	out := new(VrfDeleteResponse)
-	err := c.cc.Invoke(ctx, NetworkResourceService_VrfDelete_FullMethodName, in, out, opts...)
+func (c *bridgeDomainServiceClient) BridgeDomainGet(ctx context.Context, in *BridgeDomainGetRequest, opts ...grpc.CallOption) (*BridgeDomainGetResponse, error) {
+	out := new(BridgeDomainGetResponse)
+	err := c.cc.Invoke(ctx, BridgeDomainService_BridgeDomainGet_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *networkResourceServiceClient) VrfList(ctx context.Context, in *VrfListRequest, opts ...grpc.CallOption) (*VrfListResponse, error) {
-	out := new(VrfListResponse)
-	err := c.cc.Invoke(ctx, NetworkResourceService_VrfList_FullMethodName, in, out, opts...)
+func (c *bridgeDomainServiceClient) BridgeDomainList(ctx context.Context, in *BridgeDomainListRequest, opts ...grpc.CallOption) (*BridgeDomainListResponse, error) {
+	out := new(BridgeDomainListResponse)
+	err := c.cc.Invoke(ctx, BridgeDomainService_BridgeDomainList_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *networkResourceServiceClient) BridgeCreate(ctx context.Context, in *BridgeCreateRequest, opts ...grpc.CallOption) (*BridgeCreateResponse, error) {
-	out := new(BridgeCreateResponse)
-	err := c.cc.Invoke(ctx, NetworkResourceService_BridgeCreate_FullMethodName, in, out, opts...)
+func (c *bridgeDomainServiceClient) MacLimitSet(ctx context.Context, in *MacLimitSetRequest, opts ...grpc.CallOption) (*MacLimitSetResponse, error) {
+	out := new(MacLimitSetResponse)
+	err := c.cc.Invoke(ctx, BridgeDomainService_MacLimitSet_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *networkResourceServiceClient) BridgeDelete(ctx context.Context, in *BridgeDeleteRequest, opts ...grpc.CallOption) (*BridgeDeleteResponse, error) {
-	out := new(BridgeDeleteResponse)
-	err := c.cc.Invoke(ctx, NetworkResourceService_BridgeDelete_FullMethodName, in, out, opts...)
+func (c *bridgeDomainServiceClient) MacLimitDelete(ctx context.Context, in *MacLimitDeleteRequest, opts ...grpc.CallOption) (*MacLimitDeleteResponse, error) {
+	out := new(MacLimitDeleteResponse)
+	err := c.cc.Invoke(ctx, BridgeDomainService_MacLimitDelete_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *networkResourceServiceClient) BridgeList(ctx context.Context, in *BridgeListRequest, opts ...grpc.CallOption) (*BridgeListResponse, error) {
-	out := new(BridgeListResponse)
-	err := c.cc.Invoke(ctx, NetworkResourceService_BridgeList_FullMethodName, in, out, opts...)
+func (c *bridgeDomainServiceClient) MacLimitList(ctx context.Context, in *MacLimitListRequest, opts ...grpc.CallOption) (*MacLimitListResponse, error) {
+	out := new(MacLimitListResponse)
+	err := c.cc.Invoke(ctx, BridgeDomainService_MacLimitList_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// NetworkResourceServiceServer is the server API for NetworkResourceService service.
-// All implementations should embed UnimplementedNetworkResourceServiceServer
+func (c *bridgeDomainServiceClient) StormControlSet(ctx context.Context, in *StormControlSetRequest, opts ...grpc.CallOption) (*StormControlSetResponse, error) {
+	out := new(StormControlSetResponse)
+	err := c.cc.Invoke(ctx, BridgeDomainService_StormControlSet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeDomainServiceClient) StormControlDelete(ctx context.Context, in *StormControlDeleteRequest, opts ...grpc.CallOption) (*StormControlDeleteResponse, error) {
+	out := new(StormControlDeleteResponse)
+	err := c.cc.Invoke(ctx, BridgeDomainService_StormControlDelete_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeDomainServiceClient) StormControlList(ctx context.Context, in *StormControlListRequest, opts ...grpc.CallOption) (*StormControlListResponse, error) {
+	out := new(StormControlListResponse)
+	err := c.cc.Invoke(ctx, BridgeDomainService_StormControlList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeDomainServiceClient) NeighborCreate(ctx context.Context, in *NeighborCreateRequest, opts ...grpc.CallOption) (*NeighborCreateResponse, error) {
+	out := new(NeighborCreateResponse)
+	err := c.cc.Invoke(ctx, BridgeDomainService_NeighborCreate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeDomainServiceClient) NeighborDelete(ctx context.Context, in *NeighborDeleteRequest, opts ...grpc.CallOption) (*NeighborDeleteResponse, error) {
+	out := new(NeighborDeleteResponse)
+	err := c.cc.Invoke(ctx, BridgeDomainService_NeighborDelete_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeDomainServiceClient) NeighborList(ctx context.Context, in *NeighborListRequest, opts ...grpc.CallOption) (*NeighborListResponse, error) {
+	out := new(NeighborListResponse)
+	err := c.cc.Invoke(ctx, BridgeDomainService_NeighborList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeDomainServiceClient) IrbRouteCreate(ctx context.Context, in *IrbRouteCreateRequest, opts ...grpc.CallOption) (*IrbRouteCreateResponse, error) {
+	out := new(IrbRouteCreateResponse)
+	err := c.cc.Invoke(ctx, BridgeDomainService_IrbRouteCreate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeDomainServiceClient) IrbRouteDelete(ctx context.Context, in *IrbRouteDeleteRequest, opts ...grpc.CallOption) (*IrbRouteDeleteResponse, error) {
+	out := new(IrbRouteDeleteResponse)
+	err := c.cc.Invoke(ctx, BridgeDomainService_IrbRouteDelete_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeDomainServiceClient) IrbRouteList(ctx context.Context, in *IrbRouteListRequest, opts ...grpc.CallOption) (*IrbRouteListResponse, error) {
+	out := new(IrbRouteListResponse)
+	err := c.cc.Invoke(ctx, BridgeDomainService_IrbRouteList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BridgeDomainServiceServer is the server API for BridgeDomainService service.
+// All implementations should embed UnimplementedBridgeDomainServiceServer
 // for forward compatibility
-type NetworkResourceServiceServer interface {
-	VrfCreate(context.Context, *VrfCreateRequest) (*VrfCreateResponse, error)
-	VrfDelete(context.Context, *VrfDeleteRequest) (*VrfDeleteResponse, error)
-	VrfList(context.Context, *VrfListRequest) (*VrfListResponse, error)
-	BridgeCreate(context.Context, *BridgeCreateRequest) (*BridgeCreateResponse, error)
-	BridgeDelete(context.Context, *BridgeDeleteRequest) (*BridgeDeleteResponse, error)
-	BridgeList(context.Context, *BridgeListRequest) (*BridgeListResponse, error)
+type BridgeDomainServiceServer interface {
+	BridgeDomainCreate(context.Context, *BridgeDomainCreateRequest) (*BridgeDomainCreateResponse, error)
+	BridgeDomainDelete(context.Context, *BridgeDomainDeleteRequest) (*BridgeDomainDeleteResponse, error)
+	BridgeDomainGet(context.Context, *BridgeDomainGetRequest) (*BridgeDomainGetResponse, error)
+	BridgeDomainList(context.Context, *BridgeDomainListRequest) (*BridgeDomainListResponse, error)
+	MacLimitSet(context.Context, *MacLimitSetRequest) (*MacLimitSetResponse, error)
+	MacLimitDelete(context.Context, *MacLimitDeleteRequest) (*MacLimitDeleteResponse, error)
+	MacLimitList(context.Context, *MacLimitListRequest) (*MacLimitListResponse, error)
+	StormControlSet(context.Context, *StormControlSetRequest) (*StormControlSetResponse, error)
+	StormControlDelete(context.Context, *StormControlDeleteRequest) (*StormControlDeleteResponse, error)
+	StormControlList(context.Context, *StormControlListRequest) (*StormControlListResponse, error)
+	NeighborCreate(context.Context, *NeighborCreateRequest) (*NeighborCreateResponse, error)
+	NeighborDelete(context.Context, *NeighborDeleteRequest) (*NeighborDeleteResponse, error)
+	NeighborList(context.Context, *NeighborListRequest) (*NeighborListResponse, error)
+	IrbRouteCreate(context.Context, *IrbRouteCreateRequest) (*IrbRouteCreateResponse, error)
+	IrbRouteDelete(context.Context, *IrbRouteDeleteRequest) (*IrbRouteDeleteResponse, error)
+	IrbRouteList(context.Context, *IrbRouteListRequest) (*IrbRouteListResponse, error)
 }
 
-// UnimplementedNetworkResourceServiceServer should be embedded to have forward compatible implementations.
-type UnimplementedNetworkResourceServiceServer struct {
+// UnimplementedBridgeDomainServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedBridgeDomainServiceServer struct {
 }
 
-func (UnimplementedNetworkResourceServiceServer) VrfCreate(context.Context, *VrfCreateRequest) (*VrfCreateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method VrfCreate not implemented")
+func (UnimplementedBridgeDomainServiceServer) BridgeDomainCreate(context.Context, *BridgeDomainCreateRequest) (*BridgeDomainCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgeDomainCreate not implemented")
 }
-func (UnimplementedNetworkResourceServiceServer) VrfDelete(context.Context, *VrfDeleteRequest) (*VrfDeleteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method VrfDelete not implemented")
+func (UnimplementedBridgeDomainServiceServer) BridgeDomainDelete(context.Context, *BridgeDomainDeleteRequest) (*BridgeDomainDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgeDomainDelete not implemented")
 }
-func (UnimplementedNetworkResourceServiceServer) VrfList(context.Context, *VrfListRequest) (*VrfListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method VrfList not implemented")
+func (UnimplementedBridgeDomainServiceServer) BridgeDomainGet(context.Context, *BridgeDomainGetRequest) (*BridgeDomainGetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgeDomainGet not implemented")
 }
-func (UnimplementedNetworkResourceServiceServer) BridgeCreate(context.Context, *BridgeCreateRequest) (*BridgeCreateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BridgeCreate not implemented")
+func (UnimplementedBridgeDomainServiceServer) BridgeDomainList(context.Context, *BridgeDomainListRequest) (*BridgeDomainListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgeDomainList not implemented")
 }
-func (UnimplementedNetworkResourceServiceServer) BridgeDelete(context.Context, *BridgeDeleteRequest) (*BridgeDeleteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BridgeDelete not implemented")
+func (UnimplementedBridgeDomainServiceServer) MacLimitSet(context.Context, *MacLimitSetRequest) (*MacLimitSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MacLimitSet not implemented")
 }
-func (UnimplementedNetworkResourceServiceServer) BridgeList(context.Context, *BridgeListRequest) (*BridgeListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BridgeList not implemented")
+func (UnimplementedBridgeDomainServiceServer) MacLimitDelete(context.Context, *MacLimitDeleteRequest) (*MacLimitDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MacLimitDelete not implemented")
+}
+func (UnimplementedBridgeDomainServiceServer) MacLimitList(context.Context, *MacLimitListRequest) (*MacLimitListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MacLimitList not implemented")
+}
+func (UnimplementedBridgeDomainServiceServer) StormControlSet(context.Context, *StormControlSetRequest) (*StormControlSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StormControlSet not implemented")
+}
+func (UnimplementedBridgeDomainServiceServer) StormControlDelete(context.Context, *StormControlDeleteRequest) (*StormControlDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StormControlDelete not implemented")
+}
+func (UnimplementedBridgeDomainServiceServer) StormControlList(context.Context, *StormControlListRequest) (*StormControlListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StormControlList not implemented")
+}
+func (UnimplementedBridgeDomainServiceServer) NeighborCreate(context.Context, *NeighborCreateRequest) (*NeighborCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NeighborCreate not implemented")
+}
+func (UnimplementedBridgeDomainServiceServer) NeighborDelete(context.Context, *NeighborDeleteRequest) (*NeighborDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NeighborDelete not implemented")
+}
+func (UnimplementedBridgeDomainServiceServer) NeighborList(context.Context, *NeighborListRequest) (*NeighborListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NeighborList not implemented")
+}
+func (UnimplementedBridgeDomainServiceServer) IrbRouteCreate(context.Context, *IrbRouteCreateRequest) (*IrbRouteCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IrbRouteCreate not implemented")
+}
+func (UnimplementedBridgeDomainServiceServer) IrbRouteDelete(context.Context, *IrbRouteDeleteRequest) (*IrbRouteDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IrbRouteDelete not implemented")
+}
+func (UnimplementedBridgeDomainServiceServer) IrbRouteList(context.Context, *IrbRouteListRequest) (*IrbRouteListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IrbRouteList not implemented")
 }
 
-// UnsafeNetworkResourceServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to NetworkResourceServiceServer will
+// UnsafeBridgeDomainServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BridgeDomainServiceServer will
 // result in compilation errors.
-type UnsafeNetworkResourceServiceServer interface {
-	mustEmbedUnimplementedNetworkResourceServiceServer()
+type UnsafeBridgeDomainServiceServer interface {
+	mustEmbedUnimplementedBridgeDomainServiceServer()
 }
 
-func RegisterNetworkResourceServiceServer(s grpc.ServiceRegistrar, srv NetworkResourceServiceServer) {
-	s.RegisterService(&NetworkResourceService_ServiceDesc, srv)
+func RegisterBridgeDomainServiceServer(s grpc.ServiceRegistrar, srv BridgeDomainServiceServer) {
+	s.RegisterService(&BridgeDomainService_ServiceDesc, srv)
 }
 
-func _NetworkResourceService_VrfCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(VrfCreateRequest)
+func _BridgeDomainService_BridgeDomainCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BridgeDomainCreateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NetworkResourceServiceServer).VrfCreate(ctx, in)
+		return srv.(BridgeDomainServiceServer).BridgeDomainCreate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: NetworkResourceService_VrfCreate_FullMethodName,
+		FullMethod: BridgeDomainService_BridgeDomainCreate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NetworkResourceServiceServer).VrfCreate(ctx, req.(*VrfCreateRequest))
+		return srv.(BridgeDomainServiceServer).BridgeDomainCreate(ctx, req.(*BridgeDomainCreateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _NetworkResourceService_VrfDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(VrfDeleteRequest)
+func _BridgeDomainService_BridgeDomainDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BridgeDomainDeleteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NetworkResourceServiceServer).VrfDelete(ctx, in)
+		return srv.(BridgeDomainServiceServer).BridgeDomainDelete(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: NetworkResourceService_VrfDelete_FullMethodName,
+		FullMethod: BridgeDomainService_BridgeDomainDelete_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NetworkResourceServiceServer).VrfDelete(ctx, req.(*VrfDeleteRequest))
+		return srv.(BridgeDomainServiceServer).BridgeDomainDelete(ctx, req.(*BridgeDomainDeleteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _NetworkResourceService_VrfList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(VrfListRequest)
+func _BridgeDomainService_BridgeDomainGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BridgeDomainGetRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NetworkResourceServiceServer).VrfList(ctx, in)
+		return srv.(BridgeDomainServiceServer).BridgeDomainGet(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: NetworkResourceService_VrfList_FullMethodName,
+		FullMethod: BridgeDomainService_BridgeDomainGet_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NetworkResourceServiceServer).VrfList(ctx, req.(*VrfListRequest))
+		return srv.(BridgeDomainServiceServer).BridgeDomainGet(ctx, req.(*BridgeDomainGetRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _NetworkResourceService_BridgeCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BridgeCreateRequest)
+func _BridgeDomainService_BridgeDomainList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BridgeDomainListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NetworkResourceServiceServer).BridgeCreate(ctx, in)
+		return srv.(BridgeDomainServiceServer).BridgeDomainList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: NetworkResourceService_BridgeCreate_FullMethodName,
+		FullMethod: BridgeDomainService_BridgeDomainList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NetworkResourceServiceServer).BridgeCreate(ctx, req.(*BridgeCreateRequest))
+		return srv.(BridgeDomainServiceServer).BridgeDomainList(ctx, req.(*BridgeDomainListRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _NetworkResourceService_BridgeDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BridgeDeleteRequest)
+func _BridgeDomainService_MacLimitSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MacLimitSetRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NetworkResourceServiceServer).BridgeDelete(ctx, in)
+		return srv.(BridgeDomainServiceServer).MacLimitSet(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: NetworkResourceService_BridgeDelete_FullMethodName,
+		FullMethod: BridgeDomainService_MacLimitSet_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NetworkResourceServiceServer).BridgeDelete(ctx, req.(*BridgeDeleteRequest))
+		return srv.(BridgeDomainServiceServer).MacLimitSet(ctx, req.(*MacLimitSetRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _NetworkResourceService_BridgeList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BridgeListRequest)
+func _BridgeDomainService_MacLimitDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MacLimitDeleteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NetworkResourceServiceServer).BridgeList(ctx, in)
+		return srv.(BridgeDomainServiceServer).MacLimitDelete(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: NetworkResourceService_BridgeList_FullMethodName,
+		FullMethod: BridgeDomainService_MacLimitDelete_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NetworkResourceServiceServer).BridgeList(ctx, req.(*BridgeListRequest))
+		return srv.(BridgeDomainServiceServer).MacLimitDelete(ctx, req.(*MacLimitDeleteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// NetworkResourceService_ServiceDesc is the grpc.ServiceDesc for NetworkResourceService service.
-// It's only intended for direct use with grpc.RegisterService,
+func _BridgeDomainService_MacLimitList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MacLimitListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeDomainServiceServer).MacLimitList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BridgeDomainService_MacLimitList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeDomainServiceServer).MacLimitList(ctx, req.(*MacLimitListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BridgeDomainService_StormControlSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StormControlSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeDomainServiceServer).StormControlSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BridgeDomainService_StormControlSet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeDomainServiceServer).StormControlSet(ctx, req.(*StormControlSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BridgeDomainService_StormControlDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StormControlDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeDomainServiceServer).StormControlDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BridgeDomainService_StormControlDelete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeDomainServiceServer).StormControlDelete(ctx, req.(*StormControlDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BridgeDomainService_StormControlList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StormControlListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeDomainServiceServer).StormControlList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BridgeDomainService_StormControlList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeDomainServiceServer).StormControlList(ctx, req.(*StormControlListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BridgeDomainService_NeighborCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NeighborCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeDomainServiceServer).NeighborCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BridgeDomainService_NeighborCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeDomainServiceServer).NeighborCreate(ctx, req.(*NeighborCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BridgeDomainService_NeighborDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NeighborDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeDomainServiceServer).NeighborDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BridgeDomainService_NeighborDelete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeDomainServiceServer).NeighborDelete(ctx, req.(*NeighborDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BridgeDomainService_NeighborList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NeighborListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeDomainServiceServer).NeighborList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BridgeDomainService_NeighborList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeDomainServiceServer).NeighborList(ctx, req.(*NeighborListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BridgeDomainService_IrbRouteCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IrbRouteCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeDomainServiceServer).IrbRouteCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BridgeDomainService_IrbRouteCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeDomainServiceServer).IrbRouteCreate(ctx, req.(*IrbRouteCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BridgeDomainService_IrbRouteDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IrbRouteDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeDomainServiceServer).IrbRouteDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BridgeDomainService_IrbRouteDelete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeDomainServiceServer).IrbRouteDelete(ctx, req.(*IrbRouteDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BridgeDomainService_IrbRouteList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IrbRouteListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeDomainServiceServer).IrbRouteList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BridgeDomainService_IrbRouteList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeDomainServiceServer).IrbRouteList(ctx, req.(*IrbRouteListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BridgeDomainService_ServiceDesc is the grpc.ServiceDesc for BridgeDomainService service.
+// It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var NetworkResourceService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "vinbero.v1.NetworkResourceService",
-	HandlerType: (*NetworkResourceServiceServer)(nil),
+var BridgeDomainService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "vinbero.v1.BridgeDomainService",
+	HandlerType: (*BridgeDomainServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "VrfCreate",
-			Handler:    _NetworkResourceService_VrfCreate_Handler,
+			MethodName: "BridgeDomainCreate",
+			Handler:    _BridgeDomainService_BridgeDomainCreate_Handler,
 		},
 		{
-			MethodName: "VrfDelete",
-			Handler:    _NetworkResourceService_VrfDelete_Handler,
+			MethodName: "BridgeDomainDelete",
+			Handler:    _BridgeDomainService_BridgeDomainDelete_Handler,
 		},
 		{
-			MethodName: "VrfList",
-			Handler:    _NetworkResourceService_VrfList_Handler,
+			MethodName: "BridgeDomainGet",
+			Handler:    _BridgeDomainService_BridgeDomainGet_Handler,
 		},
 		{
-			MethodName: "BridgeCreate",
-			Handler:    _NetworkResourceService_BridgeCreate_Handler,
+			MethodName: "BridgeDomainList",
+			Handler:    _BridgeDomainService_BridgeDomainList_Handler,
 		},
 		{
-			MethodName: "BridgeDelete",
-			Handler:    _NetworkResourceService_BridgeDelete_Handler,
+			MethodName: "MacLimitSet",
+			Handler:    _BridgeDomainService_MacLimitSet_Handler,
 		},
 		{
-			MethodName: "BridgeList",
-			Handler:    _NetworkResourceService_BridgeList_Handler,
+			MethodName: "MacLimitDelete",
+			Handler:    _BridgeDomainService_MacLimitDelete_Handler,
+		},
+		{
+			MethodName: "MacLimitList",
+			Handler:    _BridgeDomainService_MacLimitList_Handler,
+		},
+		{
+			MethodName: "StormControlSet",
+			Handler:    _BridgeDomainService_StormControlSet_Handler,
+		},
+		{
+			MethodName: "StormControlDelete",
+			Handler:    _BridgeDomainService_StormControlDelete_Handler,
+		},
+		{
+			MethodName: "StormControlList",
+			Handler:    _BridgeDomainService_StormControlList_Handler,
+		},
+		{
+			MethodName: "NeighborCreate",
+			Handler:    _BridgeDomainService_NeighborCreate_Handler,
+		},
+		{
+			MethodName: "NeighborDelete",
+			Handler:    _BridgeDomainService_NeighborDelete_Handler,
+		},
+		{
+			MethodName: "NeighborList",
+			Handler:    _BridgeDomainService_NeighborList_Handler,
+		},
+		{
+			MethodName: "IrbRouteCreate",
+			Handler:    _BridgeDomainService_IrbRouteCreate_Handler,
+		},
+		{
+			MethodName: "IrbRouteDelete",
+			Handler:    _BridgeDomainService_IrbRouteDelete_Handler,
+		},
+		{
+			MethodName: "IrbRouteList",
+			Handler:    _BridgeDomainService_IrbRouteList_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -1834,235 +2551,1452 @@ var NetworkResourceService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	HeadendL2Service_HeadendL2Create_FullMethodName = "/vinbero.v1.HeadendL2Service/HeadendL2Create"
-	HeadendL2Service_HeadendL2Delete_FullMethodName = "/vinbero.v1.HeadendL2Service/HeadendL2Delete"
-	HeadendL2Service_HeadendL2List_FullMethodName   = "/vinbero.v1.HeadendL2Service/HeadendL2List"
-	HeadendL2Service_HeadendL2Get_FullMethodName    = "/vinbero.v1.HeadendL2Service/HeadendL2Get"
-	HeadendL2Service_HeadendL2Flush_FullMethodName  = "/vinbero.v1.HeadendL2Service/HeadendL2Flush"
+	EthernetSegmentService_EsCreate_FullMethodName  = "/vinbero.v1.EthernetSegmentService/EsCreate"
+	EthernetSegmentService_EsUpdate_FullMethodName  = "/vinbero.v1.EthernetSegmentService/EsUpdate"
+	EthernetSegmentService_EsDelete_FullMethodName  = "/vinbero.v1.EthernetSegmentService/EsDelete"
+	EthernetSegmentService_EsList_FullMethodName    = "/vinbero.v1.EthernetSegmentService/EsList"
+	EthernetSegmentService_EsSetDf_FullMethodName   = "/vinbero.v1.EthernetSegmentService/EsSetDf"
+	EthernetSegmentService_EsClearDf_FullMethodName = "/vinbero.v1.EthernetSegmentService/EsClearDf"
 )
 
-// HeadendL2ServiceClient is the client API for HeadendL2Service service.
+// EthernetSegmentServiceClient is the client API for EthernetSegmentService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-type HeadendL2ServiceClient interface {
-	HeadendL2Create(ctx context.Context, in *HeadendL2CreateRequest, opts ...grpc.CallOption) (*HeadendL2CreateResponse, error)
-	HeadendL2Delete(ctx context.Context, in *HeadendL2DeleteRequest, opts ...grpc.CallOption) (*HeadendL2DeleteResponse, error)
-	HeadendL2List(ctx context.Context, in *HeadendL2ListRequest, opts ...grpc.CallOption) (*HeadendL2ListResponse, error)
-	HeadendL2Get(ctx context.Context, in *HeadendL2GetRequest, opts ...grpc.CallOption) (*HeadendL2GetResponse, error)
-	HeadendL2Flush(ctx context.Context, in *HeadendL2FlushRequest, opts ...grpc.CallOption) (*HeadendL2FlushResponse, error)
+type EthernetSegmentServiceClient interface {
+	EsCreate(ctx context.Context, in *EsCreateRequest, opts ...grpc.CallOption) (*EsCreateResponse, error)
+	EsUpdate(ctx context.Context, in *EsUpdateRequest, opts ...grpc.CallOption) (*EsUpdateResponse, error)
+	EsDelete(ctx context.Context, in *EsDeleteRequest, opts ...grpc.CallOption) (*EsDeleteResponse, error)
+	EsList(ctx context.Context, in *EsListRequest, opts ...grpc.CallOption) (*EsListResponse, error)
+	EsSetDf(ctx context.Context, in *EsSetDfRequest, opts ...grpc.CallOption) (*EsSetDfResponse, error)
+	EsClearDf(ctx context.Context, in *EsClearDfRequest, opts ...grpc.CallOption) (*EsClearDfResponse, error)
+}
+
+type ethernetSegmentServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEthernetSegmentServiceClient(cc grpc.ClientConnInterface) EthernetSegmentServiceClient {
+	return &ethernetSegmentServiceClient{cc}
+}
+
+func (c *ethernetSegmentServiceClient) EsCreate(ctx context.Context, in *EsCreateRequest, opts ...grpc.CallOption) (*EsCreateResponse, error) {
+	out := new(EsCreateResponse)
+	err := c.cc.Invoke(ctx, EthernetSegmentService_EsCreate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ethernetSegmentServiceClient) EsUpdate(ctx context.Context, in *EsUpdateRequest, opts ...grpc.CallOption) (*EsUpdateResponse, error) {
+	out := new(EsUpdateResponse)
+	err := c.cc.Invoke(ctx, EthernetSegmentService_EsUpdate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ethernetSegmentServiceClient) EsDelete(ctx context.Context, in *EsDeleteRequest, opts ...grpc.CallOption) (*EsDeleteResponse, error) {
+	out := new(EsDeleteResponse)
+	err := c.cc.Invoke(ctx, EthernetSegmentService_EsDelete_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ethernetSegmentServiceClient) EsList(ctx context.Context, in *EsListRequest, opts ...grpc.CallOption) (*EsListResponse, error) {
+	out := new(EsListResponse)
+	err := c.cc.Invoke(ctx, EthernetSegmentService_EsList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ethernetSegmentServiceClient) EsSetDf(ctx context.Context, in *EsSetDfRequest, opts ...grpc.CallOption) (*EsSetDfResponse, error) {
+	out := new(EsSetDfResponse)
+	err := c.cc.Invoke(ctx, EthernetSegmentService_EsSetDf_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ethernetSegmentServiceClient) EsClearDf(ctx context.Context, in *EsClearDfRequest, opts ...grpc.CallOption) (*EsClearDfResponse, error) {
+	out := new(EsClearDfResponse)
+	err := c.cc.Invoke(ctx, EthernetSegmentService_EsClearDf_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EthernetSegmentServiceServer is the server API for EthernetSegmentService service.
+// All implementations should embed UnimplementedEthernetSegmentServiceServer
+// for forward compatibility
+type EthernetSegmentServiceServer interface {
+	EsCreate(context.Context, *EsCreateRequest) (*EsCreateResponse, error)
+	EsUpdate(context.Context, *EsUpdateRequest) (*EsUpdateResponse, error)
+	EsDelete(context.Context, *EsDeleteRequest) (*EsDeleteResponse, error)
+	EsList(context.Context, *EsListRequest) (*EsListResponse, error)
+	EsSetDf(context.Context, *EsSetDfRequest) (*EsSetDfResponse, error)
+	EsClearDf(context.Context, *EsClearDfRequest) (*EsClearDfResponse, error)
+}
+
+// UnimplementedEthernetSegmentServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedEthernetSegmentServiceServer struct {
+}
+
+func (UnimplementedEthernetSegmentServiceServer) EsCreate(context.Context, *EsCreateRequest) (*EsCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EsCreate not implemented")
+}
+func (UnimplementedEthernetSegmentServiceServer) EsUpdate(context.Context, *EsUpdateRequest) (*EsUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EsUpdate not implemented")
+}
+func (UnimplementedEthernetSegmentServiceServer) EsDelete(context.Context, *EsDeleteRequest) (*EsDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EsDelete not implemented")
+}
+func (UnimplementedEthernetSegmentServiceServer) EsList(context.Context, *EsListRequest) (*EsListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EsList not implemented")
+}
+func (UnimplementedEthernetSegmentServiceServer) EsSetDf(context.Context, *EsSetDfRequest) (*EsSetDfResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EsSetDf not implemented")
+}
+func (UnimplementedEthernetSegmentServiceServer) EsClearDf(context.Context, *EsClearDfRequest) (*EsClearDfResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EsClearDf not implemented")
+}
+
+// UnsafeEthernetSegmentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EthernetSegmentServiceServer will
+// result in compilation errors.
+type UnsafeEthernetSegmentServiceServer interface {
+	mustEmbedUnimplementedEthernetSegmentServiceServer()
+}
+
+func RegisterEthernetSegmentServiceServer(s grpc.ServiceRegistrar, srv EthernetSegmentServiceServer) {
+	s.RegisterService(&EthernetSegmentService_ServiceDesc, srv)
+}
+
+func _EthernetSegmentService_EsCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EsCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EthernetSegmentServiceServer).EsCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EthernetSegmentService_EsCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EthernetSegmentServiceServer).EsCreate(ctx, req.(*EsCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EthernetSegmentService_EsUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EsUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EthernetSegmentServiceServer).EsUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EthernetSegmentService_EsUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EthernetSegmentServiceServer).EsUpdate(ctx, req.(*EsUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EthernetSegmentService_EsDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EsDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EthernetSegmentServiceServer).EsDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EthernetSegmentService_EsDelete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EthernetSegmentServiceServer).EsDelete(ctx, req.(*EsDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EthernetSegmentService_EsList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EsListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EthernetSegmentServiceServer).EsList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EthernetSegmentService_EsList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EthernetSegmentServiceServer).EsList(ctx, req.(*EsListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EthernetSegmentService_EsSetDf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EsSetDfRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EthernetSegmentServiceServer).EsSetDf(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EthernetSegmentService_EsSetDf_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EthernetSegmentServiceServer).EsSetDf(ctx, req.(*EsSetDfRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EthernetSegmentService_EsClearDf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EsClearDfRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EthernetSegmentServiceServer).EsClearDf(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EthernetSegmentService_EsClearDf_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EthernetSegmentServiceServer).EsClearDf(ctx, req.(*EsClearDfRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// EthernetSegmentService_ServiceDesc is the grpc.ServiceDesc for EthernetSegmentService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var EthernetSegmentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "vinbero.v1.EthernetSegmentService",
+	HandlerType: (*EthernetSegmentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "EsCreate",
+			Handler:    _EthernetSegmentService_EsCreate_Handler,
+		},
+		{
+			MethodName: "EsUpdate",
+			Handler:    _EthernetSegmentService_EsUpdate_Handler,
+		},
+		{
+			MethodName: "EsDelete",
+			Handler:    _EthernetSegmentService_EsDelete_Handler,
+		},
+		{
+			MethodName: "EsList",
+			Handler:    _EthernetSegmentService_EsList_Handler,
+		},
+		{
+			MethodName: "EsSetDf",
+			Handler:    _EthernetSegmentService_EsSetDf_Handler,
+		},
+		{
+			MethodName: "EsClearDf",
+			Handler:    _EthernetSegmentService_EsClearDf_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "vinbero/v1/vinbero.proto",
+}
+
+const (
+	NetworkResourceService_VrfCreate_FullMethodName    = "/vinbero.v1.NetworkResourceService/VrfCreate"
+	NetworkResourceService_VrfDelete_FullMethodName    = "/vinbero.v1.NetworkResourceService/VrfDelete"
+	NetworkResourceService_VrfList_FullMethodName      = "/vinbero.v1.NetworkResourceService/VrfList"
+	NetworkResourceService_BridgeCreate_FullMethodName = "/vinbero.v1.NetworkResourceService/BridgeCreate"
+	NetworkResourceService_BridgeDelete_FullMethodName = "/vinbero.v1.NetworkResourceService/BridgeDelete"
+	NetworkResourceService_BridgeList_FullMethodName   = "/vinbero.v1.NetworkResourceService/BridgeList"
+)
+
+// NetworkResourceServiceClient is the client API for NetworkResourceService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type NetworkResourceServiceClient interface {
+	VrfCreate(ctx context.Context, in *VrfCreateRequest, opts ...grpc.CallOption) (*VrfCreateResponse, error)
+	VrfDelete(ctx context.Context, in *VrfDeleteRequest, opts ...grpc.CallOption) (*VrfDeleteResponse, error)
+	VrfList(ctx context.Context, in *VrfListRequest, opts ...grpc.CallOption) (*VrfListResponse, error)
+	BridgeCreate(ctx context.Context, in *BridgeCreateRequest, opts ...grpc.CallOption) (*BridgeCreateResponse, error)
+	BridgeDelete(ctx context.Context, in *BridgeDeleteRequest, opts ...grpc.CallOption) (*BridgeDeleteResponse, error)
+	BridgeList(ctx context.Context, in *BridgeListRequest, opts ...grpc.CallOption) (*BridgeListResponse, error)
+}
+
+type networkResourceServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNetworkResourceServiceClient(cc grpc.ClientConnInterface) NetworkResourceServiceClient {
+	return &networkResourceServiceClient{cc}
+}
+
+func (c *networkResourceServiceClient) VrfCreate(ctx context.Context, in *VrfCreateRequest, opts ...grpc.CallOption) (*VrfCreateResponse, error) {
+	out := new(VrfCreateResponse)
+	err := c.cc.Invoke(ctx, NetworkResourceService_VrfCreate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *networkResourceServiceClient) VrfDelete(ctx context.Context, in *VrfDeleteRequest, opts ...grpc.CallOption) (*VrfDeleteResponse, error) {
+	out := new(VrfDeleteResponse)
+	err := c.cc.Invoke(ctx, NetworkResourceService_VrfDelete_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *networkResourceServiceClient) VrfList(ctx context.Context, in *VrfListRequest, opts ...grpc.CallOption) (*VrfListResponse, error) {
+	out := new(VrfListResponse)
+	err := c.cc.Invoke(ctx, NetworkResourceService_VrfList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *networkResourceServiceClient) BridgeCreate(ctx context.Context, in *BridgeCreateRequest, opts ...grpc.CallOption) (*BridgeCreateResponse, error) {
+	out := new(BridgeCreateResponse)
+	err := c.cc.Invoke(ctx, NetworkResourceService_BridgeCreate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *networkResourceServiceClient) BridgeDelete(ctx context.Context, in *BridgeDeleteRequest, opts ...grpc.CallOption) (*BridgeDeleteResponse, error) {
+	out := new(BridgeDeleteResponse)
+	err := c.cc.Invoke(ctx, NetworkResourceService_BridgeDelete_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *networkResourceServiceClient) BridgeList(ctx context.Context, in *BridgeListRequest, opts ...grpc.CallOption) (*BridgeListResponse, error) {
+	out := new(BridgeListResponse)
+	err := c.cc.Invoke(ctx, NetworkResourceService_BridgeList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NetworkResourceServiceServer is the server API for NetworkResourceService service.
+// All implementations should embed UnimplementedNetworkResourceServiceServer
+// for forward compatibility
+type NetworkResourceServiceServer interface {
+	VrfCreate(context.Context, *VrfCreateRequest) (*VrfCreateResponse, error)
+	VrfDelete(context.Context, *VrfDeleteRequest) (*VrfDeleteResponse, error)
+	VrfList(context.Context, *VrfListRequest) (*VrfListResponse, error)
+	BridgeCreate(context.Context, *BridgeCreateRequest) (*BridgeCreateResponse, error)
+	BridgeDelete(context.Context, *BridgeDeleteRequest) (*BridgeDeleteResponse, error)
+	BridgeList(context.Context, *BridgeListRequest) (*BridgeListResponse, error)
+}
+
+// UnimplementedNetworkResourceServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedNetworkResourceServiceServer struct {
+}
+
+func (UnimplementedNetworkResourceServiceServer) VrfCreate(context.Context, *VrfCreateRequest) (*VrfCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VrfCreate not implemented")
+}
+func (UnimplementedNetworkResourceServiceServer) VrfDelete(context.Context, *VrfDeleteRequest) (*VrfDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VrfDelete not implemented")
+}
+func (UnimplementedNetworkResourceServiceServer) VrfList(context.Context, *VrfListRequest) (*VrfListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VrfList not implemented")
+}
+func (UnimplementedNetworkResourceServiceServer) BridgeCreate(context.Context, *BridgeCreateRequest) (*BridgeCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgeCreate not implemented")
+}
+func (UnimplementedNetworkResourceServiceServer) BridgeDelete(context.Context, *BridgeDeleteRequest) (*BridgeDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgeDelete not implemented")
+}
+func (UnimplementedNetworkResourceServiceServer) BridgeList(context.Context, *BridgeListRequest) (*BridgeListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgeList not implemented")
+}
+
+// UnsafeNetworkResourceServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NetworkResourceServiceServer will
+// result in compilation errors.
+type UnsafeNetworkResourceServiceServer interface {
+	mustEmbedUnimplementedNetworkResourceServiceServer()
+}
+
+func RegisterNetworkResourceServiceServer(s grpc.ServiceRegistrar, srv NetworkResourceServiceServer) {
+	s.RegisterService(&NetworkResourceService_ServiceDesc, srv)
+}
+
+func _NetworkResourceService_VrfCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VrfCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkResourceServiceServer).VrfCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetworkResourceService_VrfCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkResourceServiceServer).VrfCreate(ctx, req.(*VrfCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetworkResourceService_VrfDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VrfDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkResourceServiceServer).VrfDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetworkResourceService_VrfDelete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkResourceServiceServer).VrfDelete(ctx, req.(*VrfDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetworkResourceService_VrfList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VrfListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkResourceServiceServer).VrfList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetworkResourceService_VrfList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkResourceServiceServer).VrfList(ctx, req.(*VrfListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetworkResourceService_BridgeCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BridgeCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkResourceServiceServer).BridgeCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetworkResourceService_BridgeCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkResourceServiceServer).BridgeCreate(ctx, req.(*BridgeCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetworkResourceService_BridgeDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BridgeDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkResourceServiceServer).BridgeDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetworkResourceService_BridgeDelete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkResourceServiceServer).BridgeDelete(ctx, req.(*BridgeDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetworkResourceService_BridgeList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BridgeListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkResourceServiceServer).BridgeList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetworkResourceService_BridgeList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkResourceServiceServer).BridgeList(ctx, req.(*BridgeListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// NetworkResourceService_ServiceDesc is the grpc.ServiceDesc for NetworkResourceService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var NetworkResourceService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "vinbero.v1.NetworkResourceService",
+	HandlerType: (*NetworkResourceServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "VrfCreate",
+			Handler:    _NetworkResourceService_VrfCreate_Handler,
+		},
+		{
+			MethodName: "VrfDelete",
+			Handler:    _NetworkResourceService_VrfDelete_Handler,
+		},
+		{
+			MethodName: "VrfList",
+			Handler:    _NetworkResourceService_VrfList_Handler,
+		},
+		{
+			MethodName: "BridgeCreate",
+			Handler:    _NetworkResourceService_BridgeCreate_Handler,
+		},
+		{
+			MethodName: "BridgeDelete",
+			Handler:    _NetworkResourceService_BridgeDelete_Handler,
+		},
+		{
+			MethodName: "BridgeList",
+			Handler:    _NetworkResourceService_BridgeList_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "vinbero/v1/vinbero.proto",
+}
+
+const (
+	HeadendL2Service_HeadendL2Create_FullMethodName = "/vinbero.v1.HeadendL2Service/HeadendL2Create"
+	HeadendL2Service_HeadendL2Update_FullMethodName = "/vinbero.v1.HeadendL2Service/HeadendL2Update"
+	HeadendL2Service_HeadendL2Delete_FullMethodName = "/vinbero.v1.HeadendL2Service/HeadendL2Delete"
+	HeadendL2Service_HeadendL2List_FullMethodName   = "/vinbero.v1.HeadendL2Service/HeadendL2List"
+	HeadendL2Service_HeadendL2Get_FullMethodName    = "/vinbero.v1.HeadendL2Service/HeadendL2Get"
+	HeadendL2Service_HeadendL2Flush_FullMethodName  = "/vinbero.v1.HeadendL2Service/HeadendL2Flush"
+)
+
+// HeadendL2ServiceClient is the client API for HeadendL2Service service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type HeadendL2ServiceClient interface {
+	HeadendL2Create(ctx context.Context, in *HeadendL2CreateRequest, opts ...grpc.CallOption) (*HeadendL2CreateResponse, error)
+	HeadendL2Update(ctx context.Context, in *HeadendL2UpdateRequest, opts ...grpc.CallOption) (*HeadendL2UpdateResponse, error)
+	HeadendL2Delete(ctx context.Context, in *HeadendL2DeleteRequest, opts ...grpc.CallOption) (*HeadendL2DeleteResponse, error)
+	HeadendL2List(ctx context.Context, in *HeadendL2ListRequest, opts ...grpc.CallOption) (*HeadendL2ListResponse, error)
+	HeadendL2Get(ctx context.Context, in *HeadendL2GetRequest, opts ...grpc.CallOption) (*HeadendL2GetResponse, error)
+	HeadendL2Flush(ctx context.Context, in *HeadendL2FlushRequest, opts ...grpc.CallOption) (*HeadendL2FlushResponse, error)
+}
+
+type headendL2ServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewHeadendL2ServiceClient(cc grpc.ClientConnInterface) HeadendL2ServiceClient {
+	return &headendL2ServiceClient{cc}
+}
+
+func (c *headendL2ServiceClient) HeadendL2Create(ctx context.Context, in *HeadendL2CreateRequest, opts ...grpc.CallOption) (*HeadendL2CreateResponse, error) {
+	out := new(HeadendL2CreateResponse)
+	err := c.cc.Invoke(ctx, HeadendL2Service_HeadendL2Create_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *headendL2ServiceClient) HeadendL2Update(ctx context.Context, in *HeadendL2UpdateRequest, opts ...grpc.CallOption) (*HeadendL2UpdateResponse, error) {
+	out := new(HeadendL2UpdateResponse)
+	err := c.cc.Invoke(ctx, HeadendL2Service_HeadendL2Update_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *headendL2ServiceClient) HeadendL2Delete(ctx context.Context, in *HeadendL2DeleteRequest, opts ...grpc.CallOption) (*HeadendL2DeleteResponse, error) {
+	out := new(HeadendL2DeleteResponse)
+	err := c.cc.Invoke(ctx, HeadendL2Service_HeadendL2Delete_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *headendL2ServiceClient) HeadendL2List(ctx context.Context, in *HeadendL2ListRequest, opts ...grpc.CallOption) (*HeadendL2ListResponse, error) {
+	out := new(HeadendL2ListResponse)
+	err := c.cc.Invoke(ctx, HeadendL2Service_HeadendL2List_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *headendL2ServiceClient) HeadendL2Get(ctx context.Context, in *HeadendL2GetRequest, opts ...grpc.CallOption) (*HeadendL2GetResponse, error) {
+	out := new(HeadendL2GetResponse)
+	err := c.cc.Invoke(ctx, HeadendL2Service_HeadendL2Get_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *headendL2ServiceClient) HeadendL2Flush(ctx context.Context, in *HeadendL2FlushRequest, opts ...grpc.CallOption) (*HeadendL2FlushResponse, error) {
+	out := new(HeadendL2FlushResponse)
+	err := c.cc.Invoke(ctx, HeadendL2Service_HeadendL2Flush_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// HeadendL2ServiceServer is the server API for HeadendL2Service service.
+// All implementations should embed UnimplementedHeadendL2ServiceServer
+// for forward compatibility
+type HeadendL2ServiceServer interface {
+	HeadendL2Create(context.Context, *HeadendL2CreateRequest) (*HeadendL2CreateResponse, error)
+	HeadendL2Update(context.Context, *HeadendL2UpdateRequest) (*HeadendL2UpdateResponse, error)
+	HeadendL2Delete(context.Context, *HeadendL2DeleteRequest) (*HeadendL2DeleteResponse, error)
+	HeadendL2List(context.Context, *HeadendL2ListRequest) (*HeadendL2ListResponse, error)
+	HeadendL2Get(context.Context, *HeadendL2GetRequest) (*HeadendL2GetResponse, error)
+	HeadendL2Flush(context.Context, *HeadendL2FlushRequest) (*HeadendL2FlushResponse, error)
+}
+
+// UnimplementedHeadendL2ServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedHeadendL2ServiceServer struct {
+}
+
+func (UnimplementedHeadendL2ServiceServer) HeadendL2Create(context.Context, *HeadendL2CreateRequest) (*HeadendL2CreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeadendL2Create not implemented")
+}
+func (UnimplementedHeadendL2ServiceServer) HeadendL2Update(context.Context, *HeadendL2UpdateRequest) (*HeadendL2UpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeadendL2Update not implemented")
+}
+func (UnimplementedHeadendL2ServiceServer) HeadendL2Delete(context.Context, *HeadendL2DeleteRequest) (*HeadendL2DeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeadendL2Delete not implemented")
+}
+func (UnimplementedHeadendL2ServiceServer) HeadendL2List(context.Context, *HeadendL2ListRequest) (*HeadendL2ListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeadendL2List not implemented")
+}
+func (UnimplementedHeadendL2ServiceServer) HeadendL2Get(context.Context, *HeadendL2GetRequest) (*HeadendL2GetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeadendL2Get not implemented")
+}
+func (UnimplementedHeadendL2ServiceServer) HeadendL2Flush(context.Context, *HeadendL2FlushRequest) (*HeadendL2FlushResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeadendL2Flush not implemented")
+}
+
+// UnsafeHeadendL2ServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to HeadendL2ServiceServer will
+// result in compilation errors.
+type UnsafeHeadendL2ServiceServer interface {
+	mustEmbedUnimplementedHeadendL2ServiceServer()
+}
+
+func RegisterHeadendL2ServiceServer(s grpc.ServiceRegistrar, srv HeadendL2ServiceServer) {
+	s.RegisterService(&HeadendL2Service_ServiceDesc, srv)
+}
+
+func _HeadendL2Service_HeadendL2Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HeadendL2CreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeadendL2ServiceServer).HeadendL2Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HeadendL2Service_HeadendL2Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeadendL2ServiceServer).HeadendL2Create(ctx, req.(*HeadendL2CreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HeadendL2Service_HeadendL2Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HeadendL2UpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeadendL2ServiceServer).HeadendL2Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HeadendL2Service_HeadendL2Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeadendL2ServiceServer).HeadendL2Update(ctx, req.(*HeadendL2UpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HeadendL2Service_HeadendL2Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HeadendL2DeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeadendL2ServiceServer).HeadendL2Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HeadendL2Service_HeadendL2Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeadendL2ServiceServer).HeadendL2Delete(ctx, req.(*HeadendL2DeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HeadendL2Service_HeadendL2List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HeadendL2ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeadendL2ServiceServer).HeadendL2List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HeadendL2Service_HeadendL2List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeadendL2ServiceServer).HeadendL2List(ctx, req.(*HeadendL2ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HeadendL2Service_HeadendL2Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HeadendL2GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeadendL2ServiceServer).HeadendL2Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HeadendL2Service_HeadendL2Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeadendL2ServiceServer).HeadendL2Get(ctx, req.(*HeadendL2GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HeadendL2Service_HeadendL2Flush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HeadendL2FlushRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeadendL2ServiceServer).HeadendL2Flush(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HeadendL2Service_HeadendL2Flush_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeadendL2ServiceServer).HeadendL2Flush(ctx, req.(*HeadendL2FlushRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// HeadendL2Service_ServiceDesc is the grpc.ServiceDesc for HeadendL2Service service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var HeadendL2Service_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "vinbero.v1.HeadendL2Service",
+	HandlerType: (*HeadendL2ServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "HeadendL2Create",
+			Handler:    _HeadendL2Service_HeadendL2Create_Handler,
+		},
+		{
+			MethodName: "HeadendL2Update",
+			Handler:    _HeadendL2Service_HeadendL2Update_Handler,
+		},
+		{
+			MethodName: "HeadendL2Delete",
+			Handler:    _HeadendL2Service_HeadendL2Delete_Handler,
+		},
+		{
+			MethodName: "HeadendL2List",
+			Handler:    _HeadendL2Service_HeadendL2List_Handler,
+		},
+		{
+			MethodName: "HeadendL2Get",
+			Handler:    _HeadendL2Service_HeadendL2Get_Handler,
+		},
+		{
+			MethodName: "HeadendL2Flush",
+			Handler:    _HeadendL2Service_HeadendL2Flush_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "vinbero/v1/vinbero.proto",
+}
+
+const (
+	StatsService_StatsShow_FullMethodName      = "/vinbero.v1.StatsService/StatsShow"
+	StatsService_StatsReset_FullMethodName     = "/vinbero.v1.StatsService/StatsReset"
+	StatsService_StatsSlotShow_FullMethodName  = "/vinbero.v1.StatsService/StatsSlotShow"
+	StatsService_StatsSlotReset_FullMethodName = "/vinbero.v1.StatsService/StatsSlotReset"
+)
+
+// StatsServiceClient is the client API for StatsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type StatsServiceClient interface {
+	StatsShow(ctx context.Context, in *StatsShowRequest, opts ...grpc.CallOption) (*StatsShowResponse, error)
+	StatsReset(ctx context.Context, in *StatsResetRequest, opts ...grpc.CallOption) (*StatsResetResponse, error)
+	StatsSlotShow(ctx context.Context, in *StatsSlotShowRequest, opts ...grpc.CallOption) (*StatsSlotShowResponse, error)
+	StatsSlotReset(ctx context.Context, in *StatsSlotResetRequest, opts ...grpc.CallOption) (*StatsSlotResetResponse, error)
+}
+
+type statsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStatsServiceClient(cc grpc.ClientConnInterface) StatsServiceClient {
+	return &statsServiceClient{cc}
+}
+
+func (c *statsServiceClient) StatsShow(ctx context.Context, in *StatsShowRequest, opts ...grpc.CallOption) (*StatsShowResponse, error) {
+	out := new(StatsShowResponse)
+	err := c.cc.Invoke(ctx, StatsService_StatsShow_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *statsServiceClient) StatsReset(ctx context.Context, in *StatsResetRequest, opts ...grpc.CallOption) (*StatsResetResponse, error) {
+	out := new(StatsResetResponse)
+	err := c.cc.Invoke(ctx, StatsService_StatsReset_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *statsServiceClient) StatsSlotShow(ctx context.Context, in *StatsSlotShowRequest, opts ...grpc.CallOption) (*StatsSlotShowResponse, error) {
+	out := new(StatsSlotShowResponse)
+	err := c.cc.Invoke(ctx, StatsService_StatsSlotShow_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *statsServiceClient) StatsSlotReset(ctx context.Context, in *StatsSlotResetRequest, opts ...grpc.CallOption) (*StatsSlotResetResponse, error) {
+	out := new(StatsSlotResetResponse)
+	err := c.cc.Invoke(ctx, StatsService_StatsSlotReset_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// StatsServiceServer is the server API for StatsService service.
+// All implementations should embed UnimplementedStatsServiceServer
+// for forward compatibility
+type StatsServiceServer interface {
+	StatsShow(context.Context, *StatsShowRequest) (*StatsShowResponse, error)
+	StatsReset(context.Context, *StatsResetRequest) (*StatsResetResponse, error)
+	StatsSlotShow(context.Context, *StatsSlotShowRequest) (*StatsSlotShowResponse, error)
+	StatsSlotReset(context.Context, *StatsSlotResetRequest) (*StatsSlotResetResponse, error)
+}
+
+// UnimplementedStatsServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedStatsServiceServer struct {
+}
+
+func (UnimplementedStatsServiceServer) StatsShow(context.Context, *StatsShowRequest) (*StatsShowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StatsShow not implemented")
+}
+func (UnimplementedStatsServiceServer) StatsReset(context.Context, *StatsResetRequest) (*StatsResetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StatsReset not implemented")
+}
+func (UnimplementedStatsServiceServer) StatsSlotShow(context.Context, *StatsSlotShowRequest) (*StatsSlotShowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StatsSlotShow not implemented")
+}
+func (UnimplementedStatsServiceServer) StatsSlotReset(context.Context, *StatsSlotResetRequest) (*StatsSlotResetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StatsSlotReset not implemented")
+}
+
+// UnsafeStatsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StatsServiceServer will
+// result in compilation errors.
+type UnsafeStatsServiceServer interface {
+	mustEmbedUnimplementedStatsServiceServer()
+}
+
+func RegisterStatsServiceServer(s grpc.ServiceRegistrar, srv StatsServiceServer) {
+	s.RegisterService(&StatsService_ServiceDesc, srv)
+}
+
+func _StatsService_StatsShow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatsShowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatsServiceServer).StatsShow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StatsService_StatsShow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatsServiceServer).StatsShow(ctx, req.(*StatsShowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StatsService_StatsReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatsResetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatsServiceServer).StatsReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StatsService_StatsReset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatsServiceServer).StatsReset(ctx, req.(*StatsResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StatsService_StatsSlotShow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatsSlotShowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatsServiceServer).StatsSlotShow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StatsService_StatsSlotShow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatsServiceServer).StatsSlotShow(ctx, req.(*StatsSlotShowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StatsService_StatsSlotReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatsSlotResetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatsServiceServer).StatsSlotReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StatsService_StatsSlotReset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatsServiceServer).StatsSlotReset(ctx, req.(*StatsSlotResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// StatsService_ServiceDesc is the grpc.ServiceDesc for StatsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var StatsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "vinbero.v1.StatsService",
+	HandlerType: (*StatsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "StatsShow",
+			Handler:    _StatsService_StatsShow_Handler,
+		},
+		{
+			MethodName: "StatsReset",
+			Handler:    _StatsService_StatsReset_Handler,
+		},
+		{
+			MethodName: "StatsSlotShow",
+			Handler:    _StatsService_StatsSlotShow_Handler,
+		},
+		{
+			MethodName: "StatsSlotReset",
+			Handler:    _StatsService_StatsSlotReset_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "vinbero/v1/vinbero.proto",
+}
+
+const (
+	OamService_OamPing_FullMethodName       = "/vinbero.v1.OamService/OamPing"
+	OamService_OamTraceroute_FullMethodName = "/vinbero.v1.OamService/OamTraceroute"
+	OamService_OamPuntList_FullMethodName   = "/vinbero.v1.OamService/OamPuntList"
+)
+
+// OamServiceClient is the client API for OamService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type OamServiceClient interface {
+	OamPing(ctx context.Context, in *OamPingRequest, opts ...grpc.CallOption) (*OamPingResponse, error)
+	OamTraceroute(ctx context.Context, in *OamTracerouteRequest, opts ...grpc.CallOption) (*OamTracerouteResponse, error)
+	OamPuntList(ctx context.Context, in *OamPuntListRequest, opts ...grpc.CallOption) (*OamPuntListResponse, error)
+}
+
+type oamServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOamServiceClient(cc grpc.ClientConnInterface) OamServiceClient {
+	return &oamServiceClient{cc}
+}
+
+func (c *oamServiceClient) OamPing(ctx context.Context, in *OamPingRequest, opts ...grpc.CallOption) (*OamPingResponse, error) {
+	out := new(OamPingResponse)
+	err := c.cc.Invoke(ctx, OamService_OamPing_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oamServiceClient) OamTraceroute(ctx context.Context, in *OamTracerouteRequest, opts ...grpc.CallOption) (*OamTracerouteResponse, error) {
+	out := new(OamTracerouteResponse)
+	err := c.cc.Invoke(ctx, OamService_OamTraceroute_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oamServiceClient) OamPuntList(ctx context.Context, in *OamPuntListRequest, opts ...grpc.CallOption) (*OamPuntListResponse, error) {
+	out := new(OamPuntListResponse)
+	err := c.cc.Invoke(ctx, OamService_OamPuntList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OamServiceServer is the server API for OamService service.
+// All implementations should embed UnimplementedOamServiceServer
+// for forward compatibility
+type OamServiceServer interface {
+	OamPing(context.Context, *OamPingRequest) (*OamPingResponse, error)
+	OamTraceroute(context.Context, *OamTracerouteRequest) (*OamTracerouteResponse, error)
+	OamPuntList(context.Context, *OamPuntListRequest) (*OamPuntListResponse, error)
+}
+
+// UnimplementedOamServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedOamServiceServer struct {
+}
+
+func (UnimplementedOamServiceServer) OamPing(context.Context, *OamPingRequest) (*OamPingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OamPing not implemented")
+}
+func (UnimplementedOamServiceServer) OamTraceroute(context.Context, *OamTracerouteRequest) (*OamTracerouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OamTraceroute not implemented")
+}
+func (UnimplementedOamServiceServer) OamPuntList(context.Context, *OamPuntListRequest) (*OamPuntListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OamPuntList not implemented")
+}
+
+// UnsafeOamServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OamServiceServer will
+// result in compilation errors.
+type UnsafeOamServiceServer interface {
+	mustEmbedUnimplementedOamServiceServer()
+}
+
+func RegisterOamServiceServer(s grpc.ServiceRegistrar, srv OamServiceServer) {
+	s.RegisterService(&OamService_ServiceDesc, srv)
+}
+
+func _OamService_OamPing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OamPingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OamServiceServer).OamPing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OamService_OamPing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OamServiceServer).OamPing(ctx, req.(*OamPingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OamService_OamTraceroute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OamTracerouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OamServiceServer).OamTraceroute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OamService_OamTraceroute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OamServiceServer).OamTraceroute(ctx, req.(*OamTracerouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OamService_OamPuntList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OamPuntListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OamServiceServer).OamPuntList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OamService_OamPuntList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OamServiceServer).OamPuntList(ctx, req.(*OamPuntListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OamService_ServiceDesc is the grpc.ServiceDesc for OamService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OamService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "vinbero.v1.OamService",
+	HandlerType: (*OamServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "OamPing",
+			Handler:    _OamService_OamPing_Handler,
+		},
+		{
+			MethodName: "OamTraceroute",
+			Handler:    _OamService_OamTraceroute_Handler,
+		},
+		{
+			MethodName: "OamPuntList",
+			Handler:    _OamService_OamPuntList_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "vinbero/v1/vinbero.proto",
+}
+
+const (
+	PerformanceService_StampReflectorCreate_FullMethodName = "/vinbero.v1.PerformanceService/StampReflectorCreate"
+	PerformanceService_StampReflectorDelete_FullMethodName = "/vinbero.v1.PerformanceService/StampReflectorDelete"
+	PerformanceService_StampReflectorList_FullMethodName   = "/vinbero.v1.PerformanceService/StampReflectorList"
+	PerformanceService_StampSessionCreate_FullMethodName   = "/vinbero.v1.PerformanceService/StampSessionCreate"
+	PerformanceService_StampSessionDelete_FullMethodName   = "/vinbero.v1.PerformanceService/StampSessionDelete"
+	PerformanceService_StampSessionList_FullMethodName     = "/vinbero.v1.PerformanceService/StampSessionList"
+)
+
+// PerformanceServiceClient is the client API for PerformanceService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PerformanceServiceClient interface {
+	StampReflectorCreate(ctx context.Context, in *StampReflectorCreateRequest, opts ...grpc.CallOption) (*StampReflectorCreateResponse, error)
+	StampReflectorDelete(ctx context.Context, in *StampReflectorDeleteRequest, opts ...grpc.CallOption) (*StampReflectorDeleteResponse, error)
+	StampReflectorList(ctx context.Context, in *StampReflectorListRequest, opts ...grpc.CallOption) (*StampReflectorListResponse, error)
+	StampSessionCreate(ctx context.Context, in *StampSessionCreateRequest, opts ...grpc.CallOption) (*StampSessionCreateResponse, error)
+	StampSessionDelete(ctx context.Context, in *StampSessionDeleteRequest, opts ...grpc.CallOption) (*StampSessionDeleteResponse, error)
+	StampSessionList(ctx context.Context, in *StampSessionListRequest, opts ...grpc.CallOption) (*StampSessionListResponse, error)
 }
 
-type headendL2ServiceClient struct {
+type performanceServiceClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewHeadendL2ServiceClient(cc grpc.ClientConnInterface) HeadendL2ServiceClient {
-	return &headendL2ServiceClient{cc}
+func NewPerformanceServiceClient(cc grpc.ClientConnInterface) PerformanceServiceClient {
+	return &performanceServiceClient{cc}
 }
 
-func (c *headendL2ServiceClient) HeadendL2Create(ctx context.Context, in *HeadendL2CreateRequest, opts ...grpc.CallOption) (*HeadendL2CreateResponse, error) {
-	out := new(HeadendL2CreateResponse)
-	err := c.cc.Invoke(ctx, HeadendL2Service_HeadendL2Create_FullMethodName, in, out, opts...)
+func (c *performanceServiceClient) StampReflectorCreate(ctx context.Context, in *StampReflectorCreateRequest, opts ...grpc.CallOption) (*StampReflectorCreateResponse, error) {
+	out := new(StampReflectorCreateResponse)
+	err := c.cc.Invoke(ctx, PerformanceService_StampReflectorCreate_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *headendL2ServiceClient) HeadendL2Delete(ctx context.Context, in *HeadendL2DeleteRequest, opts ...grpc.CallOption) (*HeadendL2DeleteResponse, error) {
-	out := new(HeadendL2DeleteResponse)
-	err := c.cc.Invoke(ctx, HeadendL2Service_HeadendL2Delete_FullMethodName, in, out, opts...)
+func (c *performanceServiceClient) StampReflectorDelete(ctx context.Context, in *StampReflectorDeleteRequest, opts ...grpc.CallOption) (*StampReflectorDeleteResponse, error) {
+	out := new(StampReflectorDeleteResponse)
+	err := c.cc.Invoke(ctx, PerformanceService_StampReflectorDelete_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *headendL2ServiceClient) HeadendL2List(ctx context.Context, in *HeadendL2ListRequest, opts ...grpc.CallOption) (*HeadendL2ListResponse, error) {
-	out := new(HeadendL2ListResponse)
-	err := c.cc.Invoke(ctx, HeadendL2Service_HeadendL2List_FullMethodName, in, out, opts...)
+func (c *performanceServiceClient) StampReflectorList(ctx context.Context, in *StampReflectorListRequest, opts ...grpc.CallOption) (*StampReflectorListResponse, error) {
+	out := new(StampReflectorListResponse)
+	err := c.cc.Invoke(ctx, PerformanceService_StampReflectorList_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *headendL2ServiceClient) HeadendL2Get(ctx context.Context, in *HeadendL2GetRequest, opts ...grpc.CallOption) (*HeadendL2GetResponse, error) {
-	out := new(HeadendL2GetResponse)
-	err := c.cc.Invoke(ctx, HeadendL2Service_HeadendL2Get_FullMethodName, in, out, opts...)
+func (c *performanceServiceClient) StampSessionCreate(ctx context.Context, in *StampSessionCreateRequest, opts ...grpc.CallOption) (*StampSessionCreateResponse, error) {
+	out := new(StampSessionCreateResponse)
+	err := c.cc.Invoke(ctx, PerformanceService_StampSessionCreate_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *headendL2ServiceClient) HeadendL2Flush(ctx context.Context, in *HeadendL2FlushRequest, opts ...grpc.CallOption) (*HeadendL2FlushResponse, error) {
-	out := new(HeadendL2FlushResponse)
-	err := c.cc.Invoke(ctx, HeadendL2Service_HeadendL2Flush_FullMethodName, in, out, opts...)
+func (c *performanceServiceClient) StampSessionDelete(ctx context.Context, in *StampSessionDeleteRequest, opts ...grpc.CallOption) (*StampSessionDeleteResponse, error) {
+	out := new(StampSessionDeleteResponse)
+	err := c.cc.Invoke(ctx, PerformanceService_StampSessionDelete_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// HeadendL2ServiceServer is the server API for HeadendL2Service service.
-// All implementations should embed UnimplementedHeadendL2ServiceServer
+func (c *performanceServiceClient) StampSessionList(ctx context.Context, in *StampSessionListRequest, opts ...grpc.CallOption) (*StampSessionListResponse, error) {
+	out := new(StampSessionListResponse)
+	err := c.cc.Invoke(ctx, PerformanceService_StampSessionList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PerformanceServiceServer is the server API for PerformanceService service.
+// All implementations should embed UnimplementedPerformanceServiceServer
 // for forward compatibility
-type HeadendL2ServiceServer interface {
-	HeadendL2Create(context.Context, *HeadendL2CreateRequest) (*HeadendL2CreateResponse, error)
-	HeadendL2Delete(context.Context, *HeadendL2DeleteRequest) (*HeadendL2DeleteResponse, error)
-	HeadendL2List(context.Context, *HeadendL2ListRequest) (*HeadendL2ListResponse, error)
-	HeadendL2Get(context.Context, *HeadendL2GetRequest) (*HeadendL2GetResponse, error)
-	HeadendL2Flush(context.Context, *HeadendL2FlushRequest) (*HeadendL2FlushResponse, error)
+type PerformanceServiceServer interface {
+	StampReflectorCreate(context.Context, *StampReflectorCreateRequest) (*StampReflectorCreateResponse, error)
+	StampReflectorDelete(context.Context, *StampReflectorDeleteRequest) (*StampReflectorDeleteResponse, error)
+	StampReflectorList(context.Context, *StampReflectorListRequest) (*StampReflectorListResponse, error)
+	StampSessionCreate(context.Context, *StampSessionCreateRequest) (*StampSessionCreateResponse, error)
+	StampSessionDelete(context.Context, *StampSessionDeleteRequest) (*StampSessionDeleteResponse, error)
+	StampSessionList(context.Context, *StampSessionListRequest) (*StampSessionListResponse, error)
 }
 
-// UnimplementedHeadendL2ServiceServer should be embedded to have forward compatible implementations.
-type UnimplementedHeadendL2ServiceServer struct {
+// UnimplementedPerformanceServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedPerformanceServiceServer struct {
 }
 
-func (UnimplementedHeadendL2ServiceServer) HeadendL2Create(context.Context, *HeadendL2CreateRequest) (*HeadendL2CreateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HeadendL2Create not implemented")
+func (UnimplementedPerformanceServiceServer) StampReflectorCreate(context.Context, *StampReflectorCreateRequest) (*StampReflectorCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StampReflectorCreate not implemented")
 }
-func (UnimplementedHeadendL2ServiceServer) HeadendL2Delete(context.Context, *HeadendL2DeleteRequest) (*HeadendL2DeleteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HeadendL2Delete not implemented")
+func (UnimplementedPerformanceServiceServer) StampReflectorDelete(context.Context, *StampReflectorDeleteRequest) (*StampReflectorDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StampReflectorDelete not implemented")
 }
-func (UnimplementedHeadendL2ServiceServer) HeadendL2List(context.Context, *HeadendL2ListRequest) (*HeadendL2ListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HeadendL2List not implemented")
+func (UnimplementedPerformanceServiceServer) StampReflectorList(context.Context, *StampReflectorListRequest) (*StampReflectorListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StampReflectorList not implemented")
 }
-func (UnimplementedHeadendL2ServiceServer) HeadendL2Get(context.Context, *HeadendL2GetRequest) (*HeadendL2GetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HeadendL2Get not implemented")
+func (UnimplementedPerformanceServiceServer) StampSessionCreate(context.Context, *StampSessionCreateRequest) (*StampSessionCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StampSessionCreate not implemented")
 }
-func (UnimplementedHeadendL2ServiceServer) HeadendL2Flush(context.Context, *HeadendL2FlushRequest) (*HeadendL2FlushResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HeadendL2Flush not implemented")
+func (UnimplementedPerformanceServiceServer) StampSessionDelete(context.Context, *StampSessionDeleteRequest) (*StampSessionDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StampSessionDelete not implemented")
+}
+func (UnimplementedPerformanceServiceServer) StampSessionList(context.Context, *StampSessionListRequest) (*StampSessionListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StampSessionList not implemented")
 }
 
-// UnsafeHeadendL2ServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to HeadendL2ServiceServer will
+// UnsafePerformanceServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PerformanceServiceServer will
 // result in compilation errors.
-type UnsafeHeadendL2ServiceServer interface {
-	mustEmbedUnimplementedHeadendL2ServiceServer()
+type UnsafePerformanceServiceServer interface {
+	mustEmbedUnimplementedPerformanceServiceServer()
 }
 
-func RegisterHeadendL2ServiceServer(s grpc.ServiceRegistrar, srv HeadendL2ServiceServer) {
-	s.RegisterService(&HeadendL2Service_ServiceDesc, srv)
+func RegisterPerformanceServiceServer(s grpc.ServiceRegistrar, srv PerformanceServiceServer) {
+	s.RegisterService(&PerformanceService_ServiceDesc, srv)
 }
 
-func _HeadendL2Service_HeadendL2Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(HeadendL2CreateRequest)
+func _PerformanceService_StampReflectorCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StampReflectorCreateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(HeadendL2ServiceServer).HeadendL2Create(ctx, in)
+		return srv.(PerformanceServiceServer).StampReflectorCreate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: HeadendL2Service_HeadendL2Create_FullMethodName,
+		FullMethod: PerformanceService_StampReflectorCreate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HeadendL2ServiceServer).HeadendL2Create(ctx, req.(*HeadendL2CreateRequest))
+		return srv.(PerformanceServiceServer).StampReflectorCreate(ctx, req.(*StampReflectorCreateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _HeadendL2Service_HeadendL2Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(HeadendL2DeleteRequest)
+func _PerformanceService_StampReflectorDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StampReflectorDeleteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(HeadendL2ServiceServer).HeadendL2Delete(ctx, in)
+		return srv.(PerformanceServiceServer).StampReflectorDelete(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: HeadendL2Service_HeadendL2Delete_FullMethodName,
+		FullMethod: PerformanceService_StampReflectorDelete_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HeadendL2ServiceServer).HeadendL2Delete(ctx, req.(*HeadendL2DeleteRequest))
+		return srv.(PerformanceServiceServer).StampReflectorDelete(ctx, req.(*StampReflectorDeleteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _HeadendL2Service_HeadendL2List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(HeadendL2ListRequest)
+func _PerformanceService_StampReflectorList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StampReflectorListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(HeadendL2ServiceServer).HeadendL2List(ctx, in)
+		return srv.(PerformanceServiceServer).StampReflectorList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: HeadendL2Service_HeadendL2List_FullMethodName,
+		FullMethod: PerformanceService_StampReflectorList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HeadendL2ServiceServer).HeadendL2List(ctx, req.(*HeadendL2ListRequest))
+		return srv.(PerformanceServiceServer).StampReflectorList(ctx, req.(*StampReflectorListRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _HeadendL2Service_HeadendL2Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(HeadendL2GetRequest)
+func _PerformanceService_StampSessionCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StampSessionCreateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(HeadendL2ServiceServer).HeadendL2Get(ctx, in)
+		return srv.(PerformanceServiceServer).StampSessionCreate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: HeadendL2Service_HeadendL2Get_FullMethodName,
+		FullMethod: PerformanceService_StampSessionCreate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HeadendL2ServiceServer).HeadendL2Get(ctx, req.(*HeadendL2GetRequest))
+		return srv.(PerformanceServiceServer).StampSessionCreate(ctx, req.(*StampSessionCreateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _HeadendL2Service_HeadendL2Flush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(HeadendL2FlushRequest)
+func _PerformanceService_StampSessionDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StampSessionDeleteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(HeadendL2ServiceServer).HeadendL2Flush(ctx, in)
+		return srv.(PerformanceServiceServer).StampSessionDelete(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: HeadendL2Service_HeadendL2Flush_FullMethodName,
+		FullMethod: PerformanceService_StampSessionDelete_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HeadendL2ServiceServer).HeadendL2Flush(ctx, req.(*HeadendL2FlushRequest))
+		return srv.(PerformanceServiceServer).StampSessionDelete(ctx, req.(*StampSessionDeleteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// HeadendL2Service_ServiceDesc is the grpc.ServiceDesc for HeadendL2Service service.
+func _PerformanceService_StampSessionList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StampSessionListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PerformanceServiceServer).StampSessionList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PerformanceService_StampSessionList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PerformanceServiceServer).StampSessionList(ctx, req.(*StampSessionListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PerformanceService_ServiceDesc is the grpc.ServiceDesc for PerformanceService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var HeadendL2Service_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "vinbero.v1.HeadendL2Service",
-	HandlerType: (*HeadendL2ServiceServer)(nil),
+var PerformanceService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "vinbero.v1.PerformanceService",
+	HandlerType: (*PerformanceServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "HeadendL2Create",
-			Handler:    _HeadendL2Service_HeadendL2Create_Handler,
+			MethodName: "StampReflectorCreate",
+			Handler:    _PerformanceService_StampReflectorCreate_Handler,
 		},
 		{
-			MethodName: "HeadendL2Delete",
-			Handler:    _HeadendL2Service_HeadendL2Delete_Handler,
+			MethodName: "StampReflectorDelete",
+			Handler:    _PerformanceService_StampReflectorDelete_Handler,
 		},
 		{
-			MethodName: "HeadendL2List",
-			Handler:    _HeadendL2Service_HeadendL2List_Handler,
+			MethodName: "StampReflectorList",
+			Handler:    _PerformanceService_StampReflectorList_Handler,
 		},
 		{
-			MethodName: "HeadendL2Get",
-			Handler:    _HeadendL2Service_HeadendL2Get_Handler,
+			MethodName: "StampSessionCreate",
+			Handler:    _PerformanceService_StampSessionCreate_Handler,
 		},
 		{
-			MethodName: "HeadendL2Flush",
-			Handler:    _HeadendL2Service_HeadendL2Flush_Handler,
+			MethodName: "StampSessionDelete",
+			Handler:    _PerformanceService_StampSessionDelete_Handler,
+		},
+		{
+			MethodName: "StampSessionList",
+			Handler:    _PerformanceService_StampSessionList_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -2070,198 +4004,93 @@ var HeadendL2Service_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	StatsService_StatsShow_FullMethodName      = "/vinbero.v1.StatsService/StatsShow"
-	StatsService_StatsReset_FullMethodName     = "/vinbero.v1.StatsService/StatsReset"
-	StatsService_StatsSlotShow_FullMethodName  = "/vinbero.v1.StatsService/StatsSlotShow"
-	StatsService_StatsSlotReset_FullMethodName = "/vinbero.v1.StatsService/StatsSlotReset"
+	DaemonService_Reload_FullMethodName = "/vinbero.v1.DaemonService/Reload"
 )
 
-// StatsServiceClient is the client API for StatsService service.
+// DaemonServiceClient is the client API for DaemonService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-type StatsServiceClient interface {
-	StatsShow(ctx context.Context, in *StatsShowRequest, opts ...grpc.CallOption) (*StatsShowResponse, error)
-	StatsReset(ctx context.Context, in *StatsResetRequest, opts ...grpc.CallOption) (*StatsResetResponse, error)
-	StatsSlotShow(ctx context.Context, in *StatsSlotShowRequest, opts ...grpc.CallOption) (*StatsSlotShowResponse, error)
-	StatsSlotReset(ctx context.Context, in *StatsSlotResetRequest, opts ...grpc.CallOption) (*StatsSlotResetResponse, error)
+type DaemonServiceClient interface {
+	// Reload re-reads the config file and reconciles its config: section
+	// with the maps, like SIGHUP. Other sections only take effect after a
+	// restart and are reported in restart_required.
+	Reload(ctx context.Context, in *ReloadRequest, opts ...grpc.CallOption) (*ReloadResponse, error)
 }
 
-type statsServiceClient struct {
+type daemonServiceClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewStatsServiceClient(cc grpc.ClientConnInterface) StatsServiceClient {
-	return &statsServiceClient{cc}
-}
-
-func (c *statsServiceClient) StatsShow(ctx context.Context, in *StatsShowRequest, opts ...grpc.CallOption) (*StatsShowResponse, error) {
-	out := new(StatsShowResponse)
-	err := c.cc.Invoke(ctx, StatsService_StatsShow_FullMethodName, in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *statsServiceClient) StatsReset(ctx context.Context, in *StatsResetRequest, opts ...grpc.CallOption) (*StatsResetResponse, error) {
-	out := new(StatsResetResponse)
-	err := c.cc.Invoke(ctx, StatsService_StatsReset_FullMethodName, in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *statsServiceClient) StatsSlotShow(ctx context.Context, in *StatsSlotShowRequest, opts ...grpc.CallOption) (*StatsSlotShowResponse, error) {
-	out := new(StatsSlotShowResponse)
-	err := c.cc.Invoke(ctx, StatsService_StatsSlotShow_FullMethodName, in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func NewDaemonServiceClient(cc grpc.ClientConnInterface) DaemonServiceClient {
+	return &daemonServiceClient{cc}
 }
 
-func (c *statsServiceClient) StatsSlotReset(ctx context.Context, in *StatsSlotResetRequest, opts ...grpc.CallOption) (*StatsSlotResetResponse, error) {
-	out := new(StatsSlotResetResponse)
-	err := c.cc.Invoke(ctx, StatsService_StatsSlotReset_FullMethodName, in, out, opts...)
+func (c *daemonServiceClient) Reload(ctx context.Context, in *ReloadRequest, opts ...grpc.CallOption) (*ReloadResponse, error) {
+	out := new(ReloadResponse)
+	err := c.cc.Invoke(ctx, DaemonService_Reload_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// StatsServiceServer is the server API for StatsService service.
-// All implementations should embed UnimplementedStatsServiceServer
+// DaemonServiceServer is the server API for DaemonService service.
+// All implementations should embed UnimplementedDaemonServiceServer
 // for forward compatibility
-type StatsServiceServer interface {
-	StatsShow(context.Context, *StatsShowRequest) (*StatsShowResponse, error)
-	StatsReset(context.Context, *StatsResetRequest) (*StatsResetResponse, error)
-	StatsSlotShow(context.Context, *StatsSlotShowRequest) (*StatsSlotShowResponse, error)
-	StatsSlotReset(context.Context, *StatsSlotResetRequest) (*StatsSlotResetResponse, error)
+type DaemonServiceServer interface {
+	// Reload re-reads the config file and reconciles its config: section
+	// with the maps, like SIGHUP. Other sections only take effect after a
+	// restart and are reported in restart_required.
+	Reload(context.Context, *ReloadRequest) (*ReloadResponse, error)
 }
 
-// UnimplementedStatsServiceServer should be embedded to have forward compatible implementations.
-type UnimplementedStatsServiceServer struct {
+// UnimplementedDaemonServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedDaemonServiceServer struct {
 }
 
-func (UnimplementedStatsServiceServer) StatsShow(context.Context, *StatsShowRequest) (*StatsShowResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StatsShow not implemented")
-}
-func (UnimplementedStatsServiceServer) StatsReset(context.Context, *StatsResetRequest) (*StatsResetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StatsReset not implemented")
-}
-func (UnimplementedStatsServiceServer) StatsSlotShow(context.Context, *StatsSlotShowRequest) (*StatsSlotShowResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StatsSlotShow not implemented")
-}
-func (UnimplementedStatsServiceServer) StatsSlotReset(context.Context, *StatsSlotResetRequest) (*StatsSlotResetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StatsSlotReset not implemented")
+func (UnimplementedDaemonServiceServer) Reload(context.Context, *ReloadRequest) (*ReloadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reload not implemented")
 }
 
-// UnsafeStatsServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to StatsServiceServer will
+// UnsafeDaemonServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DaemonServiceServer will
 // result in compilation errors.
-type UnsafeStatsServiceServer interface {
-	mustEmbedUnimplementedStatsServiceServer()
-}
-
-func RegisterStatsServiceServer(s grpc.ServiceRegistrar, srv StatsServiceServer) {
-	s.RegisterService(&StatsService_ServiceDesc, srv)
-}
-
-func _StatsService_StatsShow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StatsShowRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(StatsServiceServer).StatsShow(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: StatsService_StatsShow_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StatsServiceServer).StatsShow(ctx, req.(*StatsShowRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _StatsService_StatsReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StatsResetRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(StatsServiceServer).StatsReset(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: StatsService_StatsReset_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StatsServiceServer).StatsReset(ctx, req.(*StatsResetRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type UnsafeDaemonServiceServer interface {
+	mustEmbedUnimplementedDaemonServiceServer()
 }
 
-func _StatsService_StatsSlotShow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StatsSlotShowRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(StatsServiceServer).StatsSlotShow(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: StatsService_StatsSlotShow_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StatsServiceServer).StatsSlotShow(ctx, req.(*StatsSlotShowRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func RegisterDaemonServiceServer(s grpc.ServiceRegistrar, srv DaemonServiceServer) {
+	s.RegisterService(&DaemonService_ServiceDesc, srv)
 }
 
-func _StatsService_StatsSlotReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StatsSlotResetRequest)
+func _DaemonService_Reload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReloadRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StatsServiceServer).StatsSlotReset(ctx, in)
+		return srv.(DaemonServiceServer).Reload(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StatsService_StatsSlotReset_FullMethodName,
+		FullMethod: DaemonService_Reload_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StatsServiceServer).StatsSlotReset(ctx, req.(*StatsSlotResetRequest))
+		return srv.(DaemonServiceServer).Reload(ctx, req.(*ReloadRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// StatsService_ServiceDesc is the grpc.ServiceDesc for StatsService service.
+// DaemonService_ServiceDesc is the grpc.ServiceDesc for DaemonService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var StatsService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "vinbero.v1.StatsService",
-	HandlerType: (*StatsServiceServer)(nil),
+var DaemonService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "vinbero.v1.DaemonService",
+	HandlerType: (*DaemonServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "StatsShow",
-			Handler:    _StatsService_StatsShow_Handler,
-		},
-		{
-			MethodName: "StatsReset",
-			Handler:    _StatsService_StatsReset_Handler,
-		},
-		{
-			MethodName: "StatsSlotShow",
-			Handler:    _StatsService_StatsSlotShow_Handler,
-		},
-		{
-			MethodName: "StatsSlotReset",
-			Handler:    _StatsService_StatsSlotReset_Handler,
+			MethodName: "Reload",
+			Handler:    _DaemonService_Reload_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -0,0 +1,229 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: vinbero/v1/system.proto
+
+package vinberov1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/takehaya/vinbero/api/vinbero/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// SystemServiceName is the fully-qualified name of the SystemService service.
+	SystemServiceName = "vinbero.v1.SystemService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// SystemServiceExportProcedure is the fully-qualified name of the SystemService's Export RPC.
+	SystemServiceExportProcedure = "/vinbero.v1.SystemService/Export"
+	// SystemServiceImportProcedure is the fully-qualified name of the SystemService's Import RPC.
+	SystemServiceImportProcedure = "/vinbero.v1.SystemService/Import"
+	// SystemServiceMapUsageProcedure is the fully-qualified name of the SystemService's MapUsage RPC.
+	SystemServiceMapUsageProcedure = "/vinbero.v1.SystemService/MapUsage"
+	// SystemServiceMapEventListProcedure is the fully-qualified name of the SystemService's
+	// MapEventList RPC.
+	SystemServiceMapEventListProcedure = "/vinbero.v1.SystemService/MapEventList"
+	// SystemServiceMapResizeProcedure is the fully-qualified name of the SystemService's MapResize RPC.
+	SystemServiceMapResizeProcedure = "/vinbero.v1.SystemService/MapResize"
+)
+
+// These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
+var (
+	systemServiceServiceDescriptor            = v1.File_vinbero_v1_system_proto.Services().ByName("SystemService")
+	systemServiceExportMethodDescriptor       = systemServiceServiceDescriptor.Methods().ByName("Export")
+	systemServiceImportMethodDescriptor       = systemServiceServiceDescriptor.Methods().ByName("Import")
+	systemServiceMapUsageMethodDescriptor     = systemServiceServiceDescriptor.Methods().ByName("MapUsage")
+	systemServiceMapEventListMethodDescriptor = systemServiceServiceDescriptor.Methods().ByName("MapEventList")
+	systemServiceMapResizeMethodDescriptor    = systemServiceServiceDescriptor.Methods().ByName("MapResize")
+)
+
+// SystemServiceClient is a client for the vinbero.v1.SystemService service.
+type SystemServiceClient interface {
+	Export(context.Context, *connect.Request[v1.ExportRequest]) (*connect.Response[v1.ExportResponse], error)
+	Import(context.Context, *connect.Request[v1.ImportRequest]) (*connect.Response[v1.ImportResponse], error)
+	MapUsage(context.Context, *connect.Request[v1.MapUsageRequest]) (*connect.Response[v1.MapUsageResponse], error)
+	MapEventList(context.Context, *connect.Request[v1.MapEventListRequest]) (*connect.Response[v1.MapEventListResponse], error)
+	MapResize(context.Context, *connect.Request[v1.MapResizeRequest]) (*connect.Response[v1.MapResizeResponse], error)
+}
+
+// NewSystemServiceClient constructs a client for the vinbero.v1.SystemService service. By default,
+// it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and
+// sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC()
+// or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewSystemServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) SystemServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &systemServiceClient{
+		export: connect.NewClient[v1.ExportRequest, v1.ExportResponse](
+			httpClient,
+			baseURL+SystemServiceExportProcedure,
+			connect.WithSchema(systemServiceExportMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		_import: connect.NewClient[v1.ImportRequest, v1.ImportResponse](
+			httpClient,
+			baseURL+SystemServiceImportProcedure,
+			connect.WithSchema(systemServiceImportMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		mapUsage: connect.NewClient[v1.MapUsageRequest, v1.MapUsageResponse](
+			httpClient,
+			baseURL+SystemServiceMapUsageProcedure,
+			connect.WithSchema(systemServiceMapUsageMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		mapEventList: connect.NewClient[v1.MapEventListRequest, v1.MapEventListResponse](
+			httpClient,
+			baseURL+SystemServiceMapEventListProcedure,
+			connect.WithSchema(systemServiceMapEventListMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		mapResize: connect.NewClient[v1.MapResizeRequest, v1.MapResizeResponse](
+			httpClient,
+			baseURL+SystemServiceMapResizeProcedure,
+			connect.WithSchema(systemServiceMapResizeMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// systemServiceClient implements SystemServiceClient.
+type systemServiceClient struct {
+	export       *connect.Client[v1.ExportRequest, v1.ExportResponse]
+	_import      *connect.Client[v1.ImportRequest, v1.ImportResponse]
+	mapUsage     *connect.Client[v1.MapUsageRequest, v1.MapUsageResponse]
+	mapEventList *connect.Client[v1.MapEventListRequest, v1.MapEventListResponse]
+	mapResize    *connect.Client[v1.MapResizeRequest, v1.MapResizeResponse]
+}
+
+// Export calls vinbero.v1.SystemService.Export.
+func (c *systemServiceClient) Export(ctx context.Context, req *connect.Request[v1.ExportRequest]) (*connect.Response[v1.ExportResponse], error) {
+	return c.export.CallUnary(ctx, req)
+}
+
+// Import calls vinbero.v1.SystemService.Import.
+func (c *systemServiceClient) Import(ctx context.Context, req *connect.Request[v1.ImportRequest]) (*connect.Response[v1.ImportResponse], error) {
+	return c._import.CallUnary(ctx, req)
+}
+
+// MapUsage calls vinbero.v1.SystemService.MapUsage.
+func (c *systemServiceClient) MapUsage(ctx context.Context, req *connect.Request[v1.MapUsageRequest]) (*connect.Response[v1.MapUsageResponse], error) {
+	return c.mapUsage.CallUnary(ctx, req)
+}
+
+// MapEventList calls vinbero.v1.SystemService.MapEventList.
+func (c *systemServiceClient) MapEventList(ctx context.Context, req *connect.Request[v1.MapEventListRequest]) (*connect.Response[v1.MapEventListResponse], error) {
+	return c.mapEventList.CallUnary(ctx, req)
+}
+
+// MapResize calls vinbero.v1.SystemService.MapResize.
+func (c *systemServiceClient) MapResize(ctx context.Context, req *connect.Request[v1.MapResizeRequest]) (*connect.Response[v1.MapResizeResponse], error) {
+	return c.mapResize.CallUnary(ctx, req)
+}
+
+// SystemServiceHandler is an implementation of the vinbero.v1.SystemService service.
+type SystemServiceHandler interface {
+	Export(context.Context, *connect.Request[v1.ExportRequest]) (*connect.Response[v1.ExportResponse], error)
+	Import(context.Context, *connect.Request[v1.ImportRequest]) (*connect.Response[v1.ImportResponse], error)
+	MapUsage(context.Context, *connect.Request[v1.MapUsageRequest]) (*connect.Response[v1.MapUsageResponse], error)
+	MapEventList(context.Context, *connect.Request[v1.MapEventListRequest]) (*connect.Response[v1.MapEventListResponse], error)
+	MapResize(context.Context, *connect.Request[v1.MapResizeRequest]) (*connect.Response[v1.MapResizeResponse], error)
+}
+
+// NewSystemServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewSystemServiceHandler(svc SystemServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	systemServiceExportHandler := connect.NewUnaryHandler(
+		SystemServiceExportProcedure,
+		svc.Export,
+		connect.WithSchema(systemServiceExportMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	systemServiceImportHandler := connect.NewUnaryHandler(
+		SystemServiceImportProcedure,
+		svc.Import,
+		connect.WithSchema(systemServiceImportMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	systemServiceMapUsageHandler := connect.NewUnaryHandler(
+		SystemServiceMapUsageProcedure,
+		svc.MapUsage,
+		connect.WithSchema(systemServiceMapUsageMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	systemServiceMapEventListHandler := connect.NewUnaryHandler(
+		SystemServiceMapEventListProcedure,
+		svc.MapEventList,
+		connect.WithSchema(systemServiceMapEventListMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	systemServiceMapResizeHandler := connect.NewUnaryHandler(
+		SystemServiceMapResizeProcedure,
+		svc.MapResize,
+		connect.WithSchema(systemServiceMapResizeMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/vinbero.v1.SystemService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case SystemServiceExportProcedure:
+			systemServiceExportHandler.ServeHTTP(w, r)
+		case SystemServiceImportProcedure:
+			systemServiceImportHandler.ServeHTTP(w, r)
+		case SystemServiceMapUsageProcedure:
+			systemServiceMapUsageHandler.ServeHTTP(w, r)
+		case SystemServiceMapEventListProcedure:
+			systemServiceMapEventListHandler.ServeHTTP(w, r)
+		case SystemServiceMapResizeProcedure:
+			systemServiceMapResizeHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedSystemServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedSystemServiceHandler struct{}
+
+func (UnimplementedSystemServiceHandler) Export(context.Context, *connect.Request[v1.ExportRequest]) (*connect.Response[v1.ExportResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.SystemService.Export is not implemented"))
+}
+
+func (UnimplementedSystemServiceHandler) Import(context.Context, *connect.Request[v1.ImportRequest]) (*connect.Response[v1.ImportResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.SystemService.Import is not implemented"))
+}
+
+func (UnimplementedSystemServiceHandler) MapUsage(context.Context, *connect.Request[v1.MapUsageRequest]) (*connect.Response[v1.MapUsageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.SystemService.MapUsage is not implemented"))
+}
+
+func (UnimplementedSystemServiceHandler) MapEventList(context.Context, *connect.Request[v1.MapEventListRequest]) (*connect.Response[v1.MapEventListResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.SystemService.MapEventList is not implemented"))
+}
+
+func (UnimplementedSystemServiceHandler) MapResize(context.Context, *connect.Request[v1.MapResizeRequest]) (*connect.Response[v1.MapResizeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.SystemService.MapResize is not implemented"))
+}
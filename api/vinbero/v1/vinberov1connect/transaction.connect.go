@@ -0,0 +1,113 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: vinbero/v1/transaction.proto
+
+package vinberov1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/takehaya/vinbero/api/vinbero/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// TransactionServiceName is the fully-qualified name of the TransactionService service.
+	TransactionServiceName = "vinbero.v1.TransactionService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// TransactionServiceTransactionCommitProcedure is the fully-qualified name of the
+	// TransactionService's TransactionCommit RPC.
+	TransactionServiceTransactionCommitProcedure = "/vinbero.v1.TransactionService/TransactionCommit"
+)
+
+// These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
+var (
+	transactionServiceServiceDescriptor                 = v1.File_vinbero_v1_transaction_proto.Services().ByName("TransactionService")
+	transactionServiceTransactionCommitMethodDescriptor = transactionServiceServiceDescriptor.Methods().ByName("TransactionCommit")
+)
+
+// TransactionServiceClient is a client for the vinbero.v1.TransactionService service.
+type TransactionServiceClient interface {
+	TransactionCommit(context.Context, *connect.Request[v1.TransactionCommitRequest]) (*connect.Response[v1.TransactionCommitResponse], error)
+}
+
+// NewTransactionServiceClient constructs a client for the vinbero.v1.TransactionService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewTransactionServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) TransactionServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &transactionServiceClient{
+		transactionCommit: connect.NewClient[v1.TransactionCommitRequest, v1.TransactionCommitResponse](
+			httpClient,
+			baseURL+TransactionServiceTransactionCommitProcedure,
+			connect.WithSchema(transactionServiceTransactionCommitMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// transactionServiceClient implements TransactionServiceClient.
+type transactionServiceClient struct {
+	transactionCommit *connect.Client[v1.TransactionCommitRequest, v1.TransactionCommitResponse]
+}
+
+// TransactionCommit calls vinbero.v1.TransactionService.TransactionCommit.
+func (c *transactionServiceClient) TransactionCommit(ctx context.Context, req *connect.Request[v1.TransactionCommitRequest]) (*connect.Response[v1.TransactionCommitResponse], error) {
+	return c.transactionCommit.CallUnary(ctx, req)
+}
+
+// TransactionServiceHandler is an implementation of the vinbero.v1.TransactionService service.
+type TransactionServiceHandler interface {
+	TransactionCommit(context.Context, *connect.Request[v1.TransactionCommitRequest]) (*connect.Response[v1.TransactionCommitResponse], error)
+}
+
+// NewTransactionServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewTransactionServiceHandler(svc TransactionServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	transactionServiceTransactionCommitHandler := connect.NewUnaryHandler(
+		TransactionServiceTransactionCommitProcedure,
+		svc.TransactionCommit,
+		connect.WithSchema(transactionServiceTransactionCommitMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/vinbero.v1.TransactionService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case TransactionServiceTransactionCommitProcedure:
+			transactionServiceTransactionCommitHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedTransactionServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedTransactionServiceHandler struct{}
+
+func (UnimplementedTransactionServiceHandler) TransactionCommit(context.Context, *connect.Request[v1.TransactionCommitRequest]) (*connect.Response[v1.TransactionCommitResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.TransactionService.TransactionCommit is not implemented"))
+}
@@ -31,8 +31,14 @@ const (
 	FdbServiceName = "vinbero.v1.FdbService"
 	// VlanTableServiceName is the fully-qualified name of the VlanTableService service.
 	VlanTableServiceName = "vinbero.v1.VlanTableService"
+	// SrDomainServiceName is the fully-qualified name of the SrDomainService service.
+	SrDomainServiceName = "vinbero.v1.SrDomainService"
 	// BdPeerServiceName is the fully-qualified name of the BdPeerService service.
 	BdPeerServiceName = "vinbero.v1.BdPeerService"
+	// VniMappingServiceName is the fully-qualified name of the VniMappingService service.
+	VniMappingServiceName = "vinbero.v1.VniMappingService"
+	// BridgeDomainServiceName is the fully-qualified name of the BridgeDomainService service.
+	BridgeDomainServiceName = "vinbero.v1.BridgeDomainService"
 	// EthernetSegmentServiceName is the fully-qualified name of the EthernetSegmentService service.
 	EthernetSegmentServiceName = "vinbero.v1.EthernetSegmentService"
 	// NetworkResourceServiceName is the fully-qualified name of the NetworkResourceService service.
@@ -41,6 +47,12 @@ const (
 	HeadendL2ServiceName = "vinbero.v1.HeadendL2Service"
 	// StatsServiceName is the fully-qualified name of the StatsService service.
 	StatsServiceName = "vinbero.v1.StatsService"
+	// OamServiceName is the fully-qualified name of the OamService service.
+	OamServiceName = "vinbero.v1.OamService"
+	// PerformanceServiceName is the fully-qualified name of the PerformanceService service.
+	PerformanceServiceName = "vinbero.v1.PerformanceService"
+	// DaemonServiceName is the fully-qualified name of the DaemonService service.
+	DaemonServiceName = "vinbero.v1.DaemonService"
 )
 
 // These constants are the fully-qualified names of the RPCs defined in this package. They're
@@ -54,6 +66,9 @@ const (
 	// SidFunctionServiceSidFunctionCreateProcedure is the fully-qualified name of the
 	// SidFunctionService's SidFunctionCreate RPC.
 	SidFunctionServiceSidFunctionCreateProcedure = "/vinbero.v1.SidFunctionService/SidFunctionCreate"
+	// SidFunctionServiceSidFunctionUpdateProcedure is the fully-qualified name of the
+	// SidFunctionService's SidFunctionUpdate RPC.
+	SidFunctionServiceSidFunctionUpdateProcedure = "/vinbero.v1.SidFunctionService/SidFunctionUpdate"
 	// SidFunctionServiceSidFunctionDeleteProcedure is the fully-qualified name of the
 	// SidFunctionService's SidFunctionDelete RPC.
 	SidFunctionServiceSidFunctionDeleteProcedure = "/vinbero.v1.SidFunctionService/SidFunctionDelete"
@@ -69,6 +84,9 @@ const (
 	// Headendv4ServiceHeadendv4CreateProcedure is the fully-qualified name of the Headendv4Service's
 	// Headendv4Create RPC.
 	Headendv4ServiceHeadendv4CreateProcedure = "/vinbero.v1.Headendv4Service/Headendv4Create"
+	// Headendv4ServiceHeadendv4UpdateProcedure is the fully-qualified name of the Headendv4Service's
+	// Headendv4Update RPC.
+	Headendv4ServiceHeadendv4UpdateProcedure = "/vinbero.v1.Headendv4Service/Headendv4Update"
 	// Headendv4ServiceHeadendv4DeleteProcedure is the fully-qualified name of the Headendv4Service's
 	// Headendv4Delete RPC.
 	Headendv4ServiceHeadendv4DeleteProcedure = "/vinbero.v1.Headendv4Service/Headendv4Delete"
@@ -84,6 +102,9 @@ const (
 	// Headendv6ServiceHeadendv6CreateProcedure is the fully-qualified name of the Headendv6Service's
 	// Headendv6Create RPC.
 	Headendv6ServiceHeadendv6CreateProcedure = "/vinbero.v1.Headendv6Service/Headendv6Create"
+	// Headendv6ServiceHeadendv6UpdateProcedure is the fully-qualified name of the Headendv6Service's
+	// Headendv6Update RPC.
+	Headendv6ServiceHeadendv6UpdateProcedure = "/vinbero.v1.Headendv6Service/Headendv6Update"
 	// Headendv6ServiceHeadendv6DeleteProcedure is the fully-qualified name of the Headendv6Service's
 	// Headendv6Delete RPC.
 	Headendv6ServiceHeadendv6DeleteProcedure = "/vinbero.v1.Headendv6Service/Headendv6Delete"
@@ -107,6 +128,9 @@ const (
 	// VlanTableServiceVlanTableCreateProcedure is the fully-qualified name of the VlanTableService's
 	// VlanTableCreate RPC.
 	VlanTableServiceVlanTableCreateProcedure = "/vinbero.v1.VlanTableService/VlanTableCreate"
+	// VlanTableServiceVlanTableUpdateProcedure is the fully-qualified name of the VlanTableService's
+	// VlanTableUpdate RPC.
+	VlanTableServiceVlanTableUpdateProcedure = "/vinbero.v1.VlanTableService/VlanTableUpdate"
 	// VlanTableServiceVlanTableDeleteProcedure is the fully-qualified name of the VlanTableService's
 	// VlanTableDelete RPC.
 	VlanTableServiceVlanTableDeleteProcedure = "/vinbero.v1.VlanTableService/VlanTableDelete"
@@ -116,9 +140,24 @@ const (
 	// VlanTableServiceVlanTableFlushProcedure is the fully-qualified name of the VlanTableService's
 	// VlanTableFlush RPC.
 	VlanTableServiceVlanTableFlushProcedure = "/vinbero.v1.VlanTableService/VlanTableFlush"
+	// SrDomainServiceSrDomainCreateProcedure is the fully-qualified name of the SrDomainService's
+	// SrDomainCreate RPC.
+	SrDomainServiceSrDomainCreateProcedure = "/vinbero.v1.SrDomainService/SrDomainCreate"
+	// SrDomainServiceSrDomainDeleteProcedure is the fully-qualified name of the SrDomainService's
+	// SrDomainDelete RPC.
+	SrDomainServiceSrDomainDeleteProcedure = "/vinbero.v1.SrDomainService/SrDomainDelete"
+	// SrDomainServiceSrDomainListProcedure is the fully-qualified name of the SrDomainService's
+	// SrDomainList RPC.
+	SrDomainServiceSrDomainListProcedure = "/vinbero.v1.SrDomainService/SrDomainList"
+	// SrDomainServiceSrDomainFlushProcedure is the fully-qualified name of the SrDomainService's
+	// SrDomainFlush RPC.
+	SrDomainServiceSrDomainFlushProcedure = "/vinbero.v1.SrDomainService/SrDomainFlush"
 	// BdPeerServiceBdPeerCreateProcedure is the fully-qualified name of the BdPeerService's
 	// BdPeerCreate RPC.
 	BdPeerServiceBdPeerCreateProcedure = "/vinbero.v1.BdPeerService/BdPeerCreate"
+	// BdPeerServiceBdPeerUpdateProcedure is the fully-qualified name of the BdPeerService's
+	// BdPeerUpdate RPC.
+	BdPeerServiceBdPeerUpdateProcedure = "/vinbero.v1.BdPeerService/BdPeerUpdate"
 	// BdPeerServiceBdPeerDeleteProcedure is the fully-qualified name of the BdPeerService's
 	// BdPeerDelete RPC.
 	BdPeerServiceBdPeerDeleteProcedure = "/vinbero.v1.BdPeerService/BdPeerDelete"
@@ -128,9 +167,72 @@ const (
 	// BdPeerServiceBdPeerFlushProcedure is the fully-qualified name of the BdPeerService's BdPeerFlush
 	// RPC.
 	BdPeerServiceBdPeerFlushProcedure = "/vinbero.v1.BdPeerService/BdPeerFlush"
+	// VniMappingServiceVniMappingCreateProcedure is the fully-qualified name of the VniMappingService's
+	// VniMappingCreate RPC.
+	VniMappingServiceVniMappingCreateProcedure = "/vinbero.v1.VniMappingService/VniMappingCreate"
+	// VniMappingServiceVniMappingDeleteProcedure is the fully-qualified name of the VniMappingService's
+	// VniMappingDelete RPC.
+	VniMappingServiceVniMappingDeleteProcedure = "/vinbero.v1.VniMappingService/VniMappingDelete"
+	// VniMappingServiceVniMappingListProcedure is the fully-qualified name of the VniMappingService's
+	// VniMappingList RPC.
+	VniMappingServiceVniMappingListProcedure = "/vinbero.v1.VniMappingService/VniMappingList"
+	// VniMappingServiceVniMappingFlushProcedure is the fully-qualified name of the VniMappingService's
+	// VniMappingFlush RPC.
+	VniMappingServiceVniMappingFlushProcedure = "/vinbero.v1.VniMappingService/VniMappingFlush"
+	// BridgeDomainServiceBridgeDomainCreateProcedure is the fully-qualified name of the
+	// BridgeDomainService's BridgeDomainCreate RPC.
+	BridgeDomainServiceBridgeDomainCreateProcedure = "/vinbero.v1.BridgeDomainService/BridgeDomainCreate"
+	// BridgeDomainServiceBridgeDomainDeleteProcedure is the fully-qualified name of the
+	// BridgeDomainService's BridgeDomainDelete RPC.
+	BridgeDomainServiceBridgeDomainDeleteProcedure = "/vinbero.v1.BridgeDomainService/BridgeDomainDelete"
+	// BridgeDomainServiceBridgeDomainGetProcedure is the fully-qualified name of the
+	// BridgeDomainService's BridgeDomainGet RPC.
+	BridgeDomainServiceBridgeDomainGetProcedure = "/vinbero.v1.BridgeDomainService/BridgeDomainGet"
+	// BridgeDomainServiceBridgeDomainListProcedure is the fully-qualified name of the
+	// BridgeDomainService's BridgeDomainList RPC.
+	BridgeDomainServiceBridgeDomainListProcedure = "/vinbero.v1.BridgeDomainService/BridgeDomainList"
+	// BridgeDomainServiceMacLimitSetProcedure is the fully-qualified name of the BridgeDomainService's
+	// MacLimitSet RPC.
+	BridgeDomainServiceMacLimitSetProcedure = "/vinbero.v1.BridgeDomainService/MacLimitSet"
+	// BridgeDomainServiceMacLimitDeleteProcedure is the fully-qualified name of the
+	// BridgeDomainService's MacLimitDelete RPC.
+	BridgeDomainServiceMacLimitDeleteProcedure = "/vinbero.v1.BridgeDomainService/MacLimitDelete"
+	// BridgeDomainServiceMacLimitListProcedure is the fully-qualified name of the BridgeDomainService's
+	// MacLimitList RPC.
+	BridgeDomainServiceMacLimitListProcedure = "/vinbero.v1.BridgeDomainService/MacLimitList"
+	// BridgeDomainServiceStormControlSetProcedure is the fully-qualified name of the
+	// BridgeDomainService's StormControlSet RPC.
+	BridgeDomainServiceStormControlSetProcedure = "/vinbero.v1.BridgeDomainService/StormControlSet"
+	// BridgeDomainServiceStormControlDeleteProcedure is the fully-qualified name of the
+	// BridgeDomainService's StormControlDelete RPC.
+	BridgeDomainServiceStormControlDeleteProcedure = "/vinbero.v1.BridgeDomainService/StormControlDelete"
+	// BridgeDomainServiceStormControlListProcedure is the fully-qualified name of the
+	// BridgeDomainService's StormControlList RPC.
+	BridgeDomainServiceStormControlListProcedure = "/vinbero.v1.BridgeDomainService/StormControlList"
+	// BridgeDomainServiceNeighborCreateProcedure is the fully-qualified name of the
+	// BridgeDomainService's NeighborCreate RPC.
+	BridgeDomainServiceNeighborCreateProcedure = "/vinbero.v1.BridgeDomainService/NeighborCreate"
+	// BridgeDomainServiceNeighborDeleteProcedure is the fully-qualified name of the
+	// BridgeDomainService's NeighborDelete RPC.
+	BridgeDomainServiceNeighborDeleteProcedure = "/vinbero.v1.BridgeDomainService/NeighborDelete"
+	// BridgeDomainServiceNeighborListProcedure is the fully-qualified name of the BridgeDomainService's
+	// NeighborList RPC.
+	BridgeDomainServiceNeighborListProcedure = "/vinbero.v1.BridgeDomainService/NeighborList"
+	// BridgeDomainServiceIrbRouteCreateProcedure is the fully-qualified name of the
+	// BridgeDomainService's IrbRouteCreate RPC.
+	BridgeDomainServiceIrbRouteCreateProcedure = "/vinbero.v1.BridgeDomainService/IrbRouteCreate"
+	// BridgeDomainServiceIrbRouteDeleteProcedure is the fully-qualified name of the
+	// BridgeDomainService's IrbRouteDelete RPC.
+	BridgeDomainServiceIrbRouteDeleteProcedure = "/vinbero.v1.BridgeDomainService/IrbRouteDelete"
+	// BridgeDomainServiceIrbRouteListProcedure is the fully-qualified name of the BridgeDomainService's
+	// IrbRouteList RPC.
+	BridgeDomainServiceIrbRouteListProcedure = "/vinbero.v1.BridgeDomainService/IrbRouteList"
 	// EthernetSegmentServiceEsCreateProcedure is the fully-qualified name of the
 	// EthernetSegmentService's EsCreate RPC.
 	EthernetSegmentServiceEsCreateProcedure = "/vinbero.v1.EthernetSegmentService/EsCreate"
+	// EthernetSegmentServiceEsUpdateProcedure is the fully-qualified name of the
+	// EthernetSegmentService's EsUpdate RPC.
+	EthernetSegmentServiceEsUpdateProcedure = "/vinbero.v1.EthernetSegmentService/EsUpdate"
 	// EthernetSegmentServiceEsDeleteProcedure is the fully-qualified name of the
 	// EthernetSegmentService's EsDelete RPC.
 	EthernetSegmentServiceEsDeleteProcedure = "/vinbero.v1.EthernetSegmentService/EsDelete"
@@ -164,6 +266,9 @@ const (
 	// HeadendL2ServiceHeadendL2CreateProcedure is the fully-qualified name of the HeadendL2Service's
 	// HeadendL2Create RPC.
 	HeadendL2ServiceHeadendL2CreateProcedure = "/vinbero.v1.HeadendL2Service/HeadendL2Create"
+	// HeadendL2ServiceHeadendL2UpdateProcedure is the fully-qualified name of the HeadendL2Service's
+	// HeadendL2Update RPC.
+	HeadendL2ServiceHeadendL2UpdateProcedure = "/vinbero.v1.HeadendL2Service/HeadendL2Update"
 	// HeadendL2ServiceHeadendL2DeleteProcedure is the fully-qualified name of the HeadendL2Service's
 	// HeadendL2Delete RPC.
 	HeadendL2ServiceHeadendL2DeleteProcedure = "/vinbero.v1.HeadendL2Service/HeadendL2Delete"
@@ -186,72 +291,147 @@ const (
 	// StatsServiceStatsSlotResetProcedure is the fully-qualified name of the StatsService's
 	// StatsSlotReset RPC.
 	StatsServiceStatsSlotResetProcedure = "/vinbero.v1.StatsService/StatsSlotReset"
+	// OamServiceOamPingProcedure is the fully-qualified name of the OamService's OamPing RPC.
+	OamServiceOamPingProcedure = "/vinbero.v1.OamService/OamPing"
+	// OamServiceOamTracerouteProcedure is the fully-qualified name of the OamService's OamTraceroute
+	// RPC.
+	OamServiceOamTracerouteProcedure = "/vinbero.v1.OamService/OamTraceroute"
+	// OamServiceOamPuntListProcedure is the fully-qualified name of the OamService's OamPuntList RPC.
+	OamServiceOamPuntListProcedure = "/vinbero.v1.OamService/OamPuntList"
+	// PerformanceServiceStampReflectorCreateProcedure is the fully-qualified name of the
+	// PerformanceService's StampReflectorCreate RPC.
+	PerformanceServiceStampReflectorCreateProcedure = "/vinbero.v1.PerformanceService/StampReflectorCreate"
+	// PerformanceServiceStampReflectorDeleteProcedure is the fully-qualified name of the
+	// PerformanceService's StampReflectorDelete RPC.
+	PerformanceServiceStampReflectorDeleteProcedure = "/vinbero.v1.PerformanceService/StampReflectorDelete"
+	// PerformanceServiceStampReflectorListProcedure is the fully-qualified name of the
+	// PerformanceService's StampReflectorList RPC.
+	PerformanceServiceStampReflectorListProcedure = "/vinbero.v1.PerformanceService/StampReflectorList"
+	// PerformanceServiceStampSessionCreateProcedure is the fully-qualified name of the
+	// PerformanceService's StampSessionCreate RPC.
+	PerformanceServiceStampSessionCreateProcedure = "/vinbero.v1.PerformanceService/StampSessionCreate"
+	// PerformanceServiceStampSessionDeleteProcedure is the fully-qualified name of the
+	// PerformanceService's StampSessionDelete RPC.
+	PerformanceServiceStampSessionDeleteProcedure = "/vinbero.v1.PerformanceService/StampSessionDelete"
+	// PerformanceServiceStampSessionListProcedure is the fully-qualified name of the
+	// PerformanceService's StampSessionList RPC.
+	PerformanceServiceStampSessionListProcedure = "/vinbero.v1.PerformanceService/StampSessionList"
+	// DaemonServiceReloadProcedure is the fully-qualified name of the DaemonService's Reload RPC.
+	DaemonServiceReloadProcedure = "/vinbero.v1.DaemonService/Reload"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
 var (
-	sidFunctionServiceServiceDescriptor                 = v1.File_vinbero_v1_vinbero_proto.Services().ByName("SidFunctionService")
-	sidFunctionServiceSidFunctionCreateMethodDescriptor = sidFunctionServiceServiceDescriptor.Methods().ByName("SidFunctionCreate")
-	sidFunctionServiceSidFunctionDeleteMethodDescriptor = sidFunctionServiceServiceDescriptor.Methods().ByName("SidFunctionDelete")
-	sidFunctionServiceSidFunctionListMethodDescriptor   = sidFunctionServiceServiceDescriptor.Methods().ByName("SidFunctionList")
-	sidFunctionServiceSidFunctionFlushMethodDescriptor  = sidFunctionServiceServiceDescriptor.Methods().ByName("SidFunctionFlush")
-	sidFunctionServiceSidFunctionGetMethodDescriptor    = sidFunctionServiceServiceDescriptor.Methods().ByName("SidFunctionGet")
-	headendv4ServiceServiceDescriptor                   = v1.File_vinbero_v1_vinbero_proto.Services().ByName("Headendv4Service")
-	headendv4ServiceHeadendv4CreateMethodDescriptor     = headendv4ServiceServiceDescriptor.Methods().ByName("Headendv4Create")
-	headendv4ServiceHeadendv4DeleteMethodDescriptor     = headendv4ServiceServiceDescriptor.Methods().ByName("Headendv4Delete")
-	headendv4ServiceHeadendv4ListMethodDescriptor       = headendv4ServiceServiceDescriptor.Methods().ByName("Headendv4List")
-	headendv4ServiceHeadendv4FlushMethodDescriptor      = headendv4ServiceServiceDescriptor.Methods().ByName("Headendv4Flush")
-	headendv4ServiceHeadendv4GetMethodDescriptor        = headendv4ServiceServiceDescriptor.Methods().ByName("Headendv4Get")
-	headendv6ServiceServiceDescriptor                   = v1.File_vinbero_v1_vinbero_proto.Services().ByName("Headendv6Service")
-	headendv6ServiceHeadendv6CreateMethodDescriptor     = headendv6ServiceServiceDescriptor.Methods().ByName("Headendv6Create")
-	headendv6ServiceHeadendv6DeleteMethodDescriptor     = headendv6ServiceServiceDescriptor.Methods().ByName("Headendv6Delete")
-	headendv6ServiceHeadendv6ListMethodDescriptor       = headendv6ServiceServiceDescriptor.Methods().ByName("Headendv6List")
-	headendv6ServiceHeadendv6GetMethodDescriptor        = headendv6ServiceServiceDescriptor.Methods().ByName("Headendv6Get")
-	headendv6ServiceHeadendv6FlushMethodDescriptor      = headendv6ServiceServiceDescriptor.Methods().ByName("Headendv6Flush")
-	fdbServiceServiceDescriptor                         = v1.File_vinbero_v1_vinbero_proto.Services().ByName("FdbService")
-	fdbServiceFdbListMethodDescriptor                   = fdbServiceServiceDescriptor.Methods().ByName("FdbList")
-	fdbServiceFdbCreateMethodDescriptor                 = fdbServiceServiceDescriptor.Methods().ByName("FdbCreate")
-	fdbServiceFdbDeleteMethodDescriptor                 = fdbServiceServiceDescriptor.Methods().ByName("FdbDelete")
-	fdbServiceFdbFlushMethodDescriptor                  = fdbServiceServiceDescriptor.Methods().ByName("FdbFlush")
-	vlanTableServiceServiceDescriptor                   = v1.File_vinbero_v1_vinbero_proto.Services().ByName("VlanTableService")
-	vlanTableServiceVlanTableCreateMethodDescriptor     = vlanTableServiceServiceDescriptor.Methods().ByName("VlanTableCreate")
-	vlanTableServiceVlanTableDeleteMethodDescriptor     = vlanTableServiceServiceDescriptor.Methods().ByName("VlanTableDelete")
-	vlanTableServiceVlanTableListMethodDescriptor       = vlanTableServiceServiceDescriptor.Methods().ByName("VlanTableList")
-	vlanTableServiceVlanTableFlushMethodDescriptor      = vlanTableServiceServiceDescriptor.Methods().ByName("VlanTableFlush")
-	bdPeerServiceServiceDescriptor                      = v1.File_vinbero_v1_vinbero_proto.Services().ByName("BdPeerService")
-	bdPeerServiceBdPeerCreateMethodDescriptor           = bdPeerServiceServiceDescriptor.Methods().ByName("BdPeerCreate")
-	bdPeerServiceBdPeerDeleteMethodDescriptor           = bdPeerServiceServiceDescriptor.Methods().ByName("BdPeerDelete")
-	bdPeerServiceBdPeerListMethodDescriptor             = bdPeerServiceServiceDescriptor.Methods().ByName("BdPeerList")
-	bdPeerServiceBdPeerFlushMethodDescriptor            = bdPeerServiceServiceDescriptor.Methods().ByName("BdPeerFlush")
-	ethernetSegmentServiceServiceDescriptor             = v1.File_vinbero_v1_vinbero_proto.Services().ByName("EthernetSegmentService")
-	ethernetSegmentServiceEsCreateMethodDescriptor      = ethernetSegmentServiceServiceDescriptor.Methods().ByName("EsCreate")
-	ethernetSegmentServiceEsDeleteMethodDescriptor      = ethernetSegmentServiceServiceDescriptor.Methods().ByName("EsDelete")
-	ethernetSegmentServiceEsListMethodDescriptor        = ethernetSegmentServiceServiceDescriptor.Methods().ByName("EsList")
-	ethernetSegmentServiceEsSetDfMethodDescriptor       = ethernetSegmentServiceServiceDescriptor.Methods().ByName("EsSetDf")
-	ethernetSegmentServiceEsClearDfMethodDescriptor     = ethernetSegmentServiceServiceDescriptor.Methods().ByName("EsClearDf")
-	networkResourceServiceServiceDescriptor             = v1.File_vinbero_v1_vinbero_proto.Services().ByName("NetworkResourceService")
-	networkResourceServiceVrfCreateMethodDescriptor     = networkResourceServiceServiceDescriptor.Methods().ByName("VrfCreate")
-	networkResourceServiceVrfDeleteMethodDescriptor     = networkResourceServiceServiceDescriptor.Methods().ByName("VrfDelete")
-	networkResourceServiceVrfListMethodDescriptor       = networkResourceServiceServiceDescriptor.Methods().ByName("VrfList")
-	networkResourceServiceBridgeCreateMethodDescriptor  = networkResourceServiceServiceDescriptor.Methods().ByName("BridgeCreate")
-	networkResourceServiceBridgeDeleteMethodDescriptor  = networkResourceServiceServiceDescriptor.Methods().ByName("BridgeDelete")
-	networkResourceServiceBridgeListMethodDescriptor    = networkResourceServiceServiceDescriptor.Methods().ByName("BridgeList")
-	headendL2ServiceServiceDescriptor                   = v1.File_vinbero_v1_vinbero_proto.Services().ByName("HeadendL2Service")
-	headendL2ServiceHeadendL2CreateMethodDescriptor     = headendL2ServiceServiceDescriptor.Methods().ByName("HeadendL2Create")
-	headendL2ServiceHeadendL2DeleteMethodDescriptor     = headendL2ServiceServiceDescriptor.Methods().ByName("HeadendL2Delete")
-	headendL2ServiceHeadendL2ListMethodDescriptor       = headendL2ServiceServiceDescriptor.Methods().ByName("HeadendL2List")
-	headendL2ServiceHeadendL2GetMethodDescriptor        = headendL2ServiceServiceDescriptor.Methods().ByName("HeadendL2Get")
-	headendL2ServiceHeadendL2FlushMethodDescriptor      = headendL2ServiceServiceDescriptor.Methods().ByName("HeadendL2Flush")
-	statsServiceServiceDescriptor                       = v1.File_vinbero_v1_vinbero_proto.Services().ByName("StatsService")
-	statsServiceStatsShowMethodDescriptor               = statsServiceServiceDescriptor.Methods().ByName("StatsShow")
-	statsServiceStatsResetMethodDescriptor              = statsServiceServiceDescriptor.Methods().ByName("StatsReset")
-	statsServiceStatsSlotShowMethodDescriptor           = statsServiceServiceDescriptor.Methods().ByName("StatsSlotShow")
-	statsServiceStatsSlotResetMethodDescriptor          = statsServiceServiceDescriptor.Methods().ByName("StatsSlotReset")
+	sidFunctionServiceServiceDescriptor                    = v1.File_vinbero_v1_vinbero_proto.Services().ByName("SidFunctionService")
+	sidFunctionServiceSidFunctionCreateMethodDescriptor    = sidFunctionServiceServiceDescriptor.Methods().ByName("SidFunctionCreate")
+	sidFunctionServiceSidFunctionUpdateMethodDescriptor    = sidFunctionServiceServiceDescriptor.Methods().ByName("SidFunctionUpdate")
+	sidFunctionServiceSidFunctionDeleteMethodDescriptor    = sidFunctionServiceServiceDescriptor.Methods().ByName("SidFunctionDelete")
+	sidFunctionServiceSidFunctionListMethodDescriptor      = sidFunctionServiceServiceDescriptor.Methods().ByName("SidFunctionList")
+	sidFunctionServiceSidFunctionFlushMethodDescriptor     = sidFunctionServiceServiceDescriptor.Methods().ByName("SidFunctionFlush")
+	sidFunctionServiceSidFunctionGetMethodDescriptor       = sidFunctionServiceServiceDescriptor.Methods().ByName("SidFunctionGet")
+	headendv4ServiceServiceDescriptor                      = v1.File_vinbero_v1_vinbero_proto.Services().ByName("Headendv4Service")
+	headendv4ServiceHeadendv4CreateMethodDescriptor        = headendv4ServiceServiceDescriptor.Methods().ByName("Headendv4Create")
+	headendv4ServiceHeadendv4UpdateMethodDescriptor        = headendv4ServiceServiceDescriptor.Methods().ByName("Headendv4Update")
+	headendv4ServiceHeadendv4DeleteMethodDescriptor        = headendv4ServiceServiceDescriptor.Methods().ByName("Headendv4Delete")
+	headendv4ServiceHeadendv4ListMethodDescriptor          = headendv4ServiceServiceDescriptor.Methods().ByName("Headendv4List")
+	headendv4ServiceHeadendv4FlushMethodDescriptor         = headendv4ServiceServiceDescriptor.Methods().ByName("Headendv4Flush")
+	headendv4ServiceHeadendv4GetMethodDescriptor           = headendv4ServiceServiceDescriptor.Methods().ByName("Headendv4Get")
+	headendv6ServiceServiceDescriptor                      = v1.File_vinbero_v1_vinbero_proto.Services().ByName("Headendv6Service")
+	headendv6ServiceHeadendv6CreateMethodDescriptor        = headendv6ServiceServiceDescriptor.Methods().ByName("Headendv6Create")
+	headendv6ServiceHeadendv6UpdateMethodDescriptor        = headendv6ServiceServiceDescriptor.Methods().ByName("Headendv6Update")
+	headendv6ServiceHeadendv6DeleteMethodDescriptor        = headendv6ServiceServiceDescriptor.Methods().ByName("Headendv6Delete")
+	headendv6ServiceHeadendv6ListMethodDescriptor          = headendv6ServiceServiceDescriptor.Methods().ByName("Headendv6List")
+	headendv6ServiceHeadendv6GetMethodDescriptor           = headendv6ServiceServiceDescriptor.Methods().ByName("Headendv6Get")
+	headendv6ServiceHeadendv6FlushMethodDescriptor         = headendv6ServiceServiceDescriptor.Methods().ByName("Headendv6Flush")
+	fdbServiceServiceDescriptor                            = v1.File_vinbero_v1_vinbero_proto.Services().ByName("FdbService")
+	fdbServiceFdbListMethodDescriptor                      = fdbServiceServiceDescriptor.Methods().ByName("FdbList")
+	fdbServiceFdbCreateMethodDescriptor                    = fdbServiceServiceDescriptor.Methods().ByName("FdbCreate")
+	fdbServiceFdbDeleteMethodDescriptor                    = fdbServiceServiceDescriptor.Methods().ByName("FdbDelete")
+	fdbServiceFdbFlushMethodDescriptor                     = fdbServiceServiceDescriptor.Methods().ByName("FdbFlush")
+	vlanTableServiceServiceDescriptor                      = v1.File_vinbero_v1_vinbero_proto.Services().ByName("VlanTableService")
+	vlanTableServiceVlanTableCreateMethodDescriptor        = vlanTableServiceServiceDescriptor.Methods().ByName("VlanTableCreate")
+	vlanTableServiceVlanTableUpdateMethodDescriptor        = vlanTableServiceServiceDescriptor.Methods().ByName("VlanTableUpdate")
+	vlanTableServiceVlanTableDeleteMethodDescriptor        = vlanTableServiceServiceDescriptor.Methods().ByName("VlanTableDelete")
+	vlanTableServiceVlanTableListMethodDescriptor          = vlanTableServiceServiceDescriptor.Methods().ByName("VlanTableList")
+	vlanTableServiceVlanTableFlushMethodDescriptor         = vlanTableServiceServiceDescriptor.Methods().ByName("VlanTableFlush")
+	srDomainServiceServiceDescriptor                       = v1.File_vinbero_v1_vinbero_proto.Services().ByName("SrDomainService")
+	srDomainServiceSrDomainCreateMethodDescriptor          = srDomainServiceServiceDescriptor.Methods().ByName("SrDomainCreate")
+	srDomainServiceSrDomainDeleteMethodDescriptor          = srDomainServiceServiceDescriptor.Methods().ByName("SrDomainDelete")
+	srDomainServiceSrDomainListMethodDescriptor            = srDomainServiceServiceDescriptor.Methods().ByName("SrDomainList")
+	srDomainServiceSrDomainFlushMethodDescriptor           = srDomainServiceServiceDescriptor.Methods().ByName("SrDomainFlush")
+	bdPeerServiceServiceDescriptor                         = v1.File_vinbero_v1_vinbero_proto.Services().ByName("BdPeerService")
+	bdPeerServiceBdPeerCreateMethodDescriptor              = bdPeerServiceServiceDescriptor.Methods().ByName("BdPeerCreate")
+	bdPeerServiceBdPeerUpdateMethodDescriptor              = bdPeerServiceServiceDescriptor.Methods().ByName("BdPeerUpdate")
+	bdPeerServiceBdPeerDeleteMethodDescriptor              = bdPeerServiceServiceDescriptor.Methods().ByName("BdPeerDelete")
+	bdPeerServiceBdPeerListMethodDescriptor                = bdPeerServiceServiceDescriptor.Methods().ByName("BdPeerList")
+	bdPeerServiceBdPeerFlushMethodDescriptor               = bdPeerServiceServiceDescriptor.Methods().ByName("BdPeerFlush")
+	vniMappingServiceServiceDescriptor                     = v1.File_vinbero_v1_vinbero_proto.Services().ByName("VniMappingService")
+	vniMappingServiceVniMappingCreateMethodDescriptor      = vniMappingServiceServiceDescriptor.Methods().ByName("VniMappingCreate")
+	vniMappingServiceVniMappingDeleteMethodDescriptor      = vniMappingServiceServiceDescriptor.Methods().ByName("VniMappingDelete")
+	vniMappingServiceVniMappingListMethodDescriptor        = vniMappingServiceServiceDescriptor.Methods().ByName("VniMappingList")
+	vniMappingServiceVniMappingFlushMethodDescriptor       = vniMappingServiceServiceDescriptor.Methods().ByName("VniMappingFlush")
+	bridgeDomainServiceServiceDescriptor                   = v1.File_vinbero_v1_vinbero_proto.Services().ByName("BridgeDomainService")
+	bridgeDomainServiceBridgeDomainCreateMethodDescriptor  = bridgeDomainServiceServiceDescriptor.Methods().ByName("BridgeDomainCreate")
+	bridgeDomainServiceBridgeDomainDeleteMethodDescriptor  = bridgeDomainServiceServiceDescriptor.Methods().ByName("BridgeDomainDelete")
+	bridgeDomainServiceBridgeDomainGetMethodDescriptor     = bridgeDomainServiceServiceDescriptor.Methods().ByName("BridgeDomainGet")
+	bridgeDomainServiceBridgeDomainListMethodDescriptor    = bridgeDomainServiceServiceDescriptor.Methods().ByName("BridgeDomainList")
+	bridgeDomainServiceMacLimitSetMethodDescriptor         = bridgeDomainServiceServiceDescriptor.Methods().ByName("MacLimitSet")
+	bridgeDomainServiceMacLimitDeleteMethodDescriptor      = bridgeDomainServiceServiceDescriptor.Methods().ByName("MacLimitDelete")
+	bridgeDomainServiceMacLimitListMethodDescriptor        = bridgeDomainServiceServiceDescriptor.Methods().ByName("MacLimitList")
+	bridgeDomainServiceStormControlSetMethodDescriptor     = bridgeDomainServiceServiceDescriptor.Methods().ByName("StormControlSet")
+	bridgeDomainServiceStormControlDeleteMethodDescriptor  = bridgeDomainServiceServiceDescriptor.Methods().ByName("StormControlDelete")
+	bridgeDomainServiceStormControlListMethodDescriptor    = bridgeDomainServiceServiceDescriptor.Methods().ByName("StormControlList")
+	bridgeDomainServiceNeighborCreateMethodDescriptor      = bridgeDomainServiceServiceDescriptor.Methods().ByName("NeighborCreate")
+	bridgeDomainServiceNeighborDeleteMethodDescriptor      = bridgeDomainServiceServiceDescriptor.Methods().ByName("NeighborDelete")
+	bridgeDomainServiceNeighborListMethodDescriptor        = bridgeDomainServiceServiceDescriptor.Methods().ByName("NeighborList")
+	bridgeDomainServiceIrbRouteCreateMethodDescriptor      = bridgeDomainServiceServiceDescriptor.Methods().ByName("IrbRouteCreate")
+	bridgeDomainServiceIrbRouteDeleteMethodDescriptor      = bridgeDomainServiceServiceDescriptor.Methods().ByName("IrbRouteDelete")
+	bridgeDomainServiceIrbRouteListMethodDescriptor        = bridgeDomainServiceServiceDescriptor.Methods().ByName("IrbRouteList")
+	ethernetSegmentServiceServiceDescriptor                = v1.File_vinbero_v1_vinbero_proto.Services().ByName("EthernetSegmentService")
+	ethernetSegmentServiceEsCreateMethodDescriptor         = ethernetSegmentServiceServiceDescriptor.Methods().ByName("EsCreate")
+	ethernetSegmentServiceEsUpdateMethodDescriptor         = ethernetSegmentServiceServiceDescriptor.Methods().ByName("EsUpdate")
+	ethernetSegmentServiceEsDeleteMethodDescriptor         = ethernetSegmentServiceServiceDescriptor.Methods().ByName("EsDelete")
+	ethernetSegmentServiceEsListMethodDescriptor           = ethernetSegmentServiceServiceDescriptor.Methods().ByName("EsList")
+	ethernetSegmentServiceEsSetDfMethodDescriptor          = ethernetSegmentServiceServiceDescriptor.Methods().ByName("EsSetDf")
+	ethernetSegmentServiceEsClearDfMethodDescriptor        = ethernetSegmentServiceServiceDescriptor.Methods().ByName("EsClearDf")
+	networkResourceServiceServiceDescriptor                = v1.File_vinbero_v1_vinbero_proto.Services().ByName("NetworkResourceService")
+	networkResourceServiceVrfCreateMethodDescriptor        = networkResourceServiceServiceDescriptor.Methods().ByName("VrfCreate")
+	networkResourceServiceVrfDeleteMethodDescriptor        = networkResourceServiceServiceDescriptor.Methods().ByName("VrfDelete")
+	networkResourceServiceVrfListMethodDescriptor          = networkResourceServiceServiceDescriptor.Methods().ByName("VrfList")
+	networkResourceServiceBridgeCreateMethodDescriptor     = networkResourceServiceServiceDescriptor.Methods().ByName("BridgeCreate")
+	networkResourceServiceBridgeDeleteMethodDescriptor     = networkResourceServiceServiceDescriptor.Methods().ByName("BridgeDelete")
+	networkResourceServiceBridgeListMethodDescriptor       = networkResourceServiceServiceDescriptor.Methods().ByName("BridgeList")
+	headendL2ServiceServiceDescriptor                      = v1.File_vinbero_v1_vinbero_proto.Services().ByName("HeadendL2Service")
+	headendL2ServiceHeadendL2CreateMethodDescriptor        = headendL2ServiceServiceDescriptor.Methods().ByName("HeadendL2Create")
+	headendL2ServiceHeadendL2UpdateMethodDescriptor        = headendL2ServiceServiceDescriptor.Methods().ByName("HeadendL2Update")
+	headendL2ServiceHeadendL2DeleteMethodDescriptor        = headendL2ServiceServiceDescriptor.Methods().ByName("HeadendL2Delete")
+	headendL2ServiceHeadendL2ListMethodDescriptor          = headendL2ServiceServiceDescriptor.Methods().ByName("HeadendL2List")
+	headendL2ServiceHeadendL2GetMethodDescriptor           = headendL2ServiceServiceDescriptor.Methods().ByName("HeadendL2Get")
+	headendL2ServiceHeadendL2FlushMethodDescriptor         = headendL2ServiceServiceDescriptor.Methods().ByName("HeadendL2Flush")
+	statsServiceServiceDescriptor                          = v1.File_vinbero_v1_vinbero_proto.Services().ByName("StatsService")
+	statsServiceStatsShowMethodDescriptor                  = statsServiceServiceDescriptor.Methods().ByName("StatsShow")
+	statsServiceStatsResetMethodDescriptor                 = statsServiceServiceDescriptor.Methods().ByName("StatsReset")
+	statsServiceStatsSlotShowMethodDescriptor              = statsServiceServiceDescriptor.Methods().ByName("StatsSlotShow")
+	statsServiceStatsSlotResetMethodDescriptor             = statsServiceServiceDescriptor.Methods().ByName("StatsSlotReset")
+	oamServiceServiceDescriptor                            = v1.File_vinbero_v1_vinbero_proto.Services().ByName("OamService")
+	oamServiceOamPingMethodDescriptor                      = oamServiceServiceDescriptor.Methods().ByName("OamPing")
+	oamServiceOamTracerouteMethodDescriptor                = oamServiceServiceDescriptor.Methods().ByName("OamTraceroute")
+	oamServiceOamPuntListMethodDescriptor                  = oamServiceServiceDescriptor.Methods().ByName("OamPuntList")
+	performanceServiceServiceDescriptor                    = v1.File_vinbero_v1_vinbero_proto.Services().ByName("PerformanceService")
+	performanceServiceStampReflectorCreateMethodDescriptor = performanceServiceServiceDescriptor.Methods().ByName("StampReflectorCreate")
+	performanceServiceStampReflectorDeleteMethodDescriptor = performanceServiceServiceDescriptor.Methods().ByName("StampReflectorDelete")
+	performanceServiceStampReflectorListMethodDescriptor   = performanceServiceServiceDescriptor.Methods().ByName("StampReflectorList")
+	performanceServiceStampSessionCreateMethodDescriptor   = performanceServiceServiceDescriptor.Methods().ByName("StampSessionCreate")
+	performanceServiceStampSessionDeleteMethodDescriptor   = performanceServiceServiceDescriptor.Methods().ByName("StampSessionDelete")
+	performanceServiceStampSessionListMethodDescriptor     = performanceServiceServiceDescriptor.Methods().ByName("StampSessionList")
+	daemonServiceServiceDescriptor                         = v1.File_vinbero_v1_vinbero_proto.Services().ByName("DaemonService")
+	daemonServiceReloadMethodDescriptor                    = daemonServiceServiceDescriptor.Methods().ByName("Reload")
 )
 
 // SidFunctionServiceClient is a client for the vinbero.v1.SidFunctionService service.
 type SidFunctionServiceClient interface {
 	SidFunctionCreate(context.Context, *connect.Request[v1.SidFunctionCreateRequest]) (*connect.Response[v1.SidFunctionCreateResponse], error)
+	SidFunctionUpdate(context.Context, *connect.Request[v1.SidFunctionUpdateRequest]) (*connect.Response[v1.SidFunctionUpdateResponse], error)
 	SidFunctionDelete(context.Context, *connect.Request[v1.SidFunctionDeleteRequest]) (*connect.Response[v1.SidFunctionDeleteResponse], error)
 	SidFunctionList(context.Context, *connect.Request[v1.SidFunctionListRequest]) (*connect.Response[v1.SidFunctionListResponse], error)
 	SidFunctionFlush(context.Context, *connect.Request[v1.SidFunctionFlushRequest]) (*connect.Response[v1.SidFunctionFlushResponse], error)
@@ -274,6 +454,12 @@ func NewSidFunctionServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(sidFunctionServiceSidFunctionCreateMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		sidFunctionUpdate: connect.NewClient[v1.SidFunctionUpdateRequest, v1.SidFunctionUpdateResponse](
+			httpClient,
+			baseURL+SidFunctionServiceSidFunctionUpdateProcedure,
+			connect.WithSchema(sidFunctionServiceSidFunctionUpdateMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		sidFunctionDelete: connect.NewClient[v1.SidFunctionDeleteRequest, v1.SidFunctionDeleteResponse](
 			httpClient,
 			baseURL+SidFunctionServiceSidFunctionDeleteProcedure,
@@ -304,6 +490,7 @@ func NewSidFunctionServiceClient(httpClient connect.HTTPClient, baseURL string,
 // sidFunctionServiceClient implements SidFunctionServiceClient.
 type sidFunctionServiceClient struct {
 	sidFunctionCreate *connect.Client[v1.SidFunctionCreateRequest, v1.SidFunctionCreateResponse]
+	sidFunctionUpdate *connect.Client[v1.SidFunctionUpdateRequest, v1.SidFunctionUpdateResponse]
 	sidFunctionDelete *connect.Client[v1.SidFunctionDeleteRequest, v1.SidFunctionDeleteResponse]
 	sidFunctionList   *connect.Client[v1.SidFunctionListRequest, v1.SidFunctionListResponse]
 	sidFunctionFlush  *connect.Client[v1.SidFunctionFlushRequest, v1.SidFunctionFlushResponse]
@@ -315,6 +502,11 @@ func (c *sidFunctionServiceClient) SidFunctionCreate(ctx context.Context, req *c
 	return c.sidFunctionCreate.CallUnary(ctx, req)
 }
 
+// SidFunctionUpdate calls vinbero.v1.SidFunctionService.SidFunctionUpdate.
+func (c *sidFunctionServiceClient) SidFunctionUpdate(ctx context.Context, req *connect.Request[v1.SidFunctionUpdateRequest]) (*connect.Response[v1.SidFunctionUpdateResponse], error) {
+	return c.sidFunctionUpdate.CallUnary(ctx, req)
+}
+
 // SidFunctionDelete calls vinbero.v1.SidFunctionService.SidFunctionDelete.
 func (c *sidFunctionServiceClient) SidFunctionDelete(ctx context.Context, req *connect.Request[v1.SidFunctionDeleteRequest]) (*connect.Response[v1.SidFunctionDeleteResponse], error) {
 	return c.sidFunctionDelete.CallUnary(ctx, req)
@@ -338,6 +530,7 @@ func (c *sidFunctionServiceClient) SidFunctionGet(ctx context.Context, req *conn
 // SidFunctionServiceHandler is an implementation of the vinbero.v1.SidFunctionService service.
 type SidFunctionServiceHandler interface {
 	SidFunctionCreate(context.Context, *connect.Request[v1.SidFunctionCreateRequest]) (*connect.Response[v1.SidFunctionCreateResponse], error)
+	SidFunctionUpdate(context.Context, *connect.Request[v1.SidFunctionUpdateRequest]) (*connect.Response[v1.SidFunctionUpdateResponse], error)
 	SidFunctionDelete(context.Context, *connect.Request[v1.SidFunctionDeleteRequest]) (*connect.Response[v1.SidFunctionDeleteResponse], error)
 	SidFunctionList(context.Context, *connect.Request[v1.SidFunctionListRequest]) (*connect.Response[v1.SidFunctionListResponse], error)
 	SidFunctionFlush(context.Context, *connect.Request[v1.SidFunctionFlushRequest]) (*connect.Response[v1.SidFunctionFlushResponse], error)
@@ -356,6 +549,12 @@ func NewSidFunctionServiceHandler(svc SidFunctionServiceHandler, opts ...connect
 		connect.WithSchema(sidFunctionServiceSidFunctionCreateMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	sidFunctionServiceSidFunctionUpdateHandler := connect.NewUnaryHandler(
+		SidFunctionServiceSidFunctionUpdateProcedure,
+		svc.SidFunctionUpdate,
+		connect.WithSchema(sidFunctionServiceSidFunctionUpdateMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	sidFunctionServiceSidFunctionDeleteHandler := connect.NewUnaryHandler(
 		SidFunctionServiceSidFunctionDeleteProcedure,
 		svc.SidFunctionDelete,
@@ -384,6 +583,8 @@ func NewSidFunctionServiceHandler(svc SidFunctionServiceHandler, opts ...connect
 		switch r.URL.Path {
 		case SidFunctionServiceSidFunctionCreateProcedure:
 			sidFunctionServiceSidFunctionCreateHandler.ServeHTTP(w, r)
+		case SidFunctionServiceSidFunctionUpdateProcedure:
+			sidFunctionServiceSidFunctionUpdateHandler.ServeHTTP(w, r)
 		case SidFunctionServiceSidFunctionDeleteProcedure:
 			sidFunctionServiceSidFunctionDeleteHandler.ServeHTTP(w, r)
 		case SidFunctionServiceSidFunctionListProcedure:
@@ -405,6 +606,10 @@ func (UnimplementedSidFunctionServiceHandler) SidFunctionCreate(context.Context,
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.SidFunctionService.SidFunctionCreate is not implemented"))
 }
 
+func (UnimplementedSidFunctionServiceHandler) SidFunctionUpdate(context.Context, *connect.Request[v1.SidFunctionUpdateRequest]) (*connect.Response[v1.SidFunctionUpdateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.SidFunctionService.SidFunctionUpdate is not implemented"))
+}
+
 func (UnimplementedSidFunctionServiceHandler) SidFunctionDelete(context.Context, *connect.Request[v1.SidFunctionDeleteRequest]) (*connect.Response[v1.SidFunctionDeleteResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.SidFunctionService.SidFunctionDelete is not implemented"))
 }
@@ -424,6 +629,7 @@ func (UnimplementedSidFunctionServiceHandler) SidFunctionGet(context.Context, *c
 // Headendv4ServiceClient is a client for the vinbero.v1.Headendv4Service service.
 type Headendv4ServiceClient interface {
 	Headendv4Create(context.Context, *connect.Request[v1.Headendv4CreateRequest]) (*connect.Response[v1.Headendv4CreateResponse], error)
+	Headendv4Update(context.Context, *connect.Request[v1.Headendv4UpdateRequest]) (*connect.Response[v1.Headendv4UpdateResponse], error)
 	Headendv4Delete(context.Context, *connect.Request[v1.Headendv4DeleteRequest]) (*connect.Response[v1.Headendv4DeleteResponse], error)
 	Headendv4List(context.Context, *connect.Request[v1.Headendv4ListRequest]) (*connect.Response[v1.Headendv4ListResponse], error)
 	Headendv4Flush(context.Context, *connect.Request[v1.Headendv4FlushRequest]) (*connect.Response[v1.Headendv4FlushResponse], error)
@@ -446,6 +652,12 @@ func NewHeadendv4ServiceClient(httpClient connect.HTTPClient, baseURL string, op
 			connect.WithSchema(headendv4ServiceHeadendv4CreateMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		headendv4Update: connect.NewClient[v1.Headendv4UpdateRequest, v1.Headendv4UpdateResponse](
+			httpClient,
+			baseURL+Headendv4ServiceHeadendv4UpdateProcedure,
+			connect.WithSchema(headendv4ServiceHeadendv4UpdateMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		headendv4Delete: connect.NewClient[v1.Headendv4DeleteRequest, v1.Headendv4DeleteResponse](
 			httpClient,
 			baseURL+Headendv4ServiceHeadendv4DeleteProcedure,
@@ -476,6 +688,7 @@ func NewHeadendv4ServiceClient(httpClient connect.HTTPClient, baseURL string, op
 // headendv4ServiceClient implements Headendv4ServiceClient.
 type headendv4ServiceClient struct {
 	headendv4Create *connect.Client[v1.Headendv4CreateRequest, v1.Headendv4CreateResponse]
+	headendv4Update *connect.Client[v1.Headendv4UpdateRequest, v1.Headendv4UpdateResponse]
 	headendv4Delete *connect.Client[v1.Headendv4DeleteRequest, v1.Headendv4DeleteResponse]
 	headendv4List   *connect.Client[v1.Headendv4ListRequest, v1.Headendv4ListResponse]
 	headendv4Flush  *connect.Client[v1.Headendv4FlushRequest, v1.Headendv4FlushResponse]
@@ -487,6 +700,11 @@ func (c *headendv4ServiceClient) Headendv4Create(ctx context.Context, req *conne
 	return c.headendv4Create.CallUnary(ctx, req)
 }
 
+// Headendv4Update calls vinbero.v1.Headendv4Service.Headendv4Update.
+func (c *headendv4ServiceClient) Headendv4Update(ctx context.Context, req *connect.Request[v1.Headendv4UpdateRequest]) (*connect.Response[v1.Headendv4UpdateResponse], error) {
+	return c.headendv4Update.CallUnary(ctx, req)
+}
+
 // Headendv4Delete calls vinbero.v1.Headendv4Service.Headendv4Delete.
 func (c *headendv4ServiceClient) Headendv4Delete(ctx context.Context, req *connect.Request[v1.Headendv4DeleteRequest]) (*connect.Response[v1.Headendv4DeleteResponse], error) {
 	return c.headendv4Delete.CallUnary(ctx, req)
@@ -510,6 +728,7 @@ func (c *headendv4ServiceClient) Headendv4Get(ctx context.Context, req *connect.
 // Headendv4ServiceHandler is an implementation of the vinbero.v1.Headendv4Service service.
 type Headendv4ServiceHandler interface {
 	Headendv4Create(context.Context, *connect.Request[v1.Headendv4CreateRequest]) (*connect.Response[v1.Headendv4CreateResponse], error)
+	Headendv4Update(context.Context, *connect.Request[v1.Headendv4UpdateRequest]) (*connect.Response[v1.Headendv4UpdateResponse], error)
 	Headendv4Delete(context.Context, *connect.Request[v1.Headendv4DeleteRequest]) (*connect.Response[v1.Headendv4DeleteResponse], error)
 	Headendv4List(context.Context, *connect.Request[v1.Headendv4ListRequest]) (*connect.Response[v1.Headendv4ListResponse], error)
 	Headendv4Flush(context.Context, *connect.Request[v1.Headendv4FlushRequest]) (*connect.Response[v1.Headendv4FlushResponse], error)
@@ -528,6 +747,12 @@ func NewHeadendv4ServiceHandler(svc Headendv4ServiceHandler, opts ...connect.Han
 		connect.WithSchema(headendv4ServiceHeadendv4CreateMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	headendv4ServiceHeadendv4UpdateHandler := connect.NewUnaryHandler(
+		Headendv4ServiceHeadendv4UpdateProcedure,
+		svc.Headendv4Update,
+		connect.WithSchema(headendv4ServiceHeadendv4UpdateMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	headendv4ServiceHeadendv4DeleteHandler := connect.NewUnaryHandler(
 		Headendv4ServiceHeadendv4DeleteProcedure,
 		svc.Headendv4Delete,
@@ -556,6 +781,8 @@ func NewHeadendv4ServiceHandler(svc Headendv4ServiceHandler, opts ...connect.Han
 		switch r.URL.Path {
 		case Headendv4ServiceHeadendv4CreateProcedure:
 			headendv4ServiceHeadendv4CreateHandler.ServeHTTP(w, r)
+		case Headendv4ServiceHeadendv4UpdateProcedure:
+			headendv4ServiceHeadendv4UpdateHandler.ServeHTTP(w, r)
 		case Headendv4ServiceHeadendv4DeleteProcedure:
 			headendv4ServiceHeadendv4DeleteHandler.ServeHTTP(w, r)
 		case Headendv4ServiceHeadendv4ListProcedure:
@@ -577,6 +804,10 @@ func (UnimplementedHeadendv4ServiceHandler) Headendv4Create(context.Context, *co
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.Headendv4Service.Headendv4Create is not implemented"))
 }
 
+func (UnimplementedHeadendv4ServiceHandler) Headendv4Update(context.Context, *connect.Request[v1.Headendv4UpdateRequest]) (*connect.Response[v1.Headendv4UpdateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.Headendv4Service.Headendv4Update is not implemented"))
+}
+
 func (UnimplementedHeadendv4ServiceHandler) Headendv4Delete(context.Context, *connect.Request[v1.Headendv4DeleteRequest]) (*connect.Response[v1.Headendv4DeleteResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.Headendv4Service.Headendv4Delete is not implemented"))
 }
@@ -596,6 +827,7 @@ func (UnimplementedHeadendv4ServiceHandler) Headendv4Get(context.Context, *conne
 // Headendv6ServiceClient is a client for the vinbero.v1.Headendv6Service service.
 type Headendv6ServiceClient interface {
 	Headendv6Create(context.Context, *connect.Request[v1.Headendv6CreateRequest]) (*connect.Response[v1.Headendv6CreateResponse], error)
+	Headendv6Update(context.Context, *connect.Request[v1.Headendv6UpdateRequest]) (*connect.Response[v1.Headendv6UpdateResponse], error)
 	Headendv6Delete(context.Context, *connect.Request[v1.Headendv6DeleteRequest]) (*connect.Response[v1.Headendv6DeleteResponse], error)
 	Headendv6List(context.Context, *connect.Request[v1.Headendv6ListRequest]) (*connect.Response[v1.Headendv6ListResponse], error)
 	Headendv6Get(context.Context, *connect.Request[v1.Headendv6GetRequest]) (*connect.Response[v1.Headendv6GetResponse], error)
@@ -618,6 +850,12 @@ func NewHeadendv6ServiceClient(httpClient connect.HTTPClient, baseURL string, op
 			connect.WithSchema(headendv6ServiceHeadendv6CreateMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		headendv6Update: connect.NewClient[v1.Headendv6UpdateRequest, v1.Headendv6UpdateResponse](
+			httpClient,
+			baseURL+Headendv6ServiceHeadendv6UpdateProcedure,
+			connect.WithSchema(headendv6ServiceHeadendv6UpdateMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		headendv6Delete: connect.NewClient[v1.Headendv6DeleteRequest, v1.Headendv6DeleteResponse](
 			httpClient,
 			baseURL+Headendv6ServiceHeadendv6DeleteProcedure,
@@ -648,6 +886,7 @@ func NewHeadendv6ServiceClient(httpClient connect.HTTPClient, baseURL string, op
 // headendv6ServiceClient implements Headendv6ServiceClient.
 type headendv6ServiceClient struct {
 	headendv6Create *connect.Client[v1.Headendv6CreateRequest, v1.Headendv6CreateResponse]
+	headendv6Update *connect.Client[v1.Headendv6UpdateRequest, v1.Headendv6UpdateResponse]
 	headendv6Delete *connect.Client[v1.Headendv6DeleteRequest, v1.Headendv6DeleteResponse]
 	headendv6List   *connect.Client[v1.Headendv6ListRequest, v1.Headendv6ListResponse]
 	headendv6Get    *connect.Client[v1.Headendv6GetRequest, v1.Headendv6GetResponse]
@@ -659,6 +898,11 @@ func (c *headendv6ServiceClient) Headendv6Create(ctx context.Context, req *conne
 	return c.headendv6Create.CallUnary(ctx, req)
 }
 
+// Headendv6Update calls vinbero.v1.Headendv6Service.Headendv6Update.
+func (c *headendv6ServiceClient) Headendv6Update(ctx context.Context, req *connect.Request[v1.Headendv6UpdateRequest]) (*connect.Response[v1.Headendv6UpdateResponse], error) {
+	return c.headendv6Update.CallUnary(ctx, req)
+}
+
 // Headendv6Delete calls vinbero.v1.Headendv6Service.Headendv6Delete.
 func (c *headendv6ServiceClient) Headendv6Delete(ctx context.Context, req *connect.Request[v1.Headendv6DeleteRequest]) (*connect.Response[v1.Headendv6DeleteResponse], error) {
 	return c.headendv6Delete.CallUnary(ctx, req)
@@ -682,6 +926,7 @@ func (c *headendv6ServiceClient) Headendv6Flush(ctx context.Context, req *connec
 // Headendv6ServiceHandler is an implementation of the vinbero.v1.Headendv6Service service.
 type Headendv6ServiceHandler interface {
 	Headendv6Create(context.Context, *connect.Request[v1.Headendv6CreateRequest]) (*connect.Response[v1.Headendv6CreateResponse], error)
+	Headendv6Update(context.Context, *connect.Request[v1.Headendv6UpdateRequest]) (*connect.Response[v1.Headendv6UpdateResponse], error)
 	Headendv6Delete(context.Context, *connect.Request[v1.Headendv6DeleteRequest]) (*connect.Response[v1.Headendv6DeleteResponse], error)
 	Headendv6List(context.Context, *connect.Request[v1.Headendv6ListRequest]) (*connect.Response[v1.Headendv6ListResponse], error)
 	Headendv6Get(context.Context, *connect.Request[v1.Headendv6GetRequest]) (*connect.Response[v1.Headendv6GetResponse], error)
@@ -700,6 +945,12 @@ func NewHeadendv6ServiceHandler(svc Headendv6ServiceHandler, opts ...connect.Han
 		connect.WithSchema(headendv6ServiceHeadendv6CreateMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	headendv6ServiceHeadendv6UpdateHandler := connect.NewUnaryHandler(
+		Headendv6ServiceHeadendv6UpdateProcedure,
+		svc.Headendv6Update,
+		connect.WithSchema(headendv6ServiceHeadendv6UpdateMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	headendv6ServiceHeadendv6DeleteHandler := connect.NewUnaryHandler(
 		Headendv6ServiceHeadendv6DeleteProcedure,
 		svc.Headendv6Delete,
@@ -728,6 +979,8 @@ func NewHeadendv6ServiceHandler(svc Headendv6ServiceHandler, opts ...connect.Han
 		switch r.URL.Path {
 		case Headendv6ServiceHeadendv6CreateProcedure:
 			headendv6ServiceHeadendv6CreateHandler.ServeHTTP(w, r)
+		case Headendv6ServiceHeadendv6UpdateProcedure:
+			headendv6ServiceHeadendv6UpdateHandler.ServeHTTP(w, r)
 		case Headendv6ServiceHeadendv6DeleteProcedure:
 			headendv6ServiceHeadendv6DeleteHandler.ServeHTTP(w, r)
 		case Headendv6ServiceHeadendv6ListProcedure:
@@ -749,6 +1002,10 @@ func (UnimplementedHeadendv6ServiceHandler) Headendv6Create(context.Context, *co
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.Headendv6Service.Headendv6Create is not implemented"))
 }
 
+func (UnimplementedHeadendv6ServiceHandler) Headendv6Update(context.Context, *connect.Request[v1.Headendv6UpdateRequest]) (*connect.Response[v1.Headendv6UpdateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.Headendv6Service.Headendv6Update is not implemented"))
+}
+
 func (UnimplementedHeadendv6ServiceHandler) Headendv6Delete(context.Context, *connect.Request[v1.Headendv6DeleteRequest]) (*connect.Response[v1.Headendv6DeleteResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.Headendv6Service.Headendv6Delete is not implemented"))
 }
@@ -914,6 +1171,7 @@ func (UnimplementedFdbServiceHandler) FdbFlush(context.Context, *connect.Request
 // VlanTableServiceClient is a client for the vinbero.v1.VlanTableService service.
 type VlanTableServiceClient interface {
 	VlanTableCreate(context.Context, *connect.Request[v1.VlanTableCreateRequest]) (*connect.Response[v1.VlanTableCreateResponse], error)
+	VlanTableUpdate(context.Context, *connect.Request[v1.VlanTableUpdateRequest]) (*connect.Response[v1.VlanTableUpdateResponse], error)
 	VlanTableDelete(context.Context, *connect.Request[v1.VlanTableDeleteRequest]) (*connect.Response[v1.VlanTableDeleteResponse], error)
 	VlanTableList(context.Context, *connect.Request[v1.VlanTableListRequest]) (*connect.Response[v1.VlanTableListResponse], error)
 	VlanTableFlush(context.Context, *connect.Request[v1.VlanTableFlushRequest]) (*connect.Response[v1.VlanTableFlushResponse], error)
@@ -935,6 +1193,12 @@ func NewVlanTableServiceClient(httpClient connect.HTTPClient, baseURL string, op
 			connect.WithSchema(vlanTableServiceVlanTableCreateMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		vlanTableUpdate: connect.NewClient[v1.VlanTableUpdateRequest, v1.VlanTableUpdateResponse](
+			httpClient,
+			baseURL+VlanTableServiceVlanTableUpdateProcedure,
+			connect.WithSchema(vlanTableServiceVlanTableUpdateMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		vlanTableDelete: connect.NewClient[v1.VlanTableDeleteRequest, v1.VlanTableDeleteResponse](
 			httpClient,
 			baseURL+VlanTableServiceVlanTableDeleteProcedure,
@@ -959,6 +1223,7 @@ func NewVlanTableServiceClient(httpClient connect.HTTPClient, baseURL string, op
 // vlanTableServiceClient implements VlanTableServiceClient.
 type vlanTableServiceClient struct {
 	vlanTableCreate *connect.Client[v1.VlanTableCreateRequest, v1.VlanTableCreateResponse]
+	vlanTableUpdate *connect.Client[v1.VlanTableUpdateRequest, v1.VlanTableUpdateResponse]
 	vlanTableDelete *connect.Client[v1.VlanTableDeleteRequest, v1.VlanTableDeleteResponse]
 	vlanTableList   *connect.Client[v1.VlanTableListRequest, v1.VlanTableListResponse]
 	vlanTableFlush  *connect.Client[v1.VlanTableFlushRequest, v1.VlanTableFlushResponse]
@@ -969,6 +1234,11 @@ func (c *vlanTableServiceClient) VlanTableCreate(ctx context.Context, req *conne
 	return c.vlanTableCreate.CallUnary(ctx, req)
 }
 
+// VlanTableUpdate calls vinbero.v1.VlanTableService.VlanTableUpdate.
+func (c *vlanTableServiceClient) VlanTableUpdate(ctx context.Context, req *connect.Request[v1.VlanTableUpdateRequest]) (*connect.Response[v1.VlanTableUpdateResponse], error) {
+	return c.vlanTableUpdate.CallUnary(ctx, req)
+}
+
 // VlanTableDelete calls vinbero.v1.VlanTableService.VlanTableDelete.
 func (c *vlanTableServiceClient) VlanTableDelete(ctx context.Context, req *connect.Request[v1.VlanTableDeleteRequest]) (*connect.Response[v1.VlanTableDeleteResponse], error) {
 	return c.vlanTableDelete.CallUnary(ctx, req)
@@ -987,6 +1257,7 @@ func (c *vlanTableServiceClient) VlanTableFlush(ctx context.Context, req *connec
 // VlanTableServiceHandler is an implementation of the vinbero.v1.VlanTableService service.
 type VlanTableServiceHandler interface {
 	VlanTableCreate(context.Context, *connect.Request[v1.VlanTableCreateRequest]) (*connect.Response[v1.VlanTableCreateResponse], error)
+	VlanTableUpdate(context.Context, *connect.Request[v1.VlanTableUpdateRequest]) (*connect.Response[v1.VlanTableUpdateResponse], error)
 	VlanTableDelete(context.Context, *connect.Request[v1.VlanTableDeleteRequest]) (*connect.Response[v1.VlanTableDeleteResponse], error)
 	VlanTableList(context.Context, *connect.Request[v1.VlanTableListRequest]) (*connect.Response[v1.VlanTableListResponse], error)
 	VlanTableFlush(context.Context, *connect.Request[v1.VlanTableFlushRequest]) (*connect.Response[v1.VlanTableFlushResponse], error)
@@ -1004,6 +1275,12 @@ func NewVlanTableServiceHandler(svc VlanTableServiceHandler, opts ...connect.Han
 		connect.WithSchema(vlanTableServiceVlanTableCreateMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	vlanTableServiceVlanTableUpdateHandler := connect.NewUnaryHandler(
+		VlanTableServiceVlanTableUpdateProcedure,
+		svc.VlanTableUpdate,
+		connect.WithSchema(vlanTableServiceVlanTableUpdateMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	vlanTableServiceVlanTableDeleteHandler := connect.NewUnaryHandler(
 		VlanTableServiceVlanTableDeleteProcedure,
 		svc.VlanTableDelete,
@@ -1026,6 +1303,8 @@ func NewVlanTableServiceHandler(svc VlanTableServiceHandler, opts ...connect.Han
 		switch r.URL.Path {
 		case VlanTableServiceVlanTableCreateProcedure:
 			vlanTableServiceVlanTableCreateHandler.ServeHTTP(w, r)
+		case VlanTableServiceVlanTableUpdateProcedure:
+			vlanTableServiceVlanTableUpdateHandler.ServeHTTP(w, r)
 		case VlanTableServiceVlanTableDeleteProcedure:
 			vlanTableServiceVlanTableDeleteHandler.ServeHTTP(w, r)
 		case VlanTableServiceVlanTableListProcedure:
@@ -1045,6 +1324,10 @@ func (UnimplementedVlanTableServiceHandler) VlanTableCreate(context.Context, *co
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.VlanTableService.VlanTableCreate is not implemented"))
 }
 
+func (UnimplementedVlanTableServiceHandler) VlanTableUpdate(context.Context, *connect.Request[v1.VlanTableUpdateRequest]) (*connect.Response[v1.VlanTableUpdateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.VlanTableService.VlanTableUpdate is not implemented"))
+}
+
 func (UnimplementedVlanTableServiceHandler) VlanTableDelete(context.Context, *connect.Request[v1.VlanTableDeleteRequest]) (*connect.Response[v1.VlanTableDeleteResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.VlanTableService.VlanTableDelete is not implemented"))
 }
@@ -1057,9 +1340,156 @@ func (UnimplementedVlanTableServiceHandler) VlanTableFlush(context.Context, *con
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.VlanTableService.VlanTableFlush is not implemented"))
 }
 
+// SrDomainServiceClient is a client for the vinbero.v1.SrDomainService service.
+type SrDomainServiceClient interface {
+	SrDomainCreate(context.Context, *connect.Request[v1.SrDomainCreateRequest]) (*connect.Response[v1.SrDomainCreateResponse], error)
+	SrDomainDelete(context.Context, *connect.Request[v1.SrDomainDeleteRequest]) (*connect.Response[v1.SrDomainDeleteResponse], error)
+	SrDomainList(context.Context, *connect.Request[v1.SrDomainListRequest]) (*connect.Response[v1.SrDomainListResponse], error)
+	SrDomainFlush(context.Context, *connect.Request[v1.SrDomainFlushRequest]) (*connect.Response[v1.SrDomainFlushResponse], error)
+}
+
+// NewSrDomainServiceClient constructs a client for the vinbero.v1.SrDomainService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewSrDomainServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) SrDomainServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &srDomainServiceClient{
+		srDomainCreate: connect.NewClient[v1.SrDomainCreateRequest, v1.SrDomainCreateResponse](
+			httpClient,
+			baseURL+SrDomainServiceSrDomainCreateProcedure,
+			connect.WithSchema(srDomainServiceSrDomainCreateMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		srDomainDelete: connect.NewClient[v1.SrDomainDeleteRequest, v1.SrDomainDeleteResponse](
+			httpClient,
+			baseURL+SrDomainServiceSrDomainDeleteProcedure,
+			connect.WithSchema(srDomainServiceSrDomainDeleteMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		srDomainList: connect.NewClient[v1.SrDomainListRequest, v1.SrDomainListResponse](
+			httpClient,
+			baseURL+SrDomainServiceSrDomainListProcedure,
+			connect.WithSchema(srDomainServiceSrDomainListMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		srDomainFlush: connect.NewClient[v1.SrDomainFlushRequest, v1.SrDomainFlushResponse](
+			httpClient,
+			baseURL+SrDomainServiceSrDomainFlushProcedure,
+			connect.WithSchema(srDomainServiceSrDomainFlushMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// srDomainServiceClient implements SrDomainServiceClient.
+type srDomainServiceClient struct {
+	srDomainCreate *connect.Client[v1.SrDomainCreateRequest, v1.SrDomainCreateResponse]
+	srDomainDelete *connect.Client[v1.SrDomainDeleteRequest, v1.SrDomainDeleteResponse]
+	srDomainList   *connect.Client[v1.SrDomainListRequest, v1.SrDomainListResponse]
+	srDomainFlush  *connect.Client[v1.SrDomainFlushRequest, v1.SrDomainFlushResponse]
+}
+
+// SrDomainCreate calls vinbero.v1.SrDomainService.SrDomainCreate.
+func (c *srDomainServiceClient) SrDomainCreate(ctx context.Context, req *connect.Request[v1.SrDomainCreateRequest]) (*connect.Response[v1.SrDomainCreateResponse], error) {
+	return c.srDomainCreate.CallUnary(ctx, req)
+}
+
+// SrDomainDelete calls vinbero.v1.SrDomainService.SrDomainDelete.
+func (c *srDomainServiceClient) SrDomainDelete(ctx context.Context, req *connect.Request[v1.SrDomainDeleteRequest]) (*connect.Response[v1.SrDomainDeleteResponse], error) {
+	return c.srDomainDelete.CallUnary(ctx, req)
+}
+
+// SrDomainList calls vinbero.v1.SrDomainService.SrDomainList.
+func (c *srDomainServiceClient) SrDomainList(ctx context.Context, req *connect.Request[v1.SrDomainListRequest]) (*connect.Response[v1.SrDomainListResponse], error) {
+	return c.srDomainList.CallUnary(ctx, req)
+}
+
+// SrDomainFlush calls vinbero.v1.SrDomainService.SrDomainFlush.
+func (c *srDomainServiceClient) SrDomainFlush(ctx context.Context, req *connect.Request[v1.SrDomainFlushRequest]) (*connect.Response[v1.SrDomainFlushResponse], error) {
+	return c.srDomainFlush.CallUnary(ctx, req)
+}
+
+// SrDomainServiceHandler is an implementation of the vinbero.v1.SrDomainService service.
+type SrDomainServiceHandler interface {
+	SrDomainCreate(context.Context, *connect.Request[v1.SrDomainCreateRequest]) (*connect.Response[v1.SrDomainCreateResponse], error)
+	SrDomainDelete(context.Context, *connect.Request[v1.SrDomainDeleteRequest]) (*connect.Response[v1.SrDomainDeleteResponse], error)
+	SrDomainList(context.Context, *connect.Request[v1.SrDomainListRequest]) (*connect.Response[v1.SrDomainListResponse], error)
+	SrDomainFlush(context.Context, *connect.Request[v1.SrDomainFlushRequest]) (*connect.Response[v1.SrDomainFlushResponse], error)
+}
+
+// NewSrDomainServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewSrDomainServiceHandler(svc SrDomainServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	srDomainServiceSrDomainCreateHandler := connect.NewUnaryHandler(
+		SrDomainServiceSrDomainCreateProcedure,
+		svc.SrDomainCreate,
+		connect.WithSchema(srDomainServiceSrDomainCreateMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	srDomainServiceSrDomainDeleteHandler := connect.NewUnaryHandler(
+		SrDomainServiceSrDomainDeleteProcedure,
+		svc.SrDomainDelete,
+		connect.WithSchema(srDomainServiceSrDomainDeleteMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	srDomainServiceSrDomainListHandler := connect.NewUnaryHandler(
+		SrDomainServiceSrDomainListProcedure,
+		svc.SrDomainList,
+		connect.WithSchema(srDomainServiceSrDomainListMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	srDomainServiceSrDomainFlushHandler := connect.NewUnaryHandler(
+		SrDomainServiceSrDomainFlushProcedure,
+		svc.SrDomainFlush,
+		connect.WithSchema(srDomainServiceSrDomainFlushMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/vinbero.v1.SrDomainService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case SrDomainServiceSrDomainCreateProcedure:
+			srDomainServiceSrDomainCreateHandler.ServeHTTP(w, r)
+		case SrDomainServiceSrDomainDeleteProcedure:
+			srDomainServiceSrDomainDeleteHandler.ServeHTTP(w, r)
+		case SrDomainServiceSrDomainListProcedure:
+			srDomainServiceSrDomainListHandler.ServeHTTP(w, r)
+		case SrDomainServiceSrDomainFlushProcedure:
+			srDomainServiceSrDomainFlushHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedSrDomainServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedSrDomainServiceHandler struct{}
+
+func (UnimplementedSrDomainServiceHandler) SrDomainCreate(context.Context, *connect.Request[v1.SrDomainCreateRequest]) (*connect.Response[v1.SrDomainCreateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.SrDomainService.SrDomainCreate is not implemented"))
+}
+
+func (UnimplementedSrDomainServiceHandler) SrDomainDelete(context.Context, *connect.Request[v1.SrDomainDeleteRequest]) (*connect.Response[v1.SrDomainDeleteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.SrDomainService.SrDomainDelete is not implemented"))
+}
+
+func (UnimplementedSrDomainServiceHandler) SrDomainList(context.Context, *connect.Request[v1.SrDomainListRequest]) (*connect.Response[v1.SrDomainListResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.SrDomainService.SrDomainList is not implemented"))
+}
+
+func (UnimplementedSrDomainServiceHandler) SrDomainFlush(context.Context, *connect.Request[v1.SrDomainFlushRequest]) (*connect.Response[v1.SrDomainFlushResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.SrDomainService.SrDomainFlush is not implemented"))
+}
+
 // BdPeerServiceClient is a client for the vinbero.v1.BdPeerService service.
 type BdPeerServiceClient interface {
 	BdPeerCreate(context.Context, *connect.Request[v1.BdPeerCreateRequest]) (*connect.Response[v1.BdPeerCreateResponse], error)
+	BdPeerUpdate(context.Context, *connect.Request[v1.BdPeerUpdateRequest]) (*connect.Response[v1.BdPeerUpdateResponse], error)
 	BdPeerDelete(context.Context, *connect.Request[v1.BdPeerDeleteRequest]) (*connect.Response[v1.BdPeerDeleteResponse], error)
 	BdPeerList(context.Context, *connect.Request[v1.BdPeerListRequest]) (*connect.Response[v1.BdPeerListResponse], error)
 	BdPeerFlush(context.Context, *connect.Request[v1.BdPeerFlushRequest]) (*connect.Response[v1.BdPeerFlushResponse], error)
@@ -1081,6 +1511,12 @@ func NewBdPeerServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(bdPeerServiceBdPeerCreateMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		bdPeerUpdate: connect.NewClient[v1.BdPeerUpdateRequest, v1.BdPeerUpdateResponse](
+			httpClient,
+			baseURL+BdPeerServiceBdPeerUpdateProcedure,
+			connect.WithSchema(bdPeerServiceBdPeerUpdateMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		bdPeerDelete: connect.NewClient[v1.BdPeerDeleteRequest, v1.BdPeerDeleteResponse](
 			httpClient,
 			baseURL+BdPeerServiceBdPeerDeleteProcedure,
@@ -1105,6 +1541,7 @@ func NewBdPeerServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 // bdPeerServiceClient implements BdPeerServiceClient.
 type bdPeerServiceClient struct {
 	bdPeerCreate *connect.Client[v1.BdPeerCreateRequest, v1.BdPeerCreateResponse]
+	bdPeerUpdate *connect.Client[v1.BdPeerUpdateRequest, v1.BdPeerUpdateResponse]
 	bdPeerDelete *connect.Client[v1.BdPeerDeleteRequest, v1.BdPeerDeleteResponse]
 	bdPeerList   *connect.Client[v1.BdPeerListRequest, v1.BdPeerListResponse]
 	bdPeerFlush  *connect.Client[v1.BdPeerFlushRequest, v1.BdPeerFlushResponse]
@@ -1115,6 +1552,11 @@ func (c *bdPeerServiceClient) BdPeerCreate(ctx context.Context, req *connect.Req
 	return c.bdPeerCreate.CallUnary(ctx, req)
 }
 
+// BdPeerUpdate calls vinbero.v1.BdPeerService.BdPeerUpdate.
+func (c *bdPeerServiceClient) BdPeerUpdate(ctx context.Context, req *connect.Request[v1.BdPeerUpdateRequest]) (*connect.Response[v1.BdPeerUpdateResponse], error) {
+	return c.bdPeerUpdate.CallUnary(ctx, req)
+}
+
 // BdPeerDelete calls vinbero.v1.BdPeerService.BdPeerDelete.
 func (c *bdPeerServiceClient) BdPeerDelete(ctx context.Context, req *connect.Request[v1.BdPeerDeleteRequest]) (*connect.Response[v1.BdPeerDeleteResponse], error) {
 	return c.bdPeerDelete.CallUnary(ctx, req)
@@ -1133,6 +1575,7 @@ func (c *bdPeerServiceClient) BdPeerFlush(ctx context.Context, req *connect.Requ
 // BdPeerServiceHandler is an implementation of the vinbero.v1.BdPeerService service.
 type BdPeerServiceHandler interface {
 	BdPeerCreate(context.Context, *connect.Request[v1.BdPeerCreateRequest]) (*connect.Response[v1.BdPeerCreateResponse], error)
+	BdPeerUpdate(context.Context, *connect.Request[v1.BdPeerUpdateRequest]) (*connect.Response[v1.BdPeerUpdateResponse], error)
 	BdPeerDelete(context.Context, *connect.Request[v1.BdPeerDeleteRequest]) (*connect.Response[v1.BdPeerDeleteResponse], error)
 	BdPeerList(context.Context, *connect.Request[v1.BdPeerListRequest]) (*connect.Response[v1.BdPeerListResponse], error)
 	BdPeerFlush(context.Context, *connect.Request[v1.BdPeerFlushRequest]) (*connect.Response[v1.BdPeerFlushResponse], error)
@@ -1150,6 +1593,12 @@ func NewBdPeerServiceHandler(svc BdPeerServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(bdPeerServiceBdPeerCreateMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	bdPeerServiceBdPeerUpdateHandler := connect.NewUnaryHandler(
+		BdPeerServiceBdPeerUpdateProcedure,
+		svc.BdPeerUpdate,
+		connect.WithSchema(bdPeerServiceBdPeerUpdateMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	bdPeerServiceBdPeerDeleteHandler := connect.NewUnaryHandler(
 		BdPeerServiceBdPeerDeleteProcedure,
 		svc.BdPeerDelete,
@@ -1172,6 +1621,8 @@ func NewBdPeerServiceHandler(svc BdPeerServiceHandler, opts ...connect.HandlerOp
 		switch r.URL.Path {
 		case BdPeerServiceBdPeerCreateProcedure:
 			bdPeerServiceBdPeerCreateHandler.ServeHTTP(w, r)
+		case BdPeerServiceBdPeerUpdateProcedure:
+			bdPeerServiceBdPeerUpdateHandler.ServeHTTP(w, r)
 		case BdPeerServiceBdPeerDeleteProcedure:
 			bdPeerServiceBdPeerDeleteHandler.ServeHTTP(w, r)
 		case BdPeerServiceBdPeerListProcedure:
@@ -1191,6 +1642,10 @@ func (UnimplementedBdPeerServiceHandler) BdPeerCreate(context.Context, *connect.
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.BdPeerService.BdPeerCreate is not implemented"))
 }
 
+func (UnimplementedBdPeerServiceHandler) BdPeerUpdate(context.Context, *connect.Request[v1.BdPeerUpdateRequest]) (*connect.Response[v1.BdPeerUpdateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.BdPeerService.BdPeerUpdate is not implemented"))
+}
+
 func (UnimplementedBdPeerServiceHandler) BdPeerDelete(context.Context, *connect.Request[v1.BdPeerDeleteRequest]) (*connect.Response[v1.BdPeerDeleteResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.BdPeerService.BdPeerDelete is not implemented"))
 }
@@ -1203,226 +1658,856 @@ func (UnimplementedBdPeerServiceHandler) BdPeerFlush(context.Context, *connect.R
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.BdPeerService.BdPeerFlush is not implemented"))
 }
 
-// EthernetSegmentServiceClient is a client for the vinbero.v1.EthernetSegmentService service.
-type EthernetSegmentServiceClient interface {
-	EsCreate(context.Context, *connect.Request[v1.EsCreateRequest]) (*connect.Response[v1.EsCreateResponse], error)
-	EsDelete(context.Context, *connect.Request[v1.EsDeleteRequest]) (*connect.Response[v1.EsDeleteResponse], error)
-	EsList(context.Context, *connect.Request[v1.EsListRequest]) (*connect.Response[v1.EsListResponse], error)
-	EsSetDf(context.Context, *connect.Request[v1.EsSetDfRequest]) (*connect.Response[v1.EsSetDfResponse], error)
-	EsClearDf(context.Context, *connect.Request[v1.EsClearDfRequest]) (*connect.Response[v1.EsClearDfResponse], error)
+// VniMappingServiceClient is a client for the vinbero.v1.VniMappingService service.
+type VniMappingServiceClient interface {
+	VniMappingCreate(context.Context, *connect.Request[v1.VniMappingCreateRequest]) (*connect.Response[v1.VniMappingCreateResponse], error)
+	VniMappingDelete(context.Context, *connect.Request[v1.VniMappingDeleteRequest]) (*connect.Response[v1.VniMappingDeleteResponse], error)
+	VniMappingList(context.Context, *connect.Request[v1.VniMappingListRequest]) (*connect.Response[v1.VniMappingListResponse], error)
+	VniMappingFlush(context.Context, *connect.Request[v1.VniMappingFlushRequest]) (*connect.Response[v1.VniMappingFlushResponse], error)
 }
 
-// NewEthernetSegmentServiceClient constructs a client for the vinbero.v1.EthernetSegmentService
-// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
-// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
-// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+// NewVniMappingServiceClient constructs a client for the vinbero.v1.VniMappingService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
 //
 // The URL supplied here should be the base URL for the Connect or gRPC server (for example,
 // http://api.acme.com or https://acme.com/grpc).
-func NewEthernetSegmentServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) EthernetSegmentServiceClient {
+func NewVniMappingServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) VniMappingServiceClient {
 	baseURL = strings.TrimRight(baseURL, "/")
-	return &ethernetSegmentServiceClient{
-		esCreate: connect.NewClient[v1.EsCreateRequest, v1.EsCreateResponse](
-			httpClient,
-			baseURL+EthernetSegmentServiceEsCreateProcedure,
-			connect.WithSchema(ethernetSegmentServiceEsCreateMethodDescriptor),
-			connect.WithClientOptions(opts...),
-		),
-		esDelete: connect.NewClient[v1.EsDeleteRequest, v1.EsDeleteResponse](
+	return &vniMappingServiceClient{
+		vniMappingCreate: connect.NewClient[v1.VniMappingCreateRequest, v1.VniMappingCreateResponse](
 			httpClient,
-			baseURL+EthernetSegmentServiceEsDeleteProcedure,
-			connect.WithSchema(ethernetSegmentServiceEsDeleteMethodDescriptor),
+			baseURL+VniMappingServiceVniMappingCreateProcedure,
+			connect.WithSchema(vniMappingServiceVniMappingCreateMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
-		esList: connect.NewClient[v1.EsListRequest, v1.EsListResponse](
+		vniMappingDelete: connect.NewClient[v1.VniMappingDeleteRequest, v1.VniMappingDeleteResponse](
 			httpClient,
-			baseURL+EthernetSegmentServiceEsListProcedure,
-			connect.WithSchema(ethernetSegmentServiceEsListMethodDescriptor),
+			baseURL+VniMappingServiceVniMappingDeleteProcedure,
+			connect.WithSchema(vniMappingServiceVniMappingDeleteMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
-		esSetDf: connect.NewClient[v1.EsSetDfRequest, v1.EsSetDfResponse](
+		vniMappingList: connect.NewClient[v1.VniMappingListRequest, v1.VniMappingListResponse](
 			httpClient,
-			baseURL+EthernetSegmentServiceEsSetDfProcedure,
-			connect.WithSchema(ethernetSegmentServiceEsSetDfMethodDescriptor),
+			baseURL+VniMappingServiceVniMappingListProcedure,
+			connect.WithSchema(vniMappingServiceVniMappingListMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
-		esClearDf: connect.NewClient[v1.EsClearDfRequest, v1.EsClearDfResponse](
+		vniMappingFlush: connect.NewClient[v1.VniMappingFlushRequest, v1.VniMappingFlushResponse](
 			httpClient,
-			baseURL+EthernetSegmentServiceEsClearDfProcedure,
-			connect.WithSchema(ethernetSegmentServiceEsClearDfMethodDescriptor),
+			baseURL+VniMappingServiceVniMappingFlushProcedure,
+			connect.WithSchema(vniMappingServiceVniMappingFlushMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
 	}
 }
 
-// ethernetSegmentServiceClient implements EthernetSegmentServiceClient.
-type ethernetSegmentServiceClient struct {
-	esCreate  *connect.Client[v1.EsCreateRequest, v1.EsCreateResponse]
-	esDelete  *connect.Client[v1.EsDeleteRequest, v1.EsDeleteResponse]
-	esList    *connect.Client[v1.EsListRequest, v1.EsListResponse]
-	esSetDf   *connect.Client[v1.EsSetDfRequest, v1.EsSetDfResponse]
-	esClearDf *connect.Client[v1.EsClearDfRequest, v1.EsClearDfResponse]
-}
-
-// EsCreate calls vinbero.v1.EthernetSegmentService.EsCreate.
-func (c *ethernetSegmentServiceClient) EsCreate(ctx context.Context, req *connect.Request[v1.EsCreateRequest]) (*connect.Response[v1.EsCreateResponse], error) {
-	return c.esCreate.CallUnary(ctx, req)
+// vniMappingServiceClient implements VniMappingServiceClient.
+type vniMappingServiceClient struct {
+	vniMappingCreate *connect.Client[v1.VniMappingCreateRequest, v1.VniMappingCreateResponse]
+	vniMappingDelete *connect.Client[v1.VniMappingDeleteRequest, v1.VniMappingDeleteResponse]
+	vniMappingList   *connect.Client[v1.VniMappingListRequest, v1.VniMappingListResponse]
+	vniMappingFlush  *connect.Client[v1.VniMappingFlushRequest, v1.VniMappingFlushResponse]
 }
 
-// EsDelete calls vinbero.v1.EthernetSegmentService.EsDelete.
-func (c *ethernetSegmentServiceClient) EsDelete(ctx context.Context, req *connect.Request[v1.EsDeleteRequest]) (*connect.Response[v1.EsDeleteResponse], error) {
-	return c.esDelete.CallUnary(ctx, req)
+// VniMappingCreate calls vinbero.v1.VniMappingService.VniMappingCreate.
+func (c *vniMappingServiceClient) VniMappingCreate(ctx context.Context, req *connect.Request[v1.VniMappingCreateRequest]) (*connect.Response[v1.VniMappingCreateResponse], error) {
+	return c.vniMappingCreate.CallUnary(ctx, req)
 }
 
-// EsList calls vinbero.v1.EthernetSegmentService.EsList.
-func (c *ethernetSegmentServiceClient) EsList(ctx context.Context, req *connect.Request[v1.EsListRequest]) (*connect.Response[v1.EsListResponse], error) {
-	return c.esList.CallUnary(ctx, req)
+// VniMappingDelete calls vinbero.v1.VniMappingService.VniMappingDelete.
+func (c *vniMappingServiceClient) VniMappingDelete(ctx context.Context, req *connect.Request[v1.VniMappingDeleteRequest]) (*connect.Response[v1.VniMappingDeleteResponse], error) {
+	return c.vniMappingDelete.CallUnary(ctx, req)
 }
 
-// EsSetDf calls vinbero.v1.EthernetSegmentService.EsSetDf.
-func (c *ethernetSegmentServiceClient) EsSetDf(ctx context.Context, req *connect.Request[v1.EsSetDfRequest]) (*connect.Response[v1.EsSetDfResponse], error) {
-	return c.esSetDf.CallUnary(ctx, req)
+// VniMappingList calls vinbero.v1.VniMappingService.VniMappingList.
+func (c *vniMappingServiceClient) VniMappingList(ctx context.Context, req *connect.Request[v1.VniMappingListRequest]) (*connect.Response[v1.VniMappingListResponse], error) {
+	return c.vniMappingList.CallUnary(ctx, req)
 }
 
-// EsClearDf calls vinbero.v1.EthernetSegmentService.EsClearDf.
-func (c *ethernetSegmentServiceClient) EsClearDf(ctx context.Context, req *connect.Request[v1.EsClearDfRequest]) (*connect.Response[v1.EsClearDfResponse], error) {
-	return c.esClearDf.CallUnary(ctx, req)
+// VniMappingFlush calls vinbero.v1.VniMappingService.VniMappingFlush.
+func (c *vniMappingServiceClient) VniMappingFlush(ctx context.Context, req *connect.Request[v1.VniMappingFlushRequest]) (*connect.Response[v1.VniMappingFlushResponse], error) {
+	return c.vniMappingFlush.CallUnary(ctx, req)
 }
 
-// EthernetSegmentServiceHandler is an implementation of the vinbero.v1.EthernetSegmentService
-// service.
-type EthernetSegmentServiceHandler interface {
-	EsCreate(context.Context, *connect.Request[v1.EsCreateRequest]) (*connect.Response[v1.EsCreateResponse], error)
-	EsDelete(context.Context, *connect.Request[v1.EsDeleteRequest]) (*connect.Response[v1.EsDeleteResponse], error)
-	EsList(context.Context, *connect.Request[v1.EsListRequest]) (*connect.Response[v1.EsListResponse], error)
-	EsSetDf(context.Context, *connect.Request[v1.EsSetDfRequest]) (*connect.Response[v1.EsSetDfResponse], error)
-	EsClearDf(context.Context, *connect.Request[v1.EsClearDfRequest]) (*connect.Response[v1.EsClearDfResponse], error)
+// VniMappingServiceHandler is an implementation of the vinbero.v1.VniMappingService service.
+type VniMappingServiceHandler interface {
+	VniMappingCreate(context.Context, *connect.Request[v1.VniMappingCreateRequest]) (*connect.Response[v1.VniMappingCreateResponse], error)
+	VniMappingDelete(context.Context, *connect.Request[v1.VniMappingDeleteRequest]) (*connect.Response[v1.VniMappingDeleteResponse], error)
+	VniMappingList(context.Context, *connect.Request[v1.VniMappingListRequest]) (*connect.Response[v1.VniMappingListResponse], error)
+	VniMappingFlush(context.Context, *connect.Request[v1.VniMappingFlushRequest]) (*connect.Response[v1.VniMappingFlushResponse], error)
 }
 
-// NewEthernetSegmentServiceHandler builds an HTTP handler from the service implementation. It
-// returns the path on which to mount the handler and the handler itself.
+// NewVniMappingServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
 //
 // By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
 // and JSON codecs. They also support gzip compression.
-func NewEthernetSegmentServiceHandler(svc EthernetSegmentServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
-	ethernetSegmentServiceEsCreateHandler := connect.NewUnaryHandler(
-		EthernetSegmentServiceEsCreateProcedure,
-		svc.EsCreate,
-		connect.WithSchema(ethernetSegmentServiceEsCreateMethodDescriptor),
+func NewVniMappingServiceHandler(svc VniMappingServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	vniMappingServiceVniMappingCreateHandler := connect.NewUnaryHandler(
+		VniMappingServiceVniMappingCreateProcedure,
+		svc.VniMappingCreate,
+		connect.WithSchema(vniMappingServiceVniMappingCreateMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
-	ethernetSegmentServiceEsDeleteHandler := connect.NewUnaryHandler(
-		EthernetSegmentServiceEsDeleteProcedure,
-		svc.EsDelete,
-		connect.WithSchema(ethernetSegmentServiceEsDeleteMethodDescriptor),
-		connect.WithHandlerOptions(opts...),
-	)
-	ethernetSegmentServiceEsListHandler := connect.NewUnaryHandler(
-		EthernetSegmentServiceEsListProcedure,
-		svc.EsList,
-		connect.WithSchema(ethernetSegmentServiceEsListMethodDescriptor),
+	vniMappingServiceVniMappingDeleteHandler := connect.NewUnaryHandler(
+		VniMappingServiceVniMappingDeleteProcedure,
+		svc.VniMappingDelete,
+		connect.WithSchema(vniMappingServiceVniMappingDeleteMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
-	ethernetSegmentServiceEsSetDfHandler := connect.NewUnaryHandler(
-		EthernetSegmentServiceEsSetDfProcedure,
-		svc.EsSetDf,
-		connect.WithSchema(ethernetSegmentServiceEsSetDfMethodDescriptor),
+	vniMappingServiceVniMappingListHandler := connect.NewUnaryHandler(
+		VniMappingServiceVniMappingListProcedure,
+		svc.VniMappingList,
+		connect.WithSchema(vniMappingServiceVniMappingListMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
-	ethernetSegmentServiceEsClearDfHandler := connect.NewUnaryHandler(
-		EthernetSegmentServiceEsClearDfProcedure,
-		svc.EsClearDf,
-		connect.WithSchema(ethernetSegmentServiceEsClearDfMethodDescriptor),
+	vniMappingServiceVniMappingFlushHandler := connect.NewUnaryHandler(
+		VniMappingServiceVniMappingFlushProcedure,
+		svc.VniMappingFlush,
+		connect.WithSchema(vniMappingServiceVniMappingFlushMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
-	return "/vinbero.v1.EthernetSegmentService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	return "/vinbero.v1.VniMappingService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
-		case EthernetSegmentServiceEsCreateProcedure:
-			ethernetSegmentServiceEsCreateHandler.ServeHTTP(w, r)
-		case EthernetSegmentServiceEsDeleteProcedure:
-			ethernetSegmentServiceEsDeleteHandler.ServeHTTP(w, r)
-		case EthernetSegmentServiceEsListProcedure:
-			ethernetSegmentServiceEsListHandler.ServeHTTP(w, r)
-		case EthernetSegmentServiceEsSetDfProcedure:
-			ethernetSegmentServiceEsSetDfHandler.ServeHTTP(w, r)
-		case EthernetSegmentServiceEsClearDfProcedure:
-			ethernetSegmentServiceEsClearDfHandler.ServeHTTP(w, r)
+		case VniMappingServiceVniMappingCreateProcedure:
+			vniMappingServiceVniMappingCreateHandler.ServeHTTP(w, r)
+		case VniMappingServiceVniMappingDeleteProcedure:
+			vniMappingServiceVniMappingDeleteHandler.ServeHTTP(w, r)
+		case VniMappingServiceVniMappingListProcedure:
+			vniMappingServiceVniMappingListHandler.ServeHTTP(w, r)
+		case VniMappingServiceVniMappingFlushProcedure:
+			vniMappingServiceVniMappingFlushHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
 	})
 }
 
-// UnimplementedEthernetSegmentServiceHandler returns CodeUnimplemented from all methods.
-type UnimplementedEthernetSegmentServiceHandler struct{}
-
-func (UnimplementedEthernetSegmentServiceHandler) EsCreate(context.Context, *connect.Request[v1.EsCreateRequest]) (*connect.Response[v1.EsCreateResponse], error) {
-	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.EthernetSegmentService.EsCreate is not implemented"))
-}
+// UnimplementedVniMappingServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedVniMappingServiceHandler struct{}
 
-func (UnimplementedEthernetSegmentServiceHandler) EsDelete(context.Context, *connect.Request[v1.EsDeleteRequest]) (*connect.Response[v1.EsDeleteResponse], error) {
-	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.EthernetSegmentService.EsDelete is not implemented"))
+func (UnimplementedVniMappingServiceHandler) VniMappingCreate(context.Context, *connect.Request[v1.VniMappingCreateRequest]) (*connect.Response[v1.VniMappingCreateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.VniMappingService.VniMappingCreate is not implemented"))
 }
 
-func (UnimplementedEthernetSegmentServiceHandler) EsList(context.Context, *connect.Request[v1.EsListRequest]) (*connect.Response[v1.EsListResponse], error) {
-	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.EthernetSegmentService.EsList is not implemented"))
+func (UnimplementedVniMappingServiceHandler) VniMappingDelete(context.Context, *connect.Request[v1.VniMappingDeleteRequest]) (*connect.Response[v1.VniMappingDeleteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.VniMappingService.VniMappingDelete is not implemented"))
 }
 
-func (UnimplementedEthernetSegmentServiceHandler) EsSetDf(context.Context, *connect.Request[v1.EsSetDfRequest]) (*connect.Response[v1.EsSetDfResponse], error) {
-	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.EthernetSegmentService.EsSetDf is not implemented"))
+func (UnimplementedVniMappingServiceHandler) VniMappingList(context.Context, *connect.Request[v1.VniMappingListRequest]) (*connect.Response[v1.VniMappingListResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.VniMappingService.VniMappingList is not implemented"))
 }
 
-func (UnimplementedEthernetSegmentServiceHandler) EsClearDf(context.Context, *connect.Request[v1.EsClearDfRequest]) (*connect.Response[v1.EsClearDfResponse], error) {
-	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.EthernetSegmentService.EsClearDf is not implemented"))
+func (UnimplementedVniMappingServiceHandler) VniMappingFlush(context.Context, *connect.Request[v1.VniMappingFlushRequest]) (*connect.Response[v1.VniMappingFlushResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.VniMappingService.VniMappingFlush is not implemented"))
 }
 
-// NetworkResourceServiceClient is a client for the vinbero.v1.NetworkResourceService service.
-type NetworkResourceServiceClient interface {
-	VrfCreate(context.Context, *connect.Request[v1.VrfCreateRequest]) (*connect.Response[v1.VrfCreateResponse], error)
-	VrfDelete(context.Context, *connect.Request[v1.VrfDeleteRequest]) (*connect.Response[v1.VrfDeleteResponse], error)
-	VrfList(context.Context, *connect.Request[v1.VrfListRequest]) (*connect.Response[v1.VrfListResponse], error)
-	BridgeCreate(context.Context, *connect.Request[v1.BridgeCreateRequest]) (*connect.Response[v1.BridgeCreateResponse], error)
-	BridgeDelete(context.Context, *connect.Request[v1.BridgeDeleteRequest]) (*connect.Response[v1.BridgeDeleteResponse], error)
-	BridgeList(context.Context, *connect.Request[v1.BridgeListRequest]) (*connect.Response[v1.BridgeListResponse], error)
+// BridgeDomainServiceClient is a client for the vinbero.v1.BridgeDomainService service.
+type BridgeDomainServiceClient interface {
+	BridgeDomainCreate(context.Context, *connect.Request[v1.BridgeDomainCreateRequest]) (*connect.Response[v1.BridgeDomainCreateResponse], error)
+	BridgeDomainDelete(context.Context, *connect.Request[v1.BridgeDomainDeleteRequest]) (*connect.Response[v1.BridgeDomainDeleteResponse], error)
+	BridgeDomainGet(context.Context, *connect.Request[v1.BridgeDomainGetRequest]) (*connect.Response[v1.BridgeDomainGetResponse], error)
+	BridgeDomainList(context.Context, *connect.Request[v1.BridgeDomainListRequest]) (*connect.Response[v1.BridgeDomainListResponse], error)
+	MacLimitSet(context.Context, *connect.Request[v1.MacLimitSetRequest]) (*connect.Response[v1.MacLimitSetResponse], error)
+	MacLimitDelete(context.Context, *connect.Request[v1.MacLimitDeleteRequest]) (*connect.Response[v1.MacLimitDeleteResponse], error)
+	MacLimitList(context.Context, *connect.Request[v1.MacLimitListRequest]) (*connect.Response[v1.MacLimitListResponse], error)
+	StormControlSet(context.Context, *connect.Request[v1.StormControlSetRequest]) (*connect.Response[v1.StormControlSetResponse], error)
+	StormControlDelete(context.Context, *connect.Request[v1.StormControlDeleteRequest]) (*connect.Response[v1.StormControlDeleteResponse], error)
+	StormControlList(context.Context, *connect.Request[v1.StormControlListRequest]) (*connect.Response[v1.StormControlListResponse], error)
+	NeighborCreate(context.Context, *connect.Request[v1.NeighborCreateRequest]) (*connect.Response[v1.NeighborCreateResponse], error)
+	NeighborDelete(context.Context, *connect.Request[v1.NeighborDeleteRequest]) (*connect.Response[v1.NeighborDeleteResponse], error)
+	NeighborList(context.Context, *connect.Request[v1.NeighborListRequest]) (*connect.Response[v1.NeighborListResponse], error)
+	IrbRouteCreate(context.Context, *connect.Request[v1.IrbRouteCreateRequest]) (*connect.Response[v1.IrbRouteCreateResponse], error)
+	IrbRouteDelete(context.Context, *connect.Request[v1.IrbRouteDeleteRequest]) (*connect.Response[v1.IrbRouteDeleteResponse], error)
+	IrbRouteList(context.Context, *connect.Request[v1.IrbRouteListRequest]) (*connect.Response[v1.IrbRouteListResponse], error)
 }
 
-// NewNetworkResourceServiceClient constructs a client for the vinbero.v1.NetworkResourceService
-// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
-// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
-// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+// NewBridgeDomainServiceClient constructs a client for the vinbero.v1.BridgeDomainService service.
+// By default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped
+// responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
 //
 // The URL supplied here should be the base URL for the Connect or gRPC server (for example,
 // http://api.acme.com or https://acme.com/grpc).
-func NewNetworkResourceServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) NetworkResourceServiceClient {
+func NewBridgeDomainServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) BridgeDomainServiceClient {
 	baseURL = strings.TrimRight(baseURL, "/")
-	return &networkResourceServiceClient{
-		vrfCreate: connect.NewClient[v1.VrfCreateRequest, v1.VrfCreateResponse](
+	return &bridgeDomainServiceClient{
+		bridgeDomainCreate: connect.NewClient[v1.BridgeDomainCreateRequest, v1.BridgeDomainCreateResponse](
 			httpClient,
-			baseURL+NetworkResourceServiceVrfCreateProcedure,
-			connect.WithSchema(networkResourceServiceVrfCreateMethodDescriptor),
+			baseURL+BridgeDomainServiceBridgeDomainCreateProcedure,
+			connect.WithSchema(bridgeDomainServiceBridgeDomainCreateMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
-		vrfDelete: connect.NewClient[v1.VrfDeleteRequest, v1.VrfDeleteResponse](
+		bridgeDomainDelete: connect.NewClient[v1.BridgeDomainDeleteRequest, v1.BridgeDomainDeleteResponse](
 			httpClient,
-			baseURL+NetworkResourceServiceVrfDeleteProcedure,
-			connect.WithSchema(networkResourceServiceVrfDeleteMethodDescriptor),
+			baseURL+BridgeDomainServiceBridgeDomainDeleteProcedure,
+			connect.WithSchema(bridgeDomainServiceBridgeDomainDeleteMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
-		vrfList: connect.NewClient[v1.VrfListRequest, v1.VrfListResponse](
+		bridgeDomainGet: connect.NewClient[v1.BridgeDomainGetRequest, v1.BridgeDomainGetResponse](
 			httpClient,
-			baseURL+NetworkResourceServiceVrfListProcedure,
-			connect.WithSchema(networkResourceServiceVrfListMethodDescriptor),
+			baseURL+BridgeDomainServiceBridgeDomainGetProcedure,
+			connect.WithSchema(bridgeDomainServiceBridgeDomainGetMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
-		bridgeCreate: connect.NewClient[v1.BridgeCreateRequest, v1.BridgeCreateResponse](
+		bridgeDomainList: connect.NewClient[v1.BridgeDomainListRequest, v1.BridgeDomainListResponse](
 			httpClient,
-			baseURL+NetworkResourceServiceBridgeCreateProcedure,
-			connect.WithSchema(networkResourceServiceBridgeCreateMethodDescriptor),
+			baseURL+BridgeDomainServiceBridgeDomainListProcedure,
+			connect.WithSchema(bridgeDomainServiceBridgeDomainListMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
-		bridgeDelete: connect.NewClient[v1.BridgeDeleteRequest, v1.BridgeDeleteResponse](
+		macLimitSet: connect.NewClient[v1.MacLimitSetRequest, v1.MacLimitSetResponse](
 			httpClient,
-			baseURL+NetworkResourceServiceBridgeDeleteProcedure,
+			baseURL+BridgeDomainServiceMacLimitSetProcedure,
+			connect.WithSchema(bridgeDomainServiceMacLimitSetMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		macLimitDelete: connect.NewClient[v1.MacLimitDeleteRequest, v1.MacLimitDeleteResponse](
+			httpClient,
+			baseURL+BridgeDomainServiceMacLimitDeleteProcedure,
+			connect.WithSchema(bridgeDomainServiceMacLimitDeleteMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		macLimitList: connect.NewClient[v1.MacLimitListRequest, v1.MacLimitListResponse](
+			httpClient,
+			baseURL+BridgeDomainServiceMacLimitListProcedure,
+			connect.WithSchema(bridgeDomainServiceMacLimitListMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		stormControlSet: connect.NewClient[v1.StormControlSetRequest, v1.StormControlSetResponse](
+			httpClient,
+			baseURL+BridgeDomainServiceStormControlSetProcedure,
+			connect.WithSchema(bridgeDomainServiceStormControlSetMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		stormControlDelete: connect.NewClient[v1.StormControlDeleteRequest, v1.StormControlDeleteResponse](
+			httpClient,
+			baseURL+BridgeDomainServiceStormControlDeleteProcedure,
+			connect.WithSchema(bridgeDomainServiceStormControlDeleteMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		stormControlList: connect.NewClient[v1.StormControlListRequest, v1.StormControlListResponse](
+			httpClient,
+			baseURL+BridgeDomainServiceStormControlListProcedure,
+			connect.WithSchema(bridgeDomainServiceStormControlListMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		neighborCreate: connect.NewClient[v1.NeighborCreateRequest, v1.NeighborCreateResponse](
+			httpClient,
+			baseURL+BridgeDomainServiceNeighborCreateProcedure,
+			connect.WithSchema(bridgeDomainServiceNeighborCreateMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		neighborDelete: connect.NewClient[v1.NeighborDeleteRequest, v1.NeighborDeleteResponse](
+			httpClient,
+			baseURL+BridgeDomainServiceNeighborDeleteProcedure,
+			connect.WithSchema(bridgeDomainServiceNeighborDeleteMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		neighborList: connect.NewClient[v1.NeighborListRequest, v1.NeighborListResponse](
+			httpClient,
+			baseURL+BridgeDomainServiceNeighborListProcedure,
+			connect.WithSchema(bridgeDomainServiceNeighborListMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		irbRouteCreate: connect.NewClient[v1.IrbRouteCreateRequest, v1.IrbRouteCreateResponse](
+			httpClient,
+			baseURL+BridgeDomainServiceIrbRouteCreateProcedure,
+			connect.WithSchema(bridgeDomainServiceIrbRouteCreateMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		irbRouteDelete: connect.NewClient[v1.IrbRouteDeleteRequest, v1.IrbRouteDeleteResponse](
+			httpClient,
+			baseURL+BridgeDomainServiceIrbRouteDeleteProcedure,
+			connect.WithSchema(bridgeDomainServiceIrbRouteDeleteMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		irbRouteList: connect.NewClient[v1.IrbRouteListRequest, v1.IrbRouteListResponse](
+			httpClient,
+			baseURL+BridgeDomainServiceIrbRouteListProcedure,
+			connect.WithSchema(bridgeDomainServiceIrbRouteListMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// bridgeDomainServiceClient implements BridgeDomainServiceClient.
+type bridgeDomainServiceClient struct {
+	bridgeDomainCreate *connect.Client[v1.BridgeDomainCreateRequest, v1.BridgeDomainCreateResponse]
+	bridgeDomainDelete *connect.Client[v1.BridgeDomainDeleteRequest, v1.BridgeDomainDeleteResponse]
+	bridgeDomainGet    *connect.Client[v1.BridgeDomainGetRequest, v1.BridgeDomainGetResponse]
+	bridgeDomainList   *connect.Client[v1.BridgeDomainListRequest, v1.BridgeDomainListResponse]
+	macLimitSet        *connect.Client[v1.MacLimitSetRequest, v1.MacLimitSetResponse]
+	macLimitDelete     *connect.Client[v1.MacLimitDeleteRequest, v1.MacLimitDeleteResponse]
+	macLimitList       *connect.Client[v1.MacLimitListRequest, v1.MacLimitListResponse]
+	stormControlSet    *connect.Client[v1.StormControlSetRequest, v1.StormControlSetResponse]
+	stormControlDelete *connect.Client[v1.StormControlDeleteRequest, v1.StormControlDeleteResponse]
+	stormControlList   *connect.Client[v1.StormControlListRequest, v1.StormControlListResponse]
+	neighborCreate     *connect.Client[v1.NeighborCreateRequest, v1.NeighborCreateResponse]
+	neighborDelete     *connect.Client[v1.NeighborDeleteRequest, v1.NeighborDeleteResponse]
+	neighborList       *connect.Client[v1.NeighborListRequest, v1.NeighborListResponse]
+	irbRouteCreate     *connect.Client[v1.IrbRouteCreateRequest, v1.IrbRouteCreateResponse]
+	irbRouteDelete     *connect.Client[v1.IrbRouteDeleteRequest, v1.IrbRouteDeleteResponse]
+	irbRouteList       *connect.Client[v1.IrbRouteListRequest, v1.IrbRouteListResponse]
+}
+
+// BridgeDomainCreate calls vinbero.v1.BridgeDomainService.BridgeDomainCreate.
+func (c *bridgeDomainServiceClient) BridgeDomainCreate(ctx context.Context, req *connect.Request[v1.BridgeDomainCreateRequest]) (*connect.Response[v1.BridgeDomainCreateResponse], error) {
+	return c.bridgeDomainCreate.CallUnary(ctx, req)
+}
+
+// BridgeDomainDelete calls vinbero.v1.BridgeDomainService.BridgeDomainDelete.
+func (c *bridgeDomainServiceClient) BridgeDomainDelete(ctx context.Context, req *connect.Request[v1.BridgeDomainDeleteRequest]) (*connect.Response[v1.BridgeDomainDeleteResponse], error) {
+	return c.bridgeDomainDelete.CallUnary(ctx, req)
+}
+
+// BridgeDomainGet calls vinbero.v1.BridgeDomainService.BridgeDomainGet.
+func (c *bridgeDomainServiceClient) BridgeDomainGet(ctx context.Context, req *connect.Request[v1.BridgeDomainGetRequest]) (*connect.Response[v1.BridgeDomainGetResponse], error) {
+	return c.bridgeDomainGet.CallUnary(ctx, req)
+}
+
+// BridgeDomainList calls vinbero.v1.BridgeDomainService.BridgeDomainList.
+func (c *bridgeDomainServiceClient) BridgeDomainList(ctx context.Context, req *connect.Request[v1.BridgeDomainListRequest]) (*connect.Response[v1.BridgeDomainListResponse], error) {
+	return c.bridgeDomainList.CallUnary(ctx, req)
+}
+
+// MacLimitSet calls vinbero.v1.BridgeDomainService.MacLimitSet.
+func (c *bridgeDomainServiceClient) MacLimitSet(ctx context.Context, req *connect.Request[v1.MacLimitSetRequest]) (*connect.Response[v1.MacLimitSetResponse], error) {
+	return c.macLimitSet.CallUnary(ctx, req)
+}
+
+// MacLimitDelete calls vinbero.v1.BridgeDomainService.MacLimitDelete.
+func (c *bridgeDomainServiceClient) MacLimitDelete(ctx context.Context, req *connect.Request[v1.MacLimitDeleteRequest]) (*connect.Response[v1.MacLimitDeleteResponse], error) {
+	return c.macLimitDelete.CallUnary(ctx, req)
+}
+
+// MacLimitList calls vinbero.v1.BridgeDomainService.MacLimitList.
+func (c *bridgeDomainServiceClient) MacLimitList(ctx context.Context, req *connect.Request[v1.MacLimitListRequest]) (*connect.Response[v1.MacLimitListResponse], error) {
+	return c.macLimitList.CallUnary(ctx, req)
+}
+
+// StormControlSet calls vinbero.v1.BridgeDomainService.StormControlSet.
+func (c *bridgeDomainServiceClient) StormControlSet(ctx context.Context, req *connect.Request[v1.StormControlSetRequest]) (*connect.Response[v1.StormControlSetResponse], error) {
+	return c.stormControlSet.CallUnary(ctx, req)
+}
+
+// StormControlDelete calls vinbero.v1.BridgeDomainService.StormControlDelete.
+func (c *bridgeDomainServiceClient) StormControlDelete(ctx context.Context, req *connect.Request[v1.StormControlDeleteRequest]) (*connect.Response[v1.StormControlDeleteResponse], error) {
+	return c.stormControlDelete.CallUnary(ctx, req)
+}
+
+// StormControlList calls vinbero.v1.BridgeDomainService.StormControlList.
+func (c *bridgeDomainServiceClient) StormControlList(ctx context.Context, req *connect.Request[v1.StormControlListRequest]) (*connect.Response[v1.StormControlListResponse], error) {
+	return c.stormControlList.CallUnary(ctx, req)
+}
+
+// NeighborCreate calls vinbero.v1.BridgeDomainService.NeighborCreate.
+func (c *bridgeDomainServiceClient) NeighborCreate(ctx context.Context, req *connect.Request[v1.NeighborCreateRequest]) (*connect.Response[v1.NeighborCreateResponse], error) {
+	return c.neighborCreate.CallUnary(ctx, req)
+}
+
+// NeighborDelete calls vinbero.v1.BridgeDomainService.NeighborDelete.
+func (c *bridgeDomainServiceClient) NeighborDelete(ctx context.Context, req *connect.Request[v1.NeighborDeleteRequest]) (*connect.Response[v1.NeighborDeleteResponse], error) {
+	return c.neighborDelete.CallUnary(ctx, req)
+}
+
+// NeighborList calls vinbero.v1.BridgeDomainService.NeighborList.
+func (c *bridgeDomainServiceClient) NeighborList(ctx context.Context, req *connect.Request[v1.NeighborListRequest]) (*connect.Response[v1.NeighborListResponse], error) {
+	return c.neighborList.CallUnary(ctx, req)
+}
+
+// IrbRouteCreate calls vinbero.v1.BridgeDomainService.IrbRouteCreate.
+func (c *bridgeDomainServiceClient) IrbRouteCreate(ctx context.Context, req *connect.Request[v1.IrbRouteCreateRequest]) (*connect.Response[v1.IrbRouteCreateResponse], error) {
+	return c.irbRouteCreate.CallUnary(ctx, req)
+}
+
+// IrbRouteDelete calls vinbero.v1.BridgeDomainService.IrbRouteDelete.
+func (c *bridgeDomainServiceClient) IrbRouteDelete(ctx context.Context, req *connect.Request[v1.IrbRouteDeleteRequest]) (*connect.Response[v1.IrbRouteDeleteResponse], error) {
+	return c.irbRouteDelete.CallUnary(ctx, req)
+}
+
+// IrbRouteList calls vinbero.v1.BridgeDomainService.IrbRouteList.
+func (c *bridgeDomainServiceClient) IrbRouteList(ctx context.Context, req *connect.Request[v1.IrbRouteListRequest]) (*connect.Response[v1.IrbRouteListResponse], error) {
+	return c.irbRouteList.CallUnary(ctx, req)
+}
+
+// BridgeDomainServiceHandler is an implementation of the vinbero.v1.BridgeDomainService service.
+type BridgeDomainServiceHandler interface {
+	BridgeDomainCreate(context.Context, *connect.Request[v1.BridgeDomainCreateRequest]) (*connect.Response[v1.BridgeDomainCreateResponse], error)
+	BridgeDomainDelete(context.Context, *connect.Request[v1.BridgeDomainDeleteRequest]) (*connect.Response[v1.BridgeDomainDeleteResponse], error)
+	BridgeDomainGet(context.Context, *connect.Request[v1.BridgeDomainGetRequest]) (*connect.Response[v1.BridgeDomainGetResponse], error)
+	BridgeDomainList(context.Context, *connect.Request[v1.BridgeDomainListRequest]) (*connect.Response[v1.BridgeDomainListResponse], error)
+	MacLimitSet(context.Context, *connect.Request[v1.MacLimitSetRequest]) (*connect.Response[v1.MacLimitSetResponse], error)
+	MacLimitDelete(context.Context, *connect.Request[v1.MacLimitDeleteRequest]) (*connect.Response[v1.MacLimitDeleteResponse], error)
+	MacLimitList(context.Context, *connect.Request[v1.MacLimitListRequest]) (*connect.Response[v1.MacLimitListResponse], error)
+	StormControlSet(context.Context, *connect.Request[v1.StormControlSetRequest]) (*connect.Response[v1.StormControlSetResponse], error)
+	StormControlDelete(context.Context, *connect.Request[v1.StormControlDeleteRequest]) (*connect.Response[v1.StormControlDeleteResponse], error)
+	StormControlList(context.Context, *connect.Request[v1.StormControlListRequest]) (*connect.Response[v1.StormControlListResponse], error)
+	NeighborCreate(context.Context, *connect.Request[v1.NeighborCreateRequest]) (*connect.Response[v1.NeighborCreateResponse], error)
+	NeighborDelete(context.Context, *connect.Request[v1.NeighborDeleteRequest]) (*connect.Response[v1.NeighborDeleteResponse], error)
+	NeighborList(context.Context, *connect.Request[v1.NeighborListRequest]) (*connect.Response[v1.NeighborListResponse], error)
+	IrbRouteCreate(context.Context, *connect.Request[v1.IrbRouteCreateRequest]) (*connect.Response[v1.IrbRouteCreateResponse], error)
+	IrbRouteDelete(context.Context, *connect.Request[v1.IrbRouteDeleteRequest]) (*connect.Response[v1.IrbRouteDeleteResponse], error)
+	IrbRouteList(context.Context, *connect.Request[v1.IrbRouteListRequest]) (*connect.Response[v1.IrbRouteListResponse], error)
+}
+
+// NewBridgeDomainServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewBridgeDomainServiceHandler(svc BridgeDomainServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	bridgeDomainServiceBridgeDomainCreateHandler := connect.NewUnaryHandler(
+		BridgeDomainServiceBridgeDomainCreateProcedure,
+		svc.BridgeDomainCreate,
+		connect.WithSchema(bridgeDomainServiceBridgeDomainCreateMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	bridgeDomainServiceBridgeDomainDeleteHandler := connect.NewUnaryHandler(
+		BridgeDomainServiceBridgeDomainDeleteProcedure,
+		svc.BridgeDomainDelete,
+		connect.WithSchema(bridgeDomainServiceBridgeDomainDeleteMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	bridgeDomainServiceBridgeDomainGetHandler := connect.NewUnaryHandler(
+		BridgeDomainServiceBridgeDomainGetProcedure,
+		svc.BridgeDomainGet,
+		connect.WithSchema(bridgeDomainServiceBridgeDomainGetMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	bridgeDomainServiceBridgeDomainListHandler := connect.NewUnaryHandler(
+		BridgeDomainServiceBridgeDomainListProcedure,
+		svc.BridgeDomainList,
+		connect.WithSchema(bridgeDomainServiceBridgeDomainListMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	bridgeDomainServiceMacLimitSetHandler := connect.NewUnaryHandler(
+		BridgeDomainServiceMacLimitSetProcedure,
+		svc.MacLimitSet,
+		connect.WithSchema(bridgeDomainServiceMacLimitSetMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	bridgeDomainServiceMacLimitDeleteHandler := connect.NewUnaryHandler(
+		BridgeDomainServiceMacLimitDeleteProcedure,
+		svc.MacLimitDelete,
+		connect.WithSchema(bridgeDomainServiceMacLimitDeleteMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	bridgeDomainServiceMacLimitListHandler := connect.NewUnaryHandler(
+		BridgeDomainServiceMacLimitListProcedure,
+		svc.MacLimitList,
+		connect.WithSchema(bridgeDomainServiceMacLimitListMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	bridgeDomainServiceStormControlSetHandler := connect.NewUnaryHandler(
+		BridgeDomainServiceStormControlSetProcedure,
+		svc.StormControlSet,
+		connect.WithSchema(bridgeDomainServiceStormControlSetMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	bridgeDomainServiceStormControlDeleteHandler := connect.NewUnaryHandler(
+		BridgeDomainServiceStormControlDeleteProcedure,
+		svc.StormControlDelete,
+		connect.WithSchema(bridgeDomainServiceStormControlDeleteMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	bridgeDomainServiceStormControlListHandler := connect.NewUnaryHandler(
+		BridgeDomainServiceStormControlListProcedure,
+		svc.StormControlList,
+		connect.WithSchema(bridgeDomainServiceStormControlListMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	bridgeDomainServiceNeighborCreateHandler := connect.NewUnaryHandler(
+		BridgeDomainServiceNeighborCreateProcedure,
+		svc.NeighborCreate,
+		connect.WithSchema(bridgeDomainServiceNeighborCreateMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	bridgeDomainServiceNeighborDeleteHandler := connect.NewUnaryHandler(
+		BridgeDomainServiceNeighborDeleteProcedure,
+		svc.NeighborDelete,
+		connect.WithSchema(bridgeDomainServiceNeighborDeleteMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	bridgeDomainServiceNeighborListHandler := connect.NewUnaryHandler(
+		BridgeDomainServiceNeighborListProcedure,
+		svc.NeighborList,
+		connect.WithSchema(bridgeDomainServiceNeighborListMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	bridgeDomainServiceIrbRouteCreateHandler := connect.NewUnaryHandler(
+		BridgeDomainServiceIrbRouteCreateProcedure,
+		svc.IrbRouteCreate,
+		connect.WithSchema(bridgeDomainServiceIrbRouteCreateMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	bridgeDomainServiceIrbRouteDeleteHandler := connect.NewUnaryHandler(
+		BridgeDomainServiceIrbRouteDeleteProcedure,
+		svc.IrbRouteDelete,
+		connect.WithSchema(bridgeDomainServiceIrbRouteDeleteMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	bridgeDomainServiceIrbRouteListHandler := connect.NewUnaryHandler(
+		BridgeDomainServiceIrbRouteListProcedure,
+		svc.IrbRouteList,
+		connect.WithSchema(bridgeDomainServiceIrbRouteListMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/vinbero.v1.BridgeDomainService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case BridgeDomainServiceBridgeDomainCreateProcedure:
+			bridgeDomainServiceBridgeDomainCreateHandler.ServeHTTP(w, r)
+		case BridgeDomainServiceBridgeDomainDeleteProcedure:
+			bridgeDomainServiceBridgeDomainDeleteHandler.ServeHTTP(w, r)
+		case BridgeDomainServiceBridgeDomainGetProcedure:
+			bridgeDomainServiceBridgeDomainGetHandler.ServeHTTP(w, r)
+		case BridgeDomainServiceBridgeDomainListProcedure:
+			bridgeDomainServiceBridgeDomainListHandler.ServeHTTP(w, r)
+		case BridgeDomainServiceMacLimitSetProcedure:
+			bridgeDomainServiceMacLimitSetHandler.ServeHTTP(w, r)
+		case BridgeDomainServiceMacLimitDeleteProcedure:
+			bridgeDomainServiceMacLimitDeleteHandler.ServeHTTP(w, r)
+		case BridgeDomainServiceMacLimitListProcedure:
+			bridgeDomainServiceMacLimitListHandler.ServeHTTP(w, r)
+		case BridgeDomainServiceStormControlSetProcedure:
+			bridgeDomainServiceStormControlSetHandler.ServeHTTP(w, r)
+		case BridgeDomainServiceStormControlDeleteProcedure:
+			bridgeDomainServiceStormControlDeleteHandler.ServeHTTP(w, r)
+		case BridgeDomainServiceStormControlListProcedure:
+			bridgeDomainServiceStormControlListHandler.ServeHTTP(w, r)
+		case BridgeDomainServiceNeighborCreateProcedure:
+			bridgeDomainServiceNeighborCreateHandler.ServeHTTP(w, r)
+		case BridgeDomainServiceNeighborDeleteProcedure:
+			bridgeDomainServiceNeighborDeleteHandler.ServeHTTP(w, r)
+		case BridgeDomainServiceNeighborListProcedure:
+			bridgeDomainServiceNeighborListHandler.ServeHTTP(w, r)
+		case BridgeDomainServiceIrbRouteCreateProcedure:
+			bridgeDomainServiceIrbRouteCreateHandler.ServeHTTP(w, r)
+		case BridgeDomainServiceIrbRouteDeleteProcedure:
+			bridgeDomainServiceIrbRouteDeleteHandler.ServeHTTP(w, r)
+		case BridgeDomainServiceIrbRouteListProcedure:
+			bridgeDomainServiceIrbRouteListHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedBridgeDomainServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedBridgeDomainServiceHandler struct{}
+
+func (UnimplementedBridgeDomainServiceHandler) BridgeDomainCreate(context.Context, *connect.Request[v1.BridgeDomainCreateRequest]) (*connect.Response[v1.BridgeDomainCreateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.BridgeDomainService.BridgeDomainCreate is not implemented"))
+}
+
+func (UnimplementedBridgeDomainServiceHandler) BridgeDomainDelete(context.Context, *connect.Request[v1.BridgeDomainDeleteRequest]) (*connect.Response[v1.BridgeDomainDeleteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.BridgeDomainService.BridgeDomainDelete is not implemented"))
+}
+
+func (UnimplementedBridgeDomainServiceHandler) BridgeDomainGet(context.Context, *connect.Request[v1.BridgeDomainGetRequest]) (*connect.Response[v1.BridgeDomainGetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.BridgeDomainService.BridgeDomainGet is not implemented"))
+}
+
+func (UnimplementedBridgeDomainServiceHandler) BridgeDomainList(context.Context, *connect.Request[v1.BridgeDomainListRequest]) (*connect.Response[v1.BridgeDomainListResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.BridgeDomainService.BridgeDomainList is not implemented"))
+}
+
+func (UnimplementedBridgeDomainServiceHandler) MacLimitSet(context.Context, *connect.Request[v1.MacLimitSetRequest]) (*connect.Response[v1.MacLimitSetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.BridgeDomainService.MacLimitSet is not implemented"))
+}
+
+func (UnimplementedBridgeDomainServiceHandler) MacLimitDelete(context.Context, *connect.Request[v1.MacLimitDeleteRequest]) (*connect.Response[v1.MacLimitDeleteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.BridgeDomainService.MacLimitDelete is not implemented"))
+}
+
+func (UnimplementedBridgeDomainServiceHandler) MacLimitList(context.Context, *connect.Request[v1.MacLimitListRequest]) (*connect.Response[v1.MacLimitListResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.BridgeDomainService.MacLimitList is not implemented"))
+}
+
+func (UnimplementedBridgeDomainServiceHandler) StormControlSet(context.Context, *connect.Request[v1.StormControlSetRequest]) (*connect.Response[v1.StormControlSetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.BridgeDomainService.StormControlSet is not implemented"))
+}
+
+func (UnimplementedBridgeDomainServiceHandler) StormControlDelete(context.Context, *connect.Request[v1.StormControlDeleteRequest]) (*connect.Response[v1.StormControlDeleteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.BridgeDomainService.StormControlDelete is not implemented"))
+}
+
+func (UnimplementedBridgeDomainServiceHandler) StormControlList(context.Context, *connect.Request[v1.StormControlListRequest]) (*connect.Response[v1.StormControlListResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.BridgeDomainService.StormControlList is not implemented"))
+}
+
+func (UnimplementedBridgeDomainServiceHandler) NeighborCreate(context.Context, *connect.Request[v1.NeighborCreateRequest]) (*connect.Response[v1.NeighborCreateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.BridgeDomainService.NeighborCreate is not implemented"))
+}
+
+func (UnimplementedBridgeDomainServiceHandler) NeighborDelete(context.Context, *connect.Request[v1.NeighborDeleteRequest]) (*connect.Response[v1.NeighborDeleteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.BridgeDomainService.NeighborDelete is not implemented"))
+}
+
+func (UnimplementedBridgeDomainServiceHandler) NeighborList(context.Context, *connect.Request[v1.NeighborListRequest]) (*connect.Response[v1.NeighborListResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.BridgeDomainService.NeighborList is not implemented"))
+}
+
+func (UnimplementedBridgeDomainServiceHandler) IrbRouteCreate(context.Context, *connect.Request[v1.IrbRouteCreateRequest]) (*connect.Response[v1.IrbRouteCreateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.BridgeDomainService.IrbRouteCreate is not implemented"))
+}
+
+func (UnimplementedBridgeDomainServiceHandler) IrbRouteDelete(context.Context, *connect.Request[v1.IrbRouteDeleteRequest]) (*connect.Response[v1.IrbRouteDeleteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.BridgeDomainService.IrbRouteDelete is not implemented"))
+}
+
+func (UnimplementedBridgeDomainServiceHandler) IrbRouteList(context.Context, *connect.Request[v1.IrbRouteListRequest]) (*connect.Response[v1.IrbRouteListResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.BridgeDomainService.IrbRouteList is not implemented"))
+}
+
+// EthernetSegmentServiceClient is a client for the vinbero.v1.EthernetSegmentService service.
+type EthernetSegmentServiceClient interface {
+	EsCreate(context.Context, *connect.Request[v1.EsCreateRequest]) (*connect.Response[v1.EsCreateResponse], error)
+	EsUpdate(context.Context, *connect.Request[v1.EsUpdateRequest]) (*connect.Response[v1.EsUpdateResponse], error)
+	EsDelete(context.Context, *connect.Request[v1.EsDeleteRequest]) (*connect.Response[v1.EsDeleteResponse], error)
+	EsList(context.Context, *connect.Request[v1.EsListRequest]) (*connect.Response[v1.EsListResponse], error)
+	EsSetDf(context.Context, *connect.Request[v1.EsSetDfRequest]) (*connect.Response[v1.EsSetDfResponse], error)
+	EsClearDf(context.Context, *connect.Request[v1.EsClearDfRequest]) (*connect.Response[v1.EsClearDfResponse], error)
+}
+
+// NewEthernetSegmentServiceClient constructs a client for the vinbero.v1.EthernetSegmentService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewEthernetSegmentServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) EthernetSegmentServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &ethernetSegmentServiceClient{
+		esCreate: connect.NewClient[v1.EsCreateRequest, v1.EsCreateResponse](
+			httpClient,
+			baseURL+EthernetSegmentServiceEsCreateProcedure,
+			connect.WithSchema(ethernetSegmentServiceEsCreateMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		esUpdate: connect.NewClient[v1.EsUpdateRequest, v1.EsUpdateResponse](
+			httpClient,
+			baseURL+EthernetSegmentServiceEsUpdateProcedure,
+			connect.WithSchema(ethernetSegmentServiceEsUpdateMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		esDelete: connect.NewClient[v1.EsDeleteRequest, v1.EsDeleteResponse](
+			httpClient,
+			baseURL+EthernetSegmentServiceEsDeleteProcedure,
+			connect.WithSchema(ethernetSegmentServiceEsDeleteMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		esList: connect.NewClient[v1.EsListRequest, v1.EsListResponse](
+			httpClient,
+			baseURL+EthernetSegmentServiceEsListProcedure,
+			connect.WithSchema(ethernetSegmentServiceEsListMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		esSetDf: connect.NewClient[v1.EsSetDfRequest, v1.EsSetDfResponse](
+			httpClient,
+			baseURL+EthernetSegmentServiceEsSetDfProcedure,
+			connect.WithSchema(ethernetSegmentServiceEsSetDfMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		esClearDf: connect.NewClient[v1.EsClearDfRequest, v1.EsClearDfResponse](
+			httpClient,
+			baseURL+EthernetSegmentServiceEsClearDfProcedure,
+			connect.WithSchema(ethernetSegmentServiceEsClearDfMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// ethernetSegmentServiceClient implements EthernetSegmentServiceClient.
+type ethernetSegmentServiceClient struct {
+	esCreate  *connect.Client[v1.EsCreateRequest, v1.EsCreateResponse]
+	esUpdate  *connect.Client[v1.EsUpdateRequest, v1.EsUpdateResponse]
+	esDelete  *connect.Client[v1.EsDeleteRequest, v1.EsDeleteResponse]
+	esList    *connect.Client[v1.EsListRequest, v1.EsListResponse]
+	esSetDf   *connect.Client[v1.EsSetDfRequest, v1.EsSetDfResponse]
+	esClearDf *connect.Client[v1.EsClearDfRequest, v1.EsClearDfResponse]
+}
+
+// EsCreate calls vinbero.v1.EthernetSegmentService.EsCreate.
+func (c *ethernetSegmentServiceClient) EsCreate(ctx context.Context, req *connect.Request[v1.EsCreateRequest]) (*connect.Response[v1.EsCreateResponse], error) {
+	return c.esCreate.CallUnary(ctx, req)
+}
+
+// EsUpdate calls vinbero.v1.EthernetSegmentService.EsUpdate.
+func (c *ethernetSegmentServiceClient) EsUpdate(ctx context.Context, req *connect.Request[v1.EsUpdateRequest]) (*connect.Response[v1.EsUpdateResponse], error) {
+	return c.esUpdate.CallUnary(ctx, req)
+}
+
+// EsDelete calls vinbero.v1.EthernetSegmentService.EsDelete.
+func (c *ethernetSegmentServiceClient) EsDelete(ctx context.Context, req *connect.Request[v1.EsDeleteRequest]) (*connect.Response[v1.EsDeleteResponse], error) {
+	return c.esDelete.CallUnary(ctx, req)
+}
+
+// EsList calls vinbero.v1.EthernetSegmentService.EsList.
+func (c *ethernetSegmentServiceClient) EsList(ctx context.Context, req *connect.Request[v1.EsListRequest]) (*connect.Response[v1.EsListResponse], error) {
+	return c.esList.CallUnary(ctx, req)
+}
+
+// EsSetDf calls vinbero.v1.EthernetSegmentService.EsSetDf.
+func (c *ethernetSegmentServiceClient) EsSetDf(ctx context.Context, req *connect.Request[v1.EsSetDfRequest]) (*connect.Response[v1.EsSetDfResponse], error) {
+	return c.esSetDf.CallUnary(ctx, req)
+}
+
+// EsClearDf calls vinbero.v1.EthernetSegmentService.EsClearDf.
+func (c *ethernetSegmentServiceClient) EsClearDf(ctx context.Context, req *connect.Request[v1.EsClearDfRequest]) (*connect.Response[v1.EsClearDfResponse], error) {
+	return c.esClearDf.CallUnary(ctx, req)
+}
+
+// EthernetSegmentServiceHandler is an implementation of the vinbero.v1.EthernetSegmentService
+// service.
+type EthernetSegmentServiceHandler interface {
+	EsCreate(context.Context, *connect.Request[v1.EsCreateRequest]) (*connect.Response[v1.EsCreateResponse], error)
+	EsUpdate(context.Context, *connect.Request[v1.EsUpdateRequest]) (*connect.Response[v1.EsUpdateResponse], error)
+	EsDelete(context.Context, *connect.Request[v1.EsDeleteRequest]) (*connect.Response[v1.EsDeleteResponse], error)
+	EsList(context.Context, *connect.Request[v1.EsListRequest]) (*connect.Response[v1.EsListResponse], error)
+	EsSetDf(context.Context, *connect.Request[v1.EsSetDfRequest]) (*connect.Response[v1.EsSetDfResponse], error)
+	EsClearDf(context.Context, *connect.Request[v1.EsClearDfRequest]) (*connect.Response[v1.EsClearDfResponse], error)
+}
+
+// NewEthernetSegmentServiceHandler builds an HTTP handler from the service implementation. It
+// returns the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewEthernetSegmentServiceHandler(svc EthernetSegmentServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	ethernetSegmentServiceEsCreateHandler := connect.NewUnaryHandler(
+		EthernetSegmentServiceEsCreateProcedure,
+		svc.EsCreate,
+		connect.WithSchema(ethernetSegmentServiceEsCreateMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	ethernetSegmentServiceEsUpdateHandler := connect.NewUnaryHandler(
+		EthernetSegmentServiceEsUpdateProcedure,
+		svc.EsUpdate,
+		connect.WithSchema(ethernetSegmentServiceEsUpdateMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	ethernetSegmentServiceEsDeleteHandler := connect.NewUnaryHandler(
+		EthernetSegmentServiceEsDeleteProcedure,
+		svc.EsDelete,
+		connect.WithSchema(ethernetSegmentServiceEsDeleteMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	ethernetSegmentServiceEsListHandler := connect.NewUnaryHandler(
+		EthernetSegmentServiceEsListProcedure,
+		svc.EsList,
+		connect.WithSchema(ethernetSegmentServiceEsListMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	ethernetSegmentServiceEsSetDfHandler := connect.NewUnaryHandler(
+		EthernetSegmentServiceEsSetDfProcedure,
+		svc.EsSetDf,
+		connect.WithSchema(ethernetSegmentServiceEsSetDfMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	ethernetSegmentServiceEsClearDfHandler := connect.NewUnaryHandler(
+		EthernetSegmentServiceEsClearDfProcedure,
+		svc.EsClearDf,
+		connect.WithSchema(ethernetSegmentServiceEsClearDfMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/vinbero.v1.EthernetSegmentService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case EthernetSegmentServiceEsCreateProcedure:
+			ethernetSegmentServiceEsCreateHandler.ServeHTTP(w, r)
+		case EthernetSegmentServiceEsUpdateProcedure:
+			ethernetSegmentServiceEsUpdateHandler.ServeHTTP(w, r)
+		case EthernetSegmentServiceEsDeleteProcedure:
+			ethernetSegmentServiceEsDeleteHandler.ServeHTTP(w, r)
+		case EthernetSegmentServiceEsListProcedure:
+			ethernetSegmentServiceEsListHandler.ServeHTTP(w, r)
+		case EthernetSegmentServiceEsSetDfProcedure:
+			ethernetSegmentServiceEsSetDfHandler.ServeHTTP(w, r)
+		case EthernetSegmentServiceEsClearDfProcedure:
+			ethernetSegmentServiceEsClearDfHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedEthernetSegmentServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedEthernetSegmentServiceHandler struct{}
+
+func (UnimplementedEthernetSegmentServiceHandler) EsCreate(context.Context, *connect.Request[v1.EsCreateRequest]) (*connect.Response[v1.EsCreateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.EthernetSegmentService.EsCreate is not implemented"))
+}
+
+func (UnimplementedEthernetSegmentServiceHandler) EsUpdate(context.Context, *connect.Request[v1.EsUpdateRequest]) (*connect.Response[v1.EsUpdateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.EthernetSegmentService.EsUpdate is not implemented"))
+}
+
+func (UnimplementedEthernetSegmentServiceHandler) EsDelete(context.Context, *connect.Request[v1.EsDeleteRequest]) (*connect.Response[v1.EsDeleteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.EthernetSegmentService.EsDelete is not implemented"))
+}
+
+func (UnimplementedEthernetSegmentServiceHandler) EsList(context.Context, *connect.Request[v1.EsListRequest]) (*connect.Response[v1.EsListResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.EthernetSegmentService.EsList is not implemented"))
+}
+
+func (UnimplementedEthernetSegmentServiceHandler) EsSetDf(context.Context, *connect.Request[v1.EsSetDfRequest]) (*connect.Response[v1.EsSetDfResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.EthernetSegmentService.EsSetDf is not implemented"))
+}
+
+func (UnimplementedEthernetSegmentServiceHandler) EsClearDf(context.Context, *connect.Request[v1.EsClearDfRequest]) (*connect.Response[v1.EsClearDfResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.EthernetSegmentService.EsClearDf is not implemented"))
+}
+
+// NetworkResourceServiceClient is a client for the vinbero.v1.NetworkResourceService service.
+type NetworkResourceServiceClient interface {
+	VrfCreate(context.Context, *connect.Request[v1.VrfCreateRequest]) (*connect.Response[v1.VrfCreateResponse], error)
+	VrfDelete(context.Context, *connect.Request[v1.VrfDeleteRequest]) (*connect.Response[v1.VrfDeleteResponse], error)
+	VrfList(context.Context, *connect.Request[v1.VrfListRequest]) (*connect.Response[v1.VrfListResponse], error)
+	BridgeCreate(context.Context, *connect.Request[v1.BridgeCreateRequest]) (*connect.Response[v1.BridgeCreateResponse], error)
+	BridgeDelete(context.Context, *connect.Request[v1.BridgeDeleteRequest]) (*connect.Response[v1.BridgeDeleteResponse], error)
+	BridgeList(context.Context, *connect.Request[v1.BridgeListRequest]) (*connect.Response[v1.BridgeListResponse], error)
+}
+
+// NewNetworkResourceServiceClient constructs a client for the vinbero.v1.NetworkResourceService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewNetworkResourceServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) NetworkResourceServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &networkResourceServiceClient{
+		vrfCreate: connect.NewClient[v1.VrfCreateRequest, v1.VrfCreateResponse](
+			httpClient,
+			baseURL+NetworkResourceServiceVrfCreateProcedure,
+			connect.WithSchema(networkResourceServiceVrfCreateMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		vrfDelete: connect.NewClient[v1.VrfDeleteRequest, v1.VrfDeleteResponse](
+			httpClient,
+			baseURL+NetworkResourceServiceVrfDeleteProcedure,
+			connect.WithSchema(networkResourceServiceVrfDeleteMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		vrfList: connect.NewClient[v1.VrfListRequest, v1.VrfListResponse](
+			httpClient,
+			baseURL+NetworkResourceServiceVrfListProcedure,
+			connect.WithSchema(networkResourceServiceVrfListMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		bridgeCreate: connect.NewClient[v1.BridgeCreateRequest, v1.BridgeCreateResponse](
+			httpClient,
+			baseURL+NetworkResourceServiceBridgeCreateProcedure,
+			connect.WithSchema(networkResourceServiceBridgeCreateMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		bridgeDelete: connect.NewClient[v1.BridgeDeleteRequest, v1.BridgeDeleteResponse](
+			httpClient,
+			baseURL+NetworkResourceServiceBridgeDeleteProcedure,
 			connect.WithSchema(networkResourceServiceBridgeDeleteMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
@@ -1578,6 +2663,7 @@ func (UnimplementedNetworkResourceServiceHandler) BridgeList(context.Context, *c
 // HeadendL2ServiceClient is a client for the vinbero.v1.HeadendL2Service service.
 type HeadendL2ServiceClient interface {
 	HeadendL2Create(context.Context, *connect.Request[v1.HeadendL2CreateRequest]) (*connect.Response[v1.HeadendL2CreateResponse], error)
+	HeadendL2Update(context.Context, *connect.Request[v1.HeadendL2UpdateRequest]) (*connect.Response[v1.HeadendL2UpdateResponse], error)
 	HeadendL2Delete(context.Context, *connect.Request[v1.HeadendL2DeleteRequest]) (*connect.Response[v1.HeadendL2DeleteResponse], error)
 	HeadendL2List(context.Context, *connect.Request[v1.HeadendL2ListRequest]) (*connect.Response[v1.HeadendL2ListResponse], error)
 	HeadendL2Get(context.Context, *connect.Request[v1.HeadendL2GetRequest]) (*connect.Response[v1.HeadendL2GetResponse], error)
@@ -1600,6 +2686,12 @@ func NewHeadendL2ServiceClient(httpClient connect.HTTPClient, baseURL string, op
 			connect.WithSchema(headendL2ServiceHeadendL2CreateMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		headendL2Update: connect.NewClient[v1.HeadendL2UpdateRequest, v1.HeadendL2UpdateResponse](
+			httpClient,
+			baseURL+HeadendL2ServiceHeadendL2UpdateProcedure,
+			connect.WithSchema(headendL2ServiceHeadendL2UpdateMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		headendL2Delete: connect.NewClient[v1.HeadendL2DeleteRequest, v1.HeadendL2DeleteResponse](
 			httpClient,
 			baseURL+HeadendL2ServiceHeadendL2DeleteProcedure,
@@ -1630,6 +2722,7 @@ func NewHeadendL2ServiceClient(httpClient connect.HTTPClient, baseURL string, op
 // headendL2ServiceClient implements HeadendL2ServiceClient.
 type headendL2ServiceClient struct {
 	headendL2Create *connect.Client[v1.HeadendL2CreateRequest, v1.HeadendL2CreateResponse]
+	headendL2Update *connect.Client[v1.HeadendL2UpdateRequest, v1.HeadendL2UpdateResponse]
 	headendL2Delete *connect.Client[v1.HeadendL2DeleteRequest, v1.HeadendL2DeleteResponse]
 	headendL2List   *connect.Client[v1.HeadendL2ListRequest, v1.HeadendL2ListResponse]
 	headendL2Get    *connect.Client[v1.HeadendL2GetRequest, v1.HeadendL2GetResponse]
@@ -1641,6 +2734,11 @@ func (c *headendL2ServiceClient) HeadendL2Create(ctx context.Context, req *conne
 	return c.headendL2Create.CallUnary(ctx, req)
 }
 
+// HeadendL2Update calls vinbero.v1.HeadendL2Service.HeadendL2Update.
+func (c *headendL2ServiceClient) HeadendL2Update(ctx context.Context, req *connect.Request[v1.HeadendL2UpdateRequest]) (*connect.Response[v1.HeadendL2UpdateResponse], error) {
+	return c.headendL2Update.CallUnary(ctx, req)
+}
+
 // HeadendL2Delete calls vinbero.v1.HeadendL2Service.HeadendL2Delete.
 func (c *headendL2ServiceClient) HeadendL2Delete(ctx context.Context, req *connect.Request[v1.HeadendL2DeleteRequest]) (*connect.Response[v1.HeadendL2DeleteResponse], error) {
 	return c.headendL2Delete.CallUnary(ctx, req)
@@ -1664,6 +2762,7 @@ func (c *headendL2ServiceClient) HeadendL2Flush(ctx context.Context, req *connec
 // HeadendL2ServiceHandler is an implementation of the vinbero.v1.HeadendL2Service service.
 type HeadendL2ServiceHandler interface {
 	HeadendL2Create(context.Context, *connect.Request[v1.HeadendL2CreateRequest]) (*connect.Response[v1.HeadendL2CreateResponse], error)
+	HeadendL2Update(context.Context, *connect.Request[v1.HeadendL2UpdateRequest]) (*connect.Response[v1.HeadendL2UpdateResponse], error)
 	HeadendL2Delete(context.Context, *connect.Request[v1.HeadendL2DeleteRequest]) (*connect.Response[v1.HeadendL2DeleteResponse], error)
 	HeadendL2List(context.Context, *connect.Request[v1.HeadendL2ListRequest]) (*connect.Response[v1.HeadendL2ListResponse], error)
 	HeadendL2Get(context.Context, *connect.Request[v1.HeadendL2GetRequest]) (*connect.Response[v1.HeadendL2GetResponse], error)
@@ -1682,6 +2781,12 @@ func NewHeadendL2ServiceHandler(svc HeadendL2ServiceHandler, opts ...connect.Han
 		connect.WithSchema(headendL2ServiceHeadendL2CreateMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	headendL2ServiceHeadendL2UpdateHandler := connect.NewUnaryHandler(
+		HeadendL2ServiceHeadendL2UpdateProcedure,
+		svc.HeadendL2Update,
+		connect.WithSchema(headendL2ServiceHeadendL2UpdateMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	headendL2ServiceHeadendL2DeleteHandler := connect.NewUnaryHandler(
 		HeadendL2ServiceHeadendL2DeleteProcedure,
 		svc.HeadendL2Delete,
@@ -1710,6 +2815,8 @@ func NewHeadendL2ServiceHandler(svc HeadendL2ServiceHandler, opts ...connect.Han
 		switch r.URL.Path {
 		case HeadendL2ServiceHeadendL2CreateProcedure:
 			headendL2ServiceHeadendL2CreateHandler.ServeHTTP(w, r)
+		case HeadendL2ServiceHeadendL2UpdateProcedure:
+			headendL2ServiceHeadendL2UpdateHandler.ServeHTTP(w, r)
 		case HeadendL2ServiceHeadendL2DeleteProcedure:
 			headendL2ServiceHeadendL2DeleteHandler.ServeHTTP(w, r)
 		case HeadendL2ServiceHeadendL2ListProcedure:
@@ -1731,6 +2838,10 @@ func (UnimplementedHeadendL2ServiceHandler) HeadendL2Create(context.Context, *co
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.HeadendL2Service.HeadendL2Create is not implemented"))
 }
 
+func (UnimplementedHeadendL2ServiceHandler) HeadendL2Update(context.Context, *connect.Request[v1.HeadendL2UpdateRequest]) (*connect.Response[v1.HeadendL2UpdateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.HeadendL2Service.HeadendL2Update is not implemented"))
+}
+
 func (UnimplementedHeadendL2ServiceHandler) HeadendL2Delete(context.Context, *connect.Request[v1.HeadendL2DeleteRequest]) (*connect.Response[v1.HeadendL2DeleteResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.HeadendL2Service.HeadendL2Delete is not implemented"))
 }
@@ -1892,3 +3003,395 @@ func (UnimplementedStatsServiceHandler) StatsSlotShow(context.Context, *connect.
 func (UnimplementedStatsServiceHandler) StatsSlotReset(context.Context, *connect.Request[v1.StatsSlotResetRequest]) (*connect.Response[v1.StatsSlotResetResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.StatsService.StatsSlotReset is not implemented"))
 }
+
+// OamServiceClient is a client for the vinbero.v1.OamService service.
+type OamServiceClient interface {
+	OamPing(context.Context, *connect.Request[v1.OamPingRequest]) (*connect.Response[v1.OamPingResponse], error)
+	OamTraceroute(context.Context, *connect.Request[v1.OamTracerouteRequest]) (*connect.Response[v1.OamTracerouteResponse], error)
+	OamPuntList(context.Context, *connect.Request[v1.OamPuntListRequest]) (*connect.Response[v1.OamPuntListResponse], error)
+}
+
+// NewOamServiceClient constructs a client for the vinbero.v1.OamService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewOamServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) OamServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &oamServiceClient{
+		oamPing: connect.NewClient[v1.OamPingRequest, v1.OamPingResponse](
+			httpClient,
+			baseURL+OamServiceOamPingProcedure,
+			connect.WithSchema(oamServiceOamPingMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		oamTraceroute: connect.NewClient[v1.OamTracerouteRequest, v1.OamTracerouteResponse](
+			httpClient,
+			baseURL+OamServiceOamTracerouteProcedure,
+			connect.WithSchema(oamServiceOamTracerouteMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		oamPuntList: connect.NewClient[v1.OamPuntListRequest, v1.OamPuntListResponse](
+			httpClient,
+			baseURL+OamServiceOamPuntListProcedure,
+			connect.WithSchema(oamServiceOamPuntListMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// oamServiceClient implements OamServiceClient.
+type oamServiceClient struct {
+	oamPing       *connect.Client[v1.OamPingRequest, v1.OamPingResponse]
+	oamTraceroute *connect.Client[v1.OamTracerouteRequest, v1.OamTracerouteResponse]
+	oamPuntList   *connect.Client[v1.OamPuntListRequest, v1.OamPuntListResponse]
+}
+
+// OamPing calls vinbero.v1.OamService.OamPing.
+func (c *oamServiceClient) OamPing(ctx context.Context, req *connect.Request[v1.OamPingRequest]) (*connect.Response[v1.OamPingResponse], error) {
+	return c.oamPing.CallUnary(ctx, req)
+}
+
+// OamTraceroute calls vinbero.v1.OamService.OamTraceroute.
+func (c *oamServiceClient) OamTraceroute(ctx context.Context, req *connect.Request[v1.OamTracerouteRequest]) (*connect.Response[v1.OamTracerouteResponse], error) {
+	return c.oamTraceroute.CallUnary(ctx, req)
+}
+
+// OamPuntList calls vinbero.v1.OamService.OamPuntList.
+func (c *oamServiceClient) OamPuntList(ctx context.Context, req *connect.Request[v1.OamPuntListRequest]) (*connect.Response[v1.OamPuntListResponse], error) {
+	return c.oamPuntList.CallUnary(ctx, req)
+}
+
+// OamServiceHandler is an implementation of the vinbero.v1.OamService service.
+type OamServiceHandler interface {
+	OamPing(context.Context, *connect.Request[v1.OamPingRequest]) (*connect.Response[v1.OamPingResponse], error)
+	OamTraceroute(context.Context, *connect.Request[v1.OamTracerouteRequest]) (*connect.Response[v1.OamTracerouteResponse], error)
+	OamPuntList(context.Context, *connect.Request[v1.OamPuntListRequest]) (*connect.Response[v1.OamPuntListResponse], error)
+}
+
+// NewOamServiceHandler builds an HTTP handler from the service implementation. It returns the path
+// on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewOamServiceHandler(svc OamServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	oamServiceOamPingHandler := connect.NewUnaryHandler(
+		OamServiceOamPingProcedure,
+		svc.OamPing,
+		connect.WithSchema(oamServiceOamPingMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	oamServiceOamTracerouteHandler := connect.NewUnaryHandler(
+		OamServiceOamTracerouteProcedure,
+		svc.OamTraceroute,
+		connect.WithSchema(oamServiceOamTracerouteMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	oamServiceOamPuntListHandler := connect.NewUnaryHandler(
+		OamServiceOamPuntListProcedure,
+		svc.OamPuntList,
+		connect.WithSchema(oamServiceOamPuntListMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/vinbero.v1.OamService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case OamServiceOamPingProcedure:
+			oamServiceOamPingHandler.ServeHTTP(w, r)
+		case OamServiceOamTracerouteProcedure:
+			oamServiceOamTracerouteHandler.ServeHTTP(w, r)
+		case OamServiceOamPuntListProcedure:
+			oamServiceOamPuntListHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedOamServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedOamServiceHandler struct{}
+
+func (UnimplementedOamServiceHandler) OamPing(context.Context, *connect.Request[v1.OamPingRequest]) (*connect.Response[v1.OamPingResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.OamService.OamPing is not implemented"))
+}
+
+func (UnimplementedOamServiceHandler) OamTraceroute(context.Context, *connect.Request[v1.OamTracerouteRequest]) (*connect.Response[v1.OamTracerouteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.OamService.OamTraceroute is not implemented"))
+}
+
+func (UnimplementedOamServiceHandler) OamPuntList(context.Context, *connect.Request[v1.OamPuntListRequest]) (*connect.Response[v1.OamPuntListResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.OamService.OamPuntList is not implemented"))
+}
+
+// PerformanceServiceClient is a client for the vinbero.v1.PerformanceService service.
+type PerformanceServiceClient interface {
+	StampReflectorCreate(context.Context, *connect.Request[v1.StampReflectorCreateRequest]) (*connect.Response[v1.StampReflectorCreateResponse], error)
+	StampReflectorDelete(context.Context, *connect.Request[v1.StampReflectorDeleteRequest]) (*connect.Response[v1.StampReflectorDeleteResponse], error)
+	StampReflectorList(context.Context, *connect.Request[v1.StampReflectorListRequest]) (*connect.Response[v1.StampReflectorListResponse], error)
+	StampSessionCreate(context.Context, *connect.Request[v1.StampSessionCreateRequest]) (*connect.Response[v1.StampSessionCreateResponse], error)
+	StampSessionDelete(context.Context, *connect.Request[v1.StampSessionDeleteRequest]) (*connect.Response[v1.StampSessionDeleteResponse], error)
+	StampSessionList(context.Context, *connect.Request[v1.StampSessionListRequest]) (*connect.Response[v1.StampSessionListResponse], error)
+}
+
+// NewPerformanceServiceClient constructs a client for the vinbero.v1.PerformanceService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewPerformanceServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) PerformanceServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &performanceServiceClient{
+		stampReflectorCreate: connect.NewClient[v1.StampReflectorCreateRequest, v1.StampReflectorCreateResponse](
+			httpClient,
+			baseURL+PerformanceServiceStampReflectorCreateProcedure,
+			connect.WithSchema(performanceServiceStampReflectorCreateMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		stampReflectorDelete: connect.NewClient[v1.StampReflectorDeleteRequest, v1.StampReflectorDeleteResponse](
+			httpClient,
+			baseURL+PerformanceServiceStampReflectorDeleteProcedure,
+			connect.WithSchema(performanceServiceStampReflectorDeleteMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		stampReflectorList: connect.NewClient[v1.StampReflectorListRequest, v1.StampReflectorListResponse](
+			httpClient,
+			baseURL+PerformanceServiceStampReflectorListProcedure,
+			connect.WithSchema(performanceServiceStampReflectorListMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		stampSessionCreate: connect.NewClient[v1.StampSessionCreateRequest, v1.StampSessionCreateResponse](
+			httpClient,
+			baseURL+PerformanceServiceStampSessionCreateProcedure,
+			connect.WithSchema(performanceServiceStampSessionCreateMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		stampSessionDelete: connect.NewClient[v1.StampSessionDeleteRequest, v1.StampSessionDeleteResponse](
+			httpClient,
+			baseURL+PerformanceServiceStampSessionDeleteProcedure,
+			connect.WithSchema(performanceServiceStampSessionDeleteMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		stampSessionList: connect.NewClient[v1.StampSessionListRequest, v1.StampSessionListResponse](
+			httpClient,
+			baseURL+PerformanceServiceStampSessionListProcedure,
+			connect.WithSchema(performanceServiceStampSessionListMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// performanceServiceClient implements PerformanceServiceClient.
+type performanceServiceClient struct {
+	stampReflectorCreate *connect.Client[v1.StampReflectorCreateRequest, v1.StampReflectorCreateResponse]
+	stampReflectorDelete *connect.Client[v1.StampReflectorDeleteRequest, v1.StampReflectorDeleteResponse]
+	stampReflectorList   *connect.Client[v1.StampReflectorListRequest, v1.StampReflectorListResponse]
+	stampSessionCreate   *connect.Client[v1.StampSessionCreateRequest, v1.StampSessionCreateResponse]
+	stampSessionDelete   *connect.Client[v1.StampSessionDeleteRequest, v1.StampSessionDeleteResponse]
+	stampSessionList     *connect.Client[v1.StampSessionListRequest, v1.StampSessionListResponse]
+}
+
+// StampReflectorCreate calls vinbero.v1.PerformanceService.StampReflectorCreate.
+func (c *performanceServiceClient) StampReflectorCreate(ctx context.Context, req *connect.Request[v1.StampReflectorCreateRequest]) (*connect.Response[v1.StampReflectorCreateResponse], error) {
+	return c.stampReflectorCreate.CallUnary(ctx, req)
+}
+
+// StampReflectorDelete calls vinbero.v1.PerformanceService.StampReflectorDelete.
+func (c *performanceServiceClient) StampReflectorDelete(ctx context.Context, req *connect.Request[v1.StampReflectorDeleteRequest]) (*connect.Response[v1.StampReflectorDeleteResponse], error) {
+	return c.stampReflectorDelete.CallUnary(ctx, req)
+}
+
+// StampReflectorList calls vinbero.v1.PerformanceService.StampReflectorList.
+func (c *performanceServiceClient) StampReflectorList(ctx context.Context, req *connect.Request[v1.StampReflectorListRequest]) (*connect.Response[v1.StampReflectorListResponse], error) {
+	return c.stampReflectorList.CallUnary(ctx, req)
+}
+
+// StampSessionCreate calls vinbero.v1.PerformanceService.StampSessionCreate.
+func (c *performanceServiceClient) StampSessionCreate(ctx context.Context, req *connect.Request[v1.StampSessionCreateRequest]) (*connect.Response[v1.StampSessionCreateResponse], error) {
+	return c.stampSessionCreate.CallUnary(ctx, req)
+}
+
+// StampSessionDelete calls vinbero.v1.PerformanceService.StampSessionDelete.
+func (c *performanceServiceClient) StampSessionDelete(ctx context.Context, req *connect.Request[v1.StampSessionDeleteRequest]) (*connect.Response[v1.StampSessionDeleteResponse], error) {
+	return c.stampSessionDelete.CallUnary(ctx, req)
+}
+
+// StampSessionList calls vinbero.v1.PerformanceService.StampSessionList.
+func (c *performanceServiceClient) StampSessionList(ctx context.Context, req *connect.Request[v1.StampSessionListRequest]) (*connect.Response[v1.StampSessionListResponse], error) {
+	return c.stampSessionList.CallUnary(ctx, req)
+}
+
+// PerformanceServiceHandler is an implementation of the vinbero.v1.PerformanceService service.
+type PerformanceServiceHandler interface {
+	StampReflectorCreate(context.Context, *connect.Request[v1.StampReflectorCreateRequest]) (*connect.Response[v1.StampReflectorCreateResponse], error)
+	StampReflectorDelete(context.Context, *connect.Request[v1.StampReflectorDeleteRequest]) (*connect.Response[v1.StampReflectorDeleteResponse], error)
+	StampReflectorList(context.Context, *connect.Request[v1.StampReflectorListRequest]) (*connect.Response[v1.StampReflectorListResponse], error)
+	StampSessionCreate(context.Context, *connect.Request[v1.StampSessionCreateRequest]) (*connect.Response[v1.StampSessionCreateResponse], error)
+	StampSessionDelete(context.Context, *connect.Request[v1.StampSessionDeleteRequest]) (*connect.Response[v1.StampSessionDeleteResponse], error)
+	StampSessionList(context.Context, *connect.Request[v1.StampSessionListRequest]) (*connect.Response[v1.StampSessionListResponse], error)
+}
+
+// NewPerformanceServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewPerformanceServiceHandler(svc PerformanceServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	performanceServiceStampReflectorCreateHandler := connect.NewUnaryHandler(
+		PerformanceServiceStampReflectorCreateProcedure,
+		svc.StampReflectorCreate,
+		connect.WithSchema(performanceServiceStampReflectorCreateMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	performanceServiceStampReflectorDeleteHandler := connect.NewUnaryHandler(
+		PerformanceServiceStampReflectorDeleteProcedure,
+		svc.StampReflectorDelete,
+		connect.WithSchema(performanceServiceStampReflectorDeleteMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	performanceServiceStampReflectorListHandler := connect.NewUnaryHandler(
+		PerformanceServiceStampReflectorListProcedure,
+		svc.StampReflectorList,
+		connect.WithSchema(performanceServiceStampReflectorListMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	performanceServiceStampSessionCreateHandler := connect.NewUnaryHandler(
+		PerformanceServiceStampSessionCreateProcedure,
+		svc.StampSessionCreate,
+		connect.WithSchema(performanceServiceStampSessionCreateMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	performanceServiceStampSessionDeleteHandler := connect.NewUnaryHandler(
+		PerformanceServiceStampSessionDeleteProcedure,
+		svc.StampSessionDelete,
+		connect.WithSchema(performanceServiceStampSessionDeleteMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	performanceServiceStampSessionListHandler := connect.NewUnaryHandler(
+		PerformanceServiceStampSessionListProcedure,
+		svc.StampSessionList,
+		connect.WithSchema(performanceServiceStampSessionListMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/vinbero.v1.PerformanceService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case PerformanceServiceStampReflectorCreateProcedure:
+			performanceServiceStampReflectorCreateHandler.ServeHTTP(w, r)
+		case PerformanceServiceStampReflectorDeleteProcedure:
+			performanceServiceStampReflectorDeleteHandler.ServeHTTP(w, r)
+		case PerformanceServiceStampReflectorListProcedure:
+			performanceServiceStampReflectorListHandler.ServeHTTP(w, r)
+		case PerformanceServiceStampSessionCreateProcedure:
+			performanceServiceStampSessionCreateHandler.ServeHTTP(w, r)
+		case PerformanceServiceStampSessionDeleteProcedure:
+			performanceServiceStampSessionDeleteHandler.ServeHTTP(w, r)
+		case PerformanceServiceStampSessionListProcedure:
+			performanceServiceStampSessionListHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedPerformanceServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedPerformanceServiceHandler struct{}
+
+func (UnimplementedPerformanceServiceHandler) StampReflectorCreate(context.Context, *connect.Request[v1.StampReflectorCreateRequest]) (*connect.Response[v1.StampReflectorCreateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.PerformanceService.StampReflectorCreate is not implemented"))
+}
+
+func (UnimplementedPerformanceServiceHandler) StampReflectorDelete(context.Context, *connect.Request[v1.StampReflectorDeleteRequest]) (*connect.Response[v1.StampReflectorDeleteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.PerformanceService.StampReflectorDelete is not implemented"))
+}
+
+func (UnimplementedPerformanceServiceHandler) StampReflectorList(context.Context, *connect.Request[v1.StampReflectorListRequest]) (*connect.Response[v1.StampReflectorListResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.PerformanceService.StampReflectorList is not implemented"))
+}
+
+func (UnimplementedPerformanceServiceHandler) StampSessionCreate(context.Context, *connect.Request[v1.StampSessionCreateRequest]) (*connect.Response[v1.StampSessionCreateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.PerformanceService.StampSessionCreate is not implemented"))
+}
+
+func (UnimplementedPerformanceServiceHandler) StampSessionDelete(context.Context, *connect.Request[v1.StampSessionDeleteRequest]) (*connect.Response[v1.StampSessionDeleteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.PerformanceService.StampSessionDelete is not implemented"))
+}
+
+func (UnimplementedPerformanceServiceHandler) StampSessionList(context.Context, *connect.Request[v1.StampSessionListRequest]) (*connect.Response[v1.StampSessionListResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.PerformanceService.StampSessionList is not implemented"))
+}
+
+// DaemonServiceClient is a client for the vinbero.v1.DaemonService service.
+type DaemonServiceClient interface {
+	// Reload re-reads the config file and reconciles its config: section
+	// with the maps, like SIGHUP. Other sections only take effect after a
+	// restart and are reported in restart_required.
+	Reload(context.Context, *connect.Request[v1.ReloadRequest]) (*connect.Response[v1.ReloadResponse], error)
+}
+
+// NewDaemonServiceClient constructs a client for the vinbero.v1.DaemonService service. By default,
+// it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and
+// sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC()
+// or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewDaemonServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) DaemonServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &daemonServiceClient{
+		reload: connect.NewClient[v1.ReloadRequest, v1.ReloadResponse](
+			httpClient,
+			baseURL+DaemonServiceReloadProcedure,
+			connect.WithSchema(daemonServiceReloadMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// daemonServiceClient implements DaemonServiceClient.
+type daemonServiceClient struct {
+	reload *connect.Client[v1.ReloadRequest, v1.ReloadResponse]
+}
+
+// Reload calls vinbero.v1.DaemonService.Reload.
+func (c *daemonServiceClient) Reload(ctx context.Context, req *connect.Request[v1.ReloadRequest]) (*connect.Response[v1.ReloadResponse], error) {
+	return c.reload.CallUnary(ctx, req)
+}
+
+// DaemonServiceHandler is an implementation of the vinbero.v1.DaemonService service.
+type DaemonServiceHandler interface {
+	// Reload re-reads the config file and reconciles its config: section
+	// with the maps, like SIGHUP. Other sections only take effect after a
+	// restart and are reported in restart_required.
+	Reload(context.Context, *connect.Request[v1.ReloadRequest]) (*connect.Response[v1.ReloadResponse], error)
+}
+
+// NewDaemonServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewDaemonServiceHandler(svc DaemonServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	daemonServiceReloadHandler := connect.NewUnaryHandler(
+		DaemonServiceReloadProcedure,
+		svc.Reload,
+		connect.WithSchema(daemonServiceReloadMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/vinbero.v1.DaemonService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case DaemonServiceReloadProcedure:
+			daemonServiceReloadHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedDaemonServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedDaemonServiceHandler struct{}
+
+func (UnimplementedDaemonServiceHandler) Reload(context.Context, *connect.Request[v1.ReloadRequest]) (*connect.Response[v1.ReloadResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("vinbero.v1.DaemonService.Reload is not implemented"))
+}
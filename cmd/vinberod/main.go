@@ -15,6 +15,7 @@ import (
 	"github.com/takehaya/vinbero/pkg/config"
 	"github.com/takehaya/vinbero/pkg/logger"
 	"github.com/takehaya/vinbero/pkg/server"
+	"github.com/takehaya/vinbero/pkg/store"
 	"github.com/takehaya/vinbero/pkg/vinbero"
 	"github.com/urfave/cli/v2"
 )
@@ -49,6 +50,11 @@ func newApp() *cli.App {
 				Name:  "bgp-enabled",
 				Usage: "Enable BGP EVPN control plane",
 			},
+			&cli.BoolFlag{
+				Name:  "detach-on-exit",
+				Value: true,
+				Usage: "Detach XDP/TC on exit; false pins them so the next vinberod takes over without a traffic gap",
+			},
 		},
 		Action:                 run,
 		EnableBashCompletion:   true,
@@ -79,7 +85,14 @@ func run(cliCtx *cli.Context) error {
 	if err != nil {
 		return fmt.Errorf("initialize vinbero: %w", err)
 	}
-	defer func() { _ = vin.Close() }()
+	detach := cliCtx.Bool("detach-on-exit")
+	defer func() {
+		if detach {
+			_ = vin.Close()
+		} else if err := vin.Release(); err != nil {
+			lg.Error("Failed to leave the data plane attached", zap.Error(err))
+		}
+	}()
 
 	if err := vin.LoadXDPProgram(); err != nil {
 		return fmt.Errorf("load XDP program: %w", err)
@@ -101,10 +114,41 @@ func run(cliCtx *cli.Context) error {
 		return fmt.Errorf("start FDB watcher: %w", err)
 	}
 
-	srv := server.NewServer(cfg, vin.GetMapOperations(), vin.GetResourceManager(), vin.GetFDBWatcher(), lg)
+	// OAM punts are best-effort: the data plane keeps forwarding without a reader
+	if err := vin.StartOAMPuntReader(); err != nil {
+		lg.Warn("OAM punt reader unavailable", zap.Error(err))
+	}
+
+	var st *store.Store
+	if cfg.Setting.Store.Enabled {
+		st, err = store.Open(cfg.Setting.Store.Path, lg)
+		if err != nil {
+			return fmt.Errorf("open store: %w", err)
+		}
+		defer func() { _ = st.Close() }()
+	}
+
+	srv := server.NewServer(cfg, vin.GetMapOperations(), vin.GetResourceManager(), vin.GetFDBWatcher(), vin.GetOAMPuntReader(), vin, st, lg)
+	// Maps reused from bpffs pins already hold what the store would
+	// replay, except for plugins
+	srv.ReplayStore(ctx, vin.MapsRestored())
+	// The config: section goes in before the API opens, through the same
+	// handlers, so clients never see a half-applied file
+	if !cfg.Static.IsEmpty() {
+		srv.ApplyStaticConfig(ctx, &cfg.Static)
+	}
+	// Plugins of a daemon that left its links pinned, then our program
+	// replaces its program on those links
+	if err := srv.LoadHandover(ctx, cfg.Setting.Upgrade.HandoverPath); err != nil {
+		lg.Warn("Plugin hand-over incomplete", zap.Error(err))
+	}
+	if err := vin.HandOver(); err != nil {
+		return fmt.Errorf("hand over links: %w", err)
+	}
 	if err := srv.StartAsync(); err != nil {
 		return fmt.Errorf("start server: %w", err)
 	}
+	srv.StartMapMonitor(ctx)
 
 	bgpClient := bgp.NewClient(lg, bgp.WithEnabled(cliCtx.Bool("bgp-enabled")))
 	defer bgpClient.Stop()
@@ -112,12 +156,35 @@ func run(cliCtx *cli.Context) error {
 		return fmt.Errorf("start BGP client: %w", err)
 	}
 
+	// SIGHUP re-reads the config file, like DaemonService.Reload
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-hup:
+				lg.Info("Received SIGHUP, reloading config")
+				if _, err := srv.Reload(ctx); err != nil {
+					lg.Error("Config reload failed", zap.Error(err))
+				}
+			}
+		}
+	}()
+
 	lg.Info("Vinbero started successfully")
 
 	// Wait for shutdown signal
 	<-ctx.Done()
 	lg.Info("Received shutdown signal, cleaning up...")
 
+	if !detach {
+		if err := srv.SaveHandover(cfg.Setting.Upgrade.HandoverPath); err != nil {
+			lg.Error("Failed to save plugins for hand-over", zap.Error(err))
+		}
+	}
 	return shutdown(srv, lg)
 }
 
@@ -0,0 +1,407 @@
+package bpf
+
+import (
+	"bytes"
+	"encoding/binary"
+	"net"
+	"testing"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	vinberov1 "github.com/takehaya/vinbero/api/vinbero/v1"
+)
+
+// buildQinQIPv4Packet constructs an S-tag (0x88a8) / C-tag (0x8100) IPv4 frame.
+func buildQinQIPv4Packet(sVlanID, cVlanID uint16, srcIP, dstIP net.IP) ([]byte, error) {
+	eth := newTestEthernet(layers.EthernetTypeQinQ)
+	sTag := &layers.Dot1Q{VLANIdentifier: sVlanID, Type: layers.EthernetTypeDot1Q}
+	cTag := &layers.Dot1Q{VLANIdentifier: cVlanID, Type: layers.EthernetTypeIPv4}
+	ip4 := &layers.IPv4{
+		Version: 4, IHL: 5, TTL: 64,
+		Protocol: layers.IPProtocolICMPv4, SrcIP: srcIP, DstIP: dstIP,
+	}
+	icmp := &layers.ICMPv4{
+		TypeCode: layers.CreateICMPv4TypeCode(layers.ICMPv4TypeEchoRequest, 0),
+		Id:       1234, Seq: 1,
+	}
+
+	buf := gopacket.NewSerializeBuffer()
+	opts := gopacket.SerializeOptions{FixLengths: true, ComputeChecksums: true}
+	if err := gopacket.SerializeLayers(buf, opts, eth, sTag, cTag, ip4, icmp, gopacket.Payload(newTestPayload(64))); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// createAC installs an H.Encaps.L2 AC on ifindex 0 and 1 (BPF_PROG_TEST_RUN
+// may report either) whose outer source address identifies it.
+func (h *xdpTestHelper) createAC(ac HeadendL2AC, srcAddr string, bdID uint16) {
+	h.t.Helper()
+	src, _ := ParseIPv6(srcAddr)
+	segments, numSegments, _ := ParseSegments([]string{"fc00::200"})
+	for _, ifindex := range []uint32{0, 1} {
+		ac.Ifindex = ifindex
+		entry := &HeadendEntry{
+			Mode:        uint8(vinberov1.Srv6HeadendBehavior_SRV6_HEADEND_BEHAVIOR_H_ENCAPS_L2),
+			NumSegments: numSegments,
+			SrcAddr:     src,
+			Segments:    segments,
+			BdId:        bdID,
+		}
+		if err := h.mapOps.CreateHeadendL2(ac, entry); err != nil {
+			h.t.Fatalf("CreateHeadendL2(%+v): %v", ac, err)
+		}
+		key := ac.Key()
+		h.t.Cleanup(func() { _ = h.mapOps.DeleteHeadendL2(key) })
+	}
+}
+
+// encapSrc returns the outer IPv6 source of an encapsulated frame, or "" if
+// the frame was not encapsulated.
+func encapSrc(pkt []byte) string {
+	if len(pkt) < ethHeaderLen+40 || binary.BigEndian.Uint16(pkt[12:14]) != 0x86dd {
+		return ""
+	}
+	return net.IP(pkt[ethHeaderLen+8 : ethHeaderLen+24]).String()
+}
+
+// TestAcVlanMatching verifies that the L2 headend picks the most specific
+// AC: the double-tagged AC, then the S-VLAN AC, then a VLAN range.
+func TestAcVlanMatching(t *testing.T) {
+	h := newXDPTestHelper(t)
+	h.createAC(HeadendL2AC{VlanID: 100, InnerVlanID: 10}, "fc00::10", 0)
+	h.createAC(HeadendL2AC{VlanID: 100}, "fc00::100", 0)
+	h.createAC(HeadendL2AC{VlanID: 500, VlanIDEnd: 599}, "fc00::500", 0)
+	h.createAC(HeadendL2AC{VlanID: 550}, "fc00::550", 0)
+
+	src := net.ParseIP("10.0.0.1").To4()
+	dst := net.ParseIP("192.0.2.100").To4()
+	tests := []struct {
+		name    string
+		vlan    uint16
+		inner   uint16 // 0 = single-tagged
+		wantSrc string // "" = no AC, XDP_PASS
+	}{
+		{"double-tagged AC", 100, 10, "fc00::10"},
+		{"other C-tag falls back to S-VLAN AC", 100, 20, "fc00::100"},
+		{"single-tagged S-VLAN AC", 100, 0, "fc00::100"},
+		{"range first VLAN", 500, 0, "fc00::500"},
+		{"range last VLAN", 599, 0, "fc00::500"},
+		{"range with C-tag", 520, 7, "fc00::500"},
+		{"exact AC inside range wins", 550, 0, "fc00::550"},
+		{"outside range", 600, 0, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var pkt []byte
+			var err error
+			if tt.inner != 0 {
+				pkt, err = buildQinQIPv4Packet(tt.vlan, tt.inner, src, dst)
+			} else {
+				pkt, err = buildVlanTaggedIPv4Packet(tt.vlan, src, dst)
+			}
+			if err != nil {
+				t.Fatalf("build packet: %v", err)
+			}
+
+			ret, out := h.run(pkt)
+			if tt.wantSrc == "" {
+				if ret != XDP_PASS {
+					t.Errorf("expected XDP_PASS, got %d", ret)
+				}
+				return
+			}
+			if got := encapSrc(out); got != tt.wantSrc {
+				t.Errorf("encapsulated by AC with source %q, want %q (action %d)", got, tt.wantSrc, ret)
+			}
+		})
+	}
+}
+
+// TestAcVlanLearning verifies that MACs learned on a Q-in-Q or range AC
+// record the AC identity, not the VLANs of the individual frame.
+func TestAcVlanLearning(t *testing.T) {
+	src := net.ParseIP("10.0.0.1").To4()
+	dst := net.ParseIP("192.0.2.100").To4()
+	localMAC := net.HardwareAddr{0x00, 0x00, 0x00, 0x00, 0x00, 0x01}
+
+	t.Run("Q-in-Q AC", func(t *testing.T) {
+		h := newXDPTestHelper(t)
+		h.createAC(HeadendL2AC{VlanID: 100, InnerVlanID: 10}, "fc00::1", 100)
+		pkt, err := buildQinQIPv4Packet(100, 10, src, dst)
+		if err != nil {
+			t.Fatalf("build packet: %v", err)
+		}
+		h.run(pkt)
+
+		e, err := h.mapOps.GetFdb(100, localMAC)
+		if err != nil {
+			t.Fatalf("src MAC not learned: %v", err)
+		}
+		if e.AcVlanId != 100 || e.AcInnerVlanId != 10 {
+			t.Errorf("learned AC = %d.%d, want 100.10", e.AcVlanId, e.AcInnerVlanId)
+		}
+	})
+
+	t.Run("range AC", func(t *testing.T) {
+		h := newXDPTestHelper(t)
+		h.createAC(HeadendL2AC{VlanID: 500, VlanIDEnd: 599}, "fc00::1", 100)
+		pkt, err := buildVlanTaggedIPv4Packet(550, src, dst)
+		if err != nil {
+			t.Fatalf("build packet: %v", err)
+		}
+		h.run(pkt)
+
+		e, err := h.mapOps.GetFdb(100, localMAC)
+		if err != nil {
+			t.Fatalf("src MAC not learned: %v", err)
+		}
+		if e.AcVlanId != 500 || e.AcInnerVlanId != 0 {
+			t.Errorf("learned AC = %d.%d, want 500.0", e.AcVlanId, e.AcInnerVlanId)
+		}
+	})
+}
+
+// verifyTags checks the TPIDs and VLAN IDs following the MACs of pkt and
+// that the IPv4 EtherType comes right after them.
+func verifyTags(t *testing.T, pkt []byte, tpids, vids []uint16) {
+	t.Helper()
+	off := 12
+	for i := range tpids {
+		if len(pkt) < off+4 {
+			t.Fatalf("frame too short for tag %d", i)
+		}
+		if got := binary.BigEndian.Uint16(pkt[off:]); got != tpids[i] {
+			t.Errorf("tag %d TPID = 0x%04x, want 0x%04x", i, got, tpids[i])
+		}
+		if got := binary.BigEndian.Uint16(pkt[off+2:]) & 0x0FFF; got != vids[i] {
+			t.Errorf("tag %d VID = %d, want %d", i, got, vids[i])
+		}
+		off += 4
+	}
+	if got := binary.BigEndian.Uint16(pkt[off:]); got != 0x0800 {
+		t.Errorf("EtherType after tags = 0x%04x, want 0x0800", got)
+	}
+	if !bytes.Equal(pkt[0:6], []byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x02}) ||
+		!bytes.Equal(pkt[6:12], []byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x01}) {
+		t.Errorf("MACs not preserved: %x", pkt[0:12])
+	}
+}
+
+// TestVlanRewriteEndDT2 verifies the egress rewrite on End.DT2 local FDB
+// hits, configured on the AC or on the FDB entry.
+func TestVlanRewriteEndDT2(t *testing.T) {
+	const bdID = 100
+	dstMAC := net.HardwareAddr{0x00, 0x00, 0x00, 0x00, 0x00, 0x02}
+
+	tests := []struct {
+		name      string
+		ac        *HeadendL2AC // AC owning the FDB entry's (oif, VLAN), nil = none
+		fdb       FdbEntry
+		wantTPIDs []uint16
+		wantVIDs  []uint16
+	}{
+		{"no rewrite", nil, FdbEntry{Oif: 1},
+			[]uint16{0x8100}, []uint16{100}},
+		{"AC translate",
+			&HeadendL2AC{Ifindex: 1, VlanID: 300, EgressRewrite: VlanRewrite{Op: VlanRewriteTranslate, VlanId: 300}},
+			FdbEntry{Oif: 1, AcVlanId: 300},
+			[]uint16{0x8100}, []uint16{300}},
+		{"AC pop",
+			&HeadendL2AC{Ifindex: 1, VlanID: 100, EgressRewrite: VlanRewrite{Op: VlanRewritePop}},
+			FdbEntry{Oif: 1, AcVlanId: 100},
+			nil, nil},
+		{"AC push S-tag",
+			&HeadendL2AC{Ifindex: 1, VlanID: 10, InnerVlanID: 100, EgressRewrite: VlanRewrite{Op: VlanRewritePush, VlanId: 10}},
+			FdbEntry{Oif: 1, AcVlanId: 10, AcInnerVlanId: 100},
+			[]uint16{0x88a8, 0x8100}, []uint16{10, 100}},
+		{"FDB entry overrides AC",
+			&HeadendL2AC{Ifindex: 1, VlanID: 300, EgressRewrite: VlanRewrite{Op: VlanRewriteTranslate, VlanId: 300}},
+			FdbEntry{Oif: 1, AcVlanId: 300, Rewrite: VlanRewrite{Op: VlanRewriteTranslate, VlanId: 400}},
+			[]uint16{0x8100}, []uint16{400}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newXDPTestHelper(t)
+			h.createSidFunctionWithBD("fd00:1:100::10/128", actionEndDT2, bdID)
+			if tt.ac != nil {
+				srcAddr, _ := ParseIPv6("fc00::1")
+				segments, numSegments, _ := ParseSegments([]string{"fc00::200"})
+				entry := &HeadendEntry{
+					Mode:        uint8(vinberov1.Srv6HeadendBehavior_SRV6_HEADEND_BEHAVIOR_H_ENCAPS_L2),
+					NumSegments: numSegments, SrcAddr: srcAddr, Segments: segments, BdId: bdID,
+				}
+				if err := h.mapOps.CreateHeadendL2(*tt.ac, entry); err != nil {
+					t.Fatalf("CreateHeadendL2: %v", err)
+				}
+			}
+			fdb := tt.fdb
+			if err := h.mapOps.CreateFdb(bdID, dstMAC, &fdb); err != nil {
+				t.Fatalf("CreateFdb: %v", err)
+			}
+
+			pkt, err := buildL2EncapsulatedPacket(
+				net.ParseIP("fd00:1:1::1"), net.ParseIP("fd00:1:100::10"),
+				[]net.IP{net.ParseIP("fd00:1:100::10")}, 0,
+				100, net.ParseIP("10.0.0.1"), net.ParseIP("192.0.2.100"), true,
+			)
+			if err != nil {
+				t.Fatalf("build packet: %v", err)
+			}
+
+			ret, out := h.run(pkt)
+			if ret != XDP_REDIRECT {
+				t.Fatalf("expected XDP_REDIRECT, got %d", ret)
+			}
+			verifyTags(t, out, tt.wantTPIDs, tt.wantVIDs)
+		})
+	}
+}
+
+// TestVlanRewriteEndDX2V verifies that End.DX2V applies the rewrite of the
+// matched VLAN table entry.
+func TestVlanRewriteEndDX2V(t *testing.T) {
+	h := newXDPTestHelper(t)
+	h.createSidFunctionWithTableID("fd00:1:100::30/128", actionEndDX2V, 10)
+	rw := VlanRewrite{Op: VlanRewriteTranslate, VlanId: 300}
+	if err := h.mapOps.CreateDx2vVlan(10, 100, 1, rw); err != nil {
+		t.Fatalf("CreateDx2vVlan: %v", err)
+	}
+
+	pkt, err := buildL2EncapsulatedPacket(
+		net.ParseIP("fd00:1:1::1"), net.ParseIP("fd00:1:100::30"),
+		[]net.IP{net.ParseIP("fd00:1:100::30")}, 0,
+		100, net.ParseIP("10.0.0.1"), net.ParseIP("192.0.2.100"), true,
+	)
+	if err != nil {
+		t.Fatalf("build packet: %v", err)
+	}
+
+	ret, out := h.run(pkt)
+	if ret != XDP_REDIRECT {
+		t.Fatalf("expected XDP_REDIRECT, got %d", ret)
+	}
+	verifyInnerVlanFrame(t, out, 0, 300)
+}
+
+// TestAcVlanRangeMapOperations verifies range bookkeeping in
+// ac_vlan_range_map across create, replace and delete.
+func TestAcVlanRangeMapOperations(t *testing.T) {
+	h := newXDPTestHelper(t)
+	entry := &HeadendEntry{
+		Mode:        uint8(vinberov1.Srv6HeadendBehavior_SRV6_HEADEND_BEHAVIOR_H_ENCAPS_L2),
+		NumSegments: 1,
+	}
+	rw := VlanRewrite{Op: VlanRewriteTranslate, VlanId: 42}
+	rangeAC := HeadendL2AC{Ifindex: 5, VlanID: 100, VlanIDEnd: 199, EgressRewrite: rw}
+
+	if err := h.mapOps.CreateHeadendL2(rangeAC, entry); err != nil {
+		t.Fatalf("create range: %v", err)
+	}
+	if got := h.mapOps.GetHeadendL2AC(rangeAC.Key()); got.VlanIDEnd != 199 || got.EgressRewrite != rw {
+		t.Errorf("GetHeadendL2AC = %+v, want end 199 and rewrite %+v", got, rw)
+	}
+
+	overlap := HeadendL2AC{Ifindex: 5, VlanID: 150, VlanIDEnd: 250}
+	if err := h.mapOps.CreateHeadendL2(overlap, entry); err == nil {
+		t.Error("overlapping range should be rejected")
+	}
+	other := HeadendL2AC{Ifindex: 6, VlanID: 150, VlanIDEnd: 250}
+	if err := h.mapOps.CreateHeadendL2(other, entry); err != nil {
+		t.Errorf("same range on another interface: %v", err)
+	}
+
+	// Replacing the range AC with a single-VLAN AC drops the range.
+	if err := h.mapOps.CreateHeadendL2(HeadendL2AC{Ifindex: 5, VlanID: 100}, entry); err != nil {
+		t.Fatalf("replace with single VLAN: %v", err)
+	}
+	if got := h.mapOps.GetHeadendL2AC(rangeAC.Key()); got.VlanIDEnd != 0 {
+		t.Errorf("range still present after replace: end %d", got.VlanIDEnd)
+	}
+	if err := h.mapOps.CreateHeadendL2(overlap, entry); err != nil {
+		t.Errorf("range no longer overlaps: %v", err)
+	}
+
+	if err := h.mapOps.DeleteHeadendL2(overlap.Key()); err != nil {
+		t.Fatalf("delete range: %v", err)
+	}
+	var ranges AcVlanRanges
+	if err := h.objs.AcVlanRangeMap.Lookup(uint32(5), &ranges); err == nil {
+		for _, r := range ranges.Range {
+			if r.First != 0 {
+				t.Errorf("range %d-%d left after delete", r.First, r.Last)
+			}
+		}
+	}
+}
+
+// TestAcPortMode verifies that a port-mode AC takes every frame no more
+// specific AC claims and encapsulates it with its tags intact.
+func TestAcPortMode(t *testing.T) {
+	h := newXDPTestHelper(t)
+	h.createAC(HeadendL2AC{VlanID: HeadendL2VlanAny}, "fc00::ff", 0)
+	h.createAC(HeadendL2AC{VlanID: 100}, "fc00::100", 0)
+	h.createAC(HeadendL2AC{VlanID: 500, VlanIDEnd: 599}, "fc00::500", 0)
+
+	src := net.ParseIP("10.0.0.1").To4()
+	dst := net.ParseIP("192.0.2.100").To4()
+	untagged, err := buildSimpleIPv4Packet(src, dst)
+	if err != nil {
+		t.Fatalf("build packet: %v", err)
+	}
+	vlan100, _ := buildVlanTaggedIPv4Packet(100, src, dst)
+	vlan200, _ := buildVlanTaggedIPv4Packet(200, src, dst)
+	vlan550, _ := buildVlanTaggedIPv4Packet(550, src, dst)
+	qinq, _ := buildQinQIPv4Packet(300, 30, src, dst)
+
+	tests := []struct {
+		name    string
+		pkt     []byte
+		wantSrc string
+	}{
+		{"untagged", untagged, "fc00::ff"},
+		{"unclaimed VLAN", vlan200, "fc00::ff"},
+		{"double-tagged", qinq, "fc00::ff"},
+		{"per-VLAN AC wins", vlan100, "fc00::100"},
+		{"range AC wins", vlan550, "fc00::500"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, out := h.run(tt.pkt)
+			if got := encapSrc(out); got != tt.wantSrc {
+				t.Fatalf("encapsulated by AC with source %q, want %q", got, tt.wantSrc)
+			}
+			if len(out) < len(tt.pkt) || !bytes.Equal(out[len(out)-len(tt.pkt):], tt.pkt) {
+				t.Error("inner frame not carried unchanged")
+			}
+		})
+	}
+
+	t.Run("learns under the port-mode AC", func(t *testing.T) {
+		h := newXDPTestHelper(t)
+		h.createAC(HeadendL2AC{VlanID: HeadendL2VlanAny}, "fc00::ff", 100)
+		h.run(qinq)
+
+		e, err := h.mapOps.GetFdb(100, net.HardwareAddr{0x00, 0x00, 0x00, 0x00, 0x00, 0x01})
+		if err != nil {
+			t.Fatalf("src MAC not learned: %v", err)
+		}
+		if e.AcVlanId != HeadendL2VlanAny || e.AcInnerVlanId != 0 {
+			t.Errorf("learned AC = %d.%d, want port mode", e.AcVlanId, e.AcInnerVlanId)
+		}
+	})
+
+	t.Run("port mode takes no VLANs", func(t *testing.T) {
+		entry := &HeadendEntry{NumSegments: 1}
+		for _, ac := range []HeadendL2AC{
+			{Ifindex: 5, VlanID: HeadendL2VlanAny, InnerVlanID: 10},
+			{Ifindex: 5, VlanID: HeadendL2VlanAny, VlanIDEnd: 100},
+		} {
+			if err := h.mapOps.CreateHeadendL2(ac, entry); err == nil {
+				t.Errorf("CreateHeadendL2(%+v) should fail", ac)
+			}
+		}
+	})
+}
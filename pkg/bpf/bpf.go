@@ -3,6 +3,7 @@ package bpf
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 
 	"github.com/cilium/ebpf"
 	"github.com/cilium/ebpf/rlimit"
@@ -22,10 +23,27 @@ var pinnedControlMaps = []string{
 	"headend_v4_map",
 	"headend_v6_map",
 	"headend_l2_map",
+	"headend_l2_ext_map",
+	"ac_vlan_range_map",
 	"fdb_map",
+	"mac_limit_map",
+	"storm_ctl_map",
 	"bd_peer_map",
 	"bd_peer_reverse_map",
+	"bd_peer_span_map",
+	"vni_map",
+	"bd_vni_map",
+	"vtep_map",
+	"vtep_reverse_map",
 	"dx2v_map",
+	"sr_domain_map",
+	"sr_domain_src_map",
+	"sr_domain_iif_map",
+	"stamp_reflector_map",
+	"neigh_map",
+	"bd_irb_map",
+	"irb_route_v4_map",
+	"irb_route_v6_map",
 }
 
 //go:generate go run github.com/cilium/ebpf/cmd/bpf2go -cc $BPF_CLANG -cflags $BPF_CFLAGS Bpf ../../src/xdp_prog.c -- -I ../../src -I /usr/include/x86_64-linux-gnu
@@ -37,56 +55,23 @@ func ReadCollection(constants map[string]any, cfg *config.Config) (*BpfObjects,
 	}
 
 	objs := &BpfObjects{}
-	spec, err := LoadBpf()
+	spec, err := collectionSpec(constants, cfg)
 	if err != nil {
-		return nil, fmt.Errorf("fail to load bpf spec: %w", err)
-	}
-
-	for name, value := range constants {
-		varSpec, ok := spec.Variables[name]
-		if !ok {
-			return nil, fmt.Errorf("constant %s not found in spec", name)
-		}
-		if err := varSpec.Set(value); err != nil {
-			return nil, err
-		}
-	}
-
-	// Override map capacities from config
-	if cfg != nil {
-		entries := cfg.Setting.Entries
-		mapSizes := map[string]int{
-			"sid_function_map":   entries.SidFunction.Capacity,
-			"sid_aux_map":        entries.SidFunction.Capacity,
-			"headend_v4_map":     entries.Headendv4.Capacity,
-			"headend_v6_map":     entries.Headendv6.Capacity,
-			"headend_l2_map":     entries.HeadendL2.Capacity,
-			"fdb_map":            entries.Fdb.Capacity,
-			"bd_peer_map":        entries.BdPeer.Capacity,
-			"bd_peer_reverse_map": entries.BdPeer.Capacity,
-			"dx2v_map":            entries.VlanTable.Capacity,
-		}
-		for name, size := range mapSizes {
-			if ms, ok := spec.Maps[name]; ok && size > 0 {
-				ms.MaxEntries = uint32(size)
-			}
-		}
+		return nil, err
 	}
 
 	collOpts := &ebpf.CollectionOptions{
 		Programs: ebpf.ProgramOptions{LogSizeStart: 64 * 1024 * 1024, LogLevel: ebpf.LogLevelInstruction},
 	}
 	if cfg != nil && cfg.Setting.PinMaps.Enabled {
-		pinPath := cfg.Setting.PinMaps.Path
-		if pinPath == "" {
-			pinPath = "/sys/fs/bpf/vinbero"
-		}
+		pinPath := pinPathOf(cfg)
 		if err := os.MkdirAll(pinPath, 0o755); err != nil {
 			return nil, fmt.Errorf("failed to create pin directory %q: %w", pinPath, err)
 		}
 		for _, name := range pinnedControlMaps {
 			if ms, ok := spec.Maps[name]; ok {
 				ms.Pinning = ebpf.PinByName
+				keepPinnedCapacity(ms, filepath.Join(pinPath, name))
 			}
 		}
 		collOpts.Maps.PinPath = pinPath
@@ -110,6 +95,84 @@ func ReadCollection(constants map[string]any, cfg *config.Config) (*BpfObjects,
 	return objs, nil
 }
 
+// collectionSpec loads the embedded spec with constants set and map
+// capacities taken from cfg.
+func collectionSpec(constants map[string]any, cfg *config.Config) (*ebpf.CollectionSpec, error) {
+	spec, err := LoadBpf()
+	if err != nil {
+		return nil, fmt.Errorf("fail to load bpf spec: %w", err)
+	}
+
+	for name, value := range constants {
+		varSpec, ok := spec.Variables[name]
+		if !ok {
+			return nil, fmt.Errorf("constant %s not found in spec", name)
+		}
+		if err := varSpec.Set(value); err != nil {
+			return nil, err
+		}
+	}
+
+	// Override map capacities from config
+	if cfg != nil {
+		entries := cfg.Setting.Entries
+		if entries.BdPeer.PerBd < 0 || entries.BdPeer.PerBd > MaxBdPeersPerBd {
+			return nil, fmt.Errorf("entries.bd_peer.per_bd must be 0..%d (0 = default), got %d",
+				MaxBdPeersPerBd, entries.BdPeer.PerBd)
+		}
+		mapSizes := mapCapacities(entries)
+		for name, size := range mapSizes {
+			if ms, ok := spec.Maps[name]; ok && size > 0 {
+				ms.MaxEntries = uint32(size)
+			}
+		}
+	}
+	return spec, nil
+}
+
+// mapCapacities maps each map sized by settings.entries to its
+// configured capacity; 0 keeps the ELF default. These are also the maps
+// MapOperations.ResizeMap can grow at runtime.
+func mapCapacities(entries config.EntriesConfig) map[string]int {
+	return map[string]int{
+		"sid_function_map":    entries.SidFunction.Capacity,
+		"sid_aux_map":         entries.SidFunction.Capacity,
+		"headend_v4_map":      entries.Headendv4.Capacity,
+		"headend_v6_map":      entries.Headendv6.Capacity,
+		"headend_l2_map":      entries.HeadendL2.Capacity,
+		"fdb_map":             entries.Fdb.Capacity,
+		"bd_peer_map":         entries.BdPeer.Capacity,
+		"bd_peer_reverse_map": entries.BdPeer.Capacity,
+		"bd_peer_l2_ext_map":  entries.BdPeer.Capacity,
+		"bd_peer_span_map":    entries.BdPeer.Capacity,
+		"dx2v_map":            entries.VlanTable.Capacity,
+		"sr_domain_map":       entries.SrDomain.Capacity,
+		"sr_domain_src_map":   entries.SrDomain.Capacity,
+		"sr_domain_iif_map":   entries.SrDomain.Capacity,
+	}
+}
+
+func pinPathOf(cfg *config.Config) string {
+	if cfg.Setting.PinMaps.Path == "" {
+		return "/sys/fs/bpf/vinbero"
+	}
+	return cfg.Setting.PinMaps.Path
+}
+
+// PinnedMapsExist reports whether ReadCollection will reuse control-state
+// maps pinned by an earlier vinberod rather than create empty ones.
+func PinnedMapsExist(cfg *config.Config) bool {
+	if cfg == nil || !cfg.Setting.PinMaps.Enabled {
+		return false
+	}
+	for _, name := range pinnedControlMaps {
+		if _, err := os.Stat(filepath.Join(pinPathOf(cfg), name)); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
 // populateProgArrays registers all tail call target programs into their
 // respective PROG_ARRAY maps. Each index corresponds to the srv6_local_action
 // or srv6_headend_behavior enum value.
@@ -134,6 +197,7 @@ func populateProgArrays(objs *BpfObjects) error {
 		21: objs.TailcallEndpointEndM_gtp4E,
 		22: objs.TailcallEndpointEndDx2v,
 		23: objs.TailcallEndpointEndDt2m,
+		24: objs.TailcallEndpointEndOp,
 	}
 	for idx, prog := range endpointProgs {
 		if err := objs.SidEndpointProgs.Update(idx, prog, ebpf.UpdateAny); err != nil {
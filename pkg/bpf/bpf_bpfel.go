@@ -13,6 +13,22 @@ import (
 	"github.com/cilium/ebpf"
 )
 
+type BpfAcVlanRanges struct {
+	_     structs.HostLayout
+	Range [8]struct {
+		_     structs.HostLayout
+		First uint16
+		Last  uint16
+	}
+}
+
+type BpfBdIrbEntry struct {
+	_          structs.HostLayout
+	VrfIfindex uint32
+	GwMac      [6]uint8
+	Pad        [2]uint8
+}
+
 type BpfBdLocalEsiVal struct {
 	_   structs.HostLayout
 	Esi [10]uint8
@@ -52,8 +68,15 @@ type BpfBdPeerReverseVal struct {
 }
 
 type BpfDx2vEntry struct {
-	_   structs.HostLayout
-	Oif uint32
+	_       structs.HostLayout
+	Oif     uint32
+	Rewrite struct {
+		_           structs.HostLayout
+		Op          uint8
+		Pad         uint8
+		VlanId      uint16
+		InnerVlanId uint16
+	}
 }
 
 type BpfDx2vKey struct {
@@ -78,16 +101,29 @@ type BpfEsiKey struct {
 }
 
 type BpfFdbEntry struct {
-	_         structs.HostLayout
-	Oif       uint32
-	IsRemote  uint8
-	IsStatic  uint8
-	PeerIndex uint16
-	BdId      uint16
-	Pad       [2]uint8
-	LastSeen  uint64
-	Esi       [10]uint8
-	PadEsi    [2]uint8
+	_             structs.HostLayout
+	Oif           uint32
+	IsRemote      uint8
+	IsStatic      uint8
+	PeerIndex     uint16
+	BdId          uint16
+	AgingArmed    uint8
+	Vtep          uint8
+	LastSeen      uint64
+	Esi           [10]uint8
+	AcVlanId      uint16
+	AcInnerVlanId uint16
+	Rewrite       struct {
+		_           structs.HostLayout
+		Op          uint8
+		Pad         uint8
+		VlanId      uint16
+		InnerVlanId uint16
+	}
+	Timer struct {
+		_ structs.HostLayout
+		_ [16]byte
+	}
 }
 
 type BpfFdbKey struct {
@@ -97,29 +133,53 @@ type BpfFdbKey struct {
 }
 
 type BpfHeadendEntry struct {
-	_           structs.HostLayout
-	Mode        uint8
-	NumSegments uint8
-	Pad         [2]uint8
-	SrcAddr     [16]uint8
-	DstAddr     [16]uint8
-	Segments    [10][16]uint8
-	BdId        uint16
-	ArgsOffset  uint8
-	PadGtp      uint8
+	_             structs.HostLayout
+	Mode          uint8
+	NumSegments   uint8
+	Mtu           uint16
+	SrcAddr       [16]uint8
+	DstAddr       [16]uint8
+	Segments      [10][16]uint8
+	BdId          uint16
+	ArgsOffset    uint8
+	TcMode        uint8
+	Tc            uint8
+	HopLimitMode  uint8
+	HopLimit      uint8
+	FlowLabelMode uint8
 }
 
 type BpfHeadendL2ExtVal struct {
-	_   structs.HostLayout
-	Esi [10]uint8
-	Pad [6]uint8
+	_             structs.HostLayout
+	Esi           [10]uint8
+	EgressRewrite struct {
+		_           structs.HostLayout
+		Op          uint8
+		Pad         uint8
+		VlanId      uint16
+		InnerVlanId uint16
+	}
 }
 
 type BpfHeadendL2Key struct {
-	_       structs.HostLayout
-	Ifindex uint32
-	VlanId  uint16
-	Pad     [2]uint8
+	_           structs.HostLayout
+	Ifindex     uint32
+	VlanId      uint16
+	InnerVlanId uint16
+}
+
+type BpfIrbRouteKeyV4 struct {
+	_          structs.HostLayout
+	Prefixlen  uint32
+	VrfIfindex uint32
+	Addr       [4]uint8
+}
+
+type BpfIrbRouteKeyV6 struct {
+	_          structs.HostLayout
+	Prefixlen  uint32
+	VrfIfindex uint32
+	Addr       [16]uint8
 }
 
 type BpfLpmKeyV4 struct {
@@ -134,6 +194,36 @@ type BpfLpmKeyV6 struct {
 	Addr      [16]uint8
 }
 
+type BpfMacLimitEntry struct {
+	_                structs.HostLayout
+	MaxMacs          uint32
+	LearningDisabled uint8
+	LimitAction      uint8
+	Pad              [2]uint8
+}
+
+type BpfMacLimitKey struct {
+	_       structs.HostLayout
+	Ifindex uint32
+	VlanId  uint16
+	BdId    uint16
+}
+
+type BpfNeighEntry struct {
+	_         structs.HostLayout
+	Mac       [6]uint8
+	Origin    uint8
+	Pad       uint8
+	UpdatedNs uint64
+}
+
+type BpfNeighKey struct {
+	_    structs.HostLayout
+	BdId uint16
+	Pad  [2]uint8
+	Addr [16]uint8
+}
+
 type BpfScratchBuf struct {
 	_    structs.HostLayout
 	Data [224]uint8
@@ -145,7 +235,7 @@ type BpfSidAuxEntry struct {
 		_       structs.HostLayout
 		Nexthop [16]uint8
 	}
-	_ [184]byte
+	_ [188]byte
 }
 
 type BpfSidFunctionEntry struct {
@@ -153,6 +243,39 @@ type BpfSidFunctionEntry struct {
 	Action   uint8
 	Flavor   uint8
 	AuxIndex uint16
+	Flags    uint8
+	Pad      [3]uint8
+}
+
+type BpfSrDomainEntry struct {
+	_        structs.HostLayout
+	PolicyId uint32
+}
+
+type BpfSrDomainIifKey struct {
+	_        structs.HostLayout
+	PolicyId uint32
+	Ifindex  uint32
+}
+
+type BpfSrDomainSrcKey struct {
+	_         structs.HostLayout
+	Prefixlen uint32
+	PolicyId  uint32
+	Addr      [16]uint8
+}
+
+type BpfStampReflectorEntry struct {
+	_             structs.HostLayout
+	ClockOffsetNs int64
+	Reflected     uint64
+}
+
+type BpfStampReflectorKey struct {
+	_    structs.HostLayout
+	Addr [16]uint8
+	Port uint16
+	Pad  [2]uint8
 }
 
 type BpfStatsEntry struct {
@@ -161,6 +284,22 @@ type BpfStatsEntry struct {
 	Bytes   uint64
 }
 
+type BpfStormCtlEntry struct {
+	_    structs.HostLayout
+	Lock struct {
+		_   structs.HostLayout
+		Val uint32
+	}
+	Pad          uint32
+	Pps          uint64
+	Bps          uint64
+	LastNs       uint64
+	PktCreditPs  uint64
+	ByteCreditPs uint64
+	DropPackets  uint64
+	DropBytes    uint64
+}
+
 type BpfTailcallCtx struct {
 	_            structs.HostLayout
 	L3Offset     uint16
@@ -172,6 +311,33 @@ type BpfTailcallCtx struct {
 	_            [196]byte
 }
 
+type BpfVniEntry struct {
+	_             structs.HostLayout
+	BdId          uint16
+	IsIpv4        uint8
+	NumVteps      uint8
+	BridgeIfindex uint32
+	LocalAddr     [16]uint8
+}
+
+type BpfVtepEntry struct {
+	_    structs.HostLayout
+	Addr [16]uint8
+}
+
+type BpfVtepKey struct {
+	_     structs.HostLayout
+	BdId  uint16
+	Index uint16
+}
+
+type BpfVtepReverseKey struct {
+	_    structs.HostLayout
+	BdId uint16
+	Addr [16]uint8
+	Pad  [2]uint8
+}
+
 // LoadBpf returns the embedded CollectionSpec for Bpf.
 func LoadBpf() (*ebpf.CollectionSpec, error) {
 	reader := bytes.NewReader(_BpfBytes)
@@ -230,6 +396,7 @@ type BpfProgramSpecs struct {
 	TailcallEndpointEndM_gtp6D    *ebpf.ProgramSpec `ebpf:"tailcall_endpoint_end_m_gtp6_d"`
 	TailcallEndpointEndM_gtp6D_di *ebpf.ProgramSpec `ebpf:"tailcall_endpoint_end_m_gtp6_d_di"`
 	TailcallEndpointEndM_gtp6E    *ebpf.ProgramSpec `ebpf:"tailcall_endpoint_end_m_gtp6_e"`
+	TailcallEndpointEndOp         *ebpf.ProgramSpec `ebpf:"tailcall_endpoint_end_op"`
 	TailcallEndpointEndT          *ebpf.ProgramSpec `ebpf:"tailcall_endpoint_end_t"`
 	TailcallEndpointEndX          *ebpf.ProgramSpec `ebpf:"tailcall_endpoint_end_x"`
 	TailcallHeadendV4H_encaps     *ebpf.ProgramSpec `ebpf:"tailcall_headend_v4_h_encaps"`
@@ -247,12 +414,17 @@ type BpfProgramSpecs struct {
 //
 // It can be passed ebpf.CollectionSpec.Assign.
 type BpfMapSpecs struct {
+	AcVlanRangeMap     *ebpf.MapSpec `ebpf:"ac_vlan_range_map"`
+	BdIrbMap           *ebpf.MapSpec `ebpf:"bd_irb_map"`
 	BdLocalEsiMap      *ebpf.MapSpec `ebpf:"bd_local_esi_map"`
 	BdPeerL2ExtMap     *ebpf.MapSpec `ebpf:"bd_peer_l2_ext_map"`
 	BdPeerMap          *ebpf.MapSpec `ebpf:"bd_peer_map"`
 	BdPeerReverseMap   *ebpf.MapSpec `ebpf:"bd_peer_reverse_map"`
+	BdPeerSpanMap      *ebpf.MapSpec `ebpf:"bd_peer_span_map"`
+	BdVniMap           *ebpf.MapSpec `ebpf:"bd_vni_map"`
 	Dx2vMap            *ebpf.MapSpec `ebpf:"dx2v_map"`
 	EsiMap             *ebpf.MapSpec `ebpf:"esi_map"`
+	FdbAgingEvents     *ebpf.MapSpec `ebpf:"fdb_aging_events"`
 	FdbMap             *ebpf.MapSpec `ebpf:"fdb_map"`
 	HeadendL2ExtMap    *ebpf.MapSpec `ebpf:"headend_l2_ext_map"`
 	HeadendL2Map       *ebpf.MapSpec `ebpf:"headend_l2_map"`
@@ -260,6 +432,12 @@ type BpfMapSpecs struct {
 	HeadendV4Progs     *ebpf.MapSpec `ebpf:"headend_v4_progs"`
 	HeadendV6Map       *ebpf.MapSpec `ebpf:"headend_v6_map"`
 	HeadendV6Progs     *ebpf.MapSpec `ebpf:"headend_v6_progs"`
+	IrbRouteV4Map      *ebpf.MapSpec `ebpf:"irb_route_v4_map"`
+	IrbRouteV6Map      *ebpf.MapSpec `ebpf:"irb_route_v6_map"`
+	MacCountMap        *ebpf.MapSpec `ebpf:"mac_count_map"`
+	MacLimitMap        *ebpf.MapSpec `ebpf:"mac_limit_map"`
+	NeighMap           *ebpf.MapSpec `ebpf:"neigh_map"`
+	OamPuntEvents      *ebpf.MapSpec `ebpf:"oam_punt_events"`
 	ScratchMap         *ebpf.MapSpec `ebpf:"scratch_map"`
 	SidAuxMap          *ebpf.MapSpec `ebpf:"sid_aux_map"`
 	SidEndpointProgs   *ebpf.MapSpec `ebpf:"sid_endpoint_progs"`
@@ -267,15 +445,25 @@ type BpfMapSpecs struct {
 	SlotStatsEndpoint  *ebpf.MapSpec `ebpf:"slot_stats_endpoint"`
 	SlotStatsHeadendV4 *ebpf.MapSpec `ebpf:"slot_stats_headend_v4"`
 	SlotStatsHeadendV6 *ebpf.MapSpec `ebpf:"slot_stats_headend_v6"`
+	SrDomainIifMap     *ebpf.MapSpec `ebpf:"sr_domain_iif_map"`
+	SrDomainMap        *ebpf.MapSpec `ebpf:"sr_domain_map"`
+	SrDomainSrcMap     *ebpf.MapSpec `ebpf:"sr_domain_src_map"`
+	StampReflectorMap  *ebpf.MapSpec `ebpf:"stamp_reflector_map"`
 	StatsMap           *ebpf.MapSpec `ebpf:"stats_map"`
+	StormCtlMap        *ebpf.MapSpec `ebpf:"storm_ctl_map"`
 	TailcallCtxMap     *ebpf.MapSpec `ebpf:"tailcall_ctx_map"`
+	VniMap             *ebpf.MapSpec `ebpf:"vni_map"`
+	VtepMap            *ebpf.MapSpec `ebpf:"vtep_map"`
+	VtepReverseMap     *ebpf.MapSpec `ebpf:"vtep_reverse_map"`
 }
 
 // BpfVariableSpecs contains global variables before they are loaded into the kernel.
 //
 // It can be passed ebpf.CollectionSpec.Assign.
 type BpfVariableSpecs struct {
-	EnableStats *ebpf.VariableSpec `ebpf:"enable_stats"`
+	BumPeersPerBd *ebpf.VariableSpec `ebpf:"bum_peers_per_bd"`
+	EnableStats   *ebpf.VariableSpec `ebpf:"enable_stats"`
+	FdbAgingNs    *ebpf.VariableSpec `ebpf:"fdb_aging_ns"`
 }
 
 // BpfObjects contains all objects after they have been loaded into the kernel.
@@ -298,12 +486,17 @@ func (o *BpfObjects) Close() error {
 //
 // It can be passed to LoadBpfObjects or ebpf.CollectionSpec.LoadAndAssign.
 type BpfMaps struct {
+	AcVlanRangeMap     *ebpf.Map `ebpf:"ac_vlan_range_map"`
+	BdIrbMap           *ebpf.Map `ebpf:"bd_irb_map"`
 	BdLocalEsiMap      *ebpf.Map `ebpf:"bd_local_esi_map"`
 	BdPeerL2ExtMap     *ebpf.Map `ebpf:"bd_peer_l2_ext_map"`
 	BdPeerMap          *ebpf.Map `ebpf:"bd_peer_map"`
 	BdPeerReverseMap   *ebpf.Map `ebpf:"bd_peer_reverse_map"`
+	BdPeerSpanMap      *ebpf.Map `ebpf:"bd_peer_span_map"`
+	BdVniMap           *ebpf.Map `ebpf:"bd_vni_map"`
 	Dx2vMap            *ebpf.Map `ebpf:"dx2v_map"`
 	EsiMap             *ebpf.Map `ebpf:"esi_map"`
+	FdbAgingEvents     *ebpf.Map `ebpf:"fdb_aging_events"`
 	FdbMap             *ebpf.Map `ebpf:"fdb_map"`
 	HeadendL2ExtMap    *ebpf.Map `ebpf:"headend_l2_ext_map"`
 	HeadendL2Map       *ebpf.Map `ebpf:"headend_l2_map"`
@@ -311,6 +504,12 @@ type BpfMaps struct {
 	HeadendV4Progs     *ebpf.Map `ebpf:"headend_v4_progs"`
 	HeadendV6Map       *ebpf.Map `ebpf:"headend_v6_map"`
 	HeadendV6Progs     *ebpf.Map `ebpf:"headend_v6_progs"`
+	IrbRouteV4Map      *ebpf.Map `ebpf:"irb_route_v4_map"`
+	IrbRouteV6Map      *ebpf.Map `ebpf:"irb_route_v6_map"`
+	MacCountMap        *ebpf.Map `ebpf:"mac_count_map"`
+	MacLimitMap        *ebpf.Map `ebpf:"mac_limit_map"`
+	NeighMap           *ebpf.Map `ebpf:"neigh_map"`
+	OamPuntEvents      *ebpf.Map `ebpf:"oam_punt_events"`
 	ScratchMap         *ebpf.Map `ebpf:"scratch_map"`
 	SidAuxMap          *ebpf.Map `ebpf:"sid_aux_map"`
 	SidEndpointProgs   *ebpf.Map `ebpf:"sid_endpoint_progs"`
@@ -318,18 +517,31 @@ type BpfMaps struct {
 	SlotStatsEndpoint  *ebpf.Map `ebpf:"slot_stats_endpoint"`
 	SlotStatsHeadendV4 *ebpf.Map `ebpf:"slot_stats_headend_v4"`
 	SlotStatsHeadendV6 *ebpf.Map `ebpf:"slot_stats_headend_v6"`
+	SrDomainIifMap     *ebpf.Map `ebpf:"sr_domain_iif_map"`
+	SrDomainMap        *ebpf.Map `ebpf:"sr_domain_map"`
+	SrDomainSrcMap     *ebpf.Map `ebpf:"sr_domain_src_map"`
+	StampReflectorMap  *ebpf.Map `ebpf:"stamp_reflector_map"`
 	StatsMap           *ebpf.Map `ebpf:"stats_map"`
+	StormCtlMap        *ebpf.Map `ebpf:"storm_ctl_map"`
 	TailcallCtxMap     *ebpf.Map `ebpf:"tailcall_ctx_map"`
+	VniMap             *ebpf.Map `ebpf:"vni_map"`
+	VtepMap            *ebpf.Map `ebpf:"vtep_map"`
+	VtepReverseMap     *ebpf.Map `ebpf:"vtep_reverse_map"`
 }
 
 func (m *BpfMaps) Close() error {
 	return _BpfClose(
+		m.AcVlanRangeMap,
+		m.BdIrbMap,
 		m.BdLocalEsiMap,
 		m.BdPeerL2ExtMap,
 		m.BdPeerMap,
 		m.BdPeerReverseMap,
+		m.BdPeerSpanMap,
+		m.BdVniMap,
 		m.Dx2vMap,
 		m.EsiMap,
+		m.FdbAgingEvents,
 		m.FdbMap,
 		m.HeadendL2ExtMap,
 		m.HeadendL2Map,
@@ -337,6 +549,12 @@ func (m *BpfMaps) Close() error {
 		m.HeadendV4Progs,
 		m.HeadendV6Map,
 		m.HeadendV6Progs,
+		m.IrbRouteV4Map,
+		m.IrbRouteV6Map,
+		m.MacCountMap,
+		m.MacLimitMap,
+		m.NeighMap,
+		m.OamPuntEvents,
 		m.ScratchMap,
 		m.SidAuxMap,
 		m.SidEndpointProgs,
@@ -344,8 +562,16 @@ func (m *BpfMaps) Close() error {
 		m.SlotStatsEndpoint,
 		m.SlotStatsHeadendV4,
 		m.SlotStatsHeadendV6,
+		m.SrDomainIifMap,
+		m.SrDomainMap,
+		m.SrDomainSrcMap,
+		m.StampReflectorMap,
 		m.StatsMap,
+		m.StormCtlMap,
 		m.TailcallCtxMap,
+		m.VniMap,
+		m.VtepMap,
+		m.VtepReverseMap,
 	)
 }
 
@@ -353,7 +579,9 @@ func (m *BpfMaps) Close() error {
 //
 // It can be passed to LoadBpfObjects or ebpf.CollectionSpec.LoadAndAssign.
 type BpfVariables struct {
-	EnableStats *ebpf.Variable `ebpf:"enable_stats"`
+	BumPeersPerBd *ebpf.Variable `ebpf:"bum_peers_per_bd"`
+	EnableStats   *ebpf.Variable `ebpf:"enable_stats"`
+	FdbAgingNs    *ebpf.Variable `ebpf:"fdb_aging_ns"`
 }
 
 // BpfPrograms contains all programs after they have been loaded into the kernel.
@@ -376,6 +604,7 @@ type BpfPrograms struct {
 	TailcallEndpointEndM_gtp6D    *ebpf.Program `ebpf:"tailcall_endpoint_end_m_gtp6_d"`
 	TailcallEndpointEndM_gtp6D_di *ebpf.Program `ebpf:"tailcall_endpoint_end_m_gtp6_d_di"`
 	TailcallEndpointEndM_gtp6E    *ebpf.Program `ebpf:"tailcall_endpoint_end_m_gtp6_e"`
+	TailcallEndpointEndOp         *ebpf.Program `ebpf:"tailcall_endpoint_end_op"`
 	TailcallEndpointEndT          *ebpf.Program `ebpf:"tailcall_endpoint_end_t"`
 	TailcallEndpointEndX          *ebpf.Program `ebpf:"tailcall_endpoint_end_x"`
 	TailcallHeadendV4H_encaps     *ebpf.Program `ebpf:"tailcall_headend_v4_h_encaps"`
@@ -407,6 +636,7 @@ func (p *BpfPrograms) Close() error {
 		p.TailcallEndpointEndM_gtp6D,
 		p.TailcallEndpointEndM_gtp6D_di,
 		p.TailcallEndpointEndM_gtp6E,
+		p.TailcallEndpointEndOp,
 		p.TailcallEndpointEndT,
 		p.TailcallEndpointEndX,
 		p.TailcallHeadendV4H_encaps,
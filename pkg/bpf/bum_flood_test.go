@@ -0,0 +1,243 @@
+package bpf
+
+import (
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/cilium/ebpf"
+	vinberov1 "github.com/takehaya/vinbero/api/vinbero/v1"
+)
+
+// tcFloodMagic mirrors TC_CB_FLOOD_MAGIC in tc_bum.h ("VNBF").
+const tcFloodMagic = 0x564E4246
+
+// bumPeersPerPass mirrors BUM_PEERS_PER_PASS in tc_bum.h.
+const bumPeersPerPass = 64
+
+const (
+	floodBdID    = uint16(300)
+	floodVlanID  = uint16(300)
+	floodIfindex = uint32(1) // lo: replicas are queued on its backlog and dropped
+)
+
+// createFloodPeers installs n L2 peers in floodBdID at indexes 0..n-1.
+func createFloodPeers(tb testing.TB, mapOps *MapOperations, n int, esiAt map[int][ESILen]byte) {
+	tb.Helper()
+	segments, numSegments, _ := ParseSegments([]string{"fc00:ff::1"})
+	for i := 0; i < n; i++ {
+		src, err := ParseIPv6(fmt.Sprintf("fc00:%x::1", i+1))
+		if err != nil {
+			tb.Fatalf("ParseIPv6: %v", err)
+		}
+		entry := &HeadendEntry{
+			Mode:        uint8(vinberov1.Srv6HeadendBehavior_SRV6_HEADEND_BEHAVIOR_H_ENCAPS_L2),
+			NumSegments: numSegments,
+			SrcAddr:     src,
+			Segments:    segments,
+			BdId:        floodBdID,
+		}
+		if err := mapOps.CreateBdPeer(floodBdID, uint16(i), entry, esiAt[i]); err != nil {
+			tb.Fatalf("CreateBdPeer(%d): %v", i, err)
+		}
+	}
+}
+
+// floodPacket is the BUM frame carried by a flood continuation clone.
+func floodPacket(tb testing.TB) []byte {
+	tb.Helper()
+	pkt, err := buildSimpleIPv4Packet(net.ParseIP("10.0.0.1").To4(), net.ParseIP("10.0.0.255").To4())
+	if err != nil {
+		tb.Fatalf("build packet: %v", err)
+	}
+	overrideDstMAC(pkt, net.HardwareAddr{0xff, 0xff, 0xff, 0xff, 0xff, 0xff})
+	return pkt
+}
+
+// runFlood drives the TC program as a flood continuation (Mode 3) that
+// resumes BD floodBdID at peer index start.
+func runFlood(tb testing.TB, objs *BpfObjects, pkt []byte, start uint32) uint32 {
+	tb.Helper()
+	ctx := skBuffCtx{
+		Ifindex: floodIfindex,
+		Cb:      [5]uint32{tcFloodMagic, uint32(floodBdID), start, uint32(floodVlanID)},
+	}
+	opts := ebpf.RunOptions{
+		Data:       pkt,
+		DataOut:    make([]byte, 1500),
+		Context:    ctx,
+		ContextOut: &skBuffCtx{},
+		Repeat:     1,
+	}
+	ret, err := objs.VinberoTcIngress.Run(&opts)
+	if err != nil {
+		tb.Fatalf("Failed to run TC BPF program: %v", err)
+	}
+	return ret
+}
+
+func TestBdPeerSpan(t *testing.T) {
+	h := newXDPTestHelper(t)
+	createFloodPeers(t, h.mapOps, 6, nil)
+
+	span := func() (uint16, bool) {
+		var v uint16
+		bd := floodBdID
+		err := h.objs.BdPeerSpanMap.Lookup(&bd, &v)
+		return v, err == nil
+	}
+	if got, ok := span(); !ok || got != 6 {
+		t.Fatalf("span after create: got %d (present=%v), want 6", got, ok)
+	}
+
+	// Deleting a hole keeps the span; deleting the top shrinks it past holes.
+	for _, idx := range []uint16{3, 4} {
+		if err := h.mapOps.DeleteBdPeer(floodBdID, idx); err != nil {
+			t.Fatalf("DeleteBdPeer(%d): %v", idx, err)
+		}
+	}
+	if got, _ := span(); got != 6 {
+		t.Errorf("span after deleting holes: got %d, want 6", got)
+	}
+	if err := h.mapOps.DeleteBdPeer(floodBdID, 5); err != nil {
+		t.Fatalf("DeleteBdPeer(5): %v", err)
+	}
+	if got, _ := span(); got != 3 {
+		t.Errorf("span after deleting top: got %d, want 3", got)
+	}
+
+	// Rebuild restores a span lost alongside the pinned peers.
+	bd := floodBdID
+	if err := h.objs.BdPeerSpanMap.Delete(&bd); err != nil {
+		t.Fatalf("delete span: %v", err)
+	}
+	if err := h.mapOps.RebuildBdPeerSpans(); err != nil {
+		t.Fatalf("RebuildBdPeerSpans: %v", err)
+	}
+	if got, ok := span(); !ok || got != 3 {
+		t.Errorf("span after rebuild: got %d (present=%v), want 3", got, ok)
+	}
+
+	if _, err := h.mapOps.FlushBdPeers(floodBdID); err != nil {
+		t.Fatalf("FlushBdPeers: %v", err)
+	}
+	if _, ok := span(); ok {
+		t.Error("span should be removed once the BD has no peers")
+	}
+}
+
+// TestLookupBdPeerIndex verifies that a peer's index is found by its
+// source address through the reverse map, and forgotten once deleted.
+func TestLookupBdPeerIndex(t *testing.T) {
+	h := newXDPTestHelper(t)
+	createFloodPeers(t, h.mapOps, 3, nil)
+
+	src, _ := ParseIPv6("fc00:3::1")
+	if idx, ok := h.mapOps.LookupBdPeerIndex(floodBdID, src); !ok || idx != 2 {
+		t.Fatalf("LookupBdPeerIndex: got %d (found=%v), want 2", idx, ok)
+	}
+	if _, ok := h.mapOps.LookupBdPeerIndex(floodBdID+1, src); ok {
+		t.Error("peer found under another BD")
+	}
+	if err := h.mapOps.DeleteBdPeer(floodBdID, 2); err != nil {
+		t.Fatalf("DeleteBdPeer: %v", err)
+	}
+	if _, ok := h.mapOps.LookupBdPeerIndex(floodBdID, src); ok {
+		t.Error("deleted peer still found")
+	}
+}
+
+func TestBdPeersPerBdConstant(t *testing.T) {
+	h := newXDPTestHelper(t)
+	if got := h.mapOps.BdPeersPerBd(); got != DefaultBdPeersPerBd {
+		t.Errorf("default BdPeersPerBd: got %d, want %d", got, DefaultBdPeersPerBd)
+	}
+
+	h = newXDPTestHelperWithConstants(t, map[string]any{"bum_peers_per_bd": uint32(16)})
+	if got := h.mapOps.BdPeersPerBd(); got != 16 {
+		t.Errorf("configured BdPeersPerBd: got %d, want 16", got)
+	}
+	createFloodPeers(t, h.mapOps, 15, nil)
+	if got := h.mapOps.FindFreeBdPeerIndex(floodBdID); got != 15 {
+		t.Errorf("FindFreeBdPeerIndex: got %d, want 15", got)
+	}
+	createFloodPeers(t, h.mapOps, 16, nil)
+	if got := h.mapOps.FindFreeBdPeerIndex(floodBdID); got != 16 {
+		t.Errorf("FindFreeBdPeerIndex on a full BD: got %d, want 16", got)
+	}
+}
+
+// TestTCBumFloodPasses verifies that a BD with more than BUM_PEERS_PER_PASS
+// peers is flooded in batches and that split-horizon still applies to peers
+// far beyond the old 8-peer limit.
+func TestTCBumFloodPasses(t *testing.T) {
+	localESI, _ := ParseESI("aa:aa:aa:aa:aa:aa:aa:aa:aa:31")
+	const numPeers = 100
+	const shPeer = 90 // shares the source AC's ES
+
+	tests := []struct {
+		name          string
+		constants     map[string]any
+		start         uint32
+		wantReplicas  uint64
+		wantSplitHzTx uint64
+	}{
+		{"first pass stops at the batch size", nil, 0, bumPeersPerPass, 0},
+		{"continuation covers the rest", nil, bumPeersPerPass, numPeers - bumPeersPerPass - 1, 1},
+		{"continuation past the span is a no-op", nil, numPeers, 0, 0},
+		{"per_bd clamps the span", map[string]any{"bum_peers_per_bd": uint32(40)}, 0, 40, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			constants := map[string]any{"enable_stats": uint8(1)}
+			for k, v := range tt.constants {
+				constants[k] = v
+			}
+			h := newXDPTestHelperWithConstants(t, constants)
+
+			srcAddr, _ := ParseIPv6("fc00::1")
+			segments, numSegments, _ := ParseSegments([]string{"fc00::200"})
+			ac := &HeadendEntry{
+				Mode:        uint8(vinberov1.Srv6HeadendBehavior_SRV6_HEADEND_BEHAVIOR_H_ENCAPS_L2),
+				NumSegments: numSegments,
+				SrcAddr:     srcAddr,
+				Segments:    segments,
+				BdId:        floodBdID,
+			}
+			if err := h.mapOps.CreateHeadendL2(HeadendL2AC{Ifindex: floodIfindex, VlanID: floodVlanID, Esi: localESI}, ac); err != nil {
+				t.Fatalf("CreateHeadendL2: %v", err)
+			}
+			createFloodPeers(t, h.mapOps, numPeers, map[int][ESILen]byte{shPeer: localESI})
+
+			if ret := runFlood(t, h.objs, floodPacket(t), tt.start); ret != TC_ACT_SHOT {
+				t.Fatalf("continuation clone should be consumed: got %d, want TC_ACT_SHOT", ret)
+			}
+			if got := readStatCounter(t, h, "BUM_REPLICA"); got != tt.wantReplicas {
+				t.Errorf("BUM_REPLICA: got %d, want %d", got, tt.wantReplicas)
+			}
+			if got := readStatCounter(t, h, "SPLIT_HORIZON_TX"); got != tt.wantSplitHzTx {
+				t.Errorf("SPLIT_HORIZON_TX: got %d, want %d", got, tt.wantSplitHzTx)
+			}
+		})
+	}
+}
+
+// BenchmarkTCBumFlood measures one flood pass for growing BD sizes; the
+// ns/op delta between sizes is the per-peer replication cost. Each pass
+// rewrites skb->cb, which BPF_PROG_TEST_RUN does not reset between repeats,
+// so every iteration is its own run and ns/op includes one syscall.
+func BenchmarkTCBumFlood(b *testing.B) {
+	for _, n := range []int{0, 1, 8, 32, bumPeersPerPass} {
+		b.Run(fmt.Sprintf("peers=%d", n), func(b *testing.B) {
+			h := newXDPTestHelper(b)
+			createFloodPeers(b, h.mapOps, n, nil)
+			pkt := floodPacket(b)
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				runFlood(b, h.objs, pkt, 0)
+			}
+		})
+	}
+}
@@ -0,0 +1,290 @@
+package bpf
+
+import (
+	"encoding/binary"
+	"net"
+	"testing"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	vinberov1 "github.com/takehaya/vinbero/api/vinbero/v1"
+	"github.com/takehaya/vinbero/pkg/packet"
+)
+
+const (
+	ecnNotECT = 0x00
+	ecnECT0   = 0x02
+	ecnCE     = 0x03
+)
+
+// buildUDPv4Packet returns an IPv4/UDP packet with the given TOS, TTL and
+// source port, optionally behind an 802.1Q tag.
+func buildUDPv4Packet(t *testing.T, vlanID uint16, tos, ttl uint8, srcPort uint16) []byte {
+	t.Helper()
+	ip4 := &layers.IPv4{
+		Version: 4, IHL: 5, TOS: tos, TTL: ttl, Flags: layers.IPv4DontFragment,
+		Protocol: layers.IPProtocolUDP,
+		SrcIP:    net.ParseIP("10.0.1.1").To4(), DstIP: net.ParseIP("10.0.2.1").To4(),
+	}
+	udp := &layers.UDP{SrcPort: layers.UDPPort(srcPort), DstPort: 5000}
+	_ = udp.SetNetworkLayerForChecksum(ip4)
+
+	var ls []gopacket.SerializableLayer
+	if vlanID != 0 {
+		ls = append(ls, newTestEthernet(layers.EthernetTypeDot1Q),
+			&layers.Dot1Q{VLANIdentifier: vlanID, Type: layers.EthernetTypeIPv4})
+	} else {
+		ls = append(ls, newTestEthernet(layers.EthernetTypeIPv4))
+	}
+	ls = append(ls, ip4, udp, gopacket.Payload(newTestPayload(32)))
+
+	buf := gopacket.NewSerializeBuffer()
+	opts := gopacket.SerializeOptions{FixLengths: true, ComputeChecksums: true}
+	if err := gopacket.SerializeLayers(buf, opts, ls...); err != nil {
+		t.Fatalf("SerializeLayers: %v", err)
+	}
+	return buf.Bytes()
+}
+
+// buildUDPv6Packet returns an IPv6/UDP packet with the given Traffic Class
+// and Hop Limit.
+func buildUDPv6Packet(t *testing.T, tc, hopLimit uint8) []byte {
+	t.Helper()
+	ip6 := &layers.IPv6{
+		Version: 6, TrafficClass: tc, HopLimit: hopLimit, NextHeader: layers.IPProtocolUDP,
+		SrcIP: net.ParseIP("2001:db8:1::1"), DstIP: net.ParseIP("2001:db8:2::1"),
+	}
+	udp := &layers.UDP{SrcPort: 40000, DstPort: 5000}
+	_ = udp.SetNetworkLayerForChecksum(ip6)
+
+	buf := gopacket.NewSerializeBuffer()
+	opts := gopacket.SerializeOptions{FixLengths: true, ComputeChecksums: true}
+	if err := gopacket.SerializeLayers(buf, opts, newTestEthernet(layers.EthernetTypeIPv6),
+		ip6, udp, gopacket.Payload(newTestPayload(32))); err != nil {
+		t.Fatalf("SerializeLayers: %v", err)
+	}
+	return buf.Bytes()
+}
+
+// outerFields returns the Traffic Class, Flow Label and Hop Limit of the
+// outer IPv6 header right after the Ethernet header.
+func outerFields(t *testing.T, pkt []byte) (tc uint8, flowLabel uint32, hopLimit uint8) {
+	t.Helper()
+	if len(pkt) < ethHeaderLen+ipv6HeaderLen {
+		t.Fatalf("packet too short: %d", len(pkt))
+	}
+	w := binary.BigEndian.Uint32(pkt[ethHeaderLen:])
+	return uint8(w >> 20), w & 0xfffff, pkt[ethHeaderLen+7]
+}
+
+func encapFieldsEntry(mode vinberov1.Srv6HeadendBehavior) *HeadendEntry {
+	var segments [10][16]byte
+	copy(segments[0][:], net.ParseIP("fc00:1::1").To16())
+	copy(segments[1][:], net.ParseIP("fc00:2::1").To16())
+	var src [16]byte
+	copy(src[:], net.ParseIP("fc00:ff::1").To16())
+	return &HeadendEntry{Mode: uint8(mode), NumSegments: 2, SrcAddr: src, Segments: segments}
+}
+
+// TestXDPProgHeadendEncapFields verifies the per-headend outer Traffic
+// Class, Hop Limit and Flow Label on H.Encaps and H.Encaps.L2.
+func TestXDPProgHeadendEncapFields(t *testing.T) {
+	hEncaps := vinberov1.Srv6HeadendBehavior_SRV6_HEADEND_BEHAVIOR_H_ENCAPS
+
+	t.Run("defaults", func(t *testing.T) {
+		h := newXDPTestHelper(t)
+		if err := h.mapOps.CreateHeadendV4("10.0.2.0/24", encapFieldsEntry(hEncaps)); err != nil {
+			t.Fatalf("CreateHeadendV4: %v", err)
+		}
+		_, out := h.run(buildUDPv4Packet(t, 0, 0xb8|ecnECT0, 37, 40000))
+		verifySRHPresent(t, out)
+		if tc, fl, hl := outerFields(t, out); tc != 0 || fl != 0 || hl != 64 {
+			t.Errorf("outer tc/flow/hop: got %#x/%#x/%d, want 0/0/64", tc, fl, hl)
+		}
+	})
+
+	t.Run("IPv4 copy, propagate and hash", func(t *testing.T) {
+		h := newXDPTestHelper(t)
+		entry := encapFieldsEntry(hEncaps)
+		entry.TcMode = uint8(vinberov1.Srv6EncapTcMode_SRV6_ENCAP_TC_MODE_COPY)
+		entry.HopLimitMode = uint8(vinberov1.Srv6EncapHopLimitMode_SRV6_ENCAP_HOP_LIMIT_MODE_PROPAGATE)
+		entry.FlowLabelMode = uint8(vinberov1.Srv6EncapFlowLabelMode_SRV6_ENCAP_FLOW_LABEL_MODE_HASH)
+		if err := h.mapOps.CreateHeadendV4("10.0.2.0/24", entry); err != nil {
+			t.Fatalf("CreateHeadendV4: %v", err)
+		}
+
+		_, out := h.run(buildUDPv4Packet(t, 0, 0xb8|ecnECT0, 37, 40000))
+		verifySRHPresent(t, out)
+		tc, fl, hl := outerFields(t, out)
+		if tc != 0xb8|ecnECT0 || hl != 37 {
+			t.Errorf("outer tc/hop: got %#x/%d, want %#x/37", tc, hl, 0xb8|ecnECT0)
+		}
+		if fl == 0 {
+			t.Errorf("flow label: got 0, want a 5-tuple hash")
+		}
+
+		_, again := h.run(buildUDPv4Packet(t, 0, 0, 64, 40000))
+		if _, fl2, _ := outerFields(t, again); fl2 != fl {
+			t.Errorf("flow label for the same 5-tuple: got %#x, want %#x", fl2, fl)
+		}
+		_, other := h.run(buildUDPv4Packet(t, 0, 0, 64, 40001))
+		if _, fl3, _ := outerFields(t, other); fl3 == fl {
+			t.Errorf("flow label for another source port: got %#x, want a different label", fl3)
+		}
+	})
+
+	t.Run("IPv6 set and fixed", func(t *testing.T) {
+		h := newXDPTestHelper(t)
+		entry := encapFieldsEntry(hEncaps)
+		entry.TcMode = uint8(vinberov1.Srv6EncapTcMode_SRV6_ENCAP_TC_MODE_SET)
+		entry.Tc = 0x28
+		entry.HopLimit = 10
+		if err := h.mapOps.CreateHeadendV6("2001:db8:2::/48", entry); err != nil {
+			t.Fatalf("CreateHeadendV6: %v", err)
+		}
+
+		_, out := h.run(buildUDPv6Packet(t, 0xb8|ecnCE, 50))
+		verifySRHPresent(t, out)
+		if tc, fl, hl := outerFields(t, out); tc != 0x28 || fl != 0 || hl != 10 {
+			t.Errorf("outer tc/flow/hop: got %#x/%#x/%d, want 0x28/0/10", tc, fl, hl)
+		}
+	})
+
+	t.Run("IPv6 copy and propagate", func(t *testing.T) {
+		h := newXDPTestHelper(t)
+		entry := encapFieldsEntry(hEncaps)
+		entry.TcMode = uint8(vinberov1.Srv6EncapTcMode_SRV6_ENCAP_TC_MODE_COPY)
+		entry.HopLimitMode = uint8(vinberov1.Srv6EncapHopLimitMode_SRV6_ENCAP_HOP_LIMIT_MODE_PROPAGATE)
+		if err := h.mapOps.CreateHeadendV6("2001:db8:2::/48", entry); err != nil {
+			t.Fatalf("CreateHeadendV6: %v", err)
+		}
+
+		_, out := h.run(buildUDPv6Packet(t, 0xb8|ecnCE, 50))
+		if tc, fl, hl := outerFields(t, out); tc != 0xb8|ecnCE || fl != 0 || hl != 50 {
+			t.Errorf("outer tc/flow/hop: got %#x/%#x/%d, want %#x/0/50", tc, fl, hl, 0xb8|ecnCE)
+		}
+	})
+
+	t.Run("L2 copy from tagged IPv4", func(t *testing.T) {
+		h := newXDPTestHelper(t)
+		entry := encapFieldsEntry(vinberov1.Srv6HeadendBehavior_SRV6_HEADEND_BEHAVIOR_H_ENCAPS_L2)
+		entry.TcMode = uint8(vinberov1.Srv6EncapTcMode_SRV6_ENCAP_TC_MODE_COPY)
+		entry.HopLimitMode = uint8(vinberov1.Srv6EncapHopLimitMode_SRV6_ENCAP_HOP_LIMIT_MODE_PROPAGATE)
+		entry.FlowLabelMode = uint8(vinberov1.Srv6EncapFlowLabelMode_SRV6_ENCAP_FLOW_LABEL_MODE_HASH)
+		for _, ifindex := range []uint32{0, 1} {
+			if err := h.mapOps.CreateHeadendL2(HeadendL2AC{Ifindex: ifindex, VlanID: 100}, entry); err != nil {
+				t.Fatalf("CreateHeadendL2: %v", err)
+			}
+		}
+
+		_, out := h.run(buildUDPv4Packet(t, 100, 0x48|ecnECT0, 20, 40000))
+		tc, fl, hl := outerFields(t, out)
+		if tc != 0x48|ecnECT0 || hl != 20 || fl == 0 {
+			t.Errorf("outer tc/flow/hop: got %#x/%#x/%d, want %#x/non-zero/20", tc, fl, hl, 0x48|ecnECT0)
+		}
+	})
+}
+
+// buildECNEncapsulatedPacket returns an SRv6 packet (SL=0) to sid whose
+// outer IPv6 Traffic Class is outerTC, carrying an inner IPv4 or IPv6
+// packet with Traffic Class / TOS innerTC.
+func buildECNEncapsulatedPacket(t *testing.T, sid string, outerTC, innerTC uint8, innerV4 bool) []byte {
+	t.Helper()
+	outer := &layers.IPv6{
+		Version: 6, TrafficClass: outerTC, HopLimit: 64, NextHeader: layers.IPProtocol(43),
+		SrcIP: net.ParseIP("fc00:ff::1"), DstIP: net.ParseIP(sid),
+	}
+	nextHeader := uint8(layers.IPProtocolIPv6)
+	if innerV4 {
+		nextHeader = uint8(layers.IPProtocolIPv4)
+	}
+	srh := &packet.Srv6Layer{
+		NextHeader: nextHeader, HdrExtLen: 2, RoutingType: 4, SegmentsLeft: 0,
+		LastEntry: 0, Segments: segmentsToNetipAddr([]net.IP{net.ParseIP(sid)}),
+	}
+
+	ls := []gopacket.SerializableLayer{newTestEthernet(layers.EthernetTypeIPv6), outer, srh}
+	if innerV4 {
+		ip4 := &layers.IPv4{
+			Version: 4, IHL: 5, TOS: innerTC, TTL: 64, Protocol: layers.IPProtocolUDP,
+			SrcIP: net.ParseIP("10.0.1.1").To4(), DstIP: net.ParseIP("10.0.2.1").To4(),
+		}
+		udp := &layers.UDP{SrcPort: 40000, DstPort: 5000}
+		_ = udp.SetNetworkLayerForChecksum(ip4)
+		ls = append(ls, ip4, udp)
+	} else {
+		ip6 := &layers.IPv6{
+			Version: 6, TrafficClass: innerTC, HopLimit: 64, NextHeader: layers.IPProtocolUDP,
+			SrcIP: net.ParseIP("2001:db8:1::1"), DstIP: net.ParseIP("2001:db8:2::1"),
+		}
+		udp := &layers.UDP{SrcPort: 40000, DstPort: 5000}
+		_ = udp.SetNetworkLayerForChecksum(ip6)
+		ls = append(ls, ip6, udp)
+	}
+	ls = append(ls, gopacket.Payload(newTestPayload(32)))
+
+	buf := gopacket.NewSerializeBuffer()
+	opts := gopacket.SerializeOptions{FixLengths: true, ComputeChecksums: true}
+	if err := gopacket.SerializeLayers(buf, opts, ls...); err != nil {
+		t.Fatalf("SerializeLayers: %v", err)
+	}
+	return buf.Bytes()
+}
+
+// TestXDPProgDecapECNPropagate verifies RFC 6040 decapsulation: with
+// SID_FLAG_ECN_PROPAGATE an outer CE mark is copied into an ECN-capable
+// inner header and a Not-ECT inner packet is dropped.
+func TestXDPProgDecapECNPropagate(t *testing.T) {
+	const sid = "fc00:1::100"
+	dx4 := uint8(vinberov1.Srv6LocalAction_SRV6_LOCAL_ACTION_END_DX4)
+	dt6 := uint8(vinberov1.Srv6LocalAction_SRV6_LOCAL_ACTION_END_DT6)
+
+	tests := []struct {
+		name      string
+		action    uint8
+		flags     uint8
+		innerV4   bool
+		outerTC   uint8
+		innerTC   uint8
+		wantTC    uint8
+		wantDrops uint64
+	}{
+		{"IPv4 ECT(0) marked CE", dx4, SidFlagEcnPropagate, true, 0xb8 | ecnCE, 0x48 | ecnECT0, 0x48 | ecnCE, 0},
+		{"IPv6 ECT(0) marked CE", dt6, SidFlagEcnPropagate, false, ecnCE, 0x48 | ecnECT0, 0x48 | ecnCE, 0},
+		{"outer not CE", dx4, SidFlagEcnPropagate, true, ecnECT0, 0x48 | ecnECT0, 0x48 | ecnECT0, 0},
+		{"IPv4 Not-ECT dropped", dx4, SidFlagEcnPropagate, true, ecnCE, 0x48 | ecnNotECT, 0x48 | ecnNotECT, 1},
+		{"IPv6 Not-ECT dropped", dt6, SidFlagEcnPropagate, false, ecnCE, ecnNotECT, ecnNotECT, 1},
+		{"flag unset", dx4, 0, true, ecnCE, 0x48 | ecnECT0, 0x48 | ecnECT0, 0},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newXDPTestHelperWithStats(t)
+			if err := h.mapOps.CreateSidFunction(sid+"/128", &SidFunctionEntry{Action: tc.action, Flags: tc.flags}, nil); err != nil {
+				t.Fatalf("CreateSidFunction: %v", err)
+			}
+
+			ret, out := h.run(buildECNEncapsulatedPacket(t, sid, tc.outerTC, tc.innerTC, tc.innerV4))
+			if ret != XDP_DROP && ret != XDP_REDIRECT {
+				t.Fatalf("expected XDP_DROP or XDP_REDIRECT after decap, got %d", ret)
+			}
+			if got := readStatCounter(t, h, "ECN_DROP"); got != tc.wantDrops {
+				t.Errorf("ECN_DROP: got %d, want %d", got, tc.wantDrops)
+			}
+
+			inner := out[ethHeaderLen:]
+			var got uint8
+			if tc.innerV4 {
+				got = inner[1]
+				if !inetChecksumOK(inner[:20]) {
+					t.Errorf("inner IPv4 header checksum invalid")
+				}
+			} else {
+				got = uint8(binary.BigEndian.Uint16(inner[0:2]) >> 4)
+			}
+			if got != tc.wantTC {
+				t.Errorf("inner traffic class: got %#x, want %#x", got, tc.wantTC)
+			}
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package bpf
+
+import (
+	"encoding/binary"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/cilium/ebpf/ringbuf"
+)
+
+// fdbAgingTestNs is the fdb_aging_ns used by the aging tests: short enough
+// to keep the suite fast, long enough for the checks right after learning.
+const fdbAgingTestNs = uint64(200 * time.Millisecond)
+
+// learnLocalMAC sends one VLAN 100 frame through the L2 headend so its
+// source MAC (00:00:00:00:00:01) is learned into BD 100.
+func learnLocalMAC(t *testing.T, h *xdpTestHelper) net.HardwareAddr {
+	t.Helper()
+	srcAddr, _ := ParseIPv6("fc00::1")
+	segments, numSegments, _ := ParseSegments([]string{"fc00::200"})
+	h.createHeadendL2Entry(0, 100, srcAddr, segments, numSegments, 100)
+	h.createHeadendL2Entry(1, 100, srcAddr, segments, numSegments, 100)
+
+	pkt, err := buildVlanTaggedIPv4Packet(100,
+		net.ParseIP("10.0.0.1").To4(), net.ParseIP("192.0.2.100").To4())
+	if err != nil {
+		t.Fatalf("build packet: %v", err)
+	}
+	h.run(pkt)
+	return net.HardwareAddr{0x00, 0x00, 0x00, 0x00, 0x00, 0x01}
+}
+
+// TestFdbAgingDisabled verifies that learned entries get no timer while
+// fdb_aging_ns is 0, leaving them to the userspace sweep.
+func TestFdbAgingDisabled(t *testing.T) {
+	h := newXDPTestHelper(t)
+	mac := learnLocalMAC(t, h)
+
+	e, err := h.mapOps.GetFdb(100, mac)
+	if err != nil {
+		t.Fatalf("src MAC not learned: %v", err)
+	}
+	if e.AgingArmed != 0 {
+		t.Error("aging timer should not be armed when fdb_aging_ns is 0")
+	}
+}
+
+// TestFdbAgingTimer verifies that the data plane deletes an idle learned
+// entry on its own, reports it on fdb_aging_events and leaves static
+// entries alone.
+func TestFdbAgingTimer(t *testing.T) {
+	h := newXDPTestHelperWithConstants(t, map[string]any{"fdb_aging_ns": fdbAgingTestNs})
+
+	rd, err := ringbuf.NewReader(h.objs.FdbAgingEvents)
+	if err != nil {
+		t.Fatalf("open fdb_aging_events: %v", err)
+	}
+	defer func() { _ = rd.Close() }()
+
+	staticMAC := net.HardwareAddr{0x00, 0x00, 0x00, 0x00, 0x00, 0x02}
+	if err := h.mapOps.CreateFdb(100, staticMAC, &FdbEntry{Oif: 2, IsStatic: 1}); err != nil {
+		t.Fatalf("create static: %v", err)
+	}
+
+	mac := learnLocalMAC(t, h)
+	e, err := h.mapOps.GetFdb(100, mac)
+	if err != nil {
+		t.Fatalf("src MAC not learned: %v", err)
+	}
+	if e.AgingArmed != 1 {
+		t.Fatal("learned entry should have its aging timer armed")
+	}
+
+	rd.SetDeadline(time.Now().Add(5 * time.Second))
+	rec, err := rd.Read()
+	if err != nil {
+		t.Fatalf("no aging event: %v", err)
+	}
+	raw := rec.RawSample
+	if len(raw) < 40 {
+		t.Fatalf("aging event too short: %d bytes", len(raw))
+	}
+	if got := binary.NativeEndian.Uint16(raw[12:14]); got != 100 {
+		t.Errorf("event bd_id: got %d, want 100", got)
+	}
+	if got := net.HardwareAddr(raw[16:22]); got.String() != mac.String() {
+		t.Errorf("event mac: got %s, want %s", got, mac)
+	}
+	if raw[22] != 0 {
+		t.Error("event should describe a local entry")
+	}
+	if got := binary.NativeEndian.Uint64(raw[0:8]); got != e.LastSeen {
+		t.Errorf("event last_seen: got %d, want %d", got, e.LastSeen)
+	}
+
+	if _, err := h.mapOps.GetFdb(100, mac); err == nil {
+		t.Error("aged entry should have been deleted from fdb_map")
+	}
+	if _, err := h.mapOps.GetFdb(100, staticMAC); err != nil {
+		t.Error("static entry should not be aged")
+	}
+}
+
+// TestFdbAgingSweepSkipsArmed verifies that the userspace sweep leaves
+// entries with a running timer to the data plane.
+func TestFdbAgingSweepSkipsArmed(t *testing.T) {
+	h := newXDPTestHelperWithConstants(t, map[string]any{"fdb_aging_ns": uint64(time.Hour)})
+	mac := learnLocalMAC(t, h)
+
+	deleted, err := h.mapOps.AgeFdbEntries(1)
+	if err != nil {
+		t.Fatalf("AgeFdbEntries: %v", err)
+	}
+	if deleted != 0 {
+		t.Errorf("sweep deleted %d armed entries, want 0", deleted)
+	}
+	if _, err := h.mapOps.GetFdb(100, mac); err != nil {
+		t.Error("armed entry should survive the sweep")
+	}
+}
+
+// TestFdbAgingAfterCopy verifies that a learned entry copied into a new
+// fdb_map (resize or pinned-map migration) still ages: its timer stays
+// with the old map, so the copy must come out unarmed.
+func TestFdbAgingAfterCopy(t *testing.T) {
+	h := newXDPTestHelperWithConstants(t, map[string]any{"fdb_aging_ns": fdbAgingTestNs})
+	mac := learnLocalMAC(t, h)
+
+	retired, _, err := h.mapOps.ResizeMap(map[string]any{"fdb_aging_ns": fdbAgingTestNs}, nil, "fdb_map", 16384)
+	if err != nil {
+		t.Fatalf("ResizeMap: %v", err)
+	}
+	_ = retired.Close()
+
+	e, err := h.mapOps.GetFdb(100, mac)
+	if err != nil {
+		t.Fatalf("entry not copied: %v", err)
+	}
+	if e.AgingArmed != 0 {
+		t.Fatal("copied entry should be unarmed")
+	}
+
+	// The next frame from the MAC arms a timer in the new map
+	pkt, err := buildVlanTaggedIPv4Packet(100,
+		net.ParseIP("10.0.0.1").To4(), net.ParseIP("192.0.2.100").To4())
+	if err != nil {
+		t.Fatal(err)
+	}
+	h.run(pkt)
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		if _, err := h.mapOps.GetFdb(100, mac); err != nil {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("copied entry never aged out")
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
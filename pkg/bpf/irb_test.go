@@ -0,0 +1,343 @@
+package bpf
+
+import (
+	"encoding/binary"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/cilium/ebpf"
+	vinberov1 "github.com/takehaya/vinbero/api/vinbero/v1"
+)
+
+const (
+	irbVrfIfindex = uint32(42) // only used as part of the irb_route key
+	vlanL3Offset  = ethHeaderLen + 4
+)
+
+var irbGatewayMAC = net.HardwareAddr{0x02, 0x00, 0x5e, 0x00, 0x01, 0x01}
+
+// setupIrbBD binds BD 100 (see setupMacLimitBD) to irbVrfIfindex with
+// irbGatewayMAC.
+func setupIrbBD(t *testing.T, h *xdpTestHelper) {
+	t.Helper()
+	setupMacLimitBD(t, h)
+	if err := h.mapOps.SetBdIrb(macLimitBdID, irbVrfIfindex, irbGatewayMAC); err != nil {
+		t.Fatalf("SetBdIrb: %v", err)
+	}
+}
+
+func irbRouteEntry(src string, segs ...string) *HeadendEntry {
+	srcAddr, _ := ParseIPv6(src)
+	segments, numSegments, _ := ParseSegments(segs)
+	return &HeadendEntry{
+		Mode:        uint8(vinberov1.Srv6HeadendBehavior_SRV6_HEADEND_BEHAVIOR_H_ENCAPS),
+		NumSegments: numSegments,
+		SrcAddr:     srcAddr,
+		Segments:    segments,
+	}
+}
+
+func createIrbRoute(t *testing.T, h *xdpTestHelper, prefix string, entry *HeadendEntry) {
+	t.Helper()
+	if err := h.mapOps.CreateIrbRoute(irbVrfIfindex, prefix, entry); err != nil {
+		t.Fatalf("CreateIrbRoute %s: %v", prefix, err)
+	}
+}
+
+// ipv4HeaderChecksumOK reports whether the IPv4 header at off sums to 0xffff.
+func ipv4HeaderChecksumOK(pkt []byte, off int) bool {
+	var sum uint32
+	for i := 0; i < ipv4HeaderLen; i += 2 {
+		sum += uint32(binary.BigEndian.Uint16(pkt[off+i:]))
+	}
+	for sum > 0xffff {
+		sum = (sum >> 16) + (sum & 0xffff)
+	}
+	return sum == 0xffff
+}
+
+func TestIrbRoute(t *testing.T) {
+	hostV4 := net.ParseIP("10.0.0.1").To4()
+	hostV6 := net.ParseIP("2001:db8::1")
+
+	t.Run("IPv4 to the gateway is encapsulated towards End.DT4", func(t *testing.T) {
+		h := newXDPTestHelperWithStats(t)
+		setupIrbBD(t, h)
+		entry := irbRouteEntry("fc00:1::1", "fc00:2::4")
+		createIrbRoute(t, h, "198.51.100.0/24", entry)
+
+		dst := net.ParseIP("198.51.100.7").To4()
+		pkt, err := buildVlanTaggedIPv4Packet(macLimitVlanID, hostV4, dst)
+		if err != nil {
+			t.Fatalf("build packet: %v", err)
+		}
+		overrideDstMAC(pkt, irbGatewayMAC)
+
+		ret, out := h.run(pkt)
+		if ret != XDP_PASS {
+			t.Fatalf("expected XDP_PASS (no FIB in test), got %d", ret)
+		}
+		if !verifyEtherType(t, out, 0x86DD) ||
+			!verifyOuterIPv6Header(t, out, entry.SrcAddr, entry.Segments[0]) ||
+			!verifyInnerPacket(t, out, 1, hostV4, dst, true) {
+			return
+		}
+		inner := ethHeaderLen + ipv6HeaderLen + srhBaseLen + ipv6AddrLen
+		if ttl := out[inner+8]; ttl != 63 {
+			t.Errorf("inner TTL: got %d, want 63", ttl)
+		}
+		if !ipv4HeaderChecksumOK(out, inner) {
+			t.Error("inner IPv4 header checksum is wrong after the TTL decrement")
+		}
+		if n := readStatCounter(t, h, "IRB_ROUTED"); n != 1 {
+			t.Errorf("IRB_ROUTED: got %d, want 1", n)
+		}
+	})
+
+	t.Run("IPv6 to the gateway is encapsulated towards End.DT6", func(t *testing.T) {
+		h := newXDPTestHelperWithStats(t)
+		setupIrbBD(t, h)
+		entry := irbRouteEntry("fc00:1::1", "fc00:2::6")
+		createIrbRoute(t, h, "2001:db8:100::/48", entry)
+
+		dst := net.ParseIP("2001:db8:100::7")
+		pkt, err := buildVlanTaggedIPv6Packet(macLimitVlanID, hostV6, dst)
+		if err != nil {
+			t.Fatalf("build packet: %v", err)
+		}
+		overrideDstMAC(pkt, irbGatewayMAC)
+		hopLimit := pkt[vlanL3Offset+7]
+
+		ret, out := h.run(pkt)
+		if ret != XDP_PASS {
+			t.Fatalf("expected XDP_PASS (no FIB in test), got %d", ret)
+		}
+		if !verifyEtherType(t, out, 0x86DD) ||
+			!verifyOuterIPv6Header(t, out, entry.SrcAddr, entry.Segments[0]) ||
+			!verifyInnerPacket(t, out, 1, hostV6, dst, false) {
+			return
+		}
+		inner := ethHeaderLen + ipv6HeaderLen + srhBaseLen + ipv6AddrLen
+		if got := out[inner+7]; got != hopLimit-1 {
+			t.Errorf("inner hop limit: got %d, want %d", got, hopLimit-1)
+		}
+		if n := readStatCounter(t, h, "IRB_ROUTED"); n != 1 {
+			t.Errorf("IRB_ROUTED: got %d, want 1", n)
+		}
+	})
+
+	t.Run("host route wins over the subnet route", func(t *testing.T) {
+		h := newXDPTestHelperWithStats(t)
+		setupIrbBD(t, h)
+		createIrbRoute(t, h, "198.51.100.0/24", irbRouteEntry("fc00:1::1", "fc00:2::4"))
+		seg, _ := ParseIPv6("fc00:3::4")
+		src, _ := ParseIPv6("fc00:3::1")
+		dst := net.ParseIP("198.51.100.7").To4()
+		if err := h.mapOps.SetIrbHostRoute(macLimitBdID, dst, src, [][IPv6AddrLen]byte{seg}); err != nil {
+			t.Fatalf("SetIrbHostRoute: %v", err)
+		}
+
+		pkt, _ := buildVlanTaggedIPv4Packet(macLimitVlanID, hostV4, dst)
+		overrideDstMAC(pkt, irbGatewayMAC)
+		_, out := h.run(pkt)
+		verifyOuterIPv6Header(t, out, src, seg)
+	})
+
+	kernelCases := []struct {
+		name   string
+		modify func(pkt []byte)
+	}{
+		{"route miss goes to the kernel", func(pkt []byte) {
+			copy(pkt[vlanL3Offset+16:], net.ParseIP("203.0.113.1").To4())
+		}},
+		{"expiring TTL goes to the kernel", func(pkt []byte) { pkt[vlanL3Offset+8] = 1 }},
+	}
+	for _, c := range kernelCases {
+		t.Run(c.name, func(t *testing.T) {
+			h := newXDPTestHelperWithStats(t)
+			setupIrbBD(t, h)
+			createIrbRoute(t, h, "198.51.100.0/24", irbRouteEntry("fc00:1::1", "fc00:2::4"))
+
+			pkt, _ := buildVlanTaggedIPv4Packet(macLimitVlanID, hostV4, net.ParseIP("198.51.100.7").To4())
+			overrideDstMAC(pkt, irbGatewayMAC)
+			c.modify(pkt)
+
+			ret, out := h.run(pkt)
+			if ret != XDP_PASS {
+				t.Fatalf("expected XDP_PASS, got %d", ret)
+			}
+			if !verifyEtherType(t, out, 0x8100) {
+				t.Error("frame to the kernel was modified")
+			}
+			if n := readStatCounter(t, h, "IRB_ROUTED"); n != 0 {
+				t.Errorf("IRB_ROUTED: got %d, want 0", n)
+			}
+		})
+	}
+
+	bridgedCases := []struct {
+		name   string
+		irb    bool
+		dstMAC net.HardwareAddr
+	}{
+		{"other MAC is bridged", true, macN(0x20)},
+		{"BD without IRB bridges the gateway MAC", false, irbGatewayMAC},
+	}
+	for _, c := range bridgedCases {
+		t.Run(c.name, func(t *testing.T) {
+			build := func() []byte {
+				pkt, _ := buildVlanTaggedIPv4Packet(macLimitVlanID, hostV4, net.ParseIP("198.51.100.7").To4())
+				overrideDstMAC(pkt, c.dstMAC)
+				return pkt
+			}
+
+			// Reference: the same frame in a BD that has never heard of IRB
+			ref := newXDPTestHelperWithStats(t)
+			setupMacLimitBD(t, ref)
+			wantRet, wantOut := ref.run(build())
+
+			h := newXDPTestHelperWithStats(t)
+			setupMacLimitBD(t, h)
+			if c.irb {
+				if err := h.mapOps.SetBdIrb(macLimitBdID, irbVrfIfindex, irbGatewayMAC); err != nil {
+					t.Fatalf("SetBdIrb: %v", err)
+				}
+			}
+			createIrbRoute(t, h, "198.51.100.0/24", irbRouteEntry("fc00:1::1", "fc00:2::4"))
+
+			ret, out := h.run(build())
+			if ret != wantRet {
+				t.Errorf("ret: got %d, want %d", ret, wantRet)
+			}
+			if string(out) != string(wantOut) {
+				t.Error("bridged frame differs from the frame of a BD without IRB")
+			}
+			if n := readStatCounter(t, h, "IRB_ROUTED"); n != 0 {
+				t.Errorf("IRB_ROUTED: got %d, want 0", n)
+			}
+		})
+	}
+}
+
+func TestIrbMapOperations(t *testing.T) {
+	h := newXDPTestHelper(t)
+	ip := net.ParseIP("192.0.2.10")
+	src, _ := ParseIPv6("fc00:2::1")
+	seg, _ := ParseIPv6("fc00:2::4")
+	segs := [][IPv6AddrLen]byte{seg}
+
+	t.Run("host route needs IRB on the BD", func(t *testing.T) {
+		if err := h.mapOps.SetIrbHostRoute(macLimitBdID, ip, src, segs); err != nil {
+			t.Fatalf("SetIrbHostRoute: %v", err)
+		}
+		routes, err := h.mapOps.ListIrbRoutes()
+		if err != nil {
+			t.Fatalf("ListIrbRoutes: %v", err)
+		}
+		if len(routes) != 0 {
+			t.Errorf("expected no routes without IRB, got %d", len(routes))
+		}
+	})
+
+	if err := h.mapOps.SetBdIrb(macLimitBdID, irbVrfIfindex, irbGatewayMAC); err != nil {
+		t.Fatalf("SetBdIrb: %v", err)
+	}
+
+	t.Run("bd_irb round trip", func(t *testing.T) {
+		got, err := h.mapOps.GetBdIrb(macLimitBdID)
+		if err != nil {
+			t.Fatalf("GetBdIrb: %v", err)
+		}
+		if got.VrfIfindex != irbVrfIfindex || net.HardwareAddr(got.GwMac[:]).String() != irbGatewayMAC.String() {
+			t.Errorf("got %+v", got)
+		}
+	})
+
+	t.Run("host route is owned by the BD", func(t *testing.T) {
+		if err := h.mapOps.SetIrbHostRoute(macLimitBdID, ip, src, segs); err != nil {
+			t.Fatalf("SetIrbHostRoute: %v", err)
+		}
+		got, err := h.mapOps.GetIrbRoute(irbVrfIfindex, "192.0.2.10/32")
+		if err != nil {
+			t.Fatalf("GetIrbRoute: %v", err)
+		}
+		if got.BdId != macLimitBdID || got.NumSegments != 1 || got.Segments[0] != seg {
+			t.Errorf("got %+v", got)
+		}
+	})
+
+	t.Run("exact match only", func(t *testing.T) {
+		createIrbRoute(t, h, "192.0.2.0/24", irbRouteEntry("fc00:1::1", "fc00:9::4"))
+		if _, err := h.mapOps.GetIrbRoute(irbVrfIfindex, "192.0.2.0/25"); !errors.Is(err, ebpf.ErrKeyNotExist) {
+			t.Errorf("expected ErrKeyNotExist for an uncovered /25, got %v", err)
+		}
+		if _, err := h.mapOps.GetIrbRoute(irbVrfIfindex+1, "192.0.2.0/24"); !errors.Is(err, ebpf.ErrKeyNotExist) {
+			t.Errorf("expected ErrKeyNotExist in another VRF, got %v", err)
+		}
+	})
+
+	t.Run("configured host route is not taken over", func(t *testing.T) {
+		static := net.ParseIP("2001:db8::10")
+		createIrbRoute(t, h, "2001:db8::10/128", irbRouteEntry("fc00:1::1", "fc00:9::6"))
+		if err := h.mapOps.SetIrbHostRoute(macLimitBdID, static, src, segs); err != nil {
+			t.Fatalf("SetIrbHostRoute: %v", err)
+		}
+		if err := h.mapOps.DeleteIrbHostRoute(macLimitBdID, static); err != nil {
+			t.Fatalf("DeleteIrbHostRoute: %v", err)
+		}
+		got, err := h.mapOps.GetIrbRoute(irbVrfIfindex, "2001:db8::10/128")
+		if err != nil {
+			t.Fatalf("configured route removed: %v", err)
+		}
+		if got.BdId != 0 {
+			t.Errorf("configured route taken over by bd %d", got.BdId)
+		}
+	})
+
+	t.Run("list", func(t *testing.T) {
+		routes, err := h.mapOps.ListIrbRoutes()
+		if err != nil {
+			t.Fatalf("ListIrbRoutes: %v", err)
+		}
+		prefixes := make(map[string]uint16)
+		for _, r := range routes {
+			if r.VrfIfindex != irbVrfIfindex {
+				t.Errorf("%s: vrf %d", r.Prefix, r.VrfIfindex)
+			}
+			prefixes[r.Prefix] = r.Entry.BdId
+		}
+		want := map[string]uint16{"192.0.2.10/32": macLimitBdID, "192.0.2.0/24": 0, "2001:db8::10/128": 0}
+		for p, bd := range want {
+			if got, ok := prefixes[p]; !ok || got != bd {
+				t.Errorf("%s: got bd %d (present %v), want %d", p, got, ok, bd)
+			}
+		}
+	})
+
+	t.Run("flush removes only host routes", func(t *testing.T) {
+		n, err := h.mapOps.FlushIrbHostRoutes(macLimitBdID)
+		if err != nil {
+			t.Fatalf("FlushIrbHostRoutes: %v", err)
+		}
+		if n != 1 {
+			t.Errorf("flushed %d, want 1", n)
+		}
+		routes, _ := h.mapOps.ListIrbRoutes()
+		if len(routes) != 2 {
+			t.Errorf("expected the 2 configured routes to stay, got %d", len(routes))
+		}
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		if err := h.mapOps.DeleteIrbRoute(irbVrfIfindex, "192.0.2.0/24"); err != nil {
+			t.Fatalf("DeleteIrbRoute: %v", err)
+		}
+		if err := h.mapOps.DeleteBdIrb(macLimitBdID); err != nil {
+			t.Fatalf("DeleteBdIrb: %v", err)
+		}
+		if _, err := h.mapOps.GetBdIrb(macLimitBdID); err == nil {
+			t.Error("bd_irb entry still present")
+		}
+	})
+}
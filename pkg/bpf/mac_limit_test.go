@@ -0,0 +1,267 @@
+package bpf
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	vinberov1 "github.com/takehaya/vinbero/api/vinbero/v1"
+)
+
+const (
+	macLimitBdID    = uint16(100)
+	macLimitVlanID  = uint16(100)
+	macLimitIfindex = uint32(1) // BPF_PROG_TEST_RUN ingress (lo)
+)
+
+// setupMacLimitBD installs the VLAN 100 AC of BD 100 on ifindex 0 and 1.
+func setupMacLimitBD(t *testing.T, h *xdpTestHelper) {
+	t.Helper()
+	srcAddr, _ := ParseIPv6("fc00::1")
+	segments, numSegments, _ := ParseSegments([]string{"fc00::200"})
+	h.createHeadendL2Entry(0, macLimitVlanID, srcAddr, segments, numSegments, macLimitBdID)
+	h.createHeadendL2Entry(1, macLimitVlanID, srcAddr, segments, numSegments, macLimitBdID)
+}
+
+// sendFromMAC runs one VLAN 100 broadcast frame with the given source MAC.
+func sendFromMAC(t *testing.T, h *xdpTestHelper, src net.HardwareAddr) uint32 {
+	t.Helper()
+	pkt, err := buildVlanTaggedIPv4Packet(macLimitVlanID,
+		net.ParseIP("10.0.0.1").To4(), net.ParseIP("10.0.0.255").To4())
+	if err != nil {
+		t.Fatalf("build packet: %v", err)
+	}
+	overrideDstMAC(pkt, net.HardwareAddr{0xff, 0xff, 0xff, 0xff, 0xff, 0xff})
+	copy(pkt[6:12], src)
+	ret, _ := h.run(pkt)
+	return ret
+}
+
+func macN(n byte) net.HardwareAddr {
+	return net.HardwareAddr{0x02, 0x00, 0x00, 0x00, 0x00, n}
+}
+
+func macCount(t *testing.T, h *xdpTestHelper, key MacLimitKey) uint64 {
+	t.Helper()
+	counts, err := h.mapOps.MacCounts()
+	if err != nil {
+		t.Fatalf("MacCounts: %v", err)
+	}
+	return counts[key]
+}
+
+func TestMacLimitLearning(t *testing.T) {
+	bdKey := BdMacLimitKey(macLimitBdID)
+	acKey := AcMacLimitKey(macLimitIfindex, macLimitVlanID)
+
+	tests := []struct {
+		name       string
+		key        MacLimitKey
+		limit      MacLimitEntry
+		wantAction uint32 // for the third source MAC
+		wantLearn  int    // MACs learned out of three
+		wantStat   uint64
+	}{
+		{"no limit", bdKey, MacLimitEntry{}, XDP_PASS, 3, 0},
+		{"BD limit drops new sources", bdKey, MacLimitEntry{MaxMacs: 2}, XDP_DROP, 2, 1},
+		{"BD limit floods without learning", bdKey,
+			MacLimitEntry{MaxMacs: 2, LimitAction: MacLimitActionFlood}, XDP_PASS, 2, 1},
+		{"AC limit drops new sources", acKey, MacLimitEntry{MaxMacs: 2}, XDP_DROP, 2, 1},
+		{"learning disabled", acKey, MacLimitEntry{LearningDisabled: 1}, XDP_PASS, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newXDPTestHelperWithStats(t)
+			setupMacLimitBD(t, h)
+			if err := h.mapOps.SetMacLimit(tt.key, &tt.limit); err != nil {
+				t.Fatalf("SetMacLimit: %v", err)
+			}
+
+			var ret uint32
+			for i := byte(1); i <= 3; i++ {
+				ret = sendFromMAC(t, h, macN(i))
+			}
+			if ret != tt.wantAction {
+				t.Errorf("third source: got action %d, want %d", ret, tt.wantAction)
+			}
+
+			learned := 0
+			for i := byte(1); i <= 3; i++ {
+				if _, err := h.mapOps.GetFdb(macLimitBdID, macN(i)); err == nil {
+					learned++
+				}
+			}
+			if learned != tt.wantLearn {
+				t.Errorf("learned %d MACs, want %d", learned, tt.wantLearn)
+			}
+			if got := macCount(t, h, bdKey); got != uint64(tt.wantLearn) {
+				t.Errorf("BD count: got %d, want %d", got, tt.wantLearn)
+			}
+			if got := macCount(t, h, acKey); got != uint64(tt.wantLearn) {
+				t.Errorf("AC count: got %d, want %d", got, tt.wantLearn)
+			}
+			if got := readStatCounter(t, h, "MAC_LIMIT"); got != tt.wantStat {
+				t.Errorf("MAC_LIMIT: got %d, want %d", got, tt.wantStat)
+			}
+
+			// Known sources keep being forwarded at the limit
+			if ret := sendFromMAC(t, h, macN(1)); tt.wantLearn > 0 && ret != XDP_PASS {
+				t.Errorf("known source at the limit: got action %d, want XDP_PASS", ret)
+			}
+		})
+	}
+}
+
+// TestMacLimitCounts verifies that userspace writes, deletes, the aging
+// timer and RebuildMacCounts keep mac_count_map in step with fdb_map.
+func TestMacLimitCounts(t *testing.T) {
+	h := newXDPTestHelperWithConstants(t, map[string]any{"fdb_aging_ns": fdbAgingTestNs})
+	setupMacLimitBD(t, h)
+	bdKey := BdMacLimitKey(macLimitBdID)
+	acKey := AcMacLimitKey(macLimitIfindex, macLimitVlanID)
+
+	sendFromMAC(t, h, macN(1))
+	if err := h.mapOps.CreateFdb(macLimitBdID, macN(2),
+		&FdbEntry{Oif: macLimitIfindex, AcVlanId: macLimitVlanID}); err != nil {
+		t.Fatalf("CreateFdb: %v", err)
+	}
+	if err := h.mapOps.CreateFdb(macLimitBdID, macN(3), &FdbEntry{Oif: 2, IsStatic: 1}); err != nil {
+		t.Fatalf("CreateFdb static: %v", err)
+	}
+	if got := macCount(t, h, bdKey); got != 2 {
+		t.Fatalf("BD count after learn + create: got %d, want 2 (static entries are not counted)", got)
+	}
+
+	// Overwriting an entry does not count it twice
+	if err := h.mapOps.CreateFdb(macLimitBdID, macN(2),
+		&FdbEntry{Oif: macLimitIfindex, AcVlanId: macLimitVlanID}); err != nil {
+		t.Fatalf("CreateFdb overwrite: %v", err)
+	}
+	if err := h.mapOps.DeleteFdb(macLimitBdID, macN(2)); err != nil {
+		t.Fatalf("DeleteFdb: %v", err)
+	}
+	if got := macCount(t, h, bdKey); got != 1 {
+		t.Errorf("BD count after delete: got %d, want 1", got)
+	}
+
+	// The aging timer removes the learned MAC and its counts
+	deadline := time.Now().Add(5 * time.Second)
+	for macCount(t, h, bdKey) != 0 && time.Now().Before(deadline) {
+		time.Sleep(50 * time.Millisecond)
+	}
+	if got := macCount(t, h, bdKey); got != 0 {
+		t.Errorf("BD count after aging: got %d, want 0", got)
+	}
+	if got := macCount(t, h, acKey); got != 0 {
+		t.Errorf("AC count after aging: got %d, want 0", got)
+	}
+
+	// Rebuild recounts from fdb_map
+	if err := h.mapOps.CreateFdb(macLimitBdID, macN(4),
+		&FdbEntry{Oif: macLimitIfindex, AcVlanId: macLimitVlanID}); err != nil {
+		t.Fatalf("CreateFdb: %v", err)
+	}
+	stale := int64(42)
+	if err := h.objs.MacCountMap.Put(&bdKey, &stale); err != nil {
+		t.Fatalf("corrupt count: %v", err)
+	}
+	if err := h.mapOps.RebuildMacCounts(); err != nil {
+		t.Fatalf("RebuildMacCounts: %v", err)
+	}
+	if got := macCount(t, h, bdKey); got != 1 {
+		t.Errorf("BD count after rebuild: got %d, want 1", got)
+	}
+	if got := macCount(t, h, acKey); got != 1 {
+		t.Errorf("AC count after rebuild: got %d, want 1", got)
+	}
+}
+
+func TestMacLearnAllowed(t *testing.T) {
+	h := newXDPTestHelper(t)
+	setupMacLimitBD(t, h)
+	bdKey := BdMacLimitKey(macLimitBdID)
+	if err := h.mapOps.SetMacLimit(bdKey, &MacLimitEntry{MaxMacs: 1}); err != nil {
+		t.Fatalf("SetMacLimit: %v", err)
+	}
+	if !h.mapOps.MacLearnAllowed(macLimitBdID, macLimitIfindex, macLimitVlanID, false) {
+		t.Fatal("first MAC should be allowed")
+	}
+	sendFromMAC(t, h, macN(1))
+	if h.mapOps.MacLearnAllowed(macLimitBdID, macLimitIfindex, macLimitVlanID, false) {
+		t.Error("new MAC should be refused at the BD limit")
+	}
+	if !h.mapOps.MacLearnAllowed(macLimitBdID, 2, macLimitVlanID, true) {
+		t.Error("a MAC moving inside the BD should not be refused by the BD limit")
+	}
+}
+
+// TestMacLimitRemoteLearning verifies that End.DT2 remote learning honours
+// the BD limit.
+func TestMacLimitRemoteLearning(t *testing.T) {
+	innerSrc := net.HardwareAddr{0x00, 0x00, 0x00, 0x00, 0x00, 0x01}
+
+	tests := []struct {
+		name       string
+		action     uint8
+		wantAction uint32
+	}{
+		{"drop", MacLimitActionDrop, XDP_DROP},
+		{"flood", MacLimitActionFlood, XDP_PASS},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newXDPTestHelper(t)
+			h.createSidFunctionWithBD("fd00:1:100::10/128", actionEndDT2, macLimitBdID)
+			createL2Peer(t, h.mapOps, macLimitBdID, 0, "fd00:1:1::1")
+
+			// One remote MAC already fills the BD
+			if err := h.mapOps.CreateFdb(macLimitBdID, macN(9),
+				&FdbEntry{IsRemote: 1, BdId: macLimitBdID}); err != nil {
+				t.Fatalf("CreateFdb: %v", err)
+			}
+			if err := h.mapOps.SetMacLimit(BdMacLimitKey(macLimitBdID),
+				&MacLimitEntry{MaxMacs: 1, LimitAction: tt.action}); err != nil {
+				t.Fatalf("SetMacLimit: %v", err)
+			}
+
+			pkt, err := buildL2EncapsulatedPacket(
+				net.ParseIP("fd00:1:1::1"), net.ParseIP("fd00:1:100::10"),
+				[]net.IP{net.ParseIP("fd00:1:100::10")}, 0,
+				macLimitVlanID,
+				net.ParseIP("10.0.0.1"), net.ParseIP("192.0.2.100"),
+				true,
+			)
+			if err != nil {
+				t.Fatalf("build packet: %v", err)
+			}
+			if ret, _ := h.run(pkt); ret != tt.wantAction {
+				t.Errorf("got action %d, want %d", ret, tt.wantAction)
+			}
+			if _, err := h.mapOps.GetFdb(macLimitBdID, innerSrc); err == nil {
+				t.Error("remote MAC should not be learned at the BD limit")
+			}
+		})
+	}
+}
+
+// createL2Peer installs one L2 BD peer whose outer source is src.
+func createL2Peer(t *testing.T, mapOps *MapOperations, bdID, index uint16, src string) {
+	t.Helper()
+	srcAddr, err := ParseIPv6(src)
+	if err != nil {
+		t.Fatalf("ParseIPv6: %v", err)
+	}
+	segments, numSegments, _ := ParseSegments([]string{src})
+	entry := &HeadendEntry{
+		Mode:        uint8(vinberov1.Srv6HeadendBehavior_SRV6_HEADEND_BEHAVIOR_H_ENCAPS_L2),
+		NumSegments: numSegments,
+		SrcAddr:     srcAddr,
+		Segments:    segments,
+		BdId:        bdID,
+	}
+	if err := mapOps.CreateBdPeer(bdID, index, entry, [ESILen]byte{}); err != nil {
+		t.Fatalf("CreateBdPeer: %v", err)
+	}
+}
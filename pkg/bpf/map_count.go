@@ -0,0 +1,234 @@
+package bpf
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+	"sort"
+	"sync"
+
+	"github.com/cilium/ebpf"
+)
+
+// uncountedMaps are the usage maps without a userspace counter: the data
+// plane writes fdb_map and mac_count_map, and the sid_aux_map pool is
+// tracked by its index allocator. fdb_map's usage is derived from
+// mac_count_map instead.
+var uncountedMaps = []string{"fdb_map", "mac_count_map", "sid_aux_map"}
+
+// mapCounter keeps the entry count of every control map only userspace
+// writes, so their usage is known without walking them. The counts start
+// from one walk when MapOperations is created and follow every write made
+// through MapOperations.put and delete. MapUsage walks the maps again and
+// resets them, which corrects drift from writers racing on one key.
+type mapCounter struct {
+	mu        sync.Mutex
+	names     map[*ebpf.Map]string
+	counts    map[string]int
+	fdbStatic int // Static fdb_map entries; the data plane never adds or ages them
+	onChange  func(name string, entries int, capacity uint32)
+}
+
+func newMapCounter() *mapCounter {
+	return &mapCounter{names: make(map[*ebpf.Map]string), counts: make(map[string]int)}
+}
+
+// counted reports whether mp of type typ gets a userspace counter. LRU
+// maps evict instead of filling up, and the data plane writes neigh_map.
+func counted(name string, typ ebpf.MapType) bool {
+	return slices.Contains(usageMapTypes, typ) && typ != ebpf.LRUHash &&
+		!slices.Contains(uncountedMaps, name)
+}
+
+// initCounts walks the counted maps once, and fdb_map for its static
+// entries.
+func (m *MapOperations) initCounts() error {
+	c := newMapCounter()
+	for name, f := range mapFields(&m.objs.BpfMaps) {
+		mp := f.Interface().(*ebpf.Map)
+		if mp == nil || !counted(name, mp.Type()) {
+			continue
+		}
+		n, err := countEntries(mp)
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		c.names[mp] = name
+		c.counts[name] = n
+	}
+	if m.objs.FdbMap != nil {
+		var key FdbKey
+		var entry FdbEntry
+		iter := m.objs.FdbMap.Iterate()
+		for iter.Next(&key, &entry) {
+			if entry.IsStatic != 0 {
+				c.fdbStatic++
+			}
+		}
+		if err := iter.Err(); err != nil {
+			return fmt.Errorf("fdb_map: %w", err)
+		}
+	}
+	m.counts = c
+	return nil
+}
+
+// OnMapCount sets fn to be called with the new entry count of a counted
+// map each time a write changes it. fn must not call back into
+// MapOperations.
+func (m *MapOperations) OnMapCount(fn func(name string, entries int, capacity uint32)) {
+	m.counts.mu.Lock()
+	defer m.counts.mu.Unlock()
+	m.counts.onChange = fn
+}
+
+// add changes the count of mp by delta and reports the new count.
+func (c *mapCounter) add(mp *ebpf.Map, delta int) {
+	if delta == 0 {
+		return
+	}
+	c.mu.Lock()
+	name, ok := c.names[mp]
+	if !ok {
+		c.mu.Unlock()
+		return
+	}
+	c.counts[name] = max(c.counts[name]+delta, 0)
+	n, fn := c.counts[name], c.onChange
+	c.mu.Unlock()
+	if fn != nil {
+		fn(name, n, mp.MaxEntries())
+	}
+}
+
+// addFdbStatic changes the count of static fdb_map entries by delta.
+func (c *mapCounter) addFdbStatic(delta int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.fdbStatic = max(c.fdbStatic+delta, 0)
+}
+
+// reset sets the count of map name, now held by mp, to n.
+func (c *mapCounter) reset(name string, mp *ebpf.Map, n int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for old, oldName := range c.names {
+		if oldName == name {
+			delete(c.names, old)
+		}
+	}
+	c.names[mp] = name
+	c.counts[name] = n
+}
+
+func (c *mapCounter) tracks(mp *ebpf.Map) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_, ok := c.names[mp]
+	return ok
+}
+
+// occupied reports whether key holds an entry of mp. An array slot holds
+// one when it is non-zero.
+func occupied(mp *ebpf.Map, key any) bool {
+	value := make([]byte, mp.ValueSize())
+	if mp.Lookup(key, &value) != nil {
+		return false
+	}
+	return mp.Type() != ebpf.Array || !bytes.Equal(value, make([]byte, len(value)))
+}
+
+// put writes key and value into mp, keeping its entry count. Errors are
+// returned as mp.Put returns them.
+func (m *MapOperations) put(mp *ebpf.Map, key, value any) error {
+	if !m.counts.tracks(mp) {
+		return mp.Put(key, value)
+	}
+	was := occupied(mp, key)
+	if err := mp.Put(key, value); err != nil {
+		return err
+	}
+	m.counts.add(mp, change(was, occupied(mp, key)))
+	return nil
+}
+
+// delete removes key from mp, keeping its entry count. Errors are
+// returned as mp.Delete returns them.
+func (m *MapOperations) delete(mp *ebpf.Map, key any) error {
+	if !m.counts.tracks(mp) {
+		return mp.Delete(key)
+	}
+	was := occupied(mp, key)
+	if err := mp.Delete(key); err != nil {
+		return err
+	}
+	m.counts.add(mp, change(was, false))
+	return nil
+}
+
+func change(was, is bool) int {
+	switch {
+	case was && !is:
+		return -1
+	case !was && is:
+		return 1
+	}
+	return 0
+}
+
+// CountedMapUsage reports the usage of every map known without walking
+// it: the counted maps from their counters, sid_aux_map from its
+// allocator, and fdb_map as its static entries plus the per-BD dynamic
+// counts in mac_count_map, the only map walked here (one entry per BD and
+// AC). LRU maps and mac_count_map are left to MapUsage.
+func (m *MapOperations) CountedMapUsage() ([]MapUsage, error) {
+	m.counts.mu.Lock()
+	counts := make(map[string]int, len(m.counts.counts))
+	for name, n := range m.counts.counts {
+		counts[name] = n
+	}
+	fdbStatic := m.counts.fdbStatic
+	m.counts.mu.Unlock()
+
+	var out []MapUsage
+	for name, f := range mapFields(&m.objs.BpfMaps) {
+		mp := f.Interface().(*ebpf.Map)
+		if mp == nil {
+			continue
+		}
+		u := MapUsage{Name: name, Type: mp.Type(), Capacity: mp.MaxEntries(), Bytes: mapBytes(mp)}
+		switch {
+		case mp == m.objs.SidAuxMap:
+			u.Entries, u.Capacity = m.auxAlloc.usage()
+		case mp == m.objs.FdbMap:
+			dynamic, err := m.dynamicFdbCount()
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", name, err)
+			}
+			u.Entries = fdbStatic + dynamic
+		default:
+			n, ok := counts[name]
+			if !ok {
+				continue
+			}
+			u.Entries = n
+		}
+		out = append(out, u)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out, nil
+}
+
+// dynamicFdbCount sums the per-BD dynamic MAC counts of mac_count_map.
+func (m *MapOperations) dynamicFdbCount() (int, error) {
+	var key MacLimitKey
+	var count int64
+	total := 0
+	iter := m.objs.MacCountMap.Iterate()
+	for iter.Next(&key, &count) {
+		if key.Ifindex == 0 && count > 0 {
+			total += int(count)
+		}
+	}
+	return total, iter.Err()
+}
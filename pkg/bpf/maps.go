@@ -1,12 +1,16 @@
 package bpf
 
 import (
+	"bytes"
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"math"
 	"net"
 	"strings"
+	"structs"
 	"sync"
+	"time"
 	"unsafe"
 
 	"github.com/cilium/ebpf"
@@ -14,10 +18,14 @@ import (
 )
 
 const (
-	MaxSegments    = 10
-	IPv4AddrLen    = 4
-	IPv6AddrLen    = 16
-	MaxBumNexthops = 8 // Must match MAX_BUM_NEXTHOPS in xdp_prog.h
+	MaxSegments = 10
+	IPv4AddrLen = 4
+	IPv6AddrLen = 16
+
+	// DefaultBdPeersPerBd must match the bum_peers_per_bd default in tc_bum.h
+	DefaultBdPeersPerBd = 256
+	// MaxBdPeersPerBd keeps peer indexes below BD_PEER_INDEX_INVALID (0xFFFF)
+	MaxBdPeersPerBd = 0xFFFF
 )
 
 // Type aliases for BPF generated types
@@ -37,11 +45,67 @@ type (
 	Dx2vEntry        = BpfDx2vEntry
 	EsiKey           = BpfEsiKey
 	EsiEntry         = BpfEsiEntry
+	SrDomainEntry    = BpfSrDomainEntry
+	SrDomainSrcKey   = BpfSrDomainSrcKey
+	SrDomainIifKey   = BpfSrDomainIifKey
+
+	StampReflectorKey   = BpfStampReflectorKey
+	StampReflectorEntry = BpfStampReflectorEntry
+
+	MacLimitKey   = BpfMacLimitKey
+	MacLimitEntry = BpfMacLimitEntry
+	StormCtlEntry = BpfStormCtlEntry
+
+	NeighKey   = BpfNeighKey
+	NeighEntry = BpfNeighEntry
+
+	BdIrbEntry    = BpfBdIrbEntry
+	IrbRouteKeyV4 = BpfIrbRouteKeyV4
+	IrbRouteKeyV6 = BpfIrbRouteKeyV6
+
+	AcVlanRanges = BpfAcVlanRanges
+
+	VniEntry       = BpfVniEntry
+	VtepKey        = BpfVtepKey
+	VtepEntry      = BpfVtepEntry
+	VtepReverseKey = BpfVtepReverseKey
+)
+
+// VlanRewrite mirrors struct vlan_rewrite, which bpf2go only emits inline in
+// the structs that embed it (fdb_entry, dx2v_entry, headend_l2_ext_val).
+type VlanRewrite = struct {
+	_           structs.HostLayout
+	Op          uint8
+	Pad         uint8
+	VlanId      uint16
+	InnerVlanId uint16
+}
+
+// vlan_rewrite_op values (core/xdp_prog.h)
+const (
+	VlanRewriteNone      uint8 = 0
+	VlanRewritePop       uint8 = 1
+	VlanRewritePush      uint8 = 2
+	VlanRewriteTranslate uint8 = 3
 )
 
+// AcVlanRangesMax must match AC_VLAN_RANGES_MAX in xdp_prog.h
+const AcVlanRangesMax = 8
+
+// HeadendL2VlanAny is the VlanID of a port-mode AC, which matches every
+// frame on its port that no more specific AC claims. Must match
+// HEADEND_L2_VLAN_ANY in xdp_prog.h.
+const HeadendL2VlanAny uint16 = 0xFFFF
+
 // ESILen is the fixed length of RFC 7432 Ethernet Segment Identifier.
 const ESILen = 10
 
+// VxlanMaxVteps must match VXLAN_MAX_VTEPS in xdp_prog.h
+const VxlanMaxVteps = 64
+
+// VxlanMaxVni is the largest 24-bit VXLAN Network Identifier.
+const VxlanMaxVni = 0xFFFFFF
+
 // MapOperator interface for testability
 type MapOperator interface {
 	Put(key, value any) error
@@ -52,21 +116,37 @@ type MapOperator interface {
 
 // MapOperations provides operations for BPF maps
 type MapOperations struct {
-	objs     *BpfObjects
-	auxAlloc *indexAllocator
+	objs         *BpfObjects
+	auxAlloc     *indexAllocator
+	bdPeersPerBd uint16
+	counts       *mapCounter
 }
 
 // NewMapOperations creates a new MapOperations instance.
-// The aux index allocator capacity is derived from the actual sid_aux_map MaxEntries.
+// The aux index allocator capacity is derived from the actual sid_aux_map MaxEntries,
+// and the per-BD peer limit from the loaded bum_peers_per_bd constant.
 func NewMapOperations(objs *BpfObjects) *MapOperations {
 	auxMax := uint32(512) // fallback
 	if info, err := objs.SidAuxMap.Info(); err == nil {
 		auxMax = info.MaxEntries
 	}
-	return &MapOperations{
-		objs:     objs,
-		auxAlloc: newIndexAllocator(auxMax),
+	perBd := uint32(DefaultBdPeersPerBd) // fallback
+	if objs.BumPeersPerBd != nil {
+		var v uint32
+		if err := objs.BumPeersPerBd.Get(&v); err == nil && v > 0 && v <= MaxBdPeersPerBd {
+			perBd = v
+		}
+	}
+	m := &MapOperations{
+		objs:         objs,
+		auxAlloc:     newIndexAllocator(auxMax),
+		bdPeersPerBd: uint16(perBd),
+	}
+	if err := m.initCounts(); err != nil {
+		// The first MapUsage walk fills the counts in
+		m.counts = newMapCounter()
 	}
+	return m
 }
 
 // indexAllocator manages a pool of uint32 indices with a free-list.
@@ -98,6 +178,22 @@ func (a *indexAllocator) Alloc() (uint32, error) {
 	return idx, nil
 }
 
+// grow raises the pool to max after sid_aux_map was resized.
+func (a *indexAllocator) grow(max uint32) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if max > a.maxIndex {
+		a.maxIndex = max
+	}
+}
+
+// usage returns the indices handed out and the size of the pool.
+func (a *indexAllocator) usage() (int, uint32) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return int(a.nextNew-1) - len(a.freeList), a.maxIndex - 1
+}
+
 func (a *indexAllocator) Free(idx uint32) {
 	a.mu.Lock()
 	defer a.mu.Unlock()
@@ -236,10 +332,14 @@ func SidAuxL3VrfData(entry *SidAuxEntry) uint32 {
 	return binary.NativeEndian.Uint32(entry.Nexthop.Nexthop[0:4])
 }
 
+// SidFlagEcnPropagate mirrors SID_FLAG_ECN_PROPAGATE in
+// sid_function_entry.flags: decap copies outer ECN-CE into the inner header.
+const SidFlagEcnPropagate = 1 << 0
+
 // SidAuxPluginRawMax is the capacity of the plugin_raw variant in
 // sid_aux_entry. Writes longer than this are rejected at the RPC layer so
 // we never overflow the kernel-side union.
-const SidAuxPluginRawMax = 196
+const SidAuxPluginRawMax = 200
 
 // NewSidAuxPluginRaw creates an aux entry from a plugin-defined byte payload.
 // raw may be shorter than SidAuxPluginRawMax; remaining bytes are zero.
@@ -251,6 +351,13 @@ func NewSidAuxPluginRaw(raw []byte) *SidAuxEntry {
 	return entry
 }
 
+// SidAuxPluginRawData returns the plugin_raw variant of entry with its
+// trailing zero bytes trimmed.
+func SidAuxPluginRawData(entry *SidAuxEntry) []byte {
+	raw := (*[SidAuxPluginRawMax]byte)(unsafe.Pointer(entry))[:]
+	return bytes.Clone(bytes.TrimRight(raw, "\x00"))
+}
+
 // NewSidAuxB6Policy creates an aux entry for End.B6/End.B6.Encaps
 // Stores a full HeadendEntry in the b6_policy union variant.
 func NewSidAuxB6Policy(policy *HeadendEntry) *SidAuxEntry {
@@ -335,13 +442,29 @@ func ParseIPv6(addr string) ([IPv6AddrLen]uint8, error) {
 
 // CreateSidFunction adds a SID function entry and optional aux data.
 // If aux is non-nil, an aux index is allocated and both maps are written.
+// Replacing an existing entry frees the aux index of the old one, unless
+// the aux data is the same and the index is kept.
 func (m *MapOperations) CreateSidFunction(triggerPrefix string, entry *SidFunctionEntry, aux *SidAuxEntry) error {
 	key, err := buildLpmKeyV6(triggerPrefix)
 	if err != nil {
 		return fmt.Errorf("failed to build LPM key: %w", err)
 	}
 
-	if aux != nil {
+	var old SidFunctionEntry
+	hadOld := m.objs.SidFunctionMap.Lookup(key, &old) == nil
+
+	// Unchanged aux data keeps its slot. Otherwise the new aux goes into a
+	// fresh slot before the entry is swapped to it, so the data plane never
+	// reads a half-written aux.
+	reuse := false
+	if aux != nil && hadOld && old.AuxIndex != 0 {
+		var cur SidAuxEntry
+		reuse = m.objs.SidAuxMap.Lookup(uint32(old.AuxIndex), &cur) == nil && cur == *aux
+	}
+
+	if reuse {
+		entry.AuxIndex = old.AuxIndex
+	} else if aux != nil {
 		idx, err := m.auxAlloc.Alloc()
 		if err != nil {
 			return fmt.Errorf("failed to allocate aux index: %w", err)
@@ -362,12 +485,20 @@ func (m *MapOperations) CreateSidFunction(triggerPrefix string, entry *SidFuncti
 		}
 	}
 
-	if err := m.objs.SidFunctionMap.Put(key, entry); err != nil {
-		if aux != nil {
+	if err := m.put(m.objs.SidFunctionMap, key, entry); err != nil {
+		if aux != nil && !reuse {
 			m.auxAlloc.Free(uint32(entry.AuxIndex))
 		}
 		return fmt.Errorf("failed to put SID function entry: %w", err)
 	}
+
+	// The new entry is live, so the old aux slot is no longer referenced
+	if hadOld && old.AuxIndex != 0 && old.AuxIndex != entry.AuxIndex {
+		var zeroAux SidAuxEntry
+		idx := uint32(old.AuxIndex)
+		_ = m.objs.SidAuxMap.Put(idx, &zeroAux)
+		m.auxAlloc.Free(idx)
+	}
 	return nil
 }
 
@@ -382,7 +513,7 @@ func (m *MapOperations) DeleteSidFunction(triggerPrefix string) error {
 	var entry SidFunctionEntry
 	hasEntry := m.objs.SidFunctionMap.Lookup(key, &entry) == nil
 
-	if err := m.objs.SidFunctionMap.Delete(key); err != nil {
+	if err := m.delete(m.objs.SidFunctionMap, key); err != nil {
 		return fmt.Errorf("failed to delete SID function entry: %w", err)
 	}
 
@@ -441,11 +572,15 @@ func (m *MapOperations) ListSidFunctions() (map[string]*SidFunctionEntry, error)
 
 // ===== Stats Map Operations =====
 
-const StatsMax = 8
+const StatsMax = 21
 
 var StatsCounterName = [StatsMax]string{
 	"RX_PACKETS", "PASS", "DROP", "REDIRECT", "ABORTED",
 	"SPLIT_HORIZON_TX", "SPLIT_HORIZON_RX", "NON_DF_DROP",
+	"SR_DOMAIN_DROP", "OAM_PUNT", "HOP_LIMIT_EXCEEDED", "STAMP_REFLECT",
+	"PMTU_EXCEEDED", "ECN_DROP", "BUM_REPLICA", "MAC_LIMIT",
+	"NEIGH_SUPPRESS", "IRB_ROUTED", "STORM_DROP",
+	"VXLAN_DECAP", "VXLAN_ENCAP",
 }
 
 type AggregatedStats struct {
@@ -582,7 +717,7 @@ func (m *MapOperations) CreateHeadendV4(triggerPrefix string, entry *HeadendEntr
 		return fmt.Errorf("failed to build LPM key: %w", err)
 	}
 
-	if err := m.objs.HeadendV4Map.Put(key, entry); err != nil {
+	if err := m.put(m.objs.HeadendV4Map, key, entry); err != nil {
 		return fmt.Errorf("failed to put headend v4 entry: %w", err)
 	}
 	return nil
@@ -595,7 +730,7 @@ func (m *MapOperations) DeleteHeadendV4(triggerPrefix string) error {
 		return fmt.Errorf("failed to build LPM key: %w", err)
 	}
 
-	if err := m.objs.HeadendV4Map.Delete(key); err != nil {
+	if err := m.delete(m.objs.HeadendV4Map, key); err != nil {
 		return fmt.Errorf("failed to delete headend v4 entry: %w", err)
 	}
 	return nil
@@ -644,7 +779,7 @@ func (m *MapOperations) CreateHeadendV6(triggerPrefix string, entry *HeadendEntr
 		return fmt.Errorf("failed to build LPM key: %w", err)
 	}
 
-	if err := m.objs.HeadendV6Map.Put(key, entry); err != nil {
+	if err := m.put(m.objs.HeadendV6Map, key, entry); err != nil {
 		return fmt.Errorf("failed to put headend v6 entry: %w", err)
 	}
 	return nil
@@ -657,7 +792,7 @@ func (m *MapOperations) DeleteHeadendV6(triggerPrefix string) error {
 		return fmt.Errorf("failed to build LPM key: %w", err)
 	}
 
-	if err := m.objs.HeadendV6Map.Delete(key); err != nil {
+	if err := m.delete(m.objs.HeadendV6Map, key); err != nil {
 		return fmt.Errorf("failed to delete headend v6 entry: %w", err)
 	}
 	return nil
@@ -699,70 +834,111 @@ func (m *MapOperations) ListHeadendV6() (map[string]*HeadendEntry, error) {
 
 // ===== Headend L2 Map Operations =====
 
-// CreateHeadendL2 adds a headend L2 entry to the map (keyed by port + VLAN).
-// esi is the 10-byte RFC 7432 ESI of this local AC; all-zero means
-// single-homing and skips the side-table write. If entry.BdId is non-zero
-// and esi is set, bd_local_esi_map is also populated for the DT2M DF check.
-func (m *MapOperations) CreateHeadendL2(ifindex uint32, vlanID uint16, entry *HeadendEntry, esi [ESILen]byte) error {
-	key := buildHeadendL2Key(ifindex, vlanID)
-	if err := m.objs.HeadendL2Map.Put(key, entry); err != nil {
+// HeadendL2AC describes an attachment circuit (AC): the tags it matches on
+// its port and the side data kept next to its headend_l2_map entry.
+type HeadendL2AC struct {
+	Ifindex       uint32
+	VlanID        uint16       // Outer VLAN (0 = untagged, HeadendL2VlanAny = port mode); first VLAN of a range
+	InnerVlanID   uint16       // Inner VLAN under VlanID (0 = any)
+	VlanIDEnd     uint16       // Last VLAN of a range starting at VlanID (0 = VlanID only)
+	Esi           [ESILen]byte // RFC 7432 ESI of the AC (all-zero = single-homing)
+	EgressRewrite VlanRewrite  // Rewrite of frames leaving through the AC
+}
+
+// Key returns the headend_l2_map key of the AC.
+func (ac HeadendL2AC) Key() HeadendL2Key {
+	return HeadendL2Key{Ifindex: ac.Ifindex, VlanId: ac.VlanID, InnerVlanId: ac.InnerVlanID}
+}
+
+// CreateHeadendL2 adds a headend L2 entry to the map (keyed by port + VLANs).
+// A VLAN range AC also claims a slot in ac_vlan_range_map. ac.Esi all-zero
+// means single-homing; if entry.BdId is non-zero and the ESI is set,
+// bd_local_esi_map is also populated for the DT2M DF check.
+func (m *MapOperations) CreateHeadendL2(ac HeadendL2AC, entry *HeadendEntry) error {
+	if ac.VlanID == HeadendL2VlanAny && (ac.InnerVlanID != 0 || ac.VlanIDEnd != 0) {
+		return fmt.Errorf("port-mode AC cannot match VLANs")
+	}
+	if ac.VlanIDEnd != 0 {
+		if ac.InnerVlanID != 0 {
+			return fmt.Errorf("VLAN range %d-%d cannot match an inner VLAN", ac.VlanID, ac.VlanIDEnd)
+		}
+		if err := m.putAcVlanRange(ac.Ifindex, ac.VlanID, ac.VlanIDEnd); err != nil {
+			return err
+		}
+	} else if ac.InnerVlanID == 0 {
+		// A single-VLAN AC replaces a range starting at the same VLAN
+		if err := m.deleteAcVlanRange(ac.Ifindex, ac.VlanID); err != nil {
+			return err
+		}
+	}
+
+	key := ac.Key()
+	if err := m.put(m.objs.HeadendL2Map, &key, entry); err != nil {
 		return fmt.Errorf("failed to put headend L2 entry: %w", err)
 	}
 
 	var zero [ESILen]byte
-	if esi != zero {
-		if err := m.objs.HeadendL2ExtMap.Put(key, &BpfHeadendL2ExtVal{Esi: esi}); err != nil {
-			return fmt.Errorf("failed to put headend L2 ESI ext: %w", err)
+	if ac.Esi != zero || ac.EgressRewrite.Op != VlanRewriteNone {
+		ext := &BpfHeadendL2ExtVal{Esi: ac.Esi, EgressRewrite: ac.EgressRewrite}
+		if err := m.put(m.objs.HeadendL2ExtMap, &key, ext); err != nil {
+			return fmt.Errorf("failed to put headend L2 ext: %w", err)
 		}
-		if entry.BdId != 0 {
-			bdKey := uint32(entry.BdId)
-			if err := m.objs.BdLocalEsiMap.Put(&bdKey, &BpfBdLocalEsiVal{Esi: esi}); err != nil {
+	} else {
+		_ = m.delete(m.objs.HeadendL2ExtMap, &key)
+	}
+	if entry.BdId != 0 {
+		bdKey := uint32(entry.BdId)
+		if ac.Esi != zero {
+			if err := m.put(m.objs.BdLocalEsiMap, &bdKey, &BpfBdLocalEsiVal{Esi: ac.Esi}); err != nil {
 				return fmt.Errorf("failed to put bd_local_esi entry: %w", err)
 			}
-		}
-	} else {
-		_ = m.objs.HeadendL2ExtMap.Delete(key)
-		if entry.BdId != 0 {
-			bdKey := uint32(entry.BdId)
-			_ = m.objs.BdLocalEsiMap.Delete(&bdKey)
+		} else {
+			_ = m.delete(m.objs.BdLocalEsiMap, &bdKey)
 		}
 	}
 	return nil
 }
 
-// DeleteHeadendL2 removes a headend L2 entry from the map
-func (m *MapOperations) DeleteHeadendL2(ifindex uint32, vlanID uint16) error {
-	key := buildHeadendL2Key(ifindex, vlanID)
+// DeleteHeadendL2 removes a headend L2 entry from the map, together with its
+// side-table entry and, for a range AC, its range slot.
+func (m *MapOperations) DeleteHeadendL2(key HeadendL2Key) error {
 	var prev HeadendEntry
-	hadEntry := m.objs.HeadendL2Map.Lookup(key, &prev) == nil
-	if err := m.objs.HeadendL2Map.Delete(key); err != nil {
+	hadEntry := m.objs.HeadendL2Map.Lookup(&key, &prev) == nil
+	if err := m.delete(m.objs.HeadendL2Map, &key); err != nil {
 		return fmt.Errorf("failed to delete headend L2 entry: %w", err)
 	}
-	_ = m.objs.HeadendL2ExtMap.Delete(key)
+	_ = m.delete(m.objs.HeadendL2ExtMap, &key)
+	if key.InnerVlanId == 0 {
+		if err := m.deleteAcVlanRange(key.Ifindex, key.VlanId); err != nil {
+			return err
+		}
+	}
 	if hadEntry && prev.BdId != 0 {
 		bdKey := uint32(prev.BdId)
-		_ = m.objs.BdLocalEsiMap.Delete(&bdKey)
+		_ = m.delete(m.objs.BdLocalEsiMap, &bdKey)
 	}
 	return nil
 }
 
-// GetHeadendL2Esi looks up the side-table ESI for a given (ifindex, vlan).
-// Returns zero ESI if the entry is missing (single-homing).
-func (m *MapOperations) GetHeadendL2Esi(ifindex uint32, vlanID uint16) ([ESILen]byte, error) {
-	var out [ESILen]byte
-	key := buildHeadendL2Key(ifindex, vlanID)
+// GetHeadendL2AC returns the AC behind a headend_l2_map key. Missing side
+// data reads as zero (single-homing, no rewrite, no range).
+func (m *MapOperations) GetHeadendL2AC(key HeadendL2Key) HeadendL2AC {
+	ac := HeadendL2AC{Ifindex: key.Ifindex, VlanID: key.VlanId, InnerVlanID: key.InnerVlanId}
 	var ext BpfHeadendL2ExtVal
-	if err := m.objs.HeadendL2ExtMap.Lookup(key, &ext); err != nil {
-		return out, nil // missing entry == single-homing
+	if err := m.objs.HeadendL2ExtMap.Lookup(&key, &ext); err == nil {
+		ac.Esi = ext.Esi
+		ac.EgressRewrite = ext.EgressRewrite
+	}
+	if key.InnerVlanId == 0 {
+		ac.VlanIDEnd = m.acVlanRangeEnd(key.Ifindex, key.VlanId)
 	}
-	return ext.Esi, nil
+	return ac
 }
 
 // GetHeadendL2 retrieves a headend L2 entry from the map
-func (m *MapOperations) GetHeadendL2(ifindex uint32, vlanID uint16) (*HeadendEntry, error) {
-	key := buildHeadendL2Key(ifindex, vlanID)
+func (m *MapOperations) GetHeadendL2(key HeadendL2Key) (*HeadendEntry, error) {
 	var entry HeadendEntry
-	if err := m.objs.HeadendL2Map.Lookup(key, &entry); err != nil {
+	if err := m.objs.HeadendL2Map.Lookup(&key, &entry); err != nil {
 		return nil, fmt.Errorf("failed to lookup headend L2 entry: %w", err)
 	}
 	return &entry, nil
@@ -784,23 +960,120 @@ func (m *MapOperations) ListHeadendL2() (map[HeadendL2Key]*HeadendEntry, error)
 	return result, nil
 }
 
+// putAcVlanRange records the VLAN range first-last on ifindex, replacing a
+// range that starts at the same VLAN. Ranges on a port must not overlap.
+func (m *MapOperations) putAcVlanRange(ifindex uint32, first, last uint16) error {
+	if first == 0 || last <= first {
+		return fmt.Errorf("invalid VLAN range %d-%d", first, last)
+	}
+	var ranges AcVlanRanges
+	_ = m.objs.AcVlanRangeMap.Lookup(&ifindex, &ranges)
+
+	slot := -1
+	for i, r := range ranges.Range {
+		switch {
+		case r.First == first:
+			slot = i
+		case r.First == 0:
+			if slot < 0 {
+				slot = i
+			}
+		case first <= r.Last && r.First <= last:
+			return fmt.Errorf("VLAN range %d-%d overlaps %d-%d", first, last, r.First, r.Last)
+		}
+	}
+	if slot < 0 {
+		return fmt.Errorf("interface already has %d VLAN ranges", AcVlanRangesMax)
+	}
+	ranges.Range[slot].First = first
+	ranges.Range[slot].Last = last
+	if err := m.put(m.objs.AcVlanRangeMap, &ifindex, &ranges); err != nil {
+		return fmt.Errorf("failed to put AC VLAN range: %w", err)
+	}
+	return nil
+}
+
+// deleteAcVlanRange drops the range starting at first on ifindex, if any.
+func (m *MapOperations) deleteAcVlanRange(ifindex uint32, first uint16) error {
+	var ranges AcVlanRanges
+	if err := m.objs.AcVlanRangeMap.Lookup(&ifindex, &ranges); err != nil {
+		return nil
+	}
+	found, empty := false, true
+	for i := range ranges.Range {
+		if ranges.Range[i].First == first {
+			ranges.Range[i].First, ranges.Range[i].Last = 0, 0
+			found = true
+		}
+		if ranges.Range[i].First != 0 {
+			empty = false
+		}
+	}
+	if !found {
+		return nil
+	}
+	var err error
+	if empty {
+		err = m.delete(m.objs.AcVlanRangeMap, &ifindex)
+	} else {
+		err = m.put(m.objs.AcVlanRangeMap, &ifindex, &ranges)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update AC VLAN ranges: %w", err)
+	}
+	return nil
+}
+
+// acVlanRangeEnd returns the last VLAN of the range starting at first on
+// ifindex, or 0 if there is none.
+func (m *MapOperations) acVlanRangeEnd(ifindex uint32, first uint16) uint16 {
+	var ranges AcVlanRanges
+	if err := m.objs.AcVlanRangeMap.Lookup(&ifindex, &ranges); err != nil {
+		return 0
+	}
+	for _, r := range ranges.Range {
+		if r.First == first && first != 0 {
+			return r.Last
+		}
+	}
+	return 0
+}
+
 // ===== FDB Map Operations (for End.DT2) =====
 
-// CreateFdb adds an FDB entry to the map
+// CreateFdb adds an FDB entry to the map. Replacing an element cancels its
+// aging timer, so the stored copy is always marked unarmed; the data plane
+// re-arms it on the next refresh. Dynamic entries are counted in
+// mac_count_map like data-plane learned ones.
 func (m *MapOperations) CreateFdb(bdID uint16, mac net.HardwareAddr, entry *FdbEntry) error {
 	key := buildFdbKey(bdID, mac)
-	if err := m.objs.FdbMap.Put(key, entry); err != nil {
+	var old FdbEntry
+	hadOld := m.objs.FdbMap.Lookup(key, &old) == nil
+	e := *entry
+	e.AgingArmed = 0
+	if err := m.objs.FdbMap.Put(key, &e); err != nil {
 		return fmt.Errorf("failed to put fdb entry: %w", err)
 	}
+	if hadOld {
+		m.countFdb(bdID, &old, -1)
+	}
+	m.countFdb(bdID, &e, 1)
+	m.counts.addFdbStatic(change(hadOld && old.IsStatic != 0, e.IsStatic != 0))
 	return nil
 }
 
 // DeleteFdb removes an FDB entry from the map
 func (m *MapOperations) DeleteFdb(bdID uint16, mac net.HardwareAddr) error {
 	key := buildFdbKey(bdID, mac)
+	var old FdbEntry
+	hadOld := m.objs.FdbMap.Lookup(key, &old) == nil
 	if err := m.objs.FdbMap.Delete(key); err != nil {
 		return fmt.Errorf("failed to delete fdb entry: %w", err)
 	}
+	if hadOld {
+		m.countFdb(bdID, &old, -1)
+		m.counts.addFdbStatic(change(old.IsStatic != 0, false))
+	}
 	return nil
 }
 
@@ -830,188 +1103,1302 @@ func (m *MapOperations) ListFdb() (map[FdbKey]*FdbEntry, error) {
 	return result, nil
 }
 
-// ===== VLAN Cross-Connect (DX2V) Map Operations =====
+// ===== MAC Limit Map Operations =====
 
-// CreateDx2vVlan creates a VLAN cross-connect entry in dx2v_map
-func (m *MapOperations) CreateDx2vVlan(tableID, vlanID uint16, oif uint32) error {
-	key := &Dx2vKey{TableId: tableID, VlanId: vlanID}
-	entry := &Dx2vEntry{Oif: oif}
-	if err := m.objs.Dx2vMap.Put(key, entry); err != nil {
-		return fmt.Errorf("failed to put dx2v entry: %w", err)
+// MAC limit actions (enum mac_limit_action in xdp_prog.h)
+const (
+	MacLimitActionDrop  uint8 = 0
+	MacLimitActionFlood uint8 = 1
+)
+
+// BdMacLimitKey returns the mac_limit_map / mac_count_map key of a bridge domain.
+func BdMacLimitKey(bdID uint16) MacLimitKey {
+	return MacLimitKey{BdId: bdID}
+}
+
+// AcMacLimitKey returns the mac_limit_map / mac_count_map key of an
+// attachment circuit (port + VLAN).
+func AcMacLimitKey(ifindex uint32, vlanID uint16) MacLimitKey {
+	return MacLimitKey{Ifindex: ifindex, VlanId: vlanID}
+}
+
+// SetMacLimit installs or replaces the learning controls of a BD or AC.
+func (m *MapOperations) SetMacLimit(key MacLimitKey, entry *MacLimitEntry) error {
+	if err := m.put(m.objs.MacLimitMap, &key, entry); err != nil {
+		return fmt.Errorf("failed to put mac limit: %w", err)
 	}
 	return nil
 }
 
-// DeleteDx2vVlan deletes a VLAN cross-connect entry from dx2v_map
-func (m *MapOperations) DeleteDx2vVlan(tableID, vlanID uint16) error {
-	key := &Dx2vKey{TableId: tableID, VlanId: vlanID}
-	if err := m.objs.Dx2vMap.Delete(key); err != nil {
-		return fmt.Errorf("failed to delete dx2v entry: %w", err)
+// DeleteMacLimit removes the learning controls of a BD or AC, which then
+// learns without limit again.
+func (m *MapOperations) DeleteMacLimit(key MacLimitKey) error {
+	if err := m.delete(m.objs.MacLimitMap, &key); err != nil {
+		return fmt.Errorf("failed to delete mac limit: %w", err)
 	}
 	return nil
 }
 
-// ListDx2vVlan lists all VLAN cross-connect entries
-func (m *MapOperations) ListDx2vVlan() (map[Dx2vKey]*Dx2vEntry, error) {
-	result := make(map[Dx2vKey]*Dx2vEntry)
-	var key Dx2vKey
-	var entry Dx2vEntry
-	iter := m.objs.Dx2vMap.Iterate()
+// ListMacLimits returns all configured BD and AC learning controls.
+func (m *MapOperations) ListMacLimits() (map[MacLimitKey]*MacLimitEntry, error) {
+	result := make(map[MacLimitKey]*MacLimitEntry)
+	var key MacLimitKey
+	var entry MacLimitEntry
+	iter := m.objs.MacLimitMap.Iterate()
 	for iter.Next(&key, &entry) {
 		entryCopy := entry
 		result[key] = &entryCopy
 	}
 	if err := iter.Err(); err != nil {
-		return nil, fmt.Errorf("failed to iterate dx2v map: %w", err)
+		return nil, fmt.Errorf("failed to iterate mac limit map: %w", err)
 	}
 	return result, nil
 }
 
-// AgeFdbEntries deletes dynamic FDB entries older than maxAgeNs nanoseconds.
-// Static entries (is_static=1) and entries with last_seen=0 are never aged out.
-// Returns the number of entries deleted.
-func (m *MapOperations) AgeFdbEntries(maxAgeNs uint64) (int, error) {
-	var key FdbKey
-	var entry FdbEntry
-	iter := m.objs.FdbMap.Iterate()
+// ===== Storm Control Map Operations =====
 
-	now := currentKtimeNs()
-	var toDelete []FdbKey
+// SetStormControl installs or replaces the BUM rate limit of a BD or AC
+// (same keys as the MAC limits). Both token buckets restart full; the drop
+// counters carry over.
+func (m *MapOperations) SetStormControl(key MacLimitKey, pps, bps uint64) error {
+	entry := StormCtlEntry{Pps: pps, Bps: bps}
+	var old StormCtlEntry
+	if m.objs.StormCtlMap.Lookup(&key, &old) == nil {
+		entry.DropPackets, entry.DropBytes = old.DropPackets, old.DropBytes
+	}
+	if err := m.put(m.objs.StormCtlMap, &key, &entry); err != nil {
+		return fmt.Errorf("failed to put storm control: %w", err)
+	}
+	return nil
+}
+
+// DeleteStormControl removes the BUM rate limit of a BD or AC.
+func (m *MapOperations) DeleteStormControl(key MacLimitKey) error {
+	if err := m.delete(m.objs.StormCtlMap, &key); err != nil {
+		return fmt.Errorf("failed to delete storm control: %w", err)
+	}
+	return nil
+}
+
+// ListStormControls returns all configured BD and AC BUM rate limits with
+// their drop counters.
+func (m *MapOperations) ListStormControls() (map[MacLimitKey]*StormCtlEntry, error) {
+	result := make(map[MacLimitKey]*StormCtlEntry)
+	var key MacLimitKey
+	var entry StormCtlEntry
+	iter := m.objs.StormCtlMap.Iterate()
 	for iter.Next(&key, &entry) {
-		if entry.IsStatic != 0 || entry.LastSeen == 0 {
-			continue
-		}
-		if entry.LastSeen > now {
-			continue // clock skew or corruption
-		}
-		age := now - entry.LastSeen
-		if age > maxAgeNs {
-			keyCopy := key
-			toDelete = append(toDelete, keyCopy)
-		}
+		entryCopy := entry
+		result[key] = &entryCopy
 	}
 	if err := iter.Err(); err != nil {
-		return 0, fmt.Errorf("failed to iterate fdb map: %w", err)
+		return nil, fmt.Errorf("failed to iterate storm control map: %w", err)
 	}
+	return result, nil
+}
 
-	deleted := 0
-	for _, k := range toDelete {
-		if err := m.objs.FdbMap.Delete(&k); err == nil {
-			deleted++
+// MacCounts returns the number of dynamic MACs currently learned per BD
+// and per AC, as seen by the data plane when enforcing limits.
+func (m *MapOperations) MacCounts() (map[MacLimitKey]uint64, error) {
+	result := make(map[MacLimitKey]uint64)
+	var key MacLimitKey
+	var count int64
+	iter := m.objs.MacCountMap.Iterate()
+	for iter.Next(&key, &count) {
+		if count > 0 {
+			result[key] = uint64(count)
 		}
 	}
-	return deleted, nil
+	if err := iter.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate mac count map: %w", err)
+	}
+	return result, nil
 }
 
-// currentKtimeNs reads CLOCK_MONOTONIC to match bpf_ktime_get_ns()
-func currentKtimeNs() uint64 {
-	var ts unix.Timespec
-	_ = unix.ClockGettime(unix.CLOCK_MONOTONIC, &ts)
-	return uint64(ts.Sec)*1e9 + uint64(ts.Nsec)
+// MacLearnAllowed mirrors mac_learn_verdict for MACs synced from userspace
+// (e.g. the Linux bridge FDB): it reports whether a dynamic MAC arriving on
+// the AC (ifindex, vlanID) may be learned into bdID. moved is true when the
+// MAC already has a dynamic entry in the BD.
+func (m *MapOperations) MacLearnAllowed(bdID uint16, ifindex uint32, vlanID uint16, moved bool) bool {
+	if ifindex != 0 && !m.macLimitAllows(AcMacLimitKey(ifindex, vlanID), true) {
+		return false
+	}
+	return m.macLimitAllows(BdMacLimitKey(bdID), !moved)
 }
 
-// ===== BD Peer Map Operations (for P2MP BUM flooding) =====
+func (m *MapOperations) macLimitAllows(key MacLimitKey, checkLimit bool) bool {
+	var lim MacLimitEntry
+	if m.objs.MacLimitMap.Lookup(&key, &lim) != nil {
+		return true
+	}
+	if lim.LearningDisabled != 0 {
+		return false
+	}
+	if !checkLimit || lim.MaxMacs == 0 {
+		return true
+	}
+	var count int64
+	if m.objs.MacCountMap.Lookup(&key, &count) != nil {
+		return true
+	}
+	return count < int64(lim.MaxMacs)
+}
 
-// CreateBdPeer adds a BD peer entry for BUM flooding.
-// Also populates bd_peer_reverse_map (RX split-horizon path) and
-// bd_peer_l2_ext_map (TX split-horizon path). esi is the 10-byte RFC 7432
-// Ethernet Segment Identifier; all-zero means single-homing.
-func (m *MapOperations) CreateBdPeer(bdID, index uint16, entry *HeadendEntry, esi [ESILen]byte) error {
-	key := &BdPeerKey{BdId: bdID, Index: index}
-	if err := m.objs.BdPeerMap.Put(key, entry); err != nil {
-		return fmt.Errorf("failed to put bd peer entry: %w", err)
+// countFdb adjusts the BD and AC counts of a dynamic FDB entry written or
+// removed from userspace. The read-modify-write is not atomic against the
+// data plane; RebuildMacCounts corrects any drift on startup.
+func (m *MapOperations) countFdb(bdID uint16, e *FdbEntry, delta int64) {
+	if e.IsStatic != 0 {
+		return
+	}
+	m.addMacCount(BdMacLimitKey(bdID), delta)
+	if e.IsRemote == 0 {
+		m.addMacCount(AcMacLimitKey(e.Oif, e.AcVlanId), delta)
 	}
+}
 
-	rKey := &BdPeerReverseKey{BdId: bdID}
-	copy(rKey.SrcAddr[:], entry.SrcAddr[:])
-	rVal := &BdPeerReverseVal{Index: index, Esi: esi}
-	if err := m.objs.BdPeerReverseMap.Put(rKey, rVal); err != nil {
-		return fmt.Errorf("failed to put bd peer reverse entry: %w", err)
+func (m *MapOperations) addMacCount(key MacLimitKey, delta int64) {
+	var count int64
+	if m.objs.MacCountMap.Lookup(&key, &count) != nil && delta < 0 {
+		return
 	}
+	count += delta
+	if count < 0 {
+		count = 0
+	}
+	_ = m.objs.MacCountMap.Put(&key, &count)
+}
 
-	var zero [ESILen]byte
-	extKey := &BpfBdPeerL2ExtKey{BdId: bdID, Index: index}
-	if esi != zero {
-		ext := &BpfBdPeerL2ExtVal{Esi: esi}
-		if err := m.objs.BdPeerL2ExtMap.Put(extKey, ext); err != nil {
-			return fmt.Errorf("failed to put bd peer L2 ESI ext: %w", err)
+// RebuildMacCounts recomputes mac_count_map from the dynamic entries in
+// fdb_map. Called on startup, when fdb_map may have been restored from a
+// pin while the counts were not.
+func (m *MapOperations) RebuildMacCounts() error {
+	entries, err := m.ListFdb()
+	if err != nil {
+		return err
+	}
+	counts := make(map[MacLimitKey]int64)
+	for key, e := range entries {
+		if e.IsStatic != 0 {
+			continue
+		}
+		counts[BdMacLimitKey(key.BdId)]++
+		if e.IsRemote == 0 {
+			counts[AcMacLimitKey(e.Oif, e.AcVlanId)]++
 		}
-	} else {
-		_ = m.objs.BdPeerL2ExtMap.Delete(extKey)
 	}
 
+	var key MacLimitKey
+	var count int64
+	var stale []MacLimitKey
+	iter := m.objs.MacCountMap.Iterate()
+	for iter.Next(&key, &count) {
+		if _, ok := counts[key]; !ok {
+			stale = append(stale, key)
+		}
+	}
+	if err := iter.Err(); err != nil {
+		return fmt.Errorf("failed to iterate mac count map: %w", err)
+	}
+	for _, k := range stale {
+		_ = m.objs.MacCountMap.Delete(&k)
+	}
+	for k, c := range counts {
+		if err := m.objs.MacCountMap.Put(&k, &c); err != nil {
+			return fmt.Errorf("failed to put mac count: %w", err)
+		}
+	}
 	return nil
 }
 
-// DeleteBdPeer removes a BD peer entry and its reverse-map entry.
-// Deletes forward map first to avoid inconsistency if reverse delete fails.
-func (m *MapOperations) DeleteBdPeer(bdID, index uint16) error {
-	// Look up the entry first to get src_addr for reverse map cleanup
-	key := &BdPeerKey{BdId: bdID, Index: index}
-	var entry HeadendEntry
-	hasEntry := m.objs.BdPeerMap.Lookup(key, &entry) == nil
+// ===== Neighbor (ARP/ND Suppression) Map Operations =====
 
-	if err := m.objs.BdPeerMap.Delete(key); err != nil {
-		return fmt.Errorf("failed to delete bd peer entry: %w", err)
+// Neighbor entry origins (enum neigh_origin in xdp_prog.h)
+const (
+	NeighOriginSnoop  uint8 = 0
+	NeighOriginEvpn   uint8 = 1
+	NeighOriginStatic uint8 = 2
+)
+
+// NeighborKey returns the neigh_map key of ip in bdID. IPv4 addresses are
+// stored v4-mapped (::ffff:a.b.c.d), as the data plane does for ARP.
+func NeighborKey(bdID uint16, ip net.IP) (NeighKey, error) {
+	key := NeighKey{BdId: bdID}
+	ip16 := ip.To16()
+	if ip16 == nil {
+		return key, fmt.Errorf("invalid neighbor address %q", ip)
 	}
+	copy(key.Addr[:], ip16)
+	return key, nil
+}
 
-	// Clean up reverse map + ESI side tables (best-effort: ignore errors if already gone)
-	if hasEntry {
-		rKey := &BdPeerReverseKey{BdId: bdID}
-		copy(rKey.SrcAddr[:], entry.SrcAddr[:])
-		_ = m.objs.BdPeerReverseMap.Delete(rKey)
+// NeighborIP returns the address of a neigh_map key, unmapping IPv4.
+func NeighborIP(key NeighKey) net.IP {
+	ip := net.IP(append([]byte(nil), key.Addr[:]...))
+	if v4 := ip.To4(); v4 != nil {
+		return v4
+	}
+	return ip
+}
+
+// SetNeighbor installs or replaces the IP-to-MAC binding of ip in bdID.
+func (m *MapOperations) SetNeighbor(bdID uint16, ip net.IP, mac net.HardwareAddr, origin uint8) error {
+	key, err := NeighborKey(bdID, ip)
+	if err != nil {
+		return err
+	}
+	if len(mac) != 6 {
+		return fmt.Errorf("invalid neighbor MAC %q", mac)
+	}
+	entry := NeighEntry{Origin: origin, UpdatedNs: currentKtimeNs()}
+	copy(entry.Mac[:], mac)
+	if err := m.objs.NeighMap.Put(&key, &entry); err != nil {
+		return fmt.Errorf("failed to put neighbor: %w", err)
 	}
-	_ = m.objs.BdPeerL2ExtMap.Delete(&BpfBdPeerL2ExtKey{BdId: bdID, Index: index})
 	return nil
 }
 
-// GetBdPeer retrieves a BD peer entry
-func (m *MapOperations) GetBdPeer(bdID, index uint16) (*HeadendEntry, error) {
-	key := &BdPeerKey{BdId: bdID, Index: index}
-	var entry HeadendEntry
-	if err := m.objs.BdPeerMap.Lookup(key, &entry); err != nil {
-		return nil, fmt.Errorf("failed to lookup bd peer entry: %w", err)
+// LearnNeighbor installs a dynamically learned binding (e.g. from an EVPN
+// RT2 route). Like neigh_snoop in the data plane, it never replaces a
+// STATIC entry.
+func (m *MapOperations) LearnNeighbor(bdID uint16, ip net.IP, mac net.HardwareAddr, origin uint8) error {
+	key, err := NeighborKey(bdID, ip)
+	if err != nil {
+		return err
 	}
-	return &entry, nil
+	var cur NeighEntry
+	if m.objs.NeighMap.Lookup(&key, &cur) == nil && cur.Origin == NeighOriginStatic {
+		return nil
+	}
+	return m.SetNeighbor(bdID, ip, mac, origin)
 }
 
-// FindFreeBdPeerIndex probes indexes 0..MaxBumNexthops-1 for a given BD
-// and returns the first unused index. Returns MaxBumNexthops if all slots are occupied.
-// This avoids iterating the entire bd_peer_map (ListBdPeers) on every create request.
-func (m *MapOperations) FindFreeBdPeerIndex(bdID uint16) uint16 {
-	var entry HeadendEntry
-	for i := uint16(0); i < MaxBumNexthops; i++ {
-		key := &BdPeerKey{BdId: bdID, Index: i}
-		if err := m.objs.BdPeerMap.Lookup(key, &entry); err != nil {
-			return i
-		}
+// WithdrawNeighbor removes the binding of ip in bdID only if it still has
+// the given origin, so withdrawing a route leaves a binding that was since
+// snooped or configured in place.
+func (m *MapOperations) WithdrawNeighbor(bdID uint16, ip net.IP, origin uint8) error {
+	key, err := NeighborKey(bdID, ip)
+	if err != nil {
+		return err
 	}
-	return MaxBumNexthops
+	var cur NeighEntry
+	if m.objs.NeighMap.Lookup(&key, &cur) != nil || cur.Origin != origin {
+		return nil
+	}
+	if err := m.objs.NeighMap.Delete(&key); err != nil && !errors.Is(err, ebpf.ErrKeyNotExist) {
+		return fmt.Errorf("failed to delete neighbor: %w", err)
+	}
+	return nil
 }
 
-// ListBdPeers returns all BD peer entries
-func (m *MapOperations) ListBdPeers() (map[BdPeerKey]*HeadendEntry, error) {
-	result := make(map[BdPeerKey]*HeadendEntry)
-	var key BdPeerKey
-	var entry HeadendEntry
-	iter := m.objs.BdPeerMap.Iterate()
+// DeleteNeighbor removes the binding of ip in bdID; later requests for it
+// are flooded again.
+func (m *MapOperations) DeleteNeighbor(bdID uint16, ip net.IP) error {
+	key, err := NeighborKey(bdID, ip)
+	if err != nil {
+		return err
+	}
+	if err := m.objs.NeighMap.Delete(&key); err != nil {
+		return fmt.Errorf("failed to delete neighbor: %w", err)
+	}
+	return nil
+}
+
+// ListNeighbors returns all IP-to-MAC bindings of every bridge domain.
+func (m *MapOperations) ListNeighbors() (map[NeighKey]*NeighEntry, error) {
+	result := make(map[NeighKey]*NeighEntry)
+	var key NeighKey
+	var entry NeighEntry
+	iter := m.objs.NeighMap.Iterate()
 	for iter.Next(&key, &entry) {
 		entryCopy := entry
 		result[key] = &entryCopy
 	}
 	if err := iter.Err(); err != nil {
-		return nil, fmt.Errorf("failed to iterate bd peer map: %w", err)
+		return nil, fmt.Errorf("failed to iterate neighbor map: %w", err)
 	}
 	return result, nil
 }
 
-// ===== Flush Operations =====
-//
-// Each Flush* method removes every entry from its target map in a single
-// operation. The two-phase pattern (collect keys then delete) avoids
-// mutating the map while iterating, which kernel BPF map iterators do
-// not guarantee safety for. Partial failures return the count of
-// entries already deleted plus an error so the caller can log progress.
+// FlushNeighbors removes the bindings of a single BD, or of every BD when
+// bdID == 0.
+func (m *MapOperations) FlushNeighbors(bdID uint16) (uint32, error) {
+	entries, err := m.ListNeighbors()
+	if err != nil {
+		return 0, err
+	}
+	var count uint32
+	for key := range entries {
+		if bdID != 0 && key.BdId != bdID {
+			continue
+		}
+		if err := m.objs.NeighMap.Delete(&key); err != nil {
+			return count, fmt.Errorf("flush neighbors: delete bd=%d ip=%s: %w",
+				key.BdId, NeighborIP(key), err)
+		}
+		count++
+	}
+	return count, nil
+}
+
+// ===== IRB (Integrated Routing and Bridging) Map Operations =====
+
+// irbHeadendModeHEncaps is SRV6_HEADEND_BEHAVIOR_H_ENCAPS, the mode of host
+// routes installed from EVPN RT2 MAC+IP routes.
+const irbHeadendModeHEncaps uint8 = 2
+
+// SetBdIrb binds bdID to the VRF with ifindex vrfIfindex: frames from its
+// ACs addressed to gwMAC are routed instead of bridged.
+func (m *MapOperations) SetBdIrb(bdID uint16, vrfIfindex uint32, gwMAC net.HardwareAddr) error {
+	if len(gwMAC) != 6 {
+		return fmt.Errorf("invalid gateway MAC %q", gwMAC)
+	}
+	entry := BdIrbEntry{VrfIfindex: vrfIfindex}
+	copy(entry.GwMac[:], gwMAC)
+	if err := m.put(m.objs.BdIrbMap, &bdID, &entry); err != nil {
+		return fmt.Errorf("failed to put bd_irb entry: %w", err)
+	}
+	return nil
+}
+
+// GetBdIrb returns the IRB binding of bdID.
+func (m *MapOperations) GetBdIrb(bdID uint16) (*BdIrbEntry, error) {
+	var entry BdIrbEntry
+	if err := m.objs.BdIrbMap.Lookup(&bdID, &entry); err != nil {
+		return nil, fmt.Errorf("failed to lookup bd_irb entry: %w", err)
+	}
+	return &entry, nil
+}
+
+// DeleteBdIrb unbinds bdID from its VRF; frames to the gateway MAC are
+// bridged again.
+func (m *MapOperations) DeleteBdIrb(bdID uint16) error {
+	if err := m.delete(m.objs.BdIrbMap, &bdID); err != nil {
+		return fmt.Errorf("failed to delete bd_irb entry: %w", err)
+	}
+	return nil
+}
+
+// IrbRoute is one routed destination of a VRF. Entry.BdId is 0 for routes
+// configured through the API and the owning BD for host routes installed
+// from EVPN RT2 MAC+IP routes.
+type IrbRoute struct {
+	VrfIfindex uint32
+	Prefix     string
+	Entry      *HeadendEntry
+}
+
+// CreateIrbRoute installs (or replaces) the encapsulation used for prefix
+// in the VRF with ifindex vrfIfindex. IPv4 and IPv6 prefixes go to
+// irb_route_v4_map and irb_route_v6_map respectively.
+func (m *MapOperations) CreateIrbRoute(vrfIfindex uint32, prefix string, entry *HeadendEntry) error {
+	ip, _, err := ParseCIDR(prefix)
+	if err != nil {
+		return err
+	}
+	if ip.To4() != nil {
+		key, err := buildIrbRouteKeyV4(vrfIfindex, prefix)
+		if err != nil {
+			return err
+		}
+		if err := m.put(m.objs.IrbRouteV4Map, key, entry); err != nil {
+			return fmt.Errorf("failed to put irb_route_v4 entry: %w", err)
+		}
+		return nil
+	}
+	key, err := buildIrbRouteKeyV6(vrfIfindex, prefix)
+	if err != nil {
+		return err
+	}
+	if err := m.put(m.objs.IrbRouteV6Map, key, entry); err != nil {
+		return fmt.Errorf("failed to put irb_route_v6 entry: %w", err)
+	}
+	return nil
+}
+
+// GetIrbRoute returns the route stored for exactly prefix in a VRF. A
+// map lookup would also match a covering prefix, so the entries are walked.
+func (m *MapOperations) GetIrbRoute(vrfIfindex uint32, prefix string) (*HeadendEntry, error) {
+	ip, plen, err := ParseCIDR(prefix)
+	if err != nil {
+		return nil, err
+	}
+	want := (&net.IPNet{IP: ip, Mask: net.CIDRMask(plen, len(ip)*8)}).String()
+	routes, err := m.ListIrbRoutes()
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range routes {
+		if r.VrfIfindex == vrfIfindex && r.Prefix == want {
+			return r.Entry, nil
+		}
+	}
+	return nil, fmt.Errorf("irb route %s not found: %w", prefix, ebpf.ErrKeyNotExist)
+}
+
+// DeleteIrbRoute removes the route for prefix from a VRF.
+func (m *MapOperations) DeleteIrbRoute(vrfIfindex uint32, prefix string) error {
+	ip, _, err := ParseCIDR(prefix)
+	if err != nil {
+		return err
+	}
+	if ip.To4() != nil {
+		key, err := buildIrbRouteKeyV4(vrfIfindex, prefix)
+		if err != nil {
+			return err
+		}
+		if err := m.delete(m.objs.IrbRouteV4Map, key); err != nil {
+			return fmt.Errorf("failed to delete irb_route_v4 entry: %w", err)
+		}
+		return nil
+	}
+	key, err := buildIrbRouteKeyV6(vrfIfindex, prefix)
+	if err != nil {
+		return err
+	}
+	if err := m.delete(m.objs.IrbRouteV6Map, key); err != nil {
+		return fmt.Errorf("failed to delete irb_route_v6 entry: %w", err)
+	}
+	return nil
+}
+
+// ListIrbRoutes returns the routes of every VRF, IPv4 first.
+func (m *MapOperations) ListIrbRoutes() ([]IrbRoute, error) {
+	var result []IrbRoute
+	var key4 IrbRouteKeyV4
+	var entry HeadendEntry
+	iter := m.objs.IrbRouteV4Map.Iterate()
+	for iter.Next(&key4, &entry) {
+		entryCopy := entry
+		result = append(result, IrbRoute{
+			VrfIfindex: key4.VrfIfindex,
+			Prefix:     fmt.Sprintf("%s/%d", net.IP(key4.Addr[:]), key4.Prefixlen-32),
+			Entry:      &entryCopy,
+		})
+	}
+	if err := iter.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate irb_route_v4 map: %w", err)
+	}
+	var key6 IrbRouteKeyV6
+	iter = m.objs.IrbRouteV6Map.Iterate()
+	for iter.Next(&key6, &entry) {
+		entryCopy := entry
+		result = append(result, IrbRoute{
+			VrfIfindex: key6.VrfIfindex,
+			Prefix:     fmt.Sprintf("%s/%d", net.IP(key6.Addr[:]), key6.Prefixlen-32),
+			Entry:      &entryCopy,
+		})
+	}
+	if err := iter.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate irb_route_v6 map: %w", err)
+	}
+	return result, nil
+}
+
+// SetIrbHostRoute installs the host route of a remote host of bdID, learned
+// from an EVPN RT2 MAC+IP route, into the BD's VRF: ip is encapsulated with
+// H.Encaps towards segments, the last of which is the remote PE's
+// End.DT4/DT6 SID. A BD without IRB is left alone, and so is a host route
+// configured through the API.
+func (m *MapOperations) SetIrbHostRoute(bdID uint16, ip net.IP, srcAddr [IPv6AddrLen]byte, segments [][IPv6AddrLen]byte) error {
+	irb, err := m.GetBdIrb(bdID)
+	if err != nil {
+		return nil
+	}
+	if len(segments) == 0 || len(segments) > MaxSegments {
+		return fmt.Errorf("host route %s needs 1-%d segments, got %d", ip, MaxSegments, len(segments))
+	}
+	prefix := irbHostPrefix(ip)
+	if cur, err := m.GetIrbRoute(irb.VrfIfindex, prefix); err == nil && cur.BdId != bdID {
+		return nil
+	}
+	entry := &HeadendEntry{
+		Mode:        irbHeadendModeHEncaps,
+		NumSegments: uint8(len(segments)),
+		SrcAddr:     srcAddr,
+		BdId:        bdID,
+	}
+	copy(entry.Segments[:], segments)
+	return m.CreateIrbRoute(irb.VrfIfindex, prefix, entry)
+}
+
+// DeleteIrbHostRoute removes a host route installed by SetIrbHostRoute.
+func (m *MapOperations) DeleteIrbHostRoute(bdID uint16, ip net.IP) error {
+	irb, err := m.GetBdIrb(bdID)
+	if err != nil {
+		return nil
+	}
+	prefix := irbHostPrefix(ip)
+	cur, err := m.GetIrbRoute(irb.VrfIfindex, prefix)
+	if err != nil || cur.BdId != bdID {
+		return nil
+	}
+	return m.DeleteIrbRoute(irb.VrfIfindex, prefix)
+}
+
+// FlushIrbHostRoutes removes the host routes installed for bdID, or for
+// every BD when bdID == 0. Routes configured through the API stay.
+func (m *MapOperations) FlushIrbHostRoutes(bdID uint16) (uint32, error) {
+	routes, err := m.ListIrbRoutes()
+	if err != nil {
+		return 0, err
+	}
+	var count uint32
+	for _, r := range routes {
+		if r.Entry.BdId == 0 || (bdID != 0 && r.Entry.BdId != bdID) {
+			continue
+		}
+		if err := m.DeleteIrbRoute(r.VrfIfindex, r.Prefix); err != nil {
+			return count, fmt.Errorf("flush irb host routes: %s: %w", r.Prefix, err)
+		}
+		count++
+	}
+	return count, nil
+}
+
+func irbHostPrefix(ip net.IP) string {
+	if v4 := ip.To4(); v4 != nil {
+		return v4.String() + "/32"
+	}
+	return ip.String() + "/128"
+}
+
+// ===== VLAN Cross-Connect (DX2V) Map Operations =====
+
+// CreateDx2vVlan creates a VLAN cross-connect entry in dx2v_map. rewrite is
+// applied to the decapsulated frame before it leaves on oif.
+func (m *MapOperations) CreateDx2vVlan(tableID, vlanID uint16, oif uint32, rewrite VlanRewrite) error {
+	key := &Dx2vKey{TableId: tableID, VlanId: vlanID}
+	entry := &Dx2vEntry{Oif: oif, Rewrite: rewrite}
+	if err := m.put(m.objs.Dx2vMap, key, entry); err != nil {
+		return fmt.Errorf("failed to put dx2v entry: %w", err)
+	}
+	return nil
+}
+
+// GetDx2vVlan looks up a VLAN cross-connect entry in dx2v_map
+func (m *MapOperations) GetDx2vVlan(tableID, vlanID uint16) (*Dx2vEntry, error) {
+	key := &Dx2vKey{TableId: tableID, VlanId: vlanID}
+	var entry Dx2vEntry
+	if err := m.objs.Dx2vMap.Lookup(key, &entry); err != nil {
+		return nil, fmt.Errorf("failed to lookup dx2v entry: %w", err)
+	}
+	return &entry, nil
+}
+
+// DeleteDx2vVlan deletes a VLAN cross-connect entry from dx2v_map
+func (m *MapOperations) DeleteDx2vVlan(tableID, vlanID uint16) error {
+	key := &Dx2vKey{TableId: tableID, VlanId: vlanID}
+	if err := m.delete(m.objs.Dx2vMap, key); err != nil {
+		return fmt.Errorf("failed to delete dx2v entry: %w", err)
+	}
+	return nil
+}
+
+// ListDx2vVlan lists all VLAN cross-connect entries
+func (m *MapOperations) ListDx2vVlan() (map[Dx2vKey]*Dx2vEntry, error) {
+	result := make(map[Dx2vKey]*Dx2vEntry)
+	var key Dx2vKey
+	var entry Dx2vEntry
+	iter := m.objs.Dx2vMap.Iterate()
+	for iter.Next(&key, &entry) {
+		entryCopy := entry
+		result[key] = &entryCopy
+	}
+	if err := iter.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate dx2v map: %w", err)
+	}
+	return result, nil
+}
+
+// AgeFdbEntries deletes dynamic FDB entries older than maxAgeNs nanoseconds.
+// Static entries (is_static=1), entries with last_seen=0 and entries whose
+// data-plane aging timer is armed are never touched: the timer ages those.
+// FDBWatcher runs it every aging interval for the entries that have no
+// timer: ones written by CreateFdb or copied into a new map, and ones
+// learned while aging was disabled.
+// Returns the number of entries deleted.
+func (m *MapOperations) AgeFdbEntries(maxAgeNs uint64) (int, error) {
+	var key FdbKey
+	var entry FdbEntry
+	iter := m.objs.FdbMap.Iterate()
+
+	now := currentKtimeNs()
+	var toDelete []FdbKey
+	for iter.Next(&key, &entry) {
+		if entry.IsStatic != 0 || entry.LastSeen == 0 || entry.AgingArmed != 0 {
+			continue
+		}
+		if entry.LastSeen > now {
+			continue // clock skew or corruption
+		}
+		age := now - entry.LastSeen
+		if age > maxAgeNs {
+			keyCopy := key
+			toDelete = append(toDelete, keyCopy)
+		}
+	}
+	if err := iter.Err(); err != nil {
+		return 0, fmt.Errorf("failed to iterate fdb map: %w", err)
+	}
+
+	deleted := 0
+	for _, k := range toDelete {
+		if err := m.DeleteFdb(k.BdId, net.HardwareAddr(k.Mac[:])); err == nil {
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+// currentKtimeNs reads CLOCK_MONOTONIC to match bpf_ktime_get_ns()
+func currentKtimeNs() uint64 {
+	var ts unix.Timespec
+	_ = unix.ClockGettime(unix.CLOCK_MONOTONIC, &ts)
+	return uint64(ts.Sec)*1e9 + uint64(ts.Nsec)
+}
+
+// ===== BD Peer Map Operations (for P2MP BUM flooding) =====
+
+// CreateBdPeer adds a BD peer entry for BUM flooding.
+// Also populates bd_peer_reverse_map (RX split-horizon path) and
+// bd_peer_l2_ext_map (TX split-horizon path). esi is the 10-byte RFC 7432
+// Ethernet Segment Identifier; all-zero means single-homing.
+func (m *MapOperations) CreateBdPeer(bdID, index uint16, entry *HeadendEntry, esi [ESILen]byte) error {
+	key := &BdPeerKey{BdId: bdID, Index: index}
+	if err := m.put(m.objs.BdPeerMap, key, entry); err != nil {
+		return fmt.Errorf("failed to put bd peer entry: %w", err)
+	}
+
+	rKey := &BdPeerReverseKey{BdId: bdID}
+	copy(rKey.SrcAddr[:], entry.SrcAddr[:])
+	rVal := &BdPeerReverseVal{Index: index, Esi: esi}
+	if err := m.put(m.objs.BdPeerReverseMap, rKey, rVal); err != nil {
+		return fmt.Errorf("failed to put bd peer reverse entry: %w", err)
+	}
+
+	var zero [ESILen]byte
+	extKey := &BpfBdPeerL2ExtKey{BdId: bdID, Index: index}
+	if esi != zero {
+		ext := &BpfBdPeerL2ExtVal{Esi: esi}
+		if err := m.put(m.objs.BdPeerL2ExtMap, extKey, ext); err != nil {
+			return fmt.Errorf("failed to put bd peer L2 ESI ext: %w", err)
+		}
+	} else {
+		_ = m.delete(m.objs.BdPeerL2ExtMap, extKey)
+	}
+
+	var span uint16
+	if m.objs.BdPeerSpanMap.Lookup(&bdID, &span) != nil || span <= index {
+		span = index + 1
+		if err := m.put(m.objs.BdPeerSpanMap, &bdID, &span); err != nil {
+			return fmt.Errorf("failed to put bd peer span: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// LookupBdPeerIndex returns the index of the peer of bdID whose outer
+// source is srcAddr.
+func (m *MapOperations) LookupBdPeerIndex(bdID uint16, srcAddr [IPv6AddrLen]byte) (uint16, bool) {
+	rKey := &BdPeerReverseKey{BdId: bdID, SrcAddr: srcAddr}
+	var rVal BdPeerReverseVal
+	if m.objs.BdPeerReverseMap.Lookup(rKey, &rVal) != nil {
+		return 0, false
+	}
+	return rVal.Index, true
+}
+
+// DeleteBdPeer removes a BD peer entry and its reverse-map entry.
+// Deletes forward map first to avoid inconsistency if reverse delete fails.
+func (m *MapOperations) DeleteBdPeer(bdID, index uint16) error {
+	// Look up the entry first to get src_addr for reverse map cleanup
+	key := &BdPeerKey{BdId: bdID, Index: index}
+	var entry HeadendEntry
+	hasEntry := m.objs.BdPeerMap.Lookup(key, &entry) == nil
+
+	if err := m.delete(m.objs.BdPeerMap, key); err != nil {
+		return fmt.Errorf("failed to delete bd peer entry: %w", err)
+	}
+
+	// Clean up reverse map + ESI side tables (best-effort: ignore errors if already gone)
+	if hasEntry {
+		rKey := &BdPeerReverseKey{BdId: bdID}
+		copy(rKey.SrcAddr[:], entry.SrcAddr[:])
+		_ = m.delete(m.objs.BdPeerReverseMap, rKey)
+	}
+	_ = m.delete(m.objs.BdPeerL2ExtMap, &BpfBdPeerL2ExtKey{BdId: bdID, Index: index})
+	return m.shrinkBdPeerSpan(bdID)
+}
+
+// shrinkBdPeerSpan lowers the BD's span to its highest remaining peer
+// index + 1, removing the span once the BD has no peers left.
+func (m *MapOperations) shrinkBdPeerSpan(bdID uint16) error {
+	var span uint16
+	if m.objs.BdPeerSpanMap.Lookup(&bdID, &span) != nil {
+		return nil
+	}
+	var entry HeadendEntry
+	for span > 0 {
+		if m.objs.BdPeerMap.Lookup(&BdPeerKey{BdId: bdID, Index: span - 1}, &entry) == nil {
+			break
+		}
+		span--
+	}
+	if span == 0 {
+		_ = m.delete(m.objs.BdPeerSpanMap, &bdID)
+		return nil
+	}
+	if err := m.put(m.objs.BdPeerSpanMap, &bdID, &span); err != nil {
+		return fmt.Errorf("failed to put bd peer span: %w", err)
+	}
+	return nil
+}
+
+// RebuildBdPeerSpans recomputes bd_peer_span_map from bd_peer_map. Used at
+// startup so peers restored from pinned maps are flooded even when the
+// span map itself was not carried over.
+func (m *MapOperations) RebuildBdPeerSpans() error {
+	peers, err := m.ListBdPeers()
+	if err != nil {
+		return err
+	}
+	spans := make(map[uint16]uint16)
+	for key := range peers {
+		if key.Index+1 > spans[key.BdId] {
+			spans[key.BdId] = key.Index + 1
+		}
+	}
+	for bdID, span := range spans {
+		if err := m.put(m.objs.BdPeerSpanMap, &bdID, &span); err != nil {
+			return fmt.Errorf("failed to put bd peer span for bd %d: %w", bdID, err)
+		}
+	}
+	return nil
+}
+
+// GetBdPeer retrieves a BD peer entry
+func (m *MapOperations) GetBdPeer(bdID, index uint16) (*HeadendEntry, error) {
+	key := &BdPeerKey{BdId: bdID, Index: index}
+	var entry HeadendEntry
+	if err := m.objs.BdPeerMap.Lookup(key, &entry); err != nil {
+		return nil, fmt.Errorf("failed to lookup bd peer entry: %w", err)
+	}
+	return &entry, nil
+}
+
+// BdPeersPerBd returns the per-BD peer limit (settings.entries.bd_peer.per_bd).
+func (m *MapOperations) BdPeersPerBd() uint16 {
+	return m.bdPeersPerBd
+}
+
+// FindFreeBdPeerIndex probes indexes 0..BdPeersPerBd()-1 for a given BD
+// and returns the first unused index. Returns BdPeersPerBd() if all slots are occupied.
+// This avoids iterating the entire bd_peer_map (ListBdPeers) on every create request.
+func (m *MapOperations) FindFreeBdPeerIndex(bdID uint16) uint16 {
+	var entry HeadendEntry
+	for i := uint16(0); i < m.bdPeersPerBd; i++ {
+		key := &BdPeerKey{BdId: bdID, Index: i}
+		if err := m.objs.BdPeerMap.Lookup(key, &entry); err != nil {
+			return i
+		}
+	}
+	return m.bdPeersPerBd
+}
+
+// ListBdPeers returns all BD peer entries
+func (m *MapOperations) ListBdPeers() (map[BdPeerKey]*HeadendEntry, error) {
+	result := make(map[BdPeerKey]*HeadendEntry)
+	var key BdPeerKey
+	var entry HeadendEntry
+	iter := m.objs.BdPeerMap.Iterate()
+	for iter.Next(&key, &entry) {
+		entryCopy := entry
+		result[key] = &entryCopy
+	}
+	if err := iter.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate bd peer map: %w", err)
+	}
+	return result, nil
+}
+
+// ===== VXLAN Gateway (VNI Mapping) Map Operations =====
+
+// VniMapping is the decoded form of one VNI's gateway mapping. VTEP
+// addresses of an IPv4 underlay are 4-byte IPs.
+type VniMapping struct {
+	Vni           uint32
+	BdID          uint16
+	LocalVtep     net.IP
+	RemoteVteps   []net.IP
+	BridgeIfindex uint32
+}
+
+// CreateVniMapping bridges vni into bdID (or replaces the VTEPs of an
+// existing mapping). VTEPs are written before vni_map and bd_vni_map, and
+// stale VTEP slots are removed last, so the data plane never floods to a
+// half-written list. Remote MACs learned behind the old VTEPs are flushed,
+// since their VTEP indexes may have moved.
+func (m *MapOperations) CreateVniMapping(vni uint32, bdID uint16, local net.IP, remotes []net.IP, bridgeIfindex uint32) error {
+	if vni == 0 || vni > VxlanMaxVni {
+		return fmt.Errorf("vni %d out of range 1-%d", vni, VxlanMaxVni)
+	}
+	if bdID == 0 {
+		return fmt.Errorf("bd_id must be non-zero")
+	}
+	if len(remotes) > VxlanMaxVteps {
+		return fmt.Errorf("%d remote VTEPs exceed the maximum of %d", len(remotes), VxlanMaxVteps)
+	}
+	isIPv4 := local.To4() != nil
+	for _, r := range remotes {
+		if (r.To4() != nil) != isIPv4 {
+			return fmt.Errorf("remote VTEP %s and local VTEP %s differ in address family", r, local)
+		}
+	}
+
+	var old VniEntry
+	hadOld := m.objs.VniMap.Lookup(&vni, &old) == nil
+	if hadOld && old.BdId != bdID {
+		return fmt.Errorf("vni %d is already mapped to bd %d", vni, old.BdId)
+	}
+	var mapped uint32
+	if m.objs.BdVniMap.Lookup(&bdID, &mapped) == nil && mapped != vni {
+		return fmt.Errorf("bd %d is already mapped to vni %d", bdID, mapped)
+	}
+
+	keep := make(map[VtepReverseKey]bool, len(remotes))
+	for i, r := range remotes {
+		var vt VtepEntry
+		copy(vt.Addr[:], r.To16())
+		if err := m.put(m.objs.VtepMap, &VtepKey{BdId: bdID, Index: uint16(i)}, &vt); err != nil {
+			return fmt.Errorf("failed to put vtep entry: %w", err)
+		}
+		rk := VtepReverseKey{BdId: bdID, Addr: vt.Addr}
+		idx := uint16(i)
+		if err := m.put(m.objs.VtepReverseMap, &rk, &idx); err != nil {
+			return fmt.Errorf("failed to put vtep reverse entry: %w", err)
+		}
+		keep[rk] = true
+	}
+
+	entry := VniEntry{
+		BdId:          bdID,
+		NumVteps:      uint8(len(remotes)),
+		BridgeIfindex: bridgeIfindex,
+	}
+	if isIPv4 {
+		entry.IsIpv4 = 1
+	}
+	copy(entry.LocalAddr[:], local.To16())
+	if err := m.put(m.objs.VniMap, &vni, &entry); err != nil {
+		return fmt.Errorf("failed to put vni entry: %w", err)
+	}
+	if err := m.put(m.objs.BdVniMap, &bdID, &vni); err != nil {
+		return fmt.Errorf("failed to put bd vni entry: %w", err)
+	}
+
+	if hadOld {
+		for i := len(remotes); i < int(old.NumVteps); i++ {
+			_ = m.delete(m.objs.VtepMap, &VtepKey{BdId: bdID, Index: uint16(i)})
+		}
+		if err := m.purgeVtepReverse(bdID, keep); err != nil {
+			return err
+		}
+		return m.flushVtepFdb(bdID)
+	}
+	return nil
+}
+
+// DeleteVniMapping removes the mapping of vni, its VTEPs and the MACs
+// learned behind them.
+func (m *MapOperations) DeleteVniMapping(vni uint32) error {
+	var entry VniEntry
+	if err := m.objs.VniMap.Lookup(&vni, &entry); err != nil {
+		return fmt.Errorf("vni %d is not mapped", vni)
+	}
+	bdID := entry.BdId
+
+	// bd_vni_map first: the BD stops flooding to VTEPs before they go away
+	_ = m.delete(m.objs.BdVniMap, &bdID)
+	if err := m.delete(m.objs.VniMap, &vni); err != nil {
+		return fmt.Errorf("failed to delete vni entry: %w", err)
+	}
+	for i := 0; i < int(entry.NumVteps); i++ {
+		_ = m.delete(m.objs.VtepMap, &VtepKey{BdId: bdID, Index: uint16(i)})
+	}
+	if err := m.purgeVtepReverse(bdID, nil); err != nil {
+		return err
+	}
+	return m.flushVtepFdb(bdID)
+}
+
+// VniForBd returns the VNI bdID is mapped to, or 0 when it has none.
+func (m *MapOperations) VniForBd(bdID uint16) uint32 {
+	var vni uint32
+	if m.objs.BdVniMap.Lookup(&bdID, &vni) != nil {
+		return 0
+	}
+	return vni
+}
+
+// ListVniMappings returns every VNI mapping keyed by VNI.
+func (m *MapOperations) ListVniMappings() (map[uint32]*VniMapping, error) {
+	result := make(map[uint32]*VniMapping)
+	var vni uint32
+	var entry VniEntry
+	iter := m.objs.VniMap.Iterate()
+	for iter.Next(&vni, &entry) {
+		result[vni] = &VniMapping{
+			Vni:           vni,
+			BdID:          entry.BdId,
+			LocalVtep:     vtepAddrToIP(entry.LocalAddr, entry.IsIpv4 != 0),
+			BridgeIfindex: entry.BridgeIfindex,
+		}
+		for i := 0; i < int(entry.NumVteps); i++ {
+			var vt VtepEntry
+			if m.objs.VtepMap.Lookup(&VtepKey{BdId: entry.BdId, Index: uint16(i)}, &vt) != nil {
+				continue
+			}
+			result[vni].RemoteVteps = append(result[vni].RemoteVteps, vtepAddrToIP(vt.Addr, entry.IsIpv4 != 0))
+		}
+	}
+	if err := iter.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate vni map: %w", err)
+	}
+	return result, nil
+}
+
+// GetVtep returns the address of VTEP index of bdID, for FDB listings.
+func (m *MapOperations) GetVtep(bdID, index uint16) (net.IP, error) {
+	var vt VtepEntry
+	if err := m.objs.VtepMap.Lookup(&VtepKey{BdId: bdID, Index: index}, &vt); err != nil {
+		return nil, fmt.Errorf("failed to lookup vtep entry: %w", err)
+	}
+	ip := net.IP(vt.Addr[:])
+	if v4 := ip.To4(); v4 != nil {
+		return v4, nil
+	}
+	return ip, nil
+}
+
+// purgeVtepReverse removes the vtep_reverse_map entries of bdID not in keep.
+func (m *MapOperations) purgeVtepReverse(bdID uint16, keep map[VtepReverseKey]bool) error {
+	var stale []VtepReverseKey
+	var rk VtepReverseKey
+	var idx uint16
+	iter := m.objs.VtepReverseMap.Iterate()
+	for iter.Next(&rk, &idx) {
+		if rk.BdId == bdID && !keep[rk] {
+			stale = append(stale, rk)
+		}
+	}
+	if err := iter.Err(); err != nil {
+		return fmt.Errorf("failed to iterate vtep reverse map: %w", err)
+	}
+	for _, k := range stale {
+		_ = m.delete(m.objs.VtepReverseMap, &k)
+	}
+	return nil
+}
+
+// flushVtepFdb removes the dynamic FDB entries of bdID learned behind a VTEP.
+func (m *MapOperations) flushVtepFdb(bdID uint16) error {
+	entries, err := m.ListFdb()
+	if err != nil {
+		return err
+	}
+	for key, e := range entries {
+		if key.BdId != bdID || e.IsRemote == 0 || e.Vtep == 0 || e.IsStatic != 0 {
+			continue
+		}
+		if err := m.DeleteFdb(key.BdId, net.HardwareAddr(key.Mac[:])); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func vtepAddrToIP(addr [IPv6AddrLen]byte, isIPv4 bool) net.IP {
+	ip := net.IP(append([]byte(nil), addr[:]...))
+	if isIPv4 {
+		return ip.To4()
+	}
+	return ip
+}
+
+// ===== SR Domain Boundary Map Operations (RFC 8754 Section 5.1) =====
+
+// SrDomainPolicy is the decoded form of one locator's boundary policy:
+// the trusted source prefixes and ingress ifindexes that may reach SIDs
+// under Locator.
+type SrDomainPolicy struct {
+	Locator            string
+	PolicyID           uint32
+	TrustedSrcPrefixes []string
+	TrustedIfindexes   []uint32
+}
+
+// CreateSrDomain installs (or replaces) the boundary policy for a locator.
+// Trust entries are written before the locator entry, and stale entries of
+// a replaced policy are removed last, so in-flight packets never see a
+// policy with an empty trust list.
+func (m *MapOperations) CreateSrDomain(locator string, srcPrefixes []string, ifindexes []uint32) error {
+	key, err := buildLpmKeyV6(locator)
+	if err != nil {
+		return fmt.Errorf("failed to build LPM key: %w", err)
+	}
+
+	var existing SrDomainEntry
+	policyID := uint32(0)
+	if m.objs.SrDomainMap.Lookup(key, &existing) == nil {
+		policyID = existing.PolicyId
+	} else if policyID, err = m.findFreeSrDomainPolicyID(); err != nil {
+		return err
+	}
+
+	srcKeys := make(map[SrDomainSrcKey]bool, len(srcPrefixes))
+	for _, prefix := range srcPrefixes {
+		srcKey, err := buildSrDomainSrcKey(policyID, prefix)
+		if err != nil {
+			return fmt.Errorf("trusted source %q: %w", prefix, err)
+		}
+		srcKeys[*srcKey] = true
+	}
+	iifKeys := make(map[SrDomainIifKey]bool, len(ifindexes))
+	for _, ifindex := range ifindexes {
+		iifKeys[SrDomainIifKey{PolicyId: policyID, Ifindex: ifindex}] = true
+	}
+
+	trusted := uint8(1)
+	for k := range srcKeys {
+		if err := m.put(m.objs.SrDomainSrcMap, &k, &trusted); err != nil {
+			return fmt.Errorf("failed to put sr_domain src entry: %w", err)
+		}
+	}
+	for k := range iifKeys {
+		if err := m.put(m.objs.SrDomainIifMap, &k, &trusted); err != nil {
+			return fmt.Errorf("failed to put sr_domain iif entry: %w", err)
+		}
+	}
+	if err := m.put(m.objs.SrDomainMap, key, &SrDomainEntry{PolicyId: policyID}); err != nil {
+		return fmt.Errorf("failed to put sr_domain entry: %w", err)
+	}
+
+	return m.purgeSrDomainTrust(policyID, srcKeys, iifKeys)
+}
+
+// DeleteSrDomain removes a locator's boundary policy and its trust entries.
+func (m *MapOperations) DeleteSrDomain(locator string) error {
+	key, err := buildLpmKeyV6(locator)
+	if err != nil {
+		return fmt.Errorf("failed to build LPM key: %w", err)
+	}
+
+	var entry SrDomainEntry
+	hasEntry := m.objs.SrDomainMap.Lookup(key, &entry) == nil
+
+	if err := m.delete(m.objs.SrDomainMap, key); err != nil {
+		return fmt.Errorf("failed to delete sr_domain entry: %w", err)
+	}
+
+	// Trust entries go after the locator so the policy never filters with
+	// a partially removed trust list.
+	if hasEntry {
+		return m.purgeSrDomainTrust(entry.PolicyId, nil, nil)
+	}
+	return nil
+}
+
+// ListSrDomains returns every boundary policy keyed by locator prefix.
+func (m *MapOperations) ListSrDomains() (map[string]*SrDomainPolicy, error) {
+	result := make(map[string]*SrDomainPolicy)
+	byID := make(map[uint32]*SrDomainPolicy)
+
+	var key LpmKeyV6
+	var entry SrDomainEntry
+	iter := m.objs.SrDomainMap.Iterate()
+	for iter.Next(&key, &entry) {
+		policy := &SrDomainPolicy{Locator: lpmKeyV6ToString(&key), PolicyID: entry.PolicyId}
+		result[policy.Locator] = policy
+		byID[entry.PolicyId] = policy
+	}
+	if err := iter.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate sr_domain map: %w", err)
+	}
+
+	var srcKey SrDomainSrcKey
+	var val uint8
+	srcIter := m.objs.SrDomainSrcMap.Iterate()
+	for srcIter.Next(&srcKey, &val) {
+		if policy, ok := byID[srcKey.PolicyId]; ok {
+			policy.TrustedSrcPrefixes = append(policy.TrustedSrcPrefixes, srDomainSrcKeyToString(&srcKey))
+		}
+	}
+	if err := srcIter.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate sr_domain src map: %w", err)
+	}
+
+	var iifKey SrDomainIifKey
+	iifIter := m.objs.SrDomainIifMap.Iterate()
+	for iifIter.Next(&iifKey, &val) {
+		if policy, ok := byID[iifKey.PolicyId]; ok {
+			policy.TrustedIfindexes = append(policy.TrustedIfindexes, iifKey.Ifindex)
+		}
+	}
+	if err := iifIter.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate sr_domain iif map: %w", err)
+	}
+	return result, nil
+}
+
+// findFreeSrDomainPolicyID returns the smallest policy_id not referenced by
+// sr_domain_map. 0 is never handed out so a zeroed key can't alias a policy.
+func (m *MapOperations) findFreeSrDomainPolicyID() (uint32, error) {
+	used := make(map[uint32]bool)
+	var key LpmKeyV6
+	var entry SrDomainEntry
+	iter := m.objs.SrDomainMap.Iterate()
+	for iter.Next(&key, &entry) {
+		used[entry.PolicyId] = true
+	}
+	if err := iter.Err(); err != nil {
+		return 0, fmt.Errorf("failed to iterate sr_domain map: %w", err)
+	}
+	for id := uint32(1); id != 0; id++ {
+		if !used[id] {
+			return id, nil
+		}
+	}
+	return 0, fmt.Errorf("sr_domain policy ids exhausted")
+}
+
+// purgeSrDomainTrust deletes the trust entries of policyID that are not in
+// keepSrc / keepIif (nil keeps nothing).
+func (m *MapOperations) purgeSrDomainTrust(policyID uint32, keepSrc map[SrDomainSrcKey]bool, keepIif map[SrDomainIifKey]bool) error {
+	var val uint8
+	var staleSrc []SrDomainSrcKey
+	var srcKey SrDomainSrcKey
+	srcIter := m.objs.SrDomainSrcMap.Iterate()
+	for srcIter.Next(&srcKey, &val) {
+		if srcKey.PolicyId == policyID && !keepSrc[srcKey] {
+			staleSrc = append(staleSrc, srcKey)
+		}
+	}
+	if err := srcIter.Err(); err != nil {
+		return fmt.Errorf("failed to iterate sr_domain src map: %w", err)
+	}
+
+	var staleIif []SrDomainIifKey
+	var iifKey SrDomainIifKey
+	iifIter := m.objs.SrDomainIifMap.Iterate()
+	for iifIter.Next(&iifKey, &val) {
+		if iifKey.PolicyId == policyID && !keepIif[iifKey] {
+			staleIif = append(staleIif, iifKey)
+		}
+	}
+	if err := iifIter.Err(); err != nil {
+		return fmt.Errorf("failed to iterate sr_domain iif map: %w", err)
+	}
+
+	for _, k := range staleSrc {
+		if err := m.delete(m.objs.SrDomainSrcMap, &k); err != nil {
+			return fmt.Errorf("failed to delete sr_domain src entry: %w", err)
+		}
+	}
+	for _, k := range staleIif {
+		if err := m.delete(m.objs.SrDomainIifMap, &k); err != nil {
+			return fmt.Errorf("failed to delete sr_domain iif entry: %w", err)
+		}
+	}
+	return nil
+}
+
+// ===== STAMP Reflector Map Operations (RFC 8762) =====
+
+// KtimeOffset returns wall clock time minus CLOCK_MONOTONIC, the clock
+// behind bpf_ktime_get_ns(). Adding it to a BPF timestamp yields Unix time.
+func KtimeOffset() (time.Duration, error) {
+	var ts unix.Timespec
+	if err := unix.ClockGettime(unix.CLOCK_MONOTONIC, &ts); err != nil {
+		return 0, fmt.Errorf("clock_gettime: %w", err)
+	}
+	return time.Duration(time.Now().UnixNano() - ts.Nano()), nil
+}
+
+// CreateStampReflector makes the XDP path answer STAMP test packets sent
+// to addr:port. The clock offset is refreshed on every call, so
+// re-creating a reflector also resyncs its timestamps with the wall clock.
+func (m *MapOperations) CreateStampReflector(addr string, port uint16) error {
+	key, err := buildStampReflectorKey(addr, port)
+	if err != nil {
+		return err
+	}
+	offset, err := KtimeOffset()
+	if err != nil {
+		return err
+	}
+
+	entry := StampReflectorEntry{ClockOffsetNs: int64(offset)}
+	var existing StampReflectorEntry
+	if m.objs.StampReflectorMap.Lookup(key, &existing) == nil {
+		entry.Reflected = existing.Reflected
+	}
+	if err := m.put(m.objs.StampReflectorMap, key, &entry); err != nil {
+		return fmt.Errorf("failed to put stamp reflector entry: %w", err)
+	}
+	return nil
+}
+
+// DeleteStampReflector stops answering STAMP test packets for addr:port.
+func (m *MapOperations) DeleteStampReflector(addr string, port uint16) error {
+	key, err := buildStampReflectorKey(addr, port)
+	if err != nil {
+		return err
+	}
+	if err := m.delete(m.objs.StampReflectorMap, key); err != nil {
+		return fmt.Errorf("failed to delete stamp reflector entry: %w", err)
+	}
+	return nil
+}
+
+// ListStampReflectors returns every reflector with its entry.
+func (m *MapOperations) ListStampReflectors() (map[StampReflectorKey]*StampReflectorEntry, error) {
+	result := make(map[StampReflectorKey]*StampReflectorEntry)
+	var key StampReflectorKey
+	var entry StampReflectorEntry
+	iter := m.objs.StampReflectorMap.Iterate()
+	for iter.Next(&key, &entry) {
+		e := entry
+		result[key] = &e
+	}
+	if err := iter.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate stamp reflector map: %w", err)
+	}
+	return result, nil
+}
+
+func buildStampReflectorKey(addr string, port uint16) (*StampReflectorKey, error) {
+	if ip := net.ParseIP(addr); ip == nil || ip.To4() != nil {
+		return nil, fmt.Errorf("invalid IPv6 reflector address: %q", addr)
+	}
+	ip, err := ParseIPv6(addr)
+	if err != nil {
+		return nil, err
+	}
+	if port == 0 {
+		return nil, fmt.Errorf("STAMP port must not be 0")
+	}
+	return &StampReflectorKey{Addr: ip, Port: port}, nil
+}
+
+// ===== Flush Operations =====
+//
+// Each Flush* method removes every entry from its target map in a single
+// operation. The two-phase pattern (collect keys then delete) avoids
+// mutating the map while iterating, which kernel BPF map iterators do
+// not guarantee safety for. Partial failures return the count of
+// entries already deleted plus an error so the caller can log progress.
 
 // FlushSidFunctions removes every SID function entry and releases the
 // associated aux indices. Returns the number of entries deleted.
@@ -1070,9 +2457,9 @@ func (m *MapOperations) FlushHeadendL2() (uint32, error) {
 	}
 	var count uint32
 	for key := range entries {
-		if err := m.DeleteHeadendL2(key.Ifindex, key.VlanId); err != nil {
-			return count, fmt.Errorf("flush headend_l2: delete ifindex=%d vlan=%d: %w",
-				key.Ifindex, key.VlanId, err)
+		if err := m.DeleteHeadendL2(key); err != nil {
+			return count, fmt.Errorf("flush headend_l2: delete ifindex=%d vlan=%d inner=%d: %w",
+				key.Ifindex, key.VlanId, key.InnerVlanId, err)
 		}
 		count++
 	}
@@ -1148,6 +2535,39 @@ func (m *MapOperations) FlushVlanTable(tableID uint16) (uint32, error) {
 	return count, nil
 }
 
+// FlushVniMappings removes every VNI mapping together with its VTEPs.
+func (m *MapOperations) FlushVniMappings() (uint32, error) {
+	mappings, err := m.ListVniMappings()
+	if err != nil {
+		return 0, err
+	}
+	var count uint32
+	for vni := range mappings {
+		if err := m.DeleteVniMapping(vni); err != nil {
+			return count, fmt.Errorf("flush vni_mapping: delete vni=%d: %w", vni, err)
+		}
+		count++
+	}
+	return count, nil
+}
+
+// FlushSrDomains removes every SR domain boundary policy together with its
+// trusted source and interface entries.
+func (m *MapOperations) FlushSrDomains() (uint32, error) {
+	policies, err := m.ListSrDomains()
+	if err != nil {
+		return 0, err
+	}
+	var count uint32
+	for locator := range policies {
+		if err := m.DeleteSrDomain(locator); err != nil {
+			return count, fmt.Errorf("flush sr_domain: delete %q: %w", locator, err)
+		}
+		count++
+	}
+	return count, nil
+}
+
 // ===== Helper Functions =====
 
 func buildLpmKeyV4(cidr string) (*LpmKeyV4, error) {
@@ -1196,11 +2616,48 @@ func lpmKeyV6ToString(key *LpmKeyV6) string {
 	return fmt.Sprintf("%s/%d", ip.String(), key.Prefixlen)
 }
 
-func buildHeadendL2Key(ifindex uint32, vlanID uint16) *HeadendL2Key {
-	return &HeadendL2Key{
-		Ifindex: ifindex,
-		VlanId:  vlanID,
+func buildSrDomainSrcKey(policyID uint32, cidr string) (*SrDomainSrcKey, error) {
+	lpm, err := buildLpmKeyV6(cidr)
+	if err != nil {
+		return nil, err
+	}
+	if net.IP(lpm.Addr[:]).To4() != nil {
+		return nil, fmt.Errorf("not an IPv6 prefix: %s", cidr)
+	}
+	return &SrDomainSrcKey{
+		Prefixlen: 32 + lpm.Prefixlen,
+		PolicyId:  policyID,
+		Addr:      lpm.Addr,
+	}, nil
+}
+
+func srDomainSrcKeyToString(key *SrDomainSrcKey) string {
+	ip := net.IP(key.Addr[:])
+	return fmt.Sprintf("%s/%d", ip.String(), key.Prefixlen-32)
+}
+
+func buildIrbRouteKeyV4(vrfIfindex uint32, cidr string) (*IrbRouteKeyV4, error) {
+	lpm, err := buildLpmKeyV4(cidr)
+	if err != nil {
+		return nil, err
+	}
+	return &IrbRouteKeyV4{
+		Prefixlen:  32 + lpm.Prefixlen,
+		VrfIfindex: vrfIfindex,
+		Addr:       lpm.Addr,
+	}, nil
+}
+
+func buildIrbRouteKeyV6(vrfIfindex uint32, cidr string) (*IrbRouteKeyV6, error) {
+	lpm, err := buildLpmKeyV6(cidr)
+	if err != nil {
+		return nil, err
 	}
+	return &IrbRouteKeyV6{
+		Prefixlen:  32 + lpm.Prefixlen,
+		VrfIfindex: vrfIfindex,
+		Addr:       lpm.Addr,
+	}, nil
 }
 
 func buildFdbKey(bdID uint16, mac net.HardwareAddr) *FdbKey {
@@ -1288,6 +2745,9 @@ func (m *MapOperations) GetSharedMaps() map[string]*ebpf.Map {
 		"headend_l2_ext_map":  m.objs.HeadendL2ExtMap,
 		"bd_local_esi_map":    m.objs.BdLocalEsiMap,
 		"dx2v_map":           m.objs.Dx2vMap,
+		"sr_domain_map":      m.objs.SrDomainMap,
+		"sr_domain_src_map":  m.objs.SrDomainSrcMap,
+		"sr_domain_iif_map":  m.objs.SrDomainIifMap,
 		"scratch_map":             m.objs.ScratchMap,
 		"stats_map":               m.objs.StatsMap,
 		// slot_stats_* are vinbero-internal observability maps. Exposed to
@@ -1492,7 +2952,7 @@ func (m *MapOperations) CreateEsi(esi [ESILen]byte, entry *EsiEntry) error {
 		return fmt.Errorf("all-zero ESI is reserved as single-homing sentinel")
 	}
 	key := &EsiKey{Esi: esi}
-	if err := m.objs.EsiMap.Put(key, entry); err != nil {
+	if err := m.put(m.objs.EsiMap, key, entry); err != nil {
 		return fmt.Errorf("failed to put esi entry: %w", err)
 	}
 	return nil
@@ -1501,7 +2961,7 @@ func (m *MapOperations) CreateEsi(esi [ESILen]byte, entry *EsiEntry) error {
 // DeleteEsi removes an Ethernet Segment entry by ESI.
 func (m *MapOperations) DeleteEsi(esi [ESILen]byte) error {
 	key := &EsiKey{Esi: esi}
-	if err := m.objs.EsiMap.Delete(key); err != nil {
+	if err := m.delete(m.objs.EsiMap, key); err != nil {
 		return fmt.Errorf("failed to delete esi entry: %w", err)
 	}
 	return nil
@@ -1526,7 +2986,7 @@ func (m *MapOperations) SetEsiDfPe(esi [ESILen]byte, dfAddr [IPv6AddrLen]byte) (
 	}
 	entry.DfPeSrcAddr = dfAddr
 	key := &EsiKey{Esi: esi}
-	if err := m.objs.EsiMap.Put(key, entry); err != nil {
+	if err := m.put(m.objs.EsiMap, key, entry); err != nil {
 		return nil, fmt.Errorf("failed to update esi entry: %w", err)
 	}
 	return entry, nil
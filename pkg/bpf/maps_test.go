@@ -85,6 +85,14 @@ func TestSidAuxRoundTrip(t *testing.T) {
 			t.Errorf("segments mismatch")
 		}
 	})
+
+	t.Run("PluginRaw", func(t *testing.T) {
+		raw := []byte{0x01, 0x00, 0x02, 0x00, 0x00}
+		got := SidAuxPluginRawData(NewSidAuxPluginRaw(raw))
+		if string(got) != string(raw[:3]) {
+			t.Errorf("plugin_raw: got %x, want %x", got, raw[:3])
+		}
+	})
 }
 
 // TestRecoverAuxIndices verifies that the index allocator correctly recovers
@@ -134,6 +142,77 @@ func TestRecoverAuxIndices(t *testing.T) {
 	}
 }
 
+// TestReplaceSidFunctionFreesAux verifies that re-creating a SID with aux
+// data returns the old entry's aux index to the allocator.
+func TestReplaceSidFunctionFreesAux(t *testing.T) {
+	h := newXDPTestHelper(t)
+
+	nh, _ := ParseIPv6("fc00::1")
+	for i := 0; i < 3; i++ {
+		e := &SidFunctionEntry{Action: actionEndX}
+		if err := h.mapOps.CreateSidFunction("fc00:1::1/128", e, NewSidAuxNexthop(nh)); err != nil {
+			t.Fatalf("create %d: %v", i, err)
+		}
+	}
+
+	e := &SidFunctionEntry{Action: actionEndX}
+	if err := h.mapOps.CreateSidFunction("fc00:2::1/128", e, NewSidAuxNexthop(nh)); err != nil {
+		t.Fatalf("create second SID: %v", err)
+	}
+	got, err := h.mapOps.GetSidFunction("fc00:2::1/128")
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	// The replaced SID holds one index; the freed ones are reused
+	if got.AuxIndex > 2 {
+		t.Errorf("aux index %d leaked by replacing a SID, want <= 2", got.AuxIndex)
+	}
+}
+
+// TestReplaceSidFunctionAuxSlot verifies that replacing a SID keeps its aux
+// index while the aux data is unchanged, and moves to a new one otherwise.
+func TestReplaceSidFunctionAuxSlot(t *testing.T) {
+	h := newXDPTestHelper(t)
+
+	nh1, _ := ParseIPv6("fc00::1")
+	nh2, _ := ParseIPv6("fc00::2")
+	create := func(flavor uint8, nh [IPv6AddrLen]byte) *SidFunctionEntry {
+		t.Helper()
+		e := &SidFunctionEntry{Action: actionEndX, Flavor: flavor}
+		if err := h.mapOps.CreateSidFunction("fc00:1::1/128", e, NewSidAuxNexthop(nh)); err != nil {
+			t.Fatalf("create: %v", err)
+		}
+		got, err := h.mapOps.GetSidFunction("fc00:1::1/128")
+		if err != nil {
+			t.Fatalf("get: %v", err)
+		}
+		return got
+	}
+
+	first := create(0, nh1)
+	if got := create(1, nh1); got.AuxIndex != first.AuxIndex || got.Flavor != 1 {
+		t.Errorf("same aux: index %d flavor %d, want index %d flavor 1", got.AuxIndex, got.Flavor, first.AuxIndex)
+	}
+	moved := create(1, nh2)
+	if moved.AuxIndex == first.AuxIndex {
+		t.Fatalf("changed aux kept index %d", moved.AuxIndex)
+	}
+	aux, err := h.mapOps.GetSidAux(uint32(moved.AuxIndex))
+	if err != nil {
+		t.Fatalf("get aux: %v", err)
+	}
+	if *aux != *NewSidAuxNexthop(nh2) {
+		t.Errorf("aux at new index is not the new nexthop")
+	}
+	stale, err := h.mapOps.GetSidAux(uint32(first.AuxIndex))
+	if err != nil {
+		t.Fatalf("get old aux: %v", err)
+	}
+	if *stale != (SidAuxEntry{}) {
+		t.Errorf("old aux slot %d not cleared", first.AuxIndex)
+	}
+}
+
 // TestFlushSidFunctions verifies that FlushSidFunctions wipes every SID
 // entry, frees the matching aux indices back into the allocator, and
 // leaves the map ready to accept fresh entries from index 1 again.
@@ -223,6 +302,10 @@ func TestBpfLoad_PinMapsRoundTrip(t *testing.T) {
 		},
 	}
 
+	if PinnedMapsExist(cfg) {
+		t.Fatal("PinnedMapsExist before the first load")
+	}
+
 	// Phase 1: load fresh, create a SID entry, close.
 	{
 		objs, err := ReadCollection(nil, cfg)
@@ -246,6 +329,9 @@ func TestBpfLoad_PinMapsRoundTrip(t *testing.T) {
 			t.Fatalf("expected pin %q to persist after close: %v", name, err)
 		}
 	}
+	if !PinnedMapsExist(cfg) {
+		t.Error("PinnedMapsExist after close: got false")
+	}
 
 	// Phase 2: reload from the same pin path and verify the SID entry
 	// is still there.
@@ -0,0 +1,468 @@
+package bpf
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"unsafe"
+
+	"github.com/cilium/ebpf"
+	"github.com/cilium/ebpf/btf"
+	"github.com/takehaya/vinbero/pkg/config"
+)
+
+// mapConverter rewrites the entries of one pinned control map from the
+// layout a previous release wrote (From) to the next one (To). Versions
+// are the schema strings returned by mapSchema; MigratePinnedMaps prints
+// both sides when it finds a map it cannot convert, so a release that
+// changes e.g. struct headend_entry copies them from there.
+//
+// Convert gets one raw key/value pair and returns the pair to store in
+// the new map. Returning a nil key drops the entry.
+type mapConverter struct {
+	Map     string
+	From    string
+	To      string
+	Convert func(key, value []byte) ([]byte, []byte, error)
+}
+
+// mapConverters is the converter registry. Add an entry here whenever a
+// struct stored in a pinnedControlMaps map changes; several entries for
+// the same map are chained From -> To until the current schema is reached.
+var mapConverters = []mapConverter{
+	// Maps pinned by the first release with pin_maps. Every field added
+	// since then starts at 0, which is the behavior those entries had.
+	{Map: "sid_function_map", From: "47b257a84505", To: "22532d5a2305", Convert: convertSidFunctionEntryV1},
+	{Map: "sid_aux_map", From: "356b2c96e602", To: "d4a0ed0b2f15", Convert: convertSidAuxEntryV1},
+	{Map: "headend_v4_map", From: "7fa28517d04a", To: "0fd34f36523b", Convert: convertHeadendEntryV1},
+	{Map: "headend_v6_map", From: "dc84d05ece13", To: "b5a7c2b1c69f", Convert: convertHeadendEntryV1},
+	{Map: "headend_l2_map", From: "4bc8f499c049", To: "54438d959c0e", Convert: convertHeadendEntryV1},
+	{Map: "bd_peer_map", From: "c8393b9a0849", To: "3ba90dda7a89", Convert: convertHeadendEntryV1},
+	{Map: "fdb_map", From: "74d3d6347882", To: "cb1cdeb5eaf1", Convert: convertFdbEntryV1},
+	{Map: "dx2v_map", From: "6c53f1c71454", To: "883ebc2c11ce", Convert: convertDx2vEntryV1},
+}
+
+// widen returns value, which must be size bytes, zero-extended to
+// newSize bytes.
+func widen(value []byte, size, newSize int) ([]byte, error) {
+	if len(value) != size {
+		return nil, fmt.Errorf("value is %d bytes, want %d", len(value), size)
+	}
+	out := make([]byte, newSize)
+	copy(out, value)
+	return out, nil
+}
+
+// convertSidFunctionEntryV1 appends flags and _pad to struct
+// sid_function_entry (4 -> 8 bytes).
+func convertSidFunctionEntryV1(key, value []byte) ([]byte, []byte, error) {
+	out, err := widen(value, 4, 8)
+	return key, out, err
+}
+
+// convertSidAuxEntryV1 grows struct sid_aux_entry with its b6_policy and
+// plugin_raw variants (200 -> 204 bytes). The variant isn't known, so the
+// bytes are kept where they are: the b6_policy fields that moved into
+// headend_entry's padding were zero there.
+func convertSidAuxEntryV1(key, value []byte) ([]byte, []byte, error) {
+	out, err := widen(value, 200, 204)
+	return key, out, err
+}
+
+// convertHeadendEntryV1 converts struct headend_entry (200 -> 204 bytes):
+// mtu replaces _pad, and tc_mode, tc, hop_limit_mode, hop_limit and
+// flow_label_mode follow args_offset in place of _pad_gtp.
+func convertHeadendEntryV1(key, value []byte) ([]byte, []byte, error) {
+	out, err := widen(value, 200, 204)
+	if err != nil {
+		return nil, nil, err
+	}
+	out[2], out[3] = 0, 0 // mtu
+	out[199] = 0          // tc_mode
+	return key, out, nil
+}
+
+// convertFdbEntryV1 converts struct fdb_entry (32 -> 56 bytes):
+// aging_armed and vtep replace _pad, ac_vlan_id replaces _pad_esi, and
+// ac_inner_vlan_id, rewrite and the aging timer are appended.
+func convertFdbEntryV1(key, value []byte) ([]byte, []byte, error) {
+	out, err := widen(value, 32, 56)
+	if err != nil {
+		return nil, nil, err
+	}
+	out[10], out[11] = 0, 0 // aging_armed, vtep
+	out[30], out[31] = 0, 0 // ac_vlan_id
+	return key, out, nil
+}
+
+// convertDx2vEntryV1 appends rewrite to struct dx2v_entry (4 -> 10
+// bytes); VLAN_REWRITE_NONE is 0.
+func convertDx2vEntryV1(key, value []byte) ([]byte, []byte, error) {
+	out, err := widen(value, 4, 10)
+	return key, out, err
+}
+
+// maxConverterChain bounds the converter chain so a registry loop fails
+// instead of hanging the daemon at startup.
+const maxConverterChain = 16
+
+// MapMigration describes one pinned map rewritten by MigratePinnedMaps.
+type MapMigration struct {
+	Map     string
+	From    string // schema of the pinned map
+	To      string // schema this build expects
+	Entries int
+}
+
+// MigratePinnedMaps brings the control maps pinned under
+// settings.pin_maps.path to the layout and capacity this build expects,
+// so ReadCollection can reuse them. A pinned map whose key/value BTF or
+// map attributes differ from the spec is copied into a new map, through
+// the registered converters when the layout changed, and the new map is
+// pinned in its place.
+//
+// Every map is checked before any is rewritten: if one of them has no
+// converter, nothing is touched and the error tells the operator how to
+// carry the state over by hand.
+func MigratePinnedMaps(cfg *config.Config) ([]MapMigration, error) {
+	if cfg == nil || !cfg.Setting.PinMaps.Enabled {
+		return nil, nil
+	}
+	spec, err := collectionSpec(nil, cfg)
+	if err != nil {
+		return nil, err
+	}
+	return migratePinnedMaps(spec, pinPathOf(cfg))
+}
+
+// pendingMigration is a map MigratePinnedMaps has decided to rewrite.
+type pendingMigration struct {
+	MapMigration
+	old        *ebpf.Map
+	spec       *ebpf.MapSpec
+	converters []mapConverter
+}
+
+func migratePinnedMaps(spec *ebpf.CollectionSpec, dir string) ([]MapMigration, error) {
+	var pending []*pendingMigration
+	defer func() {
+		for _, p := range pending {
+			_ = p.old.Close()
+		}
+	}()
+
+	for _, name := range pinnedControlMaps {
+		ms, ok := spec.Maps[name]
+		if !ok {
+			continue
+		}
+		p, err := planMigration(ms, filepath.Join(dir, name))
+		if err != nil {
+			return nil, err
+		}
+		if p != nil {
+			pending = append(pending, p)
+		}
+	}
+
+	done := make([]MapMigration, 0, len(pending))
+	for _, p := range pending {
+		if err := p.run(filepath.Join(dir, p.Map)); err != nil {
+			return done, fmt.Errorf("migrate pinned map %s: %w", p.Map, err)
+		}
+		done = append(done, p.MapMigration)
+	}
+	return done, nil
+}
+
+// planMigration compares the map pinned at pin with ms. It returns nil
+// when there is no pin or the pin can be reused as is. A pin larger than
+// ms keeps its capacity.
+func planMigration(ms *ebpf.MapSpec, pin string) (*pendingMigration, error) {
+	old, err := ebpf.LoadPinnedMap(pin, nil)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("open pinned map %s: %w", pin, err)
+	}
+
+	if old.MaxEntries() > ms.MaxEntries {
+		// Grown by MapResize: keep the size until settings.entries
+		// catches up, rather than copy it back into a smaller map
+		ms = ms.Copy()
+		ms.MaxEntries = old.MaxEntries()
+	}
+
+	want := mapSchema(ms.Key, ms.Value, ms.KeySize, ms.ValueSize)
+	have, known := pinnedSchema(old, ms)
+	if !known && old.KeySize() == ms.KeySize && old.ValueSize() == ms.ValueSize {
+		// Same sizes is all a pin without BTF can tell us.
+		have = want
+	}
+	compatible := ms.Compatible(old) == nil
+	if compatible && have == want {
+		_ = old.Close()
+		return nil, nil
+	}
+
+	p := &pendingMigration{
+		MapMigration: MapMigration{Map: ms.Name, From: have, To: want},
+		old:          old,
+		spec:         ms,
+	}
+	if have != want {
+		if p.converters, err = converterChain(ms.Name, have, want); err != nil {
+			_ = old.Close()
+			return nil, fmt.Errorf("pinned map %s has schema %s but this build expects %s and %w; "+
+				"to carry the entries over, run `vinbero export` against the previous vinberod (or rely on settings.store), "+
+				"stop it, remove %s, then start this vinberod and `vinbero import` the snapshot "+
+				"(see docs/design/ja/persistence.md)",
+				ms.Name, have, want, err, filepath.Dir(pin))
+		}
+	}
+	return p, nil
+}
+
+// pinnedSchema returns the schema of a pinned map, looking its key and
+// value types up by the names ms uses. known is false when the pin
+// carries no BTF or the types were renamed, in which case only the sizes
+// are reported.
+func pinnedSchema(m *ebpf.Map, ms *ebpf.MapSpec) (schema string, known bool) {
+	unknown := mapSchema(nil, nil, m.KeySize(), m.ValueSize())
+	info, err := m.Info()
+	if err != nil {
+		return unknown, false
+	}
+	id, ok := info.BTFID()
+	if !ok {
+		return unknown, false
+	}
+	h, err := btf.NewHandleFromID(id)
+	if err != nil {
+		return unknown, false
+	}
+	defer func() { _ = h.Close() }()
+	types, err := h.Spec(nil)
+	if err != nil {
+		return unknown, false
+	}
+
+	// A name such as __u32 may appear several times in the object's
+	// BTF; any candidate with the expected layout is the one the map
+	// was created with.
+	lookup := func(t btf.Type) (string, bool) {
+		if t == nil || t.TypeName() == "" {
+			return "", false
+		}
+		found, err := types.AnyTypesByName(t.TypeName())
+		if err != nil || len(found) == 0 {
+			return "", false
+		}
+		want := layout(t, 0)
+		for _, c := range found {
+			if l := layout(c, 0); l == want {
+				return l, true
+			}
+		}
+		return layout(found[0], 0), true
+	}
+	key, ok := lookup(ms.Key)
+	if !ok {
+		return unknown, false
+	}
+	value, ok := lookup(ms.Value)
+	if !ok {
+		return unknown, false
+	}
+	return schemaOf(key, value), true
+}
+
+// mapSchema condenses a key/value layout into a short version string:
+// member names, offsets, sizes and integer encodings all count, type and
+// typedef names don't. Nil types fall back to the raw sizes.
+func mapSchema(key, value btf.Type, keySize, valueSize uint32) string {
+	return schemaOf(layout(key, keySize), layout(value, valueSize))
+}
+
+func schemaOf(key, value string) string {
+	sum := sha256.Sum256([]byte(key + "|" + value))
+	return hex.EncodeToString(sum[:6])
+}
+
+func layout(t btf.Type, size uint32) string {
+	var b strings.Builder
+	if t == nil {
+		fmt.Fprintf(&b, "raw%d", size)
+	} else {
+		layoutOf(&b, t)
+	}
+	return b.String()
+}
+
+func layoutOf(b *strings.Builder, t btf.Type) {
+	switch t := btf.UnderlyingType(t).(type) {
+	case *btf.Int:
+		fmt.Fprintf(b, "int%d/%d", t.Size*8, t.Encoding)
+	case *btf.Enum:
+		fmt.Fprintf(b, "enum%d", t.Size*8)
+	case *btf.Pointer:
+		b.WriteString("ptr")
+	case *btf.Array:
+		fmt.Fprintf(b, "[%d]", t.Nelems)
+		layoutOf(b, t.Type)
+	case *btf.Struct:
+		fmt.Fprintf(b, "struct%d{", t.Size)
+		members(b, t.Members)
+		b.WriteByte('}')
+	case *btf.Union:
+		fmt.Fprintf(b, "union%d{", t.Size)
+		members(b, t.Members)
+		b.WriteByte('}')
+	default:
+		size, err := btf.Sizeof(t)
+		if err != nil {
+			size = -1
+		}
+		fmt.Fprintf(b, "%T%d", t, size)
+	}
+}
+
+func members(b *strings.Builder, ms []btf.Member) {
+	for _, m := range ms {
+		fmt.Fprintf(b, "%s@%d", m.Name, m.Offset)
+		if m.BitfieldSize > 0 {
+			fmt.Fprintf(b, ":%d", m.BitfieldSize)
+		}
+		b.WriteByte('=')
+		layoutOf(b, m.Type)
+		b.WriteByte(';')
+	}
+}
+
+// converterChain finds converters leading from one schema of a map to
+// another.
+func converterChain(name, from, to string) ([]mapConverter, error) {
+	var chain []mapConverter
+	for cur := from; cur != to; {
+		if len(chain) == maxConverterChain {
+			return nil, fmt.Errorf("converter chain for %s exceeds %d steps", name, maxConverterChain)
+		}
+		found := false
+		for _, c := range mapConverters {
+			if c.Map == name && c.From == cur {
+				chain = append(chain, c)
+				cur, found = c.To, true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("no converter is registered from %s", cur)
+		}
+	}
+	return chain, nil
+}
+
+// keepPinnedCapacity raises ms to the capacity of the map pinned at pin
+// when that is larger, as planMigration does, so ReadCollection accepts
+// the pin.
+func keepPinnedCapacity(ms *ebpf.MapSpec, pin string) {
+	m, err := ebpf.LoadPinnedMap(pin, &ebpf.LoadPinOptions{ReadOnly: true})
+	if err != nil {
+		return
+	}
+	defer func() { _ = m.Close() }()
+	if m.MaxEntries() > ms.MaxEntries {
+		ms.MaxEntries = m.MaxEntries()
+	}
+}
+
+// run copies the pinned map into a new one built from the spec and pins
+// it in place of the old one.
+func (p *pendingMigration) run(pin string) error {
+	ms := p.spec.Copy()
+	ms.Pinning = ebpf.PinNone
+	m, err := ebpf.NewMap(ms)
+	if err != nil {
+		return fmt.Errorf("create map: %w", err)
+	}
+	defer func() { _ = m.Close() }()
+
+	if p.Entries, err = copyEntries(p.Map, p.old, m, p.converters); err != nil {
+		return err
+	}
+	return replacePin(m, pin)
+}
+
+// copyEntries puts every entry of the map name from src into dst, through
+// converters when given, and returns how many were put.
+func copyEntries(name string, src, dst *ebpf.Map, converters []mapConverter) (int, error) {
+	var err error
+	copied := 0
+	zero := make([]byte, src.ValueSize())
+	var key, value []byte
+	iter := src.Iterate()
+	for iter.Next(&key, &value) {
+		// Unused array slots are already zero in the new map, and may
+		// lie beyond a reduced capacity.
+		if src.Type() == ebpf.Array && bytes.Equal(value, zero) {
+			continue
+		}
+		k, v := key, value
+		for _, c := range converters {
+			if k, v, err = c.Convert(k, v); err != nil {
+				return copied, fmt.Errorf("convert %s -> %s: %w", c.From, c.To, err)
+			}
+			if k == nil {
+				break
+			}
+		}
+		if k == nil {
+			continue
+		}
+		if name == "fdb_map" {
+			unarmFdbEntry(v)
+		}
+		if err := dst.Put(k, v); err != nil {
+			return copied, fmt.Errorf("copy entry %d (capacity %d): %w", copied+1, dst.MaxEntries(), err)
+		}
+		copied++
+	}
+	if err := iter.Err(); err != nil {
+		return copied, fmt.Errorf("iterate map: %w", err)
+	}
+	return copied, nil
+}
+
+// unarmFdbEntry clears aging_armed in a raw fdb_entry. The kernel
+// doesn't copy bpf_timer state into another map, so a copied entry has no
+// timer running; unarmed, the data plane arms a new one on the next
+// refresh, and until then FDBWatcher's periodic sweep (AgeFdbEntries)
+// ages it, as after CreateFdb.
+func unarmFdbEntry(value []byte) {
+	if off := unsafe.Offsetof(FdbEntry{}.AgingArmed); uintptr(len(value)) > off {
+		value[off] = 0
+	}
+}
+
+// replacePin pins m at pin in place of whatever is pinned there. The
+// rename makes the switch atomic: a failure before it leaves the old pin
+// untouched.
+func replacePin(m *ebpf.Map, pin string) error {
+	// bpffs rejects names containing dots
+	tmp := pin + "_migrate"
+	_ = os.Remove(tmp)
+	if err := m.Pin(tmp); err != nil {
+		return fmt.Errorf("pin new map: %w", err)
+	}
+	if err := os.Rename(tmp, pin); err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("replace pin: %w", err)
+	}
+	return nil
+}
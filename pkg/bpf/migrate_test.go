@@ -0,0 +1,429 @@
+package bpf
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"testing"
+
+	"github.com/cilium/ebpf"
+	"github.com/cilium/ebpf/btf"
+	"github.com/takehaya/vinbero/pkg/config"
+)
+
+// testPinDir returns a fresh directory on bpffs, skipping the test when
+// /sys/fs/bpf is not bpffs-mounted.
+func testPinDir(t *testing.T) string {
+	t.Helper()
+	const bpffsRoot = "/sys/fs/bpf"
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(bpffsRoot, &stat); err != nil {
+		t.Skipf("bpffs not accessible at %s: %v", bpffsRoot, err)
+	}
+	const bpfFsMagic = 0xcafe4a11
+	if stat.Type != bpfFsMagic {
+		t.Skipf("%s is not bpffs (fstype=0x%x)", bpffsRoot, stat.Type)
+	}
+	name := strings.ReplaceAll(t.Name(), "/", "_")
+	dir := filepath.Join(bpffsRoot, fmt.Sprintf("vinbero-migrate-%d-%s", os.Getpid(), name))
+	_ = os.RemoveAll(dir)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+	return dir
+}
+
+// migrateTestSpec builds a hash map spec named like a pinned control map
+// whose value is struct dx2v_entry with the given u32 members.
+func migrateTestSpec(maxEntries uint32, fields ...string) *ebpf.MapSpec {
+	u32 := &btf.Typedef{Name: "__u32", Type: &btf.Int{Name: "unsigned int", Size: 4}}
+	value := &btf.Struct{Name: "dx2v_entry", Size: uint32(4 * len(fields))}
+	for i, f := range fields {
+		value.Members = append(value.Members, btf.Member{Name: f, Type: u32, Offset: btf.Bits(32 * i)})
+	}
+	return &ebpf.MapSpec{
+		Name:       "dx2v_map",
+		Type:       ebpf.Hash,
+		KeySize:    4,
+		ValueSize:  value.Size,
+		MaxEntries: maxEntries,
+		Key:        u32,
+		Value:      value,
+	}
+}
+
+// pinOld creates the map a previous release would have left behind.
+func pinOld(t *testing.T, dir string, ms *ebpf.MapSpec, entries map[uint32][]byte) {
+	t.Helper()
+	m, err := ebpf.NewMap(ms)
+	if err != nil {
+		t.Fatalf("create old map: %v", err)
+	}
+	defer func() { _ = m.Close() }()
+	for k, v := range entries {
+		if err := m.Put(k, v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := m.Pin(filepath.Join(dir, ms.Name)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func u32s(vs ...uint32) []byte {
+	b := make([]byte, 0, 4*len(vs))
+	for _, v := range vs {
+		b = binary.NativeEndian.AppendUint32(b, v)
+	}
+	return b
+}
+
+func TestMigratePinnedMaps(t *testing.T) {
+	v1 := migrateTestSpec(16, "oif")
+	v2 := migrateTestSpec(16, "oif", "vlan")
+	entries := map[uint32][]byte{1: u32s(10), 2: u32s(20)}
+
+	t.Run("unchanged", func(t *testing.T) {
+		dir := testPinDir(t)
+		pinOld(t, dir, v1, entries)
+		done, err := migratePinnedMaps(&ebpf.CollectionSpec{Maps: map[string]*ebpf.MapSpec{"dx2v_map": v1}}, dir)
+		if err != nil || len(done) != 0 {
+			t.Fatalf("got %v, %v; want no migration", done, err)
+		}
+	})
+
+	t.Run("capacity", func(t *testing.T) {
+		dir := testPinDir(t)
+		pinOld(t, dir, v1, entries)
+		bigger := migrateTestSpec(64, "oif")
+		done, err := migratePinnedMaps(&ebpf.CollectionSpec{Maps: map[string]*ebpf.MapSpec{"dx2v_map": bigger}}, dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(done) != 1 || done[0].Entries != 2 || done[0].From != done[0].To {
+			t.Fatalf("got %+v; want one identity copy of 2 entries", done)
+		}
+		m, err := ebpf.LoadPinnedMap(filepath.Join(dir, "dx2v_map"), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer func() { _ = m.Close() }()
+		if err := bigger.Compatible(m); err != nil {
+			t.Errorf("re-pinned map: %v", err)
+		}
+		var v []byte
+		if err := m.Lookup(uint32(2), &v); err != nil || string(v) != string(u32s(20)) {
+			t.Errorf("entry 2: got %v, %v", v, err)
+		}
+	})
+
+	t.Run("layout without converter", func(t *testing.T) {
+		dir := testPinDir(t)
+		pinOld(t, dir, v1, entries)
+		_, err := migratePinnedMaps(&ebpf.CollectionSpec{Maps: map[string]*ebpf.MapSpec{"dx2v_map": v2}}, dir)
+		if err == nil || !strings.Contains(err.Error(), "no converter") || !strings.Contains(err.Error(), "vinbero export") {
+			t.Fatalf("got %v; want a no-converter error with instructions", err)
+		}
+		// The old pin must be left alone
+		m, err := ebpf.LoadPinnedMap(filepath.Join(dir, "dx2v_map"), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer func() { _ = m.Close() }()
+		if err := v1.Compatible(m); err != nil {
+			t.Errorf("old pin was replaced: %v", err)
+		}
+	})
+
+	t.Run("layout with converter", func(t *testing.T) {
+		dir := testPinDir(t)
+		pinOld(t, dir, v1, entries)
+		from := mapSchema(v1.Key, v1.Value, v1.KeySize, v1.ValueSize)
+		to := mapSchema(v2.Key, v2.Value, v2.KeySize, v2.ValueSize)
+		saved := mapConverters
+		t.Cleanup(func() { mapConverters = saved })
+		mapConverters = append(mapConverters, mapConverter{
+			Map: "dx2v_map", From: from, To: to,
+			Convert: func(key, value []byte) ([]byte, []byte, error) {
+				if binary.NativeEndian.Uint32(key) == 2 {
+					return nil, nil, nil
+				}
+				return key, append(value, u32s(100)...), nil
+			},
+		})
+
+		done, err := migratePinnedMaps(&ebpf.CollectionSpec{Maps: map[string]*ebpf.MapSpec{"dx2v_map": v2}}, dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(done) != 1 || done[0].From != from || done[0].To != to || done[0].Entries != 1 {
+			t.Fatalf("got %+v; want %s -> %s with 1 entry", done, from, to)
+		}
+		m, err := ebpf.LoadPinnedMap(filepath.Join(dir, "dx2v_map"), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer func() { _ = m.Close() }()
+		var v []byte
+		if err := m.Lookup(uint32(1), &v); err != nil || string(v) != string(u32s(10, 100)) {
+			t.Errorf("entry 1: got %v, %v", v, err)
+		}
+		if err := m.Lookup(uint32(2), &v); err == nil {
+			t.Error("entry 2 should have been dropped")
+		}
+		if _, err := os.Stat(filepath.Join(dir, "dx2v_map_migrate")); !os.IsNotExist(err) {
+			t.Errorf("temporary pin left behind: %v", err)
+		}
+	})
+}
+
+func TestMapSchema(t *testing.T) {
+	a := migrateTestSpec(16, "oif")
+	b := migrateTestSpec(16, "oif")
+	if mapSchema(a.Key, a.Value, 4, 4) != mapSchema(b.Key, b.Value, 4, 4) {
+		t.Error("identical layouts should share a schema")
+	}
+	renamed := migrateTestSpec(16, "ifindex")
+	if mapSchema(a.Key, a.Value, 4, 4) == mapSchema(renamed.Key, renamed.Value, 4, 4) {
+		t.Error("a renamed member should change the schema")
+	}
+}
+
+// TestMigratePinnedMaps_Collection runs the migration against maps pinned
+// by ReadCollection: an unchanged build reuses them, a larger capacity
+// re-pins the affected maps and the next load accepts them.
+func TestMigratePinnedMaps_Collection(t *testing.T) {
+	dir := testPinDir(t)
+	cfg := &config.Config{
+		Setting: config.SettingConfig{
+			PinMaps: config.PinMapsConfig{Enabled: true, Path: dir},
+		},
+	}
+
+	objs, err := ReadCollection(nil, cfg)
+	if err != nil {
+		t.Fatalf("initial ReadCollection: %v", err)
+	}
+	if err := objs.Dx2vMap.Put(uint32(7), BpfDx2vEntry{Oif: 3}); err != nil {
+		t.Fatal(err)
+	}
+	if err := objs.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	done, err := MigratePinnedMaps(cfg)
+	if err != nil || len(done) != 0 {
+		t.Fatalf("unchanged build: got %+v, %v; want no migration", done, err)
+	}
+
+	cfg.Setting.Entries.VlanTable.Capacity = 8192
+	done, err = MigratePinnedMaps(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(done) != 1 || done[0].Map != "dx2v_map" || done[0].Entries != 1 {
+		t.Fatalf("got %+v; want dx2v_map migrated with 1 entry", done)
+	}
+
+	objs, err = ReadCollection(nil, cfg)
+	if err != nil {
+		t.Fatalf("ReadCollection after migration: %v", err)
+	}
+	defer func() { _ = objs.Close() }()
+	var got BpfDx2vEntry
+	if err := objs.Dx2vMap.Lookup(uint32(7), &got); err != nil || got.Oif != 3 {
+		t.Errorf("migrated entry: got %+v, %v", got, err)
+	}
+	if objs.Dx2vMap.MaxEntries() != 8192 {
+		t.Errorf("capacity: got %d, want 8192", objs.Dx2vMap.MaxEntries())
+	}
+}
+
+// baselineLayouts are the value layouts of the first release with
+// pin_maps, written out in BTF so the converters registered for them run
+// against maps shaped exactly like the pins such a node left behind.
+func baselineLayouts() (values map[string]*btf.Struct, l2Key *btf.Struct) {
+	u8 := &btf.Int{Name: "__u8", Size: 1}
+	u16 := &btf.Int{Name: "__u16", Size: 2}
+	u32 := &btf.Int{Name: "__u32", Size: 4}
+	u64 := &btf.Int{Name: "__u64", Size: 8}
+	arr := func(n uint32, t btf.Type) *btf.Array { return &btf.Array{Index: u32, Type: t, Nelems: n} }
+	member := func(name string, off uint32, t btf.Type) btf.Member {
+		return btf.Member{Name: name, Type: t, Offset: btf.Bits(8 * off)}
+	}
+	st := func(size uint32, ms ...btf.Member) *btf.Struct { return &btf.Struct{Size: size, Members: ms} }
+
+	headend := st(200,
+		member("mode", 0, u8),
+		member("num_segments", 1, u8),
+		member("_pad", 2, arr(2, u8)),
+		member("src_addr", 4, arr(16, u8)),
+		member("dst_addr", 20, arr(16, u8)),
+		member("segments", 36, arr(10, arr(16, u8))),
+		member("bd_id", 196, u16),
+		member("args_offset", 198, u8),
+		member("_pad_gtp", 199, u8),
+	)
+	aux := &btf.Union{Size: 200, Members: []btf.Member{
+		member("nexthop", 0, st(16, member("nexthop", 0, arr(16, u8)))),
+		member("l2", 0, st(8, member("bd_id", 0, u16), member("_pad", 2, u16), member("bridge_ifindex", 4, u32))),
+		member("dx2v", 0, st(4, member("table_id", 0, u16), member("_pad", 2, u16))),
+		member("gtp4e", 0, st(8, member("args_offset", 0, u8), member("gtp_v4_src_addr", 1, arr(4, u8)), member("_pad", 5, arr(3, u8)))),
+		member("gtp6d", 0, st(8, member("args_offset", 0, u8), member("_pad", 1, arr(7, u8)))),
+		member("gtp6e", 0, st(40,
+			member("args_offset", 0, u8), member("_pad", 1, arr(7, u8)),
+			member("src_addr", 8, arr(16, u8)), member("dst_addr", 24, arr(16, u8)))),
+		member("b6_policy", 0, headend),
+		member("l3vrf", 0, st(4, member("vrf_ifindex", 0, u32))),
+		member("plugin_raw", 0, arr(196, u8)),
+	}}
+
+	values = map[string]*btf.Struct{
+		"sid_function_map": st(4, member("action", 0, u8), member("flavor", 1, u8), member("aux_index", 2, u16)),
+		"sid_aux_map":      st(200, member("", 0, aux)),
+		"headend_v4_map":   headend,
+		"headend_v6_map":   headend,
+		"headend_l2_map":   headend,
+		"bd_peer_map":      headend,
+		"fdb_map": st(32,
+			member("oif", 0, u32),
+			member("is_remote", 4, u8),
+			member("is_static", 5, u8),
+			member("peer_index", 6, u16),
+			member("bd_id", 8, u16),
+			member("_pad", 10, arr(2, u8)),
+			member("last_seen", 12, u64),
+			member("esi", 20, arr(10, u8)),
+			member("_pad_esi", 30, arr(2, u8)),
+		),
+		"dx2v_map": st(4, member("oif", 0, u32)),
+	}
+	l2Key = st(8, member("ifindex", 0, u32), member("vlan_id", 4, u16), member("_pad", 6, arr(2, u8)))
+	return values, l2Key
+}
+
+// TestMigratePinnedMaps_Baseline pins one entry in every map whose layout
+// changed since the first release with pin_maps and checks that the
+// registered converters carry it over: old fields keep their bytes, and
+// fields that took the place of padding or were appended read as 0.
+func TestMigratePinnedMaps_Baseline(t *testing.T) {
+	dir := testPinDir(t)
+	spec, err := LoadBpf()
+	if err != nil {
+		t.Fatal(err)
+	}
+	values, l2Key := baselineLayouts()
+
+	// Bytes of the current value that the conversion must leave at 0
+	zeroed := map[string][]int{
+		"headend_v4_map": {2, 3, 199},
+		"headend_v6_map": {2, 3, 199},
+		"headend_l2_map": {2, 3, 199},
+		"bd_peer_map":    {2, 3, 199},
+		"fdb_map":        {10, 11, 30, 31},
+	}
+
+	current := &ebpf.CollectionSpec{Maps: map[string]*ebpf.MapSpec{}}
+	keys := map[string][]byte{}
+	olds := map[string][]byte{}
+	for name, value := range values {
+		cur := spec.Maps[name]
+		current.Maps[name] = cur
+
+		old := cur.Copy()
+		old.Pinning = ebpf.PinNone
+		old.Value = value
+		old.ValueSize = value.Size
+		value.Name = cur.Value.TypeName()
+		if name == "headend_l2_map" {
+			l2Key.Name = cur.Key.TypeName()
+			old.Key = l2Key
+		}
+
+		key := make([]byte, old.KeySize)
+		switch old.Type {
+		case ebpf.LPMTrie:
+			key[0] = 8 // prefixlen
+		case ebpf.Array:
+			key[0] = 1
+		default:
+			key[0], key[1] = 1, 2
+		}
+		v := make([]byte, old.ValueSize)
+		for i := range v {
+			v[i] = byte(i + 1)
+		}
+		for _, off := range zeroed[name] {
+			if off < len(v) {
+				v[off] = 0 // Padding was written as 0
+			}
+		}
+
+		m, err := ebpf.NewMap(old)
+		if err != nil {
+			t.Fatalf("%s: create old map: %v", name, err)
+		}
+		if err := m.Put(key, v); err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if err := m.Pin(filepath.Join(dir, name)); err != nil {
+			t.Fatal(err)
+		}
+		_ = m.Close()
+		keys[name], olds[name] = key, v
+	}
+
+	done, err := migratePinnedMaps(current, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(done) != len(values) {
+		t.Fatalf("migrated %+v; want all of %d maps", done, len(values))
+	}
+	for _, d := range done {
+		if d.Entries != 1 || d.From == d.To {
+			t.Errorf("%s: got %+v; want 1 entry converted", d.Map, d)
+		}
+	}
+
+	for name, cur := range current.Maps {
+		m, err := ebpf.LoadPinnedMap(filepath.Join(dir, name), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := cur.Compatible(m); err != nil {
+			t.Errorf("%s: re-pinned map: %v", name, err)
+		}
+		var v []byte
+		err = m.Lookup(keys[name], &v)
+		_ = m.Close()
+		if err != nil {
+			t.Errorf("%s: lookup: %v", name, err)
+			continue
+		}
+		want := make([]byte, cur.ValueSize)
+		copy(want, olds[name])
+		if string(v) != string(want) {
+			t.Errorf("%s: got %v, want %v", name, v, want)
+		}
+	}
+
+	// Spot-check through the generated types
+	m, err := ebpf.LoadPinnedMap(filepath.Join(dir, "fdb_map"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = m.Close() }()
+	var fdb BpfFdbEntry
+	if err := m.Lookup(keys["fdb_map"], &fdb); err != nil {
+		t.Fatal(err)
+	}
+	if fdb.Oif != binary.NativeEndian.Uint32(olds["fdb_map"]) || fdb.Esi[0] != 21 ||
+		fdb.AgingArmed != 0 || fdb.Vtep != 0 || fdb.AcVlanId != 0 || fdb.Rewrite.Op != 0 {
+		t.Errorf("fdb entry: %+v", fdb)
+	}
+}
@@ -0,0 +1,319 @@
+package bpf
+
+import (
+	"net"
+	"testing"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+)
+
+var (
+	neighHostMAC  = net.HardwareAddr{0x02, 0x00, 0x00, 0x00, 0x00, 0x01}
+	neighKnownMAC = net.HardwareAddr{0x02, 0x00, 0x00, 0x00, 0x00, 0x10}
+	neighBcastMAC = net.HardwareAddr{0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+)
+
+// buildNeighARP returns a VLAN 100 ARP frame from neighHostMAC.
+func buildNeighARP(t *testing.T, dst net.HardwareAddr, op uint16, spa, tpa string) []byte {
+	t.Helper()
+	eth := &layers.Ethernet{SrcMAC: neighHostMAC, DstMAC: dst, EthernetType: layers.EthernetTypeDot1Q}
+	vlan := &layers.Dot1Q{VLANIdentifier: macLimitVlanID, Type: layers.EthernetTypeARP}
+	arp := &layers.ARP{
+		AddrType:          layers.LinkTypeEthernet,
+		Protocol:          layers.EthernetTypeIPv4,
+		HwAddressSize:     6,
+		ProtAddressSize:   4,
+		Operation:         op,
+		SourceHwAddress:   neighHostMAC,
+		SourceProtAddress: net.ParseIP(spa).To4(),
+		DstHwAddress:      make([]byte, 6),
+		DstProtAddress:    net.ParseIP(tpa).To4(),
+	}
+	buf := gopacket.NewSerializeBuffer()
+	if err := gopacket.SerializeLayers(buf, gopacket.SerializeOptions{}, eth, vlan, arp); err != nil {
+		t.Fatalf("serialize ARP: %v", err)
+	}
+	return buf.Bytes()
+}
+
+// buildNeighNS returns a VLAN 100 Neighbor Solicitation for target with an
+// SLLA option, sent to the solicited-node multicast group.
+func buildNeighNS(t *testing.T, src, target string) []byte {
+	t.Helper()
+	tgt := net.ParseIP(target)
+	group := net.ParseIP("ff02::1:ff00:0")
+	copy(group[13:], tgt[13:])
+	eth := &layers.Ethernet{
+		SrcMAC:       neighHostMAC,
+		DstMAC:       net.HardwareAddr{0x33, 0x33, 0xff, tgt[13], tgt[14], tgt[15]},
+		EthernetType: layers.EthernetTypeDot1Q,
+	}
+	vlan := &layers.Dot1Q{VLANIdentifier: macLimitVlanID, Type: layers.EthernetTypeIPv6}
+	ip6 := &layers.IPv6{
+		Version: 6, HopLimit: 255, NextHeader: layers.IPProtocolICMPv6,
+		SrcIP: net.ParseIP(src), DstIP: group,
+	}
+	icmp := &layers.ICMPv6{TypeCode: layers.CreateICMPv6TypeCode(layers.ICMPv6TypeNeighborSolicitation, 0)}
+	_ = icmp.SetNetworkLayerForChecksum(ip6)
+	ns := &layers.ICMPv6NeighborSolicitation{TargetAddress: tgt}
+	if !net.ParseIP(src).IsUnspecified() {
+		ns.Options = layers.ICMPv6Options{{Type: layers.ICMPv6OptSourceAddress, Data: neighHostMAC}}
+	}
+	buf := gopacket.NewSerializeBuffer()
+	opts := gopacket.SerializeOptions{ComputeChecksums: true, FixLengths: true}
+	if err := gopacket.SerializeLayers(buf, opts, eth, vlan, ip6, icmp, ns); err != nil {
+		t.Fatalf("serialize NS: %v", err)
+	}
+	return buf.Bytes()
+}
+
+// buildNeighNA returns a VLAN 100 unsolicited NA for target with a TLLA option.
+func buildNeighNA(t *testing.T, target string, mac net.HardwareAddr) []byte {
+	t.Helper()
+	eth := &layers.Ethernet{
+		SrcMAC:       mac,
+		DstMAC:       net.HardwareAddr{0x33, 0x33, 0, 0, 0, 1},
+		EthernetType: layers.EthernetTypeDot1Q,
+	}
+	vlan := &layers.Dot1Q{VLANIdentifier: macLimitVlanID, Type: layers.EthernetTypeIPv6}
+	ip6 := &layers.IPv6{
+		Version: 6, HopLimit: 255, NextHeader: layers.IPProtocolICMPv6,
+		SrcIP: net.ParseIP(target), DstIP: net.ParseIP("ff02::1"),
+	}
+	icmp := &layers.ICMPv6{TypeCode: layers.CreateICMPv6TypeCode(layers.ICMPv6TypeNeighborAdvertisement, 0)}
+	_ = icmp.SetNetworkLayerForChecksum(ip6)
+	na := &layers.ICMPv6NeighborAdvertisement{
+		Flags:         0x20,
+		TargetAddress: net.ParseIP(target),
+		Options:       layers.ICMPv6Options{{Type: layers.ICMPv6OptTargetAddress, Data: mac}},
+	}
+	buf := gopacket.NewSerializeBuffer()
+	opts := gopacket.SerializeOptions{ComputeChecksums: true, FixLengths: true}
+	if err := gopacket.SerializeLayers(buf, opts, eth, vlan, ip6, icmp, na); err != nil {
+		t.Fatalf("serialize NA: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func getNeighbor(t *testing.T, h *xdpTestHelper, ip string) (NeighEntry, bool) {
+	t.Helper()
+	key, err := NeighborKey(macLimitBdID, net.ParseIP(ip))
+	if err != nil {
+		t.Fatalf("NeighborKey: %v", err)
+	}
+	var entry NeighEntry
+	if err := h.objs.NeighMap.Lookup(&key, &entry); err != nil {
+		return entry, false
+	}
+	return entry, true
+}
+
+func TestNeighSuppressARP(t *testing.T) {
+	t.Run("known address is answered", func(t *testing.T) {
+		h := newXDPTestHelperWithStats(t)
+		setupMacLimitBD(t, h)
+		if err := h.mapOps.SetNeighbor(macLimitBdID, net.ParseIP("10.0.0.10"), neighKnownMAC, NeighOriginStatic); err != nil {
+			t.Fatalf("SetNeighbor: %v", err)
+		}
+
+		ret, out := h.run(buildNeighARP(t, neighBcastMAC, layers.ARPRequest, "10.0.0.1", "10.0.0.10"))
+		if ret != XDP_TX {
+			t.Fatalf("expected XDP_TX, got %d", ret)
+		}
+		p := gopacket.NewPacket(out, layers.LayerTypeEthernet, gopacket.Default)
+		eth := p.Layer(layers.LayerTypeEthernet).(*layers.Ethernet)
+		if eth.DstMAC.String() != neighHostMAC.String() || eth.SrcMAC.String() != neighKnownMAC.String() {
+			t.Errorf("ethernet: got %s -> %s", eth.SrcMAC, eth.DstMAC)
+		}
+		if vlan, ok := p.Layer(layers.LayerTypeDot1Q).(*layers.Dot1Q); !ok || vlan.VLANIdentifier != macLimitVlanID {
+			t.Errorf("reply lost its VLAN %d tag", macLimitVlanID)
+		}
+		arp, ok := p.Layer(layers.LayerTypeARP).(*layers.ARP)
+		if !ok {
+			t.Fatal("no ARP layer in reply")
+		}
+		if arp.Operation != layers.ARPReply {
+			t.Errorf("operation: got %d, want reply", arp.Operation)
+		}
+		if net.HardwareAddr(arp.SourceHwAddress).String() != neighKnownMAC.String() ||
+			!net.IP(arp.SourceProtAddress).Equal(net.ParseIP("10.0.0.10")) {
+			t.Errorf("sender: got %s/%s", net.HardwareAddr(arp.SourceHwAddress), net.IP(arp.SourceProtAddress))
+		}
+		if net.HardwareAddr(arp.DstHwAddress).String() != neighHostMAC.String() ||
+			!net.IP(arp.DstProtAddress).Equal(net.ParseIP("10.0.0.1")) {
+			t.Errorf("target: got %s/%s", net.HardwareAddr(arp.DstHwAddress), net.IP(arp.DstProtAddress))
+		}
+		if got := readStatCounter(t, h, "NEIGH_SUPPRESS"); got != 1 {
+			t.Errorf("NEIGH_SUPPRESS: got %d, want 1", got)
+		}
+	})
+
+	t.Run("unknown address is flooded", func(t *testing.T) {
+		h := newXDPTestHelperWithStats(t)
+		setupMacLimitBD(t, h)
+
+		ret, _ := h.run(buildNeighARP(t, neighBcastMAC, layers.ARPRequest, "10.0.0.1", "10.0.0.99"))
+		if ret != XDP_PASS {
+			t.Errorf("expected XDP_PASS (flood), got %d", ret)
+		}
+		if got := readStatCounter(t, h, "NEIGH_SUPPRESS"); got != 0 {
+			t.Errorf("NEIGH_SUPPRESS: got %d, want 0", got)
+		}
+	})
+
+	t.Run("probe is flooded", func(t *testing.T) {
+		h := newXDPTestHelper(t)
+		setupMacLimitBD(t, h)
+		if err := h.mapOps.SetNeighbor(macLimitBdID, net.ParseIP("10.0.0.10"), neighKnownMAC, NeighOriginStatic); err != nil {
+			t.Fatalf("SetNeighbor: %v", err)
+		}
+		if ret, _ := h.run(buildNeighARP(t, neighBcastMAC, layers.ARPRequest, "0.0.0.0", "10.0.0.10")); ret != XDP_PASS {
+			t.Errorf("expected XDP_PASS for an ARP probe, got %d", ret)
+		}
+	})
+
+	t.Run("reply is snooped", func(t *testing.T) {
+		h := newXDPTestHelper(t)
+		setupMacLimitBD(t, h)
+
+		h.run(buildNeighARP(t, macN(9), layers.ARPReply, "10.0.0.1", "10.0.0.9"))
+		entry, ok := getNeighbor(t, h, "10.0.0.1")
+		if !ok {
+			t.Fatal("10.0.0.1 not snooped")
+		}
+		if net.HardwareAddr(entry.Mac[:]).String() != neighHostMAC.String() || entry.Origin != NeighOriginSnoop {
+			t.Errorf("snooped entry: got %s origin %d", net.HardwareAddr(entry.Mac[:]), entry.Origin)
+		}
+	})
+
+	t.Run("snooping keeps static entries", func(t *testing.T) {
+		h := newXDPTestHelper(t)
+		setupMacLimitBD(t, h)
+		if err := h.mapOps.SetNeighbor(macLimitBdID, net.ParseIP("10.0.0.1"), neighKnownMAC, NeighOriginStatic); err != nil {
+			t.Fatalf("SetNeighbor: %v", err)
+		}
+
+		h.run(buildNeighARP(t, neighBcastMAC, layers.ARPRequest, "10.0.0.1", "10.0.0.1")) // GARP
+		entry, _ := getNeighbor(t, h, "10.0.0.1")
+		if net.HardwareAddr(entry.Mac[:]).String() != neighKnownMAC.String() || entry.Origin != NeighOriginStatic {
+			t.Errorf("static entry replaced: got %s origin %d", net.HardwareAddr(entry.Mac[:]), entry.Origin)
+		}
+	})
+}
+
+func TestNeighSuppressND(t *testing.T) {
+	t.Run("known target is answered", func(t *testing.T) {
+		h := newXDPTestHelperWithStats(t)
+		setupMacLimitBD(t, h)
+		if err := h.mapOps.SetNeighbor(macLimitBdID, net.ParseIP("2001:db8::10"), neighKnownMAC, NeighOriginEvpn); err != nil {
+			t.Fatalf("SetNeighbor: %v", err)
+		}
+
+		ret, out := h.run(buildNeighNS(t, "2001:db8::1", "2001:db8::10"))
+		if ret != XDP_TX {
+			t.Fatalf("expected XDP_TX, got %d", ret)
+		}
+		p := gopacket.NewPacket(out, layers.LayerTypeEthernet, gopacket.Default)
+		eth := p.Layer(layers.LayerTypeEthernet).(*layers.Ethernet)
+		if eth.DstMAC.String() != neighHostMAC.String() || eth.SrcMAC.String() != neighKnownMAC.String() {
+			t.Errorf("ethernet: got %s -> %s", eth.SrcMAC, eth.DstMAC)
+		}
+		ip6, ok := p.Layer(layers.LayerTypeIPv6).(*layers.IPv6)
+		if !ok {
+			t.Fatal("no IPv6 layer in reply")
+		}
+		if !ip6.SrcIP.Equal(net.ParseIP("2001:db8::10")) || !ip6.DstIP.Equal(net.ParseIP("2001:db8::1")) {
+			t.Errorf("addresses: got %s -> %s", ip6.SrcIP, ip6.DstIP)
+		}
+		if ip6.HopLimit != 255 {
+			t.Errorf("hop limit: got %d, want 255", ip6.HopLimit)
+		}
+		msg := ip6.Payload
+		if len(msg) != 32 {
+			t.Fatalf("NA length: got %d, want 32", len(msg))
+		}
+		if msg[0] != uint8(layers.ICMPv6TypeNeighborAdvertisement) || msg[4] != 0x60 {
+			t.Errorf("type/flags: got %d/%#x, want NA/0x60", msg[0], msg[4])
+		}
+		if !net.IP(msg[8:24]).Equal(net.ParseIP("2001:db8::10")) {
+			t.Errorf("target: got %s", net.IP(msg[8:24]))
+		}
+		if msg[24] != uint8(layers.ICMPv6OptTargetAddress) || msg[25] != 1 ||
+			net.HardwareAddr(msg[26:32]).String() != neighKnownMAC.String() {
+			t.Errorf("TLLA option: got % x", msg[24:32])
+		}
+		if !icmpv6ChecksumOK(ip6.SrcIP, ip6.DstIP, msg) {
+			t.Error("ICMPv6 checksum invalid")
+		}
+		if got := readStatCounter(t, h, "NEIGH_SUPPRESS"); got != 1 {
+			t.Errorf("NEIGH_SUPPRESS: got %d, want 1", got)
+		}
+	})
+
+	t.Run("DAD is flooded", func(t *testing.T) {
+		h := newXDPTestHelper(t)
+		setupMacLimitBD(t, h)
+		if err := h.mapOps.SetNeighbor(macLimitBdID, net.ParseIP("2001:db8::10"), neighKnownMAC, NeighOriginStatic); err != nil {
+			t.Fatalf("SetNeighbor: %v", err)
+		}
+		if ret, _ := h.run(buildNeighNS(t, "::", "2001:db8::10")); ret != XDP_PASS {
+			t.Errorf("expected XDP_PASS for DAD, got %d", ret)
+		}
+	})
+
+	t.Run("NA is snooped", func(t *testing.T) {
+		h := newXDPTestHelper(t)
+		setupMacLimitBD(t, h)
+
+		h.run(buildNeighNA(t, "2001:db8::20", macN(0x20)))
+		entry, ok := getNeighbor(t, h, "2001:db8::20")
+		if !ok {
+			t.Fatal("2001:db8::20 not snooped")
+		}
+		if net.HardwareAddr(entry.Mac[:]).String() != macN(0x20).String() || entry.Origin != NeighOriginSnoop {
+			t.Errorf("snooped entry: got %s origin %d", net.HardwareAddr(entry.Mac[:]), entry.Origin)
+		}
+	})
+}
+
+func TestNeighborMapOperations(t *testing.T) {
+	h := newXDPTestHelper(t)
+	ip := net.ParseIP("10.0.0.10")
+
+	if err := h.mapOps.SetNeighbor(macLimitBdID, ip, neighKnownMAC, NeighOriginStatic); err != nil {
+		t.Fatalf("SetNeighbor: %v", err)
+	}
+	if err := h.mapOps.LearnNeighbor(macLimitBdID, ip, macN(1), NeighOriginEvpn); err != nil {
+		t.Fatalf("LearnNeighbor: %v", err)
+	}
+	if entry, _ := getNeighbor(t, h, "10.0.0.10"); entry.Origin != NeighOriginStatic {
+		t.Errorf("LearnNeighbor replaced a static entry (origin %d)", entry.Origin)
+	}
+	if err := h.mapOps.WithdrawNeighbor(macLimitBdID, ip, NeighOriginEvpn); err != nil {
+		t.Fatalf("WithdrawNeighbor: %v", err)
+	}
+	if _, ok := getNeighbor(t, h, "10.0.0.10"); !ok {
+		t.Error("WithdrawNeighbor removed a static entry")
+	}
+
+	if err := h.mapOps.SetNeighbor(200, net.ParseIP("2001:db8::1"), neighKnownMAC, NeighOriginStatic); err != nil {
+		t.Fatalf("SetNeighbor: %v", err)
+	}
+	n, err := h.mapOps.FlushNeighbors(macLimitBdID)
+	if err != nil || n != 1 {
+		t.Fatalf("FlushNeighbors: got %d, %v; want 1", n, err)
+	}
+	all, err := h.mapOps.ListNeighbors()
+	if err != nil {
+		t.Fatalf("ListNeighbors: %v", err)
+	}
+	if len(all) != 1 {
+		t.Fatalf("expected 1 neighbor left, got %d", len(all))
+	}
+	for key := range all {
+		if key.BdId != 200 || NeighborIP(key).String() != "2001:db8::1" {
+			t.Errorf("unexpected neighbor bd=%d ip=%s", key.BdId, NeighborIP(key))
+		}
+	}
+}
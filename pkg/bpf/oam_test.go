@@ -0,0 +1,218 @@
+package bpf
+
+import (
+	"encoding/binary"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/cilium/ebpf/perf"
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	vinberov1 "github.com/takehaya/vinbero/api/vinbero/v1"
+)
+
+const actionEndOP = uint8(vinberov1.Srv6LocalAction_SRV6_LOCAL_ACTION_END_OP)
+
+// Offsets into buildSRv6Packet output (untagged, two segments)
+const (
+	oamHopLimitOffset = ethHeaderLen + 7
+	oamSRHFlagsOffset = ethHeaderLen + ipv6HeaderLen + 5
+	oamUpperOffset    = ethHeaderLen + ipv6HeaderLen + srhBaseLen + 2*ipv6AddrLen
+)
+
+// buildOamTestPacket returns an End-bound SRv6 packet (SL=1) to the SID
+// fd00:1:100::2 with the given hop limit and SRH flags.
+func buildOamTestPacket(t *testing.T, hopLimit, srhFlags uint8) []byte {
+	t.Helper()
+	pkt, err := buildSRv6Packet(net.ParseIP("fd00:1::1"), net.ParseIP("fd00:1:100::2"),
+		[]net.IP{net.ParseIP("fd00:1:100::3"), net.ParseIP("fd00:1:100::2")}, 1)
+	if err != nil {
+		t.Fatalf("buildSRv6Packet: %v", err)
+	}
+	pkt[oamHopLimitOffset] = hopLimit
+	pkt[oamSRHFlagsOffset] = srhFlags
+	return pkt
+}
+
+func readStatCounter(t *testing.T, h *xdpTestHelper, name string) uint64 {
+	t.Helper()
+	stats, err := h.mapOps.ReadStats()
+	if err != nil {
+		t.Fatalf("ReadStats: %v", err)
+	}
+	for _, s := range stats {
+		if s.Name == name {
+			return s.Packets
+		}
+	}
+	t.Fatalf("stats counter %q not found", name)
+	return 0
+}
+
+// icmpv6Checksum verifies an ICMPv6 message against its pseudo-header;
+// a correct message sums to 0xffff.
+func icmpv6ChecksumOK(src, dst net.IP, msg []byte) bool {
+	var sum uint32
+	add := func(b []byte) {
+		for i := 0; i+1 < len(b); i += 2 {
+			sum += uint32(binary.BigEndian.Uint16(b[i:]))
+		}
+		if len(b)%2 == 1 {
+			sum += uint32(b[len(b)-1]) << 8
+		}
+	}
+	add(src.To16())
+	add(dst.To16())
+	var l [8]byte
+	binary.BigEndian.PutUint32(l[0:4], uint32(len(msg)))
+	l[7] = 58
+	add(l[:])
+	add(msg)
+	for sum>>16 != 0 {
+		sum = (sum & 0xffff) + (sum >> 16)
+	}
+	return sum == 0xffff
+}
+
+// TestXDPProgEndHopLimit verifies RFC 8986 Sec.4.1 hop limit handling:
+// End decrements the hop limit, and a packet arriving with hop limit 1 is
+// answered with an ICMPv6 Time Exceeded sourced from the SID.
+func TestXDPProgEndHopLimit(t *testing.T) {
+	t.Run("decrement", func(t *testing.T) {
+		h := newXDPTestHelper(t)
+		h.createSidFunction("fd00:1:100::2/128", actionEnd)
+
+		ret, out := h.run(buildOamTestPacket(t, 64, 0))
+		if ret != XDP_PASS {
+			t.Fatalf("expected XDP_PASS, got %d", ret)
+		}
+		verifyDAAndSL(t, out, "fd00:1:100::3", 1)
+		if got := out[oamHopLimitOffset]; got != 63 {
+			t.Errorf("hop limit: got %d, want 63", got)
+		}
+	})
+
+	t.Run("expiry sends time exceeded", func(t *testing.T) {
+		h := newXDPTestHelperWithStats(t)
+		h.createSidFunction("fd00:1:100::2/128", actionEnd)
+
+		in := buildOamTestPacket(t, 1, 0)
+		ret, out := h.run(in)
+		if ret != XDP_PASS {
+			t.Fatalf("expected XDP_PASS (no FIB route in test netns), got %d", ret)
+		}
+
+		p := gopacket.NewPacket(out, layers.LayerTypeEthernet, gopacket.Default)
+		ip6, ok := p.Layer(layers.LayerTypeIPv6).(*layers.IPv6)
+		if !ok {
+			t.Fatalf("no IPv6 layer in output")
+		}
+		if !ip6.SrcIP.Equal(net.ParseIP("fd00:1:100::2")) || !ip6.DstIP.Equal(net.ParseIP("fd00:1::1")) {
+			t.Errorf("addresses: got %s -> %s, want fd00:1:100::2 -> fd00:1::1", ip6.SrcIP, ip6.DstIP)
+		}
+		if ip6.NextHeader != layers.IPProtocolICMPv6 {
+			t.Fatalf("next header: got %d, want ICMPv6", ip6.NextHeader)
+		}
+		msg := ip6.Payload
+		if msg[0] != 3 || msg[1] != 0 {
+			t.Errorf("ICMPv6 type/code: got %d/%d, want 3/0", msg[0], msg[1])
+		}
+		quoted := msg[8:]
+		wantQuote := (len(in) - ethHeaderLen) &^ 3
+		if len(quoted) != wantQuote {
+			t.Errorf("quoted length: got %d, want %d", len(quoted), wantQuote)
+		}
+		if string(quoted[:ipv6HeaderLen]) != string(in[ethHeaderLen:ethHeaderLen+ipv6HeaderLen]) {
+			t.Errorf("quoted IPv6 header does not match the received packet")
+		}
+		if !icmpv6ChecksumOK(ip6.SrcIP, ip6.DstIP, msg) {
+			t.Errorf("ICMPv6 checksum invalid")
+		}
+		if got := readStatCounter(t, h, "HOP_LIMIT_EXCEEDED"); got != 1 {
+			t.Errorf("HOP_LIMIT_EXCEEDED: got %d, want 1", got)
+		}
+	})
+
+	t.Run("no error for ICMPv6 error", func(t *testing.T) {
+		h := newXDPTestHelper(t)
+		h.createSidFunction("fd00:1:100::2/128", actionEnd)
+
+		pkt := buildOamTestPacket(t, 1, 0)
+		pkt[oamUpperOffset] = 1 // Destination Unreachable
+		if ret, _ := h.run(pkt); ret != XDP_DROP {
+			t.Fatalf("expected XDP_DROP, got %d", ret)
+		}
+	})
+}
+
+// TestXDPProgOamPunt verifies that the SRH O-flag and End.OP punt a
+// timestamped copy to oam_punt_events.
+func TestXDPProgOamPunt(t *testing.T) {
+	tests := []struct {
+		name         string
+		action       uint8
+		flags        uint8
+		expectAction uint32
+		expectReason uint8
+	}{
+		{"O-flag on End → punt + forward", actionEnd, 0x20, XDP_PASS, 1},
+		{"End.OP → punt + pass", actionEndOP, 0, XDP_PASS, 2},
+		{"End without O-flag → no punt", actionEnd, 0, XDP_PASS, 0},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newXDPTestHelperWithStats(t)
+			h.createSidFunction("fd00:1:100::2/128", tc.action)
+
+			rd, err := perf.NewReader(h.objs.OamPuntEvents, 4096)
+			if err != nil {
+				t.Fatalf("perf.NewReader: %v", err)
+			}
+			defer func() { _ = rd.Close() }()
+
+			pkt := buildOamTestPacket(t, 64, tc.flags)
+			ret, out := h.run(pkt)
+			if ret != tc.expectAction {
+				t.Fatalf("expected action %d, got %d", tc.expectAction, ret)
+			}
+			if tc.action == actionEnd {
+				verifyDAAndSL(t, out, "fd00:1:100::3", 1)
+			}
+
+			wantPunts := uint64(0)
+			if tc.expectReason != 0 {
+				wantPunts = 1
+			}
+			if got := readStatCounter(t, h, "OAM_PUNT"); got != wantPunts {
+				t.Errorf("OAM_PUNT: got %d, want %d", got, wantPunts)
+			}
+			if tc.expectReason == 0 {
+				return
+			}
+
+			rd.SetDeadline(time.Now().Add(time.Second))
+			rec, err := rd.Read()
+			if err != nil {
+				t.Fatalf("perf read: %v", err)
+			}
+			raw := rec.RawSample
+			if len(raw) < 24+len(pkt) {
+				t.Fatalf("sample too short: %d bytes", len(raw))
+			}
+			if ts := binary.NativeEndian.Uint64(raw[0:8]); ts == 0 {
+				t.Errorf("timestamp not set")
+			}
+			if got := binary.NativeEndian.Uint16(raw[12:14]); int(got) != len(pkt) {
+				t.Errorf("pkt_len: got %d, want %d", got, len(pkt))
+			}
+			if raw[16] != tc.expectReason || raw[17] != tc.action {
+				t.Errorf("reason/action: got %d/%d, want %d/%d", raw[16], raw[17], tc.expectReason, tc.action)
+			}
+			if string(raw[24:24+len(pkt)]) != string(pkt) {
+				t.Errorf("punted frame does not match the received packet")
+			}
+		})
+	}
+}
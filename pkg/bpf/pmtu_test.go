@@ -0,0 +1,231 @@
+package bpf
+
+import (
+	"encoding/binary"
+	"net"
+	"testing"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	vinberov1 "github.com/takehaya/vinbero/api/vinbero/v1"
+)
+
+const (
+	pmtuTestMTU       = 1280
+	pmtuTestOverhead  = ipv6HeaderLen + srhBaseLen + 2*ipv6AddrLen // H.Encaps with two segments
+	pmtuTestLargeData = 1250
+)
+
+// buildLargeIPv6Packet returns an ICMPv6 echo request whose IPv6 packet is
+// 40 + 8 + payloadLen bytes.
+func buildLargeIPv6Packet(t *testing.T, src, dst string, payloadLen int) []byte {
+	t.Helper()
+	eth := newTestEthernet(layers.EthernetTypeIPv6)
+	ip6 := &layers.IPv6{
+		Version: 6, SrcIP: net.ParseIP(src), DstIP: net.ParseIP(dst),
+		NextHeader: layers.IPProtocolICMPv6, HopLimit: 64,
+	}
+	icmp, icmpEcho := newTestICMPv6Echo()
+	_ = icmp.SetNetworkLayerForChecksum(ip6)
+
+	buf := gopacket.NewSerializeBuffer()
+	opts := gopacket.SerializeOptions{FixLengths: true, ComputeChecksums: true}
+	if err := gopacket.SerializeLayers(buf, opts, eth, ip6, icmp, icmpEcho, gopacket.Payload(newTestPayload(payloadLen))); err != nil {
+		t.Fatalf("SerializeLayers: %v", err)
+	}
+	return buf.Bytes()
+}
+
+// buildLargeIPv4Packet returns an ICMP echo request whose IPv4 packet is
+// 20 + 8 + payloadLen bytes.
+func buildLargeIPv4Packet(t *testing.T, src, dst string, payloadLen int, df bool) []byte {
+	t.Helper()
+	eth := newTestEthernet(layers.EthernetTypeIPv4)
+	ip4 := &layers.IPv4{
+		Version: 4, IHL: 5, TTL: 64,
+		Protocol: layers.IPProtocolICMPv4, SrcIP: net.ParseIP(src), DstIP: net.ParseIP(dst),
+	}
+	if df {
+		ip4.Flags = layers.IPv4DontFragment
+	}
+	icmp := &layers.ICMPv4{
+		TypeCode: layers.CreateICMPv4TypeCode(layers.ICMPv4TypeEchoRequest, 0),
+		Id:       1234, Seq: 1,
+	}
+
+	buf := gopacket.NewSerializeBuffer()
+	opts := gopacket.SerializeOptions{FixLengths: true, ComputeChecksums: true}
+	if err := gopacket.SerializeLayers(buf, opts, eth, ip4, icmp, gopacket.Payload(newTestPayload(payloadLen))); err != nil {
+		t.Fatalf("SerializeLayers: %v", err)
+	}
+	return buf.Bytes()
+}
+
+// inetChecksumOK reports whether b sums to 0xffff in one's complement.
+func inetChecksumOK(b []byte) bool {
+	var sum uint32
+	for i := 0; i+1 < len(b); i += 2 {
+		sum += uint32(binary.BigEndian.Uint16(b[i:]))
+	}
+	if len(b)%2 == 1 {
+		sum += uint32(b[len(b)-1]) << 8
+	}
+	for sum>>16 != 0 {
+		sum = (sum & 0xffff) + (sum >> 16)
+	}
+	return sum == 0xffff
+}
+
+func (h *xdpTestHelper) createHeadendEntryWithMTU(prefix string, isIPv4 bool, mtu uint16) {
+	h.t.Helper()
+	var segments [10][16]byte
+	copy(segments[0][:], net.ParseIP("fc00:1::1").To16())
+	copy(segments[1][:], net.ParseIP("fc00:2::1").To16())
+	var src [16]byte
+	copy(src[:], net.ParseIP("fc00:ff::1").To16())
+
+	entry := &HeadendEntry{
+		Mode:        uint8(vinberov1.Srv6HeadendBehavior_SRV6_HEADEND_BEHAVIOR_H_ENCAPS),
+		NumSegments: 2,
+		Mtu:         mtu,
+		SrcAddr:     src,
+		Segments:    segments,
+	}
+	var err error
+	if isIPv4 {
+		err = h.mapOps.CreateHeadendV4(prefix, entry)
+	} else {
+		err = h.mapOps.CreateHeadendV6(prefix, entry)
+	}
+	if err != nil {
+		h.t.Fatalf("Failed to create headend entry: %v", err)
+	}
+}
+
+// TestXDPProgHeadendPMTU verifies that H.Encaps output over the headend
+// MTU is answered with ICMPv6 Packet Too Big / ICMPv4 Fragmentation Needed
+// carrying the MTU left after the encap overhead.
+func TestXDPProgHeadendPMTU(t *testing.T) {
+	const wantMTU = pmtuTestMTU - pmtuTestOverhead
+
+	t.Run("IPv6 packet too big", func(t *testing.T) {
+		h := newXDPTestHelperWithStats(t)
+		h.createHeadendEntryWithMTU("2001:db8:2::/48", false, pmtuTestMTU)
+
+		in := buildLargeIPv6Packet(t, "2001:db8:1::1", "2001:db8:2::1", pmtuTestLargeData)
+		ret, out := h.run(in)
+		if ret != XDP_PASS {
+			t.Fatalf("expected XDP_PASS (no FIB route in test netns), got %d", ret)
+		}
+
+		p := gopacket.NewPacket(out, layers.LayerTypeEthernet, gopacket.Default)
+		ip6, ok := p.Layer(layers.LayerTypeIPv6).(*layers.IPv6)
+		if !ok {
+			t.Fatalf("no IPv6 layer in output")
+		}
+		if !ip6.SrcIP.Equal(net.ParseIP("fc00:ff::1")) || !ip6.DstIP.Equal(net.ParseIP("2001:db8:1::1")) {
+			t.Errorf("addresses: got %s -> %s, want fc00:ff::1 -> 2001:db8:1::1", ip6.SrcIP, ip6.DstIP)
+		}
+		if ip6.NextHeader != layers.IPProtocolICMPv6 {
+			t.Fatalf("next header: got %d, want ICMPv6", ip6.NextHeader)
+		}
+		msg := ip6.Payload
+		if msg[0] != 2 || msg[1] != 0 {
+			t.Errorf("ICMPv6 type/code: got %d/%d, want 2/0", msg[0], msg[1])
+		}
+		if got := binary.BigEndian.Uint32(msg[4:8]); got != wantMTU {
+			t.Errorf("MTU: got %d, want %d", got, wantMTU)
+		}
+		if got, want := len(msg), 1280-ipv6HeaderLen; got != want {
+			t.Errorf("ICMPv6 length: got %d, want %d", got, want)
+		}
+		if string(msg[8:8+ipv6HeaderLen]) != string(in[ethHeaderLen:ethHeaderLen+ipv6HeaderLen]) {
+			t.Errorf("quoted IPv6 header does not match the received packet")
+		}
+		if !icmpv6ChecksumOK(ip6.SrcIP, ip6.DstIP, msg) {
+			t.Errorf("ICMPv6 checksum invalid")
+		}
+		if got := readStatCounter(t, h, "PMTU_EXCEEDED"); got != 1 {
+			t.Errorf("PMTU_EXCEEDED: got %d, want 1", got)
+		}
+	})
+
+	t.Run("IPv4 DF fragmentation needed", func(t *testing.T) {
+		h := newXDPTestHelperWithStats(t)
+		h.createHeadendEntryWithMTU("10.0.2.0/24", true, pmtuTestMTU)
+
+		in := buildLargeIPv4Packet(t, "10.0.1.1", "10.0.2.1", pmtuTestLargeData, true)
+		ret, out := h.run(in)
+		if ret != XDP_PASS {
+			t.Fatalf("expected XDP_PASS (no FIB route in test netns), got %d", ret)
+		}
+
+		if got := binary.BigEndian.Uint16(out[12:14]); got != uint16(layers.EthernetTypeIPv4) {
+			t.Fatalf("ethertype: got %#x, want IPv4", got)
+		}
+		iph := out[ethHeaderLen : ethHeaderLen+20]
+		if !inetChecksumOK(iph) {
+			t.Errorf("IPv4 header checksum invalid")
+		}
+		if !net.IP(iph[12:16]).Equal(net.ParseIP("10.0.2.1")) || !net.IP(iph[16:20]).Equal(net.ParseIP("10.0.1.1")) {
+			t.Errorf("addresses: got %s -> %s, want 10.0.2.1 -> 10.0.1.1", net.IP(iph[12:16]), net.IP(iph[16:20]))
+		}
+		if iph[9] != uint8(layers.IPProtocolICMPv4) {
+			t.Fatalf("protocol: got %d, want ICMP", iph[9])
+		}
+		msg := out[ethHeaderLen+20:]
+		if got, want := int(binary.BigEndian.Uint16(iph[2:4])), 20+len(msg); got != want {
+			t.Errorf("total length: got %d, want %d", got, want)
+		}
+		if got, want := len(msg), 576-20; got != want {
+			t.Errorf("ICMP length: got %d, want %d", got, want)
+		}
+		if msg[0] != 3 || msg[1] != 4 {
+			t.Errorf("ICMP type/code: got %d/%d, want 3/4", msg[0], msg[1])
+		}
+		if got := binary.BigEndian.Uint16(msg[6:8]); got != wantMTU {
+			t.Errorf("next-hop MTU: got %d, want %d", got, wantMTU)
+		}
+		if string(msg[8:28]) != string(in[ethHeaderLen:ethHeaderLen+20]) {
+			t.Errorf("quoted IPv4 header does not match the received packet")
+		}
+		if !inetChecksumOK(msg) {
+			t.Errorf("ICMP checksum invalid")
+		}
+		if got := readStatCounter(t, h, "PMTU_EXCEEDED"); got != 1 {
+			t.Errorf("PMTU_EXCEEDED: got %d, want 1", got)
+		}
+	})
+
+	t.Run("IPv4 without DF is dropped", func(t *testing.T) {
+		h := newXDPTestHelperWithStats(t)
+		h.createHeadendEntryWithMTU("10.0.2.0/24", true, pmtuTestMTU)
+
+		in := buildLargeIPv4Packet(t, "10.0.1.1", "10.0.2.1", pmtuTestLargeData, false)
+		if ret, _ := h.run(in); ret != XDP_DROP {
+			t.Fatalf("expected XDP_DROP, got %d", ret)
+		}
+		if got := readStatCounter(t, h, "PMTU_EXCEEDED"); got != 1 {
+			t.Errorf("PMTU_EXCEEDED: got %d, want 1", got)
+		}
+	})
+
+	t.Run("packet within MTU is encapsulated", func(t *testing.T) {
+		h := newXDPTestHelperWithStats(t)
+		h.createHeadendEntryWithMTU("2001:db8:2::/48", false, pmtuTestMTU)
+
+		payload := pmtuTestMTU - pmtuTestOverhead - ipv6HeaderLen - 8
+		in := buildLargeIPv6Packet(t, "2001:db8:1::1", "2001:db8:2::1", payload)
+		ret, out := h.run(in)
+		if ret != XDP_PASS {
+			t.Fatalf("expected XDP_PASS (no FIB route in test netns), got %d", ret)
+		}
+		if got, want := len(out), ethHeaderLen+pmtuTestMTU; got != want {
+			t.Errorf("encapsulated length: got %d, want %d", got, want)
+		}
+		verifySRHPresent(t, out)
+		if got := readStatCounter(t, h, "PMTU_EXCEEDED"); got != 0 {
+			t.Errorf("PMTU_EXCEEDED: got %d, want 0", got)
+		}
+	})
+}
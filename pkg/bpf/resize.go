@@ -0,0 +1,237 @@
+package bpf
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"sort"
+
+	"github.com/cilium/ebpf"
+	"github.com/takehaya/vinbero/pkg/config"
+)
+
+// MapUsage is the fill level of one control map. Bytes is the memory the
+// kernel charges for the map, or key plus value size times capacity on
+// kernels that don't report it.
+type MapUsage struct {
+	Name     string
+	Type     ebpf.MapType
+	Entries  int
+	Capacity uint32
+	Bytes    uint64
+}
+
+// usageMapTypes are the map types MapUsage reports and ResizeMap grows.
+// Per-CPU scratch and stats maps, PROG_ARRAYs and event rings have a
+// fixed shape and are left out.
+var usageMapTypes = []ebpf.MapType{ebpf.Hash, ebpf.LRUHash, ebpf.LPMTrie, ebpf.Array}
+
+// mapFields returns the map fields of maps by their ELF name.
+func mapFields(maps *BpfMaps) map[string]reflect.Value {
+	fields := make(map[string]reflect.Value)
+	v := reflect.ValueOf(maps).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		if name := v.Type().Field(i).Tag.Get("ebpf"); name != "" {
+			fields[name] = v.Field(i)
+		}
+	}
+	return fields
+}
+
+// MapUsage counts the entries of every control map by walking it, and
+// resets the counters CountedMapUsage reads to what it found. Array slots
+// count when they are non-zero. sid_aux_map is read from its index
+// allocator instead: slot 0 is the "no aux" sentinel, so the pool is full
+// one entry before the map is.
+func (m *MapOperations) MapUsage() ([]MapUsage, error) {
+	var out []MapUsage
+	for name, f := range mapFields(&m.objs.BpfMaps) {
+		mp := f.Interface().(*ebpf.Map)
+		if mp == nil || !slices.Contains(usageMapTypes, mp.Type()) {
+			continue
+		}
+		u := MapUsage{Name: name, Type: mp.Type(), Capacity: mp.MaxEntries(), Bytes: mapBytes(mp)}
+		if mp == m.objs.SidAuxMap {
+			u.Entries, u.Capacity = m.auxAlloc.usage()
+		} else {
+			n, err := countEntries(mp)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", name, err)
+			}
+			u.Entries = n
+			if counted(name, mp.Type()) {
+				m.counts.reset(name, mp, n)
+			}
+		}
+		out = append(out, u)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out, nil
+}
+
+func mapBytes(mp *ebpf.Map) uint64 {
+	if info, err := mp.Info(); err == nil {
+		if n, ok := info.Memlock(); ok {
+			return n
+		}
+	}
+	return uint64(mp.KeySize()+mp.ValueSize()) * uint64(mp.MaxEntries())
+}
+
+func countEntries(mp *ebpf.Map) (int, error) {
+	n := 0
+	zero := make([]byte, mp.ValueSize())
+	var key, value []byte
+	iter := mp.Iterate()
+	for iter.Next(&key, &value) {
+		if mp.Type() == ebpf.Array && bytes.Equal(value, zero) {
+			continue
+		}
+		n++
+	}
+	return n, iter.Err()
+}
+
+// ResizeMap replaces the map name with a copy of capacity entries and
+// moves the data plane onto it. Maps are bound to programs at load time,
+// so the programs are loaded again from the ELF: the new map goes in
+// fresh, every other map is passed as a replacement, so the new programs
+// share all remaining state with the running ones. The tail-call slots of
+// the shared PROG_ARRAYs then point at the new programs.
+//
+// On return the MapOperations use the new map and programs. The caller
+// moves its XDP/TC links onto the new entry programs, re-registers the
+// plugins that use the map, then closes retired, which holds the old
+// programs and the old map. The caller holds off control-plane writes
+// while this runs, but the data plane keeps writing to the old map until
+// the links move; CopyRetired carries those entries over afterwards.
+func (m *MapOperations) ResizeMap(constants map[string]any, cfg *config.Config, name string, capacity uint32) (retired *BpfObjects, copied int, err error) {
+	fields := mapFields(&m.objs.BpfMaps)
+	field, ok := fields[name]
+	if !ok {
+		return nil, 0, fmt.Errorf("unknown map %q", name)
+	}
+	old := field.Interface().(*ebpf.Map)
+	if !slices.Contains(usageMapTypes, old.Type()) {
+		return nil, 0, fmt.Errorf("map %s (%s) can't be resized", name, old.Type())
+	}
+	if capacity <= old.MaxEntries() {
+		return nil, 0, fmt.Errorf("capacity of %s must exceed the current %d", name, old.MaxEntries())
+	}
+
+	spec, err := collectionSpec(constants, cfg)
+	if err != nil {
+		return nil, 0, err
+	}
+	ms, ok := spec.Maps[name]
+	if !ok {
+		return nil, 0, fmt.Errorf("map %s not found in spec", name)
+	}
+
+	// Keep the spec's pinning off: the pin, if any, is replaced below
+	// once the copy is complete.
+	ms.MaxEntries = capacity
+	ms.Pinning = ebpf.PinNone
+	grown, err := ebpf.NewMap(ms)
+	if err != nil {
+		return nil, 0, fmt.Errorf("create %s: %w", name, err)
+	}
+	if copied, err = copyEntries(name, old, grown, nil); err != nil {
+		_ = grown.Close()
+		return nil, 0, fmt.Errorf("copy %s: %w", name, err)
+	}
+
+	replacements := map[string]*ebpf.Map{name: grown}
+	for n, f := range fields {
+		mp := f.Interface().(*ebpf.Map)
+		s, ok := spec.Maps[n]
+		if n == name || mp == nil || !ok {
+			continue
+		}
+		// Earlier resizes leave live maps larger than the config says
+		s.MaxEntries = mp.MaxEntries()
+		s.Pinning = ebpf.PinNone
+		replacements[n] = mp
+	}
+
+	fresh := &BpfObjects{}
+	if err := spec.LoadAndAssign(fresh, &ebpf.CollectionOptions{
+		MapReplacements: replacements,
+	}); err != nil {
+		_ = grown.Close()
+		return nil, 0, fmt.Errorf("reload programs: %w", err)
+	}
+	// fresh.BpfMaps only holds clones of the replacements
+	_ = fresh.BpfMaps.Close()
+
+	if cfg != nil && cfg.Setting.PinMaps.Enabled && slices.Contains(pinnedControlMaps, name) {
+		if err := replacePin(grown, filepath.Join(pinPathOf(cfg), name)); err != nil {
+			_ = fresh.BpfPrograms.Close()
+			_ = grown.Close()
+			return nil, 0, fmt.Errorf("pin %s: %w", name, err)
+		}
+	}
+
+	retired = &BpfObjects{BpfPrograms: m.objs.BpfPrograms}
+	mapFields(&retired.BpfMaps)[name].Set(reflect.ValueOf(old))
+	m.objs.BpfPrograms = fresh.BpfPrograms
+	m.objs.BpfVariables = fresh.BpfVariables
+	field.Set(reflect.ValueOf(grown))
+	if counted(name, grown.Type()) {
+		m.counts.reset(name, grown, copied)
+	}
+
+	if err := populateProgArrays(m.objs); err != nil {
+		return retired, copied, fmt.Errorf("populate prog arrays: %w", err)
+	}
+	if name == "sid_aux_map" {
+		m.auxAlloc.grow(capacity)
+	}
+	return retired, copied, nil
+}
+
+// CopyRetired puts the entries of retired's map name that the current
+// map lacks, such as FDB entries learned between ResizeMap's copy and the
+// link swap, and returns how many were put. Run it after the links move,
+// before retired is closed. Entries the data plane wrote to the new map
+// meanwhile win. Arrays are left out: every slot exists in both maps and
+// only the control plane writes them.
+func (m *MapOperations) CopyRetired(retired *BpfObjects, name string) (int, error) {
+	cur, ok := mapFields(&m.objs.BpfMaps)[name]
+	if !ok {
+		return 0, fmt.Errorf("unknown map %q", name)
+	}
+	dst := cur.Interface().(*ebpf.Map)
+	src, _ := mapFields(&retired.BpfMaps)[name].Interface().(*ebpf.Map)
+	if src == nil || dst == nil || src.Type() == ebpf.Array {
+		return 0, nil
+	}
+
+	put := 0
+	scratch := make([]byte, dst.ValueSize())
+	var key, value []byte
+	iter := src.Iterate()
+	for iter.Next(&key, &value) {
+		if dst.Lookup(key, &scratch) == nil {
+			continue
+		}
+		if name == "fdb_map" {
+			unarmFdbEntry(value)
+		}
+		if err := dst.Update(key, value, ebpf.UpdateNoExist); err != nil {
+			if errors.Is(err, ebpf.ErrKeyExist) {
+				continue
+			}
+			return put, fmt.Errorf("copy %s entry: %w", name, err)
+		}
+		put++
+		m.counts.add(dst, 1)
+	}
+	if err := iter.Err(); err != nil {
+		return put, fmt.Errorf("iterate retired %s: %w", name, err)
+	}
+	return put, nil
+}
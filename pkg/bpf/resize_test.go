@@ -0,0 +1,269 @@
+package bpf
+
+import (
+	"net"
+	"slices"
+	"testing"
+
+	"github.com/takehaya/vinbero/pkg/config"
+)
+
+func TestResizeMap(t *testing.T) {
+	h := newXDPTestHelper(t)
+	h.createSidFunction("fd00:1:100::2/128", actionEnd)
+
+	retired, copied, err := h.mapOps.ResizeMap(nil, nil, "sid_function_map", 2048)
+	if err != nil {
+		t.Fatalf("ResizeMap: %v", err)
+	}
+	if err := retired.Close(); err != nil {
+		t.Fatalf("close retired: %v", err)
+	}
+	if copied != 1 {
+		t.Errorf("copied %d entries, want 1", copied)
+	}
+	if got := h.objs.SidFunctionMap.MaxEntries(); got != 2048 {
+		t.Errorf("capacity: got %d, want 2048", got)
+	}
+
+	// A SID installed after the resize lands in the new map only; the
+	// reloaded program must see it, and the copied one too.
+	h.createSidFunction("fd00:1:100::5/128", actionEnd)
+	for _, sid := range []string{"fd00:1:100::2", "fd00:1:100::5"} {
+		segments := []net.IP{net.ParseIP("fd00:1:100::3"), net.ParseIP(sid)}
+		pkt, err := buildSRv6Packet(net.ParseIP("fd00:1:1::1"), net.ParseIP(sid), segments, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ret, out := h.run(pkt)
+		if ret != XDP_PASS {
+			t.Errorf("%s: action %d, want XDP_PASS", sid, ret)
+		}
+		verifyDAAndSL(t, out, "fd00:1:100::3", 1)
+	}
+
+	usage, err := h.mapOps.MapUsage()
+	if err != nil {
+		t.Fatalf("MapUsage: %v", err)
+	}
+	found := false
+	for _, u := range usage {
+		if u.Name == "sid_function_map" {
+			found = true
+			if u.Entries != 2 || u.Capacity != 2048 || u.Bytes == 0 {
+				t.Errorf("sid_function_map usage: got %+v, want 2/2048 and its size", u)
+			}
+		}
+		if u.Name == "sid_endpoint_progs" || u.Name == "stats_map" {
+			t.Errorf("MapUsage reports %s", u.Name)
+		}
+	}
+	if !found {
+		t.Error("MapUsage misses sid_function_map")
+	}
+}
+
+func TestResizeMapAuxPool(t *testing.T) {
+	h := newXDPTestHelper(t)
+	retired, _, err := h.mapOps.ResizeMap(nil, nil, "sid_aux_map", 4096)
+	if err != nil {
+		t.Fatalf("ResizeMap: %v", err)
+	}
+	_ = retired.Close()
+	if got := h.mapOps.auxAlloc.maxIndex; got != 4096 {
+		t.Errorf("aux pool: got %d, want 4096", got)
+	}
+}
+
+func TestMapUsageAuxPool(t *testing.T) {
+	h := newXDPTestHelper(t)
+	a := h.mapOps.auxAlloc
+	first, _ := a.Alloc()
+	if _, err := a.Alloc(); err != nil {
+		t.Fatal(err)
+	}
+	a.Free(first)
+
+	usage, err := h.mapOps.MapUsage()
+	if err != nil {
+		t.Fatalf("MapUsage: %v", err)
+	}
+	for _, u := range usage {
+		if u.Name == "sid_aux_map" {
+			// Slot 0 is never handed out
+			if u.Entries != 1 || u.Capacity != h.objs.SidAuxMap.MaxEntries()-1 {
+				t.Errorf("sid_aux_map usage: got %+v, want 1 of %d", u, h.objs.SidAuxMap.MaxEntries()-1)
+			}
+			return
+		}
+	}
+	t.Error("MapUsage misses sid_aux_map")
+}
+
+func TestResizeMapRejects(t *testing.T) {
+	h := newXDPTestHelper(t)
+	for name, capacity := range map[string]uint32{
+		"no_such_map":        4096,
+		"sid_endpoint_progs": 4096,
+		"stats_map":          4096,
+		"fdb_map":            16, // not larger than the current size
+	} {
+		if _, _, err := h.mapOps.ResizeMap(nil, nil, name, capacity); err == nil {
+			t.Errorf("%s -> %d: expected error", name, capacity)
+		}
+	}
+}
+
+// TestResizeMapPinned checks that a pinned map grown past settings.entries
+// keeps its size and entries across a restart with the old config.
+func TestResizeMapPinned(t *testing.T) {
+	dir := testPinDir(t)
+	cfg := &config.Config{
+		Setting: config.SettingConfig{
+			Entries: config.EntriesConfig{VlanTable: config.EntryCapacityConfig{Capacity: 4}},
+			PinMaps: config.PinMapsConfig{Enabled: true, Path: dir},
+		},
+	}
+
+	objs, err := ReadCollection(nil, cfg)
+	if err != nil {
+		t.Fatalf("ReadCollection: %v", err)
+	}
+	mapOps := NewMapOperations(objs)
+	for k := uint32(1); k <= 4; k++ {
+		if err := objs.Dx2vMap.Put(k, BpfDx2vEntry{Oif: k}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	retired, _, err := mapOps.ResizeMap(nil, cfg, "dx2v_map", 8)
+	if err != nil {
+		t.Fatalf("ResizeMap: %v", err)
+	}
+	_ = retired.Close()
+	for k := uint32(5); k <= 6; k++ {
+		if err := objs.Dx2vMap.Put(k, BpfDx2vEntry{Oif: k}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := objs.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Restart with settings.entries still at 4
+	done, err := MigratePinnedMaps(cfg)
+	if err != nil || len(done) != 0 {
+		t.Fatalf("MigratePinnedMaps: got %+v, %v; want no migration", done, err)
+	}
+	objs, err = ReadCollection(nil, cfg)
+	if err != nil {
+		t.Fatalf("ReadCollection after restart: %v", err)
+	}
+	defer func() { _ = objs.Close() }()
+	if got := objs.Dx2vMap.MaxEntries(); got != 8 {
+		t.Errorf("capacity: got %d, want 8", got)
+	}
+	for k := uint32(1); k <= 6; k++ {
+		var got BpfDx2vEntry
+		if err := objs.Dx2vMap.Lookup(k, &got); err != nil || got.Oif != k {
+			t.Errorf("entry %d: got %+v, %v", k, got, err)
+		}
+	}
+}
+
+// TestResizeMapCopyRetired checks that FDB entries learned in the old map
+// between the copy and the link swap are carried over, unarmed, without
+// overwriting what the new map learned meanwhile.
+func TestResizeMapCopyRetired(t *testing.T) {
+	h := newXDPTestHelper(t)
+	retired, _, err := h.mapOps.ResizeMap(nil, nil, "fdb_map", 16384)
+	if err != nil {
+		t.Fatalf("ResizeMap: %v", err)
+	}
+	defer func() { _ = retired.Close() }()
+
+	late := net.HardwareAddr{0x02, 0, 0, 0, 0, 0x01}
+	both := net.HardwareAddr{0x02, 0, 0, 0, 0, 0x02}
+	if err := retired.FdbMap.Put(buildFdbKey(100, late), &FdbEntry{Oif: 1, LastSeen: 1, AgingArmed: 1}); err != nil {
+		t.Fatal(err)
+	}
+	if err := retired.FdbMap.Put(buildFdbKey(100, both), &FdbEntry{Oif: 1, LastSeen: 1}); err != nil {
+		t.Fatal(err)
+	}
+	if err := h.mapOps.CreateFdb(100, both, &FdbEntry{Oif: 2, LastSeen: 2}); err != nil {
+		t.Fatal(err)
+	}
+
+	put, err := h.mapOps.CopyRetired(retired, "fdb_map")
+	if err != nil {
+		t.Fatalf("CopyRetired: %v", err)
+	}
+	if put != 1 {
+		t.Errorf("put %d entries, want 1", put)
+	}
+	e, err := h.mapOps.GetFdb(100, late)
+	if err != nil {
+		t.Fatalf("late entry not carried over: %v", err)
+	}
+	if e.Oif != 1 || e.AgingArmed != 0 {
+		t.Errorf("late entry: got oif %d armed %d, want oif 1 unarmed", e.Oif, e.AgingArmed)
+	}
+	if e, err := h.mapOps.GetFdb(100, both); err != nil || e.Oif != 2 {
+		t.Errorf("entry of the new map was overwritten: %+v, %v", e, err)
+	}
+}
+
+// TestCountedMapUsage checks that the counters follow writes without a
+// walk: a SID create and delete each report the new count, and fdb_map
+// adds the statics to what the data plane learned.
+func TestCountedMapUsage(t *testing.T) {
+	h := newXDPTestHelper(t)
+	var changes []int
+	h.mapOps.OnMapCount(func(name string, entries int, capacity uint32) {
+		if name == "sid_function_map" {
+			changes = append(changes, entries)
+		}
+	})
+
+	h.createSidFunction("fd00:1:100::2/128", actionEnd)
+	h.createSidFunction("fd00:1:100::3/128", actionEnd)
+	h.createSidFunction("fd00:1:100::3/128", actionEnd) // replaced, not added
+	if err := h.mapOps.DeleteSidFunction("fd00:1:100::2/128"); err != nil {
+		t.Fatal(err)
+	}
+	if want := []int{1, 2, 1}; !slices.Equal(changes, want) {
+		t.Errorf("sid_function_map counts: got %v, want %v", changes, want)
+	}
+
+	if err := h.mapOps.CreateFdb(100, net.HardwareAddr{0x02, 0, 0, 0, 0, 0x09}, &FdbEntry{Oif: 2, IsStatic: 1}); err != nil {
+		t.Fatal(err)
+	}
+	learnLocalMAC(t, h)
+
+	counted, err := h.mapOps.CountedMapUsage()
+	if err != nil {
+		t.Fatalf("CountedMapUsage: %v", err)
+	}
+	walked, err := h.mapOps.MapUsage()
+	if err != nil {
+		t.Fatalf("MapUsage: %v", err)
+	}
+	entries := func(usage []MapUsage, name string) int {
+		for _, u := range usage {
+			if u.Name == name {
+				return u.Entries
+			}
+		}
+		return -1
+	}
+	for name, want := range map[string]int{"sid_function_map": 1, "fdb_map": 2, "headend_l2_map": 2} {
+		if got := entries(counted, name); got != want {
+			t.Errorf("%s: counted %d, want %d", name, got, want)
+		}
+		if got := entries(walked, name); got != want {
+			t.Errorf("%s: walked %d, want %d", name, got, want)
+		}
+	}
+	if got := entries(counted, "neigh_map"); got != -1 {
+		t.Errorf("neigh_map is LRU and should only be walked, got %d", got)
+	}
+}
@@ -110,7 +110,7 @@ func TestXDPProgEndDT2MNonDFDrop(t *testing.T) {
 				SrcAddr:     localPE,
 				BdId:        100,
 			}
-			if err := h.mapOps.CreateHeadendL2(1, 100, hl2, localESI); err != nil {
+			if err := h.mapOps.CreateHeadendL2(HeadendL2AC{Ifindex: 1, VlanID: 100, Esi: localESI}, hl2); err != nil {
 				t.Fatalf("CreateHeadendL2: %v", err)
 			}
 
@@ -0,0 +1,203 @@
+package bpf
+
+import (
+	"net"
+	"sort"
+	"testing"
+
+	"github.com/cilium/ebpf"
+)
+
+// xdpMdCtx mirrors struct xdp_md for BPF_PROG_RUN. The kernel requires
+// data_meta == 0 and data_end == len(packet); ingress_ifindex must name an
+// existing device.
+type xdpMdCtx struct {
+	Data           uint32
+	DataEnd        uint32
+	DataMeta       uint32
+	IngressIfindex uint32
+	RxQueueIndex   uint32
+	EgressIfindex  uint32
+}
+
+// buildSrDomainTestPacket returns an End-bound SRv6 packet (SL=1) from src to
+// the SID fd00:1:100::2.
+func buildSrDomainTestPacket(t *testing.T, src string) []byte {
+	t.Helper()
+	pkt, err := buildSRv6Packet(net.ParseIP(src), net.ParseIP("fd00:1:100::2"),
+		[]net.IP{net.ParseIP("fd00:1:100::3"), net.ParseIP("fd00:1:100::2")}, 1)
+	if err != nil {
+		t.Fatalf("buildSRv6Packet: %v", err)
+	}
+	return pkt
+}
+
+// TestXDPProgSrDomainBoundary verifies that a SID under a protected locator
+// only runs for trusted sources, and that locators without a policy are
+// unaffected.
+func TestXDPProgSrDomainBoundary(t *testing.T) {
+	tests := []struct {
+		name         string
+		locator      string // empty = no policy
+		trustedSrcs  []string
+		src          string
+		expectAction uint32
+	}{
+		{"no policy → End runs", "", nil, "2001:db8::1", XDP_PASS},
+		{"trusted source → End runs", "fd00:1:100::/48", []string{"fd00:1::/32"}, "fd00:1:1::1", XDP_PASS},
+		{"untrusted source → drop", "fd00:1:100::/48", []string{"fd00:1::/32"}, "2001:db8::1", XDP_DROP},
+		{"policy on other locator → End runs", "fd00:2:100::/48", []string{"fd00:1::/32"}, "2001:db8::1", XDP_PASS},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newXDPTestHelperWithStats(t)
+			h.createSidFunction("fd00:1:100::2/128", actionEnd)
+			if tc.locator != "" {
+				if err := h.mapOps.CreateSrDomain(tc.locator, tc.trustedSrcs, nil); err != nil {
+					t.Fatalf("CreateSrDomain: %v", err)
+				}
+			}
+
+			ret, outPkt := h.run(buildSrDomainTestPacket(t, tc.src))
+			if ret != tc.expectAction {
+				t.Fatalf("expected action %d, got %d", tc.expectAction, ret)
+			}
+			if ret == XDP_PASS {
+				verifyDAAndSL(t, outPkt, "fd00:1:100::3", 1)
+			}
+
+			stats, err := h.mapOps.ReadStats()
+			if err != nil {
+				t.Fatalf("ReadStats: %v", err)
+			}
+			var drops uint64
+			for _, s := range stats {
+				if s.Name == "SR_DOMAIN_DROP" {
+					drops = s.Packets
+				}
+			}
+			wantDrops := uint64(0)
+			if tc.expectAction == XDP_DROP {
+				wantDrops = 1
+			}
+			if drops != wantDrops {
+				t.Errorf("SR_DOMAIN_DROP: got %d, want %d", drops, wantDrops)
+			}
+		})
+	}
+}
+
+// TestXDPProgSrDomainTrustedInterface verifies that an untrusted source is
+// still accepted when it arrives on a trusted ingress interface.
+func TestXDPProgSrDomainTrustedInterface(t *testing.T) {
+	lo, err := net.InterfaceByName("lo")
+	if err != nil {
+		t.Skipf("loopback interface unavailable: %v", err)
+	}
+
+	tests := []struct {
+		name         string
+		trustedIif   uint32
+		expectAction uint32
+	}{
+		{"ingress interface trusted → End runs", uint32(lo.Index), XDP_PASS},
+		{"ingress interface not trusted → drop", uint32(lo.Index) + 1000, XDP_DROP},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newXDPTestHelper(t)
+			h.createSidFunction("fd00:1:100::2/128", actionEnd)
+			if err := h.mapOps.CreateSrDomain("fd00:1:100::/48", nil, []uint32{tc.trustedIif}); err != nil {
+				t.Fatalf("CreateSrDomain: %v", err)
+			}
+
+			pkt := buildSrDomainTestPacket(t, "2001:db8::1")
+			opts := ebpf.RunOptions{
+				Data:    pkt,
+				DataOut: make([]byte, 1500),
+				Context: xdpMdCtx{DataEnd: uint32(len(pkt)), IngressIfindex: uint32(lo.Index)},
+				Repeat:  1,
+			}
+			ret, err := h.objs.VinberoMain.Run(&opts)
+			if err != nil {
+				t.Fatalf("Failed to run BPF program: %v", err)
+			}
+			if ret != tc.expectAction {
+				t.Errorf("expected action %d, got %d", tc.expectAction, ret)
+			}
+		})
+	}
+}
+
+// TestSrDomainMapOperations verifies create/replace/list/delete keep the
+// trusted side tables in sync with sr_domain_map.
+func TestSrDomainMapOperations(t *testing.T) {
+	h := newXDPTestHelper(t)
+
+	if err := h.mapOps.CreateSrDomain("fd00:1::/48", []string{"fd00::/16", "fc00::/8"}, []uint32{7}); err != nil {
+		t.Fatalf("CreateSrDomain: %v", err)
+	}
+	if err := h.mapOps.CreateSrDomain("fd00:2::/48", []string{"fd00::/16"}, nil); err != nil {
+		t.Fatalf("CreateSrDomain second locator: %v", err)
+	}
+
+	// Replace the first policy: fc00::/8 and ifindex 7 must disappear.
+	if err := h.mapOps.CreateSrDomain("fd00:1::/48", []string{"fd00::/16"}, []uint32{9}); err != nil {
+		t.Fatalf("CreateSrDomain replace: %v", err)
+	}
+
+	policies, err := h.mapOps.ListSrDomains()
+	if err != nil {
+		t.Fatalf("ListSrDomains: %v", err)
+	}
+	if len(policies) != 2 {
+		t.Fatalf("expected 2 policies, got %d", len(policies))
+	}
+	p1 := policies["fd00:1::/48"]
+	if p1 == nil {
+		t.Fatalf("policy fd00:1::/48 missing: %v", policies)
+	}
+	if len(p1.TrustedSrcPrefixes) != 1 || p1.TrustedSrcPrefixes[0] != "fd00::/16" {
+		t.Errorf("replaced sources: got %v, want [fd00::/16]", p1.TrustedSrcPrefixes)
+	}
+	if len(p1.TrustedIfindexes) != 1 || p1.TrustedIfindexes[0] != 9 {
+		t.Errorf("replaced ifindexes: got %v, want [9]", p1.TrustedIfindexes)
+	}
+	if p2 := policies["fd00:2::/48"]; p2 == nil || p2.PolicyID == p1.PolicyID {
+		t.Errorf("second locator must have its own policy id: %+v vs %+v", p2, p1)
+	}
+
+	if err := h.mapOps.DeleteSrDomain("fd00:1::/48"); err != nil {
+		t.Fatalf("DeleteSrDomain: %v", err)
+	}
+	policies, err = h.mapOps.ListSrDomains()
+	if err != nil {
+		t.Fatalf("ListSrDomains after delete: %v", err)
+	}
+	var locators []string
+	for l := range policies {
+		locators = append(locators, l)
+	}
+	sort.Strings(locators)
+	if len(locators) != 1 || locators[0] != "fd00:2::/48" {
+		t.Errorf("locators after delete: got %v, want [fd00:2::/48]", locators)
+	}
+
+	// The deleted policy's trust entries must be gone from the side tables.
+	var iifKey SrDomainIifKey
+	var val uint8
+	iter := h.objs.SrDomainIifMap.Iterate()
+	for iter.Next(&iifKey, &val) {
+		t.Errorf("stale sr_domain_iif_map entry: %+v", iifKey)
+	}
+
+	count, err := h.mapOps.FlushSrDomains()
+	if err != nil {
+		t.Fatalf("FlushSrDomains: %v", err)
+	}
+	if count != 1 {
+		t.Errorf("FlushSrDomains: got %d, want 1", count)
+	}
+}
@@ -0,0 +1,193 @@
+package bpf
+
+import (
+	"encoding/binary"
+	"net"
+	"testing"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/takehaya/vinbero/pkg/packet"
+)
+
+const (
+	stampTestPort   = 862
+	stampPacketLen  = 44
+	stampSenderSeq  = 0x01020304
+	stampSenderSec  = 0x11223344
+	stampSenderNsec = 0x00aabbcc
+)
+
+// buildStampTestPacket returns a STAMP Session-Sender test packet from
+// fd00:1::1 to reflector. With segments set, the packet carries an SRH
+// with SL=0 as it would after traversing an SR policy.
+func buildStampTestPacket(t *testing.T, reflector string, dstPort uint16, segments []net.IP) []byte {
+	t.Helper()
+	eth := newTestEthernet(layers.EthernetTypeIPv6)
+	ip6 := &layers.IPv6{
+		Version: 6, SrcIP: net.ParseIP("fd00:1::1"), DstIP: net.ParseIP(reflector),
+		NextHeader: layers.IPProtocolUDP, HopLimit: 62,
+	}
+	udp := &layers.UDP{SrcPort: 40000, DstPort: layers.UDPPort(dstPort)}
+	_ = udp.SetNetworkLayerForChecksum(ip6)
+
+	payload := make([]byte, stampPacketLen)
+	binary.BigEndian.PutUint32(payload[0:4], stampSenderSeq)
+	binary.BigEndian.PutUint32(payload[4:8], stampSenderSec)
+	binary.BigEndian.PutUint32(payload[8:12], stampSenderNsec)
+	binary.BigEndian.PutUint16(payload[12:14], 0x4001)
+
+	layersToSerialize := []gopacket.SerializableLayer{eth, ip6}
+	if len(segments) > 0 {
+		ip6.NextHeader = layers.IPProtocol(43)
+		layersToSerialize = append(layersToSerialize, &packet.Srv6Layer{
+			NextHeader: uint8(layers.IPProtocolUDP), HdrExtLen: uint8(len(segments) * 2),
+			RoutingType: 4, SegmentsLeft: 0,
+			LastEntry: uint8(len(segments) - 1), Segments: segmentsToNetipAddr(segments),
+		})
+	}
+	layersToSerialize = append(layersToSerialize, udp, gopacket.Payload(payload))
+
+	buf := gopacket.NewSerializeBuffer()
+	opts := gopacket.SerializeOptions{FixLengths: true, ComputeChecksums: true}
+	if err := gopacket.SerializeLayers(buf, opts, layersToSerialize...); err != nil {
+		t.Fatalf("SerializeLayers: %v", err)
+	}
+	return buf.Bytes()
+}
+
+// udpChecksumOK verifies a UDP datagram against its IPv6 pseudo-header.
+func udpChecksumOK(src, dst net.IP, msg []byte) bool {
+	var sum uint32
+	add := func(b []byte) {
+		for i := 0; i+1 < len(b); i += 2 {
+			sum += uint32(binary.BigEndian.Uint16(b[i:]))
+		}
+		if len(b)%2 == 1 {
+			sum += uint32(b[len(b)-1]) << 8
+		}
+	}
+	add(src.To16())
+	add(dst.To16())
+	var l [8]byte
+	binary.BigEndian.PutUint32(l[0:4], uint32(len(msg)))
+	l[7] = 17
+	add(l[:])
+	add(msg)
+	for sum>>16 != 0 {
+		sum = (sum & 0xffff) + (sum >> 16)
+	}
+	return sum == 0xffff
+}
+
+// TestXDPProgStampReflect verifies the stateless STAMP Session-Reflector
+// (RFC 8762 Section 4.3.1), including test packets that arrive over an SR
+// policy with SL=0 (RFC 9503).
+func TestXDPProgStampReflect(t *testing.T) {
+	const reflector = "fd00:1:100::10"
+
+	tests := []struct {
+		name     string
+		segments []net.IP
+	}{
+		{"plain UDP", nil},
+		{"SRH with SL=0", []net.IP{net.ParseIP(reflector), net.ParseIP("fd00:1:100::2")}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newXDPTestHelperWithStats(t)
+			if err := h.mapOps.CreateStampReflector(reflector, stampTestPort); err != nil {
+				t.Fatalf("CreateStampReflector: %v", err)
+			}
+
+			ret, out := h.run(buildStampTestPacket(t, reflector, stampTestPort, tc.segments))
+			if ret != XDP_PASS {
+				t.Fatalf("expected XDP_PASS (no FIB route in test netns), got %d", ret)
+			}
+
+			p := gopacket.NewPacket(out, layers.LayerTypeEthernet, gopacket.Default)
+			ip6, ok := p.Layer(layers.LayerTypeIPv6).(*layers.IPv6)
+			if !ok {
+				t.Fatalf("no IPv6 layer in output")
+			}
+			if !ip6.SrcIP.Equal(net.ParseIP(reflector)) || !ip6.DstIP.Equal(net.ParseIP("fd00:1::1")) {
+				t.Errorf("addresses: got %s -> %s, want %s -> fd00:1::1", ip6.SrcIP, ip6.DstIP, reflector)
+			}
+			if ip6.NextHeader != layers.IPProtocolUDP {
+				t.Fatalf("next header: got %d, want UDP (SRH removed)", ip6.NextHeader)
+			}
+			if ip6.HopLimit != 255 {
+				t.Errorf("hop limit: got %d, want 255", ip6.HopLimit)
+			}
+
+			msg := ip6.Payload
+			if len(msg) != 8+stampPacketLen {
+				t.Fatalf("UDP length: got %d, want %d", len(msg), 8+stampPacketLen)
+			}
+			if sp, dp := binary.BigEndian.Uint16(msg[0:2]), binary.BigEndian.Uint16(msg[2:4]); sp != stampTestPort || dp != 40000 {
+				t.Errorf("ports: got %d -> %d, want %d -> 40000", sp, dp, stampTestPort)
+			}
+			if !udpChecksumOK(ip6.SrcIP, ip6.DstIP, msg) {
+				t.Errorf("UDP checksum invalid")
+			}
+
+			body := msg[8:]
+			if got := binary.BigEndian.Uint32(body[0:4]); got != stampSenderSeq {
+				t.Errorf("seq: got %#x, want %#x", got, stampSenderSeq)
+			}
+			if got := binary.BigEndian.Uint16(body[12:14]); got != 0x4001 {
+				t.Errorf("error estimate: got %#x, want 0x4001", got)
+			}
+			if got := binary.BigEndian.Uint32(body[24:28]); got != stampSenderSeq {
+				t.Errorf("sender seq: got %#x, want %#x", got, stampSenderSeq)
+			}
+			if sec, nsec := binary.BigEndian.Uint32(body[28:32]), binary.BigEndian.Uint32(body[32:36]); sec != stampSenderSec || nsec != stampSenderNsec {
+				t.Errorf("sender timestamp: got %#x.%#x, want %#x.%#x", sec, nsec, stampSenderSec, stampSenderNsec)
+			}
+			if got := body[40]; got != 62 {
+				t.Errorf("sender TTL: got %d, want 62", got)
+			}
+			rxSec, rxNsec := binary.BigEndian.Uint32(body[16:20]), binary.BigEndian.Uint32(body[20:24])
+			txSec, txNsec := binary.BigEndian.Uint32(body[4:8]), binary.BigEndian.Uint32(body[8:12])
+			if rxSec == 0 || rxNsec >= 1e9 || txNsec >= 1e9 {
+				t.Errorf("invalid reflector timestamps: rx %d.%d tx %d.%d", rxSec, rxNsec, txSec, txNsec)
+			}
+			if uint64(txSec)*1e9+uint64(txNsec) < uint64(rxSec)*1e9+uint64(rxNsec) {
+				t.Errorf("transmit timestamp %d.%d before receive timestamp %d.%d", txSec, txNsec, rxSec, rxNsec)
+			}
+
+			if got := readStatCounter(t, h, "STAMP_REFLECT"); got != 1 {
+				t.Errorf("STAMP_REFLECT: got %d, want 1", got)
+			}
+			entries, err := h.mapOps.ListStampReflectors()
+			if err != nil {
+				t.Fatalf("ListStampReflectors: %v", err)
+			}
+			for _, e := range entries {
+				if e.Reflected != 1 {
+					t.Errorf("reflected: got %d, want 1", e.Reflected)
+				}
+			}
+		})
+	}
+
+	t.Run("other port passes through", func(t *testing.T) {
+		h := newXDPTestHelperWithStats(t)
+		if err := h.mapOps.CreateStampReflector(reflector, stampTestPort); err != nil {
+			t.Fatalf("CreateStampReflector: %v", err)
+		}
+
+		in := buildStampTestPacket(t, reflector, 863, nil)
+		ret, out := h.run(in)
+		if ret != XDP_PASS {
+			t.Fatalf("expected XDP_PASS, got %d", ret)
+		}
+		if string(out) != string(in) {
+			t.Errorf("packet modified for a port without a reflector")
+		}
+		if got := readStatCounter(t, h, "STAMP_REFLECT"); got != 0 {
+			t.Errorf("STAMP_REFLECT: got %d, want 0", got)
+		}
+	})
+}
@@ -0,0 +1,67 @@
+package bpf
+
+import (
+	"testing"
+)
+
+// Storm control sits on the TC Mode 1 path, which BPF_PROG_TEST_RUN cannot
+// reach (no data_meta injection, see tc_test.go), so only the map
+// operations are unit-tested here.
+func TestStormControlMapOperations(t *testing.T) {
+	h := newXDPTestHelper(t)
+	bdKey := BdMacLimitKey(100)
+	acKey := AcMacLimitKey(1, HeadendL2VlanAny)
+
+	if err := h.mapOps.SetStormControl(bdKey, 1000, 0); err != nil {
+		t.Fatalf("SetStormControl(BD): %v", err)
+	}
+	if err := h.mapOps.SetStormControl(acKey, 0, 1_000_000); err != nil {
+		t.Fatalf("SetStormControl(AC): %v", err)
+	}
+
+	entries, err := h.mapOps.ListStormControls()
+	if err != nil {
+		t.Fatalf("ListStormControls: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("got %d entries, want 2", len(entries))
+	}
+	if e := entries[bdKey]; e == nil || e.Pps != 1000 || e.Bps != 0 {
+		t.Errorf("BD entry = %+v, want pps 1000", e)
+	}
+	if e := entries[acKey]; e == nil || e.Pps != 0 || e.Bps != 1_000_000 {
+		t.Errorf("AC entry = %+v, want bps 1000000", e)
+	}
+
+	// The data plane has been dropping: replacing the rates refills the
+	// buckets but keeps the counters.
+	used := StormCtlEntry{Pps: 1000, LastNs: 12345, PktCreditPs: 7, DropPackets: 3, DropBytes: 180}
+	if err := h.mapOps.objs.StormCtlMap.Put(&bdKey, &used); err != nil {
+		t.Fatalf("put: %v", err)
+	}
+	if err := h.mapOps.SetStormControl(bdKey, 2000, 64000); err != nil {
+		t.Fatalf("SetStormControl(BD) again: %v", err)
+	}
+	var got StormCtlEntry
+	if err := h.mapOps.objs.StormCtlMap.Lookup(&bdKey, &got); err != nil {
+		t.Fatalf("lookup: %v", err)
+	}
+	want := StormCtlEntry{Pps: 2000, Bps: 64000, DropPackets: 3, DropBytes: 180}
+	if got != want {
+		t.Errorf("after re-set: got %+v, want %+v", got, want)
+	}
+
+	if err := h.mapOps.DeleteStormControl(acKey); err != nil {
+		t.Fatalf("DeleteStormControl: %v", err)
+	}
+	if err := h.mapOps.DeleteStormControl(acKey); err == nil {
+		t.Error("deleting a missing storm control should fail")
+	}
+	entries, err = h.mapOps.ListStormControls()
+	if err != nil {
+		t.Fatalf("ListStormControls: %v", err)
+	}
+	if _, ok := entries[acKey]; ok || len(entries) != 1 {
+		t.Errorf("after delete: got %d entries, want only the BD", len(entries))
+	}
+}
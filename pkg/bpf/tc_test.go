@@ -178,12 +178,12 @@ func TestTCBumProgramLoaded(t *testing.T) {
 		BdId:        100,
 	}
 
-	if err := h.mapOps.CreateHeadendL2(1, 100, entry, [ESILen]byte{}); err != nil {
+	if err := h.mapOps.CreateHeadendL2(HeadendL2AC{Ifindex: 1, VlanID: 100}, entry); err != nil {
 		t.Fatalf("Failed to create headend L2 entry: %v", err)
 	}
-	t.Cleanup(func() { _ = h.mapOps.DeleteHeadendL2(1, 100) })
+	t.Cleanup(func() { _ = h.mapOps.DeleteHeadendL2(HeadendL2Key{Ifindex: 1, VlanId: 100}) })
 
-	got, err := h.mapOps.GetHeadendL2(1, 100)
+	got, err := h.mapOps.GetHeadendL2(HeadendL2Key{Ifindex: 1, VlanId: 100})
 	if err != nil {
 		t.Fatalf("Failed to get headend L2 entry: %v", err)
 	}
@@ -0,0 +1,369 @@
+package bpf
+
+import (
+	"bytes"
+	"net"
+	"testing"
+
+	vinberov1 "github.com/takehaya/vinbero/api/vinbero/v1"
+	"github.com/takehaya/vinbero/pkg/packet"
+)
+
+const (
+	vxlanGwBdID = uint16(100)
+	vxlanGwVni  = uint32(5000)
+)
+
+var vxlanGwBroadcast = net.HardwareAddr{0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+
+// buildVxlanGwInnerFrame returns the untagged frame carried over VXLAN:
+// 00:..:01 -> dst, IPv4 10.0.0.1 -> 10.0.0.2.
+func buildVxlanGwInnerFrame(t *testing.T, dst net.HardwareAddr) []byte {
+	t.Helper()
+	frame, err := buildSimpleIPv4Packet(net.ParseIP("10.0.0.1").To4(), net.ParseIP("10.0.0.2").To4())
+	if err != nil {
+		t.Fatalf("buildSimpleIPv4Packet: %v", err)
+	}
+	overrideDstMAC(frame, dst)
+	return frame
+}
+
+// setupVxlanGwPeer installs SRv6 peer 0 of the gateway BD (H.Encaps.L2
+// from fc00::1 via fc00::200) and a remote FDB entry for 00:..:02 behind it.
+func (h *xdpTestHelper) setupVxlanGwPeer() {
+	h.t.Helper()
+	src, _ := ParseIPv6("fc00::1")
+	segments, numSegments, _ := ParseSegments([]string{"fc00::200"})
+	peer := &HeadendEntry{
+		Mode:        uint8(vinberov1.Srv6HeadendBehavior_SRV6_HEADEND_BEHAVIOR_H_ENCAPS_L2),
+		NumSegments: numSegments,
+		SrcAddr:     src,
+		Segments:    segments,
+		BdId:        vxlanGwBdID,
+	}
+	if err := h.mapOps.CreateBdPeer(vxlanGwBdID, 0, peer, [ESILen]byte{}); err != nil {
+		h.t.Fatalf("CreateBdPeer: %v", err)
+	}
+	mac := net.HardwareAddr{0x00, 0x00, 0x00, 0x00, 0x00, 0x02}
+	if err := h.mapOps.CreateFdb(vxlanGwBdID, mac, &FdbEntry{IsRemote: 1, BdId: vxlanGwBdID}); err != nil {
+		h.t.Fatalf("CreateFdb: %v", err)
+	}
+}
+
+// createVtepFdb installs a remote FDB entry for mac behind VTEP index 0.
+func (h *xdpTestHelper) createVtepFdb(mac net.HardwareAddr) {
+	h.t.Helper()
+	entry := &FdbEntry{IsRemote: 1, Vtep: 1, BdId: vxlanGwBdID}
+	if err := h.mapOps.CreateFdb(vxlanGwBdID, mac, entry); err != nil {
+		h.t.Fatalf("CreateFdb: %v", err)
+	}
+}
+
+func vxlanStat(t *testing.T, h *xdpTestHelper, name string) uint64 {
+	t.Helper()
+	stats, err := h.mapOps.ReadStats()
+	if err != nil {
+		t.Fatalf("ReadStats: %v", err)
+	}
+	for _, s := range stats {
+		if s.Name == name {
+			return s.Packets
+		}
+	}
+	return 0
+}
+
+// TestVniMappingMapOperations verifies create / replace / list / delete of
+// VNI mappings and their validation.
+func TestVniMappingMapOperations(t *testing.T) {
+	h := newXDPTestHelper(t)
+	local := net.ParseIP("192.0.2.1")
+	vteps := []net.IP{net.ParseIP("192.0.2.2"), net.ParseIP("192.0.2.3")}
+
+	if err := h.mapOps.CreateVniMapping(vxlanGwVni, vxlanGwBdID, local, vteps, 0); err != nil {
+		t.Fatalf("CreateVniMapping: %v", err)
+	}
+	if got := h.mapOps.VniForBd(vxlanGwBdID); got != vxlanGwVni {
+		t.Errorf("VniForBd: got %d, want %d", got, vxlanGwVni)
+	}
+	if ip, err := h.mapOps.GetVtep(vxlanGwBdID, 1); err != nil || !ip.Equal(vteps[1]) {
+		t.Errorf("GetVtep(1): got %v, %v", ip, err)
+	}
+
+	// A MAC learned behind a VTEP is flushed when the VTEP list changes
+	h.createVtepFdb(net.HardwareAddr{0x00, 0x00, 0x00, 0x00, 0x00, 0x09})
+
+	if err := h.mapOps.CreateVniMapping(vxlanGwVni, vxlanGwBdID, local, vteps[1:], 0); err != nil {
+		t.Fatalf("CreateVniMapping replace: %v", err)
+	}
+	mappings, err := h.mapOps.ListVniMappings()
+	if err != nil {
+		t.Fatalf("ListVniMappings: %v", err)
+	}
+	m := mappings[vxlanGwVni]
+	if m == nil || m.BdID != vxlanGwBdID || !m.LocalVtep.Equal(local) {
+		t.Fatalf("ListVniMappings: got %+v", m)
+	}
+	if len(m.RemoteVteps) != 1 || !m.RemoteVteps[0].Equal(vteps[1]) {
+		t.Errorf("RemoteVteps after replace: got %v, want [%s]", m.RemoteVteps, vteps[1])
+	}
+	if _, err := h.mapOps.GetVtep(vxlanGwBdID, 1); err == nil {
+		t.Error("stale VTEP slot 1 still present after replace")
+	}
+	if _, err := h.mapOps.GetFdb(vxlanGwBdID, net.HardwareAddr{0x00, 0x00, 0x00, 0x00, 0x00, 0x09}); err == nil {
+		t.Error("VTEP-learned FDB entry survived the VTEP list change")
+	}
+
+	rejects := []struct {
+		name   string
+		vni    uint32
+		bdID   uint16
+		local  net.IP
+		remote []net.IP
+	}{
+		{"vni 0", 0, 200, local, nil},
+		{"vni over 24 bits", 1 << 24, 200, local, nil},
+		{"bd 0", 6000, 0, local, nil},
+		{"vni on another bd", vxlanGwVni, 200, local, nil},
+		{"bd on another vni", 6000, vxlanGwBdID, local, nil},
+		{"mixed families", 6000, 200, local, []net.IP{net.ParseIP("2001:db8::2")}},
+	}
+	for _, tc := range rejects {
+		if err := h.mapOps.CreateVniMapping(tc.vni, tc.bdID, tc.local, tc.remote, 0); err == nil {
+			t.Errorf("%s: expected error", tc.name)
+		}
+	}
+
+	if err := h.mapOps.DeleteVniMapping(vxlanGwVni); err != nil {
+		t.Fatalf("DeleteVniMapping: %v", err)
+	}
+	if got := h.mapOps.VniForBd(vxlanGwBdID); got != 0 {
+		t.Errorf("VniForBd after delete: got %d", got)
+	}
+	if _, err := h.mapOps.GetVtep(vxlanGwBdID, 0); err == nil {
+		t.Error("VTEP slot 0 still present after delete")
+	}
+	if err := h.mapOps.DeleteVniMapping(vxlanGwVni); err == nil {
+		t.Error("second DeleteVniMapping: expected error")
+	}
+}
+
+// TestXDPVxlanGwDecapToSRv6 verifies that VXLAN to the local VTEP of a
+// mapped VNI is decapsulated, its source MAC learned behind the sending
+// VTEP, and the inner frame re-encapsulated with H.Encaps.L2 toward the
+// SRv6 peer owning the destination MAC.
+func TestXDPVxlanGwDecapToSRv6(t *testing.T) {
+	tests := []struct {
+		name   string
+		local  string
+		remote string
+	}{
+		{"IPv4 underlay", "192.0.2.1", "192.0.2.2"},
+		{"IPv6 underlay", "2001:db8::1", "2001:db8::2"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newXDPTestHelperWithStats(t)
+			h.setupVxlanGwPeer()
+			local, remote := net.ParseIP(tc.local), net.ParseIP(tc.remote)
+			if err := h.mapOps.CreateVniMapping(vxlanGwVni, vxlanGwBdID, local, []net.IP{remote}, 0); err != nil {
+				t.Fatalf("CreateVniMapping: %v", err)
+			}
+
+			inner := buildVxlanGwInnerFrame(t, net.HardwareAddr{0x00, 0x00, 0x00, 0x00, 0x00, 0x02})
+			pkt, err := buildVXLANPacket(remote, local, vxlanGwVni, inner)
+			if err != nil {
+				t.Fatalf("buildVXLANPacket: %v", err)
+			}
+
+			ret, out := h.run(pkt)
+			// FIB lookup fails under BPF_PROG_RUN, so the encapsulated
+			// packet is dropped rather than redirected.
+			if ret == XDP_PASS {
+				t.Fatalf("expected H.Encaps.L2, got XDP_PASS")
+			}
+			src, _ := ParseIPv6("fc00::1")
+			dst, _ := ParseIPv6("fc00::200")
+			if !verifyEtherType(t, out, 0x86DD) || !verifyOuterIPv6Header(t, out, src, dst) {
+				return
+			}
+			if !bytes.HasSuffix(out, inner) {
+				t.Error("inner frame not carried after the SRH")
+			}
+
+			learned, err := h.mapOps.GetFdb(vxlanGwBdID, net.HardwareAddr{0x00, 0x00, 0x00, 0x00, 0x00, 0x01})
+			if err != nil {
+				t.Fatalf("source MAC not learned: %v", err)
+			}
+			if learned.IsRemote != 1 || learned.Vtep != 1 || learned.PeerIndex != 0 {
+				t.Errorf("learned entry: got is_remote=%d vtep=%d peer_index=%d, want 1/1/0",
+					learned.IsRemote, learned.Vtep, learned.PeerIndex)
+			}
+			if got := vxlanStat(t, h, "VXLAN_DECAP"); got != 1 {
+				t.Errorf("VXLAN_DECAP: got %d, want 1", got)
+			}
+		})
+	}
+}
+
+// TestXDPVxlanGwPassThrough verifies that VXLAN the gateway doesn't own is
+// left untouched for the kernel.
+func TestXDPVxlanGwPassThrough(t *testing.T) {
+	h := newXDPTestHelper(t)
+	local := net.ParseIP("192.0.2.1")
+	if err := h.mapOps.CreateVniMapping(vxlanGwVni, vxlanGwBdID, local, nil, 0); err != nil {
+		t.Fatalf("CreateVniMapping: %v", err)
+	}
+	inner := buildVxlanGwInnerFrame(t, vxlanGwBroadcast)
+
+	tests := []struct {
+		name string
+		dst  string
+		vni  uint32
+	}{
+		{"unmapped VNI", "192.0.2.1", 6000},
+		{"not the local VTEP", "192.0.2.9", vxlanGwVni},
+		{"other address family", "2001:db8::1", vxlanGwVni},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			src := net.ParseIP("192.0.2.2")
+			if net.ParseIP(tc.dst).To4() == nil {
+				src = net.ParseIP("2001:db8::2")
+			}
+			pkt, err := buildVXLANPacket(src, net.ParseIP(tc.dst), tc.vni, inner)
+			if err != nil {
+				t.Fatalf("buildVXLANPacket: %v", err)
+			}
+			ret, out := h.run(pkt)
+			if ret != XDP_PASS {
+				t.Fatalf("expected XDP_PASS, got %d", ret)
+			}
+			if !bytes.Equal(out, pkt) {
+				t.Error("packet modified")
+			}
+		})
+	}
+}
+
+// TestXDPVxlanGwFlood verifies that BUM and unknown unicast from a VTEP are
+// decapsulated and passed to TC, which replicates them to the SRv6 peers,
+// and that a frame to a MAC behind another VTEP is dropped.
+func TestXDPVxlanGwFlood(t *testing.T) {
+	h := newXDPTestHelper(t)
+	local, remote := net.ParseIP("192.0.2.1"), net.ParseIP("192.0.2.2")
+	if err := h.mapOps.CreateVniMapping(vxlanGwVni, vxlanGwBdID, local, []net.IP{remote}, 0); err != nil {
+		t.Fatalf("CreateVniMapping: %v", err)
+	}
+	vtepMAC := net.HardwareAddr{0x00, 0x00, 0x00, 0x00, 0x00, 0x07}
+	h.createVtepFdb(vtepMAC)
+
+	tests := []struct {
+		name         string
+		dst          net.HardwareAddr
+		expectAction uint32
+	}{
+		{"broadcast", vxlanGwBroadcast, XDP_PASS},
+		{"unknown unicast", net.HardwareAddr{0x00, 0x00, 0x00, 0x00, 0x00, 0x05}, XDP_PASS},
+		{"MAC behind a VTEP", vtepMAC, XDP_DROP},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			inner := buildVxlanGwInnerFrame(t, tc.dst)
+			pkt, err := buildVXLANPacket(remote, local, vxlanGwVni, inner)
+			if err != nil {
+				t.Fatalf("buildVXLANPacket: %v", err)
+			}
+			ret, out := h.run(pkt)
+			if ret != tc.expectAction {
+				t.Fatalf("expected action %d, got %d", tc.expectAction, ret)
+			}
+			// The kernel may or may not prepend the 8-byte gateway meta
+			if ret == XDP_PASS && !bytes.HasSuffix(out, inner) {
+				t.Errorf("expected the decapsulated inner frame, got %d bytes", len(out))
+			}
+		})
+	}
+}
+
+// TestXDPVxlanGwEncapFromSRv6 verifies that End.DT2 traffic to a MAC learned
+// behind a VTEP is VXLAN-encapsulated toward that VTEP.
+func TestXDPVxlanGwEncapFromSRv6(t *testing.T) {
+	tests := []struct {
+		name   string
+		local  string
+		remote string
+	}{
+		{"IPv4 underlay", "192.0.2.1", "192.0.2.2"},
+		{"IPv6 underlay", "2001:db8::1", "2001:db8::2"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newXDPTestHelperWithStats(t)
+			h.createSidFunctionWithBD("fd00:1:100::10/128", actionEndDT2, vxlanGwBdID)
+			if err := h.mapOps.CreateVniMapping(vxlanGwVni, vxlanGwBdID,
+				net.ParseIP(tc.local), []net.IP{net.ParseIP(tc.remote)}, 0); err != nil {
+				t.Fatalf("CreateVniMapping: %v", err)
+			}
+			h.createVtepFdb(net.HardwareAddr{0x00, 0x00, 0x00, 0x00, 0x00, 0x02})
+
+			pkt, err := buildL2EncapsulatedPacket(
+				net.ParseIP("fd00:1:1::1"), net.ParseIP("fd00:1:100::10"),
+				[]net.IP{net.ParseIP("fd00:1:100::10")}, 0,
+				100, net.ParseIP("10.0.0.1"), net.ParseIP("192.0.2.100"), true,
+			)
+			if err != nil {
+				t.Fatalf("buildL2EncapsulatedPacket: %v", err)
+			}
+			inner, err := buildVlanTaggedIPv4Packet(100, net.ParseIP("10.0.0.1").To4(), net.ParseIP("192.0.2.100").To4())
+			if err != nil {
+				t.Fatalf("buildVlanTaggedIPv4Packet: %v", err)
+			}
+
+			ret, out := h.run(pkt)
+			if ret == XDP_PASS {
+				t.Fatalf("expected VXLAN encap, got XDP_PASS")
+			}
+			vni, gotInner, err := packet.DecodeVXLAN(out)
+			if err != nil {
+				t.Fatalf("DecodeVXLAN: %v", err)
+			}
+			if vni != vxlanGwVni {
+				t.Errorf("VNI: got %d, want %d", vni, vxlanGwVni)
+			}
+			if !bytes.Equal(gotInner, inner) {
+				t.Errorf("inner frame mismatch:\n got %x\nwant %x", gotInner, inner)
+			}
+			if got := vxlanStat(t, h, "VXLAN_ENCAP"); got != 1 {
+				t.Errorf("VXLAN_ENCAP: got %d, want 1", got)
+			}
+		})
+	}
+}
+
+// TestXDPVxlanGwUnknownFromSRv6 verifies that End.DT2 unknown unicast in a
+// mapped BD is passed to TC (gateway meta) so it reaches the VTEPs too.
+func TestXDPVxlanGwUnknownFromSRv6(t *testing.T) {
+	h := newXDPTestHelper(t)
+	h.createSidFunctionWithBD("fd00:1:100::10/128", actionEndDT2, vxlanGwBdID)
+	if err := h.mapOps.CreateVniMapping(vxlanGwVni, vxlanGwBdID,
+		net.ParseIP("192.0.2.1"), []net.IP{net.ParseIP("192.0.2.2")}, 0); err != nil {
+		t.Fatalf("CreateVniMapping: %v", err)
+	}
+
+	pkt, err := buildL2EncapsulatedPacket(
+		net.ParseIP("fd00:1:1::1"), net.ParseIP("fd00:1:100::10"),
+		[]net.IP{net.ParseIP("fd00:1:100::10")}, 0,
+		100, net.ParseIP("10.0.0.1"), net.ParseIP("192.0.2.100"), true,
+	)
+	if err != nil {
+		t.Fatalf("buildL2EncapsulatedPacket: %v", err)
+	}
+	if ret, _ := h.run(pkt); ret != XDP_PASS {
+		t.Fatalf("expected XDP_PASS, got %d", ret)
+	}
+}
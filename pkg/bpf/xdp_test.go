@@ -931,12 +931,12 @@ func TestHeadendL2MapOperations(t *testing.T) {
 	}
 
 	// Create with (ifindex=1, vlan=100)
-	if err := h.mapOps.CreateHeadendL2(1, 100, entry, [ESILen]byte{}); err != nil {
+	if err := h.mapOps.CreateHeadendL2(HeadendL2AC{Ifindex: 1, VlanID: 100}, entry); err != nil {
 		t.Fatalf("Create (1, 100) failed: %v", err)
 	}
 
 	// Get with same key → match
-	got, err := h.mapOps.GetHeadendL2(1, 100)
+	got, err := h.mapOps.GetHeadendL2(HeadendL2Key{Ifindex: 1, VlanId: 100})
 	if err != nil {
 		t.Fatalf("Get (1, 100) failed: %v", err)
 	}
@@ -947,7 +947,7 @@ func TestHeadendL2MapOperations(t *testing.T) {
 	// Create with (ifindex=2, vlan=100) → separate entry (same VLAN, different port)
 	entry2 := *entry
 	entry2.BdId = 200
-	if err := h.mapOps.CreateHeadendL2(2, 100, &entry2, [ESILen]byte{}); err != nil {
+	if err := h.mapOps.CreateHeadendL2(HeadendL2AC{Ifindex: 2, VlanID: 100}, &entry2); err != nil {
 		t.Fatalf("Create (2, 100) failed: %v", err)
 	}
 
@@ -961,8 +961,8 @@ func TestHeadendL2MapOperations(t *testing.T) {
 	}
 
 	// Verify they are separate
-	got1, err1 := h.mapOps.GetHeadendL2(1, 100)
-	got2, err2 := h.mapOps.GetHeadendL2(2, 100)
+	got1, err1 := h.mapOps.GetHeadendL2(HeadendL2Key{Ifindex: 1, VlanId: 100})
+	got2, err2 := h.mapOps.GetHeadendL2(HeadendL2Key{Ifindex: 2, VlanId: 100})
 	if err1 != nil || err2 != nil {
 		t.Fatalf("Get failed: err1=%v, err2=%v", err1, err2)
 	}
@@ -971,7 +971,7 @@ func TestHeadendL2MapOperations(t *testing.T) {
 	}
 
 	// Delete one
-	if err := h.mapOps.DeleteHeadendL2(1, 100); err != nil {
+	if err := h.mapOps.DeleteHeadendL2(HeadendL2Key{Ifindex: 1, VlanId: 100}); err != nil {
 		t.Fatalf("Delete (1, 100) failed: %v", err)
 	}
 
@@ -987,7 +987,7 @@ func TestHeadendL2MapOperations(t *testing.T) {
 	t.Logf("SUCCESS: HeadendL2 CRUD with (ifindex, vlan_id) key works correctly")
 
 	// Cleanup
-	_ = h.mapOps.DeleteHeadendL2(2, 100)
+	_ = h.mapOps.DeleteHeadendL2(HeadendL2Key{Ifindex: 2, VlanId: 100})
 }
 
 // TestXDPBumMetaWrite verifies that XDP writes BUM metadata (__u64) when
@@ -188,7 +188,7 @@ func (h *xdpTestHelper) createSidFunctionWithTableID(prefix string, action uint8
 
 func (h *xdpTestHelper) createDx2vVlanEntry(tableID, vlanID uint16, oif uint32) {
 	h.t.Helper()
-	if err := h.mapOps.CreateDx2vVlan(tableID, vlanID, oif); err != nil {
+	if err := h.mapOps.CreateDx2vVlan(tableID, vlanID, oif, VlanRewrite{}); err != nil {
 		h.t.Fatalf("Failed to create DX2V VLAN entry: %v", err)
 	}
 }
@@ -761,11 +761,11 @@ func (h *xdpTestHelper) createHeadendL2EntryWithMode(ifindex uint32, vlanID uint
 		Segments:    segments,
 		BdId:        bdID,
 	}
-	if err := h.mapOps.CreateHeadendL2(ifindex, vlanID, entry, [ESILen]byte{}); err != nil {
+	if err := h.mapOps.CreateHeadendL2(HeadendL2AC{Ifindex: ifindex, VlanID: vlanID}, entry); err != nil {
 		h.t.Fatalf("Failed to create headend L2 entry: %v", err)
 	}
 	h.t.Cleanup(func() {
-		_ = h.mapOps.DeleteHeadendL2(ifindex, vlanID)
+		_ = h.mapOps.DeleteHeadendL2(HeadendL2Key{Ifindex: ifindex, VlanId: vlanID})
 	})
 }
 
@@ -1067,6 +1067,42 @@ func buildGTPUv4Packet(outerSrc, outerDst net.IP, teid uint32, qfi uint8, innerS
 	return buf.Bytes(), nil
 }
 
+// buildVXLANPacket builds a VXLAN packet carrying innerFrame on vni.
+// Structure: [Eth][IPv4 or IPv6][UDP:4789][VXLAN][innerFrame]
+// The outer family follows outerSrc.
+func buildVXLANPacket(outerSrc, outerDst net.IP, vni uint32, innerFrame []byte) ([]byte, error) {
+	udp, vxlan := packet.VXLANLayers(vni, 49152)
+
+	var eth *layers.Ethernet
+	var outerIP gopacket.SerializableLayer
+	if outerSrc.To4() != nil {
+		eth = newTestEthernet(layers.EthernetTypeIPv4)
+		ip4 := &layers.IPv4{
+			Version: 4, IHL: 5, TTL: 64,
+			Protocol: layers.IPProtocolUDP,
+			SrcIP:    outerSrc.To4(),
+			DstIP:    outerDst.To4(),
+		}
+		_ = udp.SetNetworkLayerForChecksum(ip4)
+		outerIP = ip4
+	} else {
+		eth = newTestEthernet(layers.EthernetTypeIPv6)
+		ip6 := &layers.IPv6{
+			Version: 6, SrcIP: outerSrc, DstIP: outerDst,
+			NextHeader: layers.IPProtocolUDP, HopLimit: 64,
+		}
+		_ = udp.SetNetworkLayerForChecksum(ip6)
+		outerIP = ip6
+	}
+
+	buf := gopacket.NewSerializeBuffer()
+	opts := gopacket.SerializeOptions{FixLengths: true, ComputeChecksums: true}
+	if err := gopacket.SerializeLayers(buf, opts, eth, outerIP, udp, vxlan, gopacket.Payload(innerFrame)); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
 // createSidFunctionGTP6D creates a SID function entry for End.M.GTP6.D
 func (h *xdpTestHelper) createSidFunctionGTP6D(prefix string, argsOffset uint8) {
 	h.t.Helper()
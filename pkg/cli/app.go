@@ -49,13 +49,23 @@ func NewApp() *cli.App {
 			headendV6Command(),
 			headendL2Command(),
 			bdPeerCommand(),
+			bridgeDomainCommand(),
 			esCommand(),
 			bridgeCommand(),
 			vrfCommand(),
 			fdbCommand(),
 			vlanTableCommand(),
+			srDomainCommand(),
+			vniCommand(),
+			oamCommand(),
+			perfCommand(),
 			statsCommand(),
 			pluginCommand(),
+			daemonCommand(),
+			exportCommand(),
+			importCommand(),
+			systemCommand(),
+			transactionCommand(),
 			completion.Command(),
 		},
 	}
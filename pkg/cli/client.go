@@ -19,6 +19,14 @@ type Clients struct {
 	Stats    vinberov1connect.StatsServiceClient
 	Plugin   vinberov1connect.PluginServiceClient
 	Es       vinberov1connect.EthernetSegmentServiceClient
+	SrDomain vinberov1connect.SrDomainServiceClient
+	Oam      vinberov1connect.OamServiceClient
+	Perf     vinberov1connect.PerformanceServiceClient
+	Bd       vinberov1connect.BridgeDomainServiceClient
+	Vni      vinberov1connect.VniMappingServiceClient
+	Daemon   vinberov1connect.DaemonServiceClient
+	System   vinberov1connect.SystemServiceClient
+	Tx       vinberov1connect.TransactionServiceClient
 }
 
 func NewClients(serverURL string) *Clients {
@@ -37,5 +45,13 @@ func NewClients(serverURL string) *Clients {
 		Stats:    vinberov1connect.NewStatsServiceClient(httpClient, serverURL, opts...),
 		Plugin:   vinberov1connect.NewPluginServiceClient(httpClient, serverURL, opts...),
 		Es:       vinberov1connect.NewEthernetSegmentServiceClient(httpClient, serverURL, opts...),
+		SrDomain: vinberov1connect.NewSrDomainServiceClient(httpClient, serverURL, opts...),
+		Oam:      vinberov1connect.NewOamServiceClient(httpClient, serverURL, opts...),
+		Perf:     vinberov1connect.NewPerformanceServiceClient(httpClient, serverURL, opts...),
+		Bd:       vinberov1connect.NewBridgeDomainServiceClient(httpClient, serverURL, opts...),
+		Vni:      vinberov1connect.NewVniMappingServiceClient(httpClient, serverURL, opts...),
+		Daemon:   vinberov1connect.NewDaemonServiceClient(httpClient, serverURL, opts...),
+		System:   vinberov1connect.NewSystemServiceClient(httpClient, serverURL, opts...),
+		Tx:       vinberov1connect.NewTransactionServiceClient(httpClient, serverURL, opts...),
 	}
 }
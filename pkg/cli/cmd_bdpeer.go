@@ -19,26 +19,13 @@ func bdPeerCommand() *cli.Command {
 			{
 				Name:  "create",
 				Usage: "Register a remote PE in a Bridge Domain",
-				Flags: []cli.Flag{
-					&cli.UintFlag{Name: "bd-id", Required: true, Usage: "Bridge Domain ID"},
-					&cli.StringFlag{Name: "src-addr", Required: true, Usage: "Outer IPv6 source address"},
-					&cli.StringFlag{Name: "segments", Required: true, Usage: "Segment list (comma-separated)"},
-					&cli.StringFlag{Name: "mode", Value: "H_ENCAPS_L2", Usage: "Headend mode (H_ENCAPS_L2, H_ENCAPS_L2_RED)"},
-					&cli.StringFlag{Name: "esi", Usage: "RFC 7432 Ethernet Segment Identifier (10 colon-separated hex bytes)"},
-				},
+				Flags: bdPeerFlags(),
 				Action: func(c *cli.Context) error {
 					clients := clientsFromContext(c)
-					mode, err := resolveMode(c.String("mode"))
+					peer, err := bdPeerFromFlags(c)
 					if err != nil {
 						return err
 					}
-					peer := &v1.BdPeer{
-						BdId:     uint32(c.Uint("bd-id")),
-						SrcAddr:  c.String("src-addr"),
-						Segments: strings.Split(c.String("segments"), ","),
-						Mode:     mode,
-						Esi:      c.String("esi"),
-					}
 					resp, err := clients.Peer.BdPeerCreate(context.Background(),
 						connect.NewRequest(&v1.BdPeerCreateRequest{Peers: []*v1.BdPeer{peer}}))
 					if err != nil {
@@ -47,6 +34,28 @@ func bdPeerCommand() *cli.Command {
 					return printOperationResult(resp.Msg.Created, resp.Msg.Errors, "BD peer")
 				},
 			},
+			{
+				Name:  "update",
+				Usage: "Change a remote PE of a Bridge Domain in place; only the flags given are changed",
+				Flags: updateFlags(bdPeerFlags(), "bd-id", "src-addr"),
+				Action: func(c *cli.Context) error {
+					clients := clientsFromContext(c)
+					peer, err := bdPeerFromFlags(c)
+					if err != nil {
+						return err
+					}
+					mask, err := updateMask(c, []string{"bd-id", "src-addr"}, nil)
+					if err != nil {
+						return err
+					}
+					resp, err := clients.Peer.BdPeerUpdate(context.Background(),
+						connect.NewRequest(&v1.BdPeerUpdateRequest{Peers: []*v1.BdPeer{peer}, UpdateMask: mask}))
+					if err != nil {
+						return err
+					}
+					return printUpdateResult(resp.Msg.Updated, resp.Msg.Errors, "BD peer")
+				},
+			},
 			{
 				Name:  "delete",
 				Usage: "Delete all peers for a Bridge Domain",
@@ -114,3 +123,29 @@ func bdPeerCommand() *cli.Command {
 		},
 	}
 }
+
+func bdPeerFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.UintFlag{Name: "bd-id", Required: true, Usage: "Bridge Domain ID"},
+		&cli.StringFlag{Name: "src-addr", Required: true, Usage: "Outer IPv6 source address"},
+		&cli.StringFlag{Name: "segments", Required: true, Usage: "Segment list (comma-separated)"},
+		&cli.StringFlag{Name: "mode", Value: "H_ENCAPS_L2", Usage: "Headend mode (H_ENCAPS_L2, H_ENCAPS_L2_RED)"},
+		&cli.StringFlag{Name: "esi", Usage: "RFC 7432 Ethernet Segment Identifier (10 colon-separated hex bytes)"},
+	}
+}
+
+// bdPeerFromFlags builds the BD peer given by bdPeerFlags.
+func bdPeerFromFlags(c *cli.Context) (*v1.BdPeer, error) {
+	mode, err := resolveMode(c.String("mode"))
+	if err != nil {
+		return nil, err
+	}
+	peer := &v1.BdPeer{
+		BdId:     uint32(c.Uint("bd-id")),
+		SrcAddr:  c.String("src-addr"),
+		Segments: strings.Split(c.String("segments"), ","),
+		Mode:     mode,
+		Esi:      c.String("esi"),
+	}
+	return peer, nil
+}
@@ -0,0 +1,662 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"connectrpc.com/connect"
+	v1 "github.com/takehaya/vinbero/api/vinbero/v1"
+	"github.com/urfave/cli/v2"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// bdScopeFlags select a BD (--bd-id) or an AC (--interface/--vlan-id or
+// --interface/--port-mode) for MAC limits and storm control.
+var bdScopeFlags = []cli.Flag{
+	&cli.UintFlag{Name: "bd-id", Usage: "Bridge Domain ID (BD scope)"},
+	&cli.StringFlag{Name: "interface", Usage: "AC interface name (AC scope, with --vlan-id)"},
+	&cli.UintFlag{Name: "vlan-id", Usage: "AC VLAN ID (0 = untagged)"},
+	&cli.BoolFlag{Name: "port-mode", Usage: "The port-mode AC of --interface"},
+}
+
+func macLimitScope(c *cli.Context) *v1.MacLimit {
+	return &v1.MacLimit{
+		BdId:          uint32(c.Uint("bd-id")),
+		InterfaceName: c.String("interface"),
+		VlanId:        uint32(c.Uint("vlan-id")),
+		PortMode:      c.Bool("port-mode"),
+	}
+}
+
+func stormControlScope(c *cli.Context) *v1.StormControl {
+	return &v1.StormControl{
+		BdId:          uint32(c.Uint("bd-id")),
+		InterfaceName: c.String("interface"),
+		VlanId:        uint32(c.Uint("vlan-id")),
+		PortMode:      c.Bool("port-mode"),
+	}
+}
+
+func bridgeDomainCommand() *cli.Command {
+	return &cli.Command{
+		Name:    "bridge-domain",
+		Aliases: []string{"bd"},
+		Usage:   "Manage Bridge Domains and their ACs, SIDs, peers and ESs",
+		Subcommands: []*cli.Command{
+			{
+				Name:  "create",
+				Usage: "Create a Bridge Domain with its members",
+				Description: "Members (acs, sids, peers, ethernet_segments, mac_limits, neighbors, irb)\n" +
+					"are read from a JSON BridgeDomain given with --file; --name, --bd-id, --bridge,\n" +
+					"--members and the IRB flags override the file. Members inherit the BD's bd_id\n" +
+					"and bridge.",
+				Flags: []cli.Flag{
+					&cli.StringFlag{Name: "file", Usage: "JSON BridgeDomain definition"},
+					&cli.StringFlag{Name: "name", Usage: "Bridge Domain name"},
+					&cli.UintFlag{Name: "bd-id", Usage: "Bridge Domain ID"},
+					&cli.StringFlag{Name: "bridge", Usage: "Linux bridge device owned by the BD"},
+					&cli.StringFlag{Name: "members", Usage: "Bridge member interfaces (comma-separated)"},
+					&cli.StringFlag{Name: "vrf", Usage: "IRB: VRF the BD routes into"},
+					&cli.StringFlag{Name: "gateway-mac", Usage: "IRB: anycast gateway MAC"},
+					&cli.StringFlag{Name: "gateway-ips", Usage: "IRB: gateway addresses with prefix length (comma-separated)"},
+				},
+				Action: func(c *cli.Context) error {
+					clients := clientsFromContext(c)
+					bd, err := bridgeDomainFromFlags(c)
+					if err != nil {
+						return err
+					}
+					resp, err := clients.Bd.BridgeDomainCreate(context.Background(),
+						connect.NewRequest(&v1.BridgeDomainCreateRequest{BridgeDomains: []*v1.BridgeDomain{bd}}))
+					if err != nil {
+						return err
+					}
+					return printOperationResult(resp.Msg.Created, resp.Msg.Errors, "Bridge Domain")
+				},
+			},
+			{
+				Name:      "delete",
+				Usage:     "Delete Bridge Domains and everything they own",
+				ArgsUsage: "NAME [NAME...]",
+				Action: func(c *cli.Context) error {
+					clients := clientsFromContext(c)
+					if c.NArg() == 0 {
+						return fmt.Errorf("bridge domain name required")
+					}
+					resp, err := clients.Bd.BridgeDomainDelete(context.Background(),
+						connect.NewRequest(&v1.BridgeDomainDeleteRequest{Names: c.Args().Slice()}))
+					if err != nil {
+						return err
+					}
+					for _, e := range resp.Msg.Errors {
+						fmt.Fprintf(os.Stderr, "Error [%s]: %s\n", e.TriggerPrefix, e.Reason)
+					}
+					fmt.Printf("Deleted: %s\n", strings.Join(resp.Msg.DeletedNames, ", "))
+					if len(resp.Msg.Errors) > 0 {
+						return fmt.Errorf("%d error(s) occurred", len(resp.Msg.Errors))
+					}
+					return nil
+				},
+			},
+			{
+				Name:      "show",
+				Usage:     "Show a Bridge Domain with all of its members",
+				ArgsUsage: "NAME",
+				Action: func(c *cli.Context) error {
+					clients := clientsFromContext(c)
+					if c.NArg() != 1 {
+						return fmt.Errorf("exactly one bridge domain name required")
+					}
+					resp, err := clients.Bd.BridgeDomainGet(context.Background(),
+						connect.NewRequest(&v1.BridgeDomainGetRequest{Name: c.Args().First()}))
+					if err != nil {
+						return err
+					}
+					if useJSON(c) {
+						return printJSON(resp.Msg.BridgeDomain)
+					}
+					printBridgeDomain(resp.Msg.BridgeDomain)
+					return nil
+				},
+			},
+			{
+				Name:  "list",
+				Usage: "List Bridge Domains",
+				Action: func(c *cli.Context) error {
+					clients := clientsFromContext(c)
+					resp, err := clients.Bd.BridgeDomainList(context.Background(),
+						connect.NewRequest(&v1.BridgeDomainListRequest{}))
+					if err != nil {
+						return err
+					}
+					if useJSON(c) {
+						return printJSON(resp.Msg.BridgeDomains)
+					}
+					headers := []string{"NAME", "BD_ID", "BRIDGE", "VRF", "ACS", "SIDS", "PEERS", "ESS", "FDB"}
+					var rows [][]string
+					for _, bd := range resp.Msg.BridgeDomains {
+						bridge := "-"
+						if bd.Bridge != nil {
+							bridge = bd.Bridge.Name
+						}
+						vrf := "-"
+						if bd.Irb != nil {
+							vrf = bd.Irb.VrfName
+						}
+						rows = append(rows, []string{
+							bd.Name, strconv.Itoa(int(bd.BdId)), bridge, vrf,
+							strconv.Itoa(len(bd.Acs)), strconv.Itoa(len(bd.Sids)),
+							strconv.Itoa(len(bd.Peers)), strconv.Itoa(len(bd.EthernetSegments)),
+							strconv.Itoa(int(bd.FdbEntries)),
+						})
+					}
+					printTable(headers, rows)
+					return nil
+				},
+			},
+			{
+				Name:  "mac-limit",
+				Usage: "Manage per-BD / per-AC MAC learning limits",
+				Subcommands: []*cli.Command{
+					{
+						Name:  "set",
+						Usage: "Set the MAC learning controls of a BD or AC",
+						Flags: append([]cli.Flag{
+							&cli.UintFlag{Name: "max", Usage: "Maximum dynamic MACs (0 = unlimited)"},
+							&cli.StringFlag{Name: "action", Value: "DROP", Usage: "Action at the limit (DROP, FLOOD)"},
+							&cli.BoolFlag{Name: "no-learning", Usage: "Disable MAC learning"},
+						}, bdScopeFlags...),
+						Action: func(c *cli.Context) error {
+							clients := clientsFromContext(c)
+							action, err := resolveMacLimitAction(c.String("action"))
+							if err != nil {
+								return err
+							}
+							limit := macLimitScope(c)
+							limit.MaxMacs = uint32(c.Uint("max"))
+							limit.LimitAction = action
+							limit.LearningDisabled = c.Bool("no-learning")
+							resp, err := clients.Bd.MacLimitSet(context.Background(),
+								connect.NewRequest(&v1.MacLimitSetRequest{Limits: []*v1.MacLimit{limit}}))
+							if err != nil {
+								return err
+							}
+							return printOperationResult(resp.Msg.Set, resp.Msg.Errors, "MAC limit")
+						},
+					},
+					{
+						Name:  "delete",
+						Usage: "Remove the MAC learning controls of a BD or AC",
+						Flags: bdScopeFlags,
+						Action: func(c *cli.Context) error {
+							clients := clientsFromContext(c)
+							resp, err := clients.Bd.MacLimitDelete(context.Background(),
+								connect.NewRequest(&v1.MacLimitDeleteRequest{Limits: []*v1.MacLimit{macLimitScope(c)}}))
+							if err != nil {
+								return err
+							}
+							if len(resp.Msg.Errors) > 0 {
+								e := resp.Msg.Errors[0]
+								return fmt.Errorf("%s: %s", e.TriggerPrefix, e.Reason)
+							}
+							fmt.Printf("Deleted: %d\n", len(resp.Msg.Deleted))
+							return nil
+						},
+					},
+					{
+						Name:  "list",
+						Usage: "List MAC limits and current MAC counts",
+						Flags: []cli.Flag{
+							&cli.UintFlag{Name: "bd-id", Usage: "Filter by Bridge Domain ID"},
+						},
+						Action: func(c *cli.Context) error {
+							clients := clientsFromContext(c)
+							resp, err := clients.Bd.MacLimitList(context.Background(),
+								connect.NewRequest(&v1.MacLimitListRequest{BdId: uint32(c.Uint("bd-id"))}))
+							if err != nil {
+								return err
+							}
+							if useJSON(c) {
+								return printJSON(resp.Msg.Limits)
+							}
+							headers := []string{"BD_ID", "INTERFACE", "VLAN", "MACS", "MAX", "ACTION", "LEARNING"}
+							var rows [][]string
+							for _, l := range resp.Msg.Limits {
+								iface, vlan := "-", "-"
+								if l.InterfaceName != "" {
+									iface, vlan = l.InterfaceName, formatAcVlan(l.VlanId, 0, 0, l.PortMode)
+								}
+								max, action, learning := "-", "-", "on"
+								if l.Configured {
+									max = "unlimited"
+									if l.MaxMacs > 0 {
+										max = strconv.Itoa(int(l.MaxMacs))
+										action = formatMacLimitAction(l.LimitAction)
+									}
+									if l.LearningDisabled {
+										learning = "off"
+									}
+								}
+								rows = append(rows, []string{
+									strconv.Itoa(int(l.BdId)), iface, vlan,
+									strconv.FormatUint(l.MacCount, 10), max, action, learning,
+								})
+							}
+							printTable(headers, rows)
+							return nil
+						},
+					},
+				},
+			},
+			{
+				Name:    "storm-control",
+				Aliases: []string{"storm"},
+				Usage:   "Manage per-BD / per-AC BUM rate limits",
+				Subcommands: []*cli.Command{
+					{
+						Name:  "set",
+						Usage: "Set the BUM rate limit of a BD or AC",
+						Flags: append([]cli.Flag{
+							&cli.Uint64Flag{Name: "pps", Usage: "BUM packets per second (0 = unlimited)"},
+							&cli.Uint64Flag{Name: "bps", Usage: "BUM bytes per second (0 = unlimited)"},
+						}, bdScopeFlags...),
+						Action: func(c *cli.Context) error {
+							clients := clientsFromContext(c)
+							sc := stormControlScope(c)
+							sc.PacketsPerSec = c.Uint64("pps")
+							sc.BytesPerSec = c.Uint64("bps")
+							resp, err := clients.Bd.StormControlSet(context.Background(),
+								connect.NewRequest(&v1.StormControlSetRequest{StormControls: []*v1.StormControl{sc}}))
+							if err != nil {
+								return err
+							}
+							return printOperationResult(resp.Msg.Set, resp.Msg.Errors, "storm control")
+						},
+					},
+					{
+						Name:  "delete",
+						Usage: "Remove the BUM rate limit of a BD or AC",
+						Flags: bdScopeFlags,
+						Action: func(c *cli.Context) error {
+							clients := clientsFromContext(c)
+							resp, err := clients.Bd.StormControlDelete(context.Background(),
+								connect.NewRequest(&v1.StormControlDeleteRequest{StormControls: []*v1.StormControl{stormControlScope(c)}}))
+							if err != nil {
+								return err
+							}
+							if len(resp.Msg.Errors) > 0 {
+								e := resp.Msg.Errors[0]
+								return fmt.Errorf("%s: %s", e.TriggerPrefix, e.Reason)
+							}
+							fmt.Printf("Deleted: %d\n", len(resp.Msg.Deleted))
+							return nil
+						},
+					},
+					{
+						Name:  "list",
+						Usage: "List BUM rate limits and their drop counters",
+						Flags: []cli.Flag{
+							&cli.UintFlag{Name: "bd-id", Usage: "Filter by Bridge Domain ID"},
+						},
+						Action: func(c *cli.Context) error {
+							clients := clientsFromContext(c)
+							resp, err := clients.Bd.StormControlList(context.Background(),
+								connect.NewRequest(&v1.StormControlListRequest{BdId: uint32(c.Uint("bd-id"))}))
+							if err != nil {
+								return err
+							}
+							if useJSON(c) {
+								return printJSON(resp.Msg.StormControls)
+							}
+							headers := []string{"BD_ID", "INTERFACE", "VLAN", "PPS", "BPS", "DROPPED_PKTS", "DROPPED_BYTES"}
+							var rows [][]string
+							for _, sc := range resp.Msg.StormControls {
+								iface, vlan := "-", "-"
+								if sc.InterfaceName != "" {
+									iface, vlan = sc.InterfaceName, formatAcVlan(sc.VlanId, 0, 0, sc.PortMode)
+								}
+								rows = append(rows, []string{
+									strconv.Itoa(int(sc.BdId)), iface, vlan,
+									formatStormRate(sc.PacketsPerSec), formatStormRate(sc.BytesPerSec),
+									strconv.FormatUint(sc.DroppedPackets, 10), strconv.FormatUint(sc.DroppedBytes, 10),
+								})
+							}
+							printTable(headers, rows)
+							return nil
+						},
+					},
+				},
+			},
+			{
+				Name:    "neighbor",
+				Aliases: []string{"neigh"},
+				Usage:   "Manage the IP-to-MAC bindings used for ARP/ND suppression",
+				Subcommands: []*cli.Command{
+					{
+						Name:  "add",
+						Usage: "Add a static IP-to-MAC binding",
+						Flags: []cli.Flag{
+							&cli.UintFlag{Name: "bd-id", Usage: "Bridge Domain ID", Required: true},
+							&cli.StringFlag{Name: "ip", Usage: "IPv4 or IPv6 address", Required: true},
+							&cli.StringFlag{Name: "mac", Usage: "MAC address answered for --ip", Required: true},
+						},
+						Action: func(c *cli.Context) error {
+							clients := clientsFromContext(c)
+							resp, err := clients.Bd.NeighborCreate(context.Background(),
+								connect.NewRequest(&v1.NeighborCreateRequest{Neighbors: []*v1.Neighbor{{
+									BdId: uint32(c.Uint("bd-id")),
+									Ip:   c.String("ip"),
+									Mac:  c.String("mac"),
+								}}}))
+							if err != nil {
+								return err
+							}
+							return printOperationResult(resp.Msg.Created, resp.Msg.Errors, "neighbor")
+						},
+					},
+					{
+						Name:  "delete",
+						Usage: "Delete an IP-to-MAC binding of any origin",
+						Flags: []cli.Flag{
+							&cli.UintFlag{Name: "bd-id", Usage: "Bridge Domain ID", Required: true},
+							&cli.StringFlag{Name: "ip", Usage: "IPv4 or IPv6 address", Required: true},
+						},
+						Action: func(c *cli.Context) error {
+							clients := clientsFromContext(c)
+							resp, err := clients.Bd.NeighborDelete(context.Background(),
+								connect.NewRequest(&v1.NeighborDeleteRequest{Neighbors: []*v1.Neighbor{{
+									BdId: uint32(c.Uint("bd-id")),
+									Ip:   c.String("ip"),
+								}}}))
+							if err != nil {
+								return err
+							}
+							if len(resp.Msg.Errors) > 0 {
+								e := resp.Msg.Errors[0]
+								return fmt.Errorf("%s: %s", e.TriggerPrefix, e.Reason)
+							}
+							fmt.Printf("Deleted: %d\n", len(resp.Msg.Deleted))
+							return nil
+						},
+					},
+					{
+						Name:  "list",
+						Usage: "List IP-to-MAC bindings",
+						Flags: []cli.Flag{
+							&cli.UintFlag{Name: "bd-id", Usage: "Filter by Bridge Domain ID"},
+						},
+						Action: func(c *cli.Context) error {
+							clients := clientsFromContext(c)
+							resp, err := clients.Bd.NeighborList(context.Background(),
+								connect.NewRequest(&v1.NeighborListRequest{BdId: uint32(c.Uint("bd-id"))}))
+							if err != nil {
+								return err
+							}
+							if useJSON(c) {
+								return printJSON(resp.Msg.Neighbors)
+							}
+							printNeighbors(resp.Msg.Neighbors)
+							return nil
+						},
+					},
+				},
+			},
+			{
+				Name:  "irb-route",
+				Usage: "Manage the routes of IRB traffic towards remote End.DT4/DT6 SIDs",
+				Subcommands: []*cli.Command{
+					{
+						Name:  "add",
+						Usage: "Add a route to a VRF",
+						Flags: []cli.Flag{
+							&cli.StringFlag{Name: "vrf", Usage: "VRF name", Required: true},
+							&cli.StringFlag{Name: "prefix", Usage: "Destination prefix (IPv4 or IPv6)", Required: true},
+							&cli.StringFlag{Name: "src-addr", Usage: "Outer IPv6 source address", Required: true},
+							&cli.StringFlag{Name: "segments", Usage: "Segment list ending with the remote End.DT4/DT6 SID (comma-separated)", Required: true},
+							&cli.StringFlag{Name: "mode", Value: "H_ENCAPS", Usage: "Headend mode (H_ENCAPS, H_ENCAPS_RED)"},
+						},
+						Action: func(c *cli.Context) error {
+							clients := clientsFromContext(c)
+							mode, err := resolveMode(c.String("mode"))
+							if err != nil {
+								return err
+							}
+							resp, err := clients.Bd.IrbRouteCreate(context.Background(),
+								connect.NewRequest(&v1.IrbRouteCreateRequest{Routes: []*v1.IrbRoute{{
+									VrfName:  c.String("vrf"),
+									Prefix:   c.String("prefix"),
+									SrcAddr:  c.String("src-addr"),
+									Segments: strings.Split(c.String("segments"), ","),
+									Mode:     mode,
+								}}}))
+							if err != nil {
+								return err
+							}
+							return printOperationResult(resp.Msg.Created, resp.Msg.Errors, "IRB route")
+						},
+					},
+					{
+						Name:  "delete",
+						Usage: "Delete a route from a VRF",
+						Flags: []cli.Flag{
+							&cli.StringFlag{Name: "vrf", Usage: "VRF name", Required: true},
+							&cli.StringFlag{Name: "prefix", Usage: "Destination prefix", Required: true},
+						},
+						Action: func(c *cli.Context) error {
+							clients := clientsFromContext(c)
+							resp, err := clients.Bd.IrbRouteDelete(context.Background(),
+								connect.NewRequest(&v1.IrbRouteDeleteRequest{Routes: []*v1.IrbRoute{{
+									VrfName: c.String("vrf"),
+									Prefix:  c.String("prefix"),
+								}}}))
+							if err != nil {
+								return err
+							}
+							if len(resp.Msg.Errors) > 0 {
+								e := resp.Msg.Errors[0]
+								return fmt.Errorf("%s: %s", e.TriggerPrefix, e.Reason)
+							}
+							fmt.Printf("Deleted: %d\n", len(resp.Msg.Deleted))
+							return nil
+						},
+					},
+					{
+						Name:  "list",
+						Usage: "List IRB routes, including host routes installed from EVPN",
+						Flags: []cli.Flag{
+							&cli.StringFlag{Name: "vrf", Usage: "Filter by VRF name"},
+						},
+						Action: func(c *cli.Context) error {
+							clients := clientsFromContext(c)
+							resp, err := clients.Bd.IrbRouteList(context.Background(),
+								connect.NewRequest(&v1.IrbRouteListRequest{VrfName: c.String("vrf")}))
+							if err != nil {
+								return err
+							}
+							if useJSON(c) {
+								return printJSON(resp.Msg.Routes)
+							}
+							var rows [][]string
+							for _, r := range resp.Msg.Routes {
+								origin := "static"
+								if r.BdId != 0 {
+									origin = fmt.Sprintf("evpn(bd %d)", r.BdId)
+								}
+								rows = append(rows, []string{
+									r.VrfName, r.Prefix, formatMode(r.Mode), r.SrcAddr,
+									strings.Join(r.Segments, ","), origin,
+								})
+							}
+							printTable([]string{"VRF", "PREFIX", "MODE", "SRC", "SEGMENTS", "ORIGIN"}, rows)
+							return nil
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func printNeighbors(neighbors []*v1.Neighbor) {
+	var rows [][]string
+	for _, n := range neighbors {
+		rows = append(rows, []string{strconv.Itoa(int(n.BdId)), n.Ip, n.Mac, formatNeighborOrigin(n.Origin)})
+	}
+	printTable([]string{"BD_ID", "IP", "MAC", "ORIGIN"}, rows)
+}
+
+// bridgeDomainFromFlags builds a BridgeDomain from --file and the scalar flags
+func bridgeDomainFromFlags(c *cli.Context) (*v1.BridgeDomain, error) {
+	bd := &v1.BridgeDomain{}
+	if path := c.String("file"); path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if err := protojson.Unmarshal(data, bd); err != nil {
+			return nil, fmt.Errorf("parse %s: %w", path, err)
+		}
+	}
+	if c.IsSet("name") {
+		bd.Name = c.String("name")
+	}
+	if c.IsSet("bd-id") {
+		bd.BdId = uint32(c.Uint("bd-id"))
+	}
+	if c.IsSet("bridge") {
+		if bd.Bridge == nil {
+			bd.Bridge = &v1.Bridge{}
+		}
+		bd.Bridge.Name = c.String("bridge")
+	}
+	if m := c.String("members"); m != "" {
+		if bd.Bridge == nil {
+			return nil, fmt.Errorf("--members requires --bridge")
+		}
+		bd.Bridge.Members = strings.Split(m, ",")
+	}
+	if c.IsSet("vrf") || c.IsSet("gateway-mac") || c.IsSet("gateway-ips") {
+		if bd.Irb == nil {
+			bd.Irb = &v1.Irb{}
+		}
+		if c.IsSet("vrf") {
+			bd.Irb.VrfName = c.String("vrf")
+		}
+		if c.IsSet("gateway-mac") {
+			bd.Irb.GatewayMac = c.String("gateway-mac")
+		}
+		if ips := c.String("gateway-ips"); ips != "" {
+			bd.Irb.GatewayIps = strings.Split(ips, ",")
+		}
+	}
+	if bd.Name == "" || bd.BdId == 0 {
+		return nil, fmt.Errorf("--name and --bd-id (or name and bd_id in --file) are required")
+	}
+	return bd, nil
+}
+
+func printBridgeDomain(bd *v1.BridgeDomain) {
+	fmt.Printf("Name:    %s\n", bd.Name)
+	fmt.Printf("BD ID:   %d\n", bd.BdId)
+	if bd.Bridge != nil {
+		fmt.Printf("Bridge:  %s", bd.Bridge.Name)
+		if len(bd.Bridge.Members) > 0 {
+			fmt.Printf(" (%s)", strings.Join(bd.Bridge.Members, ","))
+		}
+		fmt.Println()
+	}
+	if bd.Irb != nil {
+		fmt.Printf("IRB:     vrf %s, gateway %s", bd.Irb.VrfName, bd.Irb.GatewayMac)
+		if len(bd.Irb.GatewayIps) > 0 {
+			fmt.Printf(" (%s)", strings.Join(bd.Irb.GatewayIps, ","))
+		}
+		fmt.Println()
+	}
+	fmt.Printf("FDB:     %d entries\n", bd.FdbEntries)
+
+	if len(bd.Acs) > 0 {
+		fmt.Println("\nAttachment circuits:")
+		var rows [][]string
+		for _, ac := range bd.Acs {
+			rows = append(rows, []string{
+				ac.InterfaceName, formatAcVlan(ac.VlanId, ac.InnerVlanId, ac.VlanIdEnd, ac.PortMode), formatMode(ac.Mode),
+				ac.SrcAddr, strings.Join(ac.Segments, ","), orDash(ac.Esi),
+			})
+		}
+		printTable([]string{"INTERFACE", "VLAN", "MODE", "SRC", "SEGMENTS", "ESI"}, rows)
+	}
+	if len(bd.Sids) > 0 {
+		fmt.Println("\nSIDs:")
+		var rows [][]string
+		for _, sf := range bd.Sids {
+			rows = append(rows, []string{sf.TriggerPrefix, formatAction(sf.Action), orDash(sf.BridgeName)})
+		}
+		printTable([]string{"TRIGGER_PREFIX", "ACTION", "BRIDGE"}, rows)
+	}
+	if len(bd.Peers) > 0 {
+		fmt.Println("\nPeers:")
+		var rows [][]string
+		for _, p := range bd.Peers {
+			rows = append(rows, []string{p.SrcAddr, strings.Join(p.Segments, ","), formatMode(p.Mode), orDash(p.Esi)})
+		}
+		printTable([]string{"SRC", "SEGMENTS", "MODE", "ESI"}, rows)
+	}
+	if len(bd.EthernetSegments) > 0 {
+		fmt.Println("\nEthernet Segments:")
+		var rows [][]string
+		for _, es := range bd.EthernetSegments {
+			rows = append(rows, []string{
+				es.Esi, strconv.FormatBool(es.LocalAttached),
+				orDash(es.LocalPeSrcAddr), orDash(es.DfPeSrcAddr),
+			})
+		}
+		printTable([]string{"ESI", "LOCAL", "LOCAL_PE", "DF_PE"}, rows)
+	}
+	if len(bd.MacLimits) > 0 {
+		fmt.Println("\nMAC limits:")
+		var rows [][]string
+		for _, l := range bd.MacLimits {
+			scope := "bd"
+			if l.InterfaceName != "" {
+				scope = fmt.Sprintf("%s:vlan_%d", l.InterfaceName, l.VlanId)
+				if l.PortMode {
+					scope = l.InterfaceName + ":port"
+				}
+			}
+			max := "-"
+			if l.Configured {
+				max = "unlimited"
+				if l.MaxMacs > 0 {
+					max = strconv.Itoa(int(l.MaxMacs))
+				}
+			}
+			rows = append(rows, []string{scope, strconv.FormatUint(l.MacCount, 10), max})
+		}
+		printTable([]string{"SCOPE", "MACS", "MAX"}, rows)
+	}
+	if len(bd.StormControls) > 0 {
+		fmt.Println("\nStorm control:")
+		var rows [][]string
+		for _, sc := range bd.StormControls {
+			scope := "bd"
+			if sc.InterfaceName != "" {
+				scope = fmt.Sprintf("%s:vlan_%d", sc.InterfaceName, sc.VlanId)
+				if sc.PortMode {
+					scope = sc.InterfaceName + ":port"
+				}
+			}
+			rows = append(rows, []string{
+				scope, formatStormRate(sc.PacketsPerSec), formatStormRate(sc.BytesPerSec),
+				strconv.FormatUint(sc.DroppedPackets, 10),
+			})
+		}
+		printTable([]string{"SCOPE", "PPS", "BPS", "DROPPED"}, rows)
+	}
+	if len(bd.Neighbors) > 0 {
+		fmt.Println("\nNeighbors:")
+		printNeighbors(bd.Neighbors)
+	}
+}
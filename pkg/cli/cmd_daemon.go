@@ -0,0 +1,73 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"connectrpc.com/connect"
+	v1 "github.com/takehaya/vinbero/api/vinbero/v1"
+	"github.com/urfave/cli/v2"
+)
+
+func daemonCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "daemon",
+		Usage: "Control the running vinberod",
+		Subcommands: []*cli.Command{
+			{
+				Name:  "reload",
+				Usage: "Re-read the config file and apply changes to the config: section (same as SIGHUP)",
+				Action: func(c *cli.Context) error {
+					clients := clientsFromContext(c)
+					resp, err := clients.Daemon.Reload(context.Background(),
+						connect.NewRequest(&v1.ReloadRequest{}))
+					if err != nil {
+						return err
+					}
+					if useJSON(c) {
+						return printJSON(resp.Msg)
+					}
+					printSectionTable(resp.Msg.Sections)
+					for _, key := range resp.Msg.RestartRequired {
+						fmt.Printf("Restart required: %s (not applied until vinberod restarts)\n", key)
+					}
+					return sectionErrors(resp.Msg.Sections)
+				},
+			},
+		},
+	}
+}
+
+// printSectionTable prints the per-section counts of a reload or import.
+func printSectionTable(sections []*v1.ConfigSectionResult) {
+	headers := []string{"SECTION", "CREATED", "UPDATED", "DELETED", "UNCHANGED", "ERRORS"}
+	var rows [][]string
+	for _, sec := range sections {
+		rows = append(rows, []string{
+			sec.Name,
+			fmt.Sprintf("%d", sec.Created),
+			fmt.Sprintf("%d", sec.Updated),
+			fmt.Sprintf("%d", sec.Deleted),
+			fmt.Sprintf("%d", sec.Unchanged),
+			fmt.Sprintf("%d", len(sec.Errors)),
+		})
+	}
+	printTable(headers, rows)
+}
+
+// sectionErrors prints the rejected entries of a reload or import on
+// stderr and fails when there were any.
+func sectionErrors(sections []*v1.ConfigSectionResult) error {
+	errors := 0
+	for _, sec := range sections {
+		for _, e := range sec.Errors {
+			fmt.Fprintf(os.Stderr, "Error [%s %s]: %s\n", sec.Name, e.TriggerPrefix, e.Reason)
+		}
+		errors += len(sec.Errors)
+	}
+	if errors > 0 {
+		return fmt.Errorf("%d error(s) occurred", errors)
+	}
+	return nil
+}
@@ -18,26 +18,13 @@ func esCommand() *cli.Command {
 			{
 				Name:  "create",
 				Usage: "Register an Ethernet Segment",
-				Flags: []cli.Flag{
-					&cli.StringFlag{Name: "esi", Required: true, Usage: "RFC 7432 ESI (10 colon-separated hex bytes)"},
-					&cli.BoolFlag{Name: "local-attached", Usage: "This PE attaches to the ES"},
-					&cli.StringFlag{Name: "local-pe", Usage: "Local PE IPv6 (required when --local-attached, used for DF judgement)"},
-					&cli.StringFlag{Name: "df-pe", Usage: "Initial Designated Forwarder IPv6 (optional; set later with df-set)"},
-					&cli.StringFlag{Name: "mode", Value: "ALL_ACTIVE", Usage: "Redundancy mode: SINGLE_HOMING, ALL_ACTIVE, SINGLE_ACTIVE"},
-				},
+				Flags: esFlags(),
 				Action: func(c *cli.Context) error {
 					clients := clientsFromContext(c)
-					mode, err := resolveEsiMode(c.String("mode"))
+					entry, err := esFromFlags(c)
 					if err != nil {
 						return err
 					}
-					entry := &v1.EthernetSegment{
-						Esi:             c.String("esi"),
-						LocalAttached:   c.Bool("local-attached"),
-						LocalPeSrcAddr:  c.String("local-pe"),
-						DfPeSrcAddr:     c.String("df-pe"),
-						RedundancyMode:  mode,
-					}
 					resp, err := clients.Es.EsCreate(context.Background(),
 						connect.NewRequest(&v1.EsCreateRequest{Entries: []*v1.EthernetSegment{entry}}))
 					if err != nil {
@@ -46,6 +33,32 @@ func esCommand() *cli.Command {
 					return printOperationResult(resp.Msg.Created, resp.Msg.Errors, "Ethernet Segment")
 				},
 			},
+			{
+				Name:  "update",
+				Usage: "Change an Ethernet Segment in place; only the flags given are changed",
+				Flags: updateFlags(esFlags(), "esi"),
+				Action: func(c *cli.Context) error {
+					clients := clientsFromContext(c)
+					entry, err := esFromFlags(c)
+					if err != nil {
+						return err
+					}
+					mask, err := updateMask(c, []string{"esi"}, map[string]string{
+						"local-pe": "local_pe_src_addr",
+						"df-pe":    "df_pe_src_addr",
+						"mode":     "redundancy_mode",
+					})
+					if err != nil {
+						return err
+					}
+					resp, err := clients.Es.EsUpdate(context.Background(),
+						connect.NewRequest(&v1.EsUpdateRequest{Entries: []*v1.EthernetSegment{entry}, UpdateMask: mask}))
+					if err != nil {
+						return err
+					}
+					return printUpdateResult(resp.Msg.Updated, resp.Msg.Errors, "Ethernet Segment")
+				},
+			},
 			{
 				Name:  "delete",
 				Usage: "Delete Ethernet Segments by ESI",
@@ -132,6 +145,32 @@ func esCommand() *cli.Command {
 	}
 }
 
+func esFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{Name: "esi", Required: true, Usage: "RFC 7432 ESI (10 colon-separated hex bytes)"},
+		&cli.BoolFlag{Name: "local-attached", Usage: "This PE attaches to the ES"},
+		&cli.StringFlag{Name: "local-pe", Usage: "Local PE IPv6 (required when --local-attached, used for DF judgement)"},
+		&cli.StringFlag{Name: "df-pe", Usage: "Initial Designated Forwarder IPv6 (optional; set later with df-set)"},
+		&cli.StringFlag{Name: "mode", Value: "ALL_ACTIVE", Usage: "Redundancy mode: SINGLE_HOMING, ALL_ACTIVE, SINGLE_ACTIVE"},
+	}
+}
+
+// esFromFlags builds the Ethernet Segment given by esFlags.
+func esFromFlags(c *cli.Context) (*v1.EthernetSegment, error) {
+	mode, err := resolveEsiMode(c.String("mode"))
+	if err != nil {
+		return nil, err
+	}
+	entry := &v1.EthernetSegment{
+		Esi:            c.String("esi"),
+		LocalAttached:  c.Bool("local-attached"),
+		LocalPeSrcAddr: c.String("local-pe"),
+		DfPeSrcAddr:    c.String("df-pe"),
+		RedundancyMode: mode,
+	}
+	return entry, nil
+}
+
 func resolveEsiMode(s string) (v1.EsiRedundancyMode, error) {
 	return resolveProtoEnum[v1.EsiRedundancyMode](s, "ESI_REDUNDANCY_MODE_", v1.EsiRedundancyMode_value)
 }
@@ -19,18 +19,29 @@ func fdbCommand() *cli.Command {
 			{
 				Name:  "create",
 				Usage: "Create a static FDB entry",
-				Flags: []cli.Flag{
+				Flags: append([]cli.Flag{
 					&cli.UintFlag{Name: "bd-id", Required: true, Usage: "Bridge Domain ID"},
 					&cli.StringFlag{Name: "mac", Required: true, Usage: "MAC address (e.g., aa:bb:cc:dd:ee:ff)"},
 					&cli.UintFlag{Name: "oif", Required: true, Usage: "Output interface index"},
-				},
+					&cli.UintFlag{Name: "vlan-id", Usage: "VLAN ID of the AC on --oif (selects its egress rewrite)"},
+					&cli.UintFlag{Name: "inner-vlan-id", Usage: "Inner VLAN ID of a double-tagged AC on --oif"},
+					&cli.BoolFlag{Name: "port-mode", Usage: "The AC on --oif is its port-mode AC"},
+				}, vlanRewriteFlags("")...),
 				Action: func(c *cli.Context) error {
 					clients := clientsFromContext(c)
-					_, err := clients.Fdb.FdbCreate(context.Background(),
+					rewrite, err := resolveVlanRewrite(c, "")
+					if err != nil {
+						return err
+					}
+					_, err = clients.Fdb.FdbCreate(context.Background(),
 						connect.NewRequest(&v1.FdbCreateRequest{
-							BdId: uint32(c.Uint("bd-id")),
-							Mac:  c.String("mac"),
-							Oif:  uint32(c.Uint("oif")),
+							BdId:        uint32(c.Uint("bd-id")),
+							Mac:         c.String("mac"),
+							Oif:         uint32(c.Uint("oif")),
+							VlanId:      uint32(c.Uint("vlan-id")),
+							InnerVlanId: uint32(c.Uint("inner-vlan-id")),
+							VlanRewrite: rewrite,
+							PortMode:    c.Bool("port-mode"),
 						}))
 					if err != nil {
 						return err
@@ -95,12 +106,14 @@ func fdbCommand() *cli.Command {
 					if useJSON(c) {
 						return printJSON(resp.Msg.Entries)
 					}
-					headers := []string{"BD_ID", "MAC", "OIF", "TYPE", "AGE"}
+					headers := []string{"BD_ID", "MAC", "OIF", "VLAN", "TYPE", "AGE", "REWRITE"}
 					var rows [][]string
 					for _, e := range resp.Msg.Entries {
 						entryType := "dynamic"
 						if e.IsStatic {
 							entryType = "static"
+						} else if e.Vtep != "" {
+							entryType = "vtep " + e.Vtep
 						} else if e.IsRemote {
 							entryType = "remote"
 						}
@@ -110,12 +123,19 @@ func fdbCommand() *cli.Command {
 							age = formatAge(e.LastSeen)
 						}
 
+						vlan := "-"
+						if !e.IsRemote {
+							vlan = formatAcVlan(e.VlanId, e.InnerVlanId, 0, e.PortMode)
+						}
+
 						rows = append(rows, []string{
 							fmt.Sprintf("%d", e.BdId),
 							e.Mac,
 							fmt.Sprintf("%d", e.Oif),
+							vlan,
 							entryType,
 							age,
+							formatVlanRewrite(e.VlanRewrite),
 						})
 					}
 					printTable(headers, rows)
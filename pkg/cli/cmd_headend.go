@@ -19,26 +19,13 @@ func headendV4Command() *cli.Command {
 			{
 				Name:  "create",
 				Usage: "Create a Headend v4 entry",
-				Flags: []cli.Flag{
-					&cli.StringFlag{Name: "trigger-prefix", Required: true, Usage: "IPv4 CIDR (e.g., 10.0.0.0/24)"},
-					&cli.StringFlag{Name: "src-addr", Required: true, Usage: "Outer IPv6 source address"},
-					&cli.StringFlag{Name: "segments", Required: true, Usage: "Segment list (comma-separated)"},
-					&cli.StringFlag{Name: "mode", Value: "H_ENCAPS", Usage: "Headend mode (H_ENCAPS, H_INSERT, H_M_GTP4_D)"},
-					&cli.UintFlag{Name: "args-offset", Usage: "Args.Mob.Session byte offset in SID (for H.M.GTP4.D)"},
-				},
+				Flags: headendV4Flags(),
 				Action: func(c *cli.Context) error {
 					clients := clientsFromContext(c)
-					mode, err := resolveMode(c.String("mode"))
+					entry, err := headendV4FromFlags(c)
 					if err != nil {
 						return err
 					}
-					entry := &v1.Headendv4{
-						Mode:          mode,
-						TriggerPrefix: c.String("trigger-prefix"),
-						SrcAddr:       c.String("src-addr"),
-						Segments:      strings.Split(c.String("segments"), ","),
-						ArgsOffset: uint32(c.Uint("args-offset")),
-					}
 					resp, err := clients.Hv4.Headendv4Create(context.Background(),
 						connect.NewRequest(&v1.Headendv4CreateRequest{Headendv4S: []*v1.Headendv4{entry}}))
 					if err != nil {
@@ -47,6 +34,28 @@ func headendV4Command() *cli.Command {
 					return printOperationResult(resp.Msg.Created, resp.Msg.Errors, "Headend v4")
 				},
 			},
+			{
+				Name:  "update",
+				Usage: "Change fields of a Headend v4 entry in place; only the flags given are changed",
+				Flags: updateFlags(headendV4Flags(), "trigger-prefix"),
+				Action: func(c *cli.Context) error {
+					clients := clientsFromContext(c)
+					entry, err := headendV4FromFlags(c)
+					if err != nil {
+						return err
+					}
+					mask, err := updateMask(c, []string{"trigger-prefix"}, nil)
+					if err != nil {
+						return err
+					}
+					resp, err := clients.Hv4.Headendv4Update(context.Background(),
+						connect.NewRequest(&v1.Headendv4UpdateRequest{Headendv4S: []*v1.Headendv4{entry}, UpdateMask: mask}))
+					if err != nil {
+						return err
+					}
+					return printUpdateResult(resp.Msg.Updated, resp.Msg.Errors, "Headend v4")
+				},
+			},
 			{
 				Name:  "delete",
 				Usage: "Delete a Headend v4 entry",
@@ -116,24 +125,13 @@ func headendV6Command() *cli.Command {
 			{
 				Name:  "create",
 				Usage: "Create a Headend v6 entry",
-				Flags: []cli.Flag{
-					&cli.StringFlag{Name: "trigger-prefix", Required: true, Usage: "IPv6 CIDR"},
-					&cli.StringFlag{Name: "src-addr", Required: true},
-					&cli.StringFlag{Name: "segments", Required: true, Usage: "Segment list (comma-separated)"},
-					&cli.StringFlag{Name: "mode", Value: "H_ENCAPS"},
-				},
+				Flags: headendV6Flags(),
 				Action: func(c *cli.Context) error {
 					clients := clientsFromContext(c)
-					mode, err := resolveMode(c.String("mode"))
+					entry, err := headendV6FromFlags(c)
 					if err != nil {
 						return err
 					}
-					entry := &v1.Headendv6{
-						Mode:          mode,
-						TriggerPrefix: c.String("trigger-prefix"),
-						SrcAddr:       c.String("src-addr"),
-						Segments:      strings.Split(c.String("segments"), ","),
-					}
 					resp, err := clients.Hv6.Headendv6Create(context.Background(),
 						connect.NewRequest(&v1.Headendv6CreateRequest{Headendv6S: []*v1.Headendv6{entry}}))
 					if err != nil {
@@ -142,6 +140,28 @@ func headendV6Command() *cli.Command {
 					return printOperationResult(resp.Msg.Created, resp.Msg.Errors, "Headend v6")
 				},
 			},
+			{
+				Name:  "update",
+				Usage: "Change fields of a Headend v6 entry in place; only the flags given are changed",
+				Flags: updateFlags(headendV6Flags(), "trigger-prefix"),
+				Action: func(c *cli.Context) error {
+					clients := clientsFromContext(c)
+					entry, err := headendV6FromFlags(c)
+					if err != nil {
+						return err
+					}
+					mask, err := updateMask(c, []string{"trigger-prefix"}, nil)
+					if err != nil {
+						return err
+					}
+					resp, err := clients.Hv6.Headendv6Update(context.Background(),
+						connect.NewRequest(&v1.Headendv6UpdateRequest{Headendv6S: []*v1.Headendv6{entry}, UpdateMask: mask}))
+					if err != nil {
+						return err
+					}
+					return printUpdateResult(resp.Msg.Updated, resp.Msg.Errors, "Headend v6")
+				},
+			},
 			{
 				Name:  "delete",
 				Usage: "Delete a Headend v6 entry",
@@ -211,30 +231,13 @@ func headendL2Command() *cli.Command {
 			{
 				Name:  "create",
 				Usage: "Create a Headend L2 entry",
-				Flags: []cli.Flag{
-					&cli.StringFlag{Name: "interface", Required: true, Usage: "Customer-facing interface name"},
-					&cli.UintFlag{Name: "vlan-id", Required: true, Usage: "VLAN ID (0 for untagged)"},
-					&cli.StringFlag{Name: "src-addr", Required: true, Usage: "Outer IPv6 source address"},
-					&cli.StringFlag{Name: "segments", Required: true, Usage: "Segment list (comma-separated)"},
-					&cli.UintFlag{Name: "bd-id", Usage: "Bridge Domain ID (0 = direct encap)"},
-					&cli.StringFlag{Name: "mode", Value: "H_ENCAPS_L2", Usage: "Headend mode (H_ENCAPS_L2, H_ENCAPS_L2_RED)"},
-					&cli.StringFlag{Name: "esi", Usage: "RFC 7432 Ethernet Segment Identifier (10 colon-separated hex bytes)"},
-				},
+				Flags: headendL2Flags(),
 				Action: func(c *cli.Context) error {
 					clients := clientsFromContext(c)
-					mode, err := resolveMode(c.String("mode"))
+					entry, err := headendL2FromFlags(c)
 					if err != nil {
 						return err
 					}
-					entry := &v1.HeadendL2{
-						InterfaceName: c.String("interface"),
-						VlanId:        uint32(c.Uint("vlan-id")),
-						SrcAddr:       c.String("src-addr"),
-						Segments:      strings.Split(c.String("segments"), ","),
-						BdId:          uint32(c.Uint("bd-id")),
-						Mode:          mode,
-						Esi:           c.String("esi"),
-					}
 					resp, err := clients.Hl2.HeadendL2Create(context.Background(),
 						connect.NewRequest(&v1.HeadendL2CreateRequest{HeadendL2S: []*v1.HeadendL2{entry}}))
 					if err != nil {
@@ -243,18 +246,49 @@ func headendL2Command() *cli.Command {
 					return printOperationResult(resp.Msg.Created, resp.Msg.Errors, "Headend L2")
 				},
 			},
+			{
+				Name:  "update",
+				Usage: "Change fields of a Headend L2 entry in place; only the flags given are changed",
+				Flags: updateFlags(headendL2Flags(), "interface", "vlan-id", "inner-vlan-id", "port-mode"),
+				Action: func(c *cli.Context) error {
+					clients := clientsFromContext(c)
+					entry, err := headendL2FromFlags(c)
+					if err != nil {
+						return err
+					}
+					mask, err := updateMask(c, []string{"interface", "vlan-id", "inner-vlan-id", "port-mode"}, map[string]string{
+						"interface":                 "interface_name",
+						"egress-rewrite":            "egress_vlan_rewrite",
+						"egress-rewrite-vlan":       "egress_vlan_rewrite",
+						"egress-rewrite-inner-vlan": "egress_vlan_rewrite",
+					})
+					if err != nil {
+						return err
+					}
+					resp, err := clients.Hl2.HeadendL2Update(context.Background(),
+						connect.NewRequest(&v1.HeadendL2UpdateRequest{HeadendL2S: []*v1.HeadendL2{entry}, UpdateMask: mask}))
+					if err != nil {
+						return err
+					}
+					return printUpdateResult(resp.Msg.Updated, resp.Msg.Errors, "Headend L2")
+				},
+			},
 			{
 				Name:  "delete",
 				Usage: "Delete a Headend L2 entry",
 				Flags: []cli.Flag{
 					&cli.StringFlag{Name: "interface", Required: true},
-					&cli.UintFlag{Name: "vlan-id", Required: true},
+					&cli.UintFlag{Name: "vlan-id"},
+					&cli.UintFlag{Name: "inner-vlan-id"},
+					&cli.BoolFlag{Name: "port-mode"},
 				},
 				Action: func(c *cli.Context) error {
 					clients := clientsFromContext(c)
 					target := &v1.HeadendL2DeleteTarget{
 						InterfaceName: c.String("interface"),
 						VlanId:        uint32(c.Uint("vlan-id")),
+						InnerVlanId:   uint32(c.Uint("inner-vlan-id")),
+						PortMode:      c.Bool("port-mode"),
 					}
 					resp, err := clients.Hl2.HeadendL2Delete(context.Background(),
 						connect.NewRequest(&v1.HeadendL2DeleteRequest{Targets: []*v1.HeadendL2DeleteTarget{target}}))
@@ -262,7 +296,7 @@ func headendL2Command() *cli.Command {
 						return err
 					}
 					for _, d := range resp.Msg.Deleted {
-						fmt.Printf("Deleted: %s vlan=%d\n", d.InterfaceName, d.VlanId)
+						fmt.Printf("Deleted: %s vlan=%s\n", d.InterfaceName, formatAcVlan(d.VlanId, d.InnerVlanId, 0, d.PortMode))
 					}
 					return nil
 				},
@@ -297,17 +331,18 @@ func headendL2Command() *cli.Command {
 					if useJSON(c) {
 						return printJSON(resp.Msg.HeadendL2S)
 					}
-					headers := []string{"INTERFACE", "VLAN", "SRC ADDR", "SEGMENTS", "BD_ID", "MODE", "ESI"}
+					headers := []string{"INTERFACE", "VLAN", "SRC ADDR", "SEGMENTS", "BD_ID", "MODE", "ESI", "EGRESS REWRITE"}
 					var rows [][]string
 					for _, h := range resp.Msg.HeadendL2S {
 						rows = append(rows, []string{
 							h.InterfaceName,
-							fmt.Sprintf("%d", h.VlanId),
+							formatAcVlan(h.VlanId, h.InnerVlanId, h.VlanIdEnd, h.PortMode),
 							h.SrcAddr,
 							strings.Join(h.Segments, ","),
 							fmt.Sprintf("%d", h.BdId),
 							formatMode(h.Mode),
 							h.Esi,
+							formatVlanRewrite(h.EgressVlanRewrite),
 						})
 					}
 					printTable(headers, rows)
@@ -317,3 +352,186 @@ func headendL2Command() *cli.Command {
 		},
 	}
 }
+
+func headendV4Flags() []cli.Flag {
+	return append([]cli.Flag{
+		&cli.StringFlag{Name: "trigger-prefix", Required: true, Usage: "IPv4 CIDR (e.g., 10.0.0.0/24)"},
+		&cli.StringFlag{Name: "src-addr", Required: true, Usage: "Outer IPv6 source address"},
+		&cli.StringFlag{Name: "segments", Required: true, Usage: "Segment list (comma-separated)"},
+		&cli.StringFlag{Name: "mode", Value: "H_ENCAPS", Usage: "Headend mode (H_ENCAPS, H_INSERT, H_M_GTP4_D)"},
+		&cli.UintFlag{Name: "args-offset", Usage: "Args.Mob.Session byte offset in SID (for H.M.GTP4.D)"},
+		&cli.UintFlag{Name: "mtu", Usage: "Egress MTU after encapsulation (0 = interface MTU only)"},
+	}, encapFlags()...)
+}
+
+// headendV4FromFlags builds the Headend v4 entry given by headendV4Flags.
+func headendV4FromFlags(c *cli.Context) (*v1.Headendv4, error) {
+	mode, err := resolveMode(c.String("mode"))
+	if err != nil {
+		return nil, err
+	}
+	entry := &v1.Headendv4{
+		Mode:          mode,
+		TriggerPrefix: c.String("trigger-prefix"),
+		SrcAddr:       c.String("src-addr"),
+		Segments:      strings.Split(c.String("segments"), ","),
+		ArgsOffset:    uint32(c.Uint("args-offset")),
+		Mtu:           uint32(c.Uint("mtu")),
+	}
+	encap, err := resolveEncapOptions(c)
+	if err != nil {
+		return nil, err
+	}
+	entry.TcMode, entry.Tc = encap.tcMode, encap.tc
+	entry.HopLimitMode, entry.HopLimit = encap.hopLimitMode, encap.hopLimit
+	entry.FlowLabelMode = encap.flowLabelMode
+	return entry, nil
+}
+
+func headendV6Flags() []cli.Flag {
+	return append([]cli.Flag{
+		&cli.StringFlag{Name: "trigger-prefix", Required: true, Usage: "IPv6 CIDR"},
+		&cli.StringFlag{Name: "src-addr", Required: true},
+		&cli.StringFlag{Name: "segments", Required: true, Usage: "Segment list (comma-separated)"},
+		&cli.StringFlag{Name: "mode", Value: "H_ENCAPS"},
+		&cli.UintFlag{Name: "mtu", Usage: "Egress MTU after encapsulation (0 = interface MTU only)"},
+	}, encapFlags()...)
+}
+
+// headendV6FromFlags builds the Headend v6 entry given by headendV6Flags.
+func headendV6FromFlags(c *cli.Context) (*v1.Headendv6, error) {
+	mode, err := resolveMode(c.String("mode"))
+	if err != nil {
+		return nil, err
+	}
+	entry := &v1.Headendv6{
+		Mode:          mode,
+		TriggerPrefix: c.String("trigger-prefix"),
+		SrcAddr:       c.String("src-addr"),
+		Segments:      strings.Split(c.String("segments"), ","),
+		Mtu:           uint32(c.Uint("mtu")),
+	}
+	encap, err := resolveEncapOptions(c)
+	if err != nil {
+		return nil, err
+	}
+	entry.TcMode, entry.Tc = encap.tcMode, encap.tc
+	entry.HopLimitMode, entry.HopLimit = encap.hopLimitMode, encap.hopLimit
+	entry.FlowLabelMode = encap.flowLabelMode
+	return entry, nil
+}
+
+func headendL2Flags() []cli.Flag {
+	return append([]cli.Flag{
+		&cli.StringFlag{Name: "interface", Required: true, Usage: "Customer-facing interface name"},
+		&cli.UintFlag{Name: "vlan-id", Usage: "VLAN ID (0 for untagged); the S-VLAN with --inner-vlan-id"},
+		&cli.UintFlag{Name: "inner-vlan-id", Usage: "C-VLAN ID of a double-tagged AC (0 = any)"},
+		&cli.UintFlag{Name: "vlan-id-end", Usage: "Last VLAN ID of a range AC starting at --vlan-id"},
+		&cli.BoolFlag{Name: "port-mode", Usage: "Match every frame on the interface not claimed by a per-VLAN AC"},
+		&cli.StringFlag{Name: "src-addr", Required: true, Usage: "Outer IPv6 source address"},
+		&cli.StringFlag{Name: "segments", Required: true, Usage: "Segment list (comma-separated)"},
+		&cli.UintFlag{Name: "bd-id", Usage: "Bridge Domain ID (0 = direct encap)"},
+		&cli.StringFlag{Name: "mode", Value: "H_ENCAPS_L2", Usage: "Headend mode (H_ENCAPS_L2, H_ENCAPS_L2_RED)"},
+		&cli.StringFlag{Name: "esi", Usage: "RFC 7432 Ethernet Segment Identifier (10 colon-separated hex bytes)"},
+	}, append(encapFlags(), vlanRewriteFlags("egress-")...)...)
+}
+
+// headendL2FromFlags builds the Headend L2 entry given by headendL2Flags.
+func headendL2FromFlags(c *cli.Context) (*v1.HeadendL2, error) {
+	mode, err := resolveMode(c.String("mode"))
+	if err != nil {
+		return nil, err
+	}
+	entry := &v1.HeadendL2{
+		InterfaceName: c.String("interface"),
+		VlanId:        uint32(c.Uint("vlan-id")),
+		SrcAddr:       c.String("src-addr"),
+		Segments:      strings.Split(c.String("segments"), ","),
+		BdId:          uint32(c.Uint("bd-id")),
+		Mode:          mode,
+		Esi:           c.String("esi"),
+		InnerVlanId:   uint32(c.Uint("inner-vlan-id")),
+		VlanIdEnd:     uint32(c.Uint("vlan-id-end")),
+		PortMode:      c.Bool("port-mode"),
+	}
+	if entry.EgressVlanRewrite, err = resolveVlanRewrite(c, "egress-"); err != nil {
+		return nil, err
+	}
+	encap, err := resolveEncapOptions(c)
+	if err != nil {
+		return nil, err
+	}
+	entry.TcMode, entry.Tc = encap.tcMode, encap.tc
+	entry.HopLimitMode, entry.HopLimit = encap.hopLimitMode, encap.hopLimit
+	entry.FlowLabelMode = encap.flowLabelMode
+	return entry, nil
+}
+
+// encapFlags are the outer IPv6 header options shared by the headend
+// create commands.
+func encapFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{Name: "tc-mode", Usage: "Outer Traffic Class: COPY (inner DSCP/ECN) or SET (--tc); default 0"},
+		&cli.UintFlag{Name: "tc", Usage: "Outer Traffic Class for --tc-mode SET (DSCP << 2 | ECN)"},
+		&cli.StringFlag{Name: "hop-limit-mode", Usage: "Outer Hop Limit: PROPAGATE (inner TTL/Hop Limit); default --hop-limit"},
+		&cli.UintFlag{Name: "hop-limit", Usage: "Fixed outer Hop Limit (0 = 64)"},
+		&cli.StringFlag{Name: "flow-label-mode", Usage: "Outer Flow Label: HASH (inner 5-tuple, RFC 6437); default 0"},
+	}
+}
+
+type encapOptions struct {
+	tcMode        v1.Srv6EncapTcMode
+	tc            uint32
+	hopLimitMode  v1.Srv6EncapHopLimitMode
+	hopLimit      uint32
+	flowLabelMode v1.Srv6EncapFlowLabelMode
+}
+
+func resolveEncapOptions(c *cli.Context) (encapOptions, error) {
+	o := encapOptions{tc: uint32(c.Uint("tc")), hopLimit: uint32(c.Uint("hop-limit"))}
+	var err error
+	if v := c.String("tc-mode"); v != "" {
+		if o.tcMode, err = resolveEncapTcMode(v); err != nil {
+			return o, err
+		}
+	}
+	if v := c.String("hop-limit-mode"); v != "" {
+		if o.hopLimitMode, err = resolveEncapHopLimitMode(v); err != nil {
+			return o, err
+		}
+	}
+	if v := c.String("flow-label-mode"); v != "" {
+		if o.flowLabelMode, err = resolveEncapFlowLabelMode(v); err != nil {
+			return o, err
+		}
+	}
+	return o, nil
+}
+
+// vlanRewriteFlags are the flags of an egress VLAN rewrite, named
+// <prefix>rewrite, <prefix>rewrite-vlan and <prefix>rewrite-inner-vlan.
+func vlanRewriteFlags(prefix string) []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{Name: prefix + "rewrite", Usage: "Egress VLAN rewrite: POP, PUSH or TRANSLATE"},
+		&cli.UintFlag{Name: prefix + "rewrite-vlan", Usage: "VLAN ID pushed or translated to by --" + prefix + "rewrite"},
+		&cli.UintFlag{Name: prefix + "rewrite-inner-vlan", Usage: "Inner VLAN ID translated to by --" + prefix + "rewrite TRANSLATE"},
+	}
+}
+
+// resolveVlanRewrite builds the rewrite given by vlanRewriteFlags(prefix),
+// nil when --<prefix>rewrite is not set.
+func resolveVlanRewrite(c *cli.Context, prefix string) (*v1.VlanRewrite, error) {
+	v := c.String(prefix + "rewrite")
+	if v == "" {
+		return nil, nil
+	}
+	op, err := resolveVlanRewriteOp(v)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.VlanRewrite{
+		Op:          op,
+		VlanId:      uint32(c.Uint(prefix + "rewrite-vlan")),
+		InnerVlanId: uint32(c.Uint(prefix + "rewrite-inner-vlan")),
+	}, nil
+}
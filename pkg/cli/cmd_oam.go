@@ -0,0 +1,134 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	v1 "github.com/takehaya/vinbero/api/vinbero/v1"
+	"github.com/urfave/cli/v2"
+)
+
+func oamCommand() *cli.Command {
+	probeFlags := []cli.Flag{
+		&cli.StringFlag{Name: "segments", Required: true, Usage: "SID list in traversal order (comma-separated, last = target)"},
+		&cli.StringFlag{Name: "source", Usage: "IPv6 source address for probes"},
+		&cli.UintFlag{Name: "timeout-ms", Value: 1000, Usage: "Per-probe timeout in milliseconds"},
+		&cli.BoolFlag{Name: "oam-flag", Usage: "Set the SRH O-flag so every SID punts a timestamped copy"},
+	}
+
+	return &cli.Command{
+		Name:  "oam",
+		Usage: "SRv6 OAM (RFC 9259): ping, traceroute and punted O-flag packets",
+		Subcommands: []*cli.Command{
+			{
+				Name:  "ping",
+				Usage: "Ping every segment of an SRv6 path",
+				Flags: append([]cli.Flag{
+					&cli.UintFlag{Name: "count", Value: 1, Usage: "Probes per segment"},
+				}, probeFlags...),
+				Action: func(c *cli.Context) error {
+					clients := clientsFromContext(c)
+					resp, err := clients.Oam.OamPing(context.Background(),
+						connect.NewRequest(&v1.OamPingRequest{
+							Segments:  strings.Split(c.String("segments"), ","),
+							Source:    c.String("source"),
+							Count:     uint32(c.Uint("count")),
+							TimeoutMs: uint32(c.Uint("timeout-ms")),
+							OamFlag:   c.Bool("oam-flag"),
+						}))
+					if err != nil {
+						return err
+					}
+					if useJSON(c) {
+						return printJSON(resp.Msg.Results)
+					}
+					printOamResults("SEGMENT", resp.Msg.Results)
+					return nil
+				},
+			},
+			{
+				Name:  "traceroute",
+				Usage: "Trace an SRv6 path hop by hop",
+				Flags: append([]cli.Flag{
+					&cli.UintFlag{Name: "max-hops", Value: 30, Usage: "Highest hop limit probed"},
+				}, probeFlags...),
+				Action: func(c *cli.Context) error {
+					clients := clientsFromContext(c)
+					resp, err := clients.Oam.OamTraceroute(context.Background(),
+						connect.NewRequest(&v1.OamTracerouteRequest{
+							Segments:  strings.Split(c.String("segments"), ","),
+							Source:    c.String("source"),
+							MaxHops:   uint32(c.Uint("max-hops")),
+							TimeoutMs: uint32(c.Uint("timeout-ms")),
+							OamFlag:   c.Bool("oam-flag"),
+						}))
+					if err != nil {
+						return err
+					}
+					if useJSON(c) {
+						return printJSON(resp.Msg.Hops)
+					}
+					printOamResults("HOP", resp.Msg.Hops)
+					return nil
+				},
+			},
+			{
+				Name:  "punts",
+				Usage: "List timestamped copies punted for O-flag / End.OP packets",
+				Flags: []cli.Flag{
+					&cli.UintFlag{Name: "limit", Usage: "Show only the most recent N punts"},
+				},
+				Action: func(c *cli.Context) error {
+					clients := clientsFromContext(c)
+					resp, err := clients.Oam.OamPuntList(context.Background(),
+						connect.NewRequest(&v1.OamPuntListRequest{Limit: uint32(c.Uint("limit"))}))
+					if err != nil {
+						return err
+					}
+					if useJSON(c) {
+						return printJSON(resp.Msg.Punts)
+					}
+					headers := []string{"TIME", "INTERFACE", "REASON", "ACTION", "SRC", "DST", "SL", "SEGMENTS"}
+					var rows [][]string
+					for _, p := range resp.Msg.Punts {
+						rows = append(rows, []string{
+							time.Unix(0, p.TimestampUnixNano).Format(time.RFC3339Nano),
+							p.InterfaceName,
+							p.Reason,
+							formatAction(p.Action),
+							p.Src,
+							p.Dst,
+							fmt.Sprintf("%d", p.SegmentsLeft),
+							strings.Join(p.Segments, ","),
+						})
+					}
+					printTable(headers, rows)
+					return nil
+				},
+			},
+		},
+	}
+}
+
+func printOamResults(indexHeader string, results []*v1.OamProbeResult) {
+	headers := []string{indexHeader, "TARGET", "RESPONDER", "REPLY", "CODE", "RTT"}
+	var rows [][]string
+	for _, r := range results {
+		responder, rtt := r.Responder, fmt.Sprintf("%.3fms", float64(r.RttUs)/1000)
+		if r.Reply == v1.OamReplyType_OAM_REPLY_TYPE_TIMEOUT {
+			responder, rtt = "*", "*"
+		}
+		rows = append(rows, []string{
+			fmt.Sprintf("%d", r.Index),
+			r.Target,
+			responder,
+			strings.TrimPrefix(r.Reply.String(), "OAM_REPLY_TYPE_"),
+			fmt.Sprintf("%d", r.IcmpCode),
+			rtt,
+		})
+	}
+	printTable(headers, rows)
+}
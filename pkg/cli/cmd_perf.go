@@ -0,0 +1,202 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	v1 "github.com/takehaya/vinbero/api/vinbero/v1"
+	"github.com/urfave/cli/v2"
+)
+
+func perfCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "perf",
+		Usage: "SRv6 performance measurement with STAMP (RFC 8762 / RFC 9503)",
+		Subcommands: []*cli.Command{
+			stampReflectorCommand(),
+			stampSessionCommand(),
+		},
+	}
+}
+
+func stampReflectorCommand() *cli.Command {
+	reflectorFlags := []cli.Flag{
+		&cli.StringFlag{Name: "address", Required: true, Usage: "Local IPv6 address the reflector answers on"},
+		&cli.UintFlag{Name: "port", Value: 862, Usage: "UDP port"},
+	}
+
+	return &cli.Command{
+		Name:  "reflector",
+		Usage: "Manage STAMP Session-Reflectors in the XDP path",
+		Subcommands: []*cli.Command{
+			{
+				Name:  "create",
+				Usage: "Answer STAMP test packets sent to an address and port",
+				Flags: reflectorFlags,
+				Action: func(c *cli.Context) error {
+					clients := clientsFromContext(c)
+					r := &v1.StampReflector{Address: c.String("address"), Port: uint32(c.Uint("port"))}
+					resp, err := clients.Perf.StampReflectorCreate(context.Background(),
+						connect.NewRequest(&v1.StampReflectorCreateRequest{Reflectors: []*v1.StampReflector{r}}))
+					if err != nil {
+						return err
+					}
+					return printOperationResult(resp.Msg.Created, resp.Msg.Errors, "STAMP reflector")
+				},
+			},
+			{
+				Name:  "delete",
+				Usage: "Stop answering STAMP test packets for an address and port",
+				Flags: reflectorFlags,
+				Action: func(c *cli.Context) error {
+					clients := clientsFromContext(c)
+					r := &v1.StampReflector{Address: c.String("address"), Port: uint32(c.Uint("port"))}
+					resp, err := clients.Perf.StampReflectorDelete(context.Background(),
+						connect.NewRequest(&v1.StampReflectorDeleteRequest{Reflectors: []*v1.StampReflector{r}}))
+					if err != nil {
+						return err
+					}
+					for _, d := range resp.Msg.Deleted {
+						fmt.Printf("Deleted: [%s]:%d\n", d.Address, d.Port)
+					}
+					for _, e := range resp.Msg.Errors {
+						return fmt.Errorf("%s: %s", e.TriggerPrefix, e.Reason)
+					}
+					return nil
+				},
+			},
+			{
+				Name:  "list",
+				Usage: "List STAMP reflectors",
+				Action: func(c *cli.Context) error {
+					clients := clientsFromContext(c)
+					resp, err := clients.Perf.StampReflectorList(context.Background(),
+						connect.NewRequest(&v1.StampReflectorListRequest{}))
+					if err != nil {
+						return err
+					}
+					if useJSON(c) {
+						return printJSON(resp.Msg.Reflectors)
+					}
+					headers := []string{"ADDRESS", "PORT", "REFLECTED"}
+					var rows [][]string
+					for _, r := range resp.Msg.Reflectors {
+						rows = append(rows, []string{
+							r.Address,
+							fmt.Sprintf("%d", r.Port),
+							fmt.Sprintf("%d", r.ReflectedPackets),
+						})
+					}
+					printTable(headers, rows)
+					return nil
+				},
+			},
+		},
+	}
+}
+
+func stampSessionCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "session",
+		Usage: "Manage STAMP Session-Senders probing SRv6 paths",
+		Subcommands: []*cli.Command{
+			{
+				Name:  "create",
+				Usage: "Start probing a segment list or an existing SR policy",
+				Flags: []cli.Flag{
+					&cli.StringFlag{Name: "name", Required: true, Usage: "Session name"},
+					&cli.StringFlag{Name: "segments", Usage: "SID list in traversal order (comma-separated, last = reflector)"},
+					&cli.StringFlag{Name: "policy", Usage: "Trigger prefix of a headend v4/v6 SR policy to probe instead of --segments"},
+					&cli.StringFlag{Name: "source", Usage: "IPv6 source address for test packets"},
+					&cli.UintFlag{Name: "port", Value: 862, Usage: "Reflector UDP port"},
+					&cli.UintFlag{Name: "interval-ms", Value: 1000, Usage: "Time between test packets"},
+					&cli.UintFlag{Name: "timeout-ms", Value: 1000, Usage: "Declare a test packet lost after this long"},
+					&cli.UintFlag{Name: "window", Value: 100, Usage: "Test packets covered by the rolling statistics"},
+				},
+				Action: func(c *cli.Context) error {
+					clients := clientsFromContext(c)
+					var segments []string
+					if s := c.String("segments"); s != "" {
+						segments = strings.Split(s, ",")
+					}
+					sess := &v1.StampSession{
+						Name:       c.String("name"),
+						Segments:   segments,
+						Policy:     c.String("policy"),
+						Source:     c.String("source"),
+						Port:       uint32(c.Uint("port")),
+						IntervalMs: uint32(c.Uint("interval-ms")),
+						TimeoutMs:  uint32(c.Uint("timeout-ms")),
+						Window:     uint32(c.Uint("window")),
+					}
+					resp, err := clients.Perf.StampSessionCreate(context.Background(),
+						connect.NewRequest(&v1.StampSessionCreateRequest{Sessions: []*v1.StampSession{sess}}))
+					if err != nil {
+						return err
+					}
+					return printOperationResult(resp.Msg.Created, resp.Msg.Errors, "STAMP session")
+				},
+			},
+			{
+				Name:  "delete",
+				Usage: "Stop a STAMP session",
+				Flags: []cli.Flag{
+					&cli.StringFlag{Name: "name", Required: true},
+				},
+				Action: func(c *cli.Context) error {
+					clients := clientsFromContext(c)
+					resp, err := clients.Perf.StampSessionDelete(context.Background(),
+						connect.NewRequest(&v1.StampSessionDeleteRequest{Names: []string{c.String("name")}}))
+					if err != nil {
+						return err
+					}
+					for _, e := range resp.Msg.Errors {
+						return fmt.Errorf("%s: %s", e.TriggerPrefix, e.Reason)
+					}
+					fmt.Printf("Deleted: %s\n", strings.Join(resp.Msg.DeletedNames, ", "))
+					return nil
+				},
+			},
+			{
+				Name:  "list",
+				Usage: "List STAMP sessions with rolling delay, jitter and loss",
+				Action: func(c *cli.Context) error {
+					clients := clientsFromContext(c)
+					resp, err := clients.Perf.StampSessionList(context.Background(),
+						connect.NewRequest(&v1.StampSessionListRequest{}))
+					if err != nil {
+						return err
+					}
+					if useJSON(c) {
+						return printJSON(resp.Msg.Sessions)
+					}
+					headers := []string{"NAME", "SEGMENTS", "SENT", "RECEIVED", "LOSS", "AVG", "MIN", "MAX", "JITTER"}
+					var rows [][]string
+					for _, s := range resp.Msg.Sessions {
+						st := s.Stats
+						rows = append(rows, []string{
+							s.Session.Name,
+							strings.Join(s.Session.Segments, ","),
+							fmt.Sprintf("%d", st.Sent),
+							fmt.Sprintf("%d", st.Received),
+							fmt.Sprintf("%.1f%%", st.LossRatio*100),
+							formatDelay(st.AvgDelayNs),
+							formatDelay(st.MinDelayNs),
+							formatDelay(st.MaxDelayNs),
+							formatDelay(st.JitterNs),
+						})
+					}
+					printTable(headers, rows)
+					return nil
+				},
+			},
+		},
+	}
+}
+
+func formatDelay(ns uint64) string {
+	return fmt.Sprintf("%.3fms", float64(time.Duration(ns))/float64(time.Millisecond))
+}
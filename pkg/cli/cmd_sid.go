@@ -22,107 +22,14 @@ func sidFunctionCommand() *cli.Command {
 			{
 				Name:  "create",
 				Usage: "Create a SID function",
-				Flags: []cli.Flag{
-					&cli.StringFlag{Name: "trigger-prefix", Required: true, Usage: "IPv6 CIDR (e.g., fc00:1::1/128)"},
-					&cli.StringFlag{Name: "action", Required: true, Usage: "Endpoint action (e.g., END_DT4, END_DT2)"},
-					&cli.StringFlag{Name: "vrf-name", Usage: "VRF device name (for End.DT4/DT6/DT46)"},
-					&cli.UintFlag{Name: "bd-id", Usage: "Bridge Domain ID (for End.DT2)"},
-					&cli.StringFlag{Name: "bridge-name", Usage: "Bridge device name (for End.DT2)"},
-					&cli.StringFlag{Name: "src-addr", Usage: "Source IPv6 address"},
-					&cli.StringFlag{Name: "dst-addr", Usage: "Destination IPv6 address"},
-					&cli.StringFlag{Name: "nexthop", Usage: "Next-hop IPv6 address (for End.X)"},
-					&cli.UintFlag{Name: "oif", Usage: "Output interface index (for End.DX2)"},
-					&cli.StringFlag{Name: "flavor", Usage: "SRv6 flavor (PSP, USP, USD)"},
-					&cli.StringFlag{Name: "segments", Usage: "Policy segment list, comma-separated (for End.B6)"},
-					&cli.StringFlag{Name: "headend-mode", Usage: "Policy mode: H_INSERT, H_INSERT_RED, H_ENCAPS, H_ENCAPS_RED (for End.B6)"},
-					&cli.UintFlag{Name: "args-offset", Usage: "Args.Mob.Session byte offset in SID (for GTP functions)"},
-					&cli.StringFlag{Name: "gtp-v4-src-addr", Usage: "GTP4 outer IPv4 source address (for End.M.GTP4.E)"},
-					&cli.UintFlag{Name: "table-id", Usage: "VLAN table ID (for End.DX2V)"},
-					&cli.StringFlag{Name: "plugin-aux-hex", Usage: "Plugin-defined aux payload as hex (<= 196 bytes after decode)"},
-					&cli.StringFlag{Name: "plugin-aux-json", Usage: "Plugin-defined aux payload as JSON (server encodes via plugin BTF)"},
-					&cli.StringFlag{Name: "plugin-aux-json-file", Usage: "Path to a file containing plugin aux JSON"},
-				},
+				Flags: sidFunctionFlags(),
 				Action: func(c *cli.Context) error {
 					clients := clientsFromContext(c)
-					action, err := resolveAction(c.String("action"))
+					sid, err := sidFunctionFromFlags(c)
 					if err != nil {
 						return err
 					}
 
-					var flavor v1.Srv6LocalFlavor
-					if f := c.String("flavor"); f != "" {
-						var err error
-						flavor, err = resolveFlavor(f)
-						if err != nil {
-							return err
-						}
-					}
-
-					var headendMode v1.Srv6HeadendBehavior
-					if m := c.String("headend-mode"); m != "" {
-						var err error
-						headendMode, err = resolveMode(m)
-						if err != nil {
-							return err
-						}
-					}
-
-					var segments []string
-					if s := c.String("segments"); s != "" {
-						segments = strings.Split(s, ",")
-						for i := range segments {
-							segments[i] = strings.TrimSpace(segments[i])
-						}
-					}
-
-					var pluginAuxRaw []byte
-					if hx := c.String("plugin-aux-hex"); hx != "" {
-						decoded, err := hex.DecodeString(hx)
-						if err != nil {
-							return fmt.Errorf("invalid plugin-aux-hex: %w", err)
-						}
-						if len(decoded) > bpf.SidAuxPluginRawMax {
-							return fmt.Errorf("plugin-aux-hex decodes to %d bytes, max %d",
-								len(decoded), bpf.SidAuxPluginRawMax)
-						}
-						pluginAuxRaw = decoded
-					}
-
-					pluginAuxJSON := c.String("plugin-aux-json")
-					if jsonPath := c.String("plugin-aux-json-file"); jsonPath != "" {
-						if pluginAuxJSON != "" {
-							return fmt.Errorf("--plugin-aux-json and --plugin-aux-json-file are mutually exclusive")
-						}
-						body, err := os.ReadFile(jsonPath)
-						if err != nil {
-							return fmt.Errorf("read %s: %w", jsonPath, err)
-						}
-						pluginAuxJSON = string(body)
-					}
-					if pluginAuxJSON != "" && pluginAuxRaw != nil {
-						return fmt.Errorf("--plugin-aux-hex and --plugin-aux-json* are mutually exclusive")
-					}
-
-					sid := &v1.SidFunction{
-						Action:        action,
-						TriggerPrefix: c.String("trigger-prefix"),
-						SrcAddr:       c.String("src-addr"),
-						DstAddr:       c.String("dst-addr"),
-						Nexthop:       c.String("nexthop"),
-						Flavor:        flavor,
-						VrfName:       c.String("vrf-name"),
-						BdId:          uint32(c.Uint("bd-id")),
-						BridgeName:    c.String("bridge-name"),
-						Oif:           uint32(c.Uint("oif")),
-						Segments:      segments,
-						HeadendMode:   headendMode,
-						ArgsOffset:   uint32(c.Uint("args-offset")),
-						GtpV4SrcAddr: c.String("gtp-v4-src-addr"),
-						TableId:       uint32(c.Uint("table-id")),
-						PluginAuxRaw:  pluginAuxRaw,
-						PluginAuxJson: pluginAuxJSON,
-					}
-
 					resp, err := clients.Sid.SidFunctionCreate(context.Background(),
 						connect.NewRequest(&v1.SidFunctionCreateRequest{SidFunctions: []*v1.SidFunction{sid}}))
 					if err != nil {
@@ -131,6 +38,31 @@ func sidFunctionCommand() *cli.Command {
 					return printOperationResult(resp.Msg.Created, resp.Msg.Errors, "SID function")
 				},
 			},
+			{
+				Name:  "update",
+				Usage: "Change fields of a SID function in place; only the flags given are changed",
+				Flags: updateFlags(sidFunctionFlags(), "trigger-prefix"),
+				Action: func(c *cli.Context) error {
+					clients := clientsFromContext(c)
+					sid, err := sidFunctionFromFlags(c)
+					if err != nil {
+						return err
+					}
+					mask, err := updateMask(c, []string{"trigger-prefix"}, map[string]string{
+						"plugin-aux-hex":       "plugin_aux_raw",
+						"plugin-aux-json-file": "plugin_aux_json",
+					})
+					if err != nil {
+						return err
+					}
+					resp, err := clients.Sid.SidFunctionUpdate(context.Background(),
+						connect.NewRequest(&v1.SidFunctionUpdateRequest{SidFunctions: []*v1.SidFunction{sid}, UpdateMask: mask}))
+					if err != nil {
+						return err
+					}
+					return printUpdateResult(resp.Msg.Updated, resp.Msg.Errors, "SID function")
+				},
+			},
 			{
 				Name:  "delete",
 				Usage: "Delete a SID function",
@@ -215,3 +147,116 @@ func sidFunctionCommand() *cli.Command {
 		},
 	}
 }
+
+func sidFunctionFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{Name: "trigger-prefix", Required: true, Usage: "IPv6 CIDR (e.g., fc00:1::1/128)"},
+		&cli.StringFlag{Name: "action", Required: true, Usage: "Endpoint action (e.g., END_DT4, END_DT2)"},
+		&cli.StringFlag{Name: "vrf-name", Usage: "VRF device name (for End.DT4/DT6/DT46)"},
+		&cli.UintFlag{Name: "bd-id", Usage: "Bridge Domain ID (for End.DT2)"},
+		&cli.StringFlag{Name: "bridge-name", Usage: "Bridge device name (for End.DT2)"},
+		&cli.StringFlag{Name: "src-addr", Usage: "Source IPv6 address"},
+		&cli.StringFlag{Name: "dst-addr", Usage: "Destination IPv6 address"},
+		&cli.StringFlag{Name: "nexthop", Usage: "Next-hop IPv6 address (for End.X)"},
+		&cli.UintFlag{Name: "oif", Usage: "Output interface index (for End.DX2)"},
+		&cli.StringFlag{Name: "flavor", Usage: "SRv6 flavor (PSP, USP, USD)"},
+		&cli.BoolFlag{Name: "ecn-propagate", Usage: "Copy outer ECN-CE into the inner header on decap (End.DX4/DX6/DT4/DT6/DT46, USD)"},
+		&cli.StringFlag{Name: "segments", Usage: "Policy segment list, comma-separated (for End.B6)"},
+		&cli.StringFlag{Name: "headend-mode", Usage: "Policy mode: H_INSERT, H_INSERT_RED, H_ENCAPS, H_ENCAPS_RED (for End.B6)"},
+		&cli.UintFlag{Name: "args-offset", Usage: "Args.Mob.Session byte offset in SID (for GTP functions)"},
+		&cli.StringFlag{Name: "gtp-v4-src-addr", Usage: "GTP4 outer IPv4 source address (for End.M.GTP4.E)"},
+		&cli.UintFlag{Name: "table-id", Usage: "VLAN table ID (for End.DX2V)"},
+		&cli.StringFlag{Name: "plugin-aux-hex", Usage: "Plugin-defined aux payload as hex (<= 200 bytes after decode)"},
+		&cli.StringFlag{Name: "plugin-aux-json", Usage: "Plugin-defined aux payload as JSON (server encodes via plugin BTF)"},
+		&cli.StringFlag{Name: "plugin-aux-json-file", Usage: "Path to a file containing plugin aux JSON"},
+	}
+}
+
+// sidFunctionFromFlags builds the SID function given by sidFunctionFlags.
+func sidFunctionFromFlags(c *cli.Context) (*v1.SidFunction, error) {
+	// --action is required by create; update leaves it out unless changed
+	var action v1.Srv6LocalAction
+	if a := c.String("action"); a != "" {
+		var err error
+		action, err = resolveAction(a)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var flavor v1.Srv6LocalFlavor
+	if f := c.String("flavor"); f != "" {
+		var err error
+		flavor, err = resolveFlavor(f)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var headendMode v1.Srv6HeadendBehavior
+	if m := c.String("headend-mode"); m != "" {
+		var err error
+		headendMode, err = resolveMode(m)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var segments []string
+	if s := c.String("segments"); s != "" {
+		segments = strings.Split(s, ",")
+		for i := range segments {
+			segments[i] = strings.TrimSpace(segments[i])
+		}
+	}
+
+	var pluginAuxRaw []byte
+	if hx := c.String("plugin-aux-hex"); hx != "" {
+		decoded, err := hex.DecodeString(hx)
+		if err != nil {
+			return nil, fmt.Errorf("invalid plugin-aux-hex: %w", err)
+		}
+		if len(decoded) > bpf.SidAuxPluginRawMax {
+			return nil, fmt.Errorf("plugin-aux-hex decodes to %d bytes, max %d",
+				len(decoded), bpf.SidAuxPluginRawMax)
+		}
+		pluginAuxRaw = decoded
+	}
+
+	pluginAuxJSON := c.String("plugin-aux-json")
+	if jsonPath := c.String("plugin-aux-json-file"); jsonPath != "" {
+		if pluginAuxJSON != "" {
+			return nil, fmt.Errorf("--plugin-aux-json and --plugin-aux-json-file are mutually exclusive")
+		}
+		body, err := os.ReadFile(jsonPath)
+		if err != nil {
+			return nil, fmt.Errorf("read %s: %w", jsonPath, err)
+		}
+		pluginAuxJSON = string(body)
+	}
+	if pluginAuxJSON != "" && pluginAuxRaw != nil {
+		return nil, fmt.Errorf("--plugin-aux-hex and --plugin-aux-json* are mutually exclusive")
+	}
+
+	sid := &v1.SidFunction{
+		Action:        action,
+		TriggerPrefix: c.String("trigger-prefix"),
+		SrcAddr:       c.String("src-addr"),
+		DstAddr:       c.String("dst-addr"),
+		Nexthop:       c.String("nexthop"),
+		Flavor:        flavor,
+		EcnPropagate:  c.Bool("ecn-propagate"),
+		VrfName:       c.String("vrf-name"),
+		BdId:          uint32(c.Uint("bd-id")),
+		BridgeName:    c.String("bridge-name"),
+		Oif:           uint32(c.Uint("oif")),
+		Segments:      segments,
+		HeadendMode:   headendMode,
+		ArgsOffset:    uint32(c.Uint("args-offset")),
+		GtpV4SrcAddr:  c.String("gtp-v4-src-addr"),
+		TableId:       uint32(c.Uint("table-id")),
+		PluginAuxRaw:  pluginAuxRaw,
+		PluginAuxJson: pluginAuxJSON,
+	}
+	return sid, nil
+}
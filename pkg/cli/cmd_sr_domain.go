@@ -0,0 +1,111 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	v1 "github.com/takehaya/vinbero/api/vinbero/v1"
+	"github.com/urfave/cli/v2"
+)
+
+func srDomainCommand() *cli.Command {
+	return &cli.Command{
+		Name:    "sr-domain",
+		Aliases: []string{"srd"},
+		Usage:   "Manage SR domain boundary protection (RFC 8754 Sec.5.1)",
+		Subcommands: []*cli.Command{
+			{
+				Name:  "create",
+				Usage: "Protect a locator: only trusted sources/interfaces may reach its SIDs",
+				Flags: []cli.Flag{
+					&cli.StringFlag{Name: "locator", Required: true, Usage: "IPv6 locator prefix (e.g., fc00:1::/48)"},
+					&cli.StringFlag{Name: "trusted-src", Usage: "Trusted IPv6 source prefixes (comma-separated)"},
+					&cli.StringFlag{Name: "trusted-interfaces", Usage: "Trusted ingress interfaces (comma-separated)"},
+				},
+				Action: func(c *cli.Context) error {
+					clients := clientsFromContext(c)
+					var srcs, ifaces []string
+					if s := c.String("trusted-src"); s != "" {
+						srcs = strings.Split(s, ",")
+					}
+					if s := c.String("trusted-interfaces"); s != "" {
+						ifaces = strings.Split(s, ",")
+					}
+					policy := &v1.SrDomainPolicy{
+						Locator:            c.String("locator"),
+						TrustedSrcPrefixes: srcs,
+						TrustedInterfaces:  ifaces,
+					}
+					resp, err := clients.SrDomain.SrDomainCreate(context.Background(),
+						connect.NewRequest(&v1.SrDomainCreateRequest{Policies: []*v1.SrDomainPolicy{policy}}))
+					if err != nil {
+						return err
+					}
+					return printOperationResult(resp.Msg.Created, resp.Msg.Errors, "SR domain policy")
+				},
+			},
+			{
+				Name:  "delete",
+				Usage: "Remove the boundary policy of a locator",
+				Flags: []cli.Flag{
+					&cli.StringFlag{Name: "locator", Required: true},
+				},
+				Action: func(c *cli.Context) error {
+					clients := clientsFromContext(c)
+					resp, err := clients.SrDomain.SrDomainDelete(context.Background(),
+						connect.NewRequest(&v1.SrDomainDeleteRequest{Locators: []string{c.String("locator")}}))
+					if err != nil {
+						return err
+					}
+					fmt.Printf("Deleted: %s\n", strings.Join(resp.Msg.DeletedLocators, ", "))
+					return nil
+				},
+			},
+			{
+				Name:  "flush",
+				Usage: "Delete every SR domain boundary policy (requires --yes)",
+				Flags: []cli.Flag{
+					&cli.BoolFlag{Name: "yes", Required: true, Usage: "Confirm the destructive operation"},
+				},
+				Action: func(c *cli.Context) error {
+					clients := clientsFromContext(c)
+					resp, err := clients.SrDomain.SrDomainFlush(context.Background(),
+						connect.NewRequest(&v1.SrDomainFlushRequest{}))
+					if err != nil {
+						return err
+					}
+					fmt.Printf("Flushed %d SR domain policies\n", resp.Msg.DeletedCount)
+					return nil
+				},
+			},
+			{
+				Name:  "list",
+				Usage: "List SR domain boundary policies",
+				Action: func(c *cli.Context) error {
+					clients := clientsFromContext(c)
+					resp, err := clients.SrDomain.SrDomainList(context.Background(),
+						connect.NewRequest(&v1.SrDomainListRequest{}))
+					if err != nil {
+						return err
+					}
+					if useJSON(c) {
+						return printJSON(resp.Msg.Policies)
+					}
+					headers := []string{"LOCATOR", "TRUSTED_SRC", "TRUSTED_INTERFACES"}
+					var rows [][]string
+					for _, p := range resp.Msg.Policies {
+						rows = append(rows, []string{
+							p.Locator,
+							strings.Join(p.TrustedSrcPrefixes, ","),
+							strings.Join(p.TrustedInterfaces, ","),
+						})
+					}
+					printTable(headers, rows)
+					return nil
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,254 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	v1 "github.com/takehaya/vinbero/api/vinbero/v1"
+	"github.com/urfave/cli/v2"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/yaml.v3"
+)
+
+func exportCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "export",
+		Usage: "Print the node's SIDs, headends, ACs, peers, ESs, VLAN table, static FDB, VRFs, bridges and plugins as one YAML document (JSON with --json)",
+		Action: func(c *cli.Context) error {
+			clients := clientsFromContext(c)
+			resp, err := clients.System.Export(context.Background(),
+				connect.NewRequest(&v1.ExportRequest{}))
+			if err != nil {
+				return err
+			}
+			data, err := protojson.MarshalOptions{UseProtoNames: true, Multiline: true}.Marshal(resp.Msg.Config)
+			if err != nil {
+				return err
+			}
+			if useJSON(c) {
+				_, err = fmt.Println(string(data))
+				return err
+			}
+			out, err := jsonToYAML(data)
+			if err != nil {
+				return err
+			}
+			_, err = os.Stdout.Write(out)
+			return err
+		},
+	}
+}
+
+func importCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "import",
+		Usage: "Apply a document written by 'vinbero export' (YAML or JSON)",
+		Description: "Entries of the document are created, or updated when they differ from the\n" +
+			"installed ones. Other installed entries are kept unless --replace is given,\n" +
+			"which deletes them.",
+		Flags: []cli.Flag{
+			&cli.StringFlag{Name: "file", Aliases: []string{"f"}, Required: true, Usage: "Document to import (- for stdin)"},
+			&cli.BoolFlag{Name: "replace", Usage: "Delete installed entries the document doesn't list"},
+		},
+		Action: func(c *cli.Context) error {
+			nc := &v1.NodeConfig{}
+			if err := readDocument(c.String("file"), nc); err != nil {
+				return err
+			}
+			mode := v1.ImportMode_IMPORT_MODE_MERGE
+			if c.Bool("replace") {
+				mode = v1.ImportMode_IMPORT_MODE_REPLACE
+			}
+			clients := clientsFromContext(c)
+			resp, err := clients.System.Import(context.Background(),
+				connect.NewRequest(&v1.ImportRequest{Config: nc, Mode: mode}))
+			if err != nil {
+				return err
+			}
+			if useJSON(c) {
+				return printJSON(resp.Msg)
+			}
+			printSectionTable(resp.Msg.Sections)
+			return sectionErrors(resp.Msg.Sections)
+		},
+	}
+}
+
+// readDocument reads a YAML or JSON document into msg. YAML is a
+// superset of JSON, so both go through the YAML decoder and then
+// protojson, which accepts proto and JSON field names alike.
+func readDocument(path string, msg proto.Message) error {
+	var data []byte
+	var err error
+	if path == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return err
+	}
+	var doc any
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return fmt.Errorf("parse %s: %w", path, err)
+	}
+	js, err := json.Marshal(doc)
+	if err != nil {
+		return fmt.Errorf("parse %s: %w", path, err)
+	}
+	if err := protojson.Unmarshal(js, msg); err != nil {
+		return fmt.Errorf("parse %s: %w", path, err)
+	}
+	return nil
+}
+
+// jsonToYAML re-emits a JSON document as block-style YAML, keeping the
+// field order of the JSON.
+func jsonToYAML(data []byte) ([]byte, error) {
+	var node yaml.Node
+	if err := yaml.Unmarshal(data, &node); err != nil {
+		return nil, err
+	}
+	var block func(*yaml.Node)
+	block = func(n *yaml.Node) {
+		n.Style = 0
+		for _, child := range n.Content {
+			block(child)
+		}
+	}
+	block(&node)
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(&node); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func systemCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "system",
+		Usage: "Inspect and grow the BPF maps of the running vinberod",
+		Subcommands: []*cli.Command{
+			{
+				Name:  "maps",
+				Usage: "Show entries, capacity, size and high-watermark of every control map",
+				Action: func(c *cli.Context) error {
+					clients := clientsFromContext(c)
+					resp, err := clients.System.MapUsage(context.Background(),
+						connect.NewRequest(&v1.MapUsageRequest{}))
+					if err != nil {
+						return err
+					}
+					if useJSON(c) {
+						return printJSON(resp.Msg)
+					}
+					printMapUsageTable(resp.Msg.Maps)
+					return nil
+				},
+			},
+			{
+				Name:  "events",
+				Usage: "List maps crossing their settings.map_usage threshold, filling up or dropping back below",
+				Flags: []cli.Flag{
+					&cli.UintFlag{Name: "limit", Usage: "Show only the most recent N events"},
+				},
+				Action: func(c *cli.Context) error {
+					clients := clientsFromContext(c)
+					resp, err := clients.System.MapEventList(context.Background(),
+						connect.NewRequest(&v1.MapEventListRequest{Limit: uint32(c.Uint("limit"))}))
+					if err != nil {
+						return err
+					}
+					if useJSON(c) {
+						return printJSON(resp.Msg.Events)
+					}
+					headers := []string{"TIME", "MAP", "EVENT", "ENTRIES", "CAPACITY", "WARN"}
+					var rows [][]string
+					for _, e := range resp.Msg.Events {
+						rows = append(rows, []string{
+							time.Unix(0, e.TimestampUnixNano).Format(time.RFC3339),
+							e.Map,
+							strings.TrimPrefix(e.Kind.String(), "MAP_EVENT_KIND_"),
+							fmt.Sprintf("%d", e.Entries),
+							fmt.Sprintf("%d", e.Capacity),
+							fmt.Sprintf("%d%%", e.WarnPercent),
+						})
+					}
+					printTable(headers, rows)
+					return nil
+				},
+			},
+			{
+				Name:  "resize",
+				Usage: "Grow a map without losing its entries (kept across restarts only with pin_maps; also raise settings.entries)",
+				Flags: []cli.Flag{
+					&cli.StringFlag{Name: "map", Required: true, Usage: "Map name as shown by 'system maps', e.g. fdb_map"},
+					&cli.UintFlag{Name: "capacity", Required: true, Usage: "New max_entries; must exceed the current one"},
+				},
+				Action: func(c *cli.Context) error {
+					clients := clientsFromContext(c)
+					resp, err := clients.System.MapResize(context.Background(),
+						connect.NewRequest(&v1.MapResizeRequest{
+							Name:     c.String("map"),
+							Capacity: uint32(c.Uint("capacity")),
+						}))
+					if err != nil {
+						return err
+					}
+					if useJSON(c) {
+						return printJSON(resp.Msg)
+					}
+					printMapUsageTable([]*v1.BpfMapUsage{resp.Msg.Map})
+					for _, p := range resp.Msg.ReloadedPlugins {
+						fmt.Printf("Plugin reloaded: %s\n", p)
+					}
+					return nil
+				},
+			},
+		},
+	}
+}
+
+// printMapUsageTable prints maps with their fill level in percent. Maps
+// at or above their threshold are marked with "!".
+func printMapUsageTable(maps []*v1.BpfMapUsage) {
+	headers := []string{"MAP", "TYPE", "ENTRIES", "CAPACITY", "USED", "HWM", "WARN", "BYTES"}
+	var rows [][]string
+	for _, m := range maps {
+		used, warn := "-", "-"
+		if m.Capacity > 0 {
+			used = fmt.Sprintf("%.1f%%", 100*float64(m.Entries)/float64(m.Capacity))
+		}
+		if m.WarnPercent > 0 {
+			warn = fmt.Sprintf("%d%%", m.WarnPercent)
+			if uint64(m.Entries)*100 >= uint64(m.Capacity)*uint64(m.WarnPercent) {
+				used += " !"
+			}
+		}
+		rows = append(rows, []string{
+			m.Name,
+			m.Type,
+			fmt.Sprintf("%d", m.Entries),
+			fmt.Sprintf("%d", m.Capacity),
+			used,
+			fmt.Sprintf("%d", m.HighWatermark),
+			warn,
+			fmt.Sprintf("%d", m.Bytes),
+		})
+	}
+	printTable(headers, rows)
+}
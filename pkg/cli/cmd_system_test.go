@@ -0,0 +1,55 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	v1 "github.com/takehaya/vinbero/api/vinbero/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// TestExportYAMLRoundTrip checks that the YAML 'vinbero export' prints
+// reads back into the same NodeConfig.
+func TestExportYAMLRoundTrip(t *testing.T) {
+	want := &v1.NodeConfig{
+		Vrfs: []*v1.Vrf{{Name: "vrf100", TableId: 100, Members: []string{"eth2"}}},
+		SidFunctions: []*v1.SidFunction{{
+			TriggerPrefix: "fc00:1::100/128",
+			Action:        v1.Srv6LocalAction_SRV6_LOCAL_ACTION_END_DT4,
+			VrfName:       "vrf100",
+		}, {
+			TriggerPrefix: "fc00:1::200/128",
+			Action:        40,
+			PluginAuxJson: `{"count":"true","mac":"aa:bb:cc:dd:ee:ff"}`,
+		}},
+		FdbEntries: []*v1.FdbEntry{{BdId: 100, Mac: "02:00:00:00:00:01", Oif: 3, IsStatic: true, LastSeen: 1 << 60}},
+		Plugins:    []*v1.PluginRegisterRequest{{MapType: "endpoint", Index: 40, Program: "plugin_counter", BpfElf: []byte{0x7f, 'E', 'L', 'F', 0}}},
+	}
+
+	data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := jsonToYAML(data)
+	if err != nil {
+		t.Fatalf("jsonToYAML: %v", err)
+	}
+	if !strings.Contains(string(out), "sid_functions:\n") || strings.HasPrefix(string(out), "{") {
+		t.Errorf("not block-style YAML with proto names:\n%s", out)
+	}
+
+	path := filepath.Join(t.TempDir(), "node.yaml")
+	if err := os.WriteFile(path, out, 0644); err != nil {
+		t.Fatal(err)
+	}
+	got := &v1.NodeConfig{}
+	if err := readDocument(path, got); err != nil {
+		t.Fatalf("readDocument: %v\n%s", err, out)
+	}
+	if !proto.Equal(got, want) {
+		t.Errorf("round trip mismatch:\n got %v\nwant %v", got, want)
+	}
+}
@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"connectrpc.com/connect"
+	v1 "github.com/takehaya/vinbero/api/vinbero/v1"
+	"github.com/urfave/cli/v2"
+)
+
+func transactionCommand() *cli.Command {
+	return &cli.Command{
+		Name:    "transaction",
+		Aliases: []string{"tx"},
+		Usage:   "Apply a batch of operations across resource types as one unit",
+		Subcommands: []*cli.Command{
+			{
+				Name:  "commit",
+				Usage: "Validate and apply every operation of a file, or none of them",
+				Description: "The file (YAML or JSON) holds an operations list; each entry has an op\n" +
+					"(create, update or delete) and one resource, for example:\n\n" +
+					"  operations:\n" +
+					"    - op: TRANSACTION_OP_CREATE\n" +
+					"      vrf: {name: vrf100, table_id: 100}\n" +
+					"    - op: TRANSACTION_OP_CREATE\n" +
+					"      sid_function: {trigger_prefix: fc00:1::100/128, action: SRV6_LOCAL_ACTION_END_DT4, vrf_name: vrf100}\n\n" +
+					"Operations are applied VRFs and bridges first, then ESs, SIDs, headends, ACs,\n" +
+					"peers and the VLAN table; deletes in reverse. If one fails, the applied ones\n" +
+					"are rolled back.",
+				Flags: []cli.Flag{
+					&cli.StringFlag{Name: "file", Aliases: []string{"f"}, Required: true, Usage: "Operations file (- for stdin)"},
+					&cli.BoolFlag{Name: "dry-run", Usage: "Only validate the operations"},
+				},
+				Action: func(c *cli.Context) error {
+					req := &v1.TransactionCommitRequest{}
+					if err := readDocument(c.String("file"), req); err != nil {
+						return err
+					}
+					req.DryRun = c.Bool("dry-run")
+					clients := clientsFromContext(c)
+					resp, err := clients.Tx.TransactionCommit(context.Background(), connect.NewRequest(req))
+					if err != nil {
+						return err
+					}
+					if useJSON(c) {
+						return printJSON(resp.Msg)
+					}
+					for _, e := range resp.Msg.Errors {
+						fmt.Fprintf(os.Stderr, "Error [%s]: %s\n", e.TriggerPrefix, e.Reason)
+					}
+					for _, e := range resp.Msg.RollbackErrors {
+						fmt.Fprintf(os.Stderr, "Rollback error [%s]: %s\n", e.TriggerPrefix, e.Reason)
+					}
+					switch {
+					case resp.Msg.Applied:
+						fmt.Printf("Transaction applied: %d operation(s)\n", len(req.Operations))
+					case len(resp.Msg.Errors) == 0:
+						fmt.Printf("Transaction valid: %d operation(s) (dry run, nothing applied)\n", len(req.Operations))
+					default:
+						fmt.Println("Transaction not applied")
+					}
+					if n := len(resp.Msg.Errors) + len(resp.Msg.RollbackErrors); n > 0 {
+						return fmt.Errorf("%d error(s) occurred", n)
+					}
+					return nil
+				},
+			},
+		},
+	}
+}
@@ -18,27 +18,48 @@ func vlanTableCommand() *cli.Command {
 			{
 				Name:  "create",
 				Usage: "Create a VLAN cross-connect entry",
-				Flags: []cli.Flag{
-					&cli.UintFlag{Name: "table-id", Required: true, Usage: "VLAN table ID"},
-					&cli.UintFlag{Name: "vlan-id", Required: true, Usage: "VLAN ID (0-4095)"},
-					&cli.StringFlag{Name: "interface", Required: true, Usage: "Output interface name"},
-				},
+				Flags: vlanTableFlags(),
 				Action: func(c *cli.Context) error {
 					clients := clientsFromContext(c)
+					entry, err := vlanTableEntryFromFlags(c)
+					if err != nil {
+						return err
+					}
 					resp, err := clients.VlanTbl.VlanTableCreate(context.Background(),
-						connect.NewRequest(&v1.VlanTableCreateRequest{
-							Entries: []*v1.VlanTableEntry{{
-								TableId:       uint32(c.Uint("table-id")),
-								VlanId:        uint32(c.Uint("vlan-id")),
-								InterfaceName: c.String("interface"),
-							}},
-						}))
+						connect.NewRequest(&v1.VlanTableCreateRequest{Entries: []*v1.VlanTableEntry{entry}}))
 					if err != nil {
 						return err
 					}
 					return printOperationResult(resp.Msg.Created, resp.Msg.Errors, "VLAN table entry")
 				},
 			},
+			{
+				Name:  "update",
+				Usage: "Change a VLAN cross-connect entry in place; only the flags given are changed",
+				Flags: updateFlags(vlanTableFlags(), "table-id", "vlan-id"),
+				Action: func(c *cli.Context) error {
+					clients := clientsFromContext(c)
+					entry, err := vlanTableEntryFromFlags(c)
+					if err != nil {
+						return err
+					}
+					mask, err := updateMask(c, []string{"table-id", "vlan-id"}, map[string]string{
+						"interface":          "interface_name",
+						"rewrite":            "vlan_rewrite",
+						"rewrite-vlan":       "vlan_rewrite",
+						"rewrite-inner-vlan": "vlan_rewrite",
+					})
+					if err != nil {
+						return err
+					}
+					resp, err := clients.VlanTbl.VlanTableUpdate(context.Background(),
+						connect.NewRequest(&v1.VlanTableUpdateRequest{Entries: []*v1.VlanTableEntry{entry}, UpdateMask: mask}))
+					if err != nil {
+						return err
+					}
+					return printUpdateResult(resp.Msg.Updated, resp.Msg.Errors, "VLAN table entry")
+				},
+			},
 			{
 				Name:  "delete",
 				Usage: "Delete a VLAN cross-connect entry",
@@ -97,13 +118,14 @@ func vlanTableCommand() *cli.Command {
 					if useJSON(c) {
 						return printJSON(resp.Msg.Entries)
 					}
-					headers := []string{"TABLE_ID", "VLAN_ID", "INTERFACE"}
+					headers := []string{"TABLE_ID", "VLAN_ID", "INTERFACE", "REWRITE"}
 					var rows [][]string
 					for _, e := range resp.Msg.Entries {
 						rows = append(rows, []string{
 							fmt.Sprintf("%d", e.TableId),
 							fmt.Sprintf("%d", e.VlanId),
 							e.InterfaceName,
+							formatVlanRewrite(e.VlanRewrite),
 						})
 					}
 					printTable(headers, rows)
@@ -113,3 +135,25 @@ func vlanTableCommand() *cli.Command {
 		},
 	}
 }
+
+func vlanTableFlags() []cli.Flag {
+	return append([]cli.Flag{
+		&cli.UintFlag{Name: "table-id", Required: true, Usage: "VLAN table ID"},
+		&cli.UintFlag{Name: "vlan-id", Required: true, Usage: "VLAN ID (0-4095)"},
+		&cli.StringFlag{Name: "interface", Required: true, Usage: "Output interface name"},
+	}, vlanRewriteFlags("")...)
+}
+
+// vlanTableEntryFromFlags builds the VLAN table entry given by vlanTableFlags.
+func vlanTableEntryFromFlags(c *cli.Context) (*v1.VlanTableEntry, error) {
+	rewrite, err := resolveVlanRewrite(c, "")
+	if err != nil {
+		return nil, err
+	}
+	return &v1.VlanTableEntry{
+		TableId:       uint32(c.Uint("table-id")),
+		VlanId:        uint32(c.Uint("vlan-id")),
+		InterfaceName: c.String("interface"),
+		VlanRewrite:   rewrite,
+	}, nil
+}
@@ -0,0 +1,134 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	v1 "github.com/takehaya/vinbero/api/vinbero/v1"
+	"github.com/urfave/cli/v2"
+)
+
+func vniCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "vni",
+		Usage: "Manage VXLAN VNI to bridge domain mappings (VXLAN <-> SRv6 gateway)",
+		Subcommands: []*cli.Command{
+			{
+				Name:  "create",
+				Usage: "Map a VNI to a bridge domain (replaces the VTEPs of an existing mapping)",
+				Flags: []cli.Flag{
+					&cli.UintFlag{Name: "vni", Required: true, Usage: "VXLAN Network Identifier (1-16777215)"},
+					&cli.UintFlag{Name: "bd-id", Required: true, Usage: "Bridge Domain ID"},
+					&cli.StringFlag{Name: "local-vtep", Required: true, Usage: "Local VTEP address (IPv4 or IPv6)"},
+					&cli.StringSliceFlag{Name: "remote-vtep", Usage: "Remote VTEP address, receives the BD's BUM (repeatable)"},
+					&cli.StringFlag{Name: "bridge", Usage: "Bridge of the BD's local ACs, flooded with gateway BUM"},
+				},
+				Action: func(c *cli.Context) error {
+					clients := clientsFromContext(c)
+					resp, err := clients.Vni.VniMappingCreate(context.Background(),
+						connect.NewRequest(&v1.VniMappingCreateRequest{
+							Mappings: []*v1.VniMapping{{
+								Vni:         uint32(c.Uint("vni")),
+								BdId:        uint32(c.Uint("bd-id")),
+								LocalVtep:   c.String("local-vtep"),
+								RemoteVteps: c.StringSlice("remote-vtep"),
+								BridgeName:  c.String("bridge"),
+							}},
+						}))
+					if err != nil {
+						return err
+					}
+					return printOperationResult(resp.Msg.Created, resp.Msg.Errors, "VNI mapping")
+				},
+			},
+			{
+				Name:  "delete",
+				Usage: "Delete a VNI mapping by VNI or bridge domain",
+				Flags: []cli.Flag{
+					&cli.UintFlag{Name: "vni", Usage: "VXLAN Network Identifier"},
+					&cli.UintFlag{Name: "bd-id", Usage: "Bridge Domain ID"},
+				},
+				Action: func(c *cli.Context) error {
+					req := &v1.VniMappingDeleteRequest{}
+					if c.IsSet("vni") {
+						req.Vnis = []uint32{uint32(c.Uint("vni"))}
+					}
+					if c.IsSet("bd-id") {
+						req.BdIds = []uint32{uint32(c.Uint("bd-id"))}
+					}
+					if len(req.Vnis) == 0 && len(req.BdIds) == 0 {
+						return fmt.Errorf("--vni or --bd-id is required")
+					}
+					clients := clientsFromContext(c)
+					resp, err := clients.Vni.VniMappingDelete(context.Background(), connect.NewRequest(req))
+					if err != nil {
+						return err
+					}
+					return printOperationResult(resp.Msg.DeletedVnis, resp.Msg.Errors, "VNI mapping")
+				},
+			},
+			{
+				Name:  "flush",
+				Usage: "Delete every VNI mapping (requires --yes)",
+				Flags: []cli.Flag{
+					&cli.BoolFlag{Name: "yes", Required: true, Usage: "Confirm the destructive operation"},
+				},
+				Action: func(c *cli.Context) error {
+					clients := clientsFromContext(c)
+					resp, err := clients.Vni.VniMappingFlush(context.Background(),
+						connect.NewRequest(&v1.VniMappingFlushRequest{}))
+					if err != nil {
+						return err
+					}
+					fmt.Printf("Flushed %d VNI mappings\n", resp.Msg.DeletedCount)
+					return nil
+				},
+			},
+			{
+				Name:  "list",
+				Usage: "List VNI mappings",
+				Flags: []cli.Flag{
+					&cli.UintFlag{Name: "vni", Usage: "Filter by VNI (0 = all)"},
+					&cli.UintFlag{Name: "bd-id", Usage: "Filter by BD ID (0 = all)"},
+				},
+				Action: func(c *cli.Context) error {
+					clients := clientsFromContext(c)
+					resp, err := clients.Vni.VniMappingList(context.Background(),
+						connect.NewRequest(&v1.VniMappingListRequest{
+							Vni:  uint32(c.Uint("vni")),
+							BdId: uint32(c.Uint("bd-id")),
+						}))
+					if err != nil {
+						return err
+					}
+					if useJSON(c) {
+						return printJSON(resp.Msg.Mappings)
+					}
+					headers := []string{"VNI", "BD_ID", "LOCAL_VTEP", "REMOTE_VTEPS", "BRIDGE"}
+					var rows [][]string
+					for _, m := range resp.Msg.Mappings {
+						bridge := m.BridgeName
+						if bridge == "" {
+							bridge = "-"
+						}
+						remotes := strings.Join(m.RemoteVteps, ",")
+						if remotes == "" {
+							remotes = "-"
+						}
+						rows = append(rows, []string{
+							fmt.Sprintf("%d", m.Vni),
+							fmt.Sprintf("%d", m.BdId),
+							m.LocalVtep,
+							remotes,
+							bridge,
+						})
+					}
+					printTable(headers, rows)
+					return nil
+				},
+			},
+		},
+	}
+}
@@ -66,6 +66,26 @@ func resolveFlavor(input string) (v1.Srv6LocalFlavor, error) {
 	return resolveProtoEnum[v1.Srv6LocalFlavor](input, "SRV6_LOCAL_FLAVOR_", v1.Srv6LocalFlavor_value)
 }
 
+func resolveEncapTcMode(input string) (v1.Srv6EncapTcMode, error) {
+	return resolveProtoEnum[v1.Srv6EncapTcMode](input, "SRV6_ENCAP_TC_MODE_", v1.Srv6EncapTcMode_value)
+}
+
+func resolveEncapHopLimitMode(input string) (v1.Srv6EncapHopLimitMode, error) {
+	return resolveProtoEnum[v1.Srv6EncapHopLimitMode](input, "SRV6_ENCAP_HOP_LIMIT_MODE_", v1.Srv6EncapHopLimitMode_value)
+}
+
+func resolveEncapFlowLabelMode(input string) (v1.Srv6EncapFlowLabelMode, error) {
+	return resolveProtoEnum[v1.Srv6EncapFlowLabelMode](input, "SRV6_ENCAP_FLOW_LABEL_MODE_", v1.Srv6EncapFlowLabelMode_value)
+}
+
+func resolveMacLimitAction(input string) (v1.MacLimitAction, error) {
+	return resolveProtoEnum[v1.MacLimitAction](input, "MAC_LIMIT_ACTION_", v1.MacLimitAction_value)
+}
+
+func resolveVlanRewriteOp(input string) (v1.VlanRewriteOp, error) {
+	return resolveProtoEnum[v1.VlanRewriteOp](input, "VLAN_REWRITE_OP_", v1.VlanRewriteOp_value)
+}
+
 func formatAction(action v1.Srv6LocalAction) string {
 	return formatProtoEnum(action, "SRV6_LOCAL_ACTION_")
 }
@@ -77,3 +97,51 @@ func formatMode(mode v1.Srv6HeadendBehavior) string {
 func formatFlavor(flavor v1.Srv6LocalFlavor) string {
 	return formatProtoEnum(flavor, "SRV6_LOCAL_FLAVOR_")
 }
+
+func formatMacLimitAction(action v1.MacLimitAction) string {
+	return formatProtoEnum(action, "MAC_LIMIT_ACTION_")
+}
+
+func formatNeighborOrigin(origin v1.NeighborOrigin) string {
+	return formatProtoEnum(origin, "NEIGHBOR_ORIGIN_")
+}
+
+// formatStormRate shows a storm control rate, 0 meaning no limit
+func formatStormRate(rate uint64) string {
+	if rate == 0 {
+		return "unlimited"
+	}
+	return strconv.FormatUint(rate, 10)
+}
+
+// formatVlanRewrite renders a VLAN rewrite as "pop", "push 10" or
+// "translate 300.30". Returns "" when there is none.
+func formatVlanRewrite(rw *v1.VlanRewrite) string {
+	if rw == nil {
+		return ""
+	}
+	op := strings.ToLower(formatProtoEnum(rw.Op, "VLAN_REWRITE_OP_"))
+	switch {
+	case op == "" || rw.Op == v1.VlanRewriteOp_VLAN_REWRITE_OP_POP:
+		return op
+	case rw.InnerVlanId != 0:
+		return fmt.Sprintf("%s %d.%d", op, rw.VlanId, rw.InnerVlanId)
+	default:
+		return fmt.Sprintf("%s %d", op, rw.VlanId)
+	}
+}
+
+// formatAcVlan renders the VLAN match of an AC: "100", "100.10", "100-199"
+// or "port".
+func formatAcVlan(vlanID, innerVlanID, vlanIDEnd uint32, portMode bool) string {
+	switch {
+	case portMode:
+		return "port"
+	case innerVlanID != 0:
+		return fmt.Sprintf("%d.%d", vlanID, innerVlanID)
+	case vlanIDEnd != 0:
+		return fmt.Sprintf("%d-%d", vlanID, vlanIDEnd)
+	default:
+		return fmt.Sprintf("%d", vlanID)
+	}
+}
@@ -174,3 +174,48 @@ func TestFormatMode(t *testing.T) {
 		})
 	}
 }
+
+func TestFormatVlanRewrite(t *testing.T) {
+	tests := []struct {
+		input    *v1.VlanRewrite
+		expected string
+	}{
+		{nil, ""},
+		{&v1.VlanRewrite{}, ""},
+		{&v1.VlanRewrite{Op: v1.VlanRewriteOp_VLAN_REWRITE_OP_POP}, "pop"},
+		{&v1.VlanRewrite{Op: v1.VlanRewriteOp_VLAN_REWRITE_OP_PUSH, VlanId: 10}, "push 10"},
+		{&v1.VlanRewrite{Op: v1.VlanRewriteOp_VLAN_REWRITE_OP_TRANSLATE, VlanId: 300, InnerVlanId: 30}, "translate 300.30"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			got := formatVlanRewrite(tt.input)
+			if got != tt.expected {
+				t.Errorf("formatVlanRewrite(%v) = %q, want %q", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFormatAcVlan(t *testing.T) {
+	tests := []struct {
+		vlan, inner, end uint32
+		portMode         bool
+		expected         string
+	}{
+		{0, 0, 0, false, "0"},
+		{100, 0, 0, false, "100"},
+		{100, 10, 0, false, "100.10"},
+		{100, 0, 199, false, "100-199"},
+		{0, 0, 0, true, "port"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			got := formatAcVlan(tt.vlan, tt.inner, tt.end, tt.portMode)
+			if got != tt.expected {
+				t.Errorf("formatAcVlan(%d, %d, %d) = %q, want %q", tt.vlan, tt.inner, tt.end, got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	v1 "github.com/takehaya/vinbero/api/vinbero/v1"
+	"github.com/urfave/cli/v2"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// updateFlags turns the flags of a create command into those of its
+// update command: only the flags in keys, which pick the installed entry,
+// stay required.
+func updateFlags(flags []cli.Flag, keys ...string) []cli.Flag {
+	for _, f := range flags {
+		required := slices.Contains(keys, f.Names()[0])
+		switch f := f.(type) {
+		case *cli.StringFlag:
+			f.Required = f.Required && required
+		case *cli.UintFlag:
+			f.Required = f.Required && required
+		case *cli.BoolFlag:
+			f.Required = f.Required && required
+		}
+	}
+	return flags
+}
+
+// updateMask names the fields an update command changes: one per flag
+// given on the command line, except the key flags. A flag maps to the
+// field of the same name with "-" turned into "_", unless fields renames
+// it; several flags may share a field.
+func updateMask(c *cli.Context, keys []string, fields map[string]string) (*fieldmaskpb.FieldMask, error) {
+	mask := &fieldmaskpb.FieldMask{}
+	for _, f := range c.Command.Flags {
+		name := f.Names()[0]
+		if slices.Contains(keys, name) || !c.IsSet(name) {
+			continue
+		}
+		field, ok := fields[name]
+		if !ok {
+			field = strings.ReplaceAll(name, "-", "_")
+		}
+		if !slices.Contains(mask.Paths, field) {
+			mask.Paths = append(mask.Paths, field)
+		}
+	}
+	if len(mask.Paths) == 0 {
+		return nil, fmt.Errorf("nothing to update: give at least one field besides --%s", strings.Join(keys, ", --"))
+	}
+	return mask, nil
+}
+
+func printUpdateResult[T any](updated []T, errors []*v1.OperationError, resourceName string) error {
+	if len(updated) > 0 {
+		fmt.Printf("%s updated: %d\n", resourceName, len(updated))
+	}
+	for _, e := range errors {
+		fmt.Fprintf(os.Stderr, "Error [%s]: %s\n", e.TriggerPrefix, e.Reason)
+	}
+	if len(errors) > 0 {
+		return fmt.Errorf("%d error(s) occurred", len(errors))
+	}
+	return nil
+}
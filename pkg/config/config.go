@@ -11,6 +11,7 @@ import (
 type Config struct {
 	InternalConfig InternalConfig `yaml:"internal,omitempty"`
 	Setting        SettingConfig  `yaml:"settings,omitempty"`
+	Static         StaticConfig   `yaml:"config,omitempty"`
 	Original       string
 	Configpath     string
 }
@@ -23,7 +24,18 @@ func (c *Config) BpfConstants() map[string]any {
 	if c.Setting.EnableStats {
 		v = 1
 	}
-	return map[string]any{"enable_stats": v}
+	agingNs := uint64(0)
+	if c.Setting.FdbAgingSeconds > 0 {
+		agingNs = uint64(c.Setting.FdbAgingSeconds) * 1e9
+	}
+	consts := map[string]any{
+		"enable_stats": v,
+		"fdb_aging_ns": agingNs,
+	}
+	if n := c.Setting.Entries.BdPeer.PerBd; n > 0 {
+		consts["bum_peers_per_bd"] = uint32(n)
+	}
+	return consts
 }
 
 type SettingConfig struct {
@@ -32,14 +44,48 @@ type SettingConfig struct {
 	StatePath       string         `yaml:"state_path,omitempty"`                      // Path for resource state file (default: /var/lib/vinbero/state.json)
 	FdbAgingSeconds int            `yaml:"fdb_aging_seconds,omitempty" default:"300"` // FDB entry aging timeout (0=disabled)
 	PinMaps         PinMapsConfig  `yaml:"pin_maps,omitempty"`                        // Pin control-state BPF maps under /sys/fs/bpf so they survive a vinberod restart.
+	Store           StoreConfig    `yaml:"store,omitempty"`                           // Journal accepted mutating RPCs and replay them at startup.
+	Upgrade         UpgradeConfig  `yaml:"upgrade,omitempty"`                         // Where vinberod --detach-on-exit=false leaves its data plane for the next daemon.
+	MapUsage        MapUsageConfig `yaml:"map_usage,omitempty"`                       // How often map fill levels are sampled and when they warn.
+}
+
+// MapUsageConfig sets the sampling of control-map fill levels. A map
+// whose entries reach WarnPercent of its capacity (or its own entry in
+// Thresholds) is logged and recorded as a map event once, and again when
+// it becomes full or drops back below. Maps only userspace writes are
+// checked on every change; IntervalSeconds is how often fdb_map, which the
+// data plane learns into, is sampled from its counters (0: only on
+// MapUsage calls). WarnPercent 0 disables the warnings.
+type MapUsageConfig struct {
+	IntervalSeconds int            `yaml:"interval_seconds,omitempty" default:"30"`
+	WarnPercent     int            `yaml:"warn_percent,omitempty" default:"80"`
+	Thresholds      map[string]int `yaml:"thresholds,omitempty"` // Per-map warn_percent by map name, e.g. fdb_map: 90
+}
+
+// UpgradeConfig says where a daemon run with --detach-on-exit=false hands
+// its data plane to the next one. The XDP/TCX links and PROG_ARRAYs are
+// pinned under LinkPath; registered plugins are written to HandoverPath,
+// since the next daemon's PROG_ARRAYs start empty.
+type UpgradeConfig struct {
+	LinkPath     string `yaml:"link_path,omitempty" default:"/sys/fs/bpf/vinbero/links"`
+	HandoverPath string `yaml:"handover_path,omitempty" default:"/var/lib/vinbero/handover.json"`
+}
+
+// StoreConfig enables the on-disk journal of accepted mutating RPCs. At
+// startup the journal is replayed into the maps unless they were reused
+// from bpffs pins (plugins are replayed either way), so it is the source
+// of truth when pin_maps is off.
+type StoreConfig struct {
+	Enabled bool   `yaml:"enabled,omitempty" default:"false"`
+	Path    string `yaml:"path,omitempty" default:"/var/lib/vinbero/store.jsonl"`
 }
 
 // PinMapsConfig toggles pinning for the daemon's control-state BPF maps
 // (sid_function_map, sid_aux_map, headend_*_map, fdb_map, bd_peer_map,
-// bd_peer_reverse_map, dx2v_map). Ephemeral maps (stats, slot_stats,
-// scratch, tailcall_ctx, PROG_ARRAY) are never pinned: their values
-// either reset naturally at restart or hold program FDs that can't
-// survive a new process.
+// bd_peer_reverse_map, dx2v_map, sr_domain_*_map, stamp_reflector_map).
+// Ephemeral maps (stats, slot_stats, scratch, tailcall_ctx, PROG_ARRAY) are
+// never pinned: their values either reset naturally at restart or hold
+// program FDs that can't survive a new process.
 type PinMapsConfig struct {
 	Enabled bool   `yaml:"enabled,omitempty" default:"false"`
 	Path    string `yaml:"path,omitempty" default:"/sys/fs/bpf/vinbero"`
@@ -47,14 +93,15 @@ type PinMapsConfig struct {
 
 // EntriesConfig holds the capacity settings for each entry type
 type EntriesConfig struct {
-	SidFunction EntryCapacityConfig `yaml:"sid_function,omitempty"`
-	Headendv4   EntryCapacityConfig `yaml:"headendv4,omitempty"`
-	Headendv6   EntryCapacityConfig `yaml:"headendv6,omitempty"`
-	HeadendL2   EntryCapacityConfig `yaml:"headend_l2,omitempty"`
-	Fdb         EntryCapacityConfig `yaml:"fdb,omitempty"`
-	BdPeer      EntryCapacityConfig `yaml:"bd_peer,omitempty"`
-	VlanTable   EntryCapacityConfig `yaml:"vlan_table,omitempty"`
-	MaxSegments int                 `yaml:"max_segments,omitempty" default:"10"`
+	SidFunction EntryCapacityConfig  `yaml:"sid_function,omitempty"`
+	Headendv4   EntryCapacityConfig  `yaml:"headendv4,omitempty"`
+	Headendv6   EntryCapacityConfig  `yaml:"headendv6,omitempty"`
+	HeadendL2   EntryCapacityConfig  `yaml:"headend_l2,omitempty"`
+	Fdb         EntryCapacityConfig  `yaml:"fdb,omitempty"`
+	BdPeer      BdPeerCapacityConfig `yaml:"bd_peer,omitempty"`
+	VlanTable   EntryCapacityConfig  `yaml:"vlan_table,omitempty"`
+	SrDomain    EntryCapacityConfig  `yaml:"sr_domain,omitempty"`
+	MaxSegments int                  `yaml:"max_segments,omitempty" default:"10"`
 }
 
 // EntryCapacityConfig holds capacity setting for a single entry type
@@ -62,6 +109,14 @@ type EntryCapacityConfig struct {
 	Capacity int `yaml:"capacity,omitempty" default:"1024"`
 }
 
+// BdPeerCapacityConfig sizes the BD peer tables. Capacity is the total
+// across all BDs; PerBd caps the remote PEs of a single BD, i.e. the BUM
+// replication fan-out.
+type BdPeerCapacityConfig struct {
+	Capacity int `yaml:"capacity,omitempty" default:"1024"`
+	PerBd    int `yaml:"per_bd,omitempty" default:"256"`
+}
+
 // LoadFile parses the given YAML file into a Config.
 func LoadFile(filename string) (*Config, error) {
 	content, err := os.ReadFile(filename)
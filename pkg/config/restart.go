@@ -0,0 +1,37 @@
+package config
+
+import (
+	"reflect"
+	"strings"
+)
+
+// RestartRequired lists the keys outside the config: section that differ
+// between the running and the re-read configuration, as dotted YAML paths
+// (e.g. "internal.devices", "settings.entries.fdb.capacity"). They are
+// fixed when vinberod loads the BPF objects, so a reload can't apply them.
+func RestartRequired(running, next *Config) []string {
+	var keys []string
+	keys = diffYAMLPaths("internal", reflect.ValueOf(running.InternalConfig), reflect.ValueOf(next.InternalConfig), keys)
+	keys = diffYAMLPaths("settings", reflect.ValueOf(running.Setting), reflect.ValueOf(next.Setting), keys)
+	return keys
+}
+
+// diffYAMLPaths appends the paths of the leaves that differ between a and
+// b. Structs are walked by their yaml tags; anything else is a leaf.
+func diffYAMLPaths(path string, a, b reflect.Value, keys []string) []string {
+	if a.Kind() != reflect.Struct {
+		if !reflect.DeepEqual(a.Interface(), b.Interface()) {
+			keys = append(keys, path)
+		}
+		return keys
+	}
+	t := a.Type()
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("yaml"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+		keys = diffYAMLPaths(path+"."+name, a.Field(i), b.Field(i), keys)
+	}
+	return keys
+}
@@ -0,0 +1,60 @@
+package config
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestRestartRequired(t *testing.T) {
+	running, err := Load(`
+internal:
+  devices: [eth0]
+settings:
+  entries:
+    fdb:
+      capacity: 1024
+config:
+  vrfs:
+    - name: vrf100
+      table_id: 100
+`)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+
+	same, err := Load(`
+internal:
+  devices: [eth0]
+settings:
+  entries:
+    fdb:
+      capacity: 1024
+config:
+  vrfs:
+    - name: vrf200
+      table_id: 200
+`)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if keys := RestartRequired(running, same); len(keys) != 0 {
+		t.Errorf("config: change only: got %v, want none", keys)
+	}
+
+	next, err := Load(`
+internal:
+  devices: [eth0, eth1]
+settings:
+  entries:
+    fdb:
+      capacity: 4096
+  enable_stats: true
+`)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	want := []string{"internal.devices", "settings.entries.fdb.capacity", "settings.enable_stats"}
+	if keys := RestartRequired(running, next); !slices.Equal(keys, want) {
+		t.Errorf("got %v, want %v", keys, want)
+	}
+}
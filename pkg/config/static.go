@@ -0,0 +1,92 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+
+	v1 "github.com/takehaya/vinbero/api/vinbero/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/yaml.v3"
+)
+
+// StaticConfig is the declarative control state of the `config:` section,
+// installed by vinberod at startup. Each list mirrors the protobuf message
+// of its Create RPC: keys are the proto field names and enums take their
+// proto names, as in `vinbero bd create --file`.
+type StaticConfig struct {
+	Vrfs             []*v1.Vrf             `yaml:"vrfs,omitempty"`
+	Bridges          []*v1.Bridge          `yaml:"bridges,omitempty"`
+	EthernetSegments []*v1.EthernetSegment `yaml:"ethernet_segments,omitempty"`
+	SidFunctions     []*v1.SidFunction     `yaml:"sid_functions,omitempty"`
+	Headendv4s       []*v1.Headendv4       `yaml:"headendv4s,omitempty"`
+	Headendv6s       []*v1.Headendv6       `yaml:"headendv6s,omitempty"`
+	HeadendL2s       []*v1.HeadendL2       `yaml:"headend_l2s,omitempty"`
+	BdPeers          []*v1.BdPeer          `yaml:"bd_peers,omitempty"`
+	VlanTable        []*v1.VlanTableEntry  `yaml:"vlan_table,omitempty"`
+}
+
+// IsEmpty reports whether the section declares nothing.
+func (s *StaticConfig) IsEmpty() bool {
+	return len(s.Vrfs) == 0 && len(s.Bridges) == 0 && len(s.EthernetSegments) == 0 &&
+		len(s.SidFunctions) == 0 && len(s.Headendv4s) == 0 && len(s.Headendv6s) == 0 &&
+		len(s.HeadendL2s) == 0 && len(s.BdPeers) == 0 && len(s.VlanTable) == 0
+}
+
+// UnmarshalYAML decodes each entry through protojson so the section
+// accepts exactly what the API does. Unknown sections and fields are
+// errors, reported with the entry's position.
+func (s *StaticConfig) UnmarshalYAML(node *yaml.Node) error {
+	var raw map[string][]any
+	if err := node.Decode(&raw); err != nil {
+		return fmt.Errorf("config: %w", err)
+	}
+	for key, items := range raw {
+		var err error
+		switch key {
+		case "vrfs":
+			s.Vrfs, err = decodeEntries[v1.Vrf](key, items)
+		case "bridges":
+			s.Bridges, err = decodeEntries[v1.Bridge](key, items)
+		case "ethernet_segments":
+			s.EthernetSegments, err = decodeEntries[v1.EthernetSegment](key, items)
+		case "sid_functions":
+			s.SidFunctions, err = decodeEntries[v1.SidFunction](key, items)
+		case "headendv4s":
+			s.Headendv4s, err = decodeEntries[v1.Headendv4](key, items)
+		case "headendv6s":
+			s.Headendv6s, err = decodeEntries[v1.Headendv6](key, items)
+		case "headend_l2s":
+			s.HeadendL2s, err = decodeEntries[v1.HeadendL2](key, items)
+		case "bd_peers":
+			s.BdPeers, err = decodeEntries[v1.BdPeer](key, items)
+		case "vlan_table":
+			s.VlanTable, err = decodeEntries[v1.VlanTableEntry](key, items)
+		default:
+			err = fmt.Errorf("config: unknown section %q", key)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func decodeEntries[T any, P interface {
+	*T
+	proto.Message
+}](key string, items []any) ([]P, error) {
+	out := make([]P, 0, len(items))
+	for i, item := range items {
+		data, err := json.Marshal(item)
+		if err != nil {
+			return nil, fmt.Errorf("config.%s[%d]: %w", key, i, err)
+		}
+		msg := P(new(T))
+		if err := protojson.Unmarshal(data, msg); err != nil {
+			return nil, fmt.Errorf("config.%s[%d]: %w", key, i, err)
+		}
+		out = append(out, msg)
+	}
+	return out, nil
+}
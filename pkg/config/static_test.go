@@ -0,0 +1,80 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	v1 "github.com/takehaya/vinbero/api/vinbero/v1"
+)
+
+func TestLoadStaticConfig(t *testing.T) {
+	cfg, err := Load(`
+settings:
+  enable_stats: true
+config:
+  vrfs:
+    - name: vrf100
+      table_id: 100
+      members: [eth2]
+  sid_functions:
+    - trigger_prefix: "fc00:1::100/128"
+      action: SRV6_LOCAL_ACTION_END_DT4
+      vrf_name: vrf100
+    - triggerPrefix: "fc00:1::1/128"
+      action: 1
+  headend_l2s:
+    - interface_name: eth1
+      vlan_id: 100
+      src_addr: "fc00:1::1"
+      segments: ["fc00:2::10"]
+      bd_id: 100
+      egress_vlan_rewrite:
+        op: VLAN_REWRITE_OP_TRANSLATE
+        vlan_id: 200
+`)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if !cfg.Setting.EnableStats {
+		t.Error("settings lost next to config:")
+	}
+	sc := cfg.Static
+	if sc.IsEmpty() {
+		t.Fatal("static config is empty")
+	}
+	if len(sc.Vrfs) != 1 || sc.Vrfs[0].Name != "vrf100" || sc.Vrfs[0].TableId != 100 {
+		t.Errorf("vrfs: got %v", sc.Vrfs)
+	}
+	if len(sc.SidFunctions) != 2 {
+		t.Fatalf("sid_functions: got %d entries, want 2", len(sc.SidFunctions))
+	}
+	if sc.SidFunctions[0].Action != v1.Srv6LocalAction_SRV6_LOCAL_ACTION_END_DT4 {
+		t.Errorf("enum by name: got %v", sc.SidFunctions[0].Action)
+	}
+	if sc.SidFunctions[1].TriggerPrefix != "fc00:1::1/128" || sc.SidFunctions[1].Action != v1.Srv6LocalAction_SRV6_LOCAL_ACTION_END {
+		t.Errorf("json name and enum by number: got %v", sc.SidFunctions[1])
+	}
+	if len(sc.HeadendL2s) != 1 || sc.HeadendL2s[0].EgressVlanRewrite.GetVlanId() != 200 {
+		t.Errorf("nested message: got %v", sc.HeadendL2s)
+	}
+}
+
+func TestLoadStaticConfigErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		yaml string
+		want string
+	}{
+		{"unknown section", "config:\n  sids: []\n", `unknown section "sids"`},
+		{"unknown field", "config:\n  bd_peers:\n    - bd_id: 1\n    - bdid: 2\n", "config.bd_peers[1]"},
+		{"bad enum", "config:\n  sid_functions:\n    - action: END_FOO\n", "config.sid_functions[0]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Load(tt.yaml)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("got %v, want error containing %q", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package evpn
+
+import (
+	"fmt"
+	"net"
+)
+
+// IrbHostRouteTable holds the per-VRF host routes of symmetric IRB.
+// bpf.MapOperations implements it, resolving the VRF from the BD.
+type IrbHostRouteTable interface {
+	// SetIrbHostRoute routes ip, a host in bdID behind a remote PE, with
+	// H.Encaps over segments. It is a no-op for a BD without IRB.
+	SetIrbHostRoute(bdID uint16, ip net.IP, srcAddr [IPv6Length]byte, segments [][IPv6Length]byte) error
+	// DeleteIrbHostRoute removes a route installed by SetIrbHostRoute.
+	DeleteIrbHostRoute(bdID uint16, ip net.IP) error
+}
+
+// NewIrbHandler returns a Handler that installs a host route for the IP of
+// every RT2 MAC+IP route carrying an L3 service SID, so traffic a local
+// host sends to the anycast gateway reaches the remote host directly with
+// End.DT4/DT6 (symmetric IRB). Other routes are ignored.
+func NewIrbHandler(table IrbHostRouteTable) Handler {
+	return irbHandler{table: table}
+}
+
+type irbHandler struct {
+	table IrbHostRouteTable
+}
+
+func (h irbHandler) ApplyRoute(r Route) error {
+	adv, ip, ok := macIPBinding(r)
+	if !ok || len(adv.L3SegmentList) == 0 {
+		return nil
+	}
+	if err := h.table.SetIrbHostRoute(adv.BDID, ip, adv.PESrcAddr, adv.L3SegmentList); err != nil {
+		return fmt.Errorf("RT2 bd %d %s host route: %w", adv.BDID, ip, err)
+	}
+	return nil
+}
+
+func (h irbHandler) WithdrawRoute(r Route) error {
+	adv, ip, ok := macIPBinding(r)
+	if !ok || len(adv.L3SegmentList) == 0 {
+		return nil
+	}
+	if err := h.table.DeleteIrbHostRoute(adv.BDID, ip); err != nil {
+		return fmt.Errorf("RT2 bd %d %s host route: %w", adv.BDID, ip, err)
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package evpn
+
+import (
+	"net"
+	"testing"
+)
+
+type fakeHostRoute struct {
+	bdID     uint16
+	segments int
+}
+
+type fakeIrbTable map[string]fakeHostRoute
+
+func (f fakeIrbTable) SetIrbHostRoute(bdID uint16, ip net.IP, srcAddr [IPv6Length]byte, segments [][IPv6Length]byte) error {
+	f[ip.String()] = fakeHostRoute{bdID: bdID, segments: len(segments)}
+	return nil
+}
+
+func (f fakeIrbTable) DeleteIrbHostRoute(bdID uint16, ip net.IP) error {
+	delete(f, ip.String())
+	return nil
+}
+
+func rt2L3(ip string) Route {
+	r := rt2(ip)
+	adv := r.Payload.(MACIPAdvertisement)
+	adv.L3SegmentList = [][IPv6Length]byte{{0xfc, 0x00, 0x00, 0x02, 15: 0x04}}
+	r.Payload = adv
+	return r
+}
+
+func TestIrbHandler(t *testing.T) {
+	table := fakeIrbTable{}
+	h := NewIrbHandler(table)
+
+	for _, ip := range []string{"192.0.2.10", "2001:db8::10"} {
+		if err := h.ApplyRoute(rt2L3(ip)); err != nil {
+			t.Fatalf("apply %s: %v", ip, err)
+		}
+		got, ok := table[ip]
+		if !ok {
+			t.Fatalf("%s: no host route", ip)
+		}
+		if got.bdID != 100 || got.segments != 1 {
+			t.Errorf("%s: got %+v", ip, got)
+		}
+	}
+
+	if err := h.WithdrawRoute(rt2L3("192.0.2.10")); err != nil {
+		t.Fatalf("withdraw: %v", err)
+	}
+	if _, ok := table["192.0.2.10"]; ok {
+		t.Error("192.0.2.10 still routed after withdraw")
+	}
+	if _, ok := table["2001:db8::10"]; !ok {
+		t.Error("2001:db8::10 removed by an unrelated withdraw")
+	}
+}
+
+func TestIrbHandlerIgnores(t *testing.T) {
+	table := fakeIrbTable{}
+	h := NewIrbHandler(table)
+
+	routes := []Route{
+		rt2("192.0.2.10"), // no L3 SID: asymmetric or L2 only
+		rt2L3(""),         // MAC only
+		{Type: RouteTypeInclusiveMulticast, Payload: InclusiveMulticast{BDID: 100}},
+	}
+	for _, r := range routes {
+		if err := h.ApplyRoute(r); err != nil {
+			t.Errorf("apply %v: %v", r.Type, err)
+		}
+	}
+	if len(table) != 0 {
+		t.Errorf("expected no host routes, got %v", table)
+	}
+}
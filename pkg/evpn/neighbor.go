@@ -0,0 +1,67 @@
+package evpn
+
+import (
+	"fmt"
+	"net"
+)
+
+// neighborOriginEVPN mirrors NEIGH_ORIGIN_EVPN (enum neigh_origin in
+// src/core/xdp_prog.h), kept local for the same reason as the lengths in
+// types.go.
+const neighborOriginEVPN uint8 = 1
+
+// NeighborTable is the per-BD IP-to-MAC table behind ARP/ND suppression.
+// bpf.MapOperations implements it.
+type NeighborTable interface {
+	// LearnNeighbor installs a binding unless a static one exists.
+	LearnNeighbor(bdID uint16, ip net.IP, mac net.HardwareAddr, origin uint8) error
+	// WithdrawNeighbor removes a binding only if it still has origin.
+	WithdrawNeighbor(bdID uint16, ip net.IP, origin uint8) error
+}
+
+// NewNeighborHandler returns a Handler that reflects the MAC+IP of RT2
+// routes into table, so remote hosts are answered locally instead of
+// flooding ARP requests and Neighbor Solicitations to every PE. Other route
+// types and MAC-only RT2 routes are ignored.
+func NewNeighborHandler(table NeighborTable) Handler {
+	return neighborHandler{table: table}
+}
+
+type neighborHandler struct {
+	table NeighborTable
+}
+
+func (h neighborHandler) ApplyRoute(r Route) error {
+	adv, ip, ok := macIPBinding(r)
+	if !ok {
+		return nil
+	}
+	if err := h.table.LearnNeighbor(adv.BDID, ip, net.HardwareAddr(adv.MAC[:]), neighborOriginEVPN); err != nil {
+		return fmt.Errorf("RT2 bd %d %s: %w", adv.BDID, ip, err)
+	}
+	return nil
+}
+
+func (h neighborHandler) WithdrawRoute(r Route) error {
+	adv, ip, ok := macIPBinding(r)
+	if !ok {
+		return nil
+	}
+	if err := h.table.WithdrawNeighbor(adv.BDID, ip, neighborOriginEVPN); err != nil {
+		return fmt.Errorf("RT2 bd %d %s: %w", adv.BDID, ip, err)
+	}
+	return nil
+}
+
+// macIPBinding extracts the IP-to-MAC binding of an RT2 route. IPv4 is
+// carried v4-mapped in IPAddr; the all-zero address means MAC only.
+func macIPBinding(r Route) (MACIPAdvertisement, net.IP, bool) {
+	if r.Type != RouteTypeMACIPAdvertisement {
+		return MACIPAdvertisement{}, nil, false
+	}
+	adv, ok := r.Payload.(MACIPAdvertisement)
+	if !ok || adv.IPAddr == ([IPv6Length]byte{}) || adv.BDID == 0 {
+		return MACIPAdvertisement{}, nil, false
+	}
+	return adv, net.IP(append([]byte(nil), adv.IPAddr[:]...)), true
+}
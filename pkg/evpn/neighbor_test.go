@@ -0,0 +1,80 @@
+package evpn
+
+import (
+	"net"
+	"testing"
+)
+
+type fakeNeighbor struct {
+	mac    string
+	origin uint8
+}
+
+type fakeNeighborTable map[string]fakeNeighbor
+
+func (f fakeNeighborTable) LearnNeighbor(bdID uint16, ip net.IP, mac net.HardwareAddr, origin uint8) error {
+	f[ip.String()] = fakeNeighbor{mac: mac.String(), origin: origin}
+	return nil
+}
+
+func (f fakeNeighborTable) WithdrawNeighbor(bdID uint16, ip net.IP, origin uint8) error {
+	if f[ip.String()].origin == origin {
+		delete(f, ip.String())
+	}
+	return nil
+}
+
+func rt2(ip string) Route {
+	adv := MACIPAdvertisement{MAC: [MACLength]byte{0x02, 0, 0, 0, 0, 0x10}, BDID: 100}
+	if ip != "" {
+		copy(adv.IPAddr[:], net.ParseIP(ip).To16())
+	}
+	return Route{Type: RouteTypeMACIPAdvertisement, Payload: adv}
+}
+
+func TestNeighborHandler(t *testing.T) {
+	table := fakeNeighborTable{}
+	h := NewNeighborHandler(table)
+
+	for _, ip := range []string{"192.0.2.10", "2001:db8::10"} {
+		if err := h.ApplyRoute(rt2(ip)); err != nil {
+			t.Fatalf("apply %s: %v", ip, err)
+		}
+		got, ok := table[ip]
+		if !ok {
+			t.Fatalf("%s not installed", ip)
+		}
+		if got.mac != "02:00:00:00:00:10" || got.origin != neighborOriginEVPN {
+			t.Errorf("%s: got %+v", ip, got)
+		}
+	}
+
+	if err := h.WithdrawRoute(rt2("192.0.2.10")); err != nil {
+		t.Fatalf("withdraw: %v", err)
+	}
+	if _, ok := table["192.0.2.10"]; ok {
+		t.Error("192.0.2.10 still installed after withdraw")
+	}
+	if _, ok := table["2001:db8::10"]; !ok {
+		t.Error("2001:db8::10 removed by an unrelated withdraw")
+	}
+}
+
+func TestNeighborHandlerIgnores(t *testing.T) {
+	table := fakeNeighborTable{}
+	h := NewNeighborHandler(table)
+
+	routes := []Route{
+		rt2(""), // MAC only
+		{Type: RouteTypeInclusiveMulticast, Payload: InclusiveMulticast{BDID: 100}},
+		{Type: RouteTypeMACIPAdvertisement, Payload: EthernetSegment{}},
+	}
+	for _, r := range routes {
+		if err := h.ApplyRoute(r); err != nil {
+			t.Errorf("apply %v: %v", r.Type, err)
+		}
+	}
+	if len(table) != 0 {
+		t.Errorf("expected no neighbors, got %v", table)
+	}
+}
@@ -47,6 +47,9 @@ type MACIPAdvertisement struct {
 	BDID        uint16
 	PESrcAddr   [IPv6Length]byte
 	SegmentList [][IPv6Length]byte
+	// L3SegmentList ends with the IP-VRF's End.DT4/DT6 SID of a symmetric
+	// IRB route (RFC 9135 / RFC 9252); empty for L2-only routes.
+	L3SegmentList [][IPv6Length]byte
 }
 
 // InclusiveMulticast is the RT3 payload: this peer is a BUM endpoint for BDID.
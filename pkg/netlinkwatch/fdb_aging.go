@@ -0,0 +1,68 @@
+package netlinkwatch
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/cilium/ebpf/ringbuf"
+	"github.com/takehaya/vinbero/pkg/bpf"
+	"go.uber.org/zap"
+)
+
+// fdbAgedEventLen is sizeof(struct fdb_aged_event).
+const fdbAgedEventLen = 40
+
+// FdbAgedEvent is one dynamic FDB entry removed by its data-plane aging timer.
+type FdbAgedEvent struct {
+	BdID      uint16
+	MAC       net.HardwareAddr
+	IsRemote  bool
+	Oif       uint32 // Local entries only
+	PeerIndex uint16 // Remote entries only
+	ESI       [bpf.ESILen]byte
+	LastSeen  uint64 // bpf_ktime_get_ns() of the last refresh
+}
+
+// decodeFdbAgedEvent parses one fdb_aging_events record.
+func decodeFdbAgedEvent(raw []byte) (FdbAgedEvent, error) {
+	if len(raw) < fdbAgedEventLen {
+		return FdbAgedEvent{}, fmt.Errorf("record too short: %d bytes", len(raw))
+	}
+	ev := FdbAgedEvent{
+		LastSeen:  binary.NativeEndian.Uint64(raw[0:8]),
+		Oif:       binary.NativeEndian.Uint32(raw[8:12]),
+		BdID:      binary.NativeEndian.Uint16(raw[12:14]),
+		PeerIndex: binary.NativeEndian.Uint16(raw[14:16]),
+		MAC:       net.HardwareAddr(append([]byte(nil), raw[16:22]...)),
+		IsRemote:  raw[22] != 0,
+	}
+	copy(ev.ESI[:], raw[24:24+bpf.ESILen])
+	return ev, nil
+}
+
+// runAgingEvents drains fdb_aging_events until the reader is closed and
+// logs every event. The data plane keeps an entry it could not report, so
+// the ring buffer must be drained even with nothing else to notify.
+func (w *FDBWatcher) runAgingEvents(rd *ringbuf.Reader) {
+	for {
+		rec, err := rd.Read()
+		if err != nil {
+			if errors.Is(err, ringbuf.ErrClosed) {
+				return
+			}
+			w.logger.Warn("FDB aging event read failed", zap.Error(err))
+			continue
+		}
+		ev, err := decodeFdbAgedEvent(rec.RawSample)
+		if err != nil {
+			w.logger.Warn("FDB aging event decode failed", zap.Error(err))
+			continue
+		}
+		w.logger.Debug("FDB entry aged out",
+			zap.Uint16("bd_id", ev.BdID),
+			zap.String("mac", ev.MAC.String()),
+			zap.Bool("remote", ev.IsRemote))
+	}
+}
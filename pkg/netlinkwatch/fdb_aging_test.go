@@ -0,0 +1,39 @@
+package netlinkwatch
+
+import (
+	"encoding/binary"
+	"testing"
+)
+
+func TestDecodeFdbAgedEvent(t *testing.T) {
+	raw := make([]byte, fdbAgedEventLen)
+	binary.NativeEndian.PutUint64(raw[0:8], 12345)
+	binary.NativeEndian.PutUint32(raw[8:12], 7)
+	binary.NativeEndian.PutUint16(raw[12:14], 100)
+	binary.NativeEndian.PutUint16(raw[14:16], 3)
+	copy(raw[16:22], []byte{0x02, 0x00, 0x00, 0x00, 0x00, 0x0a})
+	raw[22] = 1
+	raw[24] = 0x01
+	raw[33] = 0x09
+
+	ev, err := decodeFdbAgedEvent(raw)
+	if err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if ev.LastSeen != 12345 || ev.Oif != 7 || ev.BdID != 100 || ev.PeerIndex != 3 {
+		t.Errorf("unexpected fields: %+v", ev)
+	}
+	if ev.MAC.String() != "02:00:00:00:00:0a" {
+		t.Errorf("mac: got %s", ev.MAC)
+	}
+	if !ev.IsRemote {
+		t.Error("is_remote should be set")
+	}
+	if ev.ESI[0] != 0x01 || ev.ESI[9] != 0x09 {
+		t.Errorf("esi: got %x", ev.ESI)
+	}
+
+	if _, err := decodeFdbAgedEvent(raw[:fdbAgedEventLen-1]); err == nil {
+		t.Error("short record should be rejected")
+	}
+}
@@ -2,10 +2,13 @@ package netlinkwatch
 
 import (
 	"context"
+	"fmt"
 	"net"
 	"sync"
 	"time"
 
+	"github.com/cilium/ebpf"
+	"github.com/cilium/ebpf/ringbuf"
 	"github.com/takehaya/vinbero/pkg/bpf"
 	"github.com/vishvananda/netlink"
 	"go.uber.org/zap"
@@ -13,7 +16,9 @@ import (
 )
 
 // FDBWatcher watches Linux bridge FDB updates via Netlink and syncs them to BPF fdb_map.
-// Also runs a periodic aging timer to delete stale dynamic entries.
+// Dynamic entries are aged by per-entry timers in the data plane; the watcher
+// drains the resulting fdb_aging_events ring buffer and sweeps the entries
+// that have no timer yet.
 type FDBWatcher struct {
 	mapOps       *bpf.MapOperations
 	logger       *zap.Logger
@@ -22,6 +27,8 @@ type FDBWatcher struct {
 	done         chan struct{}
 	wg           sync.WaitGroup
 	agingSeconds int // 0=disabled
+	agingEvents  *ebpf.Map
+	agingReader  *ringbuf.Reader
 }
 
 // NewFDBWatcher creates a new FDB watcher
@@ -68,7 +75,6 @@ func (w *FDBWatcher) Start(ctx context.Context) error {
 		w.processUpdates(ctx, updates)
 	}()
 
-	// Start aging timer if configured
 	if w.agingSeconds > 0 {
 		w.wg.Add(1)
 		go func() {
@@ -77,37 +83,61 @@ func (w *FDBWatcher) Start(ctx context.Context) error {
 		}()
 	}
 
+	if w.agingEvents != nil {
+		rd, err := ringbuf.NewReader(w.agingEvents)
+		if err != nil {
+			return fmt.Errorf("open fdb_aging_events: %w", err)
+		}
+		w.agingReader = rd
+		w.wg.Add(1)
+		go func() {
+			defer w.wg.Done()
+			w.runAgingEvents(rd)
+		}()
+	}
+
 	return nil
 }
 
-// SetAgingSeconds configures the FDB aging timeout.
+// SetAgingSeconds configures the FDB aging timeout. The data plane ages
+// entries on its own (settings.fdb_aging_seconds → fdb_aging_ns); the
+// watcher uses it for the sweep of entries without a timer.
 func (w *FDBWatcher) SetAgingSeconds(seconds int) {
 	w.agingSeconds = seconds
 }
 
+// runAging sweeps the dynamic entries that carry no data-plane timer
+// (written by CreateFdb, copied by a migration or resize, or learned while
+// aging was off) once at start and then every aging interval. Such an
+// entry is armed by its next refresh; one whose MAC went quiet is only
+// ever removed here.
 func (w *FDBWatcher) runAging(ctx context.Context) {
-	interval := time.Duration(w.agingSeconds) * time.Second
-	ticker := time.NewTicker(interval)
+	ticker := time.NewTicker(time.Duration(w.agingSeconds) * time.Second)
 	defer ticker.Stop()
 
 	for {
+		deleted, err := w.mapOps.AgeFdbEntries(uint64(w.agingSeconds) * 1e9)
+		if err != nil {
+			w.logger.Warn("FDB aging sweep error", zap.Error(err))
+		} else if deleted > 0 {
+			w.logger.Info("FDB aging sweep: deleted stale entries", zap.Int("count", deleted))
+		}
+
 		select {
 		case <-ctx.Done():
 			return
 		case <-w.done:
 			return
 		case <-ticker.C:
-			maxAgeNs := uint64(w.agingSeconds) * 1e9
-			deleted, err := w.mapOps.AgeFdbEntries(maxAgeNs)
-			if err != nil {
-				w.logger.Warn("FDB aging error", zap.Error(err))
-			} else if deleted > 0 {
-				w.logger.Info("FDB aging: deleted stale entries", zap.Int("count", deleted))
-			}
 		}
 	}
 }
 
+// SetAgingEvents sets the fdb_aging_events ring buffer Start drains.
+func (w *FDBWatcher) SetAgingEvents(events *ebpf.Map) {
+	w.agingEvents = events
+}
+
 func (w *FDBWatcher) processUpdates(ctx context.Context, updates <-chan netlink.NeighUpdate) {
 	for {
 		select {
@@ -152,7 +182,18 @@ func (w *FDBWatcher) handleNeighUpdate(update netlink.NeighUpdate) {
 	switch update.Type {
 	case unix.RTM_NEWNEIGH:
 		entry := &bpf.FdbEntry{
-			Oif: uint32(neigh.LinkIndex),
+			Oif:      uint32(neigh.LinkIndex),
+			AcVlanId: uint16(neigh.Vlan),
+		}
+		// Refreshes of a MAC already on this port are always synced
+		old, err := w.mapOps.GetFdb(bdID, net.HardwareAddr(mac))
+		moved := err == nil && old.IsStatic == 0
+		refresh := moved && old.IsRemote == 0 && old.Oif == entry.Oif
+		if !refresh && !w.mapOps.MacLearnAllowed(bdID, entry.Oif, entry.AcVlanId, moved) {
+			w.logger.Debug("FDB entry not synced: MAC learning disabled or at limit",
+				zap.String("mac", mac.String()),
+				zap.Uint16("bd_id", bdID))
+			return
 		}
 		if err := w.mapOps.CreateFdb(bdID, net.HardwareAddr(mac), entry); err != nil {
 			w.logger.Debug("Failed to sync FDB entry to BPF map",
@@ -178,5 +219,8 @@ func (w *FDBWatcher) Stop() {
 	default:
 		close(w.done)
 	}
+	if w.agingReader != nil {
+		_ = w.agingReader.Close()
+	}
 	w.wg.Wait()
 }
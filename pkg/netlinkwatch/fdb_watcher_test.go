@@ -1,8 +1,10 @@
 package netlinkwatch
 
 import (
+	"context"
 	"net"
 	"testing"
+	"time"
 
 	"github.com/takehaya/vinbero/pkg/bpf"
 	"github.com/vishvananda/netlink"
@@ -133,3 +135,50 @@ func TestFDBWatcherHandleNeighUpdate(t *testing.T) {
 		}
 	})
 }
+
+// TestFDBWatcherAgesUnarmedEntries verifies that a dynamic entry without
+// a data-plane timer is removed once it has been idle past aging_seconds,
+// not only by the sweep at start.
+func TestFDBWatcherAgesUnarmedEntries(t *testing.T) {
+	w, mapOps := newTestFDBWatcher(t)
+	w.SetAgingSeconds(1)
+
+	var ts unix.Timespec
+	if err := unix.ClockGettime(unix.CLOCK_MONOTONIC, &ts); err != nil {
+		t.Fatalf("ClockGettime: %v", err)
+	}
+	mac := net.HardwareAddr{0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0x02}
+	if err := mapOps.CreateFdb(100, mac, &bpf.FdbEntry{Oif: 3, LastSeen: uint64(ts.Nano())}); err != nil {
+		t.Fatalf("CreateFdb: %v", err)
+	}
+	staticMAC := net.HardwareAddr{0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0x03}
+	if err := mapOps.CreateFdb(100, staticMAC, &bpf.FdbEntry{Oif: 3, IsStatic: 1}); err != nil {
+		t.Fatalf("CreateFdb static: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		w.runAging(ctx)
+	}()
+
+	// Fresh at the first sweep, so only a later one can remove it
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		if _, err := mapOps.GetFdb(100, mac); err != nil {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("unarmed entry was never aged out")
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	cancel()
+	<-done
+
+	if _, err := mapOps.GetFdb(100, staticMAC); err != nil {
+		t.Error("static entry should not be aged")
+	}
+}
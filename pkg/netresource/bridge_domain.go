@@ -0,0 +1,93 @@
+package netresource
+
+import (
+	"fmt"
+
+	"go.uber.org/zap"
+)
+
+// AddBridgeDomain records a named bridge domain. Names and bd_ids are unique.
+func (m *ResourceManager) AddBridgeDomain(bd ManagedBridgeDomain) error {
+	m.mu.Lock()
+	for _, existing := range m.state.BridgeDomains {
+		if existing.Name == bd.Name {
+			m.mu.Unlock()
+			return fmt.Errorf("bridge domain %s already exists", bd.Name)
+		}
+		if existing.BdID == bd.BdID {
+			m.mu.Unlock()
+			return fmt.Errorf("bd_id %d is already used by bridge domain %s", bd.BdID, existing.Name)
+		}
+	}
+	m.state.BridgeDomains = append(m.state.BridgeDomains, bd)
+	m.mu.Unlock()
+
+	if err := saveState(m.statePath, m.state); err != nil {
+		m.logger.Warn("failed to save state after bridge domain creation", zap.Error(err))
+	}
+
+	m.logger.Info("Created bridge domain",
+		zap.String("name", bd.Name), zap.Uint16("bd_id", bd.BdID))
+	return nil
+}
+
+// RemoveBridgeDomain forgets a named bridge domain. Its members must have
+// been removed by the caller.
+func (m *ResourceManager) RemoveBridgeDomain(name string) error {
+	m.mu.Lock()
+	found := false
+	filtered := m.state.BridgeDomains[:0]
+	for _, bd := range m.state.BridgeDomains {
+		if bd.Name == name {
+			found = true
+			continue
+		}
+		filtered = append(filtered, bd)
+	}
+	m.state.BridgeDomains = filtered
+	m.mu.Unlock()
+
+	if !found {
+		return fmt.Errorf("bridge domain %s not found", name)
+	}
+
+	if err := saveState(m.statePath, m.state); err != nil {
+		m.logger.Warn("failed to save state after bridge domain deletion", zap.Error(err))
+	}
+
+	m.logger.Info("Deleted bridge domain", zap.String("name", name))
+	return nil
+}
+
+// GetBridgeDomain returns a named bridge domain.
+func (m *ResourceManager) GetBridgeDomain(name string) (ManagedBridgeDomain, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for _, bd := range m.state.BridgeDomains {
+		if bd.Name == name {
+			return bd, true
+		}
+	}
+	return ManagedBridgeDomain{}, false
+}
+
+// GetBridgeDomainByBridge returns the bridge domain that owns a bridge device.
+func (m *ResourceManager) GetBridgeDomainByBridge(bridge string) (ManagedBridgeDomain, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for _, bd := range m.state.BridgeDomains {
+		if bd.Bridge != "" && bd.Bridge == bridge {
+			return bd, true
+		}
+	}
+	return ManagedBridgeDomain{}, false
+}
+
+// ListBridgeDomains returns all named bridge domains.
+func (m *ResourceManager) ListBridgeDomains() []ManagedBridgeDomain {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	result := make([]ManagedBridgeDomain, len(m.state.BridgeDomains))
+	copy(result, m.state.BridgeDomains)
+	return result
+}
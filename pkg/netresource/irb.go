@@ -0,0 +1,81 @@
+package netresource
+
+import (
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/vishvananda/netlink"
+	"go.uber.org/zap"
+)
+
+// SetupIrb makes a bridge the anycast gateway of its BD: the bridge is
+// enslaved to the VRF, takes the gateway MAC and holds the gateway
+// addresses. Repeating it is harmless.
+func (m *ResourceManager) SetupIrb(bridge string, irb ManagedIrb) error {
+	if err := setupIrbNetlink(bridge, irb); err != nil {
+		return err
+	}
+	m.logger.Info("Configured IRB",
+		zap.String("bridge", bridge), zap.String("vrf", irb.Vrf),
+		zap.String("gateway_mac", irb.GatewayMAC), zap.Strings("gateway_ips", irb.GatewayIPs))
+	return nil
+}
+
+// TeardownIrb undoes SetupIrb, leaving the bridge itself in place.
+func (m *ResourceManager) TeardownIrb(bridge string, irb ManagedIrb) error {
+	link, err := netlink.LinkByName(bridge)
+	if err != nil {
+		return fmt.Errorf("bridge %s not found: %w", bridge, err)
+	}
+	var errs []error
+	for _, cidr := range irb.GatewayIPs {
+		addr, err := netlink.ParseAddr(cidr)
+		if err != nil {
+			continue
+		}
+		if err := netlink.AddrDel(link, addr); err != nil {
+			errs = append(errs, fmt.Errorf("remove %s from %s: %w", cidr, bridge, err))
+		}
+	}
+	if err := netlink.LinkSetNoMaster(link); err != nil {
+		errs = append(errs, fmt.Errorf("release %s from %s: %w", bridge, irb.Vrf, err))
+	}
+	return errors.Join(errs...)
+}
+
+func setupIrbNetlink(bridge string, irb ManagedIrb) error {
+	link, err := netlink.LinkByName(bridge)
+	if err != nil {
+		return fmt.Errorf("bridge %s not found: %w", bridge, err)
+	}
+	vrf, err := netlink.LinkByName(irb.Vrf)
+	if err != nil {
+		return fmt.Errorf("VRF %s not found: %w", irb.Vrf, err)
+	}
+	mac, err := net.ParseMAC(irb.GatewayMAC)
+	if err != nil {
+		return fmt.Errorf("invalid gateway MAC %q: %w", irb.GatewayMAC, err)
+	}
+
+	if link.Attrs().MasterIndex != vrf.Attrs().Index {
+		if err := netlink.LinkSetMaster(link, vrf); err != nil {
+			return fmt.Errorf("enslave %s to %s: %w", bridge, irb.Vrf, err)
+		}
+	}
+	if link.Attrs().HardwareAddr.String() != mac.String() {
+		if err := netlink.LinkSetHardwareAddr(link, mac); err != nil {
+			return fmt.Errorf("set %s address %s: %w", bridge, mac, err)
+		}
+	}
+	for _, cidr := range irb.GatewayIPs {
+		addr, err := netlink.ParseAddr(cidr)
+		if err != nil {
+			return fmt.Errorf("invalid gateway address %q: %w", cidr, err)
+		}
+		if err := netlink.AddrReplace(link, addr); err != nil {
+			return fmt.Errorf("add %s to %s: %w", cidr, bridge, err)
+		}
+	}
+	return nil
+}
@@ -70,6 +70,17 @@ func (m *ResourceManager) Reconcile() error {
 		}
 	}
 
+	// A recreated bridge or VRF has lost its IRB configuration
+	for _, bd := range m.state.BridgeDomains {
+		if bd.Irb == nil || bd.Bridge == "" {
+			continue
+		}
+		if err := setupIrbNetlink(bd.Bridge, *bd.Irb); err != nil {
+			m.logger.Error("Reconcile: failed to configure IRB",
+				zap.String("bridge_domain", bd.Name), zap.Error(err))
+		}
+	}
+
 	return saveState(m.statePath, m.state)
 }
 
@@ -7,8 +7,9 @@ import (
 )
 
 type ManagedState struct {
-	VRFs    []ManagedVrf    `json:"vrfs"`
-	Bridges []ManagedBridge `json:"bridges"`
+	VRFs          []ManagedVrf          `json:"vrfs"`
+	Bridges       []ManagedBridge       `json:"bridges"`
+	BridgeDomains []ManagedBridgeDomain `json:"bridge_domains"`
 }
 
 type ManagedVrf struct {
@@ -26,11 +27,33 @@ type ManagedBridge struct {
 	Ifindex uint32   `json:"ifindex"`
 }
 
+// ManagedBridgeDomain records a named bridge domain. ACs, SIDs and peers are
+// found in the BPF maps by bd_id; only what cannot be derived from them is
+// kept here.
+type ManagedBridgeDomain struct {
+	Name   string      `json:"name"`
+	BdID   uint16      `json:"bd_id"`
+	Bridge string      `json:"bridge,omitempty"`
+	ESIs   []string    `json:"esis,omitempty"`
+	Irb    *ManagedIrb `json:"irb,omitempty"`
+}
+
+// ManagedIrb records the VRF binding and anycast gateway of a bridge domain.
+type ManagedIrb struct {
+	Vrf        string   `json:"vrf"`
+	GatewayMAC string   `json:"gateway_mac"`
+	GatewayIPs []string `json:"gateway_ips,omitempty"`
+}
+
 func loadState(path string) (*ManagedState, error) {
 	data, err := os.ReadFile(path)
 	if err != nil {
 		if os.IsNotExist(err) {
-			return &ManagedState{VRFs: []ManagedVrf{}, Bridges: []ManagedBridge{}}, nil
+			return &ManagedState{
+				VRFs:          []ManagedVrf{},
+				Bridges:       []ManagedBridge{},
+				BridgeDomains: []ManagedBridgeDomain{},
+			}, nil
 		}
 		return nil, err
 	}
@@ -0,0 +1,194 @@
+package oam
+
+import (
+	"encoding/binary"
+	"net/netip"
+	"testing"
+	"time"
+
+	"golang.org/x/net/icmp"
+	"golang.org/x/net/ipv6"
+)
+
+func TestBuildSRH(t *testing.T) {
+	path := []netip.Addr{
+		netip.MustParseAddr("fc00:1::1"),
+		netip.MustParseAddr("fc00:2::1"),
+		netip.MustParseAddr("fc00:3::1"),
+	}
+	b := BuildSRH(path, true)
+
+	if len(b) != 8+16*3 {
+		t.Fatalf("length: got %d, want %d", len(b), 8+16*3)
+	}
+	if b[1] != 6 || b[2] != 4 || b[3] != 2 || b[4] != 2 {
+		t.Errorf("header: hdrlen=%d type=%d sl=%d last=%d, want 6/4/2/2", b[1], b[2], b[3], b[4])
+	}
+	if b[5] != srhFlagOAM {
+		t.Errorf("flags: got 0x%02x, want 0x%02x", b[5], srhFlagOAM)
+	}
+	// Segment List[0] is the final segment
+	for i, want := range []string{"fc00:3::1", "fc00:2::1", "fc00:1::1"} {
+		got := netip.AddrFrom16([16]byte(b[8+16*i : 24+16*i]))
+		if got.String() != want {
+			t.Errorf("segment[%d]: got %s, want %s", i, got, want)
+		}
+	}
+	if BuildSRH(path, false)[5] != 0 {
+		t.Errorf("O-flag set without oamFlag")
+	}
+}
+
+func TestMatchReply(t *testing.T) {
+	const id, seq = 0x1234, 7
+
+	echo := func(typ icmp.Type, id, seq int) []byte {
+		b, err := (&icmp.Message{Type: typ, Body: &icmp.Echo{ID: id, Seq: seq, Data: []byte("vinbero-oam")}}).Marshal(nil)
+		if err != nil {
+			t.Fatalf("marshal: %v", err)
+		}
+		return b
+	}
+	// Invoking packet as quoted in an ICMPv6 error: IPv6 + SRH + echo request
+	quote := func(seq int) []byte {
+		srh := BuildSRH([]netip.Addr{netip.MustParseAddr("fc00:1::1"), netip.MustParseAddr("fc00:2::1")}, false)
+		srh[0] = protocolICMPv6
+		q := make([]byte, 40)
+		q[0] = 0x60
+		q[6] = nextHdrRouting
+		q = append(q, srh...)
+		return append(q, echo(ipv6.ICMPTypeEchoRequest, id, seq)...)
+	}
+	errMsg := func(typ icmp.Type, code int, body icmp.MessageBody) []byte {
+		b, err := (&icmp.Message{Type: typ, Code: code, Body: body}).Marshal(nil)
+		if err != nil {
+			t.Fatalf("marshal: %v", err)
+		}
+		return b
+	}
+
+	tests := []struct {
+		name      string
+		msg       []byte
+		wantOK    bool
+		wantReply Reply
+		wantCode  uint8
+	}{
+		{"echo reply", echo(ipv6.ICMPTypeEchoReply, id, seq), true, ReplyEchoReply, 0},
+		{"echo reply other seq", echo(ipv6.ICMPTypeEchoReply, id, seq+1), false, ReplyTimeout, 0},
+		{"echo request", echo(ipv6.ICMPTypeEchoRequest, id, seq), false, ReplyTimeout, 0},
+		{"time exceeded", errMsg(ipv6.ICMPTypeTimeExceeded, 0, &icmp.TimeExceeded{Data: quote(seq)}), true, ReplyTimeExceeded, 0},
+		{"dest unreachable", errMsg(ipv6.ICMPTypeDestinationUnreachable, 4, &icmp.DstUnreach{Data: quote(seq)}), true, ReplyDestUnreachable, 4},
+		{"param problem", errMsg(ipv6.ICMPTypeParameterProblem, 0, &icmp.ParamProb{Data: quote(seq)}), true, ReplyParamProblem, 0},
+		{"time exceeded for other probe", errMsg(ipv6.ICMPTypeTimeExceeded, 0, &icmp.TimeExceeded{Data: quote(seq + 1)}), false, ReplyTimeout, 0},
+		{"truncated quote", errMsg(ipv6.ICMPTypeTimeExceeded, 0, &icmp.TimeExceeded{Data: quote(seq)[:48]}), false, ReplyTimeout, 0},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			reply, code, ok := matchReply(tc.msg, id, seq)
+			if ok != tc.wantOK || reply != tc.wantReply || code != tc.wantCode {
+				t.Errorf("got (%d, %d, %v), want (%d, %d, %v)", reply, code, ok, tc.wantReply, tc.wantCode, tc.wantOK)
+			}
+		})
+	}
+}
+
+// testFrame returns an Ethernet + IPv6 + SRH frame with an optional VLAN tag.
+func testFrame(vlan bool) []byte {
+	f := make([]byte, 12)
+	if vlan {
+		f = append(f, 0x81, 0x00, 0x00, 0x64)
+	}
+	f = append(f, 0x86, 0xdd)
+	ip6 := make([]byte, 40)
+	ip6[0] = 0x60
+	ip6[6] = nextHdrRouting
+	src, dst := netip.MustParseAddr("fc00::1").As16(), netip.MustParseAddr("fc00:1::1").As16()
+	copy(ip6[8:], src[:])
+	copy(ip6[24:], dst[:])
+	srh := BuildSRH([]netip.Addr{netip.MustParseAddr("fc00:1::1"), netip.MustParseAddr("fc00:2::1")}, true)
+	return append(append(f, ip6...), srh...)
+}
+
+func TestParseSRv6Frame(t *testing.T) {
+	for _, vlan := range []bool{false, true} {
+		hdr, err := ParseSRv6Frame(testFrame(vlan))
+		if err != nil {
+			t.Fatalf("vlan=%v: %v", vlan, err)
+		}
+		if hdr.Src.String() != "fc00::1" || hdr.Dst.String() != "fc00:1::1" {
+			t.Errorf("vlan=%v: addresses %s -> %s", vlan, hdr.Src, hdr.Dst)
+		}
+		if hdr.SegmentsLeft != 1 {
+			t.Errorf("vlan=%v: segments left: got %d, want 1", vlan, hdr.SegmentsLeft)
+		}
+		if len(hdr.Segments) != 2 || hdr.Segments[0].String() != "fc00:1::1" || hdr.Segments[1].String() != "fc00:2::1" {
+			t.Errorf("vlan=%v: segments: got %v", vlan, hdr.Segments)
+		}
+	}
+
+	if _, err := ParseSRv6Frame(make([]byte, 10)); err == nil {
+		t.Errorf("expected error for truncated frame")
+	}
+}
+
+func TestDecodePunt(t *testing.T) {
+	frame := testFrame(false)
+	raw := make([]byte, puntMetaLen, puntMetaLen+len(frame)+4)
+	binary.NativeEndian.PutUint64(raw[0:8], uint64(5*time.Second))
+	binary.NativeEndian.PutUint32(raw[8:12], 3)
+	binary.NativeEndian.PutUint16(raw[12:14], 1500)
+	binary.NativeEndian.PutUint16(raw[14:16], uint16(len(frame)))
+	raw[16] = PuntReasonOFlag
+	raw[17] = 1
+	raw = append(raw, frame...)
+	raw = append(raw, 0, 0, 0, 0) // perf sample padding
+
+	p, err := decodePunt(raw, time.Hour)
+	if err != nil {
+		t.Fatalf("decodePunt: %v", err)
+	}
+	if want := time.Unix(0, 0).Add(time.Hour + 5*time.Second); !p.Time.Equal(want) {
+		t.Errorf("time: got %v, want %v", p.Time, want)
+	}
+	if p.Ifindex != 3 || p.PktLen != 1500 || p.Reason != PuntReasonOFlag || p.Action != 1 {
+		t.Errorf("meta: got %+v", p)
+	}
+	if len(p.Frame) != len(frame) {
+		t.Errorf("frame length: got %d, want %d", len(p.Frame), len(frame))
+	}
+	if p.ReasonString() != "o-flag" {
+		t.Errorf("reason: got %q", p.ReasonString())
+	}
+
+	if _, err := decodePunt(raw[:10], 0); err == nil {
+		t.Errorf("expected error for short sample")
+	}
+}
+
+func TestPuntReaderRecent(t *testing.T) {
+	r := NewPuntReader(nil, 3, nil)
+	if got := r.Recent(0); len(got) != 0 {
+		t.Fatalf("empty reader returned %d punts", len(got))
+	}
+	for i := range 5 {
+		r.add(Punt{Ifindex: uint32(i)})
+	}
+
+	check := func(limit int, want ...uint32) {
+		t.Helper()
+		got := r.Recent(limit)
+		if len(got) != len(want) {
+			t.Fatalf("Recent(%d): got %d punts, want %d", limit, len(got), len(want))
+		}
+		for i := range want {
+			if got[i].Ifindex != want[i] {
+				t.Errorf("Recent(%d)[%d]: got %d, want %d", limit, i, got[i].Ifindex, want[i])
+			}
+		}
+	}
+	check(0, 2, 3, 4)
+	check(2, 3, 4)
+	check(10, 2, 3, 4)
+}
@@ -0,0 +1,321 @@
+package oam
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net"
+	"net/netip"
+	"os"
+	"sync"
+	"time"
+
+	"golang.org/x/net/icmp"
+	"golang.org/x/net/ipv6"
+	"golang.org/x/sys/unix"
+)
+
+// Reply classifies the answer to one probe.
+type Reply uint8
+
+const (
+	ReplyTimeout Reply = iota
+	ReplyEchoReply
+	ReplyTimeExceeded
+	ReplyDestUnreachable
+	ReplyParamProblem
+)
+
+// SRH flag carried by OAM probes (RFC 9259 Section 2.1)
+const srhFlagOAM = 0x20
+
+// maxSegments matches MAX_SEGMENTS in the data plane.
+const maxSegments = 10
+
+const (
+	defaultTimeout  = time.Second
+	defaultMaxHops  = 30
+	probeHopLimit   = 64
+	protocolICMPv6  = 58
+	nextHdrRouting  = 43
+	nextHdrHopByHop = 0
+	nextHdrDestOpts = 60
+)
+
+// Result is the outcome of one probe.
+type Result struct {
+	Index     int        // Segment index (ping) or hop limit (traceroute)
+	Target    netip.Addr // Address the probe was sent to
+	Responder netip.Addr // Source of the answer; invalid on timeout
+	Reply     Reply
+	Code      uint8
+	RTT       time.Duration
+}
+
+// ProbeOptions are shared by Ping and Traceroute.
+type ProbeOptions struct {
+	Source  netip.Addr    // Optional; kernel picks one when invalid
+	Timeout time.Duration // Per-probe timeout (default 1s)
+	OAMFlag bool          // Set the SRH O-flag on every probe
+}
+
+// Prober sends SRv6 ICMPv6 echo probes from a raw socket. The SRH is
+// attached with IPV6_RTHDR, so the kernel writes the first segment into
+// the DA and computes the ICMPv6 checksum against the final segment.
+type Prober struct {
+	mu  sync.Mutex
+	id  uint16
+	seq uint16
+}
+
+// NewProber creates a Prober with a per-process echo identifier.
+func NewProber() *Prober {
+	return &Prober{id: uint16(os.Getpid()) ^ uint16(rand.Uint32())}
+}
+
+// Ping probes every segment of the path: probe i travels segments[0..i]
+// and targets segments[i], so a broken SID shows up at its own index.
+func (p *Prober) Ping(ctx context.Context, segments []netip.Addr, count int, opts ProbeOptions) ([]Result, error) {
+	if err := validateSegments(segments); err != nil {
+		return nil, err
+	}
+	if count <= 0 {
+		count = 1
+	}
+	var results []Result
+	for i := range segments {
+		for range count {
+			r, err := p.probe(ctx, segments[:i+1], probeHopLimit, opts)
+			if err != nil {
+				return results, err
+			}
+			r.Index = i
+			results = append(results, r)
+		}
+	}
+	return results, nil
+}
+
+// Traceroute sends probes along the full path with hop limits 1..maxHops
+// and stops at the first Echo Reply or terminal error.
+func (p *Prober) Traceroute(ctx context.Context, segments []netip.Addr, maxHops int, opts ProbeOptions) ([]Result, error) {
+	if err := validateSegments(segments); err != nil {
+		return nil, err
+	}
+	if maxHops <= 0 {
+		maxHops = defaultMaxHops
+	}
+	var results []Result
+	for hop := 1; hop <= maxHops; hop++ {
+		r, err := p.probe(ctx, segments, hop, opts)
+		if err != nil {
+			return results, err
+		}
+		r.Index = hop
+		results = append(results, r)
+		switch r.Reply {
+		case ReplyEchoReply, ReplyDestUnreachable, ReplyParamProblem:
+			return results, nil
+		}
+	}
+	return results, nil
+}
+
+func validateSegments(segments []netip.Addr) error {
+	if len(segments) == 0 {
+		return fmt.Errorf("at least one segment is required")
+	}
+	if len(segments) > maxSegments {
+		return fmt.Errorf("too many segments: %d (max %d)", len(segments), maxSegments)
+	}
+	for _, s := range segments {
+		if !s.Is6() || s.Is4In6() {
+			return fmt.Errorf("segment %s is not an IPv6 address", s)
+		}
+	}
+	return nil
+}
+
+func (p *Prober) nextSeq() (uint16, uint16) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.seq++
+	return p.id, p.seq
+}
+
+// probe sends one echo request along path and waits for the matching
+// answer. The last path entry is the final destination.
+func (p *Prober) probe(ctx context.Context, path []netip.Addr, hopLimit int, opts ProbeOptions) (Result, error) {
+	target := path[len(path)-1]
+	res := Result{Target: target, Reply: ReplyTimeout}
+
+	laddr := "::"
+	if opts.Source.IsValid() {
+		laddr = opts.Source.String()
+	}
+	conn, err := icmp.ListenPacket("ip6:ipv6-icmp", laddr)
+	if err != nil {
+		return res, fmt.Errorf("open ICMPv6 socket: %w", err)
+	}
+	defer func() { _ = conn.Close() }()
+
+	var srh []byte
+	if len(path) > 1 || opts.OAMFlag {
+		srh = BuildSRH(path, opts.OAMFlag)
+	}
+	if err := setProbeSockopts(conn, srh, hopLimit); err != nil {
+		return res, err
+	}
+
+	id, seq := p.nextSeq()
+	msg := icmp.Message{
+		Type: ipv6.ICMPTypeEchoRequest,
+		Body: &icmp.Echo{ID: int(id), Seq: int(seq), Data: []byte("vinbero-oam")},
+	}
+	wb, err := msg.Marshal(nil)
+	if err != nil {
+		return res, fmt.Errorf("marshal echo: %w", err)
+	}
+
+	timeout := opts.Timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	deadline := time.Now().Add(timeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	if err := conn.SetReadDeadline(deadline); err != nil {
+		return res, err
+	}
+
+	sent := time.Now()
+	if _, err := conn.WriteTo(wb, &net.IPAddr{IP: net.IP(target.AsSlice())}); err != nil {
+		return res, fmt.Errorf("send probe to %s: %w", target, err)
+	}
+
+	rb := make([]byte, 1500)
+	for {
+		n, peer, err := conn.ReadFrom(rb)
+		if err != nil {
+			var nerr net.Error
+			if errors.As(err, &nerr) && nerr.Timeout() {
+				return res, nil
+			}
+			return res, fmt.Errorf("read: %w", err)
+		}
+		reply, code, ok := matchReply(rb[:n], id, seq)
+		if !ok {
+			continue
+		}
+		res.Reply = reply
+		res.Code = code
+		res.RTT = time.Since(sent)
+		if ipa, ok := peer.(*net.IPAddr); ok {
+			res.Responder, _ = netip.AddrFromSlice(ipa.IP)
+		}
+		return res, nil
+	}
+}
+
+func setProbeSockopts(conn *icmp.PacketConn, srh []byte, hopLimit int) error {
+	ipc, ok := conn.IPv6PacketConn().PacketConn.(*net.IPConn)
+	if !ok {
+		return fmt.Errorf("unexpected ICMPv6 socket type")
+	}
+	rc, err := ipc.SyscallConn()
+	if err != nil {
+		return err
+	}
+	var serr error
+	err = rc.Control(func(fd uintptr) {
+		if serr = unix.SetsockoptInt(int(fd), unix.IPPROTO_IPV6, unix.IPV6_UNICAST_HOPS, hopLimit); serr != nil {
+			serr = fmt.Errorf("set hop limit: %w", serr)
+			return
+		}
+		if len(srh) > 0 {
+			if serr = unix.SetsockoptString(int(fd), unix.IPPROTO_IPV6, unix.IPV6_RTHDR, string(srh)); serr != nil {
+				serr = fmt.Errorf("set SRH: %w", serr)
+			}
+		}
+	})
+	if err != nil {
+		return err
+	}
+	return serr
+}
+
+// BuildSRH encodes an SRH (RFC 8754) for path given in traversal order.
+// Segment List[0] is the final segment, so the list is stored reversed
+// and Segments Left points at the first segment.
+func BuildSRH(path []netip.Addr, oamFlag bool) []byte {
+	n := len(path)
+	b := make([]byte, 8+16*n)
+	b[1] = uint8(2 * n) // Hdr Ext Len in 8-octet units, excluding the first 8
+	b[2] = 4            // Routing Type: Segment Routing
+	b[3] = uint8(n - 1) // Segments Left
+	b[4] = uint8(n - 1) // Last Entry
+	if oamFlag {
+		b[5] = srhFlagOAM
+	}
+	for i, seg := range path {
+		a := seg.As16()
+		copy(b[8+16*(n-1-i):], a[:])
+	}
+	return b
+}
+
+// matchReply reports whether an ICMPv6 message (without IPv6 header, as
+// delivered on a raw socket) answers the echo id/seq, and how.
+func matchReply(b []byte, id, seq uint16) (Reply, uint8, bool) {
+	msg, err := icmp.ParseMessage(protocolICMPv6, b)
+	if err != nil {
+		return ReplyTimeout, 0, false
+	}
+	code := uint8(msg.Code)
+	switch body := msg.Body.(type) {
+	case *icmp.Echo:
+		if msg.Type == ipv6.ICMPTypeEchoReply && body.ID == int(id) && body.Seq == int(seq) {
+			return ReplyEchoReply, code, true
+		}
+	case *icmp.TimeExceeded:
+		if quotedEchoMatches(body.Data, id, seq) {
+			return ReplyTimeExceeded, code, true
+		}
+	case *icmp.DstUnreach:
+		if quotedEchoMatches(body.Data, id, seq) {
+			return ReplyDestUnreachable, code, true
+		}
+	case *icmp.ParamProb:
+		if quotedEchoMatches(body.Data, id, seq) {
+			return ReplyParamProblem, code, true
+		}
+	}
+	return ReplyTimeout, 0, false
+}
+
+// quotedEchoMatches walks the invoking packet quoted in an ICMPv6 error
+// (IPv6 header + extension headers) down to our echo request.
+func quotedEchoMatches(q []byte, id, seq uint16) bool {
+	if len(q) < 40 {
+		return false
+	}
+	nh := q[6]
+	off := 40
+	for nh == nextHdrRouting || nh == nextHdrHopByHop || nh == nextHdrDestOpts {
+		if len(q) < off+2 {
+			return false
+		}
+		nh = q[off]
+		off += 8 + int(q[off+1])*8
+	}
+	if nh != protocolICMPv6 || len(q) < off+8 {
+		return false
+	}
+	echo := q[off:]
+	return echo[0] == byte(ipv6.ICMPTypeEchoRequest) &&
+		binary.BigEndian.Uint16(echo[4:6]) == id &&
+		binary.BigEndian.Uint16(echo[6:8]) == seq
+}
@@ -0,0 +1,238 @@
+package oam
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net/netip"
+	"sync"
+	"time"
+
+	"github.com/cilium/ebpf"
+	"github.com/cilium/ebpf/perf"
+	"github.com/takehaya/vinbero/pkg/bpf"
+	"go.uber.org/zap"
+)
+
+// Punt reasons (must match OAM_PUNT_* in src/core/xdp_prog.h)
+const (
+	PuntReasonOFlag uint8 = 1
+	PuntReasonEndOP uint8 = 2
+)
+
+// puntMetaLen is sizeof(struct oam_punt_meta).
+const puntMetaLen = 24
+
+// DefaultPuntBufferSize is how many punts PuntReader keeps for listing.
+const DefaultPuntBufferSize = 256
+
+// Punt is one timestamped copy received from the data plane.
+type Punt struct {
+	Time    time.Time
+	Ifindex uint32
+	Reason  uint8
+	Action  uint8
+	PktLen  uint16
+	Frame   []byte // Captured bytes starting at the Ethernet header
+}
+
+// ReasonString returns the CLI/API label for p.Reason.
+func (p *Punt) ReasonString() string {
+	switch p.Reason {
+	case PuntReasonOFlag:
+		return "o-flag"
+	case PuntReasonEndOP:
+		return "end-op"
+	default:
+		return fmt.Sprintf("unknown(%d)", p.Reason)
+	}
+}
+
+// PuntReader drains oam_punt_events and keeps the most recent punts in a
+// fixed-size ring for OamService.OamPuntList.
+type PuntReader struct {
+	events *ebpf.Map
+	logger *zap.Logger
+	reader *perf.Reader
+	wg     sync.WaitGroup
+
+	mu         sync.Mutex
+	ring       []Punt
+	next       int
+	full       bool
+	bootOffset time.Duration // wall clock minus CLOCK_MONOTONIC
+}
+
+// NewPuntReader creates a reader for the oam_punt_events perf map.
+func NewPuntReader(events *ebpf.Map, size int, logger *zap.Logger) *PuntReader {
+	if size <= 0 {
+		size = DefaultPuntBufferSize
+	}
+	return &PuntReader{
+		events: events,
+		logger: logger,
+		ring:   make([]Punt, size),
+	}
+}
+
+// Start opens the per-CPU perf rings and begins reading in the background.
+func (r *PuntReader) Start() error {
+	offset, err := bpf.KtimeOffset()
+	if err != nil {
+		return err
+	}
+	r.bootOffset = offset
+
+	rd, err := perf.NewReader(r.events, 4096)
+	if err != nil {
+		return fmt.Errorf("open oam_punt_events: %w", err)
+	}
+	r.reader = rd
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		r.run()
+	}()
+	return nil
+}
+
+// Stop closes the perf rings and waits for the read loop to exit.
+func (r *PuntReader) Stop() {
+	if r.reader == nil {
+		return
+	}
+	_ = r.reader.Close()
+	r.wg.Wait()
+}
+
+func (r *PuntReader) run() {
+	for {
+		rec, err := r.reader.Read()
+		if err != nil {
+			if errors.Is(err, perf.ErrClosed) {
+				return
+			}
+			r.logger.Warn("oam punt read failed", zap.Error(err))
+			continue
+		}
+		if rec.LostSamples > 0 {
+			r.logger.Warn("oam punts lost", zap.Uint64("count", rec.LostSamples))
+			continue
+		}
+		p, err := decodePunt(rec.RawSample, r.bootOffset)
+		if err != nil {
+			r.logger.Warn("oam punt decode failed", zap.Error(err))
+			continue
+		}
+		r.logger.Debug("oam punt",
+			zap.String("reason", p.ReasonString()),
+			zap.Uint32("ifindex", p.Ifindex),
+			zap.Time("timestamp", p.Time))
+		r.add(p)
+	}
+}
+
+func (r *PuntReader) add(p Punt) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.ring[r.next] = p
+	r.next = (r.next + 1) % len(r.ring)
+	if r.next == 0 {
+		r.full = true
+	}
+}
+
+// Recent returns up to limit buffered punts, oldest first. limit <= 0
+// returns everything buffered.
+func (r *PuntReader) Recent(limit int) []Punt {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var out []Punt
+	if r.full {
+		out = append(out, r.ring[r.next:]...)
+	}
+	out = append(out, r.ring[:r.next]...)
+	if limit > 0 && len(out) > limit {
+		out = out[len(out)-limit:]
+	}
+	return out
+}
+
+// decodePunt parses one perf sample: struct oam_punt_meta followed by the
+// captured frame. bootOffset converts the bpf_ktime_get_ns timestamp to
+// wall clock time.
+func decodePunt(raw []byte, bootOffset time.Duration) (Punt, error) {
+	if len(raw) < puntMetaLen {
+		return Punt{}, fmt.Errorf("sample too short: %d bytes", len(raw))
+	}
+	ts := binary.NativeEndian.Uint64(raw[0:8])
+	capLen := int(binary.NativeEndian.Uint16(raw[14:16]))
+	frame := raw[puntMetaLen:]
+	if capLen < len(frame) {
+		frame = frame[:capLen]
+	}
+	return Punt{
+		Time:    time.Unix(0, int64(ts)).Add(bootOffset),
+		Ifindex: binary.NativeEndian.Uint32(raw[8:12]),
+		PktLen:  binary.NativeEndian.Uint16(raw[12:14]),
+		Reason:  raw[16],
+		Action:  raw[17],
+		Frame:   append([]byte(nil), frame...),
+	}, nil
+}
+
+// SRv6Header is the IPv6/SRH summary of a punted frame.
+type SRv6Header struct {
+	Src          netip.Addr
+	Dst          netip.Addr
+	SegmentsLeft uint8
+	Segments     []netip.Addr // Traversal order (SRH stores them reversed)
+}
+
+// ParseSRv6Frame extracts addresses and the segment list from an Ethernet
+// frame carrying IPv6 + SRH, skipping up to two VLAN tags.
+func ParseSRv6Frame(frame []byte) (*SRv6Header, error) {
+	off := 12
+	for {
+		if len(frame) < off+2 {
+			return nil, fmt.Errorf("truncated ethernet header")
+		}
+		etype := binary.BigEndian.Uint16(frame[off:])
+		if etype == 0x8100 || etype == 0x88a8 {
+			off += 4
+			continue
+		}
+		if etype != 0x86dd {
+			return nil, fmt.Errorf("not IPv6 (ethertype 0x%04x)", etype)
+		}
+		off += 2
+		break
+	}
+	if len(frame) < off+40 {
+		return nil, fmt.Errorf("truncated IPv6 header")
+	}
+	ip6 := frame[off:]
+	hdr := &SRv6Header{
+		Src: netip.AddrFrom16([16]byte(ip6[8:24])),
+		Dst: netip.AddrFrom16([16]byte(ip6[24:40])),
+	}
+	if ip6[6] != 43 {
+		return hdr, nil
+	}
+	srh := ip6[40:]
+	if len(srh) < 8 || srh[2] != 4 {
+		return hdr, nil
+	}
+	hdr.SegmentsLeft = srh[3]
+	last := int(srh[4])
+	for i := last; i >= 0; i-- {
+		start := 8 + i*16
+		if len(srh) < start+16 {
+			continue
+		}
+		hdr.Segments = append(hdr.Segments, netip.AddrFrom16([16]byte(srh[start:start+16])))
+	}
+	return hdr, nil
+}
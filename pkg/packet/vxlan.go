@@ -0,0 +1,42 @@
+package packet
+
+import (
+	"fmt"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+)
+
+// VXLANPort is the IANA-assigned VXLAN UDP destination port (RFC 7348 Section 5).
+const VXLANPort = 4789
+
+// VXLANLayers returns the UDP and VXLAN headers that carry an Ethernet
+// frame on vni. Serialize them after the outer IPv4/IPv6 layer and before
+// the inner frame. The UDP checksum stays zero unless the caller sets the
+// network layer for it, which is how the data plane sends VXLAN.
+func VXLANLayers(vni uint32, srcPort uint16) (*layers.UDP, *layers.VXLAN) {
+	udp := &layers.UDP{
+		SrcPort: layers.UDPPort(srcPort),
+		DstPort: layers.UDPPort(VXLANPort),
+	}
+	vxlan := &layers.VXLAN{ValidIDFlag: true, VNI: vni}
+	return udp, vxlan
+}
+
+// DecodeVXLAN parses an Ethernet/IP/UDP/VXLAN packet and returns the VNI
+// and the inner Ethernet frame.
+func DecodeVXLAN(data []byte) (uint32, []byte, error) {
+	pkt := gopacket.NewPacket(data, layers.LayerTypeEthernet, gopacket.Default)
+	udp, ok := pkt.Layer(layers.LayerTypeUDP).(*layers.UDP)
+	if !ok || udp.DstPort != VXLANPort {
+		return 0, nil, fmt.Errorf("not a VXLAN packet")
+	}
+	vx, ok := pkt.Layer(layers.LayerTypeVXLAN).(*layers.VXLAN)
+	if !ok {
+		return 0, nil, fmt.Errorf("truncated VXLAN header")
+	}
+	if !vx.ValidIDFlag {
+		return 0, nil, fmt.Errorf("VXLAN I flag not set")
+	}
+	return vx.VNI, vx.Payload, nil
+}
@@ -29,7 +29,7 @@ func (s *BdPeerServer) BdPeerCreate(
 	}
 
 	// Track next indexes per BD within this batch.
-	// Seed each BD's starting index via O(8) probe instead of full map iteration.
+	// Seed each BD's starting index via an O(per_bd) probe instead of full map iteration.
 	bdIndexes := make(map[uint32]uint16)
 
 	for _, peer := range req.Msg.Peers {
@@ -41,10 +41,18 @@ func (s *BdPeerServer) BdPeerCreate(
 		}
 		index := bdIndexes[peer.BdId]
 
-		if index >= bpf.MaxBumNexthops {
+		// A peer already in the BD (same src_addr) is replaced in place
+		replace := false
+		if src, err := bpf.ParseIPv6(peer.SrcAddr); err == nil {
+			if idx, ok := s.mapOps.LookupBdPeerIndex(bdID, src); ok {
+				index, replace = idx, true
+			}
+		}
+
+		if index >= s.mapOps.BdPeersPerBd() {
 			resp.Errors = append(resp.Errors, &v1.OperationError{
 				TriggerPrefix: fmt.Sprintf("bd_%d", peer.BdId),
-				Reason:        fmt.Sprintf("maximum number of peers (%d) reached for this BD", bpf.MaxBumNexthops),
+				Reason:        fmt.Sprintf("maximum number of peers (%d) reached for this BD", s.mapOps.BdPeersPerBd()),
 			})
 			continue
 		}
@@ -75,13 +83,88 @@ func (s *BdPeerServer) BdPeerCreate(
 			continue
 		}
 
-		bdIndexes[peer.BdId] = index + 1
+		if !replace {
+			bdIndexes[peer.BdId] = index + 1
+		}
 		resp.Created = append(resp.Created, peer)
 	}
 
 	return connect.NewResponse(resp), nil
 }
 
+// BdPeerUpdate changes installed peers in place; a peer keeps its index
+// in the BD, so BUM flooding never skips it.
+func (s *BdPeerServer) BdPeerUpdate(
+	ctx context.Context,
+	req *connect.Request[v1.BdPeerUpdateRequest],
+) (*connect.Response[v1.BdPeerUpdateResponse], error) {
+	resp := &v1.BdPeerUpdateResponse{
+		Updated: make([]*v1.BdPeer, 0),
+		Errors:  make([]*v1.OperationError, 0),
+	}
+
+	esiByPeer, err := s.mapOps.ListBdPeerEsi()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	for _, peer := range req.Msg.Peers {
+		bdID := uint16(peer.BdId)
+		trigger := fmt.Sprintf("bd_%d", peer.BdId)
+
+		src, err := bpf.ParseIPv6(peer.SrcAddr)
+		if err != nil {
+			resp.Errors = append(resp.Errors, &v1.OperationError{TriggerPrefix: trigger, Reason: err.Error()})
+			continue
+		}
+		index, ok := s.mapOps.LookupBdPeerIndex(bdID, src)
+		if !ok {
+			resp.Errors = append(resp.Errors, &v1.OperationError{
+				TriggerPrefix: trigger,
+				Reason:        fmt.Sprintf("not found: no peer %s in this BD", peer.SrcAddr),
+			})
+			continue
+		}
+		current, err := s.mapOps.GetBdPeer(bdID, index)
+		if err != nil {
+			resp.Errors = append(resp.Errors, &v1.OperationError{TriggerPrefix: trigger, Reason: err.Error()})
+			continue
+		}
+		installed := &v1.BdPeer{
+			BdId:     peer.BdId,
+			SrcAddr:  peer.SrcAddr,
+			Segments: bpf.FormatSegments(current.Segments, current.NumSegments),
+			Mode:     v1.Srv6HeadendBehavior(current.Mode),
+		}
+		if esi, ok := esiByPeer[bpf.BdPeerEsiKey{BdId: bdID, SrcAddr: src}]; ok {
+			installed.Esi = bpf.FormatESI(esi)
+		}
+
+		merged, err := mergeUpdate(installed, peer, req.Msg.UpdateMask, "bd_id", "src_addr")
+		if err != nil {
+			resp.Errors = append(resp.Errors, &v1.OperationError{TriggerPrefix: trigger, Reason: err.Error()})
+			continue
+		}
+		entry, err := s.protoToEntry(merged)
+		if err != nil {
+			resp.Errors = append(resp.Errors, &v1.OperationError{TriggerPrefix: trigger, Reason: err.Error()})
+			continue
+		}
+		esi, err := bpf.ParseESI(merged.Esi)
+		if err != nil {
+			resp.Errors = append(resp.Errors, &v1.OperationError{TriggerPrefix: trigger, Reason: err.Error()})
+			continue
+		}
+		if err := s.mapOps.CreateBdPeer(bdID, index, entry, esi); err != nil {
+			resp.Errors = append(resp.Errors, &v1.OperationError{TriggerPrefix: trigger, Reason: err.Error()})
+			continue
+		}
+		resp.Updated = append(resp.Updated, merged)
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
 func (s *BdPeerServer) BdPeerDelete(
 	ctx context.Context,
 	req *connect.Request[v1.BdPeerDeleteRequest],
@@ -93,7 +176,7 @@ func (s *BdPeerServer) BdPeerDelete(
 
 	for _, bdID := range req.Msg.BdIds {
 		deleted := false
-		for i := uint16(0); i < bpf.MaxBumNexthops; i++ {
+		for i := uint16(0); i < s.mapOps.BdPeersPerBd(); i++ {
 			err := s.mapOps.DeleteBdPeer(uint16(bdID), i)
 			if err == nil {
 				deleted = true
@@ -164,3 +247,30 @@ func (s *BdPeerServer) BdPeerList(
 func (s *BdPeerServer) protoToEntry(peer *v1.BdPeer) (*bpf.HeadendEntry, error) {
 	return buildL2HeadendEntry(peer.SrcAddr, peer.Segments, peer.Mode, peer.BdId)
 }
+
+// deletePeers removes single peers found by (bd_id, src_addr), for callers
+// that don't want BdPeerDelete's whole-BD removal.
+func (s *BdPeerServer) deletePeers(peers []*v1.BdPeer) []*v1.OperationError {
+	var errs []*v1.OperationError
+	for _, peer := range peers {
+		src, err := bpf.ParseIPv6(peer.SrcAddr)
+		if err != nil {
+			errs = append(errs, &v1.OperationError{
+				TriggerPrefix: fmt.Sprintf("bd_%d", peer.BdId),
+				Reason:        err.Error(),
+			})
+			continue
+		}
+		index, ok := s.mapOps.LookupBdPeerIndex(uint16(peer.BdId), src)
+		if !ok {
+			continue
+		}
+		if err := s.mapOps.DeleteBdPeer(uint16(peer.BdId), index); err != nil {
+			errs = append(errs, &v1.OperationError{
+				TriggerPrefix: fmt.Sprintf("bd_%d", peer.BdId),
+				Reason:        err.Error(),
+			})
+		}
+	}
+	return errs
+}
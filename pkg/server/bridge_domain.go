@@ -0,0 +1,1444 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"sync"
+
+	"connectrpc.com/connect"
+	"github.com/cilium/ebpf"
+	v1 "github.com/takehaya/vinbero/api/vinbero/v1"
+	"github.com/takehaya/vinbero/pkg/bpf"
+	"github.com/takehaya/vinbero/pkg/netresource"
+)
+
+// BridgeDomainServer implements the BridgeDomainServiceHandler interface.
+// Members of a named BD are created and listed through the per-table
+// servers so they get exactly the same validation as direct API calls.
+type BridgeDomainServer struct {
+	mapOps *bpf.MapOperations
+	resMgr *netresource.ResourceManager
+	sids   *SidFunctionServer
+	acs    *HeadendL2Server
+	peers  *BdPeerServer
+	es     *EthernetSegmentServer
+	netRes *NetworkResourceServer
+	mu     sync.Mutex // serializes BD create/delete
+}
+
+// NewBridgeDomainServer creates a new BridgeDomainServer
+func NewBridgeDomainServer(
+	mapOps *bpf.MapOperations,
+	resMgr *netresource.ResourceManager,
+	sids *SidFunctionServer,
+	acs *HeadendL2Server,
+	peers *BdPeerServer,
+	es *EthernetSegmentServer,
+	netRes *NetworkResourceServer,
+) *BridgeDomainServer {
+	return &BridgeDomainServer{
+		mapOps: mapOps,
+		resMgr: resMgr,
+		sids:   sids,
+		acs:    acs,
+		peers:  peers,
+		es:     es,
+		netRes: netRes,
+	}
+}
+
+// BridgeDomainCreate creates named BDs together with their members. Each BD
+// is all-or-nothing: on the first failing member everything already created
+// for it is removed again.
+func (s *BridgeDomainServer) BridgeDomainCreate(
+	ctx context.Context,
+	req *connect.Request[v1.BridgeDomainCreateRequest],
+) (*connect.Response[v1.BridgeDomainCreateResponse], error) {
+	resp := &v1.BridgeDomainCreateResponse{
+		Created: make([]*v1.BridgeDomain, 0),
+		Errors:  make([]*v1.OperationError, 0),
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, bd := range req.Msg.BridgeDomains {
+		if err := s.createBridgeDomain(ctx, bd); err != nil {
+			resp.Errors = append(resp.Errors, &v1.OperationError{
+				TriggerPrefix: bd.Name,
+				Reason:        err.Error(),
+			})
+			continue
+		}
+		resp.Created = append(resp.Created, bd)
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// BridgeDomainDelete deletes named BDs and every member carrying their
+// bd_id: SIDs, ACs, peers, FDB entries, MAC limits, neighbors, the IRB
+// binding and its host routes, the bridge and the Ethernet Segments no
+// other AC or peer still uses.
+func (s *BridgeDomainServer) BridgeDomainDelete(
+	ctx context.Context,
+	req *connect.Request[v1.BridgeDomainDeleteRequest],
+) (*connect.Response[v1.BridgeDomainDeleteResponse], error) {
+	resp := &v1.BridgeDomainDeleteResponse{
+		DeletedNames: make([]string, 0),
+		Errors:       make([]*v1.OperationError, 0),
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, name := range req.Msg.Names {
+		bd, ok := s.resMgr.GetBridgeDomain(name)
+		if !ok {
+			resp.Errors = append(resp.Errors, &v1.OperationError{
+				TriggerPrefix: name,
+				Reason:        "bridge domain not found",
+			})
+			continue
+		}
+		if err := s.deleteBridgeDomain(bd); err != nil {
+			resp.Errors = append(resp.Errors, &v1.OperationError{
+				TriggerPrefix: name,
+				Reason:        err.Error(),
+			})
+			continue
+		}
+		resp.DeletedNames = append(resp.DeletedNames, name)
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// BridgeDomainGet shows one BD with all of its members
+func (s *BridgeDomainServer) BridgeDomainGet(
+	ctx context.Context,
+	req *connect.Request[v1.BridgeDomainGetRequest],
+) (*connect.Response[v1.BridgeDomainGetResponse], error) {
+	bd, ok := s.resMgr.GetBridgeDomain(req.Msg.Name)
+	if !ok {
+		return nil, connect.NewError(connect.CodeNotFound,
+			fmt.Errorf("bridge domain %s not found", req.Msg.Name))
+	}
+	out, err := s.assembleBridgeDomain(ctx, bd)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&v1.BridgeDomainGetResponse{BridgeDomain: out}), nil
+}
+
+// BridgeDomainList lists all named BDs with their members
+func (s *BridgeDomainServer) BridgeDomainList(
+	ctx context.Context,
+	req *connect.Request[v1.BridgeDomainListRequest],
+) (*connect.Response[v1.BridgeDomainListResponse], error) {
+	bds := s.resMgr.ListBridgeDomains()
+	sort.Slice(bds, func(i, j int) bool { return bds[i].BdID < bds[j].BdID })
+
+	resp := &v1.BridgeDomainListResponse{
+		BridgeDomains: make([]*v1.BridgeDomain, 0, len(bds)),
+	}
+	for _, bd := range bds {
+		out, err := s.assembleBridgeDomain(ctx, bd)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		resp.BridgeDomains = append(resp.BridgeDomains, out)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// bindBridgeDomain validates a BD and hands its bd_id (and bridge) down to
+// every member. A member that names a different bd_id is rejected.
+func bindBridgeDomain(bd *v1.BridgeDomain) error {
+	if bd.Name == "" {
+		return errors.New("name is required")
+	}
+	if bd.BdId == 0 || bd.BdId > 0xFFFF {
+		return fmt.Errorf("bd_id must be 1-65535, got %d", bd.BdId)
+	}
+	bind := func(what string, id *uint32) error {
+		if *id != 0 && *id != bd.BdId {
+			return fmt.Errorf("%s has bd_id %d, but the bridge domain is %d", what, *id, bd.BdId)
+		}
+		*id = bd.BdId
+		return nil
+	}
+
+	bridgeName := ""
+	if bd.Bridge != nil {
+		if bd.Bridge.Name == "" {
+			return errors.New("bridge: name is required")
+		}
+		if err := bind("bridge "+bd.Bridge.Name, &bd.Bridge.BdId); err != nil {
+			return err
+		}
+		bridgeName = bd.Bridge.Name
+	}
+
+	acs := make(map[string]bool, len(bd.Acs))
+	for _, ac := range bd.Acs {
+		target := headendL2Trigger(ac.InterfaceName, ac.VlanId, 0, ac.PortMode)
+		if err := bind("AC "+target, &ac.BdId); err != nil {
+			return err
+		}
+		acs[target] = true
+	}
+
+	for _, sf := range bd.Sids {
+		switch sf.Action {
+		case v1.Srv6LocalAction_SRV6_LOCAL_ACTION_END_DT2,
+			v1.Srv6LocalAction_SRV6_LOCAL_ACTION_END_DT2M:
+		default:
+			return fmt.Errorf("SID %s: only End.DT2 and End.DT2M belong to a bridge domain, got %v",
+				sf.TriggerPrefix, sf.Action)
+		}
+		if err := bind("SID "+sf.TriggerPrefix, &sf.BdId); err != nil {
+			return err
+		}
+		switch {
+		case sf.BridgeName == "":
+			sf.BridgeName = bridgeName
+		case sf.BridgeName != bridgeName:
+			return fmt.Errorf("SID %s: bridge_name %q is not the bridge of the bridge domain",
+				sf.TriggerPrefix, sf.BridgeName)
+		}
+	}
+
+	for _, peer := range bd.Peers {
+		if err := bind("peer "+peer.SrcAddr, &peer.BdId); err != nil {
+			return err
+		}
+	}
+
+	for _, limit := range bd.MacLimits {
+		if limit.InterfaceName == "" {
+			if err := bind("BD MAC limit", &limit.BdId); err != nil {
+				return err
+			}
+			continue
+		}
+		target := headendL2Trigger(limit.InterfaceName, limit.VlanId, 0, limit.PortMode)
+		if !acs[target] {
+			return fmt.Errorf("MAC limit for %s: not an AC of the bridge domain", target)
+		}
+		if err := bind("MAC limit "+target, &limit.BdId); err != nil {
+			return err
+		}
+	}
+
+	for _, sc := range bd.StormControls {
+		if sc.InterfaceName == "" {
+			if err := bind("BD storm control", &sc.BdId); err != nil {
+				return err
+			}
+			continue
+		}
+		target := headendL2Trigger(sc.InterfaceName, sc.VlanId, 0, sc.PortMode)
+		if !acs[target] {
+			return fmt.Errorf("storm control for %s: not an AC of the bridge domain", target)
+		}
+		if err := bind("storm control "+target, &sc.BdId); err != nil {
+			return err
+		}
+	}
+
+	for _, n := range bd.Neighbors {
+		if err := bind("neighbor "+n.Ip, &n.BdId); err != nil {
+			return err
+		}
+	}
+
+	if bd.Irb != nil {
+		if err := validateIrb(bd.Irb, bridgeName); err != nil {
+			return fmt.Errorf("irb: %w", err)
+		}
+	}
+
+	bd.FdbEntries = 0
+	return nil
+}
+
+// validateIrb checks an IRB binding. The bridge is required: it holds the
+// gateway addresses inside the VRF for traffic the kernel routes.
+func validateIrb(irb *v1.Irb, bridgeName string) error {
+	if bridgeName == "" {
+		return errors.New("requires a bridge")
+	}
+	if irb.VrfName == "" {
+		return errors.New("vrf_name is required")
+	}
+	mac, err := net.ParseMAC(irb.GatewayMac)
+	if err != nil || len(mac) != 6 {
+		return fmt.Errorf("invalid gateway_mac %q", irb.GatewayMac)
+	}
+	if mac[0]&0x01 != 0 {
+		return fmt.Errorf("gateway_mac %s is not unicast", mac)
+	}
+	for _, cidr := range irb.GatewayIps {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			return fmt.Errorf("gateway_ips: %q is not an address with prefix length", cidr)
+		}
+	}
+	return nil
+}
+
+// createBridgeDomain creates a BD's members in dependency order: ESs,
+// bridge, SIDs, MAC limits (before anything can learn), neighbors (before
+// anything can ask), IRB, ACs, peers.
+func (s *BridgeDomainServer) createBridgeDomain(ctx context.Context, bd *v1.BridgeDomain) (err error) {
+	if err := bindBridgeDomain(bd); err != nil {
+		return err
+	}
+	if err := s.checkBridgeDomainFree(bd); err != nil {
+		return err
+	}
+
+	var undo []func()
+	defer func() {
+		if err != nil {
+			for i := len(undo) - 1; i >= 0; i-- {
+				undo[i]()
+			}
+		}
+	}()
+
+	managed := netresource.ManagedBridgeDomain{Name: bd.Name, BdID: uint16(bd.BdId)}
+
+	if len(bd.EthernetSegments) > 0 {
+		resp, err := s.es.EsCreate(ctx, connect.NewRequest(&v1.EsCreateRequest{Entries: bd.EthernetSegments}))
+		if err != nil {
+			return err
+		}
+		for _, e := range resp.Msg.Created {
+			managed.ESIs = append(managed.ESIs, e.Esi)
+			if esi, err := bpf.ParseESI(e.Esi); err == nil {
+				undo = append(undo, func() { _ = s.mapOps.DeleteEsi(esi) })
+			}
+		}
+		if err := firstOperationError(resp.Msg.Errors); err != nil {
+			return fmt.Errorf("ethernet segment %w", err)
+		}
+	}
+
+	if bd.Bridge != nil {
+		_, lookupErr := resolveIfindex(bd.Bridge.Name)
+		resp, err := s.netRes.BridgeCreate(ctx, connect.NewRequest(&v1.BridgeCreateRequest{Bridges: []*v1.Bridge{bd.Bridge}}))
+		if err != nil {
+			return err
+		}
+		if err := firstOperationError(resp.Msg.Errors); err != nil {
+			return fmt.Errorf("bridge %w", err)
+		}
+		if lookupErr != nil { // created here, so removed again on failure
+			name := bd.Bridge.Name
+			undo = append(undo, func() { _ = s.netRes.deleteBridge(name) })
+		}
+		managed.Bridge = bd.Bridge.Name
+	}
+
+	if len(bd.Sids) > 0 {
+		resp, err := s.sids.SidFunctionCreate(ctx, connect.NewRequest(&v1.SidFunctionCreateRequest{SidFunctions: bd.Sids}))
+		if err != nil {
+			return err
+		}
+		for _, sf := range resp.Msg.Created {
+			prefix := sf.TriggerPrefix
+			undo = append(undo, func() { _ = s.mapOps.DeleteSidFunction(prefix) })
+		}
+		if err := firstOperationError(resp.Msg.Errors); err != nil {
+			return fmt.Errorf("SID %w", err)
+		}
+	}
+
+	if len(bd.MacLimits) > 0 {
+		resp, err := s.MacLimitSet(ctx, connect.NewRequest(&v1.MacLimitSetRequest{Limits: bd.MacLimits}))
+		if err != nil {
+			return err
+		}
+		for _, limit := range resp.Msg.Set {
+			if key, err := macLimitKey(limit); err == nil {
+				undo = append(undo, func() { _ = s.mapOps.DeleteMacLimit(key) })
+			}
+		}
+		if err := firstOperationError(resp.Msg.Errors); err != nil {
+			return fmt.Errorf("MAC limit %w", err)
+		}
+	}
+
+	if len(bd.StormControls) > 0 {
+		resp, err := s.StormControlSet(ctx, connect.NewRequest(&v1.StormControlSetRequest{StormControls: bd.StormControls}))
+		if err != nil {
+			return err
+		}
+		for _, sc := range resp.Msg.Set {
+			if key, err := stormControlKey(sc); err == nil {
+				undo = append(undo, func() { _ = s.mapOps.DeleteStormControl(key) })
+			}
+		}
+		if err := firstOperationError(resp.Msg.Errors); err != nil {
+			return fmt.Errorf("storm control %w", err)
+		}
+	}
+
+	if len(bd.Neighbors) > 0 {
+		resp, err := s.NeighborCreate(ctx, connect.NewRequest(&v1.NeighborCreateRequest{Neighbors: bd.Neighbors}))
+		if err != nil {
+			return err
+		}
+		for _, n := range resp.Msg.Created {
+			bdID, ip := uint16(n.BdId), net.ParseIP(n.Ip)
+			undo = append(undo, func() { _ = s.mapOps.DeleteNeighbor(bdID, ip) })
+		}
+		if err := firstOperationError(resp.Msg.Errors); err != nil {
+			return fmt.Errorf("neighbor %w", err)
+		}
+	}
+
+	if bd.Irb != nil {
+		irb, err := s.createIrb(bd, &undo)
+		if err != nil {
+			return fmt.Errorf("irb: %w", err)
+		}
+		managed.Irb = irb
+	}
+
+	if len(bd.Acs) > 0 {
+		resp, err := s.acs.HeadendL2Create(ctx, connect.NewRequest(&v1.HeadendL2CreateRequest{HeadendL2S: bd.Acs}))
+		if err != nil {
+			return err
+		}
+		for _, ac := range resp.Msg.Created {
+			if ifindex, err := resolveIfindex(ac.InterfaceName); err == nil {
+				key := bpf.HeadendL2Key{Ifindex: ifindex, VlanId: acVlanID(ac.VlanId, ac.PortMode), InnerVlanId: uint16(ac.InnerVlanId)}
+				undo = append(undo, func() { _ = s.mapOps.DeleteHeadendL2(key) })
+			}
+		}
+		if err := firstOperationError(resp.Msg.Errors); err != nil {
+			return fmt.Errorf("AC %w", err)
+		}
+	}
+
+	if len(bd.Peers) > 0 {
+		before, err := s.bdPeerKeys(uint16(bd.BdId))
+		if err != nil {
+			return err
+		}
+		undo = append(undo, func() {
+			after, err := s.bdPeerKeys(uint16(bd.BdId))
+			if err != nil {
+				return
+			}
+			for key := range after {
+				if !before[key] {
+					_ = s.mapOps.DeleteBdPeer(key.BdId, key.Index)
+				}
+			}
+		})
+		resp, err := s.peers.BdPeerCreate(ctx, connect.NewRequest(&v1.BdPeerCreateRequest{Peers: bd.Peers}))
+		if err != nil {
+			return err
+		}
+		if err := firstOperationError(resp.Msg.Errors); err != nil {
+			return fmt.Errorf("peer %w", err)
+		}
+	}
+
+	return s.resMgr.AddBridgeDomain(managed)
+}
+
+// createIrb binds the BD to its VRF: the bridge becomes the anycast
+// gateway, the gateway addresses are answered from neigh_map, and
+// bd_irb_map is written last so frames are only routed once the rest is
+// in place.
+func (s *BridgeDomainServer) createIrb(bd *v1.BridgeDomain, undo *[]func()) (*netresource.ManagedIrb, error) {
+	vrfIfindex, err := resolveIfindex(bd.Irb.VrfName)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := s.resMgr.GetVrfByName(bd.Irb.VrfName); !ok {
+		return nil, fmt.Errorf("%s is not a VRF managed by vinbero", bd.Irb.VrfName)
+	}
+	mac, _ := net.ParseMAC(bd.Irb.GatewayMac)
+	bdID := uint16(bd.BdId)
+
+	irb := netresource.ManagedIrb{
+		Vrf:        bd.Irb.VrfName,
+		GatewayMAC: mac.String(),
+		GatewayIPs: bd.Irb.GatewayIps,
+	}
+	bridge := bd.Bridge.Name
+	if err := s.resMgr.SetupIrb(bridge, irb); err != nil {
+		return nil, err
+	}
+	*undo = append(*undo, func() { _ = s.resMgr.TeardownIrb(bridge, irb) })
+
+	for _, cidr := range bd.Irb.GatewayIps {
+		ip, _, _ := net.ParseCIDR(cidr)
+		if err := s.mapOps.SetNeighbor(bdID, ip, mac, bpf.NeighOriginStatic); err != nil {
+			return nil, fmt.Errorf("gateway %s: %w", ip, err)
+		}
+		*undo = append(*undo, func() { _ = s.mapOps.DeleteNeighbor(bdID, ip) })
+	}
+
+	if err := s.mapOps.SetBdIrb(bdID, vrfIfindex, mac); err != nil {
+		return nil, err
+	}
+	*undo = append(*undo, func() { _ = s.mapOps.DeleteBdIrb(bdID) })
+	return &irb, nil
+}
+
+// checkBridgeDomainFree rejects a BD whose name or bd_id is taken, whose
+// bridge belongs to another bd_id, or whose ESs, SIDs or ACs are already
+// in the maps, whatever bd_id they carry. Only a bridge already on the
+// BD's bd_id is accepted, since BridgeCreate leaves it as it is.
+func (s *BridgeDomainServer) checkBridgeDomainFree(bd *v1.BridgeDomain) error {
+	for _, other := range s.resMgr.ListBridgeDomains() {
+		if other.Name == bd.Name {
+			return fmt.Errorf("bridge domain %s already exists", bd.Name)
+		}
+		if uint32(other.BdID) == bd.BdId {
+			return fmt.Errorf("bd_id %d is already used by bridge domain %s", bd.BdId, other.Name)
+		}
+	}
+	if bd.Bridge != nil {
+		if br, ok := s.resMgr.GetBridgeByName(bd.Bridge.Name); ok && uint32(br.BdID) != bd.BdId {
+			return fmt.Errorf("bridge %s already belongs to bd_id %d", br.Name, br.BdID)
+		}
+	}
+	for _, e := range bd.EthernetSegments {
+		if esi, err := bpf.ParseESI(e.Esi); err == nil {
+			if _, err := s.mapOps.GetEsi(esi); err == nil {
+				return fmt.Errorf("ethernet segment %s already exists", e.Esi)
+			}
+		}
+	}
+	for _, sf := range bd.Sids {
+		if _, err := s.mapOps.GetSidFunction(sf.TriggerPrefix); err == nil {
+			return fmt.Errorf("SID %s already exists", sf.TriggerPrefix)
+		}
+	}
+	for _, ac := range bd.Acs {
+		ifindex, err := resolveIfindex(ac.InterfaceName)
+		if err != nil {
+			continue // reported by HeadendL2Create
+		}
+		key := bpf.HeadendL2Key{Ifindex: ifindex, VlanId: acVlanID(ac.VlanId, ac.PortMode), InnerVlanId: uint16(ac.InnerVlanId)}
+		if _, err := s.mapOps.GetHeadendL2(key); err == nil {
+			return fmt.Errorf("AC %s already exists", headendL2Trigger(ac.InterfaceName, ac.VlanId, ac.InnerVlanId, ac.PortMode))
+		}
+	}
+	return nil
+}
+
+// deleteBridgeDomain removes a BD and cascades to its members. The BD is
+// only forgotten once every member is gone, so a failed delete can be
+// retried.
+func (s *BridgeDomainServer) deleteBridgeDomain(bd netresource.ManagedBridgeDomain) error {
+	var errs []error
+
+	// Stop routing first. Deleting the bridge below also takes it out of
+	// the VRF together with the gateway addresses.
+	if err := s.mapOps.DeleteBdIrb(bd.BdID); err != nil && !errors.Is(err, ebpf.ErrKeyNotExist) {
+		errs = append(errs, err)
+	}
+	if _, err := s.mapOps.FlushIrbHostRoutes(bd.BdID); err != nil {
+		errs = append(errs, err)
+	}
+
+	sids, err := s.bdSidFunctions(bd.BdID)
+	if err != nil {
+		return err
+	}
+	for prefix := range sids {
+		if err := s.mapOps.DeleteSidFunction(prefix); err != nil {
+			errs = append(errs, fmt.Errorf("SID %s: %w", prefix, err))
+		}
+	}
+
+	acs, err := s.mapOps.ListHeadendL2()
+	if err != nil {
+		return err
+	}
+	for key, entry := range acs {
+		if entry.BdId != bd.BdID {
+			continue
+		}
+		if err := s.mapOps.DeleteMacLimit(bpf.AcMacLimitKey(key.Ifindex, key.VlanId)); err != nil &&
+			!errors.Is(err, ebpf.ErrKeyNotExist) {
+			errs = append(errs, err)
+		}
+		if err := s.mapOps.DeleteStormControl(bpf.AcMacLimitKey(key.Ifindex, key.VlanId)); err != nil &&
+			!errors.Is(err, ebpf.ErrKeyNotExist) {
+			errs = append(errs, err)
+		}
+		if err := s.mapOps.DeleteHeadendL2(key); err != nil {
+			errs = append(errs, fmt.Errorf("AC %s: %w",
+				headendL2Trigger(fmt.Sprintf("ifindex:%d", key.Ifindex), uint32(key.VlanId), uint32(key.InnerVlanId),
+					key.VlanId == bpf.HeadendL2VlanAny), err))
+		}
+	}
+
+	if _, err := s.mapOps.FlushBdPeers(bd.BdID); err != nil {
+		errs = append(errs, err)
+	}
+	if _, err := s.mapOps.FlushFdb(bd.BdID, false); err != nil {
+		errs = append(errs, err)
+	}
+	if _, err := s.mapOps.FlushNeighbors(bd.BdID); err != nil {
+		errs = append(errs, err)
+	}
+	if err := s.mapOps.DeleteMacLimit(bpf.BdMacLimitKey(bd.BdID)); err != nil &&
+		!errors.Is(err, ebpf.ErrKeyNotExist) {
+		errs = append(errs, err)
+	}
+	if err := s.mapOps.DeleteStormControl(bpf.BdMacLimitKey(bd.BdID)); err != nil &&
+		!errors.Is(err, ebpf.ErrKeyNotExist) {
+		errs = append(errs, err)
+	}
+
+	if bd.Bridge != "" {
+		if err := s.netRes.deleteBridge(bd.Bridge); err != nil {
+			errs = append(errs, fmt.Errorf("bridge %s: %w", bd.Bridge, err))
+		}
+	}
+
+	if len(bd.ESIs) > 0 {
+		inUse, err := s.esisInUse()
+		if err != nil {
+			return err
+		}
+		for _, esiStr := range bd.ESIs {
+			esi, err := bpf.ParseESI(esiStr)
+			if err != nil || inUse[esi] {
+				continue
+			}
+			if err := s.mapOps.DeleteEsi(esi); err != nil && !errors.Is(err, ebpf.ErrKeyNotExist) {
+				errs = append(errs, fmt.Errorf("ethernet segment %s: %w", esiStr, err))
+			}
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return err
+	}
+	return s.resMgr.RemoveBridgeDomain(bd.Name)
+}
+
+// ownedBridgeDomains lists the named BDs with only what they own: the
+// bridge, the ESs created with them and the IRB binding. Export carries
+// the other members in the lists of their own services.
+func (s *BridgeDomainServer) ownedBridgeDomains(context.Context) ([]*v1.BridgeDomain, error) {
+	bds := s.resMgr.ListBridgeDomains()
+	out := make([]*v1.BridgeDomain, 0, len(bds))
+	for _, bd := range bds {
+		e := &v1.BridgeDomain{Name: bd.Name, BdId: uint32(bd.BdID)}
+		if bd.Bridge != "" {
+			e.Bridge = &v1.Bridge{Name: bd.Bridge, BdId: uint32(bd.BdID)}
+			if br, ok := s.resMgr.GetBridgeByName(bd.Bridge); ok {
+				e.Bridge.Members = br.Members
+			}
+		}
+		for _, esiStr := range bd.ESIs {
+			esi, err := bpf.ParseESI(esiStr)
+			if err != nil {
+				continue
+			}
+			if entry, err := s.mapOps.GetEsi(esi); err == nil {
+				e.EthernetSegments = append(e.EthernetSegments, s.es.entryToProto(esi, entry))
+			}
+		}
+		if bd.Irb != nil {
+			e.Irb = &v1.Irb{
+				VrfName:    bd.Irb.Vrf,
+				GatewayMac: bd.Irb.GatewayMAC,
+				GatewayIps: bd.Irb.GatewayIPs,
+			}
+		}
+		out = append(out, e)
+	}
+	return out, nil
+}
+
+// assembleBridgeDomain reads a BD and its members back from the data plane
+func (s *BridgeDomainServer) assembleBridgeDomain(ctx context.Context, bd netresource.ManagedBridgeDomain) (*v1.BridgeDomain, error) {
+	out := &v1.BridgeDomain{
+		Name:             bd.Name,
+		BdId:             uint32(bd.BdID),
+		Acs:              make([]*v1.HeadendL2, 0),
+		Sids:             make([]*v1.SidFunction, 0),
+		Peers:            make([]*v1.BdPeer, 0),
+		EthernetSegments: make([]*v1.EthernetSegment, 0),
+		MacLimits:        make([]*v1.MacLimit, 0),
+		Neighbors:        make([]*v1.Neighbor, 0),
+	}
+
+	if bd.Bridge != "" {
+		out.Bridge = &v1.Bridge{Name: bd.Bridge, BdId: uint32(bd.BdID)}
+		if br, ok := s.resMgr.GetBridgeByName(bd.Bridge); ok {
+			out.Bridge.Members = br.Members
+		}
+	}
+
+	esis := make(map[string]bool)
+	for _, esi := range bd.ESIs {
+		esis[esi] = true
+	}
+
+	acs, err := s.mapOps.ListHeadendL2()
+	if err != nil {
+		return nil, err
+	}
+	for key, entry := range acs {
+		if entry.BdId != bd.BdID {
+			continue
+		}
+		ac := s.acs.entryToProto(key, entry)
+		if ac.Esi != "" {
+			esis[ac.Esi] = true
+		}
+		out.Acs = append(out.Acs, ac)
+	}
+	sort.Slice(out.Acs, func(i, j int) bool {
+		if out.Acs[i].InterfaceName != out.Acs[j].InterfaceName {
+			return out.Acs[i].InterfaceName < out.Acs[j].InterfaceName
+		}
+		return out.Acs[i].VlanId < out.Acs[j].VlanId
+	})
+
+	sids, err := s.bdSidFunctions(bd.BdID)
+	if err != nil {
+		return nil, err
+	}
+	for prefix, entry := range sids {
+		out.Sids = append(out.Sids, s.sids.entryToProto(prefix, entry))
+	}
+	sort.Slice(out.Sids, func(i, j int) bool { return out.Sids[i].TriggerPrefix < out.Sids[j].TriggerPrefix })
+
+	peers, err := s.peers.BdPeerList(ctx, connect.NewRequest(&v1.BdPeerListRequest{BdId: uint32(bd.BdID)}))
+	if err != nil {
+		return nil, err
+	}
+	for _, peer := range peers.Msg.Peers {
+		if peer.Esi != "" {
+			esis[peer.Esi] = true
+		}
+		out.Peers = append(out.Peers, peer)
+	}
+	sort.Slice(out.Peers, func(i, j int) bool { return out.Peers[i].SrcAddr < out.Peers[j].SrcAddr })
+
+	for esiStr := range esis {
+		esi, err := bpf.ParseESI(esiStr)
+		if err != nil {
+			continue
+		}
+		entry, err := s.mapOps.GetEsi(esi)
+		if err != nil {
+			continue
+		}
+		out.EthernetSegments = append(out.EthernetSegments, s.es.entryToProto(esi, entry))
+	}
+	sort.Slice(out.EthernetSegments, func(i, j int) bool {
+		return out.EthernetSegments[i].Esi < out.EthernetSegments[j].Esi
+	})
+
+	limits, err := s.MacLimitList(ctx, connect.NewRequest(&v1.MacLimitListRequest{BdId: uint32(bd.BdID)}))
+	if err != nil {
+		return nil, err
+	}
+	out.MacLimits = limits.Msg.Limits
+
+	storm, err := s.StormControlList(ctx, connect.NewRequest(&v1.StormControlListRequest{BdId: uint32(bd.BdID)}))
+	if err != nil {
+		return nil, err
+	}
+	out.StormControls = storm.Msg.StormControls
+
+	neighbors, err := s.NeighborList(ctx, connect.NewRequest(&v1.NeighborListRequest{BdId: uint32(bd.BdID)}))
+	if err != nil {
+		return nil, err
+	}
+	out.Neighbors = neighbors.Msg.Neighbors
+
+	if bd.Irb != nil {
+		out.Irb = &v1.Irb{
+			VrfName:    bd.Irb.Vrf,
+			GatewayMac: bd.Irb.GatewayMAC,
+			GatewayIps: bd.Irb.GatewayIPs,
+		}
+	}
+
+	fdb, err := s.mapOps.ListFdb()
+	if err != nil {
+		return nil, err
+	}
+	for key := range fdb {
+		if key.BdId == bd.BdID {
+			out.FdbEntries++
+		}
+	}
+
+	return out, nil
+}
+
+// bdSidFunctions returns the End.DT2/DT2M SIDs bound to bdID
+func (s *BridgeDomainServer) bdSidFunctions(bdID uint16) (map[string]*bpf.SidFunctionEntry, error) {
+	entries, err := s.mapOps.ListSidFunctions()
+	if err != nil {
+		return nil, fmt.Errorf("list SID functions: %w", err)
+	}
+	result := make(map[string]*bpf.SidFunctionEntry)
+	for prefix, entry := range entries {
+		switch v1.Srv6LocalAction(entry.Action) {
+		case v1.Srv6LocalAction_SRV6_LOCAL_ACTION_END_DT2,
+			v1.Srv6LocalAction_SRV6_LOCAL_ACTION_END_DT2M:
+		default:
+			continue
+		}
+		if entry.AuxIndex == 0 {
+			continue
+		}
+		aux, err := s.mapOps.GetSidAux(uint32(entry.AuxIndex))
+		if err != nil {
+			continue
+		}
+		if id, _ := bpf.SidAuxL2Data(aux); id == bdID {
+			result[prefix] = entry
+		}
+	}
+	return result, nil
+}
+
+func (s *BridgeDomainServer) bdPeerKeys(bdID uint16) (map[bpf.BdPeerKey]bool, error) {
+	entries, err := s.mapOps.ListBdPeers()
+	if err != nil {
+		return nil, err
+	}
+	keys := make(map[bpf.BdPeerKey]bool)
+	for key := range entries {
+		if key.BdId == bdID {
+			keys[key] = true
+		}
+	}
+	return keys, nil
+}
+
+// esisInUse returns the ESIs still referenced by an AC or a BD peer
+func (s *BridgeDomainServer) esisInUse() (map[[bpf.ESILen]byte]bool, error) {
+	inUse := make(map[[bpf.ESILen]byte]bool)
+	acs, err := s.mapOps.ListHeadendL2()
+	if err != nil {
+		return nil, err
+	}
+	for key := range acs {
+		if esi := s.mapOps.GetHeadendL2AC(key).Esi; esi != ([bpf.ESILen]byte{}) {
+			inUse[esi] = true
+		}
+	}
+	peers, err := s.mapOps.ListBdPeerEsi()
+	if err != nil {
+		return nil, err
+	}
+	for _, esi := range peers {
+		inUse[esi] = true
+	}
+	return inUse, nil
+}
+
+// firstOperationError turns the first per-entry error of a member create
+// into an error naming the entry.
+func firstOperationError(errs []*v1.OperationError) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%s: %s", errs[0].TriggerPrefix, errs[0].Reason)
+}
+
+// MacLimitSet installs or replaces the MAC learning controls of BDs and ACs
+func (s *BridgeDomainServer) MacLimitSet(
+	ctx context.Context,
+	req *connect.Request[v1.MacLimitSetRequest],
+) (*connect.Response[v1.MacLimitSetResponse], error) {
+	resp := &v1.MacLimitSetResponse{
+		Set:    make([]*v1.MacLimit, 0),
+		Errors: make([]*v1.OperationError, 0),
+	}
+
+	for _, limit := range req.Msg.Limits {
+		key, err := macLimitKey(limit)
+		if err != nil {
+			resp.Errors = append(resp.Errors, macLimitError(limit, err))
+			continue
+		}
+
+		var action uint8
+		switch limit.LimitAction {
+		case v1.MacLimitAction_MAC_LIMIT_ACTION_UNSPECIFIED, v1.MacLimitAction_MAC_LIMIT_ACTION_DROP:
+			action = bpf.MacLimitActionDrop
+		case v1.MacLimitAction_MAC_LIMIT_ACTION_FLOOD:
+			action = bpf.MacLimitActionFlood
+		default:
+			resp.Errors = append(resp.Errors, macLimitError(limit,
+				fmt.Errorf("unsupported limit_action: %v", limit.LimitAction)))
+			continue
+		}
+
+		entry := &bpf.MacLimitEntry{
+			MaxMacs:     limit.MaxMacs,
+			LimitAction: action,
+		}
+		if limit.LearningDisabled {
+			entry.LearningDisabled = 1
+		}
+		if err := s.mapOps.SetMacLimit(key, entry); err != nil {
+			resp.Errors = append(resp.Errors, macLimitError(limit, err))
+			continue
+		}
+
+		resp.Set = append(resp.Set, limit)
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// MacLimitDelete removes the learning controls of BDs and ACs
+func (s *BridgeDomainServer) MacLimitDelete(
+	ctx context.Context,
+	req *connect.Request[v1.MacLimitDeleteRequest],
+) (*connect.Response[v1.MacLimitDeleteResponse], error) {
+	resp := &v1.MacLimitDeleteResponse{
+		Deleted: make([]*v1.MacLimit, 0),
+		Errors:  make([]*v1.OperationError, 0),
+	}
+
+	for _, limit := range req.Msg.Limits {
+		key, err := macLimitKey(limit)
+		if err != nil {
+			resp.Errors = append(resp.Errors, macLimitError(limit, err))
+			continue
+		}
+		if err := s.mapOps.DeleteMacLimit(key); err != nil {
+			resp.Errors = append(resp.Errors, macLimitError(limit, err))
+			continue
+		}
+		resp.Deleted = append(resp.Deleted, limit)
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// MacLimitList lists configured learning controls together with the current
+// usage of every BD and AC that has learned MACs, configured or not
+func (s *BridgeDomainServer) MacLimitList(
+	ctx context.Context,
+	req *connect.Request[v1.MacLimitListRequest],
+) (*connect.Response[v1.MacLimitListResponse], error) {
+	limits, err := s.mapOps.ListMacLimits()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	counts, err := s.mapOps.MacCounts()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	keys := make(map[bpf.MacLimitKey]struct{}, len(limits)+len(counts))
+	for k := range limits {
+		keys[k] = struct{}{}
+	}
+	for k := range counts {
+		keys[k] = struct{}{}
+	}
+
+	resp := &v1.MacLimitListResponse{
+		Limits: make([]*v1.MacLimit, 0, len(keys)),
+	}
+	for k := range keys {
+		ml := &v1.MacLimit{MacCount: counts[k]}
+		ml.BdId, ml.InterfaceName, ml.VlanId, ml.PortMode = s.bdScopeFromKey(k)
+		if req.Msg.BdId != 0 && ml.BdId != req.Msg.BdId {
+			continue
+		}
+		if lim, ok := limits[k]; ok {
+			ml.Configured = true
+			ml.MaxMacs = lim.MaxMacs
+			ml.LearningDisabled = lim.LearningDisabled != 0
+			ml.LimitAction = v1.MacLimitAction_MAC_LIMIT_ACTION_DROP
+			if lim.LimitAction == bpf.MacLimitActionFlood {
+				ml.LimitAction = v1.MacLimitAction_MAC_LIMIT_ACTION_FLOOD
+			}
+		}
+		resp.Limits = append(resp.Limits, ml)
+	}
+	sort.Slice(resp.Limits, func(i, j int) bool {
+		a, b := resp.Limits[i], resp.Limits[j]
+		if a.BdId != b.BdId {
+			return a.BdId < b.BdId
+		}
+		if a.InterfaceName != b.InterfaceName {
+			return a.InterfaceName < b.InterfaceName
+		}
+		return a.VlanId < b.VlanId
+	})
+
+	return connect.NewResponse(resp), nil
+}
+
+// macLimitKey resolves the scope of a MacLimit: the AC when interface_name
+// is set, otherwise the BD.
+func macLimitKey(limit *v1.MacLimit) (bpf.MacLimitKey, error) {
+	return bdScopeKey(limit.BdId, limit.InterfaceName, limit.VlanId, limit.PortMode)
+}
+
+func macLimitError(limit *v1.MacLimit, err error) *v1.OperationError {
+	return &v1.OperationError{
+		TriggerPrefix: bdScopeTarget(limit.BdId, limit.InterfaceName, limit.VlanId, limit.PortMode),
+		Reason:        err.Error(),
+	}
+}
+
+// bdScopeKey resolves a per-BD or per-AC setting (MAC limit, storm control)
+// to its mac_limit_map-style key: the AC when ifaceName is set, otherwise
+// the BD.
+func bdScopeKey(bdID uint32, ifaceName string, vlanID uint32, portMode bool) (bpf.MacLimitKey, error) {
+	if ifaceName != "" {
+		if vlanID > 4095 {
+			return bpf.MacLimitKey{}, fmt.Errorf("vlan_id must be 0-4095, got %d", vlanID)
+		}
+		if portMode && vlanID != 0 {
+			return bpf.MacLimitKey{}, errPortModeVlan
+		}
+		ifindex, err := resolveIfindex(ifaceName)
+		if err != nil {
+			return bpf.MacLimitKey{}, err
+		}
+		return bpf.AcMacLimitKey(ifindex, acVlanID(vlanID, portMode)), nil
+	}
+	if bdID == 0 || bdID > 0xFFFF {
+		return bpf.MacLimitKey{}, fmt.Errorf("bd_id must be 1-65535 (or set interface_name for an AC), got %d", bdID)
+	}
+	return bpf.BdMacLimitKey(uint16(bdID)), nil
+}
+
+// bdScopeTarget names a per-BD or per-AC setting in OperationErrors
+func bdScopeTarget(bdID uint32, ifaceName string, vlanID uint32, portMode bool) string {
+	if ifaceName != "" {
+		return headendL2Trigger(ifaceName, vlanID, 0, portMode)
+	}
+	return fmt.Sprintf("bd_%d", bdID)
+}
+
+// bdScopeFromKey is the inverse of bdScopeKey for list output: the BD of an
+// AC key is read from its HeadendL2 entry, and the port-mode wildcard is
+// reported as port_mode with vlan_id 0.
+func (s *BridgeDomainServer) bdScopeFromKey(k bpf.MacLimitKey) (bdID uint32, ifaceName string, vlanID uint32, portMode bool) {
+	bdID, vlanID = uint32(k.BdId), uint32(k.VlanId)
+	if k.Ifindex == 0 {
+		return bdID, "", vlanID, false
+	}
+	if k.VlanId == bpf.HeadendL2VlanAny {
+		vlanID, portMode = 0, true
+	}
+	ifaceName = ifindexToName(k.Ifindex)
+	if ifaceName == "" {
+		ifaceName = fmt.Sprintf("ifindex:%d", k.Ifindex)
+	}
+	if ac, err := s.mapOps.GetHeadendL2(bpf.HeadendL2Key{Ifindex: k.Ifindex, VlanId: k.VlanId}); err == nil {
+		bdID = uint32(ac.BdId)
+	}
+	return bdID, ifaceName, vlanID, portMode
+}
+
+// StormControlSet installs or replaces the BUM rate limits of BDs and ACs.
+// Replacing a limit refills its buckets but keeps its drop counters.
+func (s *BridgeDomainServer) StormControlSet(
+	ctx context.Context,
+	req *connect.Request[v1.StormControlSetRequest],
+) (*connect.Response[v1.StormControlSetResponse], error) {
+	resp := &v1.StormControlSetResponse{
+		Set:    make([]*v1.StormControl, 0),
+		Errors: make([]*v1.OperationError, 0),
+	}
+
+	for _, sc := range req.Msg.StormControls {
+		key, err := stormControlKey(sc)
+		if err != nil {
+			resp.Errors = append(resp.Errors, stormControlError(sc, err))
+			continue
+		}
+		if sc.PacketsPerSec == 0 && sc.BytesPerSec == 0 {
+			resp.Errors = append(resp.Errors, stormControlError(sc,
+				errors.New("set packets_per_sec and/or bytes_per_sec (StormControlDelete removes a limit)")))
+			continue
+		}
+		if err := s.mapOps.SetStormControl(key, sc.PacketsPerSec, sc.BytesPerSec); err != nil {
+			resp.Errors = append(resp.Errors, stormControlError(sc, err))
+			continue
+		}
+		resp.Set = append(resp.Set, sc)
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// StormControlDelete removes the BUM rate limits of BDs and ACs
+func (s *BridgeDomainServer) StormControlDelete(
+	ctx context.Context,
+	req *connect.Request[v1.StormControlDeleteRequest],
+) (*connect.Response[v1.StormControlDeleteResponse], error) {
+	resp := &v1.StormControlDeleteResponse{
+		Deleted: make([]*v1.StormControl, 0),
+		Errors:  make([]*v1.OperationError, 0),
+	}
+
+	for _, sc := range req.Msg.StormControls {
+		key, err := stormControlKey(sc)
+		if err != nil {
+			resp.Errors = append(resp.Errors, stormControlError(sc, err))
+			continue
+		}
+		if err := s.mapOps.DeleteStormControl(key); err != nil {
+			resp.Errors = append(resp.Errors, stormControlError(sc, err))
+			continue
+		}
+		resp.Deleted = append(resp.Deleted, sc)
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// StormControlList lists the configured BUM rate limits with their drop
+// counters
+func (s *BridgeDomainServer) StormControlList(
+	ctx context.Context,
+	req *connect.Request[v1.StormControlListRequest],
+) (*connect.Response[v1.StormControlListResponse], error) {
+	entries, err := s.mapOps.ListStormControls()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := &v1.StormControlListResponse{
+		StormControls: make([]*v1.StormControl, 0, len(entries)),
+	}
+	for k, e := range entries {
+		sc := &v1.StormControl{
+			PacketsPerSec:  e.Pps,
+			BytesPerSec:    e.Bps,
+			DroppedPackets: e.DropPackets,
+			DroppedBytes:   e.DropBytes,
+		}
+		sc.BdId, sc.InterfaceName, sc.VlanId, sc.PortMode = s.bdScopeFromKey(k)
+		if req.Msg.BdId != 0 && sc.BdId != req.Msg.BdId {
+			continue
+		}
+		resp.StormControls = append(resp.StormControls, sc)
+	}
+	sort.Slice(resp.StormControls, func(i, j int) bool {
+		a, b := resp.StormControls[i], resp.StormControls[j]
+		if a.BdId != b.BdId {
+			return a.BdId < b.BdId
+		}
+		if a.InterfaceName != b.InterfaceName {
+			return a.InterfaceName < b.InterfaceName
+		}
+		return a.VlanId < b.VlanId
+	})
+
+	return connect.NewResponse(resp), nil
+}
+
+// stormControlKey resolves the scope of a StormControl: the AC when
+// interface_name is set, otherwise the BD.
+func stormControlKey(sc *v1.StormControl) (bpf.MacLimitKey, error) {
+	return bdScopeKey(sc.BdId, sc.InterfaceName, sc.VlanId, sc.PortMode)
+}
+
+func stormControlError(sc *v1.StormControl, err error) *v1.OperationError {
+	return &v1.OperationError{
+		TriggerPrefix: bdScopeTarget(sc.BdId, sc.InterfaceName, sc.VlanId, sc.PortMode),
+		Reason:        err.Error(),
+	}
+}
+
+// NeighborCreate installs static IP-to-MAC bindings used to answer ARP
+// requests and Neighbor Solicitations from ACs
+func (s *BridgeDomainServer) NeighborCreate(
+	ctx context.Context,
+	req *connect.Request[v1.NeighborCreateRequest],
+) (*connect.Response[v1.NeighborCreateResponse], error) {
+	resp := &v1.NeighborCreateResponse{
+		Created: make([]*v1.Neighbor, 0),
+		Errors:  make([]*v1.OperationError, 0),
+	}
+
+	for _, n := range req.Msg.Neighbors {
+		ip, err := neighborIP(n)
+		if err != nil {
+			resp.Errors = append(resp.Errors, neighborError(n, err))
+			continue
+		}
+		mac, err := net.ParseMAC(n.Mac)
+		if err != nil || len(mac) != 6 {
+			resp.Errors = append(resp.Errors, neighborError(n, fmt.Errorf("invalid MAC address %q", n.Mac)))
+			continue
+		}
+		if mac[0]&0x01 != 0 {
+			resp.Errors = append(resp.Errors, neighborError(n, fmt.Errorf("MAC %s is not unicast", mac)))
+			continue
+		}
+		if err := s.mapOps.SetNeighbor(uint16(n.BdId), ip, mac, bpf.NeighOriginStatic); err != nil {
+			resp.Errors = append(resp.Errors, neighborError(n, err))
+			continue
+		}
+		resp.Created = append(resp.Created, &v1.Neighbor{
+			BdId:   n.BdId,
+			Ip:     ip.String(),
+			Mac:    mac.String(),
+			Origin: v1.NeighborOrigin_NEIGHBOR_ORIGIN_STATIC,
+		})
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// NeighborDelete removes IP-to-MAC bindings of any origin
+func (s *BridgeDomainServer) NeighborDelete(
+	ctx context.Context,
+	req *connect.Request[v1.NeighborDeleteRequest],
+) (*connect.Response[v1.NeighborDeleteResponse], error) {
+	resp := &v1.NeighborDeleteResponse{
+		Deleted: make([]*v1.Neighbor, 0),
+		Errors:  make([]*v1.OperationError, 0),
+	}
+
+	for _, n := range req.Msg.Neighbors {
+		ip, err := neighborIP(n)
+		if err != nil {
+			resp.Errors = append(resp.Errors, neighborError(n, err))
+			continue
+		}
+		if err := s.mapOps.DeleteNeighbor(uint16(n.BdId), ip); err != nil {
+			resp.Errors = append(resp.Errors, neighborError(n, err))
+			continue
+		}
+		resp.Deleted = append(resp.Deleted, n)
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// NeighborList lists the IP-to-MAC bindings of one BD or of all BDs
+func (s *BridgeDomainServer) NeighborList(
+	ctx context.Context,
+	req *connect.Request[v1.NeighborListRequest],
+) (*connect.Response[v1.NeighborListResponse], error) {
+	entries, err := s.mapOps.ListNeighbors()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := &v1.NeighborListResponse{
+		Neighbors: make([]*v1.Neighbor, 0, len(entries)),
+	}
+	for key, entry := range entries {
+		if req.Msg.BdId != 0 && uint32(key.BdId) != req.Msg.BdId {
+			continue
+		}
+		resp.Neighbors = append(resp.Neighbors, &v1.Neighbor{
+			BdId:   uint32(key.BdId),
+			Ip:     bpf.NeighborIP(key).String(),
+			Mac:    net.HardwareAddr(entry.Mac[:]).String(),
+			Origin: neighborOriginToProto(entry.Origin),
+		})
+	}
+	sort.Slice(resp.Neighbors, func(i, j int) bool {
+		a, b := resp.Neighbors[i], resp.Neighbors[j]
+		if a.BdId != b.BdId {
+			return a.BdId < b.BdId
+		}
+		return a.Ip < b.Ip
+	})
+
+	return connect.NewResponse(resp), nil
+}
+
+func neighborIP(n *v1.Neighbor) (net.IP, error) {
+	if n.BdId == 0 || n.BdId > 0xFFFF {
+		return nil, fmt.Errorf("bd_id must be 1-65535, got %d", n.BdId)
+	}
+	ip := net.ParseIP(n.Ip)
+	if ip == nil {
+		return nil, fmt.Errorf("invalid IP address %q", n.Ip)
+	}
+	return ip, nil
+}
+
+func neighborOriginToProto(origin uint8) v1.NeighborOrigin {
+	switch origin {
+	case bpf.NeighOriginSnoop:
+		return v1.NeighborOrigin_NEIGHBOR_ORIGIN_SNOOP
+	case bpf.NeighOriginEvpn:
+		return v1.NeighborOrigin_NEIGHBOR_ORIGIN_EVPN
+	case bpf.NeighOriginStatic:
+		return v1.NeighborOrigin_NEIGHBOR_ORIGIN_STATIC
+	}
+	return v1.NeighborOrigin_NEIGHBOR_ORIGIN_UNSPECIFIED
+}
+
+func neighborError(n *v1.Neighbor, err error) *v1.OperationError {
+	return &v1.OperationError{TriggerPrefix: fmt.Sprintf("bd_%d:%s", n.BdId, n.Ip), Reason: err.Error()}
+}
+
+// IrbRouteCreate installs routes used for traffic routed from IRB bridge
+// domains into a VRF
+func (s *BridgeDomainServer) IrbRouteCreate(
+	ctx context.Context,
+	req *connect.Request[v1.IrbRouteCreateRequest],
+) (*connect.Response[v1.IrbRouteCreateResponse], error) {
+	resp := &v1.IrbRouteCreateResponse{
+		Created: make([]*v1.IrbRoute, 0),
+		Errors:  make([]*v1.OperationError, 0),
+	}
+
+	for _, r := range req.Msg.Routes {
+		vrfIfindex, err := irbRouteVrf(r)
+		if err != nil {
+			resp.Errors = append(resp.Errors, irbRouteError(r, err))
+			continue
+		}
+		entry, err := irbRouteEntry(r)
+		if err != nil {
+			resp.Errors = append(resp.Errors, irbRouteError(r, err))
+			continue
+		}
+		if err := s.mapOps.CreateIrbRoute(vrfIfindex, r.Prefix, entry); err != nil {
+			resp.Errors = append(resp.Errors, irbRouteError(r, err))
+			continue
+		}
+		resp.Created = append(resp.Created, r)
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// IrbRouteDelete removes IRB routes, including host routes installed from
+// EVPN
+func (s *BridgeDomainServer) IrbRouteDelete(
+	ctx context.Context,
+	req *connect.Request[v1.IrbRouteDeleteRequest],
+) (*connect.Response[v1.IrbRouteDeleteResponse], error) {
+	resp := &v1.IrbRouteDeleteResponse{
+		Deleted: make([]*v1.IrbRoute, 0),
+		Errors:  make([]*v1.OperationError, 0),
+	}
+
+	for _, r := range req.Msg.Routes {
+		vrfIfindex, err := irbRouteVrf(r)
+		if err != nil {
+			resp.Errors = append(resp.Errors, irbRouteError(r, err))
+			continue
+		}
+		if err := s.mapOps.DeleteIrbRoute(vrfIfindex, r.Prefix); err != nil {
+			resp.Errors = append(resp.Errors, irbRouteError(r, err))
+			continue
+		}
+		resp.Deleted = append(resp.Deleted, r)
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// IrbRouteList lists the IRB routes of one VRF or of all VRFs
+func (s *BridgeDomainServer) IrbRouteList(
+	ctx context.Context,
+	req *connect.Request[v1.IrbRouteListRequest],
+) (*connect.Response[v1.IrbRouteListResponse], error) {
+	routes, err := s.mapOps.ListIrbRoutes()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := &v1.IrbRouteListResponse{
+		Routes: make([]*v1.IrbRoute, 0, len(routes)),
+	}
+	for _, r := range routes {
+		vrfName := ifindexToName(r.VrfIfindex)
+		if vrfName == "" {
+			vrfName = fmt.Sprintf("ifindex:%d", r.VrfIfindex)
+		}
+		if req.Msg.VrfName != "" && vrfName != req.Msg.VrfName {
+			continue
+		}
+		resp.Routes = append(resp.Routes, &v1.IrbRoute{
+			VrfName:  vrfName,
+			Prefix:   r.Prefix,
+			SrcAddr:  bpf.FormatIPv6(r.Entry.SrcAddr),
+			Segments: bpf.FormatSegments(r.Entry.Segments, r.Entry.NumSegments),
+			Mode:     v1.Srv6HeadendBehavior(r.Entry.Mode),
+			BdId:     uint32(r.Entry.BdId),
+		})
+	}
+	sort.Slice(resp.Routes, func(i, j int) bool {
+		a, b := resp.Routes[i], resp.Routes[j]
+		if a.VrfName != b.VrfName {
+			return a.VrfName < b.VrfName
+		}
+		return a.Prefix < b.Prefix
+	})
+
+	return connect.NewResponse(resp), nil
+}
+
+func irbRouteVrf(r *v1.IrbRoute) (uint32, error) {
+	if r.VrfName == "" {
+		return 0, errors.New("vrf_name is required")
+	}
+	ifindex, err := resolveIfindex(r.VrfName)
+	if err != nil {
+		return 0, fmt.Errorf("vrf %q: %w", r.VrfName, err)
+	}
+	return ifindex, nil
+}
+
+// irbRouteEntry builds the headend entry of a route. Routed packets are
+// carried whole, so only the L3 encapsulations apply.
+func irbRouteEntry(r *v1.IrbRoute) (*bpf.HeadendEntry, error) {
+	mode := r.Mode
+	switch mode {
+	case v1.Srv6HeadendBehavior_SRV6_HEADEND_BEHAVIOR_UNSPECIFIED:
+		mode = v1.Srv6HeadendBehavior_SRV6_HEADEND_BEHAVIOR_H_ENCAPS
+	case v1.Srv6HeadendBehavior_SRV6_HEADEND_BEHAVIOR_H_ENCAPS,
+		v1.Srv6HeadendBehavior_SRV6_HEADEND_BEHAVIOR_H_ENCAPS_RED:
+	default:
+		return nil, fmt.Errorf("mode must be H_ENCAPS or H_ENCAPS_RED, got %v", r.Mode)
+	}
+	if len(r.Segments) == 0 {
+		return nil, errors.New("at least one segment (the remote End.DT4/DT6 SID) is required")
+	}
+	srcAddr, err := bpf.ParseIPv6(r.SrcAddr)
+	if err != nil {
+		return nil, err
+	}
+	segments, numSegments, err := bpf.ParseSegments(r.Segments)
+	if err != nil {
+		return nil, err
+	}
+	return &bpf.HeadendEntry{
+		Mode:        uint8(mode),
+		NumSegments: numSegments,
+		SrcAddr:     srcAddr,
+		Segments:    segments,
+	}, nil
+}
+
+func irbRouteError(r *v1.IrbRoute, err error) *v1.OperationError {
+	return &v1.OperationError{TriggerPrefix: fmt.Sprintf("%s:%s", r.VrfName, r.Prefix), Reason: err.Error()}
+}
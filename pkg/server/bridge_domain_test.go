@@ -0,0 +1,164 @@
+package server
+
+import (
+	"strings"
+	"testing"
+
+	v1 "github.com/takehaya/vinbero/api/vinbero/v1"
+)
+
+func testBridgeDomain() *v1.BridgeDomain {
+	return &v1.BridgeDomain{
+		Name:   "bd100",
+		BdId:   100,
+		Bridge: &v1.Bridge{Name: "br100"},
+		Acs: []*v1.HeadendL2{
+			{InterfaceName: "eth0", VlanId: 100},
+			{InterfaceName: "eth1", VlanId: 0, BdId: 100},
+		},
+		Sids: []*v1.SidFunction{
+			{TriggerPrefix: "fc00:1::/128", Action: v1.Srv6LocalAction_SRV6_LOCAL_ACTION_END_DT2},
+			{TriggerPrefix: "fc00:1::1/128", Action: v1.Srv6LocalAction_SRV6_LOCAL_ACTION_END_DT2M, BridgeName: "br100"},
+		},
+		Peers: []*v1.BdPeer{{SrcAddr: "fc00:1::1"}},
+		MacLimits: []*v1.MacLimit{
+			{MaxMacs: 100},
+			{InterfaceName: "eth0", VlanId: 100, MaxMacs: 10},
+		},
+		StormControls: []*v1.StormControl{
+			{PacketsPerSec: 10000},
+			{InterfaceName: "eth1", BytesPerSec: 1_000_000},
+		},
+		Neighbors: []*v1.Neighbor{{Ip: "192.0.2.10", Mac: "02:00:00:00:00:10"}},
+		Irb: &v1.Irb{
+			VrfName:    "vrf100",
+			GatewayMac: "02:00:5e:00:01:01",
+			GatewayIps: []string{"192.0.2.1/24", "2001:db8::1/64"},
+		},
+		FdbEntries: 42,
+	}
+}
+
+func TestBindBridgeDomain(t *testing.T) {
+	bd := testBridgeDomain()
+	if err := bindBridgeDomain(bd); err != nil {
+		t.Fatalf("bind: %v", err)
+	}
+
+	if bd.Bridge.BdId != 100 {
+		t.Errorf("bridge bd_id: got %d, want 100", bd.Bridge.BdId)
+	}
+	for _, ac := range bd.Acs {
+		if ac.BdId != 100 {
+			t.Errorf("AC %s bd_id: got %d, want 100", ac.InterfaceName, ac.BdId)
+		}
+	}
+	for _, sf := range bd.Sids {
+		if sf.BdId != 100 || sf.BridgeName != "br100" {
+			t.Errorf("SID %s: got bd_id=%d bridge=%q, want 100/br100", sf.TriggerPrefix, sf.BdId, sf.BridgeName)
+		}
+	}
+	if bd.Peers[0].BdId != 100 {
+		t.Errorf("peer bd_id: got %d, want 100", bd.Peers[0].BdId)
+	}
+	for _, l := range bd.MacLimits {
+		if l.BdId != 100 {
+			t.Errorf("MAC limit %q bd_id: got %d, want 100", l.InterfaceName, l.BdId)
+		}
+	}
+	for _, sc := range bd.StormControls {
+		if sc.BdId != 100 {
+			t.Errorf("storm control %q bd_id: got %d, want 100", sc.InterfaceName, sc.BdId)
+		}
+	}
+	if bd.Neighbors[0].BdId != 100 {
+		t.Errorf("neighbor bd_id: got %d, want 100", bd.Neighbors[0].BdId)
+	}
+	if bd.FdbEntries != 0 {
+		t.Errorf("fdb_entries is output only, got %d", bd.FdbEntries)
+	}
+}
+
+func TestBindBridgeDomainRejects(t *testing.T) {
+	cases := []struct {
+		name   string
+		modify func(bd *v1.BridgeDomain)
+		want   string
+	}{
+		{"no_name", func(bd *v1.BridgeDomain) { bd.Name = "" }, "name is required"},
+		{"bd_id_zero", func(bd *v1.BridgeDomain) { bd.BdId = 0 }, "bd_id must be"},
+		{"bd_id_too_large", func(bd *v1.BridgeDomain) { bd.BdId = 70000 }, "bd_id must be"},
+		{"bridge_mismatch", func(bd *v1.BridgeDomain) { bd.Bridge.BdId = 200 }, "bridge br100 has bd_id 200"},
+		{"ac_mismatch", func(bd *v1.BridgeDomain) { bd.Acs[0].BdId = 200 }, "AC eth0:vlan_100 has bd_id 200"},
+		{"sid_mismatch", func(bd *v1.BridgeDomain) { bd.Sids[0].BdId = 200 }, "SID fc00:1::/128 has bd_id 200"},
+		{"sid_action", func(bd *v1.BridgeDomain) {
+			bd.Sids[0].Action = v1.Srv6LocalAction_SRV6_LOCAL_ACTION_END_DT4
+		}, "only End.DT2 and End.DT2M"},
+		{"sid_other_bridge", func(bd *v1.BridgeDomain) { bd.Sids[1].BridgeName = "br200" }, "is not the bridge"},
+		{"peer_mismatch", func(bd *v1.BridgeDomain) { bd.Peers[0].BdId = 200 }, "peer fc00:1::1 has bd_id 200"},
+		{"limit_mismatch", func(bd *v1.BridgeDomain) { bd.MacLimits[0].BdId = 200 }, "BD MAC limit has bd_id 200"},
+		{"neighbor_mismatch", func(bd *v1.BridgeDomain) { bd.Neighbors[0].BdId = 200 }, "neighbor 192.0.2.10 has bd_id 200"},
+		{"limit_foreign_ac", func(bd *v1.BridgeDomain) { bd.MacLimits[1].VlanId = 300 }, "not an AC of the bridge domain"},
+		{"storm_mismatch", func(bd *v1.BridgeDomain) { bd.StormControls[0].BdId = 200 }, "BD storm control has bd_id 200"},
+		{"storm_foreign_ac", func(bd *v1.BridgeDomain) { bd.StormControls[1].PortMode = true }, "not an AC of the bridge domain"},
+		{"irb_no_bridge", func(bd *v1.BridgeDomain) {
+			bd.Bridge = nil
+			bd.Sids = nil
+		}, "irb: requires a bridge"},
+		{"irb_no_vrf", func(bd *v1.BridgeDomain) { bd.Irb.VrfName = "" }, "irb: vrf_name is required"},
+		{"irb_bad_mac", func(bd *v1.BridgeDomain) { bd.Irb.GatewayMac = "gw" }, "invalid gateway_mac"},
+		{"irb_multicast_mac", func(bd *v1.BridgeDomain) { bd.Irb.GatewayMac = "01:00:5e:00:00:01" }, "is not unicast"},
+		{"irb_ip_without_len", func(bd *v1.BridgeDomain) { bd.Irb.GatewayIps[0] = "192.0.2.1" }, "not an address with prefix length"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			bd := testBridgeDomain()
+			c.modify(bd)
+			err := bindBridgeDomain(bd)
+			if err == nil {
+				t.Fatal("expected error")
+			}
+			if !strings.Contains(err.Error(), c.want) {
+				t.Errorf("error %q does not contain %q", err, c.want)
+			}
+		})
+	}
+}
+
+func TestIrbRouteEntry(t *testing.T) {
+	entry, err := irbRouteEntry(&v1.IrbRoute{
+		Prefix:   "198.51.100.0/24",
+		SrcAddr:  "fc00:1::1",
+		Segments: []string{"fc00:2::4"},
+	})
+	if err != nil {
+		t.Fatalf("entry: %v", err)
+	}
+	if v1.Srv6HeadendBehavior(entry.Mode) != v1.Srv6HeadendBehavior_SRV6_HEADEND_BEHAVIOR_H_ENCAPS || entry.NumSegments != 1 {
+		t.Errorf("got mode=%d segments=%d, want H_ENCAPS with 1 segment", entry.Mode, entry.NumSegments)
+	}
+
+	rejects := []struct {
+		name  string
+		route *v1.IrbRoute
+		want  string
+	}{
+		{"l2_mode", &v1.IrbRoute{
+			Segments: []string{"fc00:2::4"},
+			Mode:     v1.Srv6HeadendBehavior_SRV6_HEADEND_BEHAVIOR_H_ENCAPS_L2,
+		}, "mode must be H_ENCAPS or H_ENCAPS_RED"},
+		{"no_segments", &v1.IrbRoute{}, "at least one segment"},
+		{"bad_segment", &v1.IrbRoute{Segments: []string{"fc00:2::zz"}}, "invalid segment 0"},
+	}
+	for _, c := range rejects {
+		t.Run(c.name, func(t *testing.T) {
+			_, err := irbRouteEntry(c.route)
+			if err == nil {
+				t.Fatal("expected error")
+			}
+			if !strings.Contains(err.Error(), c.want) {
+				t.Errorf("error %q does not contain %q", err, c.want)
+			}
+		})
+	}
+}
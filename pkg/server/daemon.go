@@ -0,0 +1,32 @@
+package server
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	v1 "github.com/takehaya/vinbero/api/vinbero/v1"
+)
+
+// DaemonServer implements the DaemonServiceHandler interface
+type DaemonServer struct {
+	reload func(context.Context) (*v1.ReloadResponse, error)
+}
+
+// NewDaemonServer creates a new DaemonServer. reload is Server.Reload,
+// shared with the SIGHUP handler of vinberod.
+func NewDaemonServer(reload func(context.Context) (*v1.ReloadResponse, error)) *DaemonServer {
+	return &DaemonServer{reload: reload}
+}
+
+// Reload re-reads the config file and applies the config: section diff.
+// A file that fails to load leaves the running state untouched.
+func (s *DaemonServer) Reload(
+	ctx context.Context,
+	req *connect.Request[v1.ReloadRequest],
+) (*connect.Response[v1.ReloadResponse], error) {
+	resp, err := s.reload(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	return connect.NewResponse(resp), nil
+}
@@ -86,6 +86,48 @@ func (s *EthernetSegmentServer) EsCreate(
 	return connect.NewResponse(resp), nil
 }
 
+// EsUpdate changes installed Ethernet Segments in place; ACs and peers
+// naming the ESI keep resolving it throughout.
+func (s *EthernetSegmentServer) EsUpdate(
+	ctx context.Context,
+	req *connect.Request[v1.EsUpdateRequest],
+) (*connect.Response[v1.EsUpdateResponse], error) {
+	resp := &v1.EsUpdateResponse{
+		Updated: make([]*v1.EthernetSegment, 0),
+		Errors:  make([]*v1.OperationError, 0),
+	}
+
+	for _, e := range req.Msg.Entries {
+		esi, err := bpf.ParseESI(e.Esi)
+		if err != nil {
+			resp.Errors = append(resp.Errors, &v1.OperationError{TriggerPrefix: e.Esi, Reason: err.Error()})
+			continue
+		}
+		current, err := s.mapOps.GetEsi(esi)
+		if err != nil {
+			resp.Errors = append(resp.Errors, &v1.OperationError{TriggerPrefix: e.Esi, Reason: fmt.Sprintf("not found: %v", err)})
+			continue
+		}
+		merged, err := mergeUpdate(s.entryToProto(esi, current), e, req.Msg.UpdateMask, "esi")
+		if err != nil {
+			resp.Errors = append(resp.Errors, &v1.OperationError{TriggerPrefix: e.Esi, Reason: err.Error()})
+			continue
+		}
+		cfg, err := protoToEsiCfg(merged)
+		if err != nil {
+			resp.Errors = append(resp.Errors, &v1.OperationError{TriggerPrefix: e.Esi, Reason: err.Error()})
+			continue
+		}
+		if err := s.mapOps.CreateEsi(esi, bpf.NewEsiEntry(cfg)); err != nil {
+			resp.Errors = append(resp.Errors, &v1.OperationError{TriggerPrefix: e.Esi, Reason: err.Error()})
+			continue
+		}
+		resp.Updated = append(resp.Updated, merged)
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
 func (s *EthernetSegmentServer) EsDelete(
 	ctx context.Context,
 	req *connect.Request[v1.EsDeleteRequest],
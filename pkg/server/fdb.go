@@ -43,6 +43,19 @@ func (s *FdbServer) FdbList(
 		}
 		if entry.IsRemote != 0 {
 			fdbEntry.Esi = bpf.FormatESI(entry.Esi)
+			if entry.Vtep != 0 {
+				if ip, err := s.mapOps.GetVtep(key.BdId, entry.PeerIndex); err == nil {
+					fdbEntry.Vtep = ip.String()
+				}
+			}
+		} else {
+			fdbEntry.VlanId = uint32(entry.AcVlanId)
+			if entry.AcVlanId == bpf.HeadendL2VlanAny {
+				fdbEntry.VlanId, fdbEntry.PortMode = 0, true
+			}
+			fdbEntry.InnerVlanId = uint32(entry.AcInnerVlanId)
+			fdbEntry.VlanRewrite = vlanRewriteToProto(entry.Rewrite)
+			fdbEntry.IsUntag = entry.Rewrite.Op == bpf.VlanRewritePop
 		}
 		resp.Entries = append(resp.Entries, fdbEntry)
 	}
@@ -58,9 +71,22 @@ func (s *FdbServer) FdbCreate(
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid MAC: %w", err))
 	}
+	if err := validateAcVlans(req.Msg.VlanId, req.Msg.InnerVlanId, 0); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if req.Msg.PortMode && (req.Msg.VlanId != 0 || req.Msg.InnerVlanId != 0) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errPortModeVlan)
+	}
+	rewrite, err := vlanRewriteFromProto(req.Msg.VlanRewrite)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("vlan_rewrite: %w", err))
+	}
 	entry := &bpf.FdbEntry{
-		Oif:      req.Msg.Oif,
-		IsStatic: 1,
+		Oif:           req.Msg.Oif,
+		IsStatic:      1,
+		AcVlanId:      acVlanID(req.Msg.VlanId, req.Msg.PortMode),
+		AcInnerVlanId: uint16(req.Msg.InnerVlanId),
+		Rewrite:       rewrite,
 	}
 	if err := s.mapOps.CreateFdb(uint16(req.Msg.BdId), mac, entry); err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
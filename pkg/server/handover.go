@@ -0,0 +1,81 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"connectrpc.com/connect"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	v1 "github.com/takehaya/vinbero/api/vinbero/v1"
+)
+
+// SaveHandover writes the registered plugins to path for the daemon that
+// takes over this one's links: its PROG_ARRAYs start empty and have to be
+// refilled before it swaps its program in.
+func (s *Server) SaveHandover(path string) error {
+	s.Setup()
+	return writeHandover(path, &v1.NodeConfig{Plugins: s.plugin.Registered()})
+}
+
+// LoadHandover registers the plugins a previous daemon left in path with
+// SaveHandover, then removes the file. A missing file means there was no
+// hand-over. Registrations don't go through the store: a journaled plugin
+// is replayed on its own.
+func (s *Server) LoadHandover(ctx context.Context, path string) error {
+	nc, err := readHandover(path)
+	if err != nil || nc == nil {
+		return err
+	}
+	s.Setup()
+
+	var errs []error
+	for _, p := range nc.Plugins {
+		if _, err := s.plugin.PluginRegister(ctx, connect.NewRequest(p)); err != nil {
+			errs = append(errs, fmt.Errorf("plugin %s[%d] %s: %w", p.MapType, p.Index, p.Program, err))
+		}
+	}
+	s.logger.Info("Registered handed-over plugins",
+		zap.Int("plugins", len(nc.Plugins)), zap.Int("failed", len(errs)))
+	if err := os.Remove(path); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// writeHandover writes nc to path through a temporary file, so a crash
+// leaves the old file or the new one.
+func writeHandover(path string, nc *v1.NodeConfig) error {
+	data, err := protojson.Marshal(nc)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// readHandover returns the document in path, or nil if there is none.
+func readHandover(path string) (*v1.NodeConfig, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	nc := &v1.NodeConfig{}
+	if err := protojson.Unmarshal(data, nc); err != nil {
+		return nil, fmt.Errorf("hand-over %s: %w", path, err)
+	}
+	return nc, nil
+}
@@ -0,0 +1,36 @@
+package server
+
+import (
+	"path/filepath"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	v1 "github.com/takehaya/vinbero/api/vinbero/v1"
+)
+
+func TestHandoverRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state", "handover.json")
+
+	nc, err := readHandover(path)
+	if err != nil || nc != nil {
+		t.Fatalf("missing file: got %v, %v; want nil, nil", nc, err)
+	}
+
+	want := &v1.NodeConfig{Plugins: []*v1.PluginRegisterRequest{{
+		MapType: "endpoint",
+		Index:   32,
+		BpfElf:  []byte{0x7f, 'E', 'L', 'F'},
+		Program: "plugin_counter",
+	}}}
+	if err := writeHandover(path, want); err != nil {
+		t.Fatal(err)
+	}
+	got, err := readHandover(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
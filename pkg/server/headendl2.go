@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 
@@ -49,10 +50,11 @@ func (s *HeadendL2Server) HeadendL2Create(
 	}
 
 	for _, headend := range req.Msg.HeadendL2S {
-		ifindex, err := resolveIfindex(headend.InterfaceName)
+		trigger := headendL2Trigger(headend.InterfaceName, headend.VlanId, headend.InnerVlanId, headend.PortMode)
+		ac, err := headendL2AC(headend)
 		if err != nil {
 			resp.Errors = append(resp.Errors, &v1.OperationError{
-				TriggerPrefix: fmt.Sprintf("%s:vlan_%d", headend.InterfaceName, headend.VlanId),
+				TriggerPrefix: trigger,
 				Reason:        err.Error(),
 			})
 			continue
@@ -61,30 +63,100 @@ func (s *HeadendL2Server) HeadendL2Create(
 		entry, err := s.protoToEntry(headend)
 		if err != nil {
 			resp.Errors = append(resp.Errors, &v1.OperationError{
-				TriggerPrefix: fmt.Sprintf("%s:vlan_%d", headend.InterfaceName, headend.VlanId),
+				TriggerPrefix: trigger,
+				Reason:        err.Error(),
+			})
+			continue
+		}
+
+		if err := s.mapOps.CreateHeadendL2(ac, entry); err != nil {
+			resp.Errors = append(resp.Errors, &v1.OperationError{
+				TriggerPrefix: trigger,
 				Reason:        err.Error(),
 			})
 			continue
 		}
 
-		esi, err := bpf.ParseESI(headend.Esi)
+		resp.Created = append(resp.Created, headend)
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// HeadendL2Update changes installed Headend L2 entries in place. A range
+// AC keeps its range slot, so its end can move.
+func (s *HeadendL2Server) HeadendL2Update(
+	ctx context.Context,
+	req *connect.Request[v1.HeadendL2UpdateRequest],
+) (*connect.Response[v1.HeadendL2UpdateResponse], error) {
+	resp := &v1.HeadendL2UpdateResponse{
+		Updated: make([]*v1.HeadendL2, 0),
+		Errors:  make([]*v1.OperationError, 0),
+	}
+
+	for _, headend := range req.Msg.HeadendL2S {
+		trigger := headendL2Trigger(headend.InterfaceName, headend.VlanId, headend.InnerVlanId, headend.PortMode)
+		ifindex, err := resolveIfindex(headend.InterfaceName)
+		if err == nil && headend.PortMode && (headend.VlanId != 0 || headend.InnerVlanId != 0) {
+			err = errPortModeVlan
+		}
 		if err != nil {
 			resp.Errors = append(resp.Errors, &v1.OperationError{
-				TriggerPrefix: fmt.Sprintf("%s:vlan_%d", headend.InterfaceName, headend.VlanId),
+				TriggerPrefix: trigger,
 				Reason:        err.Error(),
 			})
 			continue
 		}
 
-		if err := s.mapOps.CreateHeadendL2(ifindex, uint16(headend.VlanId), entry, esi); err != nil {
+		key := bpf.HeadendL2Key{
+			Ifindex:     ifindex,
+			VlanId:      acVlanID(headend.VlanId, headend.PortMode),
+			InnerVlanId: uint16(headend.InnerVlanId),
+		}
+		current, err := s.mapOps.GetHeadendL2(key)
+		if err != nil {
+			resp.Errors = append(resp.Errors, &v1.OperationError{
+				TriggerPrefix: trigger,
+				Reason:        fmt.Sprintf("not found: %v", err),
+			})
+			continue
+		}
+		merged, err := mergeUpdate(s.entryToProto(key, current), headend, req.Msg.UpdateMask,
+			"interface_name", "vlan_id", "inner_vlan_id", "port_mode")
+		if err != nil {
 			resp.Errors = append(resp.Errors, &v1.OperationError{
-				TriggerPrefix: fmt.Sprintf("%s:vlan_%d", headend.InterfaceName, headend.VlanId),
+				TriggerPrefix: trigger,
 				Reason:        err.Error(),
 			})
 			continue
 		}
+		// entryToProto names the interface as the kernel does
+		merged.InterfaceName = headend.InterfaceName
 
-		resp.Created = append(resp.Created, headend)
+		ac, err := headendL2AC(merged)
+		if err != nil {
+			resp.Errors = append(resp.Errors, &v1.OperationError{
+				TriggerPrefix: trigger,
+				Reason:        err.Error(),
+			})
+			continue
+		}
+		entry, err := s.protoToEntry(merged)
+		if err != nil {
+			resp.Errors = append(resp.Errors, &v1.OperationError{
+				TriggerPrefix: trigger,
+				Reason:        err.Error(),
+			})
+			continue
+		}
+		if err := s.mapOps.CreateHeadendL2(ac, entry); err != nil {
+			resp.Errors = append(resp.Errors, &v1.OperationError{
+				TriggerPrefix: trigger,
+				Reason:        err.Error(),
+			})
+			continue
+		}
+		resp.Updated = append(resp.Updated, merged)
 	}
 
 	return connect.NewResponse(resp), nil
@@ -101,18 +173,27 @@ func (s *HeadendL2Server) HeadendL2Delete(
 	}
 
 	for _, target := range req.Msg.Targets {
+		trigger := headendL2Trigger(target.InterfaceName, target.VlanId, target.InnerVlanId, target.PortMode)
 		ifindex, err := resolveIfindex(target.InterfaceName)
+		if err == nil && target.PortMode && (target.VlanId != 0 || target.InnerVlanId != 0) {
+			err = errPortModeVlan
+		}
 		if err != nil {
 			resp.Errors = append(resp.Errors, &v1.OperationError{
-				TriggerPrefix: fmt.Sprintf("%s:vlan_%d", target.InterfaceName, target.VlanId),
+				TriggerPrefix: trigger,
 				Reason:        err.Error(),
 			})
 			continue
 		}
 
-		if err := s.mapOps.DeleteHeadendL2(ifindex, uint16(target.VlanId)); err != nil {
+		key := bpf.HeadendL2Key{
+			Ifindex:     ifindex,
+			VlanId:      acVlanID(target.VlanId, target.PortMode),
+			InnerVlanId: uint16(target.InnerVlanId),
+		}
+		if err := s.mapOps.DeleteHeadendL2(key); err != nil {
 			resp.Errors = append(resp.Errors, &v1.OperationError{
-				TriggerPrefix: fmt.Sprintf("%s:vlan_%d", target.InterfaceName, target.VlanId),
+				TriggerPrefix: trigger,
 				Reason:        err.Error(),
 			})
 			continue
@@ -168,12 +249,20 @@ func (s *HeadendL2Server) HeadendL2Get(
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	entry, err := s.mapOps.GetHeadendL2(ifindex, uint16(req.Msg.VlanId))
+	if req.Msg.PortMode && (req.Msg.VlanId != 0 || req.Msg.InnerVlanId != 0) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errPortModeVlan)
+	}
+
+	key := bpf.HeadendL2Key{
+		Ifindex:     ifindex,
+		VlanId:      acVlanID(req.Msg.VlanId, req.Msg.PortMode),
+		InnerVlanId: uint16(req.Msg.InnerVlanId),
+	}
+	entry, err := s.mapOps.GetHeadendL2(key)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeNotFound, err)
 	}
 
-	key := bpf.HeadendL2Key{Ifindex: ifindex, VlanId: uint16(req.Msg.VlanId)}
 	resp := &v1.HeadendL2GetResponse{
 		HeadendL2: s.entryToProto(key, entry),
 	}
@@ -181,6 +270,128 @@ func (s *HeadendL2Server) HeadendL2Get(
 	return connect.NewResponse(resp), nil
 }
 
+// headendL2Trigger names an AC in OperationError.TriggerPrefix
+func headendL2Trigger(ifaceName string, vlanID, innerVlanID uint32, portMode bool) string {
+	if portMode {
+		return ifaceName + ":port"
+	}
+	if innerVlanID != 0 {
+		return fmt.Sprintf("%s:vlan_%d.%d", ifaceName, vlanID, innerVlanID)
+	}
+	return fmt.Sprintf("%s:vlan_%d", ifaceName, vlanID)
+}
+
+// acVlanID returns the headend_l2_map vlan_id of an AC: the wildcard
+// for a port-mode AC.
+func acVlanID(vlanID uint32, portMode bool) uint16 {
+	if portMode {
+		return bpf.HeadendL2VlanAny
+	}
+	return uint16(vlanID)
+}
+
+var errPortModeVlan = errors.New("port_mode takes no VLAN IDs")
+
+// headendL2AC validates the VLAN matching, ESI and egress rewrite of an AC.
+func headendL2AC(headend *v1.HeadendL2) (bpf.HeadendL2AC, error) {
+	if headend.PortMode && (headend.VlanId != 0 || headend.InnerVlanId != 0 || headend.VlanIdEnd != 0) {
+		return bpf.HeadendL2AC{}, errPortModeVlan
+	}
+	if err := validateAcVlans(headend.VlanId, headend.InnerVlanId, headend.VlanIdEnd); err != nil {
+		return bpf.HeadendL2AC{}, err
+	}
+	ifindex, err := resolveIfindex(headend.InterfaceName)
+	if err != nil {
+		return bpf.HeadendL2AC{}, err
+	}
+	esi, err := bpf.ParseESI(headend.Esi)
+	if err != nil {
+		return bpf.HeadendL2AC{}, err
+	}
+	rewrite, err := vlanRewriteFromProto(headend.EgressVlanRewrite)
+	if err != nil {
+		return bpf.HeadendL2AC{}, fmt.Errorf("egress_vlan_rewrite: %w", err)
+	}
+	return bpf.HeadendL2AC{
+		Ifindex:       ifindex,
+		VlanID:        acVlanID(headend.VlanId, headend.PortMode),
+		InnerVlanID:   uint16(headend.InnerVlanId),
+		VlanIDEnd:     uint16(headend.VlanIdEnd),
+		Esi:           esi,
+		EgressRewrite: rewrite,
+	}, nil
+}
+
+// validateAcVlans checks the tags an AC matches: an inner VLAN needs an
+// outer one, and a range needs an outer VLAN below its end and no inner VLAN.
+func validateAcVlans(vlanID, innerVlanID, vlanIDEnd uint32) error {
+	if vlanID > maxVlanID || innerVlanID > maxVlanID || vlanIDEnd > maxVlanID {
+		return fmt.Errorf("VLAN IDs must be at most %d", maxVlanID)
+	}
+	if innerVlanID != 0 && vlanID == 0 {
+		return fmt.Errorf("inner_vlan_id %d requires vlan_id", innerVlanID)
+	}
+	if vlanIDEnd != 0 {
+		if innerVlanID != 0 {
+			return fmt.Errorf("VLAN range cannot match an inner VLAN")
+		}
+		if vlanID == 0 || vlanIDEnd <= vlanID {
+			return fmt.Errorf("vlan_id_end %d must be above vlan_id %d", vlanIDEnd, vlanID)
+		}
+	}
+	return nil
+}
+
+// maxVlanID is the highest usable 802.1Q VLAN ID
+const maxVlanID = 4094
+
+// vlanRewriteFromProto validates an egress VLAN rewrite. nil means none.
+func vlanRewriteFromProto(rw *v1.VlanRewrite) (bpf.VlanRewrite, error) {
+	var out bpf.VlanRewrite
+	if rw == nil {
+		return out, nil
+	}
+	switch rw.Op {
+	case v1.VlanRewriteOp_VLAN_REWRITE_OP_UNSPECIFIED:
+		if rw.VlanId != 0 || rw.InnerVlanId != 0 {
+			return out, fmt.Errorf("op is required when VLAN IDs are set")
+		}
+		return out, nil
+	case v1.VlanRewriteOp_VLAN_REWRITE_OP_POP:
+		if rw.VlanId != 0 || rw.InnerVlanId != 0 {
+			return out, fmt.Errorf("pop takes no VLAN IDs")
+		}
+	case v1.VlanRewriteOp_VLAN_REWRITE_OP_PUSH, v1.VlanRewriteOp_VLAN_REWRITE_OP_TRANSLATE:
+		if rw.VlanId == 0 || rw.VlanId > maxVlanID {
+			return out, fmt.Errorf("vlan_id must be 1-%d", maxVlanID)
+		}
+		if rw.InnerVlanId > maxVlanID {
+			return out, fmt.Errorf("inner_vlan_id must be at most %d", maxVlanID)
+		}
+		if rw.Op == v1.VlanRewriteOp_VLAN_REWRITE_OP_PUSH && rw.InnerVlanId != 0 {
+			return out, fmt.Errorf("push takes no inner_vlan_id")
+		}
+	default:
+		return out, fmt.Errorf("unknown op %d", rw.Op)
+	}
+	out.Op = uint8(rw.Op)
+	out.VlanId = uint16(rw.VlanId)
+	out.InnerVlanId = uint16(rw.InnerVlanId)
+	return out, nil
+}
+
+// vlanRewriteToProto converts a data-plane VLAN rewrite; nil for none.
+func vlanRewriteToProto(rw bpf.VlanRewrite) *v1.VlanRewrite {
+	if rw.Op == bpf.VlanRewriteNone {
+		return nil
+	}
+	return &v1.VlanRewrite{
+		Op:          v1.VlanRewriteOp(rw.Op),
+		VlanId:      uint32(rw.VlanId),
+		InnerVlanId: uint32(rw.InnerVlanId),
+	}
+}
+
 // buildL2HeadendEntry builds a HeadendEntry from L2 headend parameters.
 // Shared by HeadendL2Server and BdPeerServer.
 func buildL2HeadendEntry(srcAddrStr string, segments []string, mode v1.Srv6HeadendBehavior, bdID uint32) (*bpf.HeadendEntry, error) {
@@ -210,7 +421,20 @@ func buildL2HeadendEntry(srcAddrStr string, segments []string, mode v1.Srv6Heade
 
 // protoToEntry converts a protobuf HeadendL2 to a BPF map entry
 func (s *HeadendL2Server) protoToEntry(headend *v1.HeadendL2) (*bpf.HeadendEntry, error) {
-	return buildL2HeadendEntry(headend.SrcAddr, headend.Segments, headend.Mode, headend.BdId)
+	entry, err := buildL2HeadendEntry(headend.SrcAddr, headend.Segments, headend.Mode, headend.BdId)
+	if err != nil {
+		return nil, err
+	}
+	if err := applyEncapFields(entry, encapFields{
+		tcMode:        headend.TcMode,
+		tc:            headend.Tc,
+		hopLimitMode:  headend.HopLimitMode,
+		hopLimit:      headend.HopLimit,
+		flowLabelMode: headend.FlowLabelMode,
+	}); err != nil {
+		return nil, err
+	}
+	return entry, nil
 }
 
 // entryToProto converts a BPF map entry to a protobuf HeadendL2
@@ -223,14 +447,25 @@ func (s *HeadendL2Server) entryToProto(key bpf.HeadendL2Key, entry *bpf.HeadendE
 
 	out := &v1.HeadendL2{
 		VlanId:        uint32(key.VlanId),
+		PortMode:      key.VlanId == bpf.HeadendL2VlanAny,
 		SrcAddr:       bpf.FormatIPv6(entry.SrcAddr),
 		Segments:      bpf.FormatSegments(entry.Segments, entry.NumSegments),
 		BdId:          uint32(entry.BdId),
 		InterfaceName: ifaceName,
 		Mode:          v1.Srv6HeadendBehavior(entry.Mode),
+		TcMode:        v1.Srv6EncapTcMode(entry.TcMode),
+		Tc:            uint32(entry.Tc),
+		HopLimitMode:  v1.Srv6EncapHopLimitMode(entry.HopLimitMode),
+		HopLimit:      uint32(entry.HopLimit),
+		FlowLabelMode: v1.Srv6EncapFlowLabelMode(entry.FlowLabelMode),
+		InnerVlanId:   uint32(key.InnerVlanId),
 	}
-	if esi, err := s.mapOps.GetHeadendL2Esi(key.Ifindex, key.VlanId); err == nil {
-		out.Esi = bpf.FormatESI(esi)
+	if out.PortMode {
+		out.VlanId = 0
 	}
+	ac := s.mapOps.GetHeadendL2AC(key)
+	out.Esi = bpf.FormatESI(ac.Esi)
+	out.VlanIdEnd = uint32(ac.VlanIDEnd)
+	out.EgressVlanRewrite = vlanRewriteToProto(ac.EgressRewrite)
 	return out
 }
@@ -0,0 +1,93 @@
+package server
+
+import (
+	"testing"
+
+	v1 "github.com/takehaya/vinbero/api/vinbero/v1"
+	"github.com/takehaya/vinbero/pkg/bpf"
+)
+
+func TestValidateAcVlans(t *testing.T) {
+	tests := []struct {
+		name             string
+		vlan, inner, end uint32
+		wantErr          bool
+	}{
+		{"untagged", 0, 0, 0, false},
+		{"single VLAN", 100, 0, 0, false},
+		{"Q-in-Q", 100, 10, 0, false},
+		{"range", 100, 0, 199, false},
+		{"inner without outer", 0, 10, 0, true},
+		{"range with inner", 100, 10, 199, true},
+		{"range end below start", 100, 0, 50, true},
+		{"range without start", 0, 0, 50, true},
+		{"VLAN out of range", 4095, 0, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateAcVlans(tt.vlan, tt.inner, tt.end)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateAcVlans(%d, %d, %d) err = %v, wantErr %v", tt.vlan, tt.inner, tt.end, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestVlanRewriteFromProto(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      *v1.VlanRewrite
+		want    bpf.VlanRewrite
+		wantErr bool
+	}{
+		{"nil", nil, bpf.VlanRewrite{}, false},
+		{"unspecified", &v1.VlanRewrite{}, bpf.VlanRewrite{}, false},
+		{"pop", &v1.VlanRewrite{Op: v1.VlanRewriteOp_VLAN_REWRITE_OP_POP},
+			bpf.VlanRewrite{Op: bpf.VlanRewritePop}, false},
+		{"push", &v1.VlanRewrite{Op: v1.VlanRewriteOp_VLAN_REWRITE_OP_PUSH, VlanId: 10},
+			bpf.VlanRewrite{Op: bpf.VlanRewritePush, VlanId: 10}, false},
+		{"translate both tags", &v1.VlanRewrite{Op: v1.VlanRewriteOp_VLAN_REWRITE_OP_TRANSLATE, VlanId: 300, InnerVlanId: 30},
+			bpf.VlanRewrite{Op: bpf.VlanRewriteTranslate, VlanId: 300, InnerVlanId: 30}, false},
+		{"IDs without op", &v1.VlanRewrite{VlanId: 10}, bpf.VlanRewrite{}, true},
+		{"pop with ID", &v1.VlanRewrite{Op: v1.VlanRewriteOp_VLAN_REWRITE_OP_POP, VlanId: 10}, bpf.VlanRewrite{}, true},
+		{"push without ID", &v1.VlanRewrite{Op: v1.VlanRewriteOp_VLAN_REWRITE_OP_PUSH}, bpf.VlanRewrite{}, true},
+		{"push with inner", &v1.VlanRewrite{Op: v1.VlanRewriteOp_VLAN_REWRITE_OP_PUSH, VlanId: 10, InnerVlanId: 20}, bpf.VlanRewrite{}, true},
+		{"translate out of range", &v1.VlanRewrite{Op: v1.VlanRewriteOp_VLAN_REWRITE_OP_TRANSLATE, VlanId: 4095}, bpf.VlanRewrite{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := vlanRewriteFromProto(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+			if !tt.wantErr && tt.want.Op != bpf.VlanRewriteNone {
+				if back, _ := vlanRewriteFromProto(vlanRewriteToProto(got)); back != got {
+					t.Errorf("round trip = %+v, want %+v", back, got)
+				}
+			}
+		})
+	}
+}
+
+func TestHeadendL2ACPortMode(t *testing.T) {
+	ac, err := headendL2AC(&v1.HeadendL2{InterfaceName: "lo", PortMode: true})
+	if err != nil {
+		t.Fatalf("headendL2AC: %v", err)
+	}
+	if ac.VlanID != bpf.HeadendL2VlanAny {
+		t.Errorf("VlanID = %#x, want the port-mode wildcard", ac.VlanID)
+	}
+
+	for _, h := range []*v1.HeadendL2{
+		{InterfaceName: "lo", PortMode: true, VlanId: 100},
+		{InterfaceName: "lo", PortMode: true, VlanId: 100, InnerVlanId: 10},
+		{InterfaceName: "lo", PortMode: true, VlanIdEnd: 200},
+	} {
+		if _, err := headendL2AC(h); err == nil {
+			t.Errorf("headendL2AC(%v) should fail", h)
+		}
+	}
+}
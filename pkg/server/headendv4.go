@@ -53,6 +53,58 @@ func (s *Headendv4Server) Headendv4Create(
 	return connect.NewResponse(resp), nil
 }
 
+// Headendv4Update changes installed Headend v4 entries in place, so
+// matching packets never fall through to a less specific prefix.
+func (s *Headendv4Server) Headendv4Update(
+	ctx context.Context,
+	req *connect.Request[v1.Headendv4UpdateRequest],
+) (*connect.Response[v1.Headendv4UpdateResponse], error) {
+	resp := &v1.Headendv4UpdateResponse{
+		Updated: make([]*v1.Headendv4, 0),
+		Errors:  make([]*v1.OperationError, 0),
+	}
+
+	for _, headend := range req.Msg.Headendv4S {
+		current, err := s.mapOps.GetHeadendV4(headend.TriggerPrefix)
+		if err != nil {
+			resp.Errors = append(resp.Errors, &v1.OperationError{
+				TriggerPrefix: headend.TriggerPrefix,
+				Reason:        fmt.Sprintf("not found: %v", err),
+			})
+			continue
+		}
+		merged, err := mergeUpdate(s.entryToProto(headend.TriggerPrefix, current), headend, req.Msg.UpdateMask, "trigger_prefix")
+		if err != nil {
+			resp.Errors = append(resp.Errors, &v1.OperationError{
+				TriggerPrefix: headend.TriggerPrefix,
+				Reason:        err.Error(),
+			})
+			continue
+		}
+
+		entry, err := s.protoToEntry(merged)
+		if err != nil {
+			resp.Errors = append(resp.Errors, &v1.OperationError{
+				TriggerPrefix: headend.TriggerPrefix,
+				Reason:        err.Error(),
+			})
+			continue
+		}
+
+		if err := s.mapOps.CreateHeadendV4(merged.TriggerPrefix, entry); err != nil {
+			resp.Errors = append(resp.Errors, &v1.OperationError{
+				TriggerPrefix: headend.TriggerPrefix,
+				Reason:        err.Error(),
+			})
+			continue
+		}
+
+		resp.Updated = append(resp.Updated, merged)
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
 // Headendv4Delete deletes Headend v4 entries
 func (s *Headendv4Server) Headendv4Delete(
 	ctx context.Context,
@@ -150,14 +202,30 @@ func (s *Headendv4Server) protoToEntry(headend *v1.Headendv4) (*bpf.HeadendEntry
 		return nil, fmt.Errorf("args_offset must be 0-15, got %d", headend.ArgsOffset)
 	}
 
-	return &bpf.HeadendEntry{
+	mtu, err := headendMTU(headend.Mtu)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := &bpf.HeadendEntry{
 		Mode:          uint8(headend.Mode),
 		NumSegments:   numSegments,
+		Mtu:           mtu,
 		SrcAddr:       srcAddr,
 		DstAddr:       dstAddr,
 		Segments:      segments,
 		ArgsOffset: uint8(headend.ArgsOffset),
-	}, nil
+	}
+	if err := applyEncapFields(entry, encapFields{
+		tcMode:        headend.TcMode,
+		tc:            headend.Tc,
+		hopLimitMode:  headend.HopLimitMode,
+		hopLimit:      headend.HopLimit,
+		flowLabelMode: headend.FlowLabelMode,
+	}); err != nil {
+		return nil, err
+	}
+	return entry, nil
 }
 
 // entryToProto converts a BPF map entry to a protobuf Headendv4
@@ -169,5 +237,65 @@ func (s *Headendv4Server) entryToProto(prefix string, entry *bpf.HeadendEntry) *
 		DstAddr:       bpf.FormatIPv6(entry.DstAddr),
 		Segments:      bpf.FormatSegments(entry.Segments, entry.NumSegments),
 		ArgsOffset: uint32(entry.ArgsOffset),
+		Mtu:        uint32(entry.Mtu),
+		TcMode:        v1.Srv6EncapTcMode(entry.TcMode),
+		Tc:            uint32(entry.Tc),
+		HopLimitMode:  v1.Srv6EncapHopLimitMode(entry.HopLimitMode),
+		HopLimit:      uint32(entry.HopLimit),
+		FlowLabelMode: v1.Srv6EncapFlowLabelMode(entry.FlowLabelMode),
 	}
 }
+
+// minIPv6MTU is the IPv6 minimum link MTU (RFC 8200 Section 5)
+const minIPv6MTU = 1280
+
+// headendMTU validates the per-headend egress MTU; 0 leaves only the
+// egress interface MTU check in place.
+func headendMTU(mtu uint32) (uint16, error) {
+	if mtu != 0 && (mtu < minIPv6MTU || mtu > 65535) {
+		return 0, fmt.Errorf("mtu must be 0 or %d-65535, got %d", minIPv6MTU, mtu)
+	}
+	return uint16(mtu), nil
+}
+
+// encapFields are the outer IPv6 header settings shared by Headendv4,
+// Headendv6 and HeadendL2.
+type encapFields struct {
+	tcMode        v1.Srv6EncapTcMode
+	tc            uint32
+	hopLimitMode  v1.Srv6EncapHopLimitMode
+	hopLimit      uint32
+	flowLabelMode v1.Srv6EncapFlowLabelMode
+}
+
+// applyEncapFields validates f and stores it in entry.
+func applyEncapFields(entry *bpf.HeadendEntry, f encapFields) error {
+	if _, ok := v1.Srv6EncapTcMode_name[int32(f.tcMode)]; !ok {
+		return fmt.Errorf("unknown tc_mode %d", f.tcMode)
+	}
+	if _, ok := v1.Srv6EncapHopLimitMode_name[int32(f.hopLimitMode)]; !ok {
+		return fmt.Errorf("unknown hop_limit_mode %d", f.hopLimitMode)
+	}
+	if _, ok := v1.Srv6EncapFlowLabelMode_name[int32(f.flowLabelMode)]; !ok {
+		return fmt.Errorf("unknown flow_label_mode %d", f.flowLabelMode)
+	}
+	if f.tc > 255 {
+		return fmt.Errorf("tc must be 0-255, got %d", f.tc)
+	}
+	if f.tc != 0 && f.tcMode != v1.Srv6EncapTcMode_SRV6_ENCAP_TC_MODE_SET {
+		return fmt.Errorf("tc requires tc_mode SRV6_ENCAP_TC_MODE_SET")
+	}
+	if f.hopLimit > 255 {
+		return fmt.Errorf("hop_limit must be 0-255, got %d", f.hopLimit)
+	}
+	if f.hopLimit != 0 && f.hopLimitMode == v1.Srv6EncapHopLimitMode_SRV6_ENCAP_HOP_LIMIT_MODE_PROPAGATE {
+		return fmt.Errorf("hop_limit cannot be set with hop_limit_mode SRV6_ENCAP_HOP_LIMIT_MODE_PROPAGATE")
+	}
+
+	entry.TcMode = uint8(f.tcMode)
+	entry.Tc = uint8(f.tc)
+	entry.HopLimitMode = uint8(f.hopLimitMode)
+	entry.HopLimit = uint8(f.hopLimit)
+	entry.FlowLabelMode = uint8(f.flowLabelMode)
+	return nil
+}
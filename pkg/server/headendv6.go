@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"fmt"
 
 	"connectrpc.com/connect"
 	v1 "github.com/takehaya/vinbero/api/vinbero/v1"
@@ -52,6 +53,58 @@ func (s *Headendv6Server) Headendv6Create(
 	return connect.NewResponse(resp), nil
 }
 
+// Headendv6Update changes installed Headend v6 entries in place, so
+// matching packets never fall through to a less specific prefix.
+func (s *Headendv6Server) Headendv6Update(
+	ctx context.Context,
+	req *connect.Request[v1.Headendv6UpdateRequest],
+) (*connect.Response[v1.Headendv6UpdateResponse], error) {
+	resp := &v1.Headendv6UpdateResponse{
+		Updated: make([]*v1.Headendv6, 0),
+		Errors:  make([]*v1.OperationError, 0),
+	}
+
+	for _, headend := range req.Msg.Headendv6S {
+		current, err := s.mapOps.GetHeadendV6(headend.TriggerPrefix)
+		if err != nil {
+			resp.Errors = append(resp.Errors, &v1.OperationError{
+				TriggerPrefix: headend.TriggerPrefix,
+				Reason:        fmt.Sprintf("not found: %v", err),
+			})
+			continue
+		}
+		merged, err := mergeUpdate(s.entryToProto(headend.TriggerPrefix, current), headend, req.Msg.UpdateMask, "trigger_prefix")
+		if err != nil {
+			resp.Errors = append(resp.Errors, &v1.OperationError{
+				TriggerPrefix: headend.TriggerPrefix,
+				Reason:        err.Error(),
+			})
+			continue
+		}
+
+		entry, err := s.protoToEntry(merged)
+		if err != nil {
+			resp.Errors = append(resp.Errors, &v1.OperationError{
+				TriggerPrefix: headend.TriggerPrefix,
+				Reason:        err.Error(),
+			})
+			continue
+		}
+
+		if err := s.mapOps.CreateHeadendV6(merged.TriggerPrefix, entry); err != nil {
+			resp.Errors = append(resp.Errors, &v1.OperationError{
+				TriggerPrefix: headend.TriggerPrefix,
+				Reason:        err.Error(),
+			})
+			continue
+		}
+
+		resp.Updated = append(resp.Updated, merged)
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
 // Headendv6Delete deletes Headend v6 entries
 func (s *Headendv6Server) Headendv6Delete(
 	ctx context.Context,
@@ -145,13 +198,29 @@ func (s *Headendv6Server) protoToEntry(headend *v1.Headendv6) (*bpf.HeadendEntry
 		return nil, err
 	}
 
-	return &bpf.HeadendEntry{
+	mtu, err := headendMTU(headend.Mtu)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := &bpf.HeadendEntry{
 		Mode:        uint8(headend.Mode),
 		NumSegments: numSegments,
+		Mtu:         mtu,
 		SrcAddr:     srcAddr,
 		DstAddr:     dstAddr,
 		Segments:    segments,
-	}, nil
+	}
+	if err := applyEncapFields(entry, encapFields{
+		tcMode:        headend.TcMode,
+		tc:            headend.Tc,
+		hopLimitMode:  headend.HopLimitMode,
+		hopLimit:      headend.HopLimit,
+		flowLabelMode: headend.FlowLabelMode,
+	}); err != nil {
+		return nil, err
+	}
+	return entry, nil
 }
 
 // entryToProto converts a BPF map entry to a protobuf Headendv6
@@ -162,5 +231,11 @@ func (s *Headendv6Server) entryToProto(prefix string, entry *bpf.HeadendEntry) *
 		SrcAddr:       bpf.FormatIPv6(entry.SrcAddr),
 		DstAddr:       bpf.FormatIPv6(entry.DstAddr),
 		Segments:      bpf.FormatSegments(entry.Segments, entry.NumSegments),
+		Mtu:           uint32(entry.Mtu),
+		TcMode:        v1.Srv6EncapTcMode(entry.TcMode),
+		Tc:            uint32(entry.Tc),
+		HopLimitMode:  v1.Srv6EncapHopLimitMode(entry.HopLimitMode),
+		HopLimit:      uint32(entry.HopLimit),
+		FlowLabelMode: v1.Srv6EncapFlowLabelMode(entry.FlowLabelMode),
 	}
 }
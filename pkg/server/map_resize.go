@@ -0,0 +1,109 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/cilium/ebpf"
+	"go.uber.org/zap"
+
+	v1 "github.com/takehaya/vinbero/api/vinbero/v1"
+	"github.com/takehaya/vinbero/api/vinbero/v1/vinberov1connect"
+)
+
+// MapResizer grows a map of the running data plane, moving its programs
+// onto the new map (vinbero.Vinbero).
+type MapResizer interface {
+	ResizeMap(name string, capacity uint32) (int, error)
+}
+
+// pauseInterceptor holds pauseMu shared for the duration of every call
+// but MapResize, which takes it exclusively.
+func (s *Server) pauseInterceptor() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if req.Spec().Procedure == vinberov1connect.SystemServiceMapResizeProcedure {
+				return next(ctx, req)
+			}
+			s.pauseMu.RLock()
+			defer s.pauseMu.RUnlock()
+			return next(ctx, req)
+		}
+	}
+}
+
+// ResizeMap grows the map name to capacity while no other API call or
+// reload runs, then registers again the plugins whose ELF uses the map,
+// since they were loaded against the old one.
+func (s *Server) ResizeMap(ctx context.Context, name string, capacity uint32) (*v1.MapResizeResponse, error) {
+	if s.resizer == nil {
+		return nil, connect.NewError(connect.CodeUnimplemented, fmt.Errorf("map resize is not available"))
+	}
+	s.Setup()
+
+	// pauseMu before reloadMu: a call holding pauseMu shared may be
+	// waiting for reloadMu, as Import and Commit do
+	s.pauseMu.Lock()
+	defer s.pauseMu.Unlock()
+	s.reloadMu.Lock()
+	defer s.reloadMu.Unlock()
+
+	current, err := s.mapUsageOf(name)
+	if err != nil {
+		return nil, err
+	}
+	if capacity <= current.Capacity {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("capacity of %s must exceed the current %d", name, current.Capacity))
+	}
+
+	if _, err := s.resizer.ResizeMap(name, capacity); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("resize %s: %w", name, err))
+	}
+
+	resp := &v1.MapResizeResponse{}
+	for _, p := range s.plugin.Registered() {
+		if !pluginUsesMap(p.BpfElf, name) {
+			continue
+		}
+		label := fmt.Sprintf("%s[%d] %s", p.MapType, p.Index, p.Program)
+		if _, err := s.plugin.PluginRegister(ctx, connect.NewRequest(p)); err != nil {
+			// The plugin keeps running against the old map
+			s.logger.Error("Failed to reload plugin after map resize",
+				zap.String("plugin", label), zap.String("map", name), zap.Error(err))
+			continue
+		}
+		resp.ReloadedPlugins = append(resp.ReloadedPlugins, label)
+	}
+
+	if resp.Map, err = s.mapUsageOf(name); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s *Server) mapUsageOf(name string) (*v1.BpfMapUsage, error) {
+	usage, err := s.MapUsage()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	for _, u := range usage {
+		if u.Name == name {
+			return u, nil
+		}
+	}
+	return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("no resizable map named %q", name))
+}
+
+// pluginUsesMap reports whether the plugin ELF declares map name, which
+// PluginRegister then replaces with vinbero's map.
+func pluginUsesMap(elf []byte, name string) bool {
+	spec, err := ebpf.LoadCollectionSpecFromReader(bytes.NewReader(elf))
+	if err != nil {
+		return false
+	}
+	_, ok := spec.Maps[name]
+	return ok
+}
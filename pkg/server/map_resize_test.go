@@ -0,0 +1,53 @@
+package server
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+
+	v1 "github.com/takehaya/vinbero/api/vinbero/v1"
+	"github.com/takehaya/vinbero/api/vinbero/v1/vinberov1connect"
+)
+
+// TestPauseInterceptor checks that API calls wait while a resize holds
+// pauseMu.
+func TestPauseInterceptor(t *testing.T) {
+	s, _ := newStoreTestServer(t, nil)
+	ts := httptest.NewServer(s.mux)
+	defer ts.Close()
+	client := vinberov1connect.NewSrDomainServiceClient(ts.Client(), ts.URL)
+
+	s.pauseMu.Lock()
+	done := make(chan error, 1)
+	go func() {
+		_, err := client.SrDomainList(context.Background(), connect.NewRequest(&v1.SrDomainListRequest{}))
+		done <- err
+	}()
+
+	select {
+	case <-done:
+		t.Fatal("call went through while paused")
+	case <-time.After(100 * time.Millisecond):
+	}
+	s.pauseMu.Unlock()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("SrDomainList: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("call still blocked after unpause")
+	}
+}
+
+func TestResizeMapUnavailable(t *testing.T) {
+	s := &Server{}
+	_, err := s.ResizeMap(context.Background(), "fdb_map", 16384)
+	if connect.CodeOf(err) != connect.CodeUnimplemented {
+		t.Errorf("got %v, want unimplemented", err)
+	}
+}
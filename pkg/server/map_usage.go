@@ -0,0 +1,210 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	v1 "github.com/takehaya/vinbero/api/vinbero/v1"
+	"github.com/takehaya/vinbero/pkg/bpf"
+	"github.com/takehaya/vinbero/pkg/config"
+)
+
+// mapEventBufferSize is how many map events MapEventList can return.
+const mapEventBufferSize = 256
+
+// mapMonitor keeps the high-watermark of every control map and records an
+// event each time a map crosses its warning threshold, fills up, or drops
+// back below. Create calls that hit a full map only report it per entry,
+// so this is where running out of room shows up for the node.
+type mapMonitor struct {
+	cfg    config.MapUsageConfig
+	logger *zap.Logger
+
+	mu     sync.Mutex
+	hwm    map[string]uint32
+	level  map[string]v1.MapEventKind // Last event per map; unset when below the threshold
+	events []*v1.MapEvent
+	next   int
+	full   bool
+}
+
+func newMapMonitor(cfg config.MapUsageConfig, logger *zap.Logger) *mapMonitor {
+	return &mapMonitor{
+		cfg:    cfg,
+		logger: logger,
+		hwm:    make(map[string]uint32),
+		level:  make(map[string]v1.MapEventKind),
+		events: make([]*v1.MapEvent, mapEventBufferSize),
+	}
+}
+
+// warnPercent is the threshold of map name: its settings.map_usage
+// thresholds entry, or warn_percent.
+func (m *mapMonitor) warnPercent(name string) uint32 {
+	if p, ok := m.cfg.Thresholds[name]; ok {
+		return uint32(p)
+	}
+	return uint32(m.cfg.WarnPercent)
+}
+
+// observe takes one sample of the maps. It fills in the high-watermark
+// and threshold of each entry of usage.
+func (m *mapMonitor) observe(usage []*v1.BpfMapUsage, now time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, u := range usage {
+		if u.Entries > m.hwm[u.Name] {
+			m.hwm[u.Name] = u.Entries
+		}
+		u.HighWatermark = m.hwm[u.Name]
+		u.WarnPercent = m.warnPercent(u.Name)
+
+		kind := mapLevel(u)
+		prev := m.level[u.Name]
+		if kind == prev {
+			continue
+		}
+		if kind == v1.MapEventKind_MAP_EVENT_KIND_UNSPECIFIED {
+			delete(m.level, u.Name)
+			kind = v1.MapEventKind_MAP_EVENT_KIND_THRESHOLD_CLEARED
+		} else {
+			m.level[u.Name] = kind
+		}
+		m.record(&v1.MapEvent{
+			TimestampUnixNano: now.UnixNano(),
+			Map:               u.Name,
+			Kind:              kind,
+			Entries:           u.Entries,
+			Capacity:          u.Capacity,
+			WarnPercent:       u.WarnPercent,
+		})
+	}
+}
+
+// observeCount is the sample of one map whose entry count a write just
+// changed, so its high-watermark and threshold follow every change.
+func (m *mapMonitor) observeCount(name string, entries int, capacity uint32) {
+	m.observe([]*v1.BpfMapUsage{{Name: name, Entries: uint32(entries), Capacity: capacity}}, time.Now())
+}
+
+// mapLevel is the event kind u is at, or UNSPECIFIED below its threshold.
+func mapLevel(u *v1.BpfMapUsage) v1.MapEventKind {
+	switch {
+	case u.WarnPercent == 0 || u.Capacity == 0:
+		return v1.MapEventKind_MAP_EVENT_KIND_UNSPECIFIED
+	case u.Entries >= u.Capacity:
+		return v1.MapEventKind_MAP_EVENT_KIND_FULL
+	case uint64(u.Entries)*100 >= uint64(u.Capacity)*uint64(u.WarnPercent):
+		return v1.MapEventKind_MAP_EVENT_KIND_THRESHOLD_EXCEEDED
+	}
+	return v1.MapEventKind_MAP_EVENT_KIND_UNSPECIFIED
+}
+
+func (m *mapMonitor) record(ev *v1.MapEvent) {
+	fields := []zap.Field{
+		zap.String("map", ev.Map),
+		zap.Uint32("entries", ev.Entries),
+		zap.Uint32("capacity", ev.Capacity),
+		zap.Uint32("warn_percent", ev.WarnPercent),
+	}
+	switch ev.Kind {
+	case v1.MapEventKind_MAP_EVENT_KIND_FULL:
+		m.logger.Error("BPF map is full", fields...)
+	case v1.MapEventKind_MAP_EVENT_KIND_THRESHOLD_EXCEEDED:
+		m.logger.Warn("BPF map usage above threshold", fields...)
+	default:
+		m.logger.Info("BPF map usage back below threshold", fields...)
+	}
+
+	m.events[m.next] = ev
+	m.next = (m.next + 1) % len(m.events)
+	if m.next == 0 {
+		m.full = true
+	}
+}
+
+// recent returns up to limit recorded events, oldest first. limit <= 0
+// returns everything buffered.
+func (m *mapMonitor) recent(limit int) []*v1.MapEvent {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var out []*v1.MapEvent
+	if m.full {
+		out = append(out, m.events[m.next:]...)
+	}
+	out = append(out, m.events[:m.next]...)
+	if limit > 0 && len(out) > limit {
+		out = out[len(out)-limit:]
+	}
+	return out
+}
+
+// MapUsage reports entries, capacity, size and high-watermark of every
+// control map, walking each one. Each call is also a sample for the
+// threshold events.
+func (s *Server) MapUsage() ([]*v1.BpfMapUsage, error) {
+	usage, err := s.mapOps.MapUsage()
+	if err != nil {
+		return nil, err
+	}
+	out := usageToProto(usage)
+	s.maps.observe(out, time.Now())
+	return out, nil
+}
+
+func usageToProto(usage []bpf.MapUsage) []*v1.BpfMapUsage {
+	out := make([]*v1.BpfMapUsage, 0, len(usage))
+	for _, u := range usage {
+		out = append(out, &v1.BpfMapUsage{
+			Name:     u.Name,
+			Type:     u.Type.String(),
+			Entries:  uint32(u.Entries),
+			Capacity: u.Capacity,
+			Bytes:    u.Bytes,
+		})
+	}
+	return out
+}
+
+// MapEvents returns up to limit of the most recent map events.
+func (s *Server) MapEvents(limit int) []*v1.MapEvent {
+	return s.maps.recent(limit)
+}
+
+// StartMapMonitor samples the map counters every
+// settings.map_usage.interval_seconds until ctx is done, without walking
+// the maps. The maps only userspace writes are also observed on every
+// change; the sample is what picks up fdb_map, which the data plane
+// learns into. With an interval of 0 fdb_map is only sampled by MapUsage
+// calls.
+func (s *Server) StartMapMonitor(ctx context.Context) {
+	interval := s.cfg.Setting.MapUsage.IntervalSeconds
+	if interval <= 0 {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(time.Duration(interval) * time.Second)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				// A resize swaps the maps under pauseMu
+				s.pauseMu.RLock()
+				usage, err := s.mapOps.CountedMapUsage()
+				s.pauseMu.RUnlock()
+				if err != nil {
+					s.logger.Warn("Failed to sample map usage", zap.Error(err))
+					continue
+				}
+				s.maps.observe(usageToProto(usage), time.Now())
+			}
+		}
+	}()
+}
@@ -0,0 +1,90 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	v1 "github.com/takehaya/vinbero/api/vinbero/v1"
+	"github.com/takehaya/vinbero/pkg/config"
+)
+
+func TestMapMonitor(t *testing.T) {
+	m := newMapMonitor(config.MapUsageConfig{
+		WarnPercent: 80,
+		Thresholds:  map[string]int{"fdb_map": 50, "esi_map": 0},
+	}, zap.NewNop())
+
+	sample := func(fdb, sid, esi uint32) []*v1.BpfMapUsage {
+		usage := []*v1.BpfMapUsage{
+			{Name: "fdb_map", Entries: fdb, Capacity: 100},
+			{Name: "sid_function_map", Entries: sid, Capacity: 100},
+			{Name: "esi_map", Entries: esi, Capacity: 100},
+		}
+		m.observe(usage, time.Unix(0, 0))
+		return usage
+	}
+
+	type event struct {
+		mapName string
+		kind    v1.MapEventKind
+	}
+	steps := []struct {
+		fdb, sid, esi uint32
+		want          []event
+	}{
+		{10, 10, 10, nil},
+		{50, 79, 100, []event{{"fdb_map", v1.MapEventKind_MAP_EVENT_KIND_THRESHOLD_EXCEEDED}}},
+		{60, 80, 100, []event{{"sid_function_map", v1.MapEventKind_MAP_EVENT_KIND_THRESHOLD_EXCEEDED}}},
+		{100, 80, 100, []event{{"fdb_map", v1.MapEventKind_MAP_EVENT_KIND_FULL}}},
+		{49, 20, 0, []event{
+			{"fdb_map", v1.MapEventKind_MAP_EVENT_KIND_THRESHOLD_CLEARED},
+			{"sid_function_map", v1.MapEventKind_MAP_EVENT_KIND_THRESHOLD_CLEARED},
+		}},
+	}
+	seen := 0
+	for i, st := range steps {
+		sample(st.fdb, st.sid, st.esi)
+		events := m.recent(0)[seen:]
+		seen += len(events)
+		if len(events) != len(st.want) {
+			t.Fatalf("step %d: got %v, want %v", i, events, st.want)
+		}
+		for j, ev := range events {
+			if ev.Map != st.want[j].mapName || ev.Kind != st.want[j].kind {
+				t.Errorf("step %d: event %d is %s %s, want %v", i, j, ev.Map, ev.Kind, st.want[j])
+			}
+		}
+	}
+
+	usage := sample(0, 0, 0)
+	for _, u := range usage {
+		want := map[string]uint32{"fdb_map": 100, "sid_function_map": 80, "esi_map": 100}[u.Name]
+		if u.HighWatermark != want {
+			t.Errorf("%s: high-watermark %d, want %d", u.Name, u.HighWatermark, want)
+		}
+	}
+	if got := usage[0].WarnPercent; got != 50 {
+		t.Errorf("fdb_map threshold %d, want 50", got)
+	}
+}
+
+func TestMapMonitorRecent(t *testing.T) {
+	m := newMapMonitor(config.MapUsageConfig{WarnPercent: 50}, zap.NewNop())
+	for i := 0; i < mapEventBufferSize+10; i++ {
+		entries := uint32(i%2) * 100
+		m.observe([]*v1.BpfMapUsage{{Name: "fdb_map", Entries: entries, Capacity: 100}}, time.Unix(int64(i), 0))
+	}
+
+	all := m.recent(0)
+	if len(all) != mapEventBufferSize {
+		t.Fatalf("buffered %d events, want %d", len(all), mapEventBufferSize)
+	}
+	if last := all[len(all)-1].TimestampUnixNano; last != time.Unix(mapEventBufferSize+9, 0).UnixNano() {
+		t.Errorf("newest event at %d", last)
+	}
+	if got := m.recent(3); len(got) != 3 || got[2] != all[len(all)-1] {
+		t.Errorf("recent(3) = %v", got)
+	}
+}
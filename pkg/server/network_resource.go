@@ -59,34 +59,15 @@ func (s *NetworkResourceServer) BridgeDelete(
 	}
 
 	for _, name := range req.Msg.Names {
-		// Resolve ifindex from ResourceManager cache, falling back to netlink
-		var ifindex uint32
-		if br, ok := s.resMgr.GetBridgeByName(name); ok {
-			ifindex = br.Ifindex
-		} else if resolved, err := resolveIfindex(name); err == nil {
-			ifindex = resolved
-		}
-
-		if ifindex != 0 {
-			ref, err := s.findBridgeReference(ifindex)
-			if err != nil {
-				resp.Errors = append(resp.Errors, &v1.OperationError{
-					TriggerPrefix: name,
-					Reason:        fmt.Sprintf("failed to check references: %v", err),
-				})
-				continue
-			}
-			if ref != "" {
-				resp.Errors = append(resp.Errors, &v1.OperationError{
-					TriggerPrefix: name,
-					Reason:        fmt.Sprintf("bridge is referenced by SID %s", ref),
-				})
-				continue
-			}
-			s.fdbWatcher.UnregisterBridge(int(ifindex))
+		if bd, ok := s.resMgr.GetBridgeDomainByBridge(name); ok {
+			resp.Errors = append(resp.Errors, &v1.OperationError{
+				TriggerPrefix: name,
+				Reason:        fmt.Sprintf("bridge is owned by bridge domain %s", bd.Name),
+			})
+			continue
 		}
 
-		if err := s.resMgr.DeleteBridge(name); err != nil {
+		if err := s.deleteBridge(name); err != nil {
 			resp.Errors = append(resp.Errors, &v1.OperationError{
 				TriggerPrefix: name,
 				Reason:        err.Error(),
@@ -100,6 +81,31 @@ func (s *NetworkResourceServer) BridgeDelete(
 	return connect.NewResponse(resp), nil
 }
 
+// deleteBridge removes a bridge that no SID references and stops watching
+// its FDB. Shared by BridgeDelete and the BridgeDomain cascade.
+func (s *NetworkResourceServer) deleteBridge(name string) error {
+	// Resolve ifindex from ResourceManager cache, falling back to netlink
+	var ifindex uint32
+	if br, ok := s.resMgr.GetBridgeByName(name); ok {
+		ifindex = br.Ifindex
+	} else if resolved, err := resolveIfindex(name); err == nil {
+		ifindex = resolved
+	}
+
+	if ifindex != 0 {
+		ref, err := s.findBridgeReference(ifindex)
+		if err != nil {
+			return fmt.Errorf("failed to check references: %w", err)
+		}
+		if ref != "" {
+			return fmt.Errorf("bridge is referenced by SID %s", ref)
+		}
+		s.fdbWatcher.UnregisterBridge(int(ifindex))
+	}
+
+	return s.resMgr.DeleteBridge(name)
+}
+
 func (s *NetworkResourceServer) BridgeList(
 	ctx context.Context,
 	req *connect.Request[v1.BridgeListRequest],
@@ -172,7 +178,7 @@ func (s *NetworkResourceServer) VrfDelete(
 			if ref != "" {
 				resp.Errors = append(resp.Errors, &v1.OperationError{
 					TriggerPrefix: name,
-					Reason:        fmt.Sprintf("VRF is referenced by SID %s", ref),
+					Reason:        fmt.Sprintf("VRF is referenced by %s", ref),
 				})
 				continue
 			}
@@ -240,8 +246,9 @@ func (s *NetworkResourceServer) findBridgeReference(ifindex uint32) (string, err
 	return "", nil
 }
 
-// findVrfReference checks if any End.T/DT4/DT6/DT46 SID entry references the given vrf_ifindex.
-// VRF ifindex is stored in the aux map (l3vrf variant).
+// findVrfReference checks if any End.T/DT4/DT6/DT46 SID entry, IRB bridge
+// domain or IRB route references the given vrf_ifindex, and names it.
+// For SIDs the VRF ifindex is stored in the aux map (l3vrf variant).
 func (s *NetworkResourceServer) findVrfReference(ifindex uint32) (string, error) {
 	entries, err := s.mapOps.ListSidFunctions()
 	if err != nil {
@@ -264,7 +271,21 @@ func (s *NetworkResourceServer) findVrfReference(ifindex uint32) (string, error)
 			continue
 		}
 		if bpf.SidAuxL3VrfData(aux) == ifindex {
-			return prefix, nil
+			return "SID " + prefix, nil
+		}
+	}
+	for _, bd := range s.resMgr.ListBridgeDomains() {
+		if irb, err := s.mapOps.GetBdIrb(bd.BdID); err == nil && irb.VrfIfindex == ifindex {
+			return "bridge domain " + bd.Name, nil
+		}
+	}
+	routes, err := s.mapOps.ListIrbRoutes()
+	if err != nil {
+		return "", fmt.Errorf("list IRB routes: %w", err)
+	}
+	for _, r := range routes {
+		if r.VrfIfindex == ifindex {
+			return "IRB route " + r.Prefix, nil
 		}
 	}
 	return "", nil
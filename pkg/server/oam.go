@@ -0,0 +1,147 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"time"
+
+	"connectrpc.com/connect"
+	v1 "github.com/takehaya/vinbero/api/vinbero/v1"
+	"github.com/takehaya/vinbero/pkg/oam"
+)
+
+// OamServer implements the OamServiceHandler interface
+type OamServer struct {
+	prober *oam.Prober
+	punts  *oam.PuntReader
+}
+
+// NewOamServer creates a new OamServer. punts may be nil when the punt
+// reader could not be started; OamPuntList then returns an error.
+func NewOamServer(punts *oam.PuntReader) *OamServer {
+	return &OamServer{prober: oam.NewProber(), punts: punts}
+}
+
+// OamPing probes each segment of the given path
+func (s *OamServer) OamPing(
+	ctx context.Context,
+	req *connect.Request[v1.OamPingRequest],
+) (*connect.Response[v1.OamPingResponse], error) {
+	segments, opts, err := parseOamProbe(req.Msg.Segments, req.Msg.Source, req.Msg.TimeoutMs, req.Msg.OamFlag)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	results, err := s.prober.Ping(ctx, segments, int(req.Msg.Count), opts)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&v1.OamPingResponse{Results: oamResultsToProto(results)}), nil
+}
+
+// OamTraceroute probes the full path with increasing hop limits
+func (s *OamServer) OamTraceroute(
+	ctx context.Context,
+	req *connect.Request[v1.OamTracerouteRequest],
+) (*connect.Response[v1.OamTracerouteResponse], error) {
+	segments, opts, err := parseOamProbe(req.Msg.Segments, req.Msg.Source, req.Msg.TimeoutMs, req.Msg.OamFlag)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	results, err := s.prober.Traceroute(ctx, segments, int(req.Msg.MaxHops), opts)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&v1.OamTracerouteResponse{Hops: oamResultsToProto(results)}), nil
+}
+
+// OamPuntList returns the most recent timestamped copies from the data plane
+func (s *OamServer) OamPuntList(
+	ctx context.Context,
+	req *connect.Request[v1.OamPuntListRequest],
+) (*connect.Response[v1.OamPuntListResponse], error) {
+	if s.punts == nil {
+		return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("OAM punt reader is not running"))
+	}
+
+	resp := &v1.OamPuntListResponse{Punts: make([]*v1.OamPunt, 0)}
+	for _, p := range s.punts.Recent(int(req.Msg.Limit)) {
+		punt := &v1.OamPunt{
+			TimestampUnixNano: p.Time.UnixNano(),
+			InterfaceName:     ifindexToName(p.Ifindex),
+			Reason:            p.ReasonString(),
+			Action:            v1.Srv6LocalAction(p.Action),
+			PacketLength:      uint32(p.PktLen),
+		}
+		if hdr, err := oam.ParseSRv6Frame(p.Frame); err == nil {
+			punt.Src = hdr.Src.String()
+			punt.Dst = hdr.Dst.String()
+			punt.SegmentsLeft = uint32(hdr.SegmentsLeft)
+			for _, seg := range hdr.Segments {
+				punt.Segments = append(punt.Segments, seg.String())
+			}
+		}
+		resp.Punts = append(resp.Punts, punt)
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+func parseOamProbe(segs []string, source string, timeoutMs uint32, oamFlag bool) ([]netip.Addr, oam.ProbeOptions, error) {
+	opts := oam.ProbeOptions{
+		Timeout: time.Duration(timeoutMs) * time.Millisecond,
+		OAMFlag: oamFlag,
+	}
+	if source != "" {
+		addr, err := netip.ParseAddr(source)
+		if err != nil || !addr.Is6() {
+			return nil, opts, fmt.Errorf("invalid IPv6 source %q", source)
+		}
+		opts.Source = addr
+	}
+
+	segments := make([]netip.Addr, 0, len(segs))
+	for _, s := range segs {
+		addr, err := netip.ParseAddr(s)
+		if err != nil {
+			return nil, opts, fmt.Errorf("invalid segment %q: %w", s, err)
+		}
+		segments = append(segments, addr)
+	}
+	return segments, opts, nil
+}
+
+func oamResultsToProto(results []oam.Result) []*v1.OamProbeResult {
+	out := make([]*v1.OamProbeResult, 0, len(results))
+	for _, r := range results {
+		pr := &v1.OamProbeResult{
+			Index:    uint32(r.Index),
+			Target:   r.Target.String(),
+			Reply:    oamReplyToProto(r.Reply),
+			IcmpCode: uint32(r.Code),
+			RttUs:    uint64(r.RTT.Microseconds()),
+		}
+		if r.Responder.IsValid() {
+			pr.Responder = r.Responder.String()
+		}
+		out = append(out, pr)
+	}
+	return out
+}
+
+func oamReplyToProto(r oam.Reply) v1.OamReplyType {
+	switch r {
+	case oam.ReplyEchoReply:
+		return v1.OamReplyType_OAM_REPLY_TYPE_ECHO_REPLY
+	case oam.ReplyTimeExceeded:
+		return v1.OamReplyType_OAM_REPLY_TYPE_TIME_EXCEEDED
+	case oam.ReplyDestUnreachable:
+		return v1.OamReplyType_OAM_REPLY_TYPE_DEST_UNREACHABLE
+	case oam.ReplyParamProblem:
+		return v1.OamReplyType_OAM_REPLY_TYPE_PARAM_PROBLEM
+	default:
+		return v1.OamReplyType_OAM_REPLY_TYPE_TIMEOUT
+	}
+}
@@ -0,0 +1,286 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"sort"
+	"time"
+
+	"connectrpc.com/connect"
+	v1 "github.com/takehaya/vinbero/api/vinbero/v1"
+	"github.com/takehaya/vinbero/pkg/bpf"
+	"github.com/takehaya/vinbero/pkg/stamp"
+)
+
+// PerformanceServer implements the PerformanceServiceHandler interface
+type PerformanceServer struct {
+	mapOps   *bpf.MapOperations
+	sessions *stamp.Manager
+}
+
+// NewPerformanceServer creates a new PerformanceServer
+func NewPerformanceServer(mapOps *bpf.MapOperations, sessions *stamp.Manager) *PerformanceServer {
+	return &PerformanceServer{mapOps: mapOps, sessions: sessions}
+}
+
+// StampReflectorCreate installs XDP reflectors
+func (s *PerformanceServer) StampReflectorCreate(
+	ctx context.Context,
+	req *connect.Request[v1.StampReflectorCreateRequest],
+) (*connect.Response[v1.StampReflectorCreateResponse], error) {
+	resp := &v1.StampReflectorCreateResponse{
+		Created: make([]*v1.StampReflector, 0),
+		Errors:  make([]*v1.OperationError, 0),
+	}
+
+	for _, r := range req.Msg.Reflectors {
+		port, err := stampPort(r.Port)
+		if err == nil {
+			err = s.mapOps.CreateStampReflector(r.Address, port)
+		}
+		if err != nil {
+			resp.Errors = append(resp.Errors, &v1.OperationError{
+				TriggerPrefix: r.Address,
+				Reason:        err.Error(),
+			})
+			continue
+		}
+		resp.Created = append(resp.Created, &v1.StampReflector{Address: r.Address, Port: uint32(port)})
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// StampReflectorDelete removes XDP reflectors
+func (s *PerformanceServer) StampReflectorDelete(
+	ctx context.Context,
+	req *connect.Request[v1.StampReflectorDeleteRequest],
+) (*connect.Response[v1.StampReflectorDeleteResponse], error) {
+	resp := &v1.StampReflectorDeleteResponse{
+		Deleted: make([]*v1.StampReflector, 0),
+		Errors:  make([]*v1.OperationError, 0),
+	}
+
+	for _, r := range req.Msg.Reflectors {
+		port, err := stampPort(r.Port)
+		if err == nil {
+			err = s.mapOps.DeleteStampReflector(r.Address, port)
+		}
+		if err != nil {
+			resp.Errors = append(resp.Errors, &v1.OperationError{
+				TriggerPrefix: r.Address,
+				Reason:        err.Error(),
+			})
+			continue
+		}
+		resp.Deleted = append(resp.Deleted, &v1.StampReflector{Address: r.Address, Port: uint32(port)})
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// StampReflectorList lists XDP reflectors with their reflected packet counters
+func (s *PerformanceServer) StampReflectorList(
+	ctx context.Context,
+	req *connect.Request[v1.StampReflectorListRequest],
+) (*connect.Response[v1.StampReflectorListResponse], error) {
+	entries, err := s.mapOps.ListStampReflectors()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := &v1.StampReflectorListResponse{
+		Reflectors: make([]*v1.StampReflector, 0, len(entries)),
+	}
+	for key, entry := range entries {
+		resp.Reflectors = append(resp.Reflectors, &v1.StampReflector{
+			Address:          bpf.FormatIPv6(key.Addr),
+			Port:             uint32(key.Port),
+			ReflectedPackets: entry.Reflected,
+		})
+	}
+	sort.Slice(resp.Reflectors, func(i, j int) bool {
+		if resp.Reflectors[i].Address != resp.Reflectors[j].Address {
+			return resp.Reflectors[i].Address < resp.Reflectors[j].Address
+		}
+		return resp.Reflectors[i].Port < resp.Reflectors[j].Port
+	})
+
+	return connect.NewResponse(resp), nil
+}
+
+// StampSessionCreate starts Session-Senders
+func (s *PerformanceServer) StampSessionCreate(
+	ctx context.Context,
+	req *connect.Request[v1.StampSessionCreateRequest],
+) (*connect.Response[v1.StampSessionCreateResponse], error) {
+	resp := &v1.StampSessionCreateResponse{
+		Created: make([]*v1.StampSession, 0),
+		Errors:  make([]*v1.OperationError, 0),
+	}
+
+	for _, sess := range req.Msg.Sessions {
+		cfg, err := s.protoToStampConfig(sess)
+		if err == nil {
+			_, err = s.sessions.Start(cfg)
+		}
+		if err != nil {
+			resp.Errors = append(resp.Errors, &v1.OperationError{
+				TriggerPrefix: sess.Name,
+				Reason:        err.Error(),
+			})
+			continue
+		}
+		resp.Created = append(resp.Created, sess)
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// StampSessionDelete stops Session-Senders by name
+func (s *PerformanceServer) StampSessionDelete(
+	ctx context.Context,
+	req *connect.Request[v1.StampSessionDeleteRequest],
+) (*connect.Response[v1.StampSessionDeleteResponse], error) {
+	resp := &v1.StampSessionDeleteResponse{
+		DeletedNames: make([]string, 0),
+		Errors:       make([]*v1.OperationError, 0),
+	}
+
+	for _, name := range req.Msg.Names {
+		if err := s.sessions.Stop(name); err != nil {
+			resp.Errors = append(resp.Errors, &v1.OperationError{
+				TriggerPrefix: name,
+				Reason:        err.Error(),
+			})
+			continue
+		}
+		resp.DeletedNames = append(resp.DeletedNames, name)
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// StampSessionList lists Session-Senders with their statistics
+func (s *PerformanceServer) StampSessionList(
+	ctx context.Context,
+	req *connect.Request[v1.StampSessionListRequest],
+) (*connect.Response[v1.StampSessionListResponse], error) {
+	sessions := s.sessions.Sessions()
+	resp := &v1.StampSessionListResponse{
+		Sessions: make([]*v1.StampSessionStatus, 0, len(sessions)),
+	}
+	for _, sess := range sessions {
+		resp.Sessions = append(resp.Sessions, &v1.StampSessionStatus{
+			Session: stampConfigToProto(sess.Config()),
+			Stats:   stampStatsToProto(sess.Stats()),
+		})
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// protoToStampConfig resolves the session path: explicit segments, or the
+// segment list and source address of the headend entry named by policy.
+func (s *PerformanceServer) protoToStampConfig(sess *v1.StampSession) (stamp.Config, error) {
+	cfg := stamp.Config{
+		Name:     sess.Name,
+		Interval: time.Duration(sess.IntervalMs) * time.Millisecond,
+		Timeout:  time.Duration(sess.TimeoutMs) * time.Millisecond,
+		Window:   int(sess.Window),
+	}
+	port, err := stampPort(sess.Port)
+	if err != nil {
+		return cfg, err
+	}
+	cfg.Port = port
+
+	segments := sess.Segments
+	source := sess.Source
+	switch {
+	case len(segments) > 0 && sess.Policy != "":
+		return cfg, fmt.Errorf("segments and policy are mutually exclusive")
+	case sess.Policy != "":
+		entry, err := s.lookupPolicy(sess.Policy)
+		if err != nil {
+			return cfg, err
+		}
+		segments = bpf.FormatSegments(entry.Segments, entry.NumSegments)
+		if source == "" && entry.SrcAddr != [bpf.IPv6AddrLen]uint8{} {
+			source = bpf.FormatIPv6(entry.SrcAddr)
+		}
+	}
+
+	for _, seg := range segments {
+		addr, err := netip.ParseAddr(seg)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid segment %q: %w", seg, err)
+		}
+		cfg.Segments = append(cfg.Segments, addr)
+	}
+	if source != "" {
+		addr, err := netip.ParseAddr(source)
+		if err != nil || !addr.Is6() {
+			return cfg, fmt.Errorf("invalid IPv6 source %q", source)
+		}
+		cfg.Source = addr
+	}
+	return cfg, nil
+}
+
+func (s *PerformanceServer) lookupPolicy(prefix string) (*bpf.HeadendEntry, error) {
+	if entry, err := s.mapOps.GetHeadendV6(prefix); err == nil {
+		return entry, nil
+	}
+	if entry, err := s.mapOps.GetHeadendV4(prefix); err == nil {
+		return entry, nil
+	}
+	return nil, fmt.Errorf("no headend policy with trigger prefix %s", prefix)
+}
+
+func stampPort(port uint32) (uint16, error) {
+	if port == 0 {
+		return stamp.DefaultPort, nil
+	}
+	if port > 65535 {
+		return 0, fmt.Errorf("invalid UDP port %d", port)
+	}
+	return uint16(port), nil
+}
+
+func stampConfigToProto(cfg stamp.Config) *v1.StampSession {
+	sess := &v1.StampSession{
+		Name:       cfg.Name,
+		Port:       uint32(cfg.Port),
+		IntervalMs: uint32(cfg.Interval.Milliseconds()),
+		TimeoutMs:  uint32(cfg.Timeout.Milliseconds()),
+		Window:     uint32(cfg.Window),
+	}
+	for _, seg := range cfg.Segments {
+		sess.Segments = append(sess.Segments, seg.String())
+	}
+	if cfg.Source.IsValid() {
+		sess.Source = cfg.Source.String()
+	}
+	return sess
+}
+
+func stampStatsToProto(st stamp.Stats) *v1.StampSessionStats {
+	pb := &v1.StampSessionStats{
+		Sent:        st.Sent,
+		Received:    st.Received,
+		Lost:        st.Lost,
+		Window:      uint32(st.Window),
+		WindowLost:  uint32(st.WindowLost),
+		LossRatio:   st.LossRatio(),
+		LastDelayNs: uint64(st.LastDelay),
+		MinDelayNs:  uint64(st.MinDelay),
+		MaxDelayNs:  uint64(st.MaxDelay),
+		AvgDelayNs:  uint64(st.AvgDelay),
+		JitterNs:    uint64(st.Jitter),
+	}
+	if !st.LastReply.IsZero() {
+		pb.LastReplyUnixNano = st.LastReply.UnixNano()
+	}
+	return pb
+}
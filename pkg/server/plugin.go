@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"sort"
 	"sync"
 
 	"connectrpc.com/connect"
@@ -21,10 +22,12 @@ type pluginSlotKey struct {
 // pluginEntry captures per-slot metadata the server needs after the
 // Collection has been closed. AuxType is nil for plugins that did not
 // declare a <program>_aux struct — those plugins can still be driven by
-// plugin_aux_raw (hex), they just lose the JSON path.
+// plugin_aux_raw (hex), they just lose the JSON path. The ELF is kept so
+// Export can hand the plugin to another node.
 type pluginEntry struct {
 	program string
 	auxType *btf.Struct
+	elf     []byte
 }
 
 type PluginServer struct {
@@ -70,6 +73,29 @@ func (s *PluginServer) AuxType(mapType string, slot uint32) *btf.Struct {
 	return entry.auxType
 }
 
+// Registered returns the registration of every plugin in slot order, as
+// the requests that would register it again.
+func (s *PluginServer) Registered() []*v1.PluginRegisterRequest {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	out := make([]*v1.PluginRegisterRequest, 0, len(s.registry))
+	for k, entry := range s.registry {
+		out = append(out, &v1.PluginRegisterRequest{
+			MapType: k.MapType,
+			Index:   k.Slot,
+			BpfElf:  entry.elf,
+			Program: entry.program,
+		})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].MapType != out[j].MapType {
+			return out[i].MapType < out[j].MapType
+		}
+		return out[i].Index < out[j].Index
+	})
+	return out
+}
+
 func (s *PluginServer) PluginRegister(
 	ctx context.Context,
 	req *connect.Request[v1.PluginRegisterRequest],
@@ -161,6 +187,7 @@ func (s *PluginServer) PluginRegister(
 	s.registry[pluginSlotKey{MapType: msg.MapType, Slot: msg.Index}] = &pluginEntry{
 		program: msg.Program,
 		auxType: auxType,
+		elf:     msg.BpfElf,
 	}
 	s.mu.Unlock()
 
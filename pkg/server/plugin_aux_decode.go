@@ -0,0 +1,183 @@
+package server
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"net"
+	"net/netip"
+	"strconv"
+
+	"github.com/cilium/ebpf/btf"
+)
+
+// DecodePluginAux is the inverse of EncodePluginAux: it reads a plugin's
+// aux bytes back into a JSON-ready object using the same BTF layout, so
+// List and Export can show plugin_aux_json that re-encodes to the same
+// bytes. Well-known vinbero typedefs and prefix structs come back in
+// their string form, u8 arrays as contiguous hex. Types the encoder
+// rejects are rejected here too.
+func DecodePluginAux(t *btf.Struct, raw []byte) (map[string]any, error) {
+	if t == nil {
+		return nil, fmt.Errorf("plugin aux type is nil")
+	}
+	if uint32(len(raw)) < t.Size {
+		return nil, fmt.Errorf("aux payload is %d bytes, struct %q needs %d", len(raw), t.Name, t.Size)
+	}
+	return decodeStruct(raw, 0, t)
+}
+
+func decodeStruct(buf []byte, base uint32, s *btf.Struct) (map[string]any, error) {
+	out := make(map[string]any, len(s.Members))
+	for _, m := range s.Members {
+		if m.BitfieldSize != 0 {
+			return nil, fmt.Errorf("field %q: bitfields are not supported", m.Name)
+		}
+		if m.Offset%8 != 0 {
+			return nil, fmt.Errorf("field %q: non-byte-aligned offset", m.Name)
+		}
+		if m.Name == "" {
+			return nil, fmt.Errorf("struct %q: anonymous members are not supported", s.Name)
+		}
+		val, err := decodeType(buf, base+uint32(m.Offset/8), m.Type)
+		if err != nil {
+			return nil, fmt.Errorf("field %q: %w", m.Name, err)
+		}
+		out[m.Name] = val
+	}
+	return out, nil
+}
+
+func decodeType(buf []byte, off uint32, t btf.Type) (any, error) {
+	if td, ok := t.(*btf.Typedef); ok {
+		switch td.Name {
+		case "vinbero_mac_t":
+			if !isByteArrayOfLen(td.Type, 6) {
+				return nil, fmt.Errorf("vinbero_mac_t must be [6]u8")
+			}
+			return net.HardwareAddr(buf[off : off+6]).String(), nil
+		case "vinbero_ipv4_t":
+			if !isByteArrayOfLen(td.Type, 4) {
+				return nil, fmt.Errorf("vinbero_ipv4_t must be [4]u8")
+			}
+			return netip.AddrFrom4([4]byte(buf[off : off+4])).String(), nil
+		case "vinbero_ipv6_t":
+			if !isByteArrayOfLen(td.Type, 16) {
+				return nil, fmt.Errorf("vinbero_ipv6_t must be [16]u8")
+			}
+			return netip.AddrFrom16([16]byte(buf[off : off+16])).String(), nil
+		}
+		return decodeType(buf, off, td.Type)
+	}
+	if vol, ok := t.(*btf.Volatile); ok {
+		return decodeType(buf, off, vol.Type)
+	}
+	if c, ok := t.(*btf.Const); ok {
+		return decodeType(buf, off, c.Type)
+	}
+
+	switch tt := t.(type) {
+	case *btf.Int:
+		return decodeInt(buf, off, tt)
+	case *btf.Array:
+		if isU8(tt.Type) {
+			return hex.EncodeToString(buf[off : off+tt.Nelems]), nil
+		}
+		elemSize, err := sizeOf(tt.Type)
+		if err != nil {
+			return nil, err
+		}
+		arr := make([]any, 0, tt.Nelems)
+		for i := uint32(0); i < tt.Nelems; i++ {
+			v, err := decodeType(buf, off+i*elemSize, tt.Type)
+			if err != nil {
+				return nil, fmt.Errorf("[%d]: %w", i, err)
+			}
+			arr = append(arr, v)
+		}
+		return arr, nil
+	case *btf.Struct:
+		switch tt.Name {
+		case "vinbero_ipv4_prefix_t", "vinbero_ipv6_prefix_t":
+			return decodePrefix(buf, off, tt)
+		}
+		return decodeStruct(buf, off, tt)
+	case *btf.Union:
+		return nil, fmt.Errorf("unions are not supported")
+	case *btf.Enum:
+		return nil, fmt.Errorf("enums are not supported")
+	case *btf.Pointer:
+		return nil, fmt.Errorf("pointers are not supported")
+	}
+	return nil, fmt.Errorf("unsupported BTF type %T", t)
+}
+
+// decodeInt returns int64 for signed and uint64 for unsigned fields, so
+// values above 2^53 survive json.Marshal. Unsigned values past MaxInt64
+// come back as decimal strings, the only form the encoder takes them in.
+func decodeInt(buf []byte, off uint32, t *btf.Int) (any, error) {
+	if off+t.Size > uint32(len(buf)) {
+		return nil, fmt.Errorf("int read past buffer end")
+	}
+	src := buf[off : off+t.Size]
+	var u uint64
+	switch t.Size {
+	case 1:
+		u = uint64(src[0])
+		if t.Encoding == btf.Signed {
+			return int64(int8(u)), nil
+		}
+	case 2:
+		u = uint64(binary.NativeEndian.Uint16(src))
+		if t.Encoding == btf.Signed {
+			return int64(int16(u)), nil
+		}
+	case 4:
+		u = uint64(binary.NativeEndian.Uint32(src))
+		if t.Encoding == btf.Signed {
+			return int64(int32(u)), nil
+		}
+	case 8:
+		u = binary.NativeEndian.Uint64(src)
+		if t.Encoding == btf.Signed {
+			return int64(u), nil
+		}
+		if u > math.MaxInt64 {
+			return strconv.FormatUint(u, 10), nil
+		}
+	default:
+		return nil, fmt.Errorf("unsupported int size %d", t.Size)
+	}
+	return u, nil
+}
+
+// decodePrefix reads the prefix_len and addr members of a prefix struct
+// (layout in writePrefixFields) into CIDR notation.
+func decodePrefix(buf []byte, base uint32, s *btf.Struct) (string, error) {
+	var plen uint8
+	var addr netip.Addr
+	var haveLen, haveAddr bool
+	for _, m := range s.Members {
+		off := base + uint32(m.Offset/8)
+		switch m.Name {
+		case "prefix_len":
+			plen = buf[off]
+			haveLen = true
+		case "addr":
+			if s.Name == "vinbero_ipv4_prefix_t" {
+				addr = netip.AddrFrom4([4]byte(buf[off : off+4]))
+			} else {
+				addr = netip.AddrFrom16([16]byte(buf[off : off+16]))
+			}
+			haveAddr = true
+		}
+	}
+	if !haveLen || !haveAddr {
+		return "", fmt.Errorf("struct %q missing prefix_len or addr member", s.Name)
+	}
+	if int(plen) > addr.BitLen() {
+		return "", fmt.Errorf("prefix length %d out of range", plen)
+	}
+	return netip.PrefixFrom(addr, int(plen)).String(), nil
+}
@@ -0,0 +1,74 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/cilium/ebpf/btf"
+)
+
+// TestDecode_RoundTrip checks that decoding the encoder's output yields
+// JSON that encodes back to the same bytes.
+func TestDecode_RoundTrip(t *testing.T) {
+	s := &btf.Struct{
+		Name: "rt_aux",
+		Size: 64,
+		Members: []btf.Member{
+			{Name: "id", Type: u32Type(), Offset: 0},
+			{Name: "delta", Type: s32Type(), Offset: 32},
+			{Name: "big", Type: u64Type(), Offset: 64},
+			{Name: "mac", Type: macTypedef(), Offset: 128},
+			{Name: "_pad", Type: &btf.Array{Type: u8Type(), Nelems: 2}, Offset: 176},
+			{Name: "src", Type: ipv4Typedef(), Offset: 192},
+			{Name: "dst", Type: ipv6Typedef(), Offset: 224},
+			{Name: "v4net", Type: ipv4PrefixStruct(), Offset: 352},
+			{Name: "weights", Type: &btf.Array{Type: u32Type(), Nelems: 2}, Offset: 416},
+			{Name: "tag", Type: &btf.Array{Type: u8Type(), Nelems: 4}, Offset: 480},
+		},
+	}
+
+	in := `{"id": 7, "delta": -3, "big": "18446744073709551615", "mac": "aa:bb:cc:dd:ee:ff",
+		"src": "10.0.0.1", "dst": "fc00::1", "v4net": "192.0.2.0/24", "weights": [1, 2], "tag": "deadbeef"}`
+	want, err := EncodePluginAux(s, parseJSON(t, in))
+	if err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+
+	obj, err := DecodePluginAux(s, want)
+	if err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if obj["mac"] != "aa:bb:cc:dd:ee:ff" || obj["src"] != "10.0.0.1" || obj["v4net"] != "192.0.2.0/24" {
+		t.Errorf("well-known types: got %v", obj)
+	}
+	if obj["delta"] != int64(-3) || obj["big"] != "18446744073709551615" {
+		t.Errorf("ints: got delta=%v big=%v", obj["delta"], obj["big"])
+	}
+
+	js, err := json.Marshal(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := EncodePluginAux(s, parseJSON(t, string(js)))
+	if err != nil {
+		t.Fatalf("re-encode %s: %v", js, err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("round trip mismatch:\n got %x\nwant %x", got, want)
+	}
+}
+
+func TestDecode_Unsupported(t *testing.T) {
+	s := &btf.Struct{
+		Name:    "u_aux",
+		Size:    4,
+		Members: []btf.Member{{Name: "u", Type: &btf.Union{Name: "x", Size: 4}, Offset: 0}},
+	}
+	if _, err := DecodePluginAux(s, make([]byte, 4)); err == nil {
+		t.Error("expected error for union member")
+	}
+	if _, err := DecodePluginAux(s, make([]byte, 2)); err == nil {
+		t.Error("expected error for short payload")
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"sync"
 
 	"go.uber.org/zap"
 	"golang.org/x/net/http2"
@@ -14,6 +15,9 @@ import (
 	"github.com/takehaya/vinbero/pkg/config"
 	"github.com/takehaya/vinbero/pkg/netlinkwatch"
 	"github.com/takehaya/vinbero/pkg/netresource"
+	"github.com/takehaya/vinbero/pkg/oam"
+	"github.com/takehaya/vinbero/pkg/stamp"
+	"github.com/takehaya/vinbero/pkg/store"
 )
 
 // Server represents the Connect RPC server
@@ -22,98 +26,218 @@ type Server struct {
 	mapOps     *bpf.MapOperations
 	resMgr     *netresource.ResourceManager
 	fdbWatcher *netlinkwatch.FDBWatcher
+	oamPunts   *oam.PuntReader
+	resizer    MapResizer
+	maps       *mapMonitor
+	store      *store.Store
+	stamp      *stamp.Manager
 	logger     *zap.Logger
 	mux        *http.ServeMux
 	server     *http.Server
+	setupOnce  sync.Once
+
+	// static is the config: section last applied; reloadMu serializes
+	// ApplyStaticConfig and Reload.
+	static   *config.StaticConfig
+	reloadMu sync.Mutex
+
+	// pauseMu is held shared by every API call and exclusively by
+	// ResizeMap, so no call sees the maps while they are swapped
+	pauseMu sync.RWMutex
+
+	// journalMu serializes journaled calls with their store append, so
+	// the journal holds them in the order they reached the maps
+	journalMu sync.Mutex
+
+	// Handlers built by Setup that ApplyStaticConfig, Export and Import
+	// drive directly
+	plugin          *PluginServer
+	fdb             *FdbServer
+	sidFunction     *SidFunctionServer
+	headendv4       *Headendv4Server
+	headendv6       *Headendv6Server
+	headendL2       *HeadendL2Server
+	bdPeer          *BdPeerServer
+	ethernetSegment *EthernetSegmentServer
+	netResource     *NetworkResourceServer
+	vlanTable       *VlanTableServer
+	bridgeDomain    *BridgeDomainServer
+	srDomain        *SrDomainServer
+	vniMapping      *VniMappingServer
+	performance     *PerformanceServer
 }
 
-// NewServer creates a new Server instance
-func NewServer(cfg *config.Config, mapOps *bpf.MapOperations, resMgr *netresource.ResourceManager, fdbWatcher *netlinkwatch.FDBWatcher, logger *zap.Logger) *Server {
-	return &Server{
+// NewServer creates a new Server instance. st may be nil when
+// settings.store is disabled.
+func NewServer(cfg *config.Config, mapOps *bpf.MapOperations, resMgr *netresource.ResourceManager, fdbWatcher *netlinkwatch.FDBWatcher, oamPunts *oam.PuntReader, resizer MapResizer, st *store.Store, logger *zap.Logger) *Server {
+	s := &Server{
 		cfg:        cfg,
 		mapOps:     mapOps,
 		resMgr:     resMgr,
 		fdbWatcher: fdbWatcher,
+		oamPunts:   oamPunts,
+		resizer:    resizer,
+		maps:       newMapMonitor(cfg.Setting.MapUsage, logger),
+		store:      st,
+		stamp:      stamp.NewManager(logger),
 		logger:     logger,
 		mux:        http.NewServeMux(),
 	}
+	if mapOps != nil {
+		mapOps.OnMapCount(s.maps.observeCount)
+	}
+	return s
 }
 
-// Setup registers all service handlers
+// Setup registers all service handlers. Calls after the first are no-ops.
 func (s *Server) Setup() {
+	s.setupOnce.Do(s.setup)
+}
+
+func (s *Server) setup() {
 	// Plugin service is constructed first so SidFunctionServer can resolve
 	// per-slot aux BTF types when callers use plugin_aux_json. The actual
 	// handler registration happens further down with the other services.
 	pluginServer := NewPluginServer(s.mapOps, s.cfg.BpfConstants())
 
+	// Every handler journals its accepted mutations when the store is on
+	opts := s.handlerOptions()
+
 	// SidFunction service
 	sidFunctionServer := NewSidFunctionServer(s.mapOps, pluginServer)
-	path, handler := vinberov1connect.NewSidFunctionServiceHandler(sidFunctionServer)
+	path, handler := vinberov1connect.NewSidFunctionServiceHandler(sidFunctionServer, opts...)
 	s.mux.Handle(path, handler)
 	s.logger.Info("Registered SidFunctionService", zap.String("path", path))
 
 	// Headendv4 service
 	headendv4Server := NewHeadendv4Server(s.mapOps)
-	path, handler = vinberov1connect.NewHeadendv4ServiceHandler(headendv4Server)
+	path, handler = vinberov1connect.NewHeadendv4ServiceHandler(headendv4Server, opts...)
 	s.mux.Handle(path, handler)
 	s.logger.Info("Registered Headendv4Service", zap.String("path", path))
 
 	// Headendv6 service
 	headendv6Server := NewHeadendv6Server(s.mapOps)
-	path, handler = vinberov1connect.NewHeadendv6ServiceHandler(headendv6Server)
+	path, handler = vinberov1connect.NewHeadendv6ServiceHandler(headendv6Server, opts...)
 	s.mux.Handle(path, handler)
 	s.logger.Info("Registered Headendv6Service", zap.String("path", path))
 
 	// HeadendL2 service
 	headendL2Server := NewHeadendL2Server(s.mapOps)
-	path, handler = vinberov1connect.NewHeadendL2ServiceHandler(headendL2Server)
+	path, handler = vinberov1connect.NewHeadendL2ServiceHandler(headendL2Server, opts...)
 	s.mux.Handle(path, handler)
 	s.logger.Info("Registered HeadendL2Service", zap.String("path", path))
 
 	// BdPeer service (for P2MP BUM flooding)
 	bdPeerServer := NewBdPeerServer(s.mapOps)
-	path, handler = vinberov1connect.NewBdPeerServiceHandler(bdPeerServer)
+	path, handler = vinberov1connect.NewBdPeerServiceHandler(bdPeerServer, opts...)
 	s.mux.Handle(path, handler)
 	s.logger.Info("Registered BdPeerService", zap.String("path", path))
 
 	// Ethernet Segment service (RFC 7432 ESI master table)
 	esServer := NewEthernetSegmentServer(s.mapOps)
-	path, handler = vinberov1connect.NewEthernetSegmentServiceHandler(esServer)
+	path, handler = vinberov1connect.NewEthernetSegmentServiceHandler(esServer, opts...)
 	s.mux.Handle(path, handler)
 	s.logger.Info("Registered EthernetSegmentService", zap.String("path", path))
 
 	// NetworkResource service (VRF/Bridge management)
 	netResourceServer := NewNetworkResourceServer(s.resMgr, s.fdbWatcher, s.mapOps)
-	path, handler = vinberov1connect.NewNetworkResourceServiceHandler(netResourceServer)
+	path, handler = vinberov1connect.NewNetworkResourceServiceHandler(netResourceServer, opts...)
 	s.mux.Handle(path, handler)
 	s.logger.Info("Registered NetworkResourceService", zap.String("path", path))
 
+	// BridgeDomain service (named BDs owning bridge, ACs, SIDs, peers and
+	// ESs, plus per-BD / per-AC MAC learning controls). Builds members
+	// through the servers above so they share their validation.
+	bridgeDomainServer := NewBridgeDomainServer(s.mapOps, s.resMgr,
+		sidFunctionServer, headendL2Server, bdPeerServer, esServer, netResourceServer)
+	path, handler = vinberov1connect.NewBridgeDomainServiceHandler(bridgeDomainServer, opts...)
+	s.mux.Handle(path, handler)
+	s.logger.Info("Registered BridgeDomainService", zap.String("path", path))
+
 	// FDB service (list, create/delete static entries)
 	fdbServer := NewFdbServer(s.mapOps)
-	path, handler = vinberov1connect.NewFdbServiceHandler(fdbServer)
+	path, handler = vinberov1connect.NewFdbServiceHandler(fdbServer, opts...)
 	s.mux.Handle(path, handler)
 	s.logger.Info("Registered FdbService", zap.String("path", path))
 
 	// VlanTable service (VLAN cross-connect for End.DX2V)
 	vlanTableServer := NewVlanTableServer(s.mapOps)
-	path, handler = vinberov1connect.NewVlanTableServiceHandler(vlanTableServer)
+	path, handler = vinberov1connect.NewVlanTableServiceHandler(vlanTableServer, opts...)
 	s.mux.Handle(path, handler)
 	s.logger.Info("Registered VlanTableService", zap.String("path", path))
 
+	s.plugin = pluginServer
+	s.fdb = fdbServer
+	s.sidFunction = sidFunctionServer
+	s.headendv4 = headendv4Server
+	s.headendv6 = headendv6Server
+	s.headendL2 = headendL2Server
+	s.bdPeer = bdPeerServer
+	s.ethernetSegment = esServer
+	s.netResource = netResourceServer
+	s.vlanTable = vlanTableServer
+	s.bridgeDomain = bridgeDomainServer
+
+	// SrDomain service (RFC 8754 SR domain boundary protection)
+	srDomainServer := NewSrDomainServer(s.mapOps)
+	path, handler = vinberov1connect.NewSrDomainServiceHandler(srDomainServer, opts...)
+	s.mux.Handle(path, handler)
+	s.logger.Info("Registered SrDomainService", zap.String("path", path))
+	s.srDomain = srDomainServer
+
+	// VniMapping service (VXLAN <-> SRv6 gateway)
+	vniMappingServer := NewVniMappingServer(s.mapOps)
+	path, handler = vinberov1connect.NewVniMappingServiceHandler(vniMappingServer, opts...)
+	s.mux.Handle(path, handler)
+	s.logger.Info("Registered VniMappingService", zap.String("path", path))
+	s.vniMapping = vniMappingServer
+
+	// OAM service (RFC 9259 SRv6 ping/traceroute and O-flag punts)
+	oamServer := NewOamServer(s.oamPunts)
+	path, handler = vinberov1connect.NewOamServiceHandler(oamServer, opts...)
+	s.mux.Handle(path, handler)
+	s.logger.Info("Registered OamService", zap.String("path", path))
+
+	// Performance service (STAMP reflectors and Session-Senders)
+	performanceServer := NewPerformanceServer(s.mapOps, s.stamp)
+	path, handler = vinberov1connect.NewPerformanceServiceHandler(performanceServer, opts...)
+	s.mux.Handle(path, handler)
+	s.logger.Info("Registered PerformanceService", zap.String("path", path))
+	s.performance = performanceServer
+
 	// Plugin service (dynamic BPF plugin registration). pluginServer was
 	// created at the top of Setup() so SidFunctionServer could hold a
 	// reference for plugin_aux_json encoding.
-	path, handler = vinberov1connect.NewPluginServiceHandler(pluginServer)
+	path, handler = vinberov1connect.NewPluginServiceHandler(pluginServer, opts...)
 	s.mux.Handle(path, handler)
 	s.logger.Info("Registered PluginService", zap.String("path", path))
 
 	// Stats service (read-only, for observability). Depends on pluginServer
 	// for resolving per-slot stat labels to plugin program names.
 	statsServer := NewStatsServer(s.mapOps, pluginServer)
-	path, handler = vinberov1connect.NewStatsServiceHandler(statsServer)
+	path, handler = vinberov1connect.NewStatsServiceHandler(statsServer, opts...)
 	s.mux.Handle(path, handler)
 	s.logger.Info("Registered StatsService", zap.String("path", path))
 
+	// Daemon service (config reload)
+	daemonServer := NewDaemonServer(s.Reload)
+	path, handler = vinberov1connect.NewDaemonServiceHandler(daemonServer, opts...)
+	s.mux.Handle(path, handler)
+	s.logger.Info("Registered DaemonService", zap.String("path", path))
+
+	// System service (whole-node export and import, map usage, events
+	// and resize)
+	systemServer := NewSystemServer(s.Export, s.Import, s.MapUsage, s.MapEvents, s.ResizeMap)
+	path, handler = vinberov1connect.NewSystemServiceHandler(systemServer, opts...)
+	s.mux.Handle(path, handler)
+	s.logger.Info("Registered SystemService", zap.String("path", path))
+
+	// Transaction service (all-or-nothing batches across resource types)
+	transactionServer := NewTransactionServer(s.CommitTransaction)
+	path, handler = vinberov1connect.NewTransactionServiceHandler(transactionServer, opts...)
+	s.mux.Handle(path, handler)
+	s.logger.Info("Registered TransactionService", zap.String("path", path))
+
 	// Health check endpoint
 	s.mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
@@ -134,8 +258,9 @@ func (s *Server) Start() error {
 	return s.server.ListenAndServe()
 }
 
-// Shutdown gracefully shuts down the server
+// Shutdown gracefully shuts down the server and stops STAMP sessions
 func (s *Server) Shutdown(ctx context.Context) error {
+	s.stamp.Close()
 	if s.server == nil {
 		return nil
 	}
@@ -69,6 +69,67 @@ func (s *SidFunctionServer) SidFunctionCreate(
 	return connect.NewResponse(resp), nil
 }
 
+// SidFunctionUpdate changes installed SID function entries in place. The
+// entry is overwritten rather than deleted and re-created, so packets keep
+// matching the SID throughout; new aux data is written to its own slot
+// before the entry is switched to it.
+func (s *SidFunctionServer) SidFunctionUpdate(
+	ctx context.Context,
+	req *connect.Request[v1.SidFunctionUpdateRequest],
+) (*connect.Response[v1.SidFunctionUpdateResponse], error) {
+	resp := &v1.SidFunctionUpdateResponse{
+		Updated: make([]*v1.SidFunction, 0),
+		Errors:  make([]*v1.OperationError, 0),
+	}
+	mask := req.Msg.UpdateMask
+
+	for _, sidFunc := range req.Msg.SidFunctions {
+		current, err := s.mapOps.GetSidFunction(sidFunc.TriggerPrefix)
+		if err != nil {
+			resp.Errors = append(resp.Errors, &v1.OperationError{
+				TriggerPrefix: sidFunc.TriggerPrefix,
+				Reason:        fmt.Sprintf("not found: %v", err),
+			})
+			continue
+		}
+		merged, err := mergeUpdate(s.entryToProto(sidFunc.TriggerPrefix, current), sidFunc, mask, "trigger_prefix")
+		if err != nil {
+			resp.Errors = append(resp.Errors, &v1.OperationError{
+				TriggerPrefix: sidFunc.TriggerPrefix,
+				Reason:        err.Error(),
+			})
+			continue
+		}
+		// The two plugin aux forms exclude each other; setting one drops
+		// the installed other
+		if masked(mask, "plugin_aux_raw") && len(sidFunc.PluginAuxRaw) > 0 {
+			merged.PluginAuxJson = ""
+		}
+		if masked(mask, "plugin_aux_json") && sidFunc.PluginAuxJson != "" {
+			merged.PluginAuxRaw = nil
+		}
+
+		entry, aux, err := s.protoToEntry(merged)
+		if err != nil {
+			resp.Errors = append(resp.Errors, &v1.OperationError{
+				TriggerPrefix: sidFunc.TriggerPrefix,
+				Reason:        err.Error(),
+			})
+			continue
+		}
+		if err := s.mapOps.CreateSidFunction(merged.TriggerPrefix, entry, aux); err != nil {
+			resp.Errors = append(resp.Errors, &v1.OperationError{
+				TriggerPrefix: sidFunc.TriggerPrefix,
+				Reason:        err.Error(),
+			})
+			continue
+		}
+		resp.Updated = append(resp.Updated, merged)
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
 // SidFunctionDelete deletes SID function entries
 func (s *SidFunctionServer) SidFunctionDelete(
 	ctx context.Context,
@@ -151,6 +212,12 @@ func (s *SidFunctionServer) protoToEntry(sidFunc *v1.SidFunction) (*bpf.SidFunct
 		Action: uint8(sidFunc.Action),
 		Flavor: uint8(sidFunc.Flavor),
 	}
+	if sidFunc.EcnPropagate {
+		if !sidFunctionDecaps(sidFunc) {
+			return nil, nil, fmt.Errorf("ecn_propagate requires End.DX4/DX6/DT4/DT6/DT46 or the USD flavor")
+		}
+		entry.Flags |= bpf.SidFlagEcnPropagate
+	}
 
 	// Resolve vrf_name → vrf_ifindex for End.T/DT4/DT6/DT46. The resolved
 	// ifindex is stored in the l3vrf aux variant below.
@@ -301,12 +368,27 @@ func (s *SidFunctionServer) encodePluginAuxJSON(sidFunc *v1.SidFunction) ([]byte
 	return EncodePluginAux(auxType, payload)
 }
 
+// sidFunctionDecaps reports whether the SID strips the outer IPv6 header
+// and forwards an inner IPv4/IPv6 packet.
+func sidFunctionDecaps(sidFunc *v1.SidFunction) bool {
+	switch sidFunc.Action {
+	case v1.Srv6LocalAction_SRV6_LOCAL_ACTION_END_DX4,
+		v1.Srv6LocalAction_SRV6_LOCAL_ACTION_END_DX6,
+		v1.Srv6LocalAction_SRV6_LOCAL_ACTION_END_DT4,
+		v1.Srv6LocalAction_SRV6_LOCAL_ACTION_END_DT6,
+		v1.Srv6LocalAction_SRV6_LOCAL_ACTION_END_DT46:
+		return true
+	}
+	return sidFunc.Flavor == v1.Srv6LocalFlavor_SRV6_LOCAL_FLAVOR_USD
+}
+
 // entryToProto converts a BPF map entry to a protobuf SidFunction
 func (s *SidFunctionServer) entryToProto(prefix string, entry *bpf.SidFunctionEntry) *v1.SidFunction {
 	sf := &v1.SidFunction{
 		Action:        v1.Srv6LocalAction(entry.Action),
 		TriggerPrefix: prefix,
 		Flavor:        v1.Srv6LocalFlavor(entry.Flavor),
+		EcnPropagate:  entry.Flags&bpf.SidFlagEcnPropagate != 0,
 	}
 
 	// Read aux data if present
@@ -357,6 +439,11 @@ func (s *SidFunctionServer) entryToProto(prefix string, entry *bpf.SidFunctionEn
 				sf.Segments = bpf.FormatSegments(policy.Segments, policy.NumSegments)
 				sf.HeadendMode = v1.Srv6HeadendBehavior(policy.Mode)
 				sf.SrcAddr = bpf.FormatIPv6(policy.SrcAddr)
+
+			default:
+				if entry.Action >= bpf.EndpointPluginBase {
+					s.pluginAuxToProto(sf, aux)
+				}
 			}
 		}
 	}
@@ -364,6 +451,25 @@ func (s *SidFunctionServer) entryToProto(prefix string, entry *bpf.SidFunctionEn
 	return sf
 }
 
+// pluginAuxToProto fills plugin_aux_json when the plugin registered at the
+// SID's slot declares its aux type, and plugin_aux_raw otherwise.
+func (s *SidFunctionServer) pluginAuxToProto(sf *v1.SidFunction, aux *bpf.SidAuxEntry) {
+	raw := bpf.SidAuxPluginRawData(aux)
+	if s.pluginAux != nil {
+		if auxType := s.pluginAux.AuxType(bpf.MapTypeEndpoint, uint32(sf.Action)); auxType != nil {
+			full := make([]byte, bpf.SidAuxPluginRawMax)
+			copy(full, raw)
+			if obj, err := DecodePluginAux(auxType, full); err == nil {
+				if js, err := json.Marshal(obj); err == nil {
+					sf.PluginAuxJson = string(js)
+					return
+				}
+			}
+		}
+	}
+	sf.PluginAuxRaw = raw
+}
+
 // buildPolicyEntry creates a HeadendEntry for the End.B6 policy map
 func (s *SidFunctionServer) buildPolicyEntry(sidFunc *v1.SidFunction) (*bpf.HeadendEntry, error) {
 	srcAddr, err := bpf.ParseIPv6(sidFunc.SrcAddr)
@@ -0,0 +1,169 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"connectrpc.com/connect"
+	v1 "github.com/takehaya/vinbero/api/vinbero/v1"
+	"github.com/takehaya/vinbero/pkg/bpf"
+)
+
+// SrDomainServer implements the SrDomainServiceHandler interface
+type SrDomainServer struct {
+	mapOps *bpf.MapOperations
+}
+
+// NewSrDomainServer creates a new SrDomainServer
+func NewSrDomainServer(mapOps *bpf.MapOperations) *SrDomainServer {
+	return &SrDomainServer{mapOps: mapOps}
+}
+
+// SrDomainCreate installs or replaces boundary policies
+func (s *SrDomainServer) SrDomainCreate(
+	ctx context.Context,
+	req *connect.Request[v1.SrDomainCreateRequest],
+) (*connect.Response[v1.SrDomainCreateResponse], error) {
+	resp := &v1.SrDomainCreateResponse{
+		Created: make([]*v1.SrDomainPolicy, 0),
+		Errors:  make([]*v1.OperationError, 0),
+	}
+
+	for _, policy := range req.Msg.Policies {
+		ifindexes, err := validateSrDomainPolicy(policy)
+		if err != nil {
+			resp.Errors = append(resp.Errors, &v1.OperationError{
+				TriggerPrefix: policy.Locator,
+				Reason:        err.Error(),
+			})
+			continue
+		}
+
+		if err := s.mapOps.CreateSrDomain(policy.Locator, policy.TrustedSrcPrefixes, ifindexes); err != nil {
+			resp.Errors = append(resp.Errors, &v1.OperationError{
+				TriggerPrefix: policy.Locator,
+				Reason:        err.Error(),
+			})
+			continue
+		}
+
+		resp.Created = append(resp.Created, policy)
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// SrDomainDelete removes boundary policies by locator
+func (s *SrDomainServer) SrDomainDelete(
+	ctx context.Context,
+	req *connect.Request[v1.SrDomainDeleteRequest],
+) (*connect.Response[v1.SrDomainDeleteResponse], error) {
+	resp := &v1.SrDomainDeleteResponse{
+		DeletedLocators: make([]string, 0),
+		Errors:          make([]*v1.OperationError, 0),
+	}
+
+	for _, locator := range req.Msg.Locators {
+		if err := s.mapOps.DeleteSrDomain(locator); err != nil {
+			resp.Errors = append(resp.Errors, &v1.OperationError{
+				TriggerPrefix: locator,
+				Reason:        err.Error(),
+			})
+			continue
+		}
+
+		resp.DeletedLocators = append(resp.DeletedLocators, locator)
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// SrDomainFlush removes every boundary policy.
+func (s *SrDomainServer) SrDomainFlush(
+	ctx context.Context,
+	req *connect.Request[v1.SrDomainFlushRequest],
+) (*connect.Response[v1.SrDomainFlushResponse], error) {
+	count, err := s.mapOps.FlushSrDomains()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&v1.SrDomainFlushResponse{DeletedCount: count}), nil
+}
+
+// SrDomainList lists all boundary policies
+func (s *SrDomainServer) SrDomainList(
+	ctx context.Context,
+	req *connect.Request[v1.SrDomainListRequest],
+) (*connect.Response[v1.SrDomainListResponse], error) {
+	policies, err := s.mapOps.ListSrDomains()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := &v1.SrDomainListResponse{
+		Policies: make([]*v1.SrDomainPolicy, 0, len(policies)),
+	}
+
+	for _, p := range policies {
+		interfaces := make([]string, 0, len(p.TrustedIfindexes))
+		for _, ifindex := range p.TrustedIfindexes {
+			name := ifindexToName(ifindex)
+			if name == "" {
+				name = fmt.Sprintf("ifindex:%d", ifindex)
+			}
+			interfaces = append(interfaces, name)
+		}
+		sort.Strings(p.TrustedSrcPrefixes)
+		sort.Strings(interfaces)
+		resp.Policies = append(resp.Policies, &v1.SrDomainPolicy{
+			Locator:            p.Locator,
+			TrustedSrcPrefixes: p.TrustedSrcPrefixes,
+			TrustedInterfaces:  interfaces,
+		})
+	}
+	sort.Slice(resp.Policies, func(i, j int) bool {
+		return resp.Policies[i].Locator < resp.Policies[j].Locator
+	})
+
+	return connect.NewResponse(resp), nil
+}
+
+// validateSrDomainPolicy checks that the locator and trusted sources are
+// IPv6 prefixes and resolves trusted interface names to ifindexes. A policy
+// with neither list would drop every packet to the locator, so it is refused.
+func validateSrDomainPolicy(policy *v1.SrDomainPolicy) ([]uint32, error) {
+	if err := requireIPv6Prefix(policy.Locator); err != nil {
+		return nil, fmt.Errorf("locator: %w", err)
+	}
+	if len(policy.TrustedSrcPrefixes) == 0 && len(policy.TrustedInterfaces) == 0 {
+		return nil, fmt.Errorf("at least one trusted_src_prefixes or trusted_interfaces entry is required")
+	}
+	for _, prefix := range policy.TrustedSrcPrefixes {
+		if err := requireIPv6Prefix(prefix); err != nil {
+			return nil, fmt.Errorf("trusted source: %w", err)
+		}
+	}
+
+	ifindexes := make([]uint32, 0, len(policy.TrustedInterfaces))
+	for _, name := range policy.TrustedInterfaces {
+		ifindex, err := resolveIfindex(name)
+		if err != nil {
+			return nil, err
+		}
+		ifindexes = append(ifindexes, ifindex)
+	}
+	return ifindexes, nil
+}
+
+// requireIPv6Prefix rejects anything but an IPv6 CIDR or address.
+func requireIPv6Prefix(s string) error {
+	ip, _, err := bpf.ParseCIDR(s)
+	if err != nil {
+		return err
+	}
+	if ip.To4() != nil {
+		return fmt.Errorf("not an IPv6 prefix: %s", s)
+	}
+	return nil
+}
@@ -0,0 +1,785 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/netip"
+	"strings"
+
+	"connectrpc.com/connect"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	v1 "github.com/takehaya/vinbero/api/vinbero/v1"
+	"github.com/takehaya/vinbero/pkg/bpf"
+	"github.com/takehaya/vinbero/pkg/config"
+	"github.com/takehaya/vinbero/pkg/stamp"
+)
+
+// ApplyStaticConfig installs the config: section through the Create
+// handlers, so entries get the same validation as over the API. Sections
+// go in dependency order: VRFs and bridges before the SIDs naming them,
+// ESs before the ACs and peers carrying an ESI. A failed entry doesn't
+// stop the others; every error is logged and returned.
+func (s *Server) ApplyStaticConfig(ctx context.Context, sc *config.StaticConfig) []*v1.ConfigSectionResult {
+	s.Setup()
+
+	s.reloadMu.Lock()
+	defer s.reloadMu.Unlock()
+
+	sections := s.reconcileStatic(ctx, &config.StaticConfig{}, sc)
+	s.static = sc
+	return sections
+}
+
+// Reload re-reads the config file and reconciles its config: section
+// against the maps: only entries that are missing, changed or dropped
+// from the file are touched. Entries created over the API under keys the
+// file never declared are left alone. Keys outside config: that differ
+// from the running configuration are returned as RestartRequired; the
+// daemon keeps running with its old values.
+func (s *Server) Reload(ctx context.Context) (*v1.ReloadResponse, error) {
+	s.Setup()
+
+	s.reloadMu.Lock()
+	defer s.reloadMu.Unlock()
+
+	if s.cfg.Configpath == "" {
+		return nil, fmt.Errorf("reload: vinberod was not started from a config file")
+	}
+	next, err := config.LoadFile(s.cfg.Configpath)
+	if err != nil {
+		return nil, fmt.Errorf("reload: %w", err)
+	}
+
+	resp := &v1.ReloadResponse{
+		RestartRequired: config.RestartRequired(s.cfg, next),
+	}
+	for _, key := range resp.RestartRequired {
+		s.logger.Warn("Config change needs a restart to take effect", zap.String("key", key))
+	}
+
+	prev := s.static
+	if prev == nil {
+		prev = &config.StaticConfig{}
+	}
+	resp.Sections = s.reconcileStatic(ctx, prev, &next.Static)
+	s.static = &next.Static
+
+	return resp, nil
+}
+
+// reconcileStatic moves the maps from the entries of prev to those of
+// next.
+func (s *Server) reconcileStatic(ctx context.Context, prev, next *config.StaticConfig) []*v1.ConfigSectionResult {
+	return s.reconcile(ctx, "static config", staticNode(prev), staticNode(next), true)
+}
+
+// reconcile applies next over the entries of prev. Creates and updates
+// run in dependency order, deletes in reverse so a VRF outlives the SIDs
+// that name it. Without prune, keys only prev declares are left alone.
+func (s *Server) reconcile(ctx context.Context, source string, prev, next *v1.NodeConfig, prune bool) []*v1.ConfigSectionResult {
+	plans := s.planNode(ctx, prev, next, prune)
+	for _, p := range plans {
+		p.apply(ctx)
+	}
+	for i := len(plans) - 1; i >= 0; i-- {
+		plans[i].remove(ctx)
+	}
+
+	var sections []*v1.ConfigSectionResult
+	for _, p := range plans {
+		sec := p.result()
+		if sec == nil {
+			continue
+		}
+		for _, e := range sec.Errors {
+			s.logger.Warn("Config entry rejected",
+				zap.String("source", source),
+				zap.String("section", sec.Name),
+				zap.String("entry", e.TriggerPrefix),
+				zap.String("reason", e.Reason))
+		}
+		s.logger.Info("Applied config section",
+			zap.String("source", source),
+			zap.String("section", sec.Name),
+			zap.Uint32("created", sec.Created),
+			zap.Uint32("updated", sec.Updated),
+			zap.Uint32("deleted", sec.Deleted),
+			zap.Uint32("unchanged", sec.Unchanged),
+			zap.Int("errors", len(sec.Errors)))
+		sections = append(sections, sec)
+	}
+	return sections
+}
+
+// staticNode views a config: section as a NodeConfig.
+func staticNode(sc *config.StaticConfig) *v1.NodeConfig {
+	return &v1.NodeConfig{
+		Vrfs:             sc.Vrfs,
+		Bridges:          sc.Bridges,
+		EthernetSegments: sc.EthernetSegments,
+		SidFunctions:     sc.SidFunctions,
+		Headendv4S:       sc.Headendv4s,
+		Headendv6S:       sc.Headendv6s,
+		HeadendL2S:       sc.HeadendL2s,
+		BdPeers:          sc.BdPeers,
+		VlanTable:        sc.VlanTable,
+	}
+}
+
+// Keys of each resource in the maps, shared by the planner and Export.
+func vrfKey(e *v1.Vrf) string                  { return e.Name }
+func bridgeKey(e *v1.Bridge) string            { return e.Name }
+func esKey(e *v1.EthernetSegment) string       { return normESI(e.Esi) }
+func sidKey(e *v1.SidFunction) string          { return normPrefix(e.TriggerPrefix) }
+func headendv4Key(e *v1.Headendv4) string      { return normPrefix(e.TriggerPrefix) }
+func headendv6Key(e *v1.Headendv6) string      { return normPrefix(e.TriggerPrefix) }
+func bdPeerKey(e *v1.BdPeer) string            { return fmt.Sprintf("%d/%s", e.BdId, normAddr(e.SrcAddr)) }
+func vlanTableKey(e *v1.VlanTableEntry) string { return fmt.Sprintf("%d/%d", e.TableId, e.VlanId) }
+func fdbKey(e *v1.FdbEntry) string             { return fmt.Sprintf("%d/%s", e.BdId, normMAC(e.Mac)) }
+func pluginKey(e *v1.PluginRegisterRequest) string {
+	return fmt.Sprintf("%s/%d", e.MapType, e.Index)
+}
+func headendL2Key(e *v1.HeadendL2) string {
+	return fmt.Sprintf("%s/%d/%d/%t", e.InterfaceName, e.VlanId, e.InnerVlanId, e.PortMode)
+}
+func srDomainKey(e *v1.SrDomainPolicy) string   { return normPrefix(e.Locator) }
+func bridgeDomainKey(e *v1.BridgeDomain) string { return e.Name }
+func neighborKey(e *v1.Neighbor) string         { return fmt.Sprintf("%d/%s", e.BdId, normAddr(e.Ip)) }
+func irbRouteKey(e *v1.IrbRoute) string         { return fmt.Sprintf("%s/%s", e.VrfName, normPrefix(e.Prefix)) }
+func vniMappingKey(e *v1.VniMapping) string     { return fmt.Sprintf("%d", e.Vni) }
+func macLimitScopeKey(e *v1.MacLimit) string {
+	return bdScopeTarget(e.BdId, e.InterfaceName, e.VlanId, e.PortMode)
+}
+func stormControlScopeKey(e *v1.StormControl) string {
+	return bdScopeTarget(e.BdId, e.InterfaceName, e.VlanId, e.PortMode)
+}
+func stampReflectorKey(e *v1.StampReflector) string {
+	port := e.Port
+	if port == 0 {
+		port = stamp.DefaultPort
+	}
+	return fmt.Sprintf("[%s]:%d", normAddr(e.Address), port)
+}
+
+// nodeSections holds the section of every resource kind over the
+// handlers built by Setup. Reload, Import, Export and transactions all
+// go through it.
+type nodeSections struct {
+	plugins          staticSection[*v1.PluginRegisterRequest]
+	vrfs             staticSection[*v1.Vrf]
+	bridges          staticSection[*v1.Bridge]
+	ethernetSegments staticSection[*v1.EthernetSegment]
+	sidFunctions     staticSection[*v1.SidFunction]
+	headendv4s       staticSection[*v1.Headendv4]
+	headendv6s       staticSection[*v1.Headendv6]
+	headendL2s       staticSection[*v1.HeadendL2]
+	bdPeers          staticSection[*v1.BdPeer]
+	vlanTable        staticSection[*v1.VlanTableEntry]
+	fdbEntries       staticSection[*v1.FdbEntry]
+	srDomains        staticSection[*v1.SrDomainPolicy]
+	stampReflectors  staticSection[*v1.StampReflector]
+	bridgeDomains    staticSection[*v1.BridgeDomain]
+	macLimits        staticSection[*v1.MacLimit]
+	stormControls    staticSection[*v1.StormControl]
+	neighbors        staticSection[*v1.Neighbor]
+	irbRoutes        staticSection[*v1.IrbRoute]
+	vniMappings      staticSection[*v1.VniMapping]
+}
+
+func (s *Server) sections() *nodeSections {
+	return &nodeSections{
+		plugins: staticSection[*v1.PluginRegisterRequest]{
+			name: "plugins",
+			key:  pluginKey,
+			list: func(context.Context) ([]*v1.PluginRegisterRequest, error) {
+				return s.plugin.Registered(), nil
+			},
+			create: eachWith(s.plugin.PluginRegister, pluginKey, func(e *v1.PluginRegisterRequest) *v1.PluginRegisterRequest {
+				return e
+			}),
+			remove: eachWith(s.plugin.PluginUnregister, pluginKey, func(e *v1.PluginRegisterRequest) *v1.PluginUnregisterRequest {
+				return &v1.PluginUnregisterRequest{MapType: e.MapType, Index: e.Index}
+			}),
+		},
+		vrfs: staticSection[*v1.Vrf]{
+			name:      "vrfs",
+			immutable: true,
+			key:       vrfKey,
+			validate:  func(e *v1.Vrf) error { return requireName(e.Name) },
+			list:      listWith(s.netResource.VrfList, &v1.VrfListRequest{}, (*v1.VrfListResponse).GetVrfs),
+			create: callWith(s.netResource.VrfCreate, func(e []*v1.Vrf) *v1.VrfCreateRequest {
+				return &v1.VrfCreateRequest{Vrfs: e}
+			}),
+			remove: callWith(s.netResource.VrfDelete, func(e []*v1.Vrf) *v1.VrfDeleteRequest {
+				return &v1.VrfDeleteRequest{Names: keysOf(e, (*v1.Vrf).GetName)}
+			}),
+		},
+		bridges: staticSection[*v1.Bridge]{
+			name:      "bridges",
+			immutable: true,
+			key:       bridgeKey,
+			validate:  func(e *v1.Bridge) error { return requireName(e.Name) },
+			list:      listWith(s.netResource.BridgeList, &v1.BridgeListRequest{}, (*v1.BridgeListResponse).GetBridges),
+			create: callWith(s.netResource.BridgeCreate, func(e []*v1.Bridge) *v1.BridgeCreateRequest {
+				return &v1.BridgeCreateRequest{Bridges: e}
+			}),
+			remove: callWith(s.netResource.BridgeDelete, func(e []*v1.Bridge) *v1.BridgeDeleteRequest {
+				return &v1.BridgeDeleteRequest{Names: keysOf(e, (*v1.Bridge).GetName)}
+			}),
+		},
+		ethernetSegments: staticSection[*v1.EthernetSegment]{
+			name: "ethernet_segments",
+			key:  esKey,
+			validate: func(e *v1.EthernetSegment) error {
+				if _, err := bpf.ParseESI(e.Esi); err != nil {
+					return err
+				}
+				_, err := protoToEsiCfg(e)
+				return err
+			},
+			list: listWith(s.ethernetSegment.EsList, &v1.EsListRequest{}, (*v1.EsListResponse).GetEntries),
+			create: callWith(s.ethernetSegment.EsCreate, func(e []*v1.EthernetSegment) *v1.EsCreateRequest {
+				return &v1.EsCreateRequest{Entries: e}
+			}),
+			remove: callWith(s.ethernetSegment.EsDelete, func(e []*v1.EthernetSegment) *v1.EsDeleteRequest {
+				return &v1.EsDeleteRequest{Esis: keysOf(e, (*v1.EthernetSegment).GetEsi)}
+			}),
+		},
+		sidFunctions: staticSection[*v1.SidFunction]{
+			name: "sid_functions",
+			key:  sidKey,
+			validate: func(e *v1.SidFunction) error {
+				if err := checkPrefix(e.TriggerPrefix, false); err != nil {
+					return err
+				}
+				_, _, err := s.sidFunction.protoToEntry(e)
+				return err
+			},
+			list: listWith(s.sidFunction.SidFunctionList, &v1.SidFunctionListRequest{}, (*v1.SidFunctionListResponse).GetSidFunctions),
+			create: callWith(s.sidFunction.SidFunctionCreate, func(e []*v1.SidFunction) *v1.SidFunctionCreateRequest {
+				return &v1.SidFunctionCreateRequest{SidFunctions: e}
+			}),
+			remove: callWith(s.sidFunction.SidFunctionDelete, func(e []*v1.SidFunction) *v1.SidFunctionDeleteRequest {
+				return &v1.SidFunctionDeleteRequest{TriggerPrefixes: keysOf(e, (*v1.SidFunction).GetTriggerPrefix)}
+			}),
+		},
+		headendv4s: staticSection[*v1.Headendv4]{
+			name: "headendv4s",
+			key:  headendv4Key,
+			validate: func(e *v1.Headendv4) error {
+				if err := checkPrefix(e.TriggerPrefix, true); err != nil {
+					return err
+				}
+				_, err := s.headendv4.protoToEntry(e)
+				return err
+			},
+			list: listWith(s.headendv4.Headendv4List, &v1.Headendv4ListRequest{}, (*v1.Headendv4ListResponse).GetHeadendv4S),
+			create: callWith(s.headendv4.Headendv4Create, func(e []*v1.Headendv4) *v1.Headendv4CreateRequest {
+				return &v1.Headendv4CreateRequest{Headendv4S: e}
+			}),
+			remove: callWith(s.headendv4.Headendv4Delete, func(e []*v1.Headendv4) *v1.Headendv4DeleteRequest {
+				return &v1.Headendv4DeleteRequest{TriggerPrefixes: keysOf(e, (*v1.Headendv4).GetTriggerPrefix)}
+			}),
+		},
+		headendv6s: staticSection[*v1.Headendv6]{
+			name: "headendv6s",
+			key:  headendv6Key,
+			validate: func(e *v1.Headendv6) error {
+				if err := checkPrefix(e.TriggerPrefix, false); err != nil {
+					return err
+				}
+				_, err := s.headendv6.protoToEntry(e)
+				return err
+			},
+			list: listWith(s.headendv6.Headendv6List, &v1.Headendv6ListRequest{}, (*v1.Headendv6ListResponse).GetHeadendv6S),
+			create: callWith(s.headendv6.Headendv6Create, func(e []*v1.Headendv6) *v1.Headendv6CreateRequest {
+				return &v1.Headendv6CreateRequest{Headendv6S: e}
+			}),
+			remove: callWith(s.headendv6.Headendv6Delete, func(e []*v1.Headendv6) *v1.Headendv6DeleteRequest {
+				return &v1.Headendv6DeleteRequest{TriggerPrefixes: keysOf(e, (*v1.Headendv6).GetTriggerPrefix)}
+			}),
+		},
+		headendL2s: staticSection[*v1.HeadendL2]{
+			name: "headend_l2s",
+			key:  headendL2Key,
+			validate: func(e *v1.HeadendL2) error {
+				if _, err := headendL2AC(e); err != nil {
+					return err
+				}
+				_, err := s.headendL2.protoToEntry(e)
+				return err
+			},
+			list: listWith(s.headendL2.HeadendL2List, &v1.HeadendL2ListRequest{}, (*v1.HeadendL2ListResponse).GetHeadendL2S),
+			create: callWith(s.headendL2.HeadendL2Create, func(e []*v1.HeadendL2) *v1.HeadendL2CreateRequest {
+				return &v1.HeadendL2CreateRequest{HeadendL2S: e}
+			}),
+			remove: callWith(s.headendL2.HeadendL2Delete, func(e []*v1.HeadendL2) *v1.HeadendL2DeleteRequest {
+				targets := make([]*v1.HeadendL2DeleteTarget, 0, len(e))
+				for _, ac := range e {
+					targets = append(targets, &v1.HeadendL2DeleteTarget{
+						InterfaceName: ac.InterfaceName,
+						VlanId:        ac.VlanId,
+						InnerVlanId:   ac.InnerVlanId,
+						PortMode:      ac.PortMode,
+					})
+				}
+				return &v1.HeadendL2DeleteRequest{Targets: targets}
+			}),
+		},
+		bdPeers: staticSection[*v1.BdPeer]{
+			name: "bd_peers",
+			key:  bdPeerKey,
+			validate: func(e *v1.BdPeer) error {
+				if _, err := s.bdPeer.protoToEntry(e); err != nil {
+					return err
+				}
+				_, err := bpf.ParseESI(e.Esi)
+				return err
+			},
+			list: listWith(s.bdPeer.BdPeerList, &v1.BdPeerListRequest{}, (*v1.BdPeerListResponse).GetPeers),
+			create: callWith(s.bdPeer.BdPeerCreate, func(e []*v1.BdPeer) *v1.BdPeerCreateRequest {
+				return &v1.BdPeerCreateRequest{Peers: e}
+			}),
+			// BdPeerDelete removes whole BDs; the file names single peers
+			remove: func(_ context.Context, e []*v1.BdPeer) ([]*v1.OperationError, error) {
+				return s.bdPeer.deletePeers(e), nil
+			},
+		},
+		vlanTable: staticSection[*v1.VlanTableEntry]{
+			name: "vlan_table",
+			key:  vlanTableKey,
+			validate: func(e *v1.VlanTableEntry) error {
+				if e.VlanId > 4095 {
+					return fmt.Errorf("vlan_id %d exceeds maximum 4095", e.VlanId)
+				}
+				if e.TableId > 65535 {
+					return fmt.Errorf("table_id %d exceeds maximum 65535", e.TableId)
+				}
+				if _, err := resolveIfindex(e.InterfaceName); err != nil {
+					return fmt.Errorf("interface %q: %w", e.InterfaceName, err)
+				}
+				_, err := vlanRewriteFromProto(e.VlanRewrite)
+				return err
+			},
+			list: listWith(s.vlanTable.VlanTableList, &v1.VlanTableListRequest{}, (*v1.VlanTableListResponse).GetEntries),
+			create: callWith(s.vlanTable.VlanTableCreate, func(e []*v1.VlanTableEntry) *v1.VlanTableCreateRequest {
+				return &v1.VlanTableCreateRequest{Entries: e}
+			}),
+			remove: callWith(s.vlanTable.VlanTableDelete, func(e []*v1.VlanTableEntry) *v1.VlanTableDeleteRequest {
+				return &v1.VlanTableDeleteRequest{Entries: e}
+			}),
+		},
+		fdbEntries: staticSection[*v1.FdbEntry]{
+			name: "fdb_entries",
+			key:  fdbKey,
+			list: s.staticFdb,
+			create: eachWith(s.fdb.FdbCreate, fdbKey, func(e *v1.FdbEntry) *v1.FdbCreateRequest {
+				return &v1.FdbCreateRequest{
+					BdId:        e.BdId,
+					Mac:         e.Mac,
+					Oif:         e.Oif,
+					VlanId:      e.VlanId,
+					InnerVlanId: e.InnerVlanId,
+					VlanRewrite: e.VlanRewrite,
+					PortMode:    e.PortMode,
+				}
+			}),
+			remove: eachWith(s.fdb.FdbDelete, fdbKey, func(e *v1.FdbEntry) *v1.FdbDeleteRequest {
+				return &v1.FdbDeleteRequest{BdId: e.BdId, Mac: e.Mac}
+			}),
+		},
+		srDomains: staticSection[*v1.SrDomainPolicy]{
+			name: "sr_domains",
+			key:  srDomainKey,
+			list: listWith(s.srDomain.SrDomainList, &v1.SrDomainListRequest{}, (*v1.SrDomainListResponse).GetPolicies),
+			create: callWith(s.srDomain.SrDomainCreate, func(e []*v1.SrDomainPolicy) *v1.SrDomainCreateRequest {
+				return &v1.SrDomainCreateRequest{Policies: e}
+			}),
+			remove: callWith(s.srDomain.SrDomainDelete, func(e []*v1.SrDomainPolicy) *v1.SrDomainDeleteRequest {
+				return &v1.SrDomainDeleteRequest{Locators: keysOf(e, (*v1.SrDomainPolicy).GetLocator)}
+			}),
+		},
+		stampReflectors: staticSection[*v1.StampReflector]{
+			name: "stamp_reflectors",
+			key:  stampReflectorKey,
+			list: configOnly(listWith(s.performance.StampReflectorList, &v1.StampReflectorListRequest{}, (*v1.StampReflectorListResponse).GetReflectors),
+				func(e *v1.StampReflector) bool {
+					e.ReflectedPackets = 0
+					return true
+				}),
+			create: callWith(s.performance.StampReflectorCreate, func(e []*v1.StampReflector) *v1.StampReflectorCreateRequest {
+				return &v1.StampReflectorCreateRequest{Reflectors: e}
+			}),
+			remove: callWith(s.performance.StampReflectorDelete, func(e []*v1.StampReflector) *v1.StampReflectorDeleteRequest {
+				return &v1.StampReflectorDeleteRequest{Reflectors: e}
+			}),
+		},
+		bridgeDomains: staticSection[*v1.BridgeDomain]{
+			name:      "bridge_domains",
+			immutable: true,
+			key:       bridgeDomainKey,
+			list:      s.bridgeDomain.ownedBridgeDomains,
+			create: callWith(s.bridgeDomain.BridgeDomainCreate, func(e []*v1.BridgeDomain) *v1.BridgeDomainCreateRequest {
+				return &v1.BridgeDomainCreateRequest{BridgeDomains: e}
+			}),
+			remove: callWith(s.bridgeDomain.BridgeDomainDelete, func(e []*v1.BridgeDomain) *v1.BridgeDomainDeleteRequest {
+				return &v1.BridgeDomainDeleteRequest{Names: keysOf(e, (*v1.BridgeDomain).GetName)}
+			}),
+		},
+		macLimits: staticSection[*v1.MacLimit]{
+			name: "mac_limits",
+			key:  macLimitScopeKey,
+			// MacLimitList also reports the usage of BDs and ACs without a limit
+			list: configOnly(listWith(s.bridgeDomain.MacLimitList, &v1.MacLimitListRequest{}, (*v1.MacLimitListResponse).GetLimits),
+				func(e *v1.MacLimit) bool {
+					keep := e.Configured
+					e.Configured, e.MacCount = false, 0
+					return keep
+				}),
+			create: callWith(s.bridgeDomain.MacLimitSet, func(e []*v1.MacLimit) *v1.MacLimitSetRequest {
+				return &v1.MacLimitSetRequest{Limits: e}
+			}),
+			remove: callWith(s.bridgeDomain.MacLimitDelete, func(e []*v1.MacLimit) *v1.MacLimitDeleteRequest {
+				return &v1.MacLimitDeleteRequest{Limits: e}
+			}),
+		},
+		stormControls: staticSection[*v1.StormControl]{
+			name: "storm_controls",
+			key:  stormControlScopeKey,
+			list: configOnly(listWith(s.bridgeDomain.StormControlList, &v1.StormControlListRequest{}, (*v1.StormControlListResponse).GetStormControls),
+				func(e *v1.StormControl) bool {
+					e.DroppedPackets, e.DroppedBytes = 0, 0
+					return true
+				}),
+			create: callWith(s.bridgeDomain.StormControlSet, func(e []*v1.StormControl) *v1.StormControlSetRequest {
+				return &v1.StormControlSetRequest{StormControls: e}
+			}),
+			remove: callWith(s.bridgeDomain.StormControlDelete, func(e []*v1.StormControl) *v1.StormControlDeleteRequest {
+				return &v1.StormControlDeleteRequest{StormControls: e}
+			}),
+		},
+		neighbors: staticSection[*v1.Neighbor]{
+			name: "neighbors",
+			key:  neighborKey,
+			// Snooped and EVPN bindings are learned again
+			list: configOnly(listWith(s.bridgeDomain.NeighborList, &v1.NeighborListRequest{}, (*v1.NeighborListResponse).GetNeighbors),
+				func(e *v1.Neighbor) bool {
+					keep := e.Origin == v1.NeighborOrigin_NEIGHBOR_ORIGIN_STATIC
+					e.Origin = v1.NeighborOrigin_NEIGHBOR_ORIGIN_UNSPECIFIED
+					return keep
+				}),
+			create: callWith(s.bridgeDomain.NeighborCreate, func(e []*v1.Neighbor) *v1.NeighborCreateRequest {
+				return &v1.NeighborCreateRequest{Neighbors: e}
+			}),
+			remove: callWith(s.bridgeDomain.NeighborDelete, func(e []*v1.Neighbor) *v1.NeighborDeleteRequest {
+				return &v1.NeighborDeleteRequest{Neighbors: e}
+			}),
+		},
+		irbRoutes: staticSection[*v1.IrbRoute]{
+			name: "irb_routes",
+			key:  irbRouteKey,
+			// Host routes from EVPN RT2 routes carry the bd_id of their BD
+			list: configOnly(listWith(s.bridgeDomain.IrbRouteList, &v1.IrbRouteListRequest{}, (*v1.IrbRouteListResponse).GetRoutes),
+				func(e *v1.IrbRoute) bool { return e.BdId == 0 }),
+			create: callWith(s.bridgeDomain.IrbRouteCreate, func(e []*v1.IrbRoute) *v1.IrbRouteCreateRequest {
+				return &v1.IrbRouteCreateRequest{Routes: e}
+			}),
+			remove: callWith(s.bridgeDomain.IrbRouteDelete, func(e []*v1.IrbRoute) *v1.IrbRouteDeleteRequest {
+				return &v1.IrbRouteDeleteRequest{Routes: e}
+			}),
+		},
+		vniMappings: staticSection[*v1.VniMapping]{
+			name: "vni_mappings",
+			key:  vniMappingKey,
+			list: listWith(s.vniMapping.VniMappingList, &v1.VniMappingListRequest{}, (*v1.VniMappingListResponse).GetMappings),
+			create: callWith(s.vniMapping.VniMappingCreate, func(e []*v1.VniMapping) *v1.VniMappingCreateRequest {
+				return &v1.VniMappingCreateRequest{Mappings: e}
+			}),
+			remove: callWith(s.vniMapping.VniMappingDelete, func(e []*v1.VniMapping) *v1.VniMappingDeleteRequest {
+				vnis := make([]uint32, 0, len(e))
+				for _, m := range e {
+					vnis = append(vnis, m.Vni)
+				}
+				return &v1.VniMappingDeleteRequest{Vnis: vnis}
+			}),
+		},
+	}
+}
+
+// planNode plans every section of next against prev. Plugins come first
+// so SIDs can use their actions; named BDs follow the VRFs their IRB
+// routes into; MAC limits, storm controls and neighbors precede the ACs
+// that would learn, flood and ask; static FDB entries come last.
+func (s *Server) planNode(ctx context.Context, prev, next *v1.NodeConfig, prune bool) []staticPlan {
+	secs := s.sections()
+	return []staticPlan{
+		planSection(ctx, secs.plugins, prev.Plugins, next.Plugins, prune),
+		planSection(ctx, secs.vrfs, prev.Vrfs, next.Vrfs, prune),
+		planSection(ctx, secs.bridges, prev.Bridges, next.Bridges, prune),
+		planSection(ctx, secs.bridgeDomains, prev.BridgeDomains, next.BridgeDomains, prune),
+		planSection(ctx, secs.ethernetSegments, prev.EthernetSegments, next.EthernetSegments, prune),
+		planSection(ctx, secs.srDomains, prev.SrDomains, next.SrDomains, prune),
+		planSection(ctx, secs.sidFunctions, prev.SidFunctions, next.SidFunctions, prune),
+		planSection(ctx, secs.headendv4s, prev.Headendv4S, next.Headendv4S, prune),
+		planSection(ctx, secs.headendv6s, prev.Headendv6S, next.Headendv6S, prune),
+		planSection(ctx, secs.macLimits, prev.MacLimits, next.MacLimits, prune),
+		planSection(ctx, secs.stormControls, prev.StormControls, next.StormControls, prune),
+		planSection(ctx, secs.neighbors, prev.Neighbors, next.Neighbors, prune),
+		planSection(ctx, secs.headendL2s, prev.HeadendL2S, next.HeadendL2S, prune),
+		planSection(ctx, secs.bdPeers, prev.BdPeers, next.BdPeers, prune),
+		planSection(ctx, secs.irbRoutes, prev.IrbRoutes, next.IrbRoutes, prune),
+		planSection(ctx, secs.vlanTable, prev.VlanTable, next.VlanTable, prune),
+		planSection(ctx, secs.vniMappings, prev.VniMappings, next.VniMappings, prune),
+		planSection(ctx, secs.stampReflectors, prev.StampReflectors, next.StampReflectors, prune),
+		planSection(ctx, secs.fdbEntries, prev.FdbEntries, next.FdbEntries, prune),
+	}
+}
+
+// staticSection describes one list of the config: section: how entries
+// are keyed in the maps and which handlers list, create and delete them.
+type staticSection[T proto.Message] struct {
+	name string
+	key  func(T) string
+	list func(context.Context) ([]T, error)
+	// create also replaces an installed entry with the same key
+	create func(context.Context, []T) ([]*v1.OperationError, error)
+	remove func(context.Context, []T) ([]*v1.OperationError, error)
+	// validate checks an entry the way create would, without touching
+	// the maps; nil accepts every entry
+	validate func(T) error
+	// immutable entries (devices) can't be changed once created
+	immutable bool
+}
+
+type staticPlan interface {
+	apply(ctx context.Context)
+	remove(ctx context.Context)
+	// result returns nil for a section neither config declares
+	result() *v1.ConfigSectionResult
+}
+
+type sectionPlan[T proto.Message] struct {
+	sec                       staticSection[T]
+	creates, updates, deletes []T
+	res                       *v1.ConfigSectionResult
+}
+
+// planSection classifies the entries of next against the installed keys
+// and the entries of prev: missing keys are created, keys whose entry
+// differs from prev are updated, and with prune keys only prev declares
+// are deleted.
+func planSection[T proto.Message](ctx context.Context, sec staticSection[T], prev, next []T, prune bool) staticPlan {
+	p := &sectionPlan[T]{sec: sec}
+	if len(next) == 0 && (len(prev) == 0 || !prune) {
+		return p
+	}
+	p.res = &v1.ConfigSectionResult{Name: sec.name}
+
+	current, err := sec.list(ctx)
+	if err != nil {
+		p.res.Errors = append(p.res.Errors, &v1.OperationError{Reason: err.Error()})
+		return p
+	}
+	installed := make(map[string]bool, len(current))
+	for _, e := range current {
+		installed[sec.key(e)] = true
+	}
+	old := make(map[string]T, len(prev))
+	for _, e := range prev {
+		old[sec.key(e)] = e
+	}
+
+	want := make(map[string]bool, len(next))
+	for _, e := range next {
+		k := sec.key(e)
+		want[k] = true
+		o, declared := old[k]
+		switch {
+		case !installed[k]:
+			p.creates = append(p.creates, e)
+		case declared && proto.Equal(o, e):
+			p.res.Unchanged++
+		case declared && sec.immutable:
+			p.res.Errors = append(p.res.Errors, &v1.OperationError{
+				TriggerPrefix: k,
+				Reason:        "an existing device can't be changed in place; delete it, then create it again",
+			})
+		default:
+			p.updates = append(p.updates, e)
+		}
+	}
+	for _, e := range prev {
+		k := sec.key(e)
+		if prune && !want[k] && installed[k] {
+			p.deletes = append(p.deletes, e)
+			want[k] = true // once per key
+		}
+	}
+	return p
+}
+
+func (p *sectionPlan[T]) apply(ctx context.Context) {
+	if p.res == nil {
+		return
+	}
+	p.res.Created += p.run(ctx, p.sec.create, p.creates)
+	p.res.Updated += p.run(ctx, p.sec.create, p.updates)
+}
+
+func (p *sectionPlan[T]) remove(ctx context.Context) {
+	if p.res == nil {
+		return
+	}
+	p.res.Deleted += p.run(ctx, p.sec.remove, p.deletes)
+}
+
+func (p *sectionPlan[T]) result() *v1.ConfigSectionResult {
+	return p.res
+}
+
+// run calls fn over entries and returns how many succeeded.
+func (p *sectionPlan[T]) run(ctx context.Context, fn func(context.Context, []T) ([]*v1.OperationError, error), entries []T) uint32 {
+	if len(entries) == 0 {
+		return 0
+	}
+	errs, err := fn(ctx, entries)
+	if err != nil {
+		p.res.Errors = append(p.res.Errors, &v1.OperationError{Reason: err.Error()})
+		return 0
+	}
+	p.res.Errors = append(p.res.Errors, errs...)
+	return uint32(max(len(entries)-len(errs), 0))
+}
+
+// callWith adapts a Create or Delete handler to a staticSection function.
+func callWith[T any, Req, Resp any](
+	handler func(context.Context, *connect.Request[Req]) (*connect.Response[Resp], error),
+	build func([]T) *Req,
+) func(context.Context, []T) ([]*v1.OperationError, error) {
+	return func(ctx context.Context, entries []T) ([]*v1.OperationError, error) {
+		resp, err := handler(ctx, connect.NewRequest(build(entries)))
+		if err != nil {
+			return nil, err
+		}
+		if r, ok := any(resp.Msg).(interface{ GetErrors() []*v1.OperationError }); ok {
+			return r.GetErrors(), nil
+		}
+		return nil, nil
+	}
+}
+
+// eachWith adapts a handler taking one entry per call; a failed entry
+// becomes an OperationError under its key.
+func eachWith[T any, Req, Resp any](
+	handler func(context.Context, *connect.Request[Req]) (*connect.Response[Resp], error),
+	key func(T) string,
+	build func(T) *Req,
+) func(context.Context, []T) ([]*v1.OperationError, error) {
+	return func(ctx context.Context, entries []T) ([]*v1.OperationError, error) {
+		var errs []*v1.OperationError
+		for _, e := range entries {
+			if _, err := handler(ctx, connect.NewRequest(build(e))); err != nil {
+				errs = append(errs, &v1.OperationError{TriggerPrefix: key(e), Reason: err.Error()})
+			}
+		}
+		return errs, nil
+	}
+}
+
+// listWith adapts a List handler to a staticSection function.
+func listWith[T any, Req, Resp any](
+	handler func(context.Context, *connect.Request[Req]) (*connect.Response[Resp], error),
+	req *Req,
+	get func(*Resp) []T,
+) func(context.Context) ([]T, error) {
+	return func(ctx context.Context) ([]T, error) {
+		resp, err := handler(ctx, connect.NewRequest(req))
+		if err != nil {
+			return nil, err
+		}
+		return get(resp.Msg), nil
+	}
+}
+
+// configOnly filters a list for sections whose List also reports
+// state: keep drops entries that aren't configuration and clears the
+// output-only fields of the others, so they compare equal to the file.
+func configOnly[T any](list func(context.Context) ([]T, error), keep func(T) bool) func(context.Context) ([]T, error) {
+	return func(ctx context.Context) ([]T, error) {
+		entries, err := list(ctx)
+		if err != nil {
+			return nil, err
+		}
+		out := entries[:0]
+		for _, e := range entries {
+			if keep(e) {
+				out = append(out, e)
+			}
+		}
+		return out, nil
+	}
+}
+
+func keysOf[T any](entries []T, key func(T) string) []string {
+	keys := make([]string, 0, len(entries))
+	for _, e := range entries {
+		keys = append(keys, key(e))
+	}
+	return keys
+}
+
+func requireName(name string) error {
+	if name == "" {
+		return fmt.Errorf("name is required")
+	}
+	return nil
+}
+
+// checkPrefix rejects a trigger prefix the map key of its family can't
+// hold.
+func checkPrefix(s string, v4 bool) error {
+	p, err := netip.ParsePrefix(s)
+	if err != nil {
+		return err
+	}
+	if p.Addr().Is4() != v4 {
+		if v4 {
+			return fmt.Errorf("not an IPv4 prefix: %s", s)
+		}
+		return fmt.Errorf("not an IPv6 prefix: %s", s)
+	}
+	return nil
+}
+
+// normPrefix, normAddr, normESI and normMAC give the form List reports, so a key
+// written differently in the file still matches the installed entry.
+func normPrefix(s string) string {
+	if p, err := netip.ParsePrefix(s); err == nil {
+		return p.Masked().String()
+	}
+	return s
+}
+
+func normAddr(s string) string {
+	if a, err := netip.ParseAddr(s); err == nil {
+		return a.String()
+	}
+	return s
+}
+
+func normESI(s string) string {
+	if esi, err := bpf.ParseESI(s); err == nil {
+		return bpf.FormatESI(esi)
+	}
+	return strings.ToLower(s)
+}
+
+func normMAC(s string) string {
+	if hw, err := net.ParseMAC(s); err == nil {
+		return hw.String()
+	}
+	return strings.ToLower(s)
+}
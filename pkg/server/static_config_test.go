@@ -0,0 +1,201 @@
+package server
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	v1 "github.com/takehaya/vinbero/api/vinbero/v1"
+)
+
+func TestPlanSection(t *testing.T) {
+	sid := func(prefix string, action v1.Srv6LocalAction) *v1.SidFunction {
+		return &v1.SidFunction{TriggerPrefix: prefix, Action: action}
+	}
+	end, dt4 := v1.Srv6LocalAction_SRV6_LOCAL_ACTION_END, v1.Srv6LocalAction_SRV6_LOCAL_ACTION_END_DT4
+
+	// The maps hold the previous file's entries (less fc00:1::5, deleted
+	// over the API) plus fc00:1::9, created over the API.
+	installed := map[string]*v1.SidFunction{
+		"fc00:1::1/128": sid("fc00:1::1/128", end),
+		"fc00:1::2/128": sid("fc00:1::2/128", end),
+		"fc00:1::3/128": sid("fc00:1::3/128", end),
+		"fc00:1::9/128": sid("fc00:1::9/128", end),
+	}
+	var created, deleted []string
+	sec := staticSection[*v1.SidFunction]{
+		name: "sid_functions",
+		key:  func(e *v1.SidFunction) string { return normPrefix(e.TriggerPrefix) },
+		list: func(context.Context) ([]*v1.SidFunction, error) {
+			var out []*v1.SidFunction
+			for _, e := range installed {
+				out = append(out, e)
+			}
+			return out, nil
+		},
+		create: func(_ context.Context, e []*v1.SidFunction) ([]*v1.OperationError, error) {
+			created = append(created, keysOf(e, (*v1.SidFunction).GetTriggerPrefix)...)
+			return nil, nil
+		},
+		remove: func(_ context.Context, e []*v1.SidFunction) ([]*v1.OperationError, error) {
+			deleted = append(deleted, keysOf(e, (*v1.SidFunction).GetTriggerPrefix)...)
+			return nil, nil
+		},
+	}
+
+	prev := []*v1.SidFunction{
+		sid("fc00:1::1/128", end),
+		sid("fc00:1::2/128", end),
+		sid("fc00:1::3/128", end),
+		sid("fc00:1::5/128", end),
+	}
+	next := []*v1.SidFunction{
+		sid("fc00:1::1/128", end),
+		sid("fc00:1::2/128", dt4),
+		sid("fc00:1:0:0::4/128", end),
+	}
+
+	p := planSection(context.Background(), sec, prev, next, true)
+	p.apply(context.Background())
+	p.remove(context.Background())
+	res := p.result()
+
+	if res.Created != 1 || res.Updated != 1 || res.Deleted != 1 || res.Unchanged != 1 || len(res.Errors) != 0 {
+		t.Errorf("got created=%d updated=%d deleted=%d unchanged=%d errors=%v, want 1/1/1/1/none",
+			res.Created, res.Updated, res.Deleted, res.Unchanged, res.Errors)
+	}
+	if want := []string{"fc00:1:0:0::4/128", "fc00:1::2/128"}; !slices.Equal(created, want) {
+		t.Errorf("created %v, want %v", created, want)
+	}
+	if want := []string{"fc00:1::3/128"}; !slices.Equal(deleted, want) {
+		t.Errorf("deleted %v, want %v", deleted, want)
+	}
+
+	if p := planSection(context.Background(), sec, nil, nil, true); p.result() != nil {
+		t.Errorf("empty section: got %v, want nil", p.result())
+	}
+}
+
+func TestPlanSectionImmutable(t *testing.T) {
+	vrf := &v1.Vrf{Name: "vrf100", TableId: 100}
+	sec := staticSection[*v1.Vrf]{
+		name:      "vrfs",
+		immutable: true,
+		key:       func(e *v1.Vrf) string { return e.Name },
+		list: func(context.Context) ([]*v1.Vrf, error) {
+			return []*v1.Vrf{vrf}, nil
+		},
+		create: func(context.Context, []*v1.Vrf) ([]*v1.OperationError, error) {
+			t.Error("create called for a changed device")
+			return nil, nil
+		},
+	}
+
+	p := planSection(context.Background(), sec, []*v1.Vrf{vrf}, []*v1.Vrf{{Name: "vrf100", TableId: 200}}, true)
+	p.apply(context.Background())
+	res := p.result()
+	if res.Updated != 0 || len(res.Errors) != 1 || res.Errors[0].TriggerPrefix != "vrf100" {
+		t.Errorf("got updated=%d errors=%v, want a vrf100 error", res.Updated, res.Errors)
+	}
+}
+
+// TestPlanSectionMerge plans an import in merge mode: prev is what the
+// maps hold, and keys the document leaves out stay installed.
+func TestPlanSectionMerge(t *testing.T) {
+	peer := func(bd uint32, src string, segs ...string) *v1.BdPeer {
+		return &v1.BdPeer{BdId: bd, SrcAddr: src, Segments: segs}
+	}
+	installed := []*v1.BdPeer{
+		peer(100, "fc00:1::1", "fc00:2::1"),
+		peer(100, "fc00:1::2", "fc00:3::1"),
+	}
+	var created []string
+	sec := staticSection[*v1.BdPeer]{
+		name: "bd_peers",
+		key:  bdPeerKey,
+		list: func(context.Context) ([]*v1.BdPeer, error) { return installed, nil },
+		create: func(_ context.Context, e []*v1.BdPeer) ([]*v1.OperationError, error) {
+			created = append(created, keysOf(e, bdPeerKey)...)
+			return nil, nil
+		},
+		remove: func(context.Context, []*v1.BdPeer) ([]*v1.OperationError, error) {
+			t.Error("remove called in merge mode")
+			return nil, nil
+		},
+	}
+
+	next := []*v1.BdPeer{
+		peer(100, "fc00:1::1", "fc00:2::1"),
+		peer(200, "fc00:1::1", "fc00:4::1"),
+	}
+	p := planSection(context.Background(), sec, installed, next, false)
+	p.apply(context.Background())
+	p.remove(context.Background())
+	res := p.result()
+	if res.Created != 1 || res.Unchanged != 1 || res.Deleted != 0 {
+		t.Errorf("got created=%d unchanged=%d deleted=%d, want 1/1/0", res.Created, res.Unchanged, res.Deleted)
+	}
+	if want := []string{"200/fc00:1::1"}; !slices.Equal(created, want) {
+		t.Errorf("created %v, want %v", created, want)
+	}
+
+	if p := planSection(context.Background(), sec, installed, nil, false); p.result() != nil {
+		t.Errorf("section the document leaves out: got %v, want nil", p.result())
+	}
+}
+
+// TestPlanSectionConfigOnly checks that a state-reporting List filtered
+// by configOnly compares equal to an exported document: learned entries
+// don't count as installed and output-only fields are ignored.
+func TestPlanSectionConfigOnly(t *testing.T) {
+	static := v1.NeighborOrigin_NEIGHBOR_ORIGIN_STATIC
+	snoop := v1.NeighborOrigin_NEIGHBOR_ORIGIN_SNOOP
+	var created []string
+	sec := staticSection[*v1.Neighbor]{
+		name: "neighbors",
+		key:  neighborKey,
+		list: configOnly(func(context.Context) ([]*v1.Neighbor, error) {
+			return []*v1.Neighbor{
+				{BdId: 100, Ip: "192.0.2.1", Mac: "02:00:00:00:00:01", Origin: static},
+				{BdId: 100, Ip: "192.0.2.2", Mac: "02:00:00:00:00:02", Origin: snoop},
+			}, nil
+		}, func(e *v1.Neighbor) bool {
+			keep := e.Origin == static
+			e.Origin = v1.NeighborOrigin_NEIGHBOR_ORIGIN_UNSPECIFIED
+			return keep
+		}),
+		create: func(_ context.Context, e []*v1.Neighbor) ([]*v1.OperationError, error) {
+			created = append(created, keysOf(e, neighborKey)...)
+			return nil, nil
+		},
+	}
+
+	ctx := context.Background()
+	current, err := sec.list(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	next := []*v1.Neighbor{
+		{BdId: 100, Ip: "192.0.2.1", Mac: "02:00:00:00:00:01"},
+		{BdId: 100, Ip: "192.0.2.2", Mac: "02:00:00:00:00:02"},
+	}
+	p := planSection(ctx, sec, current, next, true)
+	p.apply(ctx)
+	res := p.result()
+
+	if res.Created != 1 || res.Unchanged != 1 || res.Updated != 0 || res.Deleted != 0 {
+		t.Errorf("got created=%d updated=%d deleted=%d unchanged=%d, want 1/0/0/1",
+			res.Created, res.Updated, res.Deleted, res.Unchanged)
+	}
+	if want := []string{"100/192.0.2.2"}; !slices.Equal(created, want) {
+		t.Errorf("created %v, want %v", created, want)
+	}
+}
+
+func TestStampReflectorKey(t *testing.T) {
+	a := stampReflectorKey(&v1.StampReflector{Address: "fc00:1::1"})
+	b := stampReflectorKey(&v1.StampReflector{Address: "fc00:1:0::1", Port: 862})
+	if a != b {
+		t.Errorf("default port: %q != %q", a, b)
+	}
+}
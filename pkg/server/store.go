@@ -0,0 +1,188 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"connectrpc.com/connect"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	v1 "github.com/takehaya/vinbero/api/vinbero/v1"
+	"github.com/takehaya/vinbero/api/vinbero/v1/vinberov1connect"
+	"github.com/takehaya/vinbero/pkg/store"
+)
+
+// unjournaledServices own their state elsewhere: VRFs and bridges live in
+// state.json, and a reload is driven by the config file.
+var unjournaledServices = []string{
+	vinberov1connect.NetworkResourceServiceName,
+	vinberov1connect.DaemonServiceName,
+}
+
+// journaled reports whether an accepted call of procedure goes into the
+// store.
+func journaled(procedure string) bool {
+	for _, svc := range unjournaledServices {
+		if strings.HasPrefix(procedure, "/"+svc+"/") {
+			return false
+		}
+	}
+	return store.Mutating(procedure)
+}
+
+type replayKey struct{}
+
+func (s *Server) handlerOptions() []connect.HandlerOption {
+	interceptors := []connect.Interceptor{s.pauseInterceptor()}
+	if s.store != nil {
+		interceptors = append(interceptors, s.storeInterceptor())
+	}
+	return []connect.HandlerOption{connect.WithInterceptors(interceptors...)}
+}
+
+// storeInterceptor journals mutating requests whose handler returned no
+// error. Entries rejected inside a response's errors list are journaled
+// too and rejected again on replay; a transaction is journaled only when
+// applied. A journaled call holds journalMu across its handler and the
+// append, so two concurrent calls cannot reach the maps in one order and
+// the journal in the other. MapResize doesn't: it waits for pauseMu,
+// which a call queued on journalMu holds, and resizes are replayed
+// before everything else anyway. A failed write is logged rather than
+// returned: the maps already hold the change.
+func (s *Server) storeInterceptor() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			procedure := req.Spec().Procedure
+			if ctx.Value(replayKey{}) != nil || !journaled(procedure) {
+				return next(ctx, req)
+			}
+			if procedure != vinberov1connect.SystemServiceMapResizeProcedure {
+				s.journalMu.Lock()
+				defer s.journalMu.Unlock()
+			}
+			resp, err := next(ctx, req)
+			if err != nil {
+				return resp, err
+			}
+			// A transaction that rolled back or only validated changed nothing
+			if r, ok := resp.Any().(interface{ GetApplied() bool }); ok && !r.GetApplied() {
+				return resp, nil
+			}
+			msg, ok := req.Any().(proto.Message)
+			if !ok {
+				return resp, nil
+			}
+			if err := s.store.Append(procedure, msg); err != nil {
+				s.logger.Error("Failed to journal request", zap.String("procedure", procedure), zap.Error(err))
+			}
+			return resp, nil
+		}
+	}
+}
+
+// ReplayStore runs the journaled requests through the API handlers,
+// rebuilding maps that started empty, in the order replayRecords gives.
+// Requests that fail again are logged and skipped.
+func (s *Server) ReplayStore(ctx context.Context, mapsRestored bool) {
+	if s.store == nil {
+		return
+	}
+	s.Setup()
+
+	ctx = context.WithValue(ctx, replayKey{}, true)
+	records := replayRecords(s.store.Records(), mapsRestored)
+	failed := 0
+	for _, rec := range records {
+		if rec.Procedure == vinberov1connect.BridgeDomainServiceBridgeDomainCreateProcedure {
+			s.forgetRestoredBridgeDomains(rec.Request)
+		}
+		if err := s.replay(ctx, rec); err != nil {
+			failed++
+			s.logger.Warn("Replayed request failed",
+				zap.Uint64("seq", rec.Seq),
+				zap.String("procedure", rec.Procedure),
+				zap.Error(err))
+		}
+	}
+	s.logger.Info("Replayed store", zap.Int("records", len(records)), zap.Int("failed", failed))
+}
+
+// replayRecords orders records for replay: the map resizes first, so the
+// maps hold the capacity grown at runtime before any entry goes in, then
+// the rest in the order they were accepted. With mapsRestored only plugin
+// registrations are replayed: the pinned maps kept their entries and
+// size, and PROG_ARRAYs are never pinned.
+func replayRecords(records []store.Record, mapsRestored bool) []store.Record {
+	var resizes, rest []store.Record
+	for _, rec := range records {
+		switch {
+		case mapsRestored && !strings.HasPrefix(rec.Procedure, "/"+vinberov1connect.PluginServiceName+"/"):
+		case rec.Procedure == vinberov1connect.SystemServiceMapResizeProcedure:
+			resizes = append(resizes, rec)
+		default:
+			rest = append(rest, rec)
+		}
+	}
+	return append(resizes, rest...)
+}
+
+// forgetRestoredBridgeDomains drops the state.json record of a BD that
+// the replayed create is about to rebuild. state.json outlives the maps,
+// so without this the create would stop at "already exists" and leave
+// the BD's SIDs, ACs and peers out.
+func (s *Server) forgetRestoredBridgeDomains(body json.RawMessage) {
+	var req v1.BridgeDomainCreateRequest
+	if err := protojson.Unmarshal(body, &req); err != nil {
+		return
+	}
+	for _, bd := range req.BridgeDomains {
+		if _, ok := s.resMgr.GetBridgeDomain(bd.Name); ok {
+			_ = s.resMgr.RemoveBridgeDomain(bd.Name)
+		}
+	}
+}
+
+// replay serves one record through the mux as a Connect JSON request.
+// A response carrying an errors list counts as a failure.
+func (s *Server) replay(ctx context.Context, rec store.Record) error {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, rec.Procedure, bytes.NewReader(rec.Request))
+	if err != nil {
+		return err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	w := &replayWriter{header: make(http.Header), code: http.StatusOK}
+	s.mux.ServeHTTP(w, httpReq)
+	if w.code != http.StatusOK {
+		return fmt.Errorf("%s: %s", http.StatusText(w.code), strings.TrimSpace(w.body.String()))
+	}
+
+	var resp struct {
+		Errors []*struct {
+			TriggerPrefix string `json:"triggerPrefix"`
+			Reason        string `json:"reason"`
+		} `json:"errors"`
+	}
+	if json.Unmarshal(w.body.Bytes(), &resp) == nil && len(resp.Errors) > 0 {
+		e := resp.Errors[0]
+		return fmt.Errorf("%d rejected entries, first %s: %s", len(resp.Errors), e.TriggerPrefix, e.Reason)
+	}
+	return nil
+}
+
+// replayWriter collects the response of a replayed request.
+type replayWriter struct {
+	header http.Header
+	code   int
+	body   bytes.Buffer
+}
+
+func (w *replayWriter) Header() http.Header         { return w.header }
+func (w *replayWriter) Write(b []byte) (int, error) { return w.body.Write(b) }
+func (w *replayWriter) WriteHeader(code int)        { w.code = code }
@@ -0,0 +1,258 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"go.uber.org/zap"
+
+	v1 "github.com/takehaya/vinbero/api/vinbero/v1"
+	"github.com/takehaya/vinbero/api/vinbero/v1/vinberov1connect"
+	"github.com/takehaya/vinbero/pkg/store"
+)
+
+// fakeSrDomain records the policies it is asked to create. gate, when
+// set, runs before each create is recorded.
+type fakeSrDomain struct {
+	vinberov1connect.UnimplementedSrDomainServiceHandler
+	gate    func(locator string)
+	created []string
+}
+
+func (f *fakeSrDomain) SrDomainCreate(
+	ctx context.Context,
+	req *connect.Request[v1.SrDomainCreateRequest],
+) (*connect.Response[v1.SrDomainCreateResponse], error) {
+	for _, p := range req.Msg.Policies {
+		if f.gate != nil {
+			f.gate(p.Locator)
+		}
+		f.created = append(f.created, p.Locator)
+	}
+	return connect.NewResponse(&v1.SrDomainCreateResponse{}), nil
+}
+
+func (f *fakeSrDomain) SrDomainList(
+	ctx context.Context,
+	req *connect.Request[v1.SrDomainListRequest],
+) (*connect.Response[v1.SrDomainListResponse], error) {
+	return connect.NewResponse(&v1.SrDomainListResponse{}), nil
+}
+
+func newStoreTestServer(t *testing.T, st *store.Store) (*Server, *fakeSrDomain) {
+	t.Helper()
+	s := &Server{store: st, logger: zap.NewNop(), mux: http.NewServeMux()}
+	fake := &fakeSrDomain{}
+	path, handler := vinberov1connect.NewSrDomainServiceHandler(fake, s.handlerOptions()...)
+	s.mux.Handle(path, handler)
+	return s, fake
+}
+
+func TestStoreJournalAndReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "store.jsonl")
+	st, err := store.Open(path, zap.NewNop())
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	s, _ := newStoreTestServer(t, st)
+	ts := httptest.NewServer(s.mux)
+	defer ts.Close()
+
+	client := vinberov1connect.NewSrDomainServiceClient(ts.Client(), ts.URL)
+	ctx := context.Background()
+	if _, err := client.SrDomainCreate(ctx, connect.NewRequest(&v1.SrDomainCreateRequest{
+		Policies: []*v1.SrDomainPolicy{{Locator: "fc00:1::/48"}},
+	})); err != nil {
+		t.Fatalf("SrDomainCreate: %v", err)
+	}
+	if _, err := client.SrDomainList(ctx, connect.NewRequest(&v1.SrDomainListRequest{})); err != nil {
+		t.Fatalf("SrDomainList: %v", err)
+	}
+	_ = st.Close()
+
+	// A new daemon replays the journal into its handlers
+	st, err = store.Open(path, zap.NewNop())
+	if err != nil {
+		t.Fatalf("reopen: %v", err)
+	}
+	defer func() { _ = st.Close() }()
+	records := st.Records()
+	if len(records) != 1 || records[0].Procedure != vinberov1connect.SrDomainServiceSrDomainCreateProcedure {
+		t.Fatalf("journal: got %+v, want the create only", records)
+	}
+
+	s, fake := newStoreTestServer(t, st)
+	ctx = context.WithValue(ctx, replayKey{}, true)
+	if err := s.replay(ctx, records[0]); err != nil {
+		t.Fatalf("replay: %v", err)
+	}
+	if len(fake.created) != 1 || fake.created[0] != "fc00:1::/48" {
+		t.Errorf("replayed creates: got %v", fake.created)
+	}
+	if n := len(st.Records()); n != 1 {
+		t.Errorf("replay was journaled again: %d records", n)
+	}
+}
+
+func TestStoreJournalOrder(t *testing.T) {
+	st, err := store.Open(filepath.Join(t.TempDir(), "store.jsonl"), zap.NewNop())
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	defer func() { _ = st.Close() }()
+	s, fake := newStoreTestServer(t, st)
+	entered := make(chan string, 2)
+	release := make(chan struct{})
+	fake.gate = func(locator string) {
+		entered <- locator
+		if locator == "fc00:1::/48" {
+			<-release
+		}
+	}
+	ts := httptest.NewServer(s.mux)
+	defer ts.Close()
+	client := vinberov1connect.NewSrDomainServiceClient(ts.Client(), ts.URL)
+
+	create := func(locator string, done chan<- error) {
+		_, err := client.SrDomainCreate(context.Background(), connect.NewRequest(&v1.SrDomainCreateRequest{
+			Policies: []*v1.SrDomainPolicy{{Locator: locator}},
+		}))
+		done <- err
+	}
+	first, second := make(chan error, 1), make(chan error, 1)
+	go create("fc00:1::/48", first)
+	if got := <-entered; got != "fc00:1::/48" {
+		t.Fatalf("first handler: got %s", got)
+	}
+	go create("fc00:2::/48", second)
+
+	// The second create waits until the first is journaled
+	select {
+	case got := <-entered:
+		t.Fatalf("%s reached the handler while the first create was running", got)
+	case <-time.After(100 * time.Millisecond):
+	}
+	close(release)
+	for _, done := range []chan error{first, second} {
+		if err := <-done; err != nil {
+			t.Fatalf("SrDomainCreate: %v", err)
+		}
+	}
+
+	records := st.Records()
+	if len(records) != 2 {
+		t.Fatalf("journal: got %d records, want 2", len(records))
+	}
+	for i, want := range fake.created {
+		if !strings.Contains(string(records[i].Request), want) {
+			t.Errorf("record %d: got %s, want %s", i, records[i].Request, want)
+		}
+	}
+}
+
+// TestStoreJournalResize checks that a resize is journaled without
+// waiting for journalMu: it would otherwise wait behind a call that holds
+// pauseMu shared, which the resize needs exclusively.
+func TestStoreJournalResize(t *testing.T) {
+	st, err := store.Open(filepath.Join(t.TempDir(), "store.jsonl"), zap.NewNop())
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	defer func() { _ = st.Close() }()
+	s, fake := newStoreTestServer(t, st)
+	resizing := make(chan struct{})
+	resize := func(ctx context.Context, name string, capacity uint32) (*v1.MapResizeResponse, error) {
+		close(resizing)
+		s.pauseMu.Lock()
+		defer s.pauseMu.Unlock()
+		return &v1.MapResizeResponse{}, nil
+	}
+	path, handler := vinberov1connect.NewSystemServiceHandler(
+		NewSystemServer(nil, nil, nil, nil, resize), s.handlerOptions()...)
+	s.mux.Handle(path, handler)
+	entered := make(chan struct{})
+	fake.gate = func(string) {
+		close(entered)
+		select {
+		case <-resizing:
+		case <-time.After(5 * time.Second):
+			t.Error("resize never reached its handler")
+		}
+	}
+	ts := httptest.NewServer(s.mux)
+	defer ts.Close()
+
+	created := make(chan error, 1)
+	go func() {
+		_, err := vinberov1connect.NewSrDomainServiceClient(ts.Client(), ts.URL).SrDomainCreate(context.Background(),
+			connect.NewRequest(&v1.SrDomainCreateRequest{Policies: []*v1.SrDomainPolicy{{Locator: "fc00:1::/48"}}}))
+		created <- err
+	}()
+	<-entered
+	if _, err := vinberov1connect.NewSystemServiceClient(ts.Client(), ts.URL).MapResize(context.Background(),
+		connect.NewRequest(&v1.MapResizeRequest{Name: "fdb_map", Capacity: 1 << 17})); err != nil {
+		t.Fatalf("MapResize: %v", err)
+	}
+	if err := <-created; err != nil {
+		t.Fatalf("SrDomainCreate: %v", err)
+	}
+
+	var got []string
+	for _, rec := range st.Records() {
+		got = append(got, rec.Procedure)
+	}
+	want := []string{
+		vinberov1connect.SrDomainServiceSrDomainCreateProcedure,
+		vinberov1connect.SystemServiceMapResizeProcedure,
+	}
+	if strings.Join(got, " ") != strings.Join(want, " ") {
+		t.Errorf("journal: got %v, want %v", got, want)
+	}
+}
+
+func TestReplayRecords(t *testing.T) {
+	const (
+		create = vinberov1connect.SrDomainServiceSrDomainCreateProcedure
+		resize = vinberov1connect.SystemServiceMapResizeProcedure
+		plugin = vinberov1connect.PluginServicePluginRegisterProcedure
+	)
+	records := []store.Record{{Procedure: create}, {Procedure: plugin}, {Procedure: resize}}
+	tests := []struct {
+		mapsRestored bool
+		want         []string
+	}{
+		{false, []string{resize, create, plugin}},
+		{true, []string{plugin}},
+	}
+	for _, tt := range tests {
+		var got []string
+		for _, rec := range replayRecords(records, tt.mapsRestored) {
+			got = append(got, rec.Procedure)
+		}
+		if strings.Join(got, " ") != strings.Join(tt.want, " ") {
+			t.Errorf("mapsRestored=%v: got %v, want %v", tt.mapsRestored, got, tt.want)
+		}
+	}
+}
+
+func TestJournaled(t *testing.T) {
+	tests := map[string]bool{
+		vinberov1connect.SidFunctionServiceSidFunctionCreateProcedure: true,
+		vinberov1connect.SidFunctionServiceSidFunctionListProcedure:   false,
+		vinberov1connect.NetworkResourceServiceVrfCreateProcedure:     false,
+		vinberov1connect.DaemonServiceReloadProcedure:                 false,
+		vinberov1connect.PluginServicePluginRegisterProcedure:         true,
+		vinberov1connect.SystemServiceMapResizeProcedure:              true,
+	}
+	for procedure, want := range tests {
+		if got := journaled(procedure); got != want {
+			t.Errorf("journaled(%s) = %v, want %v", procedure, got, want)
+		}
+	}
+}
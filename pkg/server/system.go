@@ -0,0 +1,197 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"connectrpc.com/connect"
+	v1 "github.com/takehaya/vinbero/api/vinbero/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// SystemServer implements the SystemServiceHandler interface
+type SystemServer struct {
+	export     func(context.Context) (*v1.NodeConfig, error)
+	importNode func(context.Context, *v1.NodeConfig, v1.ImportMode) ([]*v1.ConfigSectionResult, error)
+	mapUsage   func() ([]*v1.BpfMapUsage, error)
+	mapEvents  func(int) []*v1.MapEvent
+	resizeMap  func(context.Context, string, uint32) (*v1.MapResizeResponse, error)
+}
+
+// NewSystemServer creates a new SystemServer over Server.Export,
+// Server.Import, Server.MapUsage, Server.MapEvents and Server.ResizeMap.
+func NewSystemServer(
+	export func(context.Context) (*v1.NodeConfig, error),
+	importNode func(context.Context, *v1.NodeConfig, v1.ImportMode) ([]*v1.ConfigSectionResult, error),
+	mapUsage func() ([]*v1.BpfMapUsage, error),
+	mapEvents func(int) []*v1.MapEvent,
+	resizeMap func(context.Context, string, uint32) (*v1.MapResizeResponse, error),
+) *SystemServer {
+	return &SystemServer{export: export, importNode: importNode, mapUsage: mapUsage, mapEvents: mapEvents, resizeMap: resizeMap}
+}
+
+// Export returns the control state of the node as one document.
+func (s *SystemServer) Export(
+	ctx context.Context,
+	req *connect.Request[v1.ExportRequest],
+) (*connect.Response[v1.ExportResponse], error) {
+	nc, err := s.export(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&v1.ExportResponse{Config: nc}), nil
+}
+
+// Import applies a document produced by Export. Rejected entries are
+// reported per section and don't stop the others.
+func (s *SystemServer) Import(
+	ctx context.Context,
+	req *connect.Request[v1.ImportRequest],
+) (*connect.Response[v1.ImportResponse], error) {
+	if req.Msg.Config == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("config is required"))
+	}
+	sections, err := s.importNode(ctx, req.Msg.Config, req.Msg.Mode)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&v1.ImportResponse{Sections: sections}), nil
+}
+
+// MapUsage reports entries, capacity, size and high-watermark of every
+// control map.
+func (s *SystemServer) MapUsage(
+	ctx context.Context,
+	req *connect.Request[v1.MapUsageRequest],
+) (*connect.Response[v1.MapUsageResponse], error) {
+	maps, err := s.mapUsage()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&v1.MapUsageResponse{Maps: maps}), nil
+}
+
+// MapEventList returns the most recent threshold events of the maps.
+func (s *SystemServer) MapEventList(
+	ctx context.Context,
+	req *connect.Request[v1.MapEventListRequest],
+) (*connect.Response[v1.MapEventListResponse], error) {
+	events := s.mapEvents(int(req.Msg.Limit))
+	if events == nil {
+		events = make([]*v1.MapEvent, 0)
+	}
+	return connect.NewResponse(&v1.MapEventListResponse{Events: events}), nil
+}
+
+// MapResize grows a map in the running data plane, keeping its entries.
+func (s *SystemServer) MapResize(
+	ctx context.Context,
+	req *connect.Request[v1.MapResizeRequest],
+) (*connect.Response[v1.MapResizeResponse], error) {
+	if req.Msg.Name == "" || req.Msg.Capacity == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name and capacity are required"))
+	}
+	resp, err := s.resizeMap(ctx, req.Msg.Name, req.Msg.Capacity)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// Export collects every section of the node through the List handlers,
+// each sorted by its key so two exports of the same state are equal.
+// The bridge and ESs a named BD created are only listed under the BD,
+// which creates them again on import and deletes them with itself.
+func (s *Server) Export(ctx context.Context) (*v1.NodeConfig, error) {
+	s.Setup()
+
+	secs := s.sections()
+	var err error
+	nc := &v1.NodeConfig{
+		Plugins:          s.plugin.Registered(),
+		Vrfs:             collect(ctx, &err, secs.vrfs),
+		Bridges:          collect(ctx, &err, secs.bridges),
+		EthernetSegments: collect(ctx, &err, secs.ethernetSegments),
+		SidFunctions:     collect(ctx, &err, secs.sidFunctions),
+		Headendv4S:       collect(ctx, &err, secs.headendv4s),
+		Headendv6S:       collect(ctx, &err, secs.headendv6s),
+		HeadendL2S:       collect(ctx, &err, secs.headendL2s),
+		BdPeers:          collect(ctx, &err, secs.bdPeers),
+		VlanTable:        collect(ctx, &err, secs.vlanTable),
+		FdbEntries:       collect(ctx, &err, secs.fdbEntries),
+		SrDomains:        collect(ctx, &err, secs.srDomains),
+		StampReflectors:  collect(ctx, &err, secs.stampReflectors),
+		BridgeDomains:    collect(ctx, &err, secs.bridgeDomains),
+		MacLimits:        collect(ctx, &err, secs.macLimits),
+		StormControls:    collect(ctx, &err, secs.stormControls),
+		Neighbors:        collect(ctx, &err, secs.neighbors),
+		IrbRoutes:        collect(ctx, &err, secs.irbRoutes),
+		VniMappings:      collect(ctx, &err, secs.vniMappings),
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	bridges, ess := make(map[string]bool), make(map[string]bool)
+	for _, bd := range nc.BridgeDomains {
+		if bd.Bridge != nil {
+			bridges[bridgeKey(bd.Bridge)] = true
+		}
+		for _, es := range bd.EthernetSegments {
+			ess[esKey(es)] = true
+		}
+	}
+	nc.Bridges = slices.DeleteFunc(nc.Bridges, func(e *v1.Bridge) bool { return bridges[bridgeKey(e)] })
+	nc.EthernetSegments = slices.DeleteFunc(nc.EthernetSegments, func(e *v1.EthernetSegment) bool { return ess[esKey(e)] })
+	return nc, nil
+}
+
+// Import applies nc like a reload applies the config: section, with the
+// current state of the node as the previous file. MERGE creates and
+// updates the entries nc lists; REPLACE also deletes installed entries nc
+// doesn't list, including those created over the API.
+func (s *Server) Import(ctx context.Context, nc *v1.NodeConfig, mode v1.ImportMode) ([]*v1.ConfigSectionResult, error) {
+	s.Setup()
+
+	s.reloadMu.Lock()
+	defer s.reloadMu.Unlock()
+
+	current, err := s.Export(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("import: %w", err)
+	}
+	return s.reconcile(ctx, "import", current, nc, mode == v1.ImportMode_IMPORT_MODE_REPLACE), nil
+}
+
+// staticFdb lists the user-configured local FDB entries; learned and
+// remote entries are rebuilt by the data plane and the peers.
+func (s *Server) staticFdb(ctx context.Context) ([]*v1.FdbEntry, error) {
+	resp, err := s.fdb.FdbList(ctx, connect.NewRequest(&v1.FdbListRequest{}))
+	if err != nil {
+		return nil, err
+	}
+	var out []*v1.FdbEntry
+	for _, e := range resp.Msg.Entries {
+		if e.IsStatic && !e.IsRemote {
+			out = append(out, e)
+		}
+	}
+	return out, nil
+}
+
+// collect lists one section for Export, sorted by key. The first failure
+// is kept in *err and later sections are skipped.
+func collect[T proto.Message](ctx context.Context, err *error, sec staticSection[T]) []T {
+	if *err != nil {
+		return nil
+	}
+	entries, lerr := sec.list(ctx)
+	if lerr != nil {
+		*err = fmt.Errorf("%s: %w", sec.name, lerr)
+		return nil
+	}
+	sort.SliceStable(entries, func(i, j int) bool { return sec.key(entries[i]) < sec.key(entries[j]) })
+	return entries
+}
@@ -0,0 +1,309 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"connectrpc.com/connect"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	v1 "github.com/takehaya/vinbero/api/vinbero/v1"
+)
+
+// TransactionServer implements the TransactionServiceHandler interface
+type TransactionServer struct {
+	commit func(context.Context, []*v1.TransactionOperation, bool) *v1.TransactionCommitResponse
+}
+
+// NewTransactionServer creates a new TransactionServer over
+// Server.CommitTransaction.
+func NewTransactionServer(
+	commit func(context.Context, []*v1.TransactionOperation, bool) *v1.TransactionCommitResponse,
+) *TransactionServer {
+	return &TransactionServer{commit: commit}
+}
+
+// TransactionCommit applies every operation of the request or none.
+func (s *TransactionServer) TransactionCommit(
+	ctx context.Context,
+	req *connect.Request[v1.TransactionCommitRequest],
+) (*connect.Response[v1.TransactionCommitResponse], error) {
+	if len(req.Msg.Operations) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("operations is empty"))
+	}
+	return connect.NewResponse(s.commit(ctx, req.Msg.Operations, req.Msg.DryRun)), nil
+}
+
+// CommitTransaction validates every operation against the installed
+// entries, then applies them in dependency order: creates and updates
+// from VRFs and bridges down to the VLAN table, deletes in reverse. When
+// a step fails, the steps already applied are undone in reverse order
+// from a snapshot taken during validation. With dryRun nothing is
+// applied.
+func (s *Server) CommitTransaction(ctx context.Context, ops []*v1.TransactionOperation, dryRun bool) *v1.TransactionCommitResponse {
+	s.Setup()
+
+	s.reloadMu.Lock()
+	defer s.reloadMu.Unlock()
+
+	secs := s.sections()
+	// VRFs and bridges come back from state.json before the store is
+	// replayed, so a replayed transaction finds the ones it created.
+	replay := ctx.Value(replayKey{}) != nil
+
+	// Devices the transaction creates don't exist yet while the SIDs
+	// naming them are validated.
+	devices := make(map[string]bool)
+	for _, op := range ops {
+		if op.Op != v1.TransactionOp_TRANSACTION_OP_CREATE {
+			continue
+		}
+		if vrf := op.GetVrf(); vrf != nil {
+			devices[vrf.Name] = true
+		}
+		if br := op.GetBridge(); br != nil {
+			devices[br.Name] = true
+		}
+	}
+	validateSid := secs.sidFunctions.validate
+	secs.sidFunctions.validate = func(e *v1.SidFunction) error {
+		if devices[e.VrfName] || devices[e.BridgeName] {
+			e = proto.Clone(e).(*v1.SidFunction)
+			if devices[e.VrfName] {
+				e.VrfName = ""
+			}
+			if devices[e.BridgeName] {
+				e.BridgeName = ""
+			}
+		}
+		return validateSid(e)
+	}
+
+	vrfs := &txSection[*v1.Vrf]{sec: secs.vrfs, replay: replay}
+	bridges := &txSection[*v1.Bridge]{sec: secs.bridges, replay: replay}
+	ess := &txSection[*v1.EthernetSegment]{sec: secs.ethernetSegments, replay: replay}
+	sids := &txSection[*v1.SidFunction]{sec: secs.sidFunctions, replay: replay}
+	v4s := &txSection[*v1.Headendv4]{sec: secs.headendv4s, replay: replay}
+	v6s := &txSection[*v1.Headendv6]{sec: secs.headendv6s, replay: replay}
+	l2s := &txSection[*v1.HeadendL2]{sec: secs.headendL2s, replay: replay}
+	peers := &txSection[*v1.BdPeer]{sec: secs.bdPeers, replay: replay}
+	vlans := &txSection[*v1.VlanTableEntry]{sec: secs.vlanTable, replay: replay}
+
+	var errs []*v1.OperationError
+	for i, op := range ops {
+		switch r := op.Resource.(type) {
+		case *v1.TransactionOperation_Vrf:
+			vrfs.add(i, op.Op, r.Vrf)
+		case *v1.TransactionOperation_Bridge:
+			bridges.add(i, op.Op, r.Bridge)
+		case *v1.TransactionOperation_EthernetSegment:
+			ess.add(i, op.Op, r.EthernetSegment)
+		case *v1.TransactionOperation_SidFunction:
+			sids.add(i, op.Op, r.SidFunction)
+		case *v1.TransactionOperation_Headendv4:
+			v4s.add(i, op.Op, r.Headendv4)
+		case *v1.TransactionOperation_Headendv6:
+			v6s.add(i, op.Op, r.Headendv6)
+		case *v1.TransactionOperation_HeadendL2:
+			l2s.add(i, op.Op, r.HeadendL2)
+		case *v1.TransactionOperation_BdPeer:
+			peers.add(i, op.Op, r.BdPeer)
+		case *v1.TransactionOperation_VlanTableEntry:
+			vlans.add(i, op.Op, r.VlanTableEntry)
+		default:
+			errs = append(errs, &v1.OperationError{
+				TriggerPrefix: fmt.Sprintf("operations[%d]", i),
+				Reason:        "no resource set",
+			})
+		}
+	}
+
+	resp := commitTx(ctx, []txPlanner{vrfs, bridges, ess, sids, v4s, v6s, l2s, peers, vlans}, errs, dryRun)
+	s.logger.Info("Transaction finished",
+		zap.Int("operations", len(ops)),
+		zap.Bool("dry_run", dryRun),
+		zap.Bool("applied", resp.Applied),
+		zap.Int("errors", len(resp.Errors)),
+		zap.Int("rollback_errors", len(resp.RollbackErrors)))
+	return resp
+}
+
+// txPlanner validates the operations of one section and turns them into
+// steps.
+type txPlanner interface {
+	plan(ctx context.Context, rank int) ([]txStep, []*v1.OperationError)
+}
+
+type txStep interface {
+	rank() int
+	isDelete() bool
+	label() string
+	apply(ctx context.Context) error
+	undo(ctx context.Context) error
+}
+
+// commitTx plans the sections, ranked by their position, and applies the
+// steps unless planning failed or dryRun is set. errs carries errors
+// found before planning.
+func commitTx(ctx context.Context, planners []txPlanner, errs []*v1.OperationError, dryRun bool) *v1.TransactionCommitResponse {
+	resp := &v1.TransactionCommitResponse{Errors: errs}
+	var steps []txStep
+	for rank, p := range planners {
+		st, perrs := p.plan(ctx, rank)
+		steps = append(steps, st...)
+		resp.Errors = append(resp.Errors, perrs...)
+	}
+	if len(resp.Errors) > 0 || dryRun {
+		return resp
+	}
+
+	// Creates and updates parents first, deletes children first
+	sort.SliceStable(steps, func(i, j int) bool {
+		a, b := steps[i], steps[j]
+		if a.isDelete() != b.isDelete() {
+			return !a.isDelete()
+		}
+		if a.isDelete() {
+			return a.rank() > b.rank()
+		}
+		return a.rank() < b.rank()
+	})
+
+	for i, st := range steps {
+		if err := st.apply(ctx); err != nil {
+			resp.Errors = append(resp.Errors, &v1.OperationError{TriggerPrefix: st.label(), Reason: err.Error()})
+			for j := i - 1; j >= 0; j-- {
+				if err := steps[j].undo(ctx); err != nil {
+					resp.RollbackErrors = append(resp.RollbackErrors, &v1.OperationError{
+						TriggerPrefix: steps[j].label(),
+						Reason:        err.Error(),
+					})
+				}
+			}
+			return resp
+		}
+	}
+	resp.Applied = true
+	return resp
+}
+
+type txInput[T proto.Message] struct {
+	index int
+	op    v1.TransactionOp
+	entry T
+}
+
+// txSection gathers the operations of one resource kind.
+type txSection[T proto.Message] struct {
+	sec    staticSection[T]
+	replay bool
+	ops    []txInput[T]
+}
+
+func (t *txSection[T]) add(index int, op v1.TransactionOp, entry T) {
+	t.ops = append(t.ops, txInput[T]{index: index, op: op, entry: entry})
+}
+
+// plan checks each operation against the installed entries: a create
+// needs a free key, an update or delete an installed one, and a key may
+// appear only once. The installed entry is kept to undo the step. On
+// replay a device that already exists is taken as created.
+func (t *txSection[T]) plan(ctx context.Context, rank int) ([]txStep, []*v1.OperationError) {
+	if len(t.ops) == 0 {
+		return nil, nil
+	}
+	current, err := t.sec.list(ctx)
+	if err != nil {
+		return nil, []*v1.OperationError{{TriggerPrefix: t.sec.name, Reason: err.Error()}}
+	}
+	installed := make(map[string]T, len(current))
+	for _, e := range current {
+		installed[t.sec.key(e)] = e
+	}
+
+	var steps []txStep
+	var errs []*v1.OperationError
+	seen := make(map[string]bool, len(t.ops))
+	for _, in := range t.ops {
+		k := t.sec.key(in.entry)
+		st := &txEntryStep[T]{sec: t.sec, order: rank, op: in.op, key: k, entry: in.entry}
+		old, exists := installed[k]
+		var reason string
+		switch {
+		case t.replay && t.sec.immutable && exists && in.op == v1.TransactionOp_TRANSACTION_OP_CREATE:
+			seen[k] = true
+			continue
+		case seen[k]:
+			reason = "key appears more than once in the transaction"
+		case in.op == v1.TransactionOp_TRANSACTION_OP_CREATE && exists:
+			reason = "already exists"
+		case in.op == v1.TransactionOp_TRANSACTION_OP_UPDATE && t.sec.immutable:
+			reason = "an existing device can't be changed in place; delete it, then create it again"
+		case (in.op == v1.TransactionOp_TRANSACTION_OP_UPDATE || in.op == v1.TransactionOp_TRANSACTION_OP_DELETE) && !exists:
+			reason = "not found"
+		case in.op == v1.TransactionOp_TRANSACTION_OP_UNSPECIFIED:
+			reason = "op is required"
+		case in.op != v1.TransactionOp_TRANSACTION_OP_DELETE && t.sec.validate != nil:
+			if err := t.sec.validate(in.entry); err != nil {
+				reason = err.Error()
+			}
+		}
+		seen[k] = true
+		if reason != "" {
+			errs = append(errs, &v1.OperationError{
+				TriggerPrefix: fmt.Sprintf("operations[%d] %s", in.index, st.label()),
+				Reason:        reason,
+			})
+			continue
+		}
+		st.old = old
+		steps = append(steps, st)
+	}
+	return steps, errs
+}
+
+// txEntryStep applies one operation through the section handlers.
+type txEntryStep[T proto.Message] struct {
+	sec   staticSection[T]
+	order int
+	op    v1.TransactionOp
+	key   string
+	entry T
+	old   T // installed entry for an update or delete
+}
+
+func (st *txEntryStep[T]) rank() int      { return st.order }
+func (st *txEntryStep[T]) isDelete() bool { return st.op == v1.TransactionOp_TRANSACTION_OP_DELETE }
+func (st *txEntryStep[T]) label() string  { return st.sec.name + " " + st.key }
+
+func (st *txEntryStep[T]) apply(ctx context.Context) error {
+	if st.isDelete() {
+		return once(ctx, st.sec.remove, st.old)
+	}
+	return once(ctx, st.sec.create, st.entry)
+}
+
+// undo removes a created entry and restores the snapshot of an updated
+// or deleted one.
+func (st *txEntryStep[T]) undo(ctx context.Context) error {
+	if st.op == v1.TransactionOp_TRANSACTION_OP_CREATE {
+		return once(ctx, st.sec.remove, st.entry)
+	}
+	return once(ctx, st.sec.create, st.old)
+}
+
+// once calls a section function for a single entry and folds its
+// per-entry error into the returned one.
+func once[T any](ctx context.Context, fn func(context.Context, []T) ([]*v1.OperationError, error), entry T) error {
+	errs, err := fn(ctx, []T{entry})
+	if err != nil {
+		return err
+	}
+	if len(errs) > 0 {
+		return errors.New(errs[0].Reason)
+	}
+	return nil
+}
@@ -0,0 +1,227 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	v1 "github.com/takehaya/vinbero/api/vinbero/v1"
+)
+
+// fakeTxSection keeps installed entries in a map and logs every call as
+// "<verb> <key>". Keys in fail make create and remove fail.
+func fakeTxSection[T proto.Message](name string, key func(T) string, installed map[string]T, log *[]string, fail map[string]bool) staticSection[T] {
+	call := func(verb string, e []T, fn func(string, T)) []*v1.OperationError {
+		var errs []*v1.OperationError
+		for _, x := range e {
+			k := key(x)
+			*log = append(*log, verb+" "+k)
+			if fail[k] {
+				errs = append(errs, &v1.OperationError{TriggerPrefix: k, Reason: "injected failure"})
+				continue
+			}
+			fn(k, x)
+		}
+		return errs
+	}
+	return staticSection[T]{
+		name: name,
+		key:  key,
+		list: func(context.Context) ([]T, error) {
+			var out []T
+			for _, e := range installed {
+				out = append(out, e)
+			}
+			return out, nil
+		},
+		create: func(_ context.Context, e []T) ([]*v1.OperationError, error) {
+			return call("create", e, func(k string, x T) { installed[k] = x }), nil
+		},
+		remove: func(_ context.Context, e []T) ([]*v1.OperationError, error) {
+			return call("remove", e, func(k string, _ T) { delete(installed, k) }), nil
+		},
+	}
+}
+
+type txFixture struct {
+	vrfs map[string]*v1.Vrf
+	sids map[string]*v1.SidFunction
+	log  []string
+	fail map[string]bool
+}
+
+func newTxFixture() *txFixture {
+	return &txFixture{
+		vrfs: map[string]*v1.Vrf{"vrf-old": {Name: "vrf-old", TableId: 9}},
+		sids: map[string]*v1.SidFunction{
+			"fc00:1::1/128": {TriggerPrefix: "fc00:1::1/128", Action: v1.Srv6LocalAction_SRV6_LOCAL_ACTION_END},
+			"fc00:1::2/128": {TriggerPrefix: "fc00:1::2/128", Action: v1.Srv6LocalAction_SRV6_LOCAL_ACTION_END},
+		},
+		fail: make(map[string]bool),
+	}
+}
+
+// commit runs ops the way CommitTransaction does, with VRFs ranked
+// before SIDs.
+func (f *txFixture) commit(ops []*v1.TransactionOperation, dryRun bool) *v1.TransactionCommitResponse {
+	vrfSec := fakeTxSection("vrfs", vrfKey, f.vrfs, &f.log, f.fail)
+	vrfSec.immutable = true
+	vrfSec.validate = func(e *v1.Vrf) error { return requireName(e.Name) }
+	sidSec := fakeTxSection("sid_functions", sidKey, f.sids, &f.log, f.fail)
+	sidSec.validate = func(e *v1.SidFunction) error { return checkPrefix(e.TriggerPrefix, false) }
+
+	vrfs := &txSection[*v1.Vrf]{sec: vrfSec}
+	sids := &txSection[*v1.SidFunction]{sec: sidSec}
+	for i, op := range ops {
+		switch r := op.Resource.(type) {
+		case *v1.TransactionOperation_Vrf:
+			vrfs.add(i, op.Op, r.Vrf)
+		case *v1.TransactionOperation_SidFunction:
+			sids.add(i, op.Op, r.SidFunction)
+		}
+	}
+	return commitTx(context.Background(), []txPlanner{vrfs, sids}, nil, dryRun)
+}
+
+func (f *txFixture) state() string {
+	var keys []string
+	for k := range f.vrfs {
+		keys = append(keys, k)
+	}
+	for k, e := range f.sids {
+		keys = append(keys, fmt.Sprintf("%s=%d", k, e.Action))
+	}
+	slices.Sort(keys)
+	return fmt.Sprint(keys)
+}
+
+func txVrf(op v1.TransactionOp, name string) *v1.TransactionOperation {
+	return &v1.TransactionOperation{Op: op, Resource: &v1.TransactionOperation_Vrf{Vrf: &v1.Vrf{Name: name, TableId: 100}}}
+}
+
+func txSid(op v1.TransactionOp, prefix string, action v1.Srv6LocalAction) *v1.TransactionOperation {
+	return &v1.TransactionOperation{Op: op, Resource: &v1.TransactionOperation_SidFunction{
+		SidFunction: &v1.SidFunction{TriggerPrefix: prefix, Action: action, VrfName: "vrf100"},
+	}}
+}
+
+const (
+	opCreate = v1.TransactionOp_TRANSACTION_OP_CREATE
+	opUpdate = v1.TransactionOp_TRANSACTION_OP_UPDATE
+	opDelete = v1.TransactionOp_TRANSACTION_OP_DELETE
+	actEnd   = v1.Srv6LocalAction_SRV6_LOCAL_ACTION_END
+	actDt4   = v1.Srv6LocalAction_SRV6_LOCAL_ACTION_END_DT4
+)
+
+// mixedOps lists children before parents so the test sees the commit
+// reorder them.
+func mixedOps() []*v1.TransactionOperation {
+	return []*v1.TransactionOperation{
+		txSid(opCreate, "fc00:1::10/128", actDt4),
+		txVrf(opDelete, "vrf-old"),
+		txVrf(opCreate, "vrf100"),
+		txSid(opUpdate, "fc00:1::1/128", actDt4),
+		txSid(opDelete, "fc00:1::2/128", actEnd),
+	}
+}
+
+func TestCommitTxOrder(t *testing.T) {
+	f := newTxFixture()
+	resp := f.commit(mixedOps(), false)
+	if !resp.Applied || len(resp.Errors) != 0 {
+		t.Fatalf("applied=%v errors=%v, want applied", resp.Applied, resp.Errors)
+	}
+	want := []string{
+		"create vrf100",
+		"create fc00:1::10/128",
+		"create fc00:1::1/128",
+		"remove fc00:1::2/128",
+		"remove vrf-old",
+	}
+	if !slices.Equal(f.log, want) {
+		t.Errorf("calls %v, want %v", f.log, want)
+	}
+	want = []string{"fc00:1::1/128=8", "fc00:1::10/128=8", "vrf100"}
+	if got := f.state(); got != fmt.Sprint(want) {
+		t.Errorf("state %s, want %v", got, want)
+	}
+}
+
+func TestCommitTxRollback(t *testing.T) {
+	f := newTxFixture()
+	before := f.state()
+	f.fail["vrf-old"] = true
+
+	resp := f.commit(mixedOps(), false)
+	if resp.Applied {
+		t.Fatal("applied despite a failed step")
+	}
+	if len(resp.Errors) != 1 || resp.Errors[0].TriggerPrefix != "vrfs vrf-old" {
+		t.Errorf("errors %v, want the vrf-old step", resp.Errors)
+	}
+	if len(resp.RollbackErrors) != 0 {
+		t.Errorf("rollback errors %v", resp.RollbackErrors)
+	}
+	wantUndo := []string{
+		"create fc00:1::2/128",
+		"create fc00:1::1/128",
+		"remove fc00:1::10/128",
+		"remove vrf100",
+	}
+	if got := f.log[len(f.log)-len(wantUndo):]; !slices.Equal(got, wantUndo) {
+		t.Errorf("undo calls %v, want %v", got, wantUndo)
+	}
+	if got := f.state(); got != before {
+		t.Errorf("state after rollback %s, want %s", got, before)
+	}
+	if f.sids["fc00:1::1/128"].Action != actEnd {
+		t.Errorf("updated SID not restored: %v", f.sids["fc00:1::1/128"])
+	}
+}
+
+func TestCommitTxValidation(t *testing.T) {
+	tests := map[string]*v1.TransactionOperation{
+		"create existing": txSid(opCreate, "fc00:1::1/128", actEnd),
+		"update missing":  txSid(opUpdate, "fc00:1::99/128", actEnd),
+		"delete missing":  txVrf(opDelete, "vrf-none"),
+		"update device":   txVrf(opUpdate, "vrf-old"),
+		"bad prefix":      txSid(opCreate, "10.0.0.0/8", actEnd),
+		"no name":         txVrf(opCreate, ""),
+		"no op":           txVrf(v1.TransactionOp_TRANSACTION_OP_UNSPECIFIED, "vrf200"),
+	}
+	for name, bad := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newTxFixture()
+			before := f.state()
+			ops := append(mixedOps(), bad)
+			resp := f.commit(ops, false)
+			if resp.Applied || len(resp.Errors) != 1 {
+				t.Fatalf("applied=%v errors=%v, want one error", resp.Applied, resp.Errors)
+			}
+			if len(f.log) != 0 || f.state() != before {
+				t.Errorf("maps touched: calls %v", f.log)
+			}
+		})
+	}
+
+	f := newTxFixture()
+	ops := append(mixedOps(), txSid(opUpdate, "fc00:1:0::10/128", actEnd))
+	if resp := f.commit(ops, false); resp.Applied || len(resp.Errors) != 1 {
+		t.Errorf("duplicate key: applied=%v errors=%v", resp.Applied, resp.Errors)
+	}
+}
+
+func TestCommitTxDryRun(t *testing.T) {
+	f := newTxFixture()
+	before := f.state()
+	resp := f.commit(mixedOps(), true)
+	if resp.Applied || len(resp.Errors) != 0 {
+		t.Errorf("applied=%v errors=%v, want validated only", resp.Applied, resp.Errors)
+	}
+	if len(f.log) != 0 || f.state() != before {
+		t.Errorf("dry run touched the maps: %v", f.log)
+	}
+}
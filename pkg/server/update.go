@@ -0,0 +1,49 @@
+package server
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// mergeUpdate builds the entry an Update RPC installs: the fields mask
+// names are taken from update, the rest from current. An empty mask takes
+// every field of update. keyFields identify the installed entry and can't
+// be named in the mask.
+func mergeUpdate[T proto.Message](current, update T, mask *fieldmaskpb.FieldMask, keyFields ...string) (T, error) {
+	if len(mask.GetPaths()) == 0 {
+		return update, nil
+	}
+	merged := proto.Clone(current).(T)
+	dst := merged.ProtoReflect()
+	// Set shares lists and messages, so take them from a copy of update
+	src := proto.Clone(update).ProtoReflect()
+	fields := dst.Descriptor().Fields()
+	for _, path := range mask.GetPaths() {
+		if strings.Contains(path, ".") {
+			return merged, fmt.Errorf("update_mask %q: nested paths are not supported", path)
+		}
+		fd := fields.ByName(protoreflect.Name(path))
+		if fd == nil {
+			return merged, fmt.Errorf("update_mask %q: no such field in %s", path, dst.Descriptor().Name())
+		}
+		if slices.Contains(keyFields, path) {
+			return merged, fmt.Errorf("update_mask %q: key fields can't be updated", path)
+		}
+		if src.Has(fd) {
+			dst.Set(fd, src.Get(fd))
+		} else {
+			dst.Clear(fd)
+		}
+	}
+	return merged, nil
+}
+
+// masked reports whether an Update with mask changes field.
+func masked(mask *fieldmaskpb.FieldMask, field string) bool {
+	return len(mask.GetPaths()) == 0 || slices.Contains(mask.GetPaths(), field)
+}
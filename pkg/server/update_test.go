@@ -0,0 +1,83 @@
+package server
+
+import (
+	"slices"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1 "github.com/takehaya/vinbero/api/vinbero/v1"
+)
+
+func TestMergeUpdate(t *testing.T) {
+	current := &v1.Headendv4{
+		TriggerPrefix: "10.0.0.0/24",
+		SrcAddr:       "fc00::1",
+		Segments:      []string{"fc00:1::1", "fc00:2::1"},
+		Mode:          v1.Srv6HeadendBehavior_SRV6_HEADEND_BEHAVIOR_H_ENCAPS,
+		Mtu:           1400,
+	}
+	update := &v1.Headendv4{
+		TriggerPrefix: "10.0.0.0/24",
+		Segments:      []string{"fc00:3::1"},
+	}
+
+	t.Run("empty mask replaces every field", func(t *testing.T) {
+		got, err := mergeUpdate(current, update, nil, "trigger_prefix")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !proto.Equal(got, update) {
+			t.Errorf("got %v, want %v", got, update)
+		}
+	})
+
+	t.Run("masked fields only", func(t *testing.T) {
+		mask := &fieldmaskpb.FieldMask{Paths: []string{"segments", "mtu"}}
+		got, err := mergeUpdate(current, update, mask, "trigger_prefix")
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := &v1.Headendv4{
+			TriggerPrefix: "10.0.0.0/24",
+			SrcAddr:       "fc00::1",
+			Segments:      []string{"fc00:3::1"},
+			Mode:          v1.Srv6HeadendBehavior_SRV6_HEADEND_BEHAVIOR_H_ENCAPS,
+		}
+		if !proto.Equal(got, want) {
+			t.Errorf("got %v, want %v", got, want)
+		}
+		// The merged entry must not share the request's list
+		got.Segments[0] = "changed"
+		if update.Segments[0] != "fc00:3::1" {
+			t.Error("merged entry aliases the update")
+		}
+		if current.Mtu != 1400 || !slices.Equal(current.Segments, []string{"fc00:1::1", "fc00:2::1"}) {
+			t.Error("current was modified")
+		}
+	})
+
+	for name, path := range map[string]string{
+		"key field":     "trigger_prefix",
+		"unknown field": "no_such_field",
+		"nested path":   "segments.value",
+	} {
+		t.Run(name, func(t *testing.T) {
+			mask := &fieldmaskpb.FieldMask{Paths: []string{path}}
+			if _, err := mergeUpdate(current, update, mask, "trigger_prefix"); err == nil {
+				t.Errorf("mask %q: expected error", path)
+			}
+		})
+	}
+}
+
+func TestMasked(t *testing.T) {
+	if !masked(nil, "segments") {
+		t.Error("empty mask should cover every field")
+	}
+	mask := &fieldmaskpb.FieldMask{Paths: []string{"mtu"}}
+	if !masked(mask, "mtu") || masked(mask, "segments") {
+		t.Error("masked should follow the mask's paths")
+	}
+}
@@ -51,7 +51,16 @@ func (s *VlanTableServer) VlanTableCreate(
 			continue
 		}
 
-		if err := s.mapOps.CreateDx2vVlan(uint16(e.TableId), uint16(e.VlanId), oif); err != nil {
+		rewrite, err := vlanRewriteFromProto(e.VlanRewrite)
+		if err != nil {
+			resp.Errors = append(resp.Errors, &v1.OperationError{
+				TriggerPrefix: fmt.Sprintf("table=%d,vlan=%d", e.TableId, e.VlanId),
+				Reason:        fmt.Sprintf("vlan_rewrite: %v", err),
+			})
+			continue
+		}
+
+		if err := s.mapOps.CreateDx2vVlan(uint16(e.TableId), uint16(e.VlanId), oif, rewrite); err != nil {
 			resp.Errors = append(resp.Errors, &v1.OperationError{
 				TriggerPrefix: fmt.Sprintf("table=%d,vlan=%d", e.TableId, e.VlanId),
 				Reason:        err.Error(),
@@ -65,6 +74,78 @@ func (s *VlanTableServer) VlanTableCreate(
 	return connect.NewResponse(resp), nil
 }
 
+// VlanTableUpdate changes installed VLAN cross-connect entries in place.
+func (s *VlanTableServer) VlanTableUpdate(
+	ctx context.Context,
+	req *connect.Request[v1.VlanTableUpdateRequest],
+) (*connect.Response[v1.VlanTableUpdateResponse], error) {
+	resp := &v1.VlanTableUpdateResponse{
+		Updated: make([]*v1.VlanTableEntry, 0),
+		Errors:  make([]*v1.OperationError, 0),
+	}
+
+	for _, e := range req.Msg.Entries {
+		trigger := fmt.Sprintf("table=%d,vlan=%d", e.TableId, e.VlanId)
+		if e.VlanId > 4095 {
+			resp.Errors = append(resp.Errors, &v1.OperationError{
+				TriggerPrefix: trigger,
+				Reason:        fmt.Sprintf("vlan_id %d exceeds maximum 4095", e.VlanId),
+			})
+			continue
+		}
+		if e.TableId > 65535 {
+			resp.Errors = append(resp.Errors, &v1.OperationError{
+				TriggerPrefix: trigger,
+				Reason:        fmt.Sprintf("table_id %d exceeds maximum 65535", e.TableId),
+			})
+			continue
+		}
+		current, err := s.mapOps.GetDx2vVlan(uint16(e.TableId), uint16(e.VlanId))
+		if err != nil {
+			resp.Errors = append(resp.Errors, &v1.OperationError{
+				TriggerPrefix: trigger,
+				Reason:        fmt.Sprintf("not found: %v", err),
+			})
+			continue
+		}
+		installed := &v1.VlanTableEntry{
+			TableId:       e.TableId,
+			VlanId:        e.VlanId,
+			InterfaceName: ifindexToName(current.Oif),
+			VlanRewrite:   vlanRewriteToProto(current.Rewrite),
+		}
+		merged, err := mergeUpdate(installed, e, req.Msg.UpdateMask, "table_id", "vlan_id")
+		if err != nil {
+			resp.Errors = append(resp.Errors, &v1.OperationError{TriggerPrefix: trigger, Reason: err.Error()})
+			continue
+		}
+
+		oif, err := resolveIfindex(merged.InterfaceName)
+		if err != nil {
+			resp.Errors = append(resp.Errors, &v1.OperationError{
+				TriggerPrefix: trigger,
+				Reason:        fmt.Sprintf("interface %q: %v", merged.InterfaceName, err),
+			})
+			continue
+		}
+		rewrite, err := vlanRewriteFromProto(merged.VlanRewrite)
+		if err != nil {
+			resp.Errors = append(resp.Errors, &v1.OperationError{
+				TriggerPrefix: trigger,
+				Reason:        fmt.Sprintf("vlan_rewrite: %v", err),
+			})
+			continue
+		}
+		if err := s.mapOps.CreateDx2vVlan(uint16(e.TableId), uint16(e.VlanId), oif, rewrite); err != nil {
+			resp.Errors = append(resp.Errors, &v1.OperationError{TriggerPrefix: trigger, Reason: err.Error()})
+			continue
+		}
+		resp.Updated = append(resp.Updated, merged)
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
 func (s *VlanTableServer) VlanTableDelete(
 	ctx context.Context,
 	req *connect.Request[v1.VlanTableDeleteRequest],
@@ -133,6 +214,7 @@ func (s *VlanTableServer) VlanTableList(
 			TableId:       uint32(key.TableId),
 			VlanId:        uint32(key.VlanId),
 			InterfaceName: ifindexToName(entry.Oif),
+			VlanRewrite:   vlanRewriteToProto(entry.Rewrite),
 		})
 	}
 
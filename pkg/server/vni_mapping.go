@@ -0,0 +1,177 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+
+	"connectrpc.com/connect"
+	v1 "github.com/takehaya/vinbero/api/vinbero/v1"
+	"github.com/takehaya/vinbero/pkg/bpf"
+)
+
+// VniMappingServer implements the VniMappingServiceHandler interface
+type VniMappingServer struct {
+	mapOps *bpf.MapOperations
+}
+
+// NewVniMappingServer creates a new VniMappingServer
+func NewVniMappingServer(mapOps *bpf.MapOperations) *VniMappingServer {
+	return &VniMappingServer{mapOps: mapOps}
+}
+
+// VniMappingCreate installs or replaces VXLAN gateway mappings
+func (s *VniMappingServer) VniMappingCreate(
+	ctx context.Context,
+	req *connect.Request[v1.VniMappingCreateRequest],
+) (*connect.Response[v1.VniMappingCreateResponse], error) {
+	resp := &v1.VniMappingCreateResponse{
+		Created: make([]*v1.VniMapping, 0),
+		Errors:  make([]*v1.OperationError, 0),
+	}
+
+	for _, mapping := range req.Msg.Mappings {
+		local, remotes, bridgeIfindex, err := validateVniMapping(mapping)
+		if err == nil {
+			err = s.mapOps.CreateVniMapping(mapping.Vni, uint16(mapping.BdId), local, remotes, bridgeIfindex)
+		}
+		if err != nil {
+			resp.Errors = append(resp.Errors, &v1.OperationError{
+				TriggerPrefix: fmt.Sprintf("vni=%d", mapping.Vni),
+				Reason:        err.Error(),
+			})
+			continue
+		}
+
+		resp.Created = append(resp.Created, mapping)
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// VniMappingDelete removes mappings by VNI or by BD
+func (s *VniMappingServer) VniMappingDelete(
+	ctx context.Context,
+	req *connect.Request[v1.VniMappingDeleteRequest],
+) (*connect.Response[v1.VniMappingDeleteResponse], error) {
+	resp := &v1.VniMappingDeleteResponse{
+		DeletedVnis: make([]uint32, 0),
+		Errors:      make([]*v1.OperationError, 0),
+	}
+
+	vnis := append([]uint32(nil), req.Msg.Vnis...)
+	for _, bdID := range req.Msg.BdIds {
+		vni := uint32(0)
+		if bdID <= 65535 {
+			vni = s.mapOps.VniForBd(uint16(bdID))
+		}
+		if vni == 0 {
+			resp.Errors = append(resp.Errors, &v1.OperationError{
+				TriggerPrefix: fmt.Sprintf("bd_id=%d", bdID),
+				Reason:        "bd is not mapped to a vni",
+			})
+			continue
+		}
+		vnis = append(vnis, vni)
+	}
+
+	for _, vni := range vnis {
+		if err := s.mapOps.DeleteVniMapping(vni); err != nil {
+			resp.Errors = append(resp.Errors, &v1.OperationError{
+				TriggerPrefix: fmt.Sprintf("vni=%d", vni),
+				Reason:        err.Error(),
+			})
+			continue
+		}
+
+		resp.DeletedVnis = append(resp.DeletedVnis, vni)
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// VniMappingList lists mappings, optionally filtered by VNI and BD
+func (s *VniMappingServer) VniMappingList(
+	ctx context.Context,
+	req *connect.Request[v1.VniMappingListRequest],
+) (*connect.Response[v1.VniMappingListResponse], error) {
+	mappings, err := s.mapOps.ListVniMappings()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := &v1.VniMappingListResponse{
+		Mappings: make([]*v1.VniMapping, 0, len(mappings)),
+	}
+
+	for vni, m := range mappings {
+		if req.Msg.Vni != 0 && vni != req.Msg.Vni {
+			continue
+		}
+		if req.Msg.BdId != 0 && uint32(m.BdID) != req.Msg.BdId {
+			continue
+		}
+		remotes := make([]string, 0, len(m.RemoteVteps))
+		for _, ip := range m.RemoteVteps {
+			remotes = append(remotes, ip.String())
+		}
+		resp.Mappings = append(resp.Mappings, &v1.VniMapping{
+			Vni:         vni,
+			BdId:        uint32(m.BdID),
+			LocalVtep:   m.LocalVtep.String(),
+			RemoteVteps: remotes,
+			BridgeName:  ifindexToName(m.BridgeIfindex),
+		})
+	}
+	sort.Slice(resp.Mappings, func(i, j int) bool {
+		return resp.Mappings[i].Vni < resp.Mappings[j].Vni
+	})
+
+	return connect.NewResponse(resp), nil
+}
+
+// VniMappingFlush removes every mapping.
+func (s *VniMappingServer) VniMappingFlush(
+	ctx context.Context,
+	req *connect.Request[v1.VniMappingFlushRequest],
+) (*connect.Response[v1.VniMappingFlushResponse], error) {
+	count, err := s.mapOps.FlushVniMappings()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&v1.VniMappingFlushResponse{DeletedCount: count}), nil
+}
+
+// validateVniMapping parses the VTEP addresses and resolves the bridge.
+// Range and family checks are left to bpf.CreateVniMapping.
+func validateVniMapping(m *v1.VniMapping) (net.IP, []net.IP, uint32, error) {
+	if m.BdId > 65535 {
+		return nil, nil, 0, fmt.Errorf("bd_id %d exceeds maximum 65535", m.BdId)
+	}
+	local := net.ParseIP(m.LocalVtep)
+	if local == nil {
+		return nil, nil, 0, fmt.Errorf("invalid local_vtep %q", m.LocalVtep)
+	}
+	remotes := make([]net.IP, 0, len(m.RemoteVteps))
+	seen := make(map[string]bool, len(m.RemoteVteps))
+	for _, r := range m.RemoteVteps {
+		ip := net.ParseIP(r)
+		if ip == nil {
+			return nil, nil, 0, fmt.Errorf("invalid remote_vtep %q", r)
+		}
+		if seen[ip.String()] {
+			return nil, nil, 0, fmt.Errorf("duplicate remote_vtep %s", ip)
+		}
+		seen[ip.String()] = true
+		remotes = append(remotes, ip)
+	}
+	var bridgeIfindex uint32
+	if m.BridgeName != "" {
+		var err error
+		if bridgeIfindex, err = resolveIfindex(m.BridgeName); err != nil {
+			return nil, nil, 0, err
+		}
+	}
+	return local, remotes, bridgeIfindex, nil
+}
@@ -0,0 +1,90 @@
+// Package stamp implements the Session-Sender side of STAMP (RFC 8762)
+// over SRv6 segment lists (RFC 9503). The matching Session-Reflector runs
+// in the XDP program (src/core/stamp.h).
+package stamp
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+)
+
+// DefaultPort is the IANA-assigned STAMP UDP port.
+const DefaultPort = 862
+
+// PacketLen is the size of the unauthenticated Session-Sender and
+// Session-Reflector test packets (RFC 8762 Sections 4.2.1 and 4.3.1).
+const PacketLen = 44
+
+// errEstimate marks timestamps as PTPv2 (Z=1), not UTC-synchronized
+// (S=0), with the smallest valid multiplier. Matches STAMP_ERR_EST in the
+// data plane.
+const errEstimate = 0x4001
+
+// errEstimatePTP is the Z bit of the Error Estimate field.
+const errEstimatePTP = 0x4000
+
+// Reflected is a decoded Session-Reflector test packet.
+type Reflected struct {
+	Seq             uint32
+	Transmit        time.Time // T3: reflector transmit time
+	Receive         time.Time // T2: reflector receive time
+	SenderSeq       uint32
+	SenderTimestamp time.Time // T1: copied from the sender packet
+	SenderTTL       uint8
+}
+
+// MarshalTestPacket encodes an unauthenticated Session-Sender test packet
+// with sequence number seq and transmit timestamp t.
+func MarshalTestPacket(seq uint32, t time.Time) []byte {
+	b := make([]byte, PacketLen)
+	binary.BigEndian.PutUint32(b[0:4], seq)
+	putTimestamp(b[4:12], t)
+	binary.BigEndian.PutUint16(b[12:14], errEstimate)
+	return b
+}
+
+// ParseReflected decodes an unauthenticated Session-Reflector test packet.
+func ParseReflected(b []byte) (*Reflected, error) {
+	if len(b) < PacketLen {
+		return nil, fmt.Errorf("STAMP packet too short: %d bytes", len(b))
+	}
+	return &Reflected{
+		Seq:             binary.BigEndian.Uint32(b[0:4]),
+		Transmit:        parseTimestamp(b[4:12], binary.BigEndian.Uint16(b[12:14])),
+		Receive:         parseTimestamp(b[16:24], binary.BigEndian.Uint16(b[12:14])),
+		SenderSeq:       binary.BigEndian.Uint32(b[24:28]),
+		SenderTimestamp: parseTimestamp(b[28:36], binary.BigEndian.Uint16(b[36:38])),
+		SenderTTL:       b[40],
+	}, nil
+}
+
+// RoundTrip returns the round-trip delay of a reply received at t4 with
+// the reflector residence time removed: (T4 - T1) - (T3 - T2). Only T2
+// and T3 come from the reflector clock, so the result does not depend on
+// the two clocks being synchronized.
+func (r *Reflected) RoundTrip(t4 time.Time) time.Duration {
+	return t4.Sub(r.SenderTimestamp) - r.Transmit.Sub(r.Receive)
+}
+
+// putTimestamp writes t in the PTPv2 truncated format: 32-bit seconds and
+// 32-bit nanoseconds since the Unix epoch.
+func putTimestamp(b []byte, t time.Time) {
+	binary.BigEndian.PutUint32(b[0:4], uint32(t.Unix()))
+	binary.BigEndian.PutUint32(b[4:8], uint32(t.Nanosecond()))
+}
+
+// ntpEpochOffset is the number of seconds from 1900-01-01 to 1970-01-01.
+const ntpEpochOffset = 2208988800
+
+// parseTimestamp decodes a timestamp in the format selected by the Z bit
+// of its Error Estimate: PTPv2 when set, NTP 64-bit otherwise.
+func parseTimestamp(b []byte, errEst uint16) time.Time {
+	sec := binary.BigEndian.Uint32(b[0:4])
+	frac := binary.BigEndian.Uint32(b[4:8])
+	if errEst&errEstimatePTP != 0 {
+		return time.Unix(int64(sec), int64(frac))
+	}
+	nsec := (uint64(frac) * uint64(time.Second)) >> 32
+	return time.Unix(int64(sec)-ntpEpochOffset, int64(nsec))
+}
@@ -0,0 +1,348 @@
+package stamp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/takehaya/vinbero/pkg/bpf"
+	"github.com/takehaya/vinbero/pkg/oam"
+	"go.uber.org/zap"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	defaultInterval = time.Second
+	defaultTimeout  = time.Second
+	senderHopLimit  = 255 // RFC 8762 Section 4.2: TTL/Hop Limit 255
+)
+
+// Config describes one Session-Sender.
+type Config struct {
+	Name     string
+	Segments []netip.Addr  // Traversal order; the last one is the Session-Reflector
+	Source   netip.Addr    // Optional; kernel picks one when invalid
+	Port     uint16        // Reflector UDP port (default 862)
+	Interval time.Duration // Time between probes (default 1s)
+	Timeout  time.Duration // A probe without reply after this long is lost (default 1s)
+	Window   int           // Probes covered by the rolling statistics (default 100)
+}
+
+// Session sends STAMP test packets along an SRv6 segment list and keeps
+// rolling delay, jitter and loss statistics from the reflected packets.
+// The SRH is attached with IPV6_RTHDR, so the kernel writes the first
+// segment into the DA.
+type Session struct {
+	cfg    Config
+	conn   *net.UDPConn
+	logger *zap.Logger
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	mu      sync.Mutex
+	seq     uint32
+	pending map[uint32]time.Time
+	stats   Stats
+	window  *window
+}
+
+func (c *Config) setDefaults() error {
+	if c.Name == "" {
+		return fmt.Errorf("session name is required")
+	}
+	if len(c.Segments) == 0 {
+		return fmt.Errorf("at least one segment is required")
+	}
+	if len(c.Segments) > bpf.MaxSegments {
+		return fmt.Errorf("too many segments: %d (max %d)", len(c.Segments), bpf.MaxSegments)
+	}
+	for _, s := range c.Segments {
+		if !s.Is6() || s.Is4In6() {
+			return fmt.Errorf("segment %s is not an IPv6 address", s)
+		}
+	}
+	if c.Port == 0 {
+		c.Port = DefaultPort
+	}
+	if c.Interval <= 0 {
+		c.Interval = defaultInterval
+	}
+	if c.Timeout <= 0 {
+		c.Timeout = defaultTimeout
+	}
+	if c.Window <= 0 {
+		c.Window = DefaultWindow
+	}
+	return nil
+}
+
+func newSession(cfg Config, logger *zap.Logger) (*Session, error) {
+	laddr := &net.UDPAddr{IP: net.IPv6unspecified}
+	if cfg.Source.IsValid() {
+		laddr.IP = net.IP(cfg.Source.AsSlice())
+	}
+	conn, err := net.ListenUDP("udp6", laddr)
+	if err != nil {
+		return nil, fmt.Errorf("open STAMP socket: %w", err)
+	}
+
+	var srh []byte
+	if len(cfg.Segments) > 1 {
+		srh = oam.BuildSRH(cfg.Segments, false)
+	}
+	if err := setSenderSockopts(conn, srh); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+
+	return &Session{
+		cfg:     cfg,
+		conn:    conn,
+		logger:  logger.With(zap.String("session", cfg.Name)),
+		pending: make(map[uint32]time.Time),
+		window:  newWindow(cfg.Window),
+	}, nil
+}
+
+func setSenderSockopts(conn *net.UDPConn, srh []byte) error {
+	rc, err := conn.SyscallConn()
+	if err != nil {
+		return err
+	}
+	var serr error
+	err = rc.Control(func(fd uintptr) {
+		if serr = unix.SetsockoptInt(int(fd), unix.IPPROTO_IPV6, unix.IPV6_UNICAST_HOPS, senderHopLimit); serr != nil {
+			serr = fmt.Errorf("set hop limit: %w", serr)
+			return
+		}
+		if len(srh) > 0 {
+			if serr = unix.SetsockoptString(int(fd), unix.IPPROTO_IPV6, unix.IPV6_RTHDR, string(srh)); serr != nil {
+				serr = fmt.Errorf("set SRH: %w", serr)
+			}
+		}
+	})
+	if err != nil {
+		return err
+	}
+	return serr
+}
+
+// Config returns the session configuration with defaults applied.
+func (s *Session) Config() Config {
+	return s.cfg
+}
+
+// Stats returns a snapshot of the session statistics.
+func (s *Session) Stats() Stats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	st := s.stats
+	s.window.fill(&st)
+	return st
+}
+
+func (s *Session) start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	s.wg.Add(2)
+	go func() {
+		defer s.wg.Done()
+		s.sendLoop(ctx)
+	}()
+	go func() {
+		defer s.wg.Done()
+		s.receiveLoop()
+	}()
+}
+
+func (s *Session) stop() {
+	s.cancel()
+	_ = s.conn.Close()
+	s.wg.Wait()
+}
+
+func (s *Session) sendLoop(ctx context.Context) {
+	target := &net.UDPAddr{
+		IP:   net.IP(s.cfg.Segments[len(s.cfg.Segments)-1].AsSlice()),
+		Port: int(s.cfg.Port),
+	}
+	ticker := time.NewTicker(s.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		s.send(target)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Session) send(target *net.UDPAddr) {
+	now := time.Now()
+
+	s.mu.Lock()
+	s.expire(now)
+	seq := s.seq
+	s.seq++
+	s.pending[seq] = now
+	s.stats.Sent++
+	s.mu.Unlock()
+
+	if _, err := s.conn.WriteToUDP(MarshalTestPacket(seq, now), target); err != nil {
+		// Left pending: an unsent probe counts as lost once it times out.
+		s.logger.Debug("stamp send failed", zap.Uint32("seq", seq), zap.Error(err))
+	}
+}
+
+// expire moves probes older than the timeout into the window as lost.
+// Callers hold s.mu.
+func (s *Session) expire(now time.Time) {
+	var expired []uint32
+	for seq, sent := range s.pending {
+		if now.Sub(sent) >= s.cfg.Timeout {
+			expired = append(expired, seq)
+		}
+	}
+	sort.Slice(expired, func(i, j int) bool { return expired[i] < expired[j] })
+	for _, seq := range expired {
+		delete(s.pending, seq)
+		s.stats.Lost++
+		s.window.add(sample{lost: true})
+	}
+}
+
+func (s *Session) receiveLoop() {
+	buf := make([]byte, 1500)
+	for {
+		n, _, err := s.conn.ReadFromUDP(buf)
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return
+			}
+			s.logger.Debug("stamp receive failed", zap.Error(err))
+			continue
+		}
+		t4 := time.Now()
+		r, err := ParseReflected(buf[:n])
+		if err != nil {
+			continue
+		}
+		s.record(r, t4)
+	}
+}
+
+// record matches a reflected packet to its pending probe. Replies that
+// arrive after the probe was declared lost are ignored.
+func (s *Session) record(r *Reflected, t4 time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.pending[r.SenderSeq]; !ok {
+		return
+	}
+	delete(s.pending, r.SenderSeq)
+
+	delay := r.RoundTrip(t4)
+	if delay < 0 {
+		delay = 0
+	}
+	s.stats.Received++
+	s.stats.LastDelay = delay
+	s.stats.LastReply = t4
+	s.window.add(sample{delay: delay})
+}
+
+// Manager owns the running Session-Senders. Stats is the hook for
+// consumers such as SR policy path selection.
+type Manager struct {
+	logger *zap.Logger
+
+	mu       sync.Mutex
+	sessions map[string]*Session
+}
+
+// NewManager creates an empty Manager.
+func NewManager(logger *zap.Logger) *Manager {
+	return &Manager{
+		logger:   logger,
+		sessions: make(map[string]*Session),
+	}
+}
+
+// Start validates cfg and starts a new session.
+func (m *Manager) Start(cfg Config) (*Session, error) {
+	if err := cfg.setDefaults(); err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.sessions[cfg.Name]; ok {
+		return nil, fmt.Errorf("session %q already exists", cfg.Name)
+	}
+	s, err := newSession(cfg, m.logger)
+	if err != nil {
+		return nil, err
+	}
+	s.start()
+	m.sessions[cfg.Name] = s
+	m.logger.Info("STAMP session started",
+		zap.String("session", cfg.Name),
+		zap.Int("segments", len(cfg.Segments)),
+		zap.Duration("interval", cfg.Interval))
+	return s, nil
+}
+
+// Stop stops and removes a session.
+func (m *Manager) Stop(name string) error {
+	m.mu.Lock()
+	s, ok := m.sessions[name]
+	delete(m.sessions, name)
+	m.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("session %q not found", name)
+	}
+	s.stop()
+	return nil
+}
+
+// Sessions returns the running sessions sorted by name.
+func (m *Manager) Sessions() []*Session {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	out := make([]*Session, 0, len(m.sessions))
+	for _, s := range m.sessions {
+		out = append(out, s)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].cfg.Name < out[j].cfg.Name })
+	return out
+}
+
+// Stats returns the statistics of a session by name.
+func (m *Manager) Stats(name string) (Stats, bool) {
+	m.mu.Lock()
+	s, ok := m.sessions[name]
+	m.mu.Unlock()
+	if !ok {
+		return Stats{}, false
+	}
+	return s.Stats(), true
+}
+
+// Close stops every session.
+func (m *Manager) Close() {
+	m.mu.Lock()
+	sessions := m.sessions
+	m.sessions = make(map[string]*Session)
+	m.mu.Unlock()
+	for _, s := range sessions {
+		s.stop()
+	}
+}
@@ -0,0 +1,92 @@
+package stamp
+
+import (
+	"encoding/binary"
+	"testing"
+	"time"
+)
+
+// reflect builds the Session-Reflector packet the data plane would send
+// for a test packet, with receive time t2 and transmit time t3.
+func reflect(test []byte, t2, t3 time.Time, ttl uint8) []byte {
+	b := make([]byte, PacketLen)
+	copy(b[0:4], test[0:4])
+	putTimestamp(b[4:12], t3)
+	binary.BigEndian.PutUint16(b[12:14], errEstimate)
+	putTimestamp(b[16:24], t2)
+	copy(b[24:38], test[0:14])
+	b[40] = ttl
+	return b
+}
+
+func TestPacketRoundTrip(t *testing.T) {
+	t1 := time.Unix(1700000000, 123456789)
+	t2 := t1.Add(3 * time.Millisecond)
+	t3 := t2.Add(200 * time.Microsecond)
+	t4 := t3.Add(5 * time.Millisecond)
+
+	test := MarshalTestPacket(42, t1)
+	if len(test) != PacketLen {
+		t.Fatalf("test packet length: got %d, want %d", len(test), PacketLen)
+	}
+
+	r, err := ParseReflected(reflect(test, t2, t3, 250))
+	if err != nil {
+		t.Fatalf("ParseReflected: %v", err)
+	}
+	if r.SenderSeq != 42 || r.Seq != 42 {
+		t.Errorf("seq: got %d/%d, want 42/42", r.Seq, r.SenderSeq)
+	}
+	if !r.SenderTimestamp.Equal(t1) || !r.Receive.Equal(t2) || !r.Transmit.Equal(t3) {
+		t.Errorf("timestamps: got T1=%v T2=%v T3=%v", r.SenderTimestamp, r.Receive, r.Transmit)
+	}
+	if r.SenderTTL != 250 {
+		t.Errorf("sender TTL: got %d, want 250", r.SenderTTL)
+	}
+	if got, want := r.RoundTrip(t4), 8*time.Millisecond; got != want {
+		t.Errorf("round trip: got %v, want %v", got, want)
+	}
+
+	if _, err := ParseReflected(test[:PacketLen-1]); err == nil {
+		t.Errorf("expected error for short packet")
+	}
+}
+
+func TestParseTimestampNTP(t *testing.T) {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint32(b[0:4], 1700000000+ntpEpochOffset)
+	binary.BigEndian.PutUint32(b[4:8], 1<<31) // 0.5s
+	got := parseTimestamp(b, 0)
+	if want := time.Unix(1700000000, 500000000); !got.Equal(want) {
+		t.Errorf("NTP timestamp: got %v, want %v", got, want)
+	}
+}
+
+func TestWindowStats(t *testing.T) {
+	w := newWindow(4)
+	for _, s := range []sample{
+		{delay: 10 * time.Millisecond}, // pushed out of the window
+		{delay: 2 * time.Millisecond},
+		{lost: true},
+		{delay: 6 * time.Millisecond},
+		{delay: 4 * time.Millisecond},
+	} {
+		w.add(s)
+	}
+
+	var st Stats
+	w.fill(&st)
+	if st.Window != 4 || st.WindowLost != 1 {
+		t.Errorf("window: got %d/%d lost, want 4/1", st.Window, st.WindowLost)
+	}
+	if st.LossRatio() != 0.25 {
+		t.Errorf("loss ratio: got %v, want 0.25", st.LossRatio())
+	}
+	if st.MinDelay != 2*time.Millisecond || st.MaxDelay != 6*time.Millisecond || st.AvgDelay != 4*time.Millisecond {
+		t.Errorf("delay: got min %v avg %v max %v, want 2ms/4ms/6ms", st.MinDelay, st.AvgDelay, st.MaxDelay)
+	}
+	// |6-2| and |4-6| between consecutive replies
+	if st.Jitter != 3*time.Millisecond {
+		t.Errorf("jitter: got %v, want 3ms", st.Jitter)
+	}
+}
@@ -0,0 +1,110 @@
+package stamp
+
+import "time"
+
+// DefaultWindow is how many recent probes the rolling statistics cover.
+const DefaultWindow = 100
+
+// Stats summarizes a session. Lifetime counters cover every probe since
+// the session started; the delay, jitter and loss figures cover only the
+// last Window probes whose outcome is known.
+type Stats struct {
+	Sent     uint64
+	Received uint64
+	Lost     uint64
+
+	Window     int           // Probes in the rolling window
+	WindowLost int           // Lost probes in the rolling window
+	LastDelay  time.Duration // Most recent round-trip delay
+	MinDelay   time.Duration
+	MaxDelay   time.Duration
+	AvgDelay   time.Duration
+	Jitter     time.Duration // Mean absolute delay variation between consecutive replies
+	LastReply  time.Time
+}
+
+// LossRatio returns the fraction of probes lost in the rolling window.
+func (s Stats) LossRatio() float64 {
+	if s.Window == 0 {
+		return 0
+	}
+	return float64(s.WindowLost) / float64(s.Window)
+}
+
+// sample is the outcome of one probe; lost samples carry no delay.
+type sample struct {
+	delay time.Duration
+	lost  bool
+}
+
+// window keeps the outcomes of the most recent probes in a ring.
+type window struct {
+	samples []sample
+	next    int
+	full    bool
+}
+
+func newWindow(size int) *window {
+	if size <= 0 {
+		size = DefaultWindow
+	}
+	return &window{samples: make([]sample, size)}
+}
+
+func (w *window) add(s sample) {
+	w.samples[w.next] = s
+	w.next = (w.next + 1) % len(w.samples)
+	if w.next == 0 {
+		w.full = true
+	}
+}
+
+// ordered returns the samples oldest first.
+func (w *window) ordered() []sample {
+	if !w.full {
+		return w.samples[:w.next]
+	}
+	out := make([]sample, 0, len(w.samples))
+	out = append(out, w.samples[w.next:]...)
+	return append(out, w.samples[:w.next]...)
+}
+
+// fill computes the rolling figures of st from the window contents.
+func (w *window) fill(st *Stats) {
+	samples := w.ordered()
+	st.Window = len(samples)
+
+	var sum, jitterSum time.Duration
+	var received, pairs int
+	var prev time.Duration
+	havePrev := false
+	for _, s := range samples {
+		if s.lost {
+			st.WindowLost++
+			continue
+		}
+		if received == 0 || s.delay < st.MinDelay {
+			st.MinDelay = s.delay
+		}
+		if s.delay > st.MaxDelay {
+			st.MaxDelay = s.delay
+		}
+		sum += s.delay
+		received++
+		if havePrev {
+			d := s.delay - prev
+			if d < 0 {
+				d = -d
+			}
+			jitterSum += d
+			pairs++
+		}
+		prev, havePrev = s.delay, true
+	}
+	if received > 0 {
+		st.AvgDelay = sum / time.Duration(received)
+	}
+	if pairs > 0 {
+		st.Jitter = jitterSum / time.Duration(pairs)
+	}
+}
@@ -0,0 +1,205 @@
+package store
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/netip"
+	"strconv"
+	"strings"
+)
+
+// keyFunc returns the keys of the entries a request names, one slice per
+// entry: the key of the entry followed by the aliases a Delete may use
+// for it instead, such as the BD of a BD peer. ok is false when the
+// request can't be read.
+type keyFunc func(req map[string]json.RawMessage) (entries [][]string, ok bool)
+
+// entryKey builds one key of an entry from its protojson fields.
+type entryKey func(e map[string]json.RawMessage) string
+
+// requestKeys lists, per method, where its requests carry the keys of
+// their entries. Methods of the same family build their keys the same
+// way, so compact can tell when a later request hits an earlier one's
+// entries. HeadendL2 and plugins are left out: MAC limits, storm
+// controls and SIDs look them up when applied.
+var requestKeys = map[string]keyFunc{
+	"SidFunctionCreate":  each("sidFunctions", key("triggerPrefix")),
+	"SidFunctionUpdate":  each("sidFunctions", key("triggerPrefix")),
+	"SidFunctionDelete":  values("triggerPrefixes", ""),
+	"Headendv4Create":    each("headendv4s", key("triggerPrefix")),
+	"Headendv4Update":    each("headendv4s", key("triggerPrefix")),
+	"Headendv4Delete":    values("triggerPrefixes", ""),
+	"Headendv6Create":    each("headendv6s", key("triggerPrefix")),
+	"Headendv6Update":    each("headendv6s", key("triggerPrefix")),
+	"Headendv6Delete":    values("triggerPrefixes", ""),
+	"FdbCreate":          one(key("bdId", "mac")),
+	"FdbDelete":          one(key("bdId", "mac")),
+	"VlanTableCreate":    each("entries", key("tableId", "vlanId")),
+	"VlanTableUpdate":    each("entries", key("tableId", "vlanId")),
+	"VlanTableDelete":    each("entries", key("tableId", "vlanId")),
+	"SrDomainCreate":     each("policies", key("locator")),
+	"SrDomainDelete":     values("locators", ""),
+	"BdPeerCreate":       each("peers", key("bdId", "srcAddr"), named("bd", "bdId")),
+	"BdPeerUpdate":       each("peers", key("bdId", "srcAddr"), named("bd", "bdId")),
+	"BdPeerDelete":       values("bdIds", "bd"),
+	"VniMappingCreate":   each("mappings", named("vni", "vni"), named("bd", "bdId")),
+	"VniMappingDelete":   merge(values("vnis", "vni"), values("bdIds", "bd")),
+	"MacLimitSet":        each("limits", scopeKey),
+	"MacLimitDelete":     each("limits", scopeKey),
+	"StormControlSet":    each("stormControls", scopeKey),
+	"StormControlDelete": each("stormControls", scopeKey),
+	"NeighborCreate":     each("neighbors", key("bdId", "ip")),
+	"NeighborDelete":     each("neighbors", key("bdId", "ip")),
+	"IrbRouteCreate":     each("routes", key("vrfName", "prefix")),
+	"IrbRouteDelete":     each("routes", key("vrfName", "prefix")),
+	"EsCreate":           each("entries", key("esi")),
+	"EsUpdate":           each("entries", key("esi")),
+	"EsSetDf":            one(key("esi")),
+	"EsClearDf":          one(key("esi")),
+	"EsDelete":           values("esis", ""),
+	"MapResize":          one(key("name")),
+}
+
+// requestKeysOf returns the keys of the entries rec names.
+func requestKeysOf(rec Record) ([][]string, bool) {
+	method := rec.Procedure[strings.LastIndex(rec.Procedure, "/")+1:]
+	fn, ok := requestKeys[method]
+	if !ok {
+		return nil, false
+	}
+	var req map[string]json.RawMessage
+	if err := json.Unmarshal(rec.Request, &req); err != nil {
+		return nil, false
+	}
+	return fn(req)
+}
+
+// each reads the entries from the list field of the request.
+func each(list string, keys ...entryKey) keyFunc {
+	return func(req map[string]json.RawMessage) ([][]string, bool) {
+		var entries []map[string]json.RawMessage
+		if raw, ok := req[list]; ok {
+			if err := json.Unmarshal(raw, &entries); err != nil {
+				return nil, false
+			}
+		}
+		out := make([][]string, 0, len(entries))
+		for _, e := range entries {
+			out = append(out, entryKeys(e, keys))
+		}
+		return out, true
+	}
+}
+
+// one reads the request as a single entry.
+func one(keys ...entryKey) keyFunc {
+	return func(req map[string]json.RawMessage) ([][]string, bool) {
+		return [][]string{entryKeys(req, keys)}, true
+	}
+}
+
+// values reads the list field of the request as bare keys of kind.
+func values(list, kind string) keyFunc {
+	return func(req map[string]json.RawMessage) ([][]string, bool) {
+		var vs []json.RawMessage
+		if raw, ok := req[list]; ok {
+			if err := json.Unmarshal(raw, &vs); err != nil {
+				return nil, false
+			}
+		}
+		out := make([][]string, 0, len(vs))
+		for _, v := range vs {
+			out = append(out, []string{kind + ":" + normValue(v)})
+		}
+		return out, true
+	}
+}
+
+// merge concatenates the entries of fns.
+func merge(fns ...keyFunc) keyFunc {
+	return func(req map[string]json.RawMessage) ([][]string, bool) {
+		var out [][]string
+		for _, fn := range fns {
+			entries, ok := fn(req)
+			if !ok {
+				return nil, false
+			}
+			out = append(out, entries...)
+		}
+		return out, true
+	}
+}
+
+func entryKeys(e map[string]json.RawMessage, keys []entryKey) []string {
+	out := make([]string, len(keys))
+	for i, k := range keys {
+		out[i] = k(e)
+	}
+	return out
+}
+
+func key(fields ...string) entryKey { return named("", fields...) }
+
+// named joins fields under kind, keeping keys of different kinds apart.
+func named(kind string, fields ...string) entryKey {
+	return func(e map[string]json.RawMessage) string {
+		vs := make([]string, len(fields))
+		for i, f := range fields {
+			vs[i] = normValue(e[f])
+		}
+		return kind + ":" + strings.Join(vs, "/")
+	}
+}
+
+// scopeKey is the scope of a MAC limit or storm control: an AC, whose BD
+// comes from its HeadendL2, or else a BD.
+func scopeKey(e map[string]json.RawMessage) string {
+	if normValue(e["interfaceName"]) != "" {
+		return named("ac", "interfaceName", "vlanId", "portMode")(e)
+	}
+	return named("bd", "bdId")(e)
+}
+
+// normValue renders a protojson value so that two spellings of one
+// prefix, address, MAC or ESI compare equal. protojson leaves zero values
+// out, so a missing field is "".
+func normValue(raw json.RawMessage) string {
+	if raw == nil {
+		return ""
+	}
+	var s string
+	if json.Unmarshal(raw, &s) != nil {
+		return string(raw)
+	}
+	if p, err := netip.ParsePrefix(s); err == nil {
+		return p.Masked().String()
+	}
+	if a, err := netip.ParseAddr(s); err == nil {
+		return a.String()
+	}
+	if hw, err := net.ParseMAC(s); err == nil {
+		return hw.String()
+	}
+	if esi, ok := normColonHex(s); ok {
+		return esi
+	}
+	return s
+}
+
+// normColonHex renders colon-separated hex bytes, such as an ESI, as
+// two lower-case digits per byte.
+func normColonHex(s string) (string, bool) {
+	parts := strings.Split(s, ":")
+	if len(parts) < 2 {
+		return "", false
+	}
+	for i, p := range parts {
+		b, err := strconv.ParseUint(p, 16, 8)
+		if err != nil {
+			return "", false
+		}
+		parts[i] = fmt.Sprintf("%02x", b)
+	}
+	return strings.Join(parts, ":"), true
+}
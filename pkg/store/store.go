@@ -0,0 +1,414 @@
+// Package store journals the mutating RPCs vinberod accepted, so the
+// control state can be replayed into fresh BPF maps at startup without
+// relying on bpffs pins.
+//
+// The journal is a file of JSON lines, one record per request. A record
+// is written with a single append followed by fsync, so a crash can only
+// leave a partial last line; Open drops it and truncates the file.
+package store
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Record is one accepted request.
+type Record struct {
+	Seq       uint64          `json:"seq"`
+	Time      time.Time       `json:"time"`
+	Procedure string          `json:"procedure"` // e.g. "/vinbero.v1.SidFunctionService/SidFunctionCreate"
+	Request   json.RawMessage `json:"request"`   // protojson of the request message
+}
+
+// Store is an append-only journal of Records.
+type Store struct {
+	mu      sync.Mutex
+	path    string
+	f       *os.File
+	seq     uint64
+	records []Record
+	logger  *zap.Logger
+}
+
+// mutatingVerbs are the method name suffixes of RPCs that change state.
+var mutatingVerbs = []string{"Unregister", "Register", "Import", "Commit", "ClearDf", "SetDf", "Create", "Update", "Delete", "Flush", "Set", "Resize"}
+
+// Mutating reports whether procedure changes control state, judged by
+// the verb its method name ends with. Reads (List, Get, Show) and
+// one-shot actions (StatsReset, OamPing) are not journaled.
+func Mutating(procedure string) bool {
+	_, _, ok := splitProcedure(procedure)
+	return ok
+}
+
+// splitProcedure splits "/pkg.Service/FooCreate" into the service, the
+// resource family ("Foo") and whether the method is mutating.
+func splitProcedure(procedure string) (service, family string, ok bool) {
+	service, method, found := strings.Cut(strings.TrimPrefix(procedure, "/"), "/")
+	if !found {
+		return "", "", false
+	}
+	for _, verb := range mutatingVerbs {
+		if family, found := strings.CutSuffix(method, verb); found {
+			return service, family, true
+		}
+	}
+	return service, method, false
+}
+
+// Open loads the journal at path, creating it if missing, and compacts
+// it. A partial last record left by a crash is dropped; a bad record
+// followed by good ones means the file was damaged and is an error.
+func Open(path string, logger *zap.Logger) (*Store, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, err
+	}
+	records, goodLen, err := readJournal(path)
+	if err != nil {
+		return nil, fmt.Errorf("store %s: %w", path, err)
+	}
+	if fi, err := os.Stat(path); err == nil && fi.Size() > goodLen {
+		logger.Warn("Dropping partial last record of the store",
+			zap.String("path", path), zap.Int64("bytes", fi.Size()-goodLen))
+		if err := os.Truncate(path, goodLen); err != nil {
+			return nil, fmt.Errorf("store %s: %w", path, err)
+		}
+	}
+
+	s := &Store{path: path, records: records, logger: logger}
+	if len(records) > 0 {
+		s.seq = records[len(records)-1].Seq
+	}
+	if compacted := compact(records); len(compacted) < len(records) {
+		if err := writeJournal(path, compacted); err != nil {
+			return nil, fmt.Errorf("store %s: compact: %w", path, err)
+		}
+		logger.Info("Compacted store",
+			zap.String("path", path), zap.Int("before", len(records)), zap.Int("after", len(compacted)))
+		s.records = compacted
+	}
+
+	s.f, err = os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// readJournal returns the records of path and the length of the prefix
+// they were decoded from.
+func readJournal(path string) ([]Record, int64, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, 0, nil
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var records []Record
+	var offset int64
+	r := bufio.NewReader(bytes.NewReader(data))
+	for line := 1; ; line++ {
+		raw, err := r.ReadBytes('\n')
+		if err == io.EOF {
+			// No newline: the last append didn't finish
+			return records, offset, nil
+		}
+		var rec Record
+		if jerr := json.Unmarshal(raw, &rec); jerr != nil {
+			if offset+int64(len(raw)) == int64(len(data)) {
+				return records, offset, nil
+			}
+			return nil, 0, fmt.Errorf("record at line %d: %w", line, jerr)
+		}
+		records = append(records, rec)
+		offset += int64(len(raw))
+	}
+}
+
+// writeJournal replaces path with records: a temporary file is written
+// and synced, then renamed over path.
+func writeJournal(path string, records []Record) error {
+	tmp := path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(f)
+	for _, rec := range records {
+		line, err := json.Marshal(rec)
+		if err != nil {
+			_ = f.Close()
+			return err
+		}
+		_, _ = w.Write(append(line, '\n'))
+	}
+	if err := w.Flush(); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return err
+	}
+	return syncDir(filepath.Dir(path))
+}
+
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = d.Close() }()
+	return d.Sync()
+}
+
+// family is the resource a procedure works on, e.g.
+// {"vinbero.v1.SidFunctionService", "SidFunction"}.
+type family struct{ service, name string }
+
+// compound families apply entries of several resources in one request,
+// so they may depend on records of any family.
+var compound = map[family]bool{
+	{"vinbero.v1.TransactionService", "Transaction"}:   true,
+	{"vinbero.v1.SystemService", ""}:                   true, // Import
+	{"vinbero.v1.BridgeDomainService", "BridgeDomain"}: true,
+}
+
+// readers lists, per flushable family, the other families whose
+// requests look its entries up when they are applied.
+var readers = map[family][]family{
+	{"vinbero.v1.Headendv4Service", "Headendv4"}: {{"vinbero.v1.PerformanceService", "StampSession"}},
+	{"vinbero.v1.Headendv6Service", "Headendv6"}: {{"vinbero.v1.PerformanceService", "StampSession"}},
+}
+
+// compact drops the records a later request makes irrelevant:
+//   - every record of a resource before an unscoped Flush of it, e.g.
+//     every SidFunctionCreate before a SidFunctionFlush with an empty
+//     request. Scoped flushes (a bd_id or keep_static) drop nothing;
+//   - a Create, Update or Set whose every entry a later Delete removes,
+//     or a later full Update or Set of the same key replaces, e.g. the
+//     SidFunctionUpdates of a prefix before its SidFunctionDelete;
+//   - then a Delete whose keys no record left before it may have
+//     written, which was a no-op then and is one on replay.
+//
+// Records of other resources are kept, so replay still ends in the same
+// state. A record that may read the dropped entries (a transaction, an
+// import, a bridge domain, a STAMP session naming a headend) must still
+// find what it found when it was accepted, so records before it are kept
+// too.
+func compact(records []Record) []Record {
+	flushed := make(map[family]bool)             // flushed later, with no reader in between
+	deleted := make(map[family]map[string]bool)  // keys deleted later
+	replaced := make(map[family]map[string]bool) // keys replaced later, by write class
+	kept := make([]Record, 0, len(records))
+	for i := len(records) - 1; i >= 0; i-- {
+		rec := records[i]
+		service, name, _ := splitProcedure(rec.Procedure)
+		fam := family{service, name}
+		verb := verbOf(rec.Procedure, name)
+		entries, keyed := requestKeysOf(rec)
+		if flushed[fam] || keyed && superseded(verb, entries, deleted[fam], replaced[fam]) {
+			continue
+		}
+		kept = append(kept, rec)
+
+		switch {
+		case verb == "Flush" && isEmptyRequest(rec.Request):
+			flushed[fam] = true
+		case keyed && verb == "Delete":
+			for _, keys := range entries {
+				mark(deleted, fam, keys...)
+			}
+		case keyed && replaces(verb, rec.Request):
+			for _, keys := range entries {
+				mark(replaced, fam, writeClass(verb)+keys[0])
+			}
+		}
+		if compound[fam] {
+			clear(flushed)
+			clear(deleted)
+			clear(replaced)
+		}
+		for f, rs := range readers {
+			if slices.Contains(rs, fam) {
+				delete(flushed, f)
+				delete(deleted, f)
+				delete(replaced, f)
+			}
+		}
+	}
+	slices.Reverse(kept)
+	kept = dropNoopDeletes(kept)
+	if len(kept) == len(records) {
+		return records
+	}
+	return kept
+}
+
+// dropNoopDeletes drops the Deletes whose keys no earlier record may have
+// written: none of the kept Creates, Updates and Sets since the last
+// unscoped Flush names them, and no compound record came before.
+func dropNoopDeletes(records []Record) []Record {
+	written := make(map[family]map[string]bool)
+	unknown := make(map[family]bool) // written with keys that couldn't be read
+	anything := false                // after a compound record
+	kept := records[:0:0]
+	for _, rec := range records {
+		service, name, _ := splitProcedure(rec.Procedure)
+		fam := family{service, name}
+		verb := verbOf(rec.Procedure, name)
+		entries, keyed := requestKeysOf(rec)
+		switch {
+		case compound[fam]:
+			anything = true
+		case verb == "Delete" && keyed && !anything && !unknown[fam] && !anyMarked(entries, written[fam]):
+			continue
+		case verb == "Flush" && isEmptyRequest(rec.Request):
+			delete(written, fam)
+			delete(unknown, fam)
+		case writeClass(verb) != "" || verb == "Create":
+			if !keyed {
+				unknown[fam] = true
+			}
+			for _, keys := range entries {
+				mark(written, fam, keys...)
+			}
+		}
+		kept = append(kept, rec)
+	}
+	return kept
+}
+
+// verbOf returns the verb the method of procedure ends with, after the
+// resource family name.
+func verbOf(procedure, name string) string {
+	method := procedure[strings.LastIndex(procedure, "/")+1:]
+	return strings.TrimPrefix(method, name)
+}
+
+// writeClass groups the verbs that replace what an earlier write of the
+// same class set on a key. Creates have none: they fail on an existing
+// key.
+func writeClass(verb string) string {
+	switch verb {
+	case "Update", "Set", "Resize":
+		return "replace:"
+	case "SetDf", "ClearDf":
+		return "df:"
+	}
+	return ""
+}
+
+// replaces reports whether a request of verb sets every field its class
+// covers: an Update does only with an empty update_mask.
+func replaces(verb string, raw json.RawMessage) bool {
+	if verb != "Update" {
+		return writeClass(verb) != ""
+	}
+	var req struct {
+		UpdateMask string `json:"updateMask"`
+	}
+	return json.Unmarshal(raw, &req) == nil && req.UpdateMask == ""
+}
+
+// superseded reports whether every entry of a write of verb is deleted
+// or replaced by a later request.
+func superseded(verb string, entries [][]string, deleted, replaced map[string]bool) bool {
+	class := writeClass(verb)
+	if class == "" && verb != "Create" || len(entries) == 0 {
+		return false
+	}
+	for _, keys := range entries {
+		if !anyMarked([][]string{keys}, deleted) && (class == "" || !replaced[class+keys[0]]) {
+			return false
+		}
+	}
+	return true
+}
+
+func mark(marks map[family]map[string]bool, fam family, keys ...string) {
+	if marks[fam] == nil {
+		marks[fam] = make(map[string]bool)
+	}
+	for _, k := range keys {
+		marks[fam][k] = true
+	}
+}
+
+func anyMarked(entries [][]string, marks map[string]bool) bool {
+	for _, keys := range entries {
+		for _, k := range keys {
+			if marks[k] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func isEmptyRequest(raw json.RawMessage) bool {
+	var fields map[string]json.RawMessage
+	return json.Unmarshal(raw, &fields) == nil && len(fields) == 0
+}
+
+// Records returns the journaled records in the order they were accepted.
+func (s *Store) Records() []Record {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Record(nil), s.records...)
+}
+
+// Append journals an accepted request and syncs it to disk.
+func (s *Store) Append(procedure string, req proto.Message) error {
+	body, err := protojson.Marshal(req)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rec := Record{Seq: s.seq + 1, Time: time.Now().UTC(), Procedure: procedure, Request: body}
+	line, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+	if _, err := s.f.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("store %s: %w", s.path, err)
+	}
+	if err := s.f.Sync(); err != nil {
+		return fmt.Errorf("store %s: %w", s.path, err)
+	}
+	s.seq = rec.Seq
+	s.records = append(s.records, rec)
+	return nil
+}
+
+// Close closes the journal file.
+func (s *Store) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.f.Close()
+}
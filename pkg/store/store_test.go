@@ -0,0 +1,238 @@
+package store
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	v1 "github.com/takehaya/vinbero/api/vinbero/v1"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+const (
+	sidCreate = "/vinbero.v1.SidFunctionService/SidFunctionCreate"
+	sidFlush  = "/vinbero.v1.SidFunctionService/SidFunctionFlush"
+	fdbCreate = "/vinbero.v1.FdbService/FdbCreate"
+	fdbFlush  = "/vinbero.v1.FdbService/FdbFlush"
+)
+
+func openStore(t *testing.T, path string) *Store {
+	t.Helper()
+	s, err := Open(path, zap.NewNop())
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	t.Cleanup(func() { _ = s.Close() })
+	return s
+}
+
+func appendSid(t *testing.T, s *Store, prefix string) {
+	t.Helper()
+	req := &v1.SidFunctionCreateRequest{SidFunctions: []*v1.SidFunction{{TriggerPrefix: prefix}}}
+	if err := s.Append(sidCreate, req); err != nil {
+		t.Fatalf("Append: %v", err)
+	}
+}
+
+func TestStoreReopen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "store.jsonl")
+	s := openStore(t, path)
+	appendSid(t, s, "fc00:1::1/128")
+	appendSid(t, s, "fc00:1::2/128")
+	_ = s.Close()
+
+	s = openStore(t, path)
+	recs := s.Records()
+	if len(recs) != 2 || recs[0].Seq != 1 || recs[1].Seq != 2 || recs[1].Procedure != sidCreate {
+		t.Fatalf("records after reopen: %+v", recs)
+	}
+	if !strings.Contains(string(recs[1].Request), "fc00:1::2/128") {
+		t.Errorf("request not kept: %s", recs[1].Request)
+	}
+	appendSid(t, s, "fc00:1::3/128")
+	if recs := s.Records(); recs[len(recs)-1].Seq != 3 {
+		t.Errorf("seq does not continue: got %d, want 3", recs[len(recs)-1].Seq)
+	}
+}
+
+func TestStorePartialLastRecord(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "store.jsonl")
+	s := openStore(t, path)
+	appendSid(t, s, "fc00:1::1/128")
+	_ = s.Close()
+
+	// A crash in the middle of the second append
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _ = f.WriteString(`{"seq":2,"procedure":"/vinbero.v1.SidFun`)
+	_ = f.Close()
+
+	s = openStore(t, path)
+	if recs := s.Records(); len(recs) != 1 {
+		t.Fatalf("got %d records, want 1", len(recs))
+	}
+	appendSid(t, s, "fc00:1::2/128")
+	_ = s.Close()
+
+	s = openStore(t, path)
+	if recs := s.Records(); len(recs) != 2 || recs[1].Seq != 2 {
+		t.Errorf("append after truncation: %+v", recs)
+	}
+}
+
+func TestStoreCorruptRecord(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "store.jsonl")
+	s := openStore(t, path)
+	appendSid(t, s, "fc00:1::1/128")
+	_ = s.Close()
+
+	data, _ := os.ReadFile(path)
+	if err := os.WriteFile(path, append([]byte("garbage\n"), data...), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Open(path, zap.NewNop()); err == nil || !strings.Contains(err.Error(), "line 1") {
+		t.Errorf("got %v, want an error for line 1", err)
+	}
+}
+
+func TestStoreCompact(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "store.jsonl")
+	s := openStore(t, path)
+	appendSid(t, s, "fc00:1::1/128")
+	_ = s.Append(fdbCreate, &v1.FdbCreateRequest{})
+	_ = s.Append(fdbFlush, &v1.FdbFlushRequest{KeepStatic: true}) // scoped: keeps the FdbCreate
+	_ = s.Append(sidFlush, &v1.SidFunctionFlushRequest{})
+	appendSid(t, s, "fc00:1::2/128")
+	_ = s.Close()
+
+	s = openStore(t, path)
+	var got []string
+	for _, rec := range s.Records() {
+		got = append(got, rec.Procedure)
+	}
+	want := []string{fdbCreate, fdbFlush, sidFlush, sidCreate}
+	if strings.Join(got, " ") != strings.Join(want, " ") {
+		t.Errorf("after compaction: got %v, want %v", got, want)
+	}
+}
+
+// TestStoreCompactReaders checks that compaction stops at a record that
+// may have read the flushed resource: the transaction updating SID 1
+// must still find it on replay, while SID 2, created after it, goes.
+func TestStoreCompactReaders(t *testing.T) {
+	const (
+		txCommit     = "/vinbero.v1.TransactionService/TransactionCommit"
+		v6Create     = "/vinbero.v1.Headendv6Service/Headendv6Create"
+		v6Flush      = "/vinbero.v1.Headendv6Service/Headendv6Flush"
+		stampSession = "/vinbero.v1.PerformanceService/StampSessionCreate"
+	)
+	path := filepath.Join(t.TempDir(), "store.jsonl")
+	s := openStore(t, path)
+	appendSid(t, s, "fc00:1::1/128")
+	_ = s.Append(v6Create, &v1.Headendv6CreateRequest{})
+	_ = s.Append(txCommit, &v1.TransactionCommitRequest{Operations: []*v1.TransactionOperation{{
+		Op:       v1.TransactionOp_TRANSACTION_OP_UPDATE,
+		Resource: &v1.TransactionOperation_SidFunction{SidFunction: &v1.SidFunction{TriggerPrefix: "fc00:1::1/128"}},
+	}}})
+	appendSid(t, s, "fc00:1::2/128")
+	_ = s.Append(sidFlush, &v1.SidFunctionFlushRequest{})
+	_ = s.Append(stampSession, &v1.StampSessionCreateRequest{})
+	_ = s.Append(v6Create, &v1.Headendv6CreateRequest{})
+	_ = s.Append(v6Flush, &v1.Headendv6FlushRequest{})
+	_ = s.Close()
+
+	s = openStore(t, path)
+	var got []string
+	for _, rec := range s.Records() {
+		got = append(got, rec.Procedure)
+	}
+	want := []string{sidCreate, v6Create, txCommit, sidFlush, stampSession, v6Flush}
+	if strings.Join(got, " ") != strings.Join(want, " ") {
+		t.Errorf("after compaction: got %v, want %v", got, want)
+	}
+}
+
+// TestStoreCompactPerKey checks that writes a later Delete or full
+// replace hits are dropped, along with Deletes that then remove nothing,
+// while a transaction still stops compaction.
+func TestStoreCompactPerKey(t *testing.T) {
+	const (
+		sidUpdate    = "/vinbero.v1.SidFunctionService/SidFunctionUpdate"
+		sidDelete    = "/vinbero.v1.SidFunctionService/SidFunctionDelete"
+		macLimitSet  = "/vinbero.v1.BridgeDomainService/MacLimitSet"
+		bdPeerCreate = "/vinbero.v1.BdPeerService/BdPeerCreate"
+		bdPeerDelete = "/vinbero.v1.BdPeerService/BdPeerDelete"
+		mapResize    = "/vinbero.v1.SystemService/MapResize"
+		txCommit     = "/vinbero.v1.TransactionService/TransactionCommit"
+	)
+	sids := func(prefix string) []*v1.SidFunction { return []*v1.SidFunction{{TriggerPrefix: prefix}} }
+	limit := func(max uint32) *v1.MacLimitSetRequest {
+		return &v1.MacLimitSetRequest{Limits: []*v1.MacLimit{{BdId: 100, MaxMacs: max}}}
+	}
+
+	path := filepath.Join(t.TempDir(), "store.jsonl")
+	s := openStore(t, path)
+	appendSid(t, s, "fc00:1::1/128")
+	_ = s.Append(sidUpdate, &v1.SidFunctionUpdateRequest{
+		SidFunctions: sids("fc00:1::1/128"), UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"vrf_name"}},
+	})
+	_ = s.Append(sidUpdate, &v1.SidFunctionUpdateRequest{SidFunctions: sids("fc00:1::1/128")})
+	appendSid(t, s, "fc00:1::2/128")
+	_ = s.Append(macLimitSet, limit(10))
+	_ = s.Append(bdPeerCreate, &v1.BdPeerCreateRequest{Peers: []*v1.BdPeer{{BdId: 100, SrcAddr: "fc00::1"}}})
+	_ = s.Append(mapResize, &v1.MapResizeRequest{Name: "fdb_map", Capacity: 1 << 17})
+	_ = s.Append(macLimitSet, limit(20))
+	_ = s.Append(sidDelete, &v1.SidFunctionDeleteRequest{TriggerPrefixes: []string{"fc00:1:0::1/128", "fc00:1::9/128"}})
+	_ = s.Append(bdPeerDelete, &v1.BdPeerDeleteRequest{BdIds: []uint32{100}})
+	_ = s.Append(mapResize, &v1.MapResizeRequest{Name: "fdb_map", Capacity: 1 << 18})
+	appendSid(t, s, "fc00:1::3/128")
+	_ = s.Append(txCommit, &v1.TransactionCommitRequest{})
+	_ = s.Append(sidDelete, &v1.SidFunctionDeleteRequest{TriggerPrefixes: []string{"fc00:1::3/128"}})
+	_ = s.Append(sidDelete, &v1.SidFunctionDeleteRequest{TriggerPrefixes: []string{"fc00:1::9/128"}})
+	_ = s.Close()
+
+	s = openStore(t, path)
+	var got []string
+	for _, rec := range s.Records() {
+		got = append(got, rec.Procedure)
+	}
+	want := []string{sidCreate, macLimitSet, mapResize, sidCreate, txCommit, sidDelete, sidDelete}
+	if strings.Join(got, " ") != strings.Join(want, " ") {
+		t.Errorf("after compaction: got %v, want %v", got, want)
+	}
+	if rec := s.Records()[1]; !strings.Contains(string(rec.Request), "20") {
+		t.Errorf("kept MAC limit %s, want the last one", rec.Request)
+	}
+	if rec := s.Records()[2]; !strings.Contains(string(rec.Request), "262144") {
+		t.Errorf("kept resize %s, want the last one", rec.Request)
+	}
+}
+
+func TestMutating(t *testing.T) {
+	tests := map[string]bool{
+		sidCreate: true,
+		sidFlush:  true,
+		"/vinbero.v1.EthernetSegmentService/EsSetDf":       true,
+		"/vinbero.v1.PluginService/PluginUnregister":       true,
+		"/vinbero.v1.BridgeDomainService/MacLimitSet":      true,
+		"/vinbero.v1.SystemService/Import":                 true,
+		"/vinbero.v1.SystemService/Export":                 false,
+		"/vinbero.v1.SystemService/MapResize":              true,
+		"/vinbero.v1.TransactionService/TransactionCommit": true,
+		"/vinbero.v1.SidFunctionService/SidFunctionUpdate": true,
+		"/vinbero.v1.VlanTableService/VlanTableUpdate":     true,
+		"/vinbero.v1.SidFunctionService/SidFunctionList":   false,
+		"/vinbero.v1.StatsService/StatsReset":              false,
+		"/vinbero.v1.OamService/OamPing":                   false,
+		"/vinbero.v1.BridgeDomainService/BridgeDomainGet":  false,
+	}
+	for procedure, want := range tests {
+		if got := Mutating(procedure); got != want {
+			t.Errorf("Mutating(%s) = %v, want %v", procedure, got, want)
+		}
+	}
+}
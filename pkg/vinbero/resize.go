@@ -0,0 +1,64 @@
+package vinbero
+
+import (
+	"fmt"
+
+	"go.uber.org/zap"
+)
+
+// ResizeMap grows a control map of the running data plane to capacity,
+// keeping its entries (see bpf.MapOperations.ResizeMap). The XDP and TC
+// links this daemon attached move to the reloaded programs before the old
+// ones are closed. It
+// returns how many entries were copied.
+func (v *Vinbero) ResizeMap(name string, capacity uint32) (int, error) {
+	retired, copied, err := v.mapOps.ResizeMap(v.cfg.BpfConstants(), v.cfg, name, capacity)
+	if retired != nil {
+		// Links not yet moved keep the old program, and through it the
+		// old map, alive in the kernel
+		defer func() { _ = retired.Close() }()
+	}
+	if err != nil {
+		return copied, err
+	}
+
+	// A resize replayed from the store runs before HandOver: adopted links
+	// keep the previous daemon's program until then
+	for _, a := range v.tcLinks {
+		if a.adopted {
+			continue
+		}
+		if err := a.Update(v.obj.VinberoTcIngress); err != nil {
+			return copied, fmt.Errorf("move %s link on %s to the reloaded program: %w", a.kind, a.dev, err)
+		}
+	}
+	for _, a := range v.devLinks {
+		if a.adopted {
+			continue
+		}
+		if err := a.Update(v.obj.VinberoMain); err != nil {
+			return copied, fmt.Errorf("move %s link on %s to the reloaded program: %w", a.kind, a.dev, err)
+		}
+	}
+
+	// Entries the data plane wrote to the old map until the links moved
+	missed, err := v.mapOps.CopyRetired(retired, name)
+	copied += missed
+	if err != nil {
+		return copied, err
+	}
+	// Copied FDB entries have no timer until their next refresh; drop the
+	// ones already idle now rather than at the watcher's next sweep
+	if name == "fdb_map" && v.cfg.Setting.FdbAgingSeconds > 0 {
+		if _, err := v.mapOps.AgeFdbEntries(uint64(v.cfg.Setting.FdbAgingSeconds) * 1e9); err != nil {
+			v.logger.Warn("FDB aging sweep after resize failed", zap.Error(err))
+		}
+	}
+
+	v.logger.Info("Resized map",
+		zap.String("map", name),
+		zap.Uint32("capacity", capacity),
+		zap.Int("entries", copied),
+		zap.Int("links", len(v.tcLinks)+len(v.devLinks)))
+	return copied, nil
+}
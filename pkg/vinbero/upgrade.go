@@ -0,0 +1,212 @@
+package vinbero
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+
+	"github.com/cilium/ebpf"
+	"github.com/cilium/ebpf/link"
+	"go.uber.org/zap"
+)
+
+// With --detach-on-exit=false the daemon leaves its data plane running
+// when it exits: the XDP and TCX links and the PROG_ARRAYs their program
+// tail-calls through are pinned under settings.upgrade.link_path. The next
+// daemon adopts the pinned links, fills its own PROG_ARRAYs and swaps its
+// program in with link.Update, so traffic never sees a detached device.
+
+// attachment is an XDP or TCX link on one device.
+type attachment struct {
+	link.Link
+	kind string // "xdp" or "tcx"
+	dev  string
+	pin  string
+
+	// pinned is set once the link has a bpffs pin at pin
+	pinned bool
+	// adopted is set while the link runs the previous daemon's program
+	adopted bool
+}
+
+const defaultLinkPath = "/sys/fs/bpf/vinbero/links"
+
+func (v *Vinbero) linkPath() string {
+	if v.cfg.Setting.Upgrade.LinkPath == "" {
+		return defaultLinkPath
+	}
+	return v.cfg.Setting.Upgrade.LinkPath
+}
+
+// progArrayPath holds the pinned PROG_ARRAYs of the daemon that pinned
+// the links, which its program keeps tail-calling through until the swap.
+func (v *Vinbero) progArrayPath() string {
+	return filepath.Join(v.linkPath(), "prog_arrays")
+}
+
+func (v *Vinbero) linkPin(kind, dev string) string {
+	return filepath.Join(v.linkPath(), kind+"_"+dev)
+}
+
+// attached wraps a link this daemon attached itself.
+func (v *Vinbero) attached(kind string, dev net.Interface, l link.Link) *attachment {
+	return &attachment{Link: l, kind: kind, dev: dev.Name, pin: v.linkPin(kind, dev.Name)}
+}
+
+// adopt loads the link the previous daemon pinned for dev, or returns nil
+// if there is none. A pin whose device is gone or was recreated is
+// dropped, since its link no longer forwards anything.
+func (v *Vinbero) adopt(kind string, dev net.Interface) (*attachment, error) {
+	pin := v.linkPin(kind, dev.Name)
+	if _, err := os.Stat(pin); errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	l, err := link.LoadPinnedLink(pin, nil)
+	if err != nil {
+		return nil, fmt.Errorf("load pinned %s link %s: %w", kind, pin, err)
+	}
+
+	var ifindex uint32
+	if info, err := l.Info(); err == nil {
+		switch kind {
+		case "xdp":
+			if xi := info.XDP(); xi != nil {
+				ifindex = xi.Ifindex
+			}
+		case "tcx":
+			if ti := info.TCX(); ti != nil {
+				ifindex = ti.Ifindex
+			}
+		}
+	}
+	if ifindex != uint32(dev.Index) {
+		v.logger.Warn("Dropping stale pinned link",
+			zap.String("kind", kind), zap.String("device", dev.Name), zap.String("pin", pin))
+		err := errors.Join(l.Unpin(), l.Close())
+		if err != nil {
+			return nil, fmt.Errorf("drop stale pinned %s link %s: %w", kind, pin, err)
+		}
+		return nil, nil
+	}
+
+	v.logger.Info("Adopted pinned link, keeping the previous program until hand-over",
+		zap.String("kind", kind), zap.String("device", dev.Name))
+	return &attachment{Link: l, kind: kind, dev: dev.Name, pin: pin, pinned: true, adopted: true}, nil
+}
+
+// HandOver swaps this daemon's programs into the links adopted from the
+// previous daemon and drops that daemon's PROG_ARRAYs. TC goes first so
+// the new XDP program never passes BUM frames to the old TC program. Call
+// it once the PROG_ARRAYs hold every plugin.
+func (v *Vinbero) HandOver() error {
+	swapped := 0
+	for _, a := range v.tcLinks {
+		if err := v.swap(a, v.obj.VinberoTcIngress); err != nil {
+			return err
+		}
+		if a.adopted {
+			swapped++
+		}
+	}
+	for _, a := range v.devLinks {
+		if err := v.swap(a, v.obj.VinberoMain); err != nil {
+			return err
+		}
+		if a.adopted {
+			swapped++
+		}
+	}
+	for _, a := range append(v.tcLinks, v.devLinks...) {
+		a.adopted = false
+	}
+	// Nothing runs the previous program any more
+	if err := os.RemoveAll(v.progArrayPath()); err != nil {
+		return fmt.Errorf("remove previous PROG_ARRAYs: %w", err)
+	}
+	if swapped > 0 {
+		v.logger.Info("Handed over adopted links to the new program", zap.Int("links", swapped))
+	}
+	return nil
+}
+
+func (v *Vinbero) swap(a *attachment, prog *ebpf.Program) error {
+	if !a.adopted {
+		return nil
+	}
+	if err := a.Update(prog); err != nil {
+		return fmt.Errorf("swap program of %s link on %s: %w", a.kind, a.dev, err)
+	}
+	return nil
+}
+
+// handingOver reports whether some link still runs the previous daemon's
+// program, i.e. HandOver hasn't run.
+func (v *Vinbero) handingOver() bool {
+	for _, a := range append(v.devLinks, v.tcLinks...) {
+		if a.adopted {
+			return true
+		}
+	}
+	return false
+}
+
+// Release closes the daemon's handles but leaves the data plane running
+// for the next daemon: links and PROG_ARRAYs are pinned rather than
+// detached. If HandOver hasn't run, the adopted links keep the previous
+// program and the links this daemon attached are detached instead.
+func (v *Vinbero) Release() error {
+	v.stopWatchers()
+
+	var errs []error
+	pending := v.handingOver()
+	if pending {
+		v.logger.Warn("Exiting before hand-over; the previous program stays attached")
+	} else if err := v.pinProgArrays(); err != nil {
+		// Without its PROG_ARRAYs the program would drop every tail call
+		errs = append(errs, err)
+		pending = true
+	}
+	for _, a := range append(v.devLinks, v.tcLinks...) {
+		if !a.pinned && !pending {
+			if err := os.MkdirAll(filepath.Dir(a.pin), 0o755); err != nil {
+				errs = append(errs, err)
+			} else if err := a.Pin(a.pin); err != nil {
+				errs = append(errs, fmt.Errorf("pin %s link on %s: %w", a.kind, a.dev, err))
+			} else {
+				a.pinned = true
+			}
+		}
+		if !a.pinned {
+			v.logger.Warn("Detaching unpinned link", zap.String("kind", a.kind), zap.String("device", a.dev))
+		}
+		if err := a.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("failed to close %s link: %w", a.kind, err))
+		}
+	}
+	if err := v.obj.Close(); err != nil {
+		errs = append(errs, fmt.Errorf("failed to close bpf objects: %w", err))
+	}
+	return errors.Join(errs...)
+}
+
+// pinProgArrays pins the PROG_ARRAYs so their entries outlive this
+// process; the kernel empties a PROG_ARRAY when its last user reference
+// goes away.
+func (v *Vinbero) pinProgArrays() error {
+	dir := v.progArrayPath()
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("create %s: %w", dir, err)
+	}
+	for name, m := range map[string]*ebpf.Map{
+		"sid_endpoint_progs": v.obj.SidEndpointProgs,
+		"headend_v4_progs":   v.obj.HeadendV4Progs,
+		"headend_v6_progs":   v.obj.HeadendV6Progs,
+	} {
+		if err := m.Pin(filepath.Join(dir, name)); err != nil {
+			return fmt.Errorf("pin %s: %w", name, err)
+		}
+	}
+	return nil
+}
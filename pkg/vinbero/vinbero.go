@@ -12,6 +12,7 @@ import (
 	"github.com/takehaya/vinbero/pkg/config"
 	"github.com/takehaya/vinbero/pkg/netlinkwatch"
 	"github.com/takehaya/vinbero/pkg/netresource"
+	"github.com/takehaya/vinbero/pkg/oam"
 	"go.uber.org/zap"
 )
 
@@ -20,14 +21,31 @@ type Vinbero struct {
 	obj        *bpf.BpfObjects
 	mapOps     *bpf.MapOperations
 	devices    []net.Interface
-	devLinks   []link.Link
-	tcLinks    []link.Link
+	devLinks   []*attachment
+	tcLinks    []*attachment
 	fdbWatcher *netlinkwatch.FDBWatcher
+	oamPunts   *oam.PuntReader
 	resMgr     *netresource.ResourceManager
 	logger     *zap.Logger
+
+	// mapsRestored is set when the control-state maps were reused from
+	// bpffs pins instead of created empty
+	mapsRestored bool
 }
 
 func NewVinbero(cfg *config.Config, logger *zap.Logger) (*Vinbero, error) {
+	mapsRestored := bpf.PinnedMapsExist(cfg)
+	migrated, err := bpf.MigratePinnedMaps(cfg)
+	for _, m := range migrated {
+		logger.Info("Migrated pinned map",
+			zap.String("map", m.Map),
+			zap.String("from", m.From),
+			zap.String("to", m.To),
+			zap.Int("entries", m.Entries))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("fail to migrate pinned maps: %w", err)
+	}
 	obj, err := bpf.ReadCollection(cfg.BpfConstants(), cfg)
 	if err != nil {
 		return nil, fmt.Errorf("fail to bpf load: %w", err)
@@ -38,6 +56,12 @@ func NewVinbero(cfg *config.Config, logger *zap.Logger) (*Vinbero, error) {
 	if err := mapOps.RecoverAuxIndices(); err != nil {
 		logger.Warn("failed to recover aux indices, starting fresh", zap.Error(err))
 	}
+	if err := mapOps.RebuildBdPeerSpans(); err != nil {
+		logger.Warn("failed to rebuild BD peer spans", zap.Error(err))
+	}
+	if err := mapOps.RebuildMacCounts(); err != nil {
+		logger.Warn("failed to rebuild MAC counts", zap.Error(err))
+	}
 
 	// resolve device interfaces
 	var devices []net.Interface
@@ -55,6 +79,8 @@ func NewVinbero(cfg *config.Config, logger *zap.Logger) (*Vinbero, error) {
 		mapOps:  mapOps,
 		devices: devices,
 		logger:  logger,
+
+		mapsRestored: mapsRestored,
 	}, nil
 }
 
@@ -64,15 +90,22 @@ func (v *Vinbero) LoadXDPProgram() error {
 		return fmt.Errorf("invalid XDP attach mode: %w", err)
 	}
 	for _, dev := range v.devices {
-		l, err := link.AttachXDP(link.XDPOptions{
-			Program:   v.obj.VinberoMain,
-			Interface: dev.Index,
-			Flags:     attachMode,
-		})
+		a, err := v.adopt("xdp", dev)
 		if err != nil {
-			return fmt.Errorf("failed to attach XDP program to device %s: %w", dev.Name, err)
+			return err
 		}
-		v.devLinks = append(v.devLinks, l)
+		if a == nil {
+			l, err := link.AttachXDP(link.XDPOptions{
+				Program:   v.obj.VinberoMain,
+				Interface: dev.Index,
+				Flags:     attachMode,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to attach XDP program to device %s: %w", dev.Name, err)
+			}
+			a = v.attached("xdp", dev, l)
+		}
+		v.devLinks = append(v.devLinks, a)
 	}
 
 	return nil
@@ -80,15 +113,22 @@ func (v *Vinbero) LoadXDPProgram() error {
 
 func (v *Vinbero) LoadTCProgram() error {
 	for _, dev := range v.devices {
-		l, err := link.AttachTCX(link.TCXOptions{
-			Program:   v.obj.VinberoTcIngress,
-			Attach:    ebpf.AttachTCXIngress,
-			Interface: dev.Index,
-		})
+		a, err := v.adopt("tcx", dev)
 		if err != nil {
-			return fmt.Errorf("failed to attach TC program to device %s: %w", dev.Name, err)
+			return err
+		}
+		if a == nil {
+			l, err := link.AttachTCX(link.TCXOptions{
+				Program:   v.obj.VinberoTcIngress,
+				Attach:    ebpf.AttachTCXIngress,
+				Interface: dev.Index,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to attach TC program to device %s: %w", dev.Name, err)
+			}
+			a = v.attached("tcx", dev, l)
 		}
-		v.tcLinks = append(v.tcLinks, l)
+		v.tcLinks = append(v.tcLinks, a)
 	}
 	return nil
 }
@@ -119,6 +159,7 @@ func (v *Vinbero) InitResourceManager() error {
 func (v *Vinbero) StartFDBWatcher(ctx context.Context) error {
 	v.fdbWatcher = netlinkwatch.NewFDBWatcher(v.mapOps, v.logger)
 	v.fdbWatcher.SetAgingSeconds(v.cfg.Setting.FdbAgingSeconds)
+	v.fdbWatcher.SetAgingEvents(v.obj.FdbAgingEvents)
 	if err := v.fdbWatcher.Start(ctx); err != nil {
 		return fmt.Errorf("failed to start FDB watcher: %w", err)
 	}
@@ -134,6 +175,22 @@ func (v *Vinbero) StartFDBWatcher(ctx context.Context) error {
 	return nil
 }
 
+// StartOAMPuntReader starts draining the data plane's OAM punt ring.
+func (v *Vinbero) StartOAMPuntReader() error {
+	r := oam.NewPuntReader(v.obj.OamPuntEvents, oam.DefaultPuntBufferSize, v.logger)
+	if err := r.Start(); err != nil {
+		return fmt.Errorf("failed to start OAM punt reader: %w", err)
+	}
+	v.oamPunts = r
+	v.logger.Info("OAM punt reader started")
+	return nil
+}
+
+// GetOAMPuntReader returns the OAM punt reader, or nil if it isn't running
+func (v *Vinbero) GetOAMPuntReader() *oam.PuntReader {
+	return v.oamPunts
+}
+
 // GetResourceManager returns the resource manager instance
 func (v *Vinbero) GetResourceManager() *netresource.ResourceManager {
 	return v.resMgr
@@ -149,27 +206,35 @@ func (v *Vinbero) GetMapOperations() *bpf.MapOperations {
 	return v.mapOps
 }
 
+// MapsRestored reports whether the control-state maps came from bpffs
+// pins and so already hold the previous daemon's entries.
+func (v *Vinbero) MapsRestored() bool {
+	return v.mapsRestored
+}
+
 // GetConfig returns the configuration
 func (v *Vinbero) GetConfig() *config.Config {
 	return v.cfg
 }
 
+// Close stops the daemon and detaches its programs. Links adopted from a
+// previous daemon that HandOver hasn't swapped yet are left attached and
+// pinned, still running that daemon's program.
 func (v *Vinbero) Close() error {
 	var errs []error
 
-	if v.fdbWatcher != nil {
-		v.fdbWatcher.Stop()
-	}
+	v.stopWatchers()
+	pending := v.handingOver()
 	// Detach XDP first (stop ingress) before removing TC (BUM encap).
 	// Otherwise XDP_PASS with BUM meta can reach a detached TC program.
-	for _, l := range v.devLinks {
-		if err := l.Close(); err != nil {
-			errs = append(errs, fmt.Errorf("failed to close XDP link: %w", err))
+	for _, a := range append(v.devLinks, v.tcLinks...) {
+		if a.pinned && !pending {
+			if err := a.Unpin(); err != nil {
+				errs = append(errs, fmt.Errorf("failed to unpin %s link: %w", a.kind, err))
+			}
 		}
-	}
-	for _, l := range v.tcLinks {
-		if err := l.Close(); err != nil {
-			errs = append(errs, fmt.Errorf("failed to close TC link: %w", err))
+		if err := a.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("failed to close %s link: %w", a.kind, err))
 		}
 	}
 	if err := v.obj.Close(); err != nil {
@@ -178,3 +243,11 @@ func (v *Vinbero) Close() error {
 	return errors.Join(errs...)
 }
 
+func (v *Vinbero) stopWatchers() {
+	if v.fdbWatcher != nil {
+		v.fdbWatcher.Stop()
+	}
+	if v.oamPunts != nil {
+		v.oamPunts.Stop()
+	}
+}
@@ -0,0 +1,175 @@
+#ifndef VINBERO_AC_VLAN_H
+#define VINBERO_AC_VLAN_H
+
+#include <linux/types.h>
+#include <linux/bpf.h>
+#include <linux/if_ether.h>
+#include <bpf/bpf_endian.h>
+#include <bpf/bpf_helpers.h>
+#include <stdbool.h>
+
+#include "core/xdp_prog.h"   // struct headend_l2_key, struct vlan_rewrite
+#include "core/xdp_map.h"    // headend_l2_map, ac_vlan_range_map, headend_l2_ext_map
+
+// Attachment circuit matching and egress VLAN rewrite (Q-in-Q, VLAN ranges,
+// VLAN translation).
+
+#define VLAN_VID_MASK 0x0FFF
+
+static __always_inline bool eth_proto_is_vlan(__be16 proto)
+{
+    return proto == bpf_htons(ETH_P_8021Q) || proto == bpf_htons(ETH_P_8021AD);
+}
+
+// Resolve the AC of a frame received on ifindex with the given outer and
+// inner VLAN IDs, most specific first:
+//   1. (ifindex, vlan_id, inner_vlan_id)  double-tagged AC
+//   2. (ifindex, vlan_id, 0)              single-tagged AC, or all C-tags of an S-VLAN
+//   3. a VLAN range on ifindex containing vlan_id, stored under (ifindex, first, 0)
+//   4. (ifindex, HEADEND_L2_VLAN_ANY, 0)  port-mode AC
+// On a hit *key holds the AC identity used by MAC limits and side tables.
+static __always_inline struct headend_entry *ac_lookup(
+    __u32 ifindex,
+    __u16 vlan_id,
+    __u16 inner_vlan_id,
+    struct headend_l2_key *key)
+{
+    key->ifindex = ifindex;
+    key->vlan_id = vlan_id;
+    key->inner_vlan_id = inner_vlan_id;
+    struct headend_entry *e = bpf_map_lookup_elem(&headend_l2_map, key);
+    if (e)
+        return e;
+
+    if (inner_vlan_id != 0) {
+        key->inner_vlan_id = 0;
+        e = bpf_map_lookup_elem(&headend_l2_map, key);
+        if (e)
+            return e;
+    }
+
+    struct ac_vlan_ranges *ranges = NULL;
+    if (vlan_id != 0)
+        ranges = bpf_map_lookup_elem(&ac_vlan_range_map, &ifindex);
+    if (ranges) {
+#pragma unroll
+        for (int i = 0; i < AC_VLAN_RANGES_MAX; i++) {
+            __u16 first = ranges->range[i].first;
+            if (first != 0 && vlan_id >= first && vlan_id <= ranges->range[i].last) {
+                key->vlan_id = first;
+                e = bpf_map_lookup_elem(&headend_l2_map, key);
+                if (e)
+                    return e;
+                break;
+            }
+        }
+    }
+
+    key->vlan_id = HEADEND_L2_VLAN_ANY;
+    key->inner_vlan_id = 0;
+    return bpf_map_lookup_elem(&headend_l2_map, key);
+}
+
+// Apply an egress VLAN rewrite to the Ethernet frame at ctx->data.
+// Frames without a tag are left alone by POP and TRANSLATE. PUSH and
+// TRANSLATE keep the PCP/DEI bits of the tag they build on.
+// Returns 0 on success, -1 if the frame could not be rewritten.
+// Invalidates all packet pointers.
+static __always_inline int vlan_rewrite_xdp(
+    struct xdp_md *ctx,
+    const struct vlan_rewrite *rw)
+{
+    __u8 op = rw->op;
+    if (op == VLAN_REWRITE_NONE)
+        return 0;
+
+    void *data = (void *)(long)ctx->data;
+    void *data_end = (void *)(long)ctx->data_end;
+    struct ethhdr *eth = data;
+    if ((void *)(eth + 1) > data_end)
+        return -1;
+
+    bool tagged = eth_proto_is_vlan(eth->h_proto);
+    struct vlan_hdr *vh = (struct vlan_hdr *)(eth + 1);
+    if (tagged && (void *)(vh + 1) > data_end)
+        return -1;
+
+    if (op == VLAN_REWRITE_TRANSLATE) {
+        if (!tagged)
+            return 0;
+        __u16 tci = bpf_ntohs(vh->h_vlan_TCI);
+        vh->h_vlan_TCI = bpf_htons((tci & ~VLAN_VID_MASK) | (rw->vlan_id & VLAN_VID_MASK));
+        if (rw->inner_vlan_id != 0 && eth_proto_is_vlan(vh->h_vlan_encapsulated_proto)) {
+            struct vlan_hdr *ivh = vh + 1;
+            if ((void *)(ivh + 1) > data_end)
+                return -1;
+            tci = bpf_ntohs(ivh->h_vlan_TCI);
+            ivh->h_vlan_TCI = bpf_htons((tci & ~VLAN_VID_MASK) | (rw->inner_vlan_id & VLAN_VID_MASK));
+        }
+        return 0;
+    }
+
+    __u8 macs[2 * ETH_ALEN];
+
+    if (op == VLAN_REWRITE_POP) {
+        if (!tagged)
+            return 0;
+        __builtin_memcpy(macs, eth, sizeof(macs));
+        if (bpf_xdp_adjust_head(ctx, (int)sizeof(struct vlan_hdr)))
+            return -1;
+        data = (void *)(long)ctx->data;
+        data_end = (void *)(long)ctx->data_end;
+        if (data + sizeof(macs) > data_end)
+            return -1;
+        // The old encapsulated protocol is now the EtherType
+        __builtin_memcpy(data, macs, sizeof(macs));
+        return 0;
+    }
+
+    if (op != VLAN_REWRITE_PUSH)
+        return -1;
+
+    __u16 tci = rw->vlan_id & VLAN_VID_MASK;
+    __be16 tpid = bpf_htons(ETH_P_8021Q);
+    if (tagged) {
+        tci |= bpf_ntohs(vh->h_vlan_TCI) & ~VLAN_VID_MASK;
+        tpid = bpf_htons(ETH_P_8021AD);
+    }
+    __builtin_memcpy(macs, eth, sizeof(macs));
+    if (bpf_xdp_adjust_head(ctx, -(int)sizeof(struct vlan_hdr)))
+        return -1;
+    data = (void *)(long)ctx->data;
+    data_end = (void *)(long)ctx->data_end;
+    eth = data;
+    vh = (struct vlan_hdr *)(eth + 1);
+    if ((void *)(vh + 1) > data_end)
+        return -1;
+    // The old EtherType already sits where the new tag's encapsulated protocol goes
+    __builtin_memcpy(eth, macs, sizeof(macs));
+    eth->h_proto = tpid;
+    vh->h_vlan_TCI = bpf_htons(tci);
+    return 0;
+}
+
+// Egress rewrite for a frame leaving through the local AC of an FDB entry:
+// the entry's own rewrite if set, otherwise the AC's.
+static __always_inline int vlan_rewrite_fdb_egress(
+    struct xdp_md *ctx,
+    const struct fdb_entry *fdb)
+{
+    struct vlan_rewrite rw = fdb->rewrite;
+    if (rw.op == VLAN_REWRITE_NONE) {
+        struct headend_l2_key key = {
+            .ifindex = fdb->oif,
+            .vlan_id = fdb->ac_vlan_id,
+            .inner_vlan_id = fdb->ac_inner_vlan_id,
+        };
+        struct headend_l2_ext_val *ext = bpf_map_lookup_elem(&headend_l2_ext_map, &key);
+        if (!ext)
+            return 0;
+        rw = ext->egress_rewrite;
+    }
+    return vlan_rewrite_xdp(ctx, &rw);
+}
+
+#endif // VINBERO_AC_VLAN_H
@@ -0,0 +1,88 @@
+#ifndef FDB_AGING_H
+#define FDB_AGING_H
+
+#include <linux/types.h>
+#include <linux/bpf.h>
+#include <bpf/bpf_helpers.h>
+
+#include "core/xdp_prog.h"
+#include "core/xdp_map.h"
+#include "core/mac_limit.h"
+
+#ifndef CLOCK_MONOTONIC
+#define CLOCK_MONOTONIC 1
+#endif
+
+// Aging timeout for dynamic FDB entries in nanoseconds (0 = no aging).
+// Rewritten from settings.fdb_aging_seconds.
+const volatile __u64 fdb_aging_ns = 0;
+
+// Retry delay when fdb_aging_events is full: the entry is kept until its
+// event can be delivered, so userspace never misses a withdrawal.
+#define FDB_AGING_RETRY_NS 1000000000ULL
+
+// Timer callback for one fdb_map entry. Refreshes only bump last_seen, so
+// the timer re-arms itself for the remaining lifetime until the entry has
+// been idle for fdb_aging_ns, then reports and deletes it.
+static int fdb_aging_timer_cb(void *map, struct fdb_key *key, struct fdb_entry *val)
+{
+    if (val->is_static || !fdb_aging_ns)
+        return 0;
+
+    __u64 now = bpf_ktime_get_ns();
+    __u64 age = now > val->last_seen ? now - val->last_seen : 0;
+    if (age < fdb_aging_ns) {
+        bpf_timer_start(&val->timer, fdb_aging_ns - age, 0);
+        return 0;
+    }
+
+    struct fdb_aged_event *ev = bpf_ringbuf_reserve(&fdb_aging_events, sizeof(*ev), 0);
+    if (!ev) {
+        bpf_timer_start(&val->timer, FDB_AGING_RETRY_NS, 0);
+        return 0;
+    }
+    __builtin_memset(ev, 0, sizeof(*ev));
+    ev->last_seen = val->last_seen;
+    ev->oif = val->oif;
+    ev->bd_id = key->bd_id;
+    ev->peer_index = val->peer_index;
+    __builtin_memcpy(ev->mac, key->mac, ETH_ALEN);
+    ev->is_remote = val->is_remote;
+    __builtin_memcpy(ev->esi, val->esi, ESI_LEN);
+    bpf_ringbuf_submit(ev, 0);
+
+    mac_count_fdb(key->bd_id, val->oif, val->ac_vlan_id, val->is_remote, -1);
+    bpf_map_delete_elem(map, key);
+    return 0;
+}
+
+// Start the aging timer of a dynamic entry that has none yet. Called on
+// learn and refresh; aging_armed keeps the refresh path to a single load
+// once the timer runs. Entries written by userspace start unarmed and are
+// picked up on their next refresh.
+static __always_inline void fdb_aging_arm(struct fdb_entry *e)
+{
+    if (!fdb_aging_ns || e->is_static || e->aging_armed)
+        return;
+    e->aging_armed = 1;
+    // -EBUSY when another CPU initialised it first; starting again is harmless
+    bpf_timer_init(&e->timer, &fdb_map, CLOCK_MONOTONIC);
+    bpf_timer_set_callback(&e->timer, fdb_aging_timer_cb);
+    bpf_timer_start(&e->timer, fdb_aging_ns, 0);
+}
+
+// Insert a freshly learned dynamic entry, count it against the MAC limits
+// and arm its aging timer. Returns 0 on success. A dynamic entry that it
+// replaces must be uncounted by the caller (mac_count_fdb with -1).
+static __always_inline int fdb_learn(struct fdb_key *key, struct fdb_entry *val)
+{
+    if (bpf_map_update_elem(&fdb_map, key, val, BPF_ANY))
+        return -1;
+    mac_count_fdb(key->bd_id, val->oif, val->ac_vlan_id, val->is_remote, 1);
+    struct fdb_entry *e = bpf_map_lookup_elem(&fdb_map, key);
+    if (e)
+        fdb_aging_arm(e);
+    return 0;
+}
+
+#endif // FDB_AGING_H
@@ -0,0 +1,134 @@
+#ifndef VINBERO_IRB_H
+#define VINBERO_IRB_H
+
+#include <linux/types.h>
+#include <linux/bpf.h>
+#include <linux/if_ether.h>
+#include <linux/ip.h>
+#include <linux/ipv6.h>
+#include <bpf/bpf_endian.h>
+#include <bpf/bpf_helpers.h>
+
+#include "core/xdp_prog.h"         // struct bd_irb_entry, irb_route_key_*
+#include "core/xdp_map.h"          // bd_irb_map, irb_route_*_map, headend_*_progs
+#include "core/xdp_stats.h"
+#include "core/xdp_tailcall.h"     // DISPATCH_HEADEND_*
+#include "core/neigh_suppress.h"   // neigh_mac_equal
+
+// Symmetric IRB (RFC 9135): a frame from an AC addressed to its BD's
+// anycast gateway MAC is routed in the BD's VRF instead of bridged. Remote
+// subnets and hosts (RT2 MAC+IP) found in irb_route_v4_map / _v6_map are
+// encapsulated towards the owning PE's End.DT4/DT6 SID through the regular
+// headend tail call targets; everything else goes to the kernel, where the
+// BD's bridge holds the gateway address inside the VRF.
+//
+// The tail call itself is made by vinbero_main: irb_route runs two frames
+// deep, where the call stack is too large for bpf_tail_call, so it only
+// fills tailcall_ctx and returns IRB_TAIL_V4 / IRB_TAIL_V6.
+
+#define IRB_TAIL_V4 0x100
+#define IRB_TAIL_V6 0x101
+
+// ip_decrease_ttl() from the kernel: incremental checksum update
+static __always_inline void irb_decrease_ttl(struct iphdr *iph)
+{
+    __u32 check = (__u32)iph->check;
+    check += (__u32)bpf_htons(0x0100);
+    iph->check = (__sum16)(check + (check >= 0xFFFF));
+    iph->ttl--;
+}
+
+// irb_route routes a frame from an AC of bd_id. Returns -1 when the frame
+// is not addressed to the BD's gateway (continue bridging), IRB_TAIL_V4 /
+// IRB_TAIL_V6 when a route was found, otherwise an XDP action.
+static __noinline int irb_route(struct xdp_md *ctx, __u16 bd_id, __u64 pkt_len)
+{
+    struct bd_irb_entry *irb = bpf_map_lookup_elem(&bd_irb_map, &bd_id);
+    if (!irb)
+        return -1;
+
+    void *data = (void *)(long)ctx->data;
+    void *data_end = (void *)(long)ctx->data_end;
+    struct ethhdr *eth = data;
+    if ((void *)(eth + 1) > data_end)
+        return -1;
+    if (!neigh_mac_equal(eth->h_dest, irb->gw_mac))
+        return -1;
+    __u32 vrf_ifindex = irb->vrf_ifindex;
+
+    __u16 l3_off = sizeof(struct ethhdr);
+    __be16 proto = eth->h_proto;
+    #pragma unroll
+    for (int i = 0; i < 2; i++) {
+        if (proto != bpf_htons(ETH_P_8021Q) && proto != bpf_htons(ETH_P_8021AD))
+            break;
+        struct vlan_hdr *vh = data + l3_off;
+        if ((void *)(vh + 1) > data_end)
+            return XDP_DROP;
+        proto = vh->h_vlan_encapsulated_proto;
+        l3_off += sizeof(struct vlan_hdr);
+    }
+
+    // Expired packets go to the kernel, which answers with Time Exceeded
+    // from the gateway address
+    if (proto == bpf_htons(ETH_P_IP)) {
+        struct iphdr *iph = data + l3_off;
+        if ((void *)(iph + 1) > data_end)
+            return XDP_DROP;
+        if (iph->ttl <= 1)
+            return XDP_PASS;
+
+        struct irb_route_key_v4 key = { .prefixlen = 32 + 32, .vrf_ifindex = vrf_ifindex };
+        __builtin_memcpy(key.addr, &iph->daddr, IPV4_ADDR_LEN);
+        struct headend_entry *route = bpf_map_lookup_elem(&irb_route_v4_map, &key);
+        if (!route)
+            return XDP_PASS;
+
+        if (tailcall_ctx_write_headend(route, l3_off, DISPATCH_HEADEND_V4, route->mode))
+            return XDP_PASS;
+        irb_decrease_ttl(iph);
+        STATS_INC(STATS_IRB_ROUTED, pkt_len);
+        return IRB_TAIL_V4;
+    }
+
+    if (proto == bpf_htons(ETH_P_IPV6)) {
+        struct ipv6hdr *ip6h = data + l3_off;
+        if ((void *)(ip6h + 1) > data_end)
+            return XDP_DROP;
+        if (ip6h->hop_limit <= 1)
+            return XDP_PASS;
+
+        struct irb_route_key_v6 key = { .prefixlen = 32 + 128, .vrf_ifindex = vrf_ifindex };
+        __builtin_memcpy(key.addr, &ip6h->daddr, IPV6_ADDR_LEN);
+        struct headend_entry *route = bpf_map_lookup_elem(&irb_route_v6_map, &key);
+        if (!route)
+            return XDP_PASS;
+
+        if (tailcall_ctx_write_headend(route, l3_off, DISPATCH_HEADEND_V6, route->mode))
+            return XDP_PASS;
+        ip6h->hop_limit--;
+        STATS_INC(STATS_IRB_ROUTED, pkt_len);
+        return IRB_TAIL_V6;
+    }
+
+    // ARP and anything else unicast to the gateway is for the kernel
+    return XDP_PASS;
+}
+
+// irb_tail_call turns an IRB_TAIL_* result of the L2 pipeline into the
+// headend tail call prepared by irb_route. Other actions pass through.
+static __always_inline int irb_tail_call(struct xdp_md *ctx, int action)
+{
+    if (action != IRB_TAIL_V4 && action != IRB_TAIL_V6)
+        return action;
+    struct tailcall_ctx *tctx = tailcall_ctx_read();
+    if (!tctx)
+        return XDP_PASS;
+    if (action == IRB_TAIL_V4)
+        bpf_tail_call(ctx, &headend_v4_progs, tctx->slot);
+    else
+        bpf_tail_call(ctx, &headend_v6_progs, tctx->slot);
+    return XDP_PASS;
+}
+
+#endif // VINBERO_IRB_H
@@ -0,0 +1,84 @@
+#ifndef VINBERO_MAC_LIMIT_H
+#define VINBERO_MAC_LIMIT_H
+
+#include <linux/types.h>
+#include <linux/bpf.h>
+#include <bpf/bpf_helpers.h>
+#include <stdbool.h>
+
+#include "core/xdp_prog.h"  // struct mac_limit_*, struct fdb_*
+#include "core/xdp_map.h"   // mac_limit_map, mac_count_map
+
+// Outcome of mac_learn_verdict
+enum mac_learn_verdict {
+    MAC_LEARN_OK          = 0,  // Learn the source MAC
+    MAC_LEARN_OFF         = 1,  // Learning disabled: forward without learning
+    MAC_LEARN_LIMIT_FLOOD = 2,  // At the limit: forward without learning
+    MAC_LEARN_LIMIT_DROP  = 3,  // At the limit: drop the frame
+};
+
+static __always_inline void mac_count_add(struct mac_limit_key *key, __s64 delta)
+{
+    __s64 *cnt = bpf_map_lookup_elem(&mac_count_map, key);
+    if (!cnt) {
+        if (delta < 0)
+            return;
+        __s64 zero = 0;
+        bpf_map_update_elem(&mac_count_map, key, &zero, BPF_NOEXIST);
+        cnt = bpf_map_lookup_elem(&mac_count_map, key);
+        if (!cnt)
+            return;
+    }
+    __sync_fetch_and_add(cnt, delta);
+}
+
+// Adjust the BD count and, for local entries, the AC count of a dynamic
+// FDB entry entering (+1) or leaving (-1) fdb_map.
+static __always_inline void mac_count_fdb(
+    __u16 bd_id, __u32 oif, __u16 ac_vlan_id, bool is_remote, __s64 delta)
+{
+    struct mac_limit_key bd = { .bd_id = bd_id };
+    mac_count_add(&bd, delta);
+    if (is_remote)
+        return;
+    struct mac_limit_key ac = { .ifindex = oif, .vlan_id = ac_vlan_id };
+    mac_count_add(&ac, delta);
+}
+
+static __always_inline int mac_limit_check(struct mac_limit_key *key, bool check_limit)
+{
+    struct mac_limit_entry *lim = bpf_map_lookup_elem(&mac_limit_map, key);
+    if (!lim)
+        return MAC_LEARN_OK;
+    if (lim->learning_disabled)
+        return MAC_LEARN_OFF;
+    if (!check_limit || !lim->max_macs)
+        return MAC_LEARN_OK;
+
+    __s64 *cnt = bpf_map_lookup_elem(&mac_count_map, key);
+    if (!cnt || *cnt < (__s64)lim->max_macs)
+        return MAC_LEARN_OK;
+    return lim->limit_action == MAC_LIMIT_ACTION_FLOOD ? MAC_LEARN_LIMIT_FLOOD
+                                                       : MAC_LEARN_LIMIT_DROP;
+}
+
+// Decide whether a new source MAC may be learned into bd_id. ifindex and
+// vlan_id name the local AC it arrived on (ifindex 0 for remote learning).
+// moved is true when the MAC already holds a dynamic entry in the BD, so
+// learning it does not grow the BD and only the AC limit applies. The
+// check and the count update are not atomic: concurrent learners on
+// several CPUs may overshoot a limit by a few entries.
+static __always_inline int mac_learn_verdict(
+    __u16 bd_id, __u32 ifindex, __u16 vlan_id, bool moved)
+{
+    if (ifindex) {
+        struct mac_limit_key ac = { .ifindex = ifindex, .vlan_id = vlan_id };
+        int v = mac_limit_check(&ac, true);
+        if (v != MAC_LEARN_OK)
+            return v;
+    }
+    struct mac_limit_key bd = { .bd_id = bd_id };
+    return mac_limit_check(&bd, !moved);
+}
+
+#endif // VINBERO_MAC_LIMIT_H
@@ -0,0 +1,270 @@
+#ifndef VINBERO_NEIGH_SUPPRESS_H
+#define VINBERO_NEIGH_SUPPRESS_H
+
+#include <linux/types.h>
+#include <linux/bpf.h>
+#include <linux/if_ether.h>
+#include <linux/ipv6.h>
+#include <bpf/bpf_endian.h>
+#include <bpf/bpf_helpers.h>
+#include <stdbool.h>
+
+#include "core/xdp_prog.h"   // struct neigh_*
+#include "core/xdp_map.h"    // neigh_map
+#include "core/xdp_stats.h"
+#include "core/oam.h"        // oam_icmp6_pseudo, oam_csum_fold, oam_icmp6_sum
+
+#define NEIGH_ARP_REQUEST 1
+#define NEIGH_ARP_REPLY   2
+
+#define NEIGH_ICMP6_NS    135
+#define NEIGH_ICMP6_NA    136
+#define NEIGH_ND_OPT_SLLA 1
+#define NEIGH_ND_OPT_TLLA 2
+#define NEIGH_NS_LEN      24      // type..target, no options
+#define NEIGH_NA_LEN      32      // type..target + TLLA option
+#define NEIGH_NA_FLAGS    0x60    // Solicited | Override (not Router)
+
+// ARP for IPv4 over Ethernet (RFC 826)
+struct neigh_arp {
+    __be16 htype;
+    __be16 ptype;
+    __u8 hlen;
+    __u8 plen;
+    __be16 oper;
+    __u8 sha[ETH_ALEN];
+    __u8 spa[4];
+    __u8 tha[ETH_ALEN];
+    __u8 tpa[4];
+} __attribute__((packed));
+
+// 4 + 2 split, like esi_equal: no __builtin_memcmp for odd sizes
+static __always_inline bool neigh_mac_equal(const __u8 *a, const __u8 *b)
+{
+    return *(const __u32 *)a == *(const __u32 *)b &&
+           *(const __u16 *)(a + 4) == *(const __u16 *)(b + 4);
+}
+
+static __always_inline void neigh_key_v4(struct neigh_key *key, __u16 bd_id, const __u8 *ip)
+{
+    __builtin_memset(key, 0, sizeof(*key));
+    key->bd_id = bd_id;
+    key->addr[10] = 0xff;
+    key->addr[11] = 0xff;
+    __builtin_memcpy(&key->addr[12], ip, 4);
+}
+
+// Record ip -> mac learned from a local host. Entries configured via the
+// API are left alone, and an unchanged entry is not rewritten.
+static __always_inline void neigh_snoop(struct neigh_key *key, const __u8 *mac)
+{
+    struct neigh_entry *cur = bpf_map_lookup_elem(&neigh_map, key);
+    if (cur) {
+        if (cur->origin == NEIGH_ORIGIN_STATIC)
+            return;
+        if (cur->origin == NEIGH_ORIGIN_SNOOP &&
+            neigh_mac_equal(cur->mac, mac))
+            return;
+    }
+    struct neigh_entry val = {
+        .origin = NEIGH_ORIGIN_SNOOP,
+        .updated_ns = bpf_ktime_get_ns(),
+    };
+    __builtin_memcpy(val.mac, mac, ETH_ALEN);
+    bpf_map_update_elem(&neigh_map, key, &val, BPF_ANY);
+}
+
+// ARP: snoop replies and gratuitous ARPs; answer a broadcast request for a
+// known address in place.
+static __always_inline int neigh_process_arp(
+    struct xdp_md *ctx, __u16 bd_id, __u32 l3_off, __u64 pkt_len)
+{
+    void *data = (void *)(long)ctx->data;
+    void *data_end = (void *)(long)ctx->data_end;
+
+    struct ethhdr *eth = data;
+    if ((void *)(eth + 1) > data_end)
+        return -1;
+    struct neigh_arp *arp = data + l3_off;
+    if ((void *)(arp + 1) > data_end)
+        return -1;
+    if (arp->htype != bpf_htons(1) || arp->ptype != bpf_htons(ETH_P_IP) ||
+        arp->hlen != ETH_ALEN || arp->plen != 4)
+        return -1;
+
+    __u32 spa, tpa;
+    __builtin_memcpy(&spa, arp->spa, 4);
+    __builtin_memcpy(&tpa, arp->tpa, 4);
+    struct neigh_key key;
+    if (arp->oper == bpf_htons(NEIGH_ARP_REPLY) ||
+        (arp->oper == bpf_htons(NEIGH_ARP_REQUEST) && spa == tpa)) {
+        if (spa != 0 && !(arp->sha[0] & 0x01)) {
+            neigh_key_v4(&key, bd_id, arp->spa);
+            neigh_snoop(&key, arp->sha);
+        }
+        return -1;
+    }
+
+    // Only broadcast requests that are not probes (spa 0.0.0.0, RFC 5227)
+    if (arp->oper != bpf_htons(NEIGH_ARP_REQUEST) || !(eth->h_dest[0] & 0x01) || spa == 0)
+        return -1;
+
+    neigh_key_v4(&key, bd_id, arp->tpa);
+    struct neigh_entry *n = bpf_map_lookup_elem(&neigh_map, &key);
+    if (!n)
+        return -1;
+
+    __u8 mac[ETH_ALEN], req_mac[ETH_ALEN];
+    __builtin_memcpy(mac, n->mac, ETH_ALEN);
+    __builtin_memcpy(req_mac, arp->sha, ETH_ALEN);
+
+    __builtin_memcpy(eth->h_dest, eth->h_source, ETH_ALEN);
+    __builtin_memcpy(eth->h_source, mac, ETH_ALEN);
+    arp->oper = bpf_htons(NEIGH_ARP_REPLY);
+    __builtin_memcpy(arp->sha, mac, ETH_ALEN);
+    __builtin_memcpy(arp->spa, &tpa, 4);
+    __builtin_memcpy(arp->tha, req_mac, ETH_ALEN);
+    __builtin_memcpy(arp->tpa, &spa, 4);
+
+    STATS_INC(STATS_NEIGH_SUPPRESS, pkt_len);
+    return XDP_TX;
+}
+
+// IPv6 ND: snoop NAs carrying a TLLA option; answer a multicast NS for a
+// known target with a solicited NA (RFC 4861 Section 7.2.4).
+static __always_inline int neigh_process_nd(
+    struct xdp_md *ctx, __u16 bd_id, __u32 l3_off, __u64 pkt_len)
+{
+    void *data = (void *)(long)ctx->data;
+    void *data_end = (void *)(long)ctx->data_end;
+
+    struct ethhdr *eth = data;
+    if ((void *)(eth + 1) > data_end)
+        return -1;
+    struct ipv6hdr *ip6h = data + l3_off;
+    if ((void *)(ip6h + 1) > data_end)
+        return -1;
+    // ND messages carry no extension headers and hop limit 255
+    if (ip6h->nexthdr != IPPROTO_ICMPV6 || ip6h->hop_limit != 255)
+        return -1;
+    __u8 *icmp = (__u8 *)(ip6h + 1);
+    if ((void *)(icmp + NEIGH_NS_LEN) > data_end)
+        return -1;
+    if (icmp[1] != 0)
+        return -1;
+
+    struct neigh_key key = { .bd_id = bd_id };
+
+    if (icmp[0] == NEIGH_ICMP6_NA) {
+        __u8 *opt = icmp + NEIGH_NS_LEN;
+        if ((void *)(opt + 8) > data_end)
+            return -1;
+        if (opt[0] != NEIGH_ND_OPT_TLLA || opt[1] != 1 || (opt[2] & 0x01))
+            return -1;
+        __builtin_memcpy(key.addr, icmp + 8, 16);
+        neigh_snoop(&key, opt + 2);
+        return -1;
+    }
+
+    if (icmp[0] != NEIGH_ICMP6_NS || !(eth->h_dest[0] & 0x01))
+        return -1;
+    // Duplicate Address Detection (source ::) must reach the real owner
+    if ((ip6h->saddr.in6_u.u6_addr32[0] | ip6h->saddr.in6_u.u6_addr32[1] |
+         ip6h->saddr.in6_u.u6_addr32[2] | ip6h->saddr.in6_u.u6_addr32[3]) == 0)
+        return -1;
+
+    __builtin_memcpy(key.addr, icmp + 8, 16);
+    struct neigh_entry *n = bpf_map_lookup_elem(&neigh_map, &key);
+    if (!n)
+        return -1;
+
+    __u8 mac[ETH_ALEN], req_mac[ETH_ALEN];
+    __builtin_memcpy(mac, n->mac, ETH_ALEN);
+    __builtin_memcpy(req_mac, eth->h_source, ETH_ALEN);
+
+    struct oam_icmp6_pseudo ph = {};
+    __builtin_memcpy(&ph.saddr, key.addr, sizeof(ph.saddr));
+    __builtin_memcpy(&ph.daddr, &ip6h->saddr, sizeof(ph.daddr));
+    ph.len = bpf_htonl(NEIGH_NA_LEN);
+    ph.nexthdr = IPPROTO_ICMPV6;
+
+    // NS options (SLLA, nonce) are replaced by exactly one TLLA option
+    int delta = (int)(l3_off + sizeof(struct ipv6hdr) + NEIGH_NA_LEN) - (int)(data_end - data);
+    if (delta && bpf_xdp_adjust_tail(ctx, delta))
+        return -1;
+
+    data = (void *)(long)ctx->data;
+    data_end = (void *)(long)ctx->data_end;
+    eth = data;
+    if ((void *)(eth + 1) > data_end)
+        return XDP_DROP;
+    if (l3_off > 22)
+        return XDP_DROP;
+    ip6h = data + l3_off;
+    if ((void *)(ip6h + 1) > data_end)
+        return XDP_DROP;
+    icmp = (__u8 *)(ip6h + 1);
+    if ((void *)(icmp + NEIGH_NA_LEN) > data_end)
+        return XDP_DROP;
+
+    __builtin_memcpy(eth->h_dest, req_mac, ETH_ALEN);
+    __builtin_memcpy(eth->h_source, mac, ETH_ALEN);
+
+    build_outer_ipv6(ip6h, IPPROTO_ICMPV6, NEIGH_NA_LEN, &ph.saddr, &ph.daddr);
+    ip6h->hop_limit = 255;
+
+    __builtin_memset(icmp, 0, 8);
+    icmp[0] = NEIGH_ICMP6_NA;
+    icmp[4] = NEIGH_NA_FLAGS;
+    // icmp[8..23] still holds the target address
+    icmp[24] = NEIGH_ND_OPT_TLLA;
+    icmp[25] = 1;
+    __builtin_memcpy(icmp + 26, mac, ETH_ALEN);
+
+    __u64 sum = 0;
+    __u32 *pw = (__u32 *)&ph;
+    #pragma unroll
+    for (int i = 0; i < (int)(sizeof(ph) / 4); i++)
+        sum += pw[i];
+    sum += oam_icmp6_sum(ctx, l3_off + sizeof(struct ipv6hdr), NEIGH_NA_LEN);
+    __u16 csum = oam_csum_fold(sum);
+    __builtin_memcpy(icmp + 2, &csum, sizeof(csum));
+
+    STATS_INC(STATS_NEIGH_SUPPRESS, pkt_len);
+    return XDP_TX;
+}
+
+// neigh_process handles ARP and IPv6 ND frames arriving on an AC of bd_id.
+// Returns an XDP action when the frame was answered from neigh_map (the
+// request is then neither learned-through nor flooded), -1 to continue
+// with normal BD forwarding.
+static __noinline int neigh_process(struct xdp_md *ctx, __u16 bd_id, __u64 pkt_len)
+{
+    void *data = (void *)(long)ctx->data;
+    void *data_end = (void *)(long)ctx->data_end;
+
+    struct ethhdr *eth = data;
+    if ((void *)(eth + 1) > data_end)
+        return -1;
+
+    __u32 l3_off = sizeof(struct ethhdr);
+    __be16 proto = eth->h_proto;
+    #pragma unroll
+    for (int i = 0; i < 2; i++) {
+        if (proto != bpf_htons(ETH_P_8021Q) && proto != bpf_htons(ETH_P_8021AD))
+            break;
+        struct vlan_hdr *vh = data + l3_off;
+        if ((void *)(vh + 1) > data_end)
+            return -1;
+        proto = vh->h_vlan_encapsulated_proto;
+        l3_off += sizeof(struct vlan_hdr);
+    }
+
+    if (proto == bpf_htons(ETH_P_ARP))
+        return neigh_process_arp(ctx, bd_id, l3_off, pkt_len);
+    if (proto == bpf_htons(ETH_P_IPV6))
+        return neigh_process_nd(ctx, bd_id, l3_off, pkt_len);
+    return -1;
+}
+
+#endif // VINBERO_NEIGH_SUPPRESS_H
@@ -0,0 +1,201 @@
+#ifndef VINBERO_OAM_H
+#define VINBERO_OAM_H
+
+#include <linux/types.h>
+#include <linux/if_ether.h>
+#include <linux/ipv6.h>
+#include <linux/in6.h>
+#include <bpf/bpf_endian.h>
+#include <bpf/bpf_helpers.h>
+
+#include "core/xdp_prog.h"   // struct oam_punt_meta
+#include "core/xdp_map.h"    // oam_punt_events
+#include "core/xdp_stats.h"
+#include "core/srv6.h"
+#include "core/srv6_fib.h"
+#include "headend/srv6_headend_utils.h"
+
+// Frame bytes copied after oam_punt_meta. Covers Eth + VLANs + IPv6 + an
+// SRH with MAX_SEGMENTS entries + the first bytes of the upper layer.
+#define OAM_PUNT_CAP_MAX 256
+
+// ICMPv6 Time Exceeded (RFC 4443 Section 3.3)
+#define OAM_ICMP6_TIME_EXCEEDED 3
+#define OAM_ICMP6_EXC_HOPLIMIT  0
+#define OAM_ICMP6_HDR_LEN       8
+
+// Bytes of the invoking packet quoted in the error: as much as fits in
+// the IPv6 minimum MTU (RFC 4443 Section 3.3), minus IPv6 + ICMPv6 headers.
+#define OAM_ICMP6_QUOTE_MAX (1280 - 40 - OAM_ICMP6_HDR_LEN)
+
+// oam_punt sends a timestamped copy of the frame to oam_punt_events
+// (RFC 9259 Section 2.1). The original packet is left untouched so the
+// caller keeps processing it; a full perf ring only loses the copy.
+static __always_inline void oam_punt(struct xdp_md *ctx, __u8 reason, __u8 action)
+{
+    void *data = (void *)(long)ctx->data;
+    void *data_end = (void *)(long)ctx->data_end;
+    __u64 pkt_len = data_end - data;
+
+    struct oam_punt_meta meta = {
+        .timestamp_ns = bpf_ktime_get_ns(),
+        .ifindex = ctx->ingress_ifindex,
+        .pkt_len = (__u16)pkt_len,
+        .reason = reason,
+        .action = action,
+    };
+    __u64 cap_len = pkt_len < OAM_PUNT_CAP_MAX ? pkt_len : OAM_PUNT_CAP_MAX;
+    meta.cap_len = (__u16)cap_len;
+
+    bpf_perf_event_output(ctx, &oam_punt_events,
+                          BPF_F_CURRENT_CPU | (cap_len << 32),
+                          &meta, sizeof(meta));
+    STATS_INC(STATS_OAM_PUNT, pkt_len);
+}
+
+// Pseudo-header for the ICMPv6 checksum (RFC 8200 Section 8.1)
+struct oam_icmp6_pseudo {
+    struct in6_addr saddr;
+    struct in6_addr daddr;
+    __be32 len;
+    __u8 zero[3];
+    __u8 nexthdr;
+} __attribute__((packed));
+
+static __always_inline __u16 oam_csum_fold(__u64 sum)
+{
+    sum = (sum & 0xffffffff) + (sum >> 32);
+    sum = (sum & 0xffffffff) + (sum >> 32);
+    sum = (sum & 0xffff) + (sum >> 16);
+    sum = (sum & 0xffff) + (sum >> 16);
+    return (__u16)~sum;
+}
+
+// oam_icmp6_sum adds up the ICMPv6 message at icmp_off as 32-bit words;
+// byte order doesn't matter as long as the folded result is stored back
+// the same way. Global (non-static) so the verifier checks the word loop
+// once per program instead of once per caller path.
+__noinline __u64 oam_icmp6_sum(struct xdp_md *ctx, __u32 icmp_off, __u32 icmp_len)
+{
+    void *data = (void *)(long)ctx->data;
+    void *data_end = (void *)(long)ctx->data_end;
+    __u64 sum = 0;
+
+    if (icmp_off > 22 + sizeof(struct ipv6hdr))
+        return 0;
+    __u32 *qw = data + icmp_off;
+    for (int i = 0; i < (OAM_ICMP6_HDR_LEN + OAM_ICMP6_QUOTE_MAX) / 4; i++) {
+        if (i >= icmp_len / 4)
+            break;
+        if ((void *)(qw + i + 1) > data_end)
+            break;
+        sum += qw[i];
+    }
+    return sum;
+}
+
+// oam_icmp6_time_exceeded turns the packet in place into an ICMPv6 Time
+// Exceeded (hop limit exceeded in transit) addressed to the original
+// source and sourced from the SID being processed, then routes it via FIB.
+// srh_nexthdr/srh_len locate the upper layer so errors are never sent in
+// response to ICMPv6 error messages (RFC 4443 Section 2.4(e)).
+//
+// Before: [Eth][VLAN*][IPv6][SRH][Upper Layer ...]
+// After:  [Eth][VLAN*][IPv6'][ICMPv6 TE][IPv6][SRH][Upper ...] (truncated)
+static __always_inline int oam_icmp6_time_exceeded(
+    struct xdp_md *ctx,
+    __u8 srh_nexthdr,
+    __u32 srh_len,
+    __u16 l3_offset)
+{
+    void *data = (void *)(long)ctx->data;
+    void *data_end = (void *)(long)ctx->data_end;
+
+    struct ethhdr *eth = data;
+    if ((void *)(eth + 1) > data_end)
+        return XDP_DROP;
+    struct ipv6hdr *ip6h = (struct ipv6hdr *)(data + l3_offset);
+    if ((void *)(ip6h + 1) > data_end)
+        return XDP_DROP;
+
+    STATS_INC(STATS_HOP_LIMIT_EXCEEDED, data_end - data);
+
+    // No errors for multicast sources or ICMPv6 error messages
+    if (ip6h->saddr.in6_u.u6_addr8[0] == 0xff)
+        return XDP_DROP;
+    if (srh_nexthdr == IPPROTO_ICMPV6) {
+        if (srh_len > 8 + MAX_SEGMENTS * 16)
+            return XDP_DROP;
+        __u8 *icmp_type = (__u8 *)(ip6h + 1) + srh_len;
+        if ((void *)(icmp_type + 1) > data_end)
+            return XDP_DROP;
+        if (*icmp_type < 128)
+            return XDP_DROP;
+    }
+
+    struct ethhdr saved_eth;
+    __builtin_memcpy(&saved_eth, eth, sizeof(saved_eth));
+    __u32 saved_vlan[2];
+    save_vlan_tags(saved_vlan, data, data_end, l3_offset);
+
+    struct oam_icmp6_pseudo ph = {};
+    __builtin_memcpy(&ph.saddr, &ip6h->daddr, sizeof(ph.saddr));
+    __builtin_memcpy(&ph.daddr, &ip6h->saddr, sizeof(ph.daddr));
+    ph.nexthdr = IPPROTO_ICMPV6;
+
+    // Quote a whole number of 32-bit words so the checksum loop below
+    // never has to deal with a trailing partial word.
+    __u32 quote_len = (__u32)(data_end - (void *)ip6h);
+    if (quote_len > OAM_ICMP6_QUOTE_MAX)
+        quote_len = OAM_ICMP6_QUOTE_MAX;
+    quote_len &= ~3U;
+
+    int trim = (int)(l3_offset + quote_len) - (int)(data_end - data);
+    if (trim < 0 && bpf_xdp_adjust_tail(ctx, trim))
+        return XDP_DROP;
+    if (bpf_xdp_adjust_head(ctx, -(int)(sizeof(struct ipv6hdr) + OAM_ICMP6_HDR_LEN)))
+        return XDP_DROP;
+
+    data = (void *)(long)ctx->data;
+    data_end = (void *)(long)ctx->data_end;
+    eth = data;
+    if ((void *)(eth + 1) > data_end)
+        return XDP_DROP;
+    ip6h = (struct ipv6hdr *)(data + l3_offset);
+    if ((void *)(ip6h + 1) > data_end)
+        return XDP_DROP;
+    __u8 *icmp = (__u8 *)(ip6h + 1);
+    if ((void *)(icmp + OAM_ICMP6_HDR_LEN) > data_end)
+        return XDP_DROP;
+
+    // Reply goes back toward the source: swap MACs, keep VLAN tags
+    __builtin_memcpy(eth->h_dest, saved_eth.h_source, ETH_ALEN);
+    __builtin_memcpy(eth->h_source, saved_eth.h_dest, ETH_ALEN);
+    eth->h_proto = saved_eth.h_proto;
+    if (restore_vlan_tags(saved_vlan, (void *)eth, data_end, l3_offset) != 0)
+        return XDP_DROP;
+
+    __u16 icmp_len = OAM_ICMP6_HDR_LEN + quote_len;
+    build_outer_ipv6(ip6h, IPPROTO_ICMPV6, icmp_len, &ph.saddr, &ph.daddr);
+
+    __builtin_memset(icmp, 0, OAM_ICMP6_HDR_LEN);
+    icmp[0] = OAM_ICMP6_TIME_EXCEEDED;
+    icmp[1] = OAM_ICMP6_EXC_HOPLIMIT;
+
+    ph.len = bpf_htonl(icmp_len);
+    __u64 sum = 0;
+    __u32 *pw = (__u32 *)&ph;
+    #pragma unroll
+    for (int i = 0; i < (int)(sizeof(ph) / 4); i++)
+        sum += pw[i];
+    sum += oam_icmp6_sum(ctx, l3_offset + sizeof(struct ipv6hdr), icmp_len);
+    __u16 csum = oam_csum_fold(sum);
+    __builtin_memcpy(icmp + 2, &csum, sizeof(csum));
+
+    __u32 out_ifindex;
+    int fib_result = srv6_fib_lookup_and_update(ctx, ip6h, eth, &out_ifindex,
+                                                ctx->ingress_ifindex);
+    return fib_result_to_xdp_action(fib_result, out_ifindex);
+}
+
+#endif // VINBERO_OAM_H
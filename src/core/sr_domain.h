@@ -0,0 +1,54 @@
+#ifndef VINBERO_SR_DOMAIN_H
+#define VINBERO_SR_DOMAIN_H
+
+#include <linux/types.h>
+#include <linux/ipv6.h>
+#include <bpf/bpf_helpers.h>
+#include <stdbool.h>
+
+#include "core/xdp_prog.h"  // struct sr_domain_*
+#include "core/xdp_map.h"   // sr_domain_map, sr_domain_src_map, sr_domain_iif_map
+#include "core/xdp_stats.h"
+
+// RFC 8754 Section 5.1: packets from outside the SR domain must not reach
+// local SIDs. A packet is trusted when no policy covers its DA's locator,
+// or when it arrived on a trusted interface or from a trusted source prefix.
+static __always_inline bool sr_domain_trusted(struct xdp_md *ctx, struct ipv6hdr *ip6h)
+{
+    struct lpm_key_v6 lkey = { .prefixlen = 128 };
+    __builtin_memcpy(lkey.addr, &ip6h->daddr, IPV6_ADDR_LEN);
+    struct sr_domain_entry *dom = bpf_map_lookup_elem(&sr_domain_map, &lkey);
+    if (!dom)
+        return true;
+    __u32 policy_id = dom->policy_id;
+
+    struct sr_domain_iif_key ikey = {
+        .policy_id = policy_id,
+        .ifindex = ctx->ingress_ifindex,
+    };
+    if (bpf_map_lookup_elem(&sr_domain_iif_map, &ikey))
+        return true;
+
+    struct sr_domain_src_key skey = {
+        .prefixlen = 32 + 128,
+        .policy_id = policy_id,
+    };
+    __builtin_memcpy(skey.addr, &ip6h->saddr, IPV6_ADDR_LEN);
+    return bpf_map_lookup_elem(&sr_domain_src_map, &skey) != NULL;
+}
+
+// sr_domain_reject runs the boundary check for a packet whose DA matched
+// sid_function_map. Returns true (after counting STATS_SR_DOMAIN_DROP) when
+// the caller must drop the packet instead of running the SID behavior.
+static __always_inline bool sr_domain_reject(struct xdp_md *ctx, struct ipv6hdr *ip6h)
+{
+    if (sr_domain_trusted(ctx, ip6h))
+        return false;
+
+    void *data = (void *)(long)ctx->data;
+    void *data_end = (void *)(long)ctx->data_end;
+    STATS_INC(STATS_SR_DOMAIN_DROP, data_end - data);
+    return true;
+}
+
+#endif // VINBERO_SR_DOMAIN_H
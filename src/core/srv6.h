@@ -33,6 +33,7 @@ enum srv6_local_action {
     SRV6_LOCAL_ACTION_END_M_GTP4_E = 21,     // End.M.GTP4.E (SRv6 => GTP-U/IPv4)
     SRV6_LOCAL_ACTION_END_DX2V = 22,         // End.DX2V (VLAN L2 cross-connect)
     SRV6_LOCAL_ACTION_END_DT2M = 23,         // End.DT2M (L2 flood for BUM, RFC 8986 Sec.4.12)
+    SRV6_LOCAL_ACTION_END_OP = 24,           // End.OP (OAM endpoint with punt, RFC 9259 Sec.2.1.1)
 };
 
 // ========== SRv6 Local Flavor ==========
@@ -59,6 +60,25 @@ enum srv6_headend_behavior {
     SRV6_HEADEND_BEHAVIOR_H_INSERT_RED = 7,    // H.Insert.Red (Reduced SRH insertion)
 };
 
+// ========== SRv6 Encap Outer Header Fields ==========
+// Map to Srv6EncapTcMode / Srv6EncapHopLimitMode / Srv6EncapFlowLabelMode
+// enums in protobuf
+enum srv6_encap_tc_mode {
+    SRV6_ENCAP_TC_MODE_UNSPECIFIED = 0,        // Traffic Class 0
+    SRV6_ENCAP_TC_MODE_COPY = 1,               // Copy inner DSCP/ECN
+    SRV6_ENCAP_TC_MODE_SET = 2,                // Use headend_entry.tc
+};
+
+enum srv6_encap_hop_limit_mode {
+    SRV6_ENCAP_HOP_LIMIT_MODE_UNSPECIFIED = 0, // headend_entry.hop_limit, or 64
+    SRV6_ENCAP_HOP_LIMIT_MODE_PROPAGATE = 1,   // Copy inner TTL/Hop Limit
+};
+
+enum srv6_encap_flow_label_mode {
+    SRV6_ENCAP_FLOW_LABEL_MODE_UNSPECIFIED = 0, // Flow Label 0
+    SRV6_ENCAP_FLOW_LABEL_MODE_HASH = 1,        // Inner 5-tuple hash (RFC 6437)
+};
+
 // Protocol numbers
 #define IPPROTO_ROUTING 43
 
@@ -13,23 +13,29 @@
 #define FIB_RESULT_REDIRECT  0   // Success, redirect to ifindex
 #define FIB_RESULT_DROP     -1   // Drop packet (blackhole/unreachable)
 #define FIB_RESULT_PASS     -2   // Pass to kernel stack
+#define FIB_RESULT_FRAG_NEEDED -3 // Packet exceeds the egress MTU
 
-// Core IPv6 FIB lookup with explicit dst address, update Ethernet header
+// Core IPv6 FIB lookup with explicit dst address and egress MTU check
 // dst: FIB lookup destination (ip6h->daddr for normal, nexthop for End.X)
 // ifindex: L3 device index for lookup (use ctx->ingress_ifindex or VRF ifindex)
-// Returns: FIB_RESULT_REDIRECT (success), FIB_RESULT_DROP, or FIB_RESULT_PASS
+// tot_len: L3 length checked against the egress MTU (0 = no check)
+// Returns: FIB_RESULT_REDIRECT (success), FIB_RESULT_DROP, FIB_RESULT_PASS,
+// or FIB_RESULT_FRAG_NEEDED with the egress MTU in *mtu
 // On success, eth header is updated and out_ifindex is set
-static __always_inline int srv6_fib_lookup_v6_core(
+static __always_inline int srv6_fib_lookup_v6_mtu(
     struct xdp_md *ctx,
     struct ipv6hdr *ip6h,
     struct ethhdr *eth,
     __u32 *out_ifindex,
     void *dst,
-    __u32 ifindex)
+    __u32 ifindex,
+    __u16 tot_len,
+    __u32 *mtu)
 {
     struct bpf_fib_lookup fib_params = {};
     fib_params.family = AF_INET6;
     fib_params.ifindex = ifindex;
+    fib_params.tot_len = tot_len;
 
     __builtin_memcpy(fib_params.ipv6_src, &ip6h->saddr, sizeof(fib_params.ipv6_src));
     __builtin_memcpy(fib_params.ipv6_dst, dst, sizeof(fib_params.ipv6_dst));
@@ -48,11 +54,30 @@ static __always_inline int srv6_fib_lookup_v6_core(
     case BPF_FIB_LKUP_RET_PROHIBIT:
         return FIB_RESULT_DROP;
 
+    case BPF_FIB_LKUP_RET_FRAG_NEEDED:
+        if (!mtu)
+            return FIB_RESULT_PASS;
+        *mtu = fib_params.mtu_result;
+        return FIB_RESULT_FRAG_NEEDED;
+
     default:
         return FIB_RESULT_PASS;
     }
 }
 
+// Core IPv6 FIB lookup with explicit dst address, update Ethernet header
+// (no MTU check)
+static __always_inline int srv6_fib_lookup_v6_core(
+    struct xdp_md *ctx,
+    struct ipv6hdr *ip6h,
+    struct ethhdr *eth,
+    __u32 *out_ifindex,
+    void *dst,
+    __u32 ifindex)
+{
+    return srv6_fib_lookup_v6_mtu(ctx, ip6h, eth, out_ifindex, dst, ifindex, 0, NULL);
+}
+
 // Perform IPv6 FIB lookup using ip6h->daddr as destination
 static __always_inline int srv6_fib_lookup_and_update(
     struct xdp_md *ctx,
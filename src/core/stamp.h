@@ -0,0 +1,167 @@
+#ifndef VINBERO_STAMP_H
+#define VINBERO_STAMP_H
+
+#include <linux/types.h>
+#include <linux/if_ether.h>
+#include <linux/ipv6.h>
+#include <linux/in.h>
+#include <linux/udp.h>
+#include <bpf/bpf_endian.h>
+#include <bpf/bpf_helpers.h>
+
+#include "core/xdp_prog.h"   // struct stamp_*
+#include "core/xdp_map.h"    // stamp_reflector_map
+#include "core/xdp_stats.h"
+#include "core/srv6.h"
+#include "core/srv6_fib.h"
+#include "core/oam.h"        // oam_csum_fold
+#include "core/sr_domain.h"  // sr_domain_reject
+#include "endpoint/srv6_endpoint_core.h"  // endpoint_strip_srh
+
+// Error Estimate (RFC 8762 Section 4.2.1): S=0 (not synchronized to UTC),
+// Z=1 (PTPv2 timestamp format), Scale=0, Multiplier=1.
+#define STAMP_ERR_EST 0x4001
+
+// RFC 8762 Section 4.3: reflected packets are sent with TTL/Hop Limit 255
+#define STAMP_REFLECT_HOP_LIMIT 255
+
+#define STAMP_NSEC_PER_SEC 1000000000ULL
+
+// stamp_now converts a bpf_ktime_get_ns() value to Unix time using the
+// offset userspace stored with the reflector.
+static __always_inline __u64 stamp_now(struct stamp_reflector_entry *entry, __u64 ktime_ns)
+{
+    return ktime_ns + entry->clock_offset_ns;
+}
+
+// process_stamp_reflect answers unauthenticated STAMP test packets sent to
+// a configured reflector address/port in stateless mode (RFC 8762 Section
+// 4.3.1): the payload is rewritten in place with T2/T3 from
+// bpf_ktime_get_ns(), addresses and ports are swapped and the reply is
+// routed back via FIB. A test packet that arrived over an SR policy
+// (SRH with SL=0, RFC 9503) has its SRH removed; the reply takes the
+// IGP path to the Session-Sender. Such a packet runs the SR domain check
+// first, as it would at a local SID, so an untrusted sender cannot use
+// the reflector to get an SRH past the boundary.
+//
+// Returns XDP_PASS for anything that is not a STAMP test packet for a
+// local reflector so the caller continues with the normal pipeline.
+// Out of line to keep its stack frame off vinbero_main's deepest call chain.
+static __noinline int process_stamp_reflect(
+    struct xdp_md *ctx,
+    struct ipv6hdr *ip6h,
+    __u16 l3_offset)
+{
+    __u64 rx_ns = bpf_ktime_get_ns();
+    void *data_end = (void *)(long)ctx->data_end;
+    void *l4 = (void *)(ip6h + 1);
+    struct ipv6_sr_hdr *srh = NULL;
+
+    if (ip6h->nexthdr == IPPROTO_ROUTING) {
+        srh = l4;
+        if ((void *)srh + 8 > data_end)
+            return XDP_PASS;
+        if (srh->type != IPV6_SRCRT_TYPE_4 || srh->segments_left != 0 ||
+            srh->nexthdr != IPPROTO_UDP)
+            return XDP_PASS;
+        __u32 srh_len = 8 + srh->hdrlen * 8;
+        if (srh_len > 8 + MAX_SEGMENTS * IPV6_ADDR_LEN)
+            return XDP_PASS;
+        l4 += srh_len;
+    } else if (ip6h->nexthdr != IPPROTO_UDP) {
+        return XDP_PASS;
+    }
+
+    struct udphdr *udp = l4;
+    if ((void *)(udp + 1) + sizeof(struct stamp_reflect_pkt) > data_end)
+        return XDP_PASS;
+    if (bpf_ntohs(udp->len) < sizeof(*udp) + sizeof(struct stamp_reflect_pkt))
+        return XDP_PASS;
+
+    struct stamp_reflector_key key = { .port = bpf_ntohs(udp->dest) };
+    __builtin_memcpy(key.addr, &ip6h->daddr, IPV6_ADDR_LEN);
+    struct stamp_reflector_entry *entry = bpf_map_lookup_elem(&stamp_reflector_map, &key);
+    if (!entry)
+        return XDP_PASS;
+
+    if (srh && sr_domain_reject(ctx, ip6h))
+        return XDP_DROP;
+
+    __u8 sender_ttl = ip6h->hop_limit;
+
+    if (srh) {
+        struct endpoint_ctx ectx = {
+            .ctx = ctx,
+            .ip6h = ip6h,
+            .srh = srh,
+            .data_end = data_end,
+            .l3_offset = l3_offset,
+        };
+        if (endpoint_strip_srh(&ectx) != 0)
+            return XDP_DROP;
+    }
+
+    void *data = (void *)(long)ctx->data;
+    data_end = (void *)(long)ctx->data_end;
+    struct ethhdr *eth = data;
+    if ((void *)(eth + 1) > data_end)
+        return XDP_DROP;
+    ip6h = (struct ipv6hdr *)(data + l3_offset);
+    if ((void *)(ip6h + 1) > data_end)
+        return XDP_DROP;
+    udp = (struct udphdr *)(ip6h + 1);
+    if ((void *)(udp + 1) > data_end)
+        return XDP_DROP;
+    struct stamp_reflect_pkt *pkt = (struct stamp_reflect_pkt *)(udp + 1);
+    if ((void *)(pkt + 1) > data_end)
+        return XDP_DROP;
+
+    // The Session-Sender fields are read before the payload is overwritten;
+    // both layouts are 44 bytes so the UDP length stays the same.
+    struct stamp_reflect_pkt old;
+    __builtin_memcpy(&old, pkt, sizeof(old));
+    struct stamp_test_pkt *tp = (struct stamp_test_pkt *)&old;
+
+    struct stamp_reflect_pkt rp = {};
+    rp.seq = tp->seq;
+    rp.err_est = bpf_htons(STAMP_ERR_EST);
+    __u64 t2 = stamp_now(entry, rx_ns);
+    rp.rx_sec = bpf_htonl((__u32)(t2 / STAMP_NSEC_PER_SEC));
+    rp.rx_nsec = bpf_htonl((__u32)(t2 % STAMP_NSEC_PER_SEC));
+    rp.sender_seq = tp->seq;
+    rp.sender_ts_sec = tp->ts_sec;
+    rp.sender_ts_nsec = tp->ts_nsec;
+    rp.sender_err_est = tp->err_est;
+    rp.sender_ttl = sender_ttl;
+    __u64 t3 = stamp_now(entry, bpf_ktime_get_ns());
+    rp.ts_sec = bpf_htonl((__u32)(t3 / STAMP_NSEC_PER_SEC));
+    rp.ts_nsec = bpf_htonl((__u32)(t3 % STAMP_NSEC_PER_SEC));
+    __builtin_memcpy(pkt, &rp, sizeof(rp));
+
+    // Swapping addresses and ports leaves the pseudo-header sum unchanged,
+    // so only the payload delta goes into the checksum.
+    __s64 sum = bpf_csum_diff((__be32 *)&old, sizeof(old), (__be32 *)&rp, sizeof(rp),
+                              ~((__u32)udp->check) & 0xffff);
+    __u16 csum = oam_csum_fold((__u64)sum);
+    udp->check = csum ? csum : 0xffff;
+
+    __be16 port = udp->source;
+    udp->source = udp->dest;
+    udp->dest = port;
+
+    struct in6_addr addr;
+    __builtin_memcpy(&addr, &ip6h->saddr, sizeof(addr));
+    __builtin_memcpy(&ip6h->saddr, &ip6h->daddr, sizeof(addr));
+    __builtin_memcpy(&ip6h->daddr, &addr, sizeof(addr));
+    ip6h->hop_limit = STAMP_REFLECT_HOP_LIMIT;
+
+    __sync_fetch_and_add(&entry->reflected, 1);
+    STATS_INC(STATS_STAMP_REFLECT, data_end - data);
+
+    __u32 out_ifindex;
+    int fib_result = srv6_fib_lookup_and_update(ctx, ip6h, eth, &out_ifindex,
+                                                ctx->ingress_ifindex);
+    return fib_result_to_xdp_action(fib_result, out_ifindex);
+}
+
+#endif // VINBERO_STAMP_H
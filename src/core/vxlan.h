@@ -0,0 +1,214 @@
+#ifndef VINBERO_VXLAN_H
+#define VINBERO_VXLAN_H
+
+#include <linux/types.h>
+#include <linux/bpf.h>
+#include <linux/if_ether.h>
+#include <linux/ip.h>
+#include <linux/ipv6.h>
+#include <linux/in.h>
+#include <linux/udp.h>
+#include <bpf/bpf_endian.h>
+#include <bpf/bpf_helpers.h>
+#include <stdbool.h>
+
+#include "core/xdp_prog.h"   // struct vni_entry, struct vtep_key / vtep_entry
+#include "core/xdp_map.h"    // vni_map, bd_vni_map, vtep_map
+#include "core/xdp_stats.h"
+#include "core/ac_vlan.h"
+#include "core/srv6_fib.h"
+#include "headend/srv6_headend_utils.h"
+#include "l2vpn/bum_meta.h"
+
+// VXLAN (RFC 7348) encapsulation for the VXLAN <-> SRv6 gateway.
+
+#define VXLAN_PORT   4789
+#define VXLAN_FLAG_I 0x08  // VNI field is valid
+
+struct vxlan_hdr {
+    __u8 flags;
+    __u8 reserved1[3];
+    __be32 vni;            // VNI in the upper 24 bits, reserved low byte
+} __attribute__((packed));
+
+// Headers prepended in front of the inner Ethernet frame
+#define VXLAN_ENCAP_LEN_V4 (sizeof(struct ethhdr) + sizeof(struct iphdr) + \
+                            sizeof(struct udphdr) + sizeof(struct vxlan_hdr))
+#define VXLAN_ENCAP_LEN_V6 (sizeof(struct ethhdr) + sizeof(struct ipv6hdr) + \
+                            sizeof(struct udphdr) + sizeof(struct vxlan_hdr))
+
+// UDP source port from the inner MAC addresses, in the dynamic range
+// (RFC 7348 Section 5) so underlay ECMP spreads the inner flows.
+static __always_inline __be16 vxlan_src_port(const __u8 macs[12])
+{
+    __u32 h = *(const __u32 *)macs ^ *(const __u32 *)(macs + 4) ^
+              *(const __u32 *)(macs + 8);
+    h ^= h >> 16;
+    return bpf_htons(49152 + (h & 0x3FFF));
+}
+
+// Fill the outer Ethernet / IP / UDP / VXLAN headers at data for an inner
+// frame of inner_len bytes toward dst. Addresses of an IPv4 underlay are
+// IPv4-mapped; only their last four bytes go on the wire. The UDP checksum
+// is zero for both families; an IPv6 receiver needs udp6zerocsumrx
+// (RFC 6935), as the Linux VXLAN device does.
+// Returns 0 on success, -1 if the headers don't fit.
+static __always_inline int vxlan_build_outer(
+    void *data,
+    void *data_end,
+    const struct vni_entry *vn,
+    const __u8 dst[IPV6_ADDR_LEN],
+    __u32 vni,
+    __u16 inner_len,
+    __be16 sport)
+{
+    struct ethhdr *eth = data;
+    struct udphdr *udph;
+    __u16 udp_len = sizeof(struct udphdr) + sizeof(struct vxlan_hdr) + inner_len;
+
+    if ((void *)(eth + 1) > data_end)
+        return -1;
+    __builtin_memset(eth->h_dest, 0, ETH_ALEN);
+    __builtin_memset(eth->h_source, 0, ETH_ALEN);
+
+    if (vn->is_ipv4) {
+        if (data + VXLAN_ENCAP_LEN_V4 > data_end)
+            return -1;
+        eth->h_proto = bpf_htons(ETH_P_IP);
+
+        struct iphdr *iph = (struct iphdr *)(eth + 1);
+        iph->version = 4;
+        iph->ihl = 5;
+        iph->tos = 0;
+        iph->tot_len = bpf_htons(sizeof(struct iphdr) + udp_len);
+        iph->id = 0;
+        iph->frag_off = bpf_htons(0x4000);  // DF bit
+        iph->ttl = 64;
+        iph->protocol = IPPROTO_UDP;
+        iph->check = 0;
+        __builtin_memcpy(&iph->saddr, vn->local_addr + 12, 4);
+        __builtin_memcpy(&iph->daddr, dst + 12, 4);
+
+        // IPv4 checksum (fixed 20-byte header, no options)
+        __u32 csum = 0;
+        __u16 *hdr16 = (__u16 *)iph;
+        #pragma unroll
+        for (int i = 0; i < 10; i++)
+            csum += hdr16[i];
+        csum = (csum >> 16) + (csum & 0xFFFF);
+        csum += (csum >> 16);
+        iph->check = (__u16)~csum;
+
+        udph = (struct udphdr *)(iph + 1);
+    } else {
+        if (data + VXLAN_ENCAP_LEN_V6 > data_end)
+            return -1;
+        eth->h_proto = bpf_htons(ETH_P_IPV6);
+
+        struct ipv6hdr *ip6h = (struct ipv6hdr *)(eth + 1);
+        build_outer_ipv6(ip6h, IPPROTO_UDP, udp_len, vn->local_addr, dst);
+        udph = (struct udphdr *)(ip6h + 1);
+    }
+
+    udph->source = sport;
+    udph->dest = bpf_htons(VXLAN_PORT);
+    udph->len = bpf_htons(udp_len);
+    udph->check = 0;
+
+    struct vxlan_hdr *vxh = (struct vxlan_hdr *)(udph + 1);
+    vxh->flags = VXLAN_FLAG_I;
+    vxh->reserved1[0] = 0;
+    vxh->reserved1[1] = 0;
+    vxh->reserved1[2] = 0;
+    vxh->vni = bpf_htonl(vni << 8);
+    return 0;
+}
+
+// Resolve the VNI, VNI mapping and remote VTEP for index of bd_id.
+// Returns NULL when the BD isn't mapped or the VTEP slot is empty.
+static __always_inline struct vtep_entry *vxlan_vtep_lookup(
+    __u16 bd_id,
+    __u16 index,
+    __u32 *vni,
+    struct vni_entry **vn)
+{
+    __u32 *vnip = bpf_map_lookup_elem(&bd_vni_map, &bd_id);
+    if (!vnip)
+        return NULL;
+    *vni = *vnip;
+    *vn = bpf_map_lookup_elem(&vni_map, vni);
+    if (!*vn || (*vn)->bd_id != bd_id)
+        return NULL;
+    struct vtep_key vk = { .bd_id = bd_id, .index = index };
+    return bpf_map_lookup_elem(&vtep_map, &vk);
+}
+
+// XDP: VXLAN-encapsulate the Ethernet frame at ctx->data toward VTEP index
+// of bd_id and redirect it along the underlay FIB. Used for remote FDB
+// entries learned behind a VTEP (fdb_entry.vtep).
+static __noinline int vxlan_encap_xdp(struct xdp_md *ctx, __u16 bd_id, __u16 index)
+{
+    __u32 vni;
+    struct vni_entry *vn;
+    struct vtep_entry *vt = vxlan_vtep_lookup(bd_id, index, &vni, &vn);
+    if (!vt)
+        return XDP_DROP;
+
+    void *data = (void *)(long)ctx->data;
+    void *data_end = (void *)(long)ctx->data_end;
+    if (data + 12 > data_end)
+        return XDP_DROP;
+    __u16 inner_len = data_end - data;
+    __be16 sport = vxlan_src_port(data);
+
+    int hdr_len = vn->is_ipv4 ? VXLAN_ENCAP_LEN_V4 : VXLAN_ENCAP_LEN_V6;
+    if (bpf_xdp_adjust_head(ctx, -hdr_len))
+        return XDP_DROP;
+
+    data = (void *)(long)ctx->data;
+    data_end = (void *)(long)ctx->data_end;
+    if (vxlan_build_outer(data, data_end, vn, vt->addr, vni, inner_len, sport) != 0)
+        return XDP_DROP;
+
+    STATS_INC(STATS_VXLAN_ENCAP, inner_len);
+
+    // After encap, must not return XDP_PASS (stale pointers in caller)
+    struct ethhdr *eth = data;
+    int action;
+    if (vn->is_ipv4) {
+        struct iphdr *iph = (struct iphdr *)(eth + 1);
+        if ((void *)(iph + 1) > data_end)
+            return XDP_DROP;
+        action = srv6_fib_redirect_v4(ctx, iph, eth, ctx->ingress_ifindex);
+    } else {
+        struct ipv6hdr *ip6h = (struct ipv6hdr *)(eth + 1);
+        if ((void *)(ip6h + 1) > data_end)
+            return XDP_DROP;
+        action = srv6_fib_redirect(ctx, ip6h, eth, ctx->ingress_ifindex);
+    }
+    return (action == XDP_PASS) ? XDP_DROP : action;
+}
+
+// Outer VLAN ID of the Ethernet frame at ctx->data, 0 when untagged.
+// Gateway BUM metadata carries it so TC can re-insert the tag the kernel
+// moves to skb->vlan_tci.
+static __always_inline __u16 eth_frame_vlan(struct xdp_md *ctx)
+{
+    void *data = (void *)(long)ctx->data;
+    void *data_end = (void *)(long)ctx->data_end;
+    struct ethhdr *eth = data;
+    if ((void *)(eth + 1) > data_end || !eth_proto_is_vlan(eth->h_proto))
+        return 0;
+    struct vlan_hdr *vh = (struct vlan_hdr *)(eth + 1);
+    if ((void *)(vh + 1) > data_end)
+        return 0;
+    return bpf_ntohs(vh->h_vlan_TCI) & VLAN_VID_MASK;
+}
+
+// True when bd_id is bridged to a VNI, i.e. its BUM also goes to VTEPs
+static __always_inline bool vxlan_bd_mapped(__u16 bd_id)
+{
+    return bpf_map_lookup_elem(&bd_vni_map, &bd_id) != NULL;
+}
+
+#endif // VINBERO_VXLAN_H
@@ -54,7 +54,7 @@ struct {
 // End.B6 policy: stored in sid_aux_map (b6_policy variant), no separate map needed.
 
 // Headend L2 map (Hash)
-// Key: VLAN ID (future: consider Bridge Domain with ifindex+VLAN)
+// Key: ifindex + outer VLAN + inner VLAN (the attachment circuit)
 // Value: Headend configuration (H.Encaps.L2)
 struct {
     __uint(type, BPF_MAP_TYPE_HASH);
@@ -63,6 +63,16 @@ struct {
     __uint(max_entries, 1024);
 } headend_l2_map SEC(".maps");
 
+// AC VLAN ranges per port (Hash)
+// Key: ifindex
+// Value: ranges whose ACs live in headend_l2_map under (ifindex, first, 0)
+struct {
+    __uint(type, BPF_MAP_TYPE_HASH);
+    __type(key, __u32);
+    __type(value, struct ac_vlan_ranges);
+    __uint(max_entries, 256);
+} ac_vlan_range_map SEC(".maps");
+
 // FDB map (Hash) for End.DT2 L2VPN forwarding database
 // Key: Bridge Domain ID + MAC address
 // Value: output interface + flags
@@ -73,6 +83,82 @@ struct {
     __uint(max_entries, 8192);
 } fdb_map SEC(".maps");
 
+// FDB aging events (ring buffer): one fdb_aged_event per entry removed by
+// its aging timer. Sized for a burst of ~26k events.
+struct {
+    __uint(type, BPF_MAP_TYPE_RINGBUF);
+    __uint(max_entries, 1 << 20);
+} fdb_aging_events SEC(".maps");
+
+// MAC learning controls per bridge domain and per AC
+// Key: {0, 0, bd_id} for a BD, {ifindex, vlan_id, 0} for an AC
+// Value: max_macs, learning on/off, action at the limit
+struct {
+    __uint(type, BPF_MAP_TYPE_HASH);
+    __type(key, struct mac_limit_key);
+    __type(value, struct mac_limit_entry);
+    __uint(max_entries, 1024);
+} mac_limit_map SEC(".maps");
+
+// Dynamic MACs currently in fdb_map per BD and per AC (same keys as
+// mac_limit_map). Signed so a transient miscount cannot wrap to a value
+// that blocks learning; rebuilt from fdb_map by userspace on startup.
+struct {
+    __uint(type, BPF_MAP_TYPE_HASH);
+    __type(key, struct mac_limit_key);
+    __type(value, __s64);
+    __uint(max_entries, 2048);
+} mac_count_map SEC(".maps");
+
+// BUM storm control per bridge domain and per AC (same keys as
+// mac_limit_map). Checked by the TC BUM path before replication.
+struct {
+    __uint(type, BPF_MAP_TYPE_HASH);
+    __type(key, struct mac_limit_key);
+    __type(value, struct storm_ctl_entry);
+    __uint(max_entries, 1024);
+} storm_ctl_map SEC(".maps");
+
+// ARP/ND suppression table per bridge domain
+// Key: Bridge Domain ID + IP address (IPv4-mapped for IPv4)
+// Value: MAC address + origin. LRU so snooped entries cannot exhaust it;
+// an evicted entry only means the next request is flooded again.
+struct {
+    __uint(type, BPF_MAP_TYPE_LRU_HASH);
+    __type(key, struct neigh_key);
+    __type(value, struct neigh_entry);
+    __uint(max_entries, 16384);
+} neigh_map SEC(".maps");
+
+// Integrated routing and bridging (IRB) per bridge domain
+// Key: Bridge Domain ID
+// Value: VRF ifindex + anycast gateway MAC
+struct {
+    __uint(type, BPF_MAP_TYPE_HASH);
+    __type(key, __u16);
+    __type(value, struct bd_irb_entry);
+    __uint(max_entries, 4096);
+} bd_irb_map SEC(".maps");
+
+// IRB routes per VRF (IPv4 / IPv6 LPM Trie): host routes from RT2 MAC+IP
+// and prefixes whose traffic leaves a BD through the anycast gateway.
+// Value: H.Encaps / H.Encaps.Red to the remote PE's End.DT4/DT6 SID
+struct {
+    __uint(type, BPF_MAP_TYPE_LPM_TRIE);
+    __type(key, struct irb_route_key_v4);
+    __type(value, struct headend_entry);
+    __uint(max_entries, 16384);
+    __uint(map_flags, BPF_F_NO_PREALLOC);
+} irb_route_v4_map SEC(".maps");
+
+struct {
+    __uint(type, BPF_MAP_TYPE_LPM_TRIE);
+    __type(key, struct irb_route_key_v6);
+    __type(value, struct headend_entry);
+    __uint(max_entries, 16384);
+    __uint(map_flags, BPF_F_NO_PREALLOC);
+} irb_route_v6_map SEC(".maps");
+
 // VLAN cross-connect map (Hash) for End.DX2V
 // Key: table_id + VLAN ID
 // Value: output interface index
@@ -93,6 +179,16 @@ struct {
     __uint(max_entries, 1024);
 } bd_peer_map SEC(".maps");
 
+// BD Peer span map: bd_id → highest used peer index + 1
+// Maintained by userspace alongside bd_peer_map so the TC BUM flood only
+// walks the occupied index range of a BD.
+struct {
+    __uint(type, BPF_MAP_TYPE_HASH);
+    __type(key, __u16);
+    __type(value, __u16);
+    __uint(max_entries, 1024);
+} bd_peer_span_map SEC(".maps");
+
 // BD Peer reverse map: {bd_id, src_addr} → peer_index
 // Populated by userspace alongside bd_peer_map.
 // Used by End.DT2 for O(1) peer_index resolution during remote MAC learning.
@@ -120,7 +216,7 @@ struct {
     __uint(max_entries, 1024);
 } bd_peer_l2_ext_map SEC(".maps");
 
-// Local AC source ESI side table: (ifindex, vlan_id) → ESI. Paired with headend_l2_map.
+// Local AC side table: AC → source ESI and egress VLAN rewrite. Paired with headend_l2_map.
 struct {
     __uint(type, BPF_MAP_TYPE_HASH);
     __type(key, struct headend_l2_key);
@@ -139,6 +235,91 @@ struct {
     __uint(max_entries, 512);
 } bd_local_esi_map SEC(".maps");
 
+// VXLAN gateway: VNI -> BD and local VTEP (VniMappingService)
+struct {
+    __uint(type, BPF_MAP_TYPE_HASH);
+    __type(key, __u32 /* vni */);
+    __type(value, struct vni_entry);
+    __uint(max_entries, 1024);
+} vni_map SEC(".maps");
+
+// VXLAN gateway: BD -> VNI, the reverse of vni_map for encapsulation
+struct {
+    __uint(type, BPF_MAP_TYPE_HASH);
+    __type(key, __u16 /* bd_id */);
+    __type(value, __u32 /* vni */);
+    __uint(max_entries, 1024);
+} bd_vni_map SEC(".maps");
+
+// VXLAN gateway: remote VTEPs of a mapped BD, flooded in index order
+struct {
+    __uint(type, BPF_MAP_TYPE_HASH);
+    __type(key, struct vtep_key);
+    __type(value, struct vtep_entry);
+    __uint(max_entries, 4096);
+} vtep_map SEC(".maps");
+
+// VXLAN gateway: {bd_id, VTEP address} -> index, paired with vtep_map
+struct {
+    __uint(type, BPF_MAP_TYPE_HASH);
+    __type(key, struct vtep_reverse_key);
+    __type(value, __u16);
+    __uint(max_entries, 4096);
+} vtep_reverse_map SEC(".maps");
+
+// SR domain boundary policies (IPv6 LPM Trie)
+// Key: locator prefix
+// Value: policy_id scoping the trusted source / ingress tables below
+struct {
+    __uint(type, BPF_MAP_TYPE_LPM_TRIE);
+    __type(key, struct lpm_key_v6);
+    __type(value, struct sr_domain_entry);
+    __uint(max_entries, 256);
+    __uint(map_flags, BPF_F_NO_PREALLOC);
+} sr_domain_map SEC(".maps");
+
+// SR domain trusted source prefixes (LPM Trie)
+// Key: policy_id + IPv6 source prefix
+// Value: unused (presence = trusted)
+struct {
+    __uint(type, BPF_MAP_TYPE_LPM_TRIE);
+    __type(key, struct sr_domain_src_key);
+    __type(value, __u8);
+    __uint(max_entries, 1024);
+    __uint(map_flags, BPF_F_NO_PREALLOC);
+} sr_domain_src_map SEC(".maps");
+
+// SR domain trusted ingress interfaces (Hash)
+// Key: policy_id + ingress ifindex
+// Value: unused (presence = trusted)
+struct {
+    __uint(type, BPF_MAP_TYPE_HASH);
+    __type(key, struct sr_domain_iif_key);
+    __type(value, __u8);
+    __uint(max_entries, 1024);
+} sr_domain_iif_map SEC(".maps");
+
+// ========== SRv6 OAM ==========
+
+// Timestamped copies of O-flag and End.OP packets punted to userspace.
+// Each record is struct oam_punt_meta followed by the captured frame.
+// max_entries is left at 0 so the loader sizes it to the number of CPUs.
+struct {
+    __uint(type, BPF_MAP_TYPE_PERF_EVENT_ARRAY);
+    __uint(key_size, sizeof(__u32));
+    __uint(value_size, sizeof(__u32));
+} oam_punt_events SEC(".maps");
+
+// STAMP session reflectors answered in XDP (RFC 8762)
+// Key: reflector address + UDP port
+// Value: clock offset for timestamps + reflected packet counter
+struct {
+    __uint(type, BPF_MAP_TYPE_HASH);
+    __type(key, struct stamp_reflector_key);
+    __type(value, struct stamp_reflector_entry);
+    __uint(max_entries, 64);
+} stamp_reflector_map SEC(".maps");
+
 // Per-CPU scratch buffer for mid-packet editing (e.g., End.M.GTP6.D header save/restore).
 // Used to work around BPF stack limit (512 bytes) by storing temporary data in map memory.
 // Max size covers ETH(14) + IPv6(40) + SRH(8 + MAX_SEGMENTS*16 = 168) = 222 bytes.
@@ -96,13 +96,48 @@ struct lpm_key_v6 {
     __u8 addr[IPV6_ADDR_LEN];     // IPv6 address (16 bytes)
 } __attribute__((packed));
 
-// Key for L2 headend map (port + VLAN)
+// Key for L2 headend map (port + VLAN). This is the attachment circuit (AC)
+// identity; a VLAN range AC is stored under the first VLAN of its range and
+// a port-mode AC under (ifindex, HEADEND_L2_VLAN_ANY, 0).
 struct headend_l2_key {
     __u32 ifindex;                // Ingress port ifindex
-    __u16 vlan_id;                // VLAN ID (0 = untagged)
-    __u8 _pad[2];
+    __u16 vlan_id;                // Outer VLAN ID (0 = untagged)
+    __u16 inner_vlan_id;          // Inner (C-tag) VLAN ID under vlan_id (0 = any)
+} __attribute__((packed));
+
+// Wildcard vlan_id of a port-mode AC: every frame on the port, tagged or
+// not, that no more specific AC claims. Outside the 12-bit VID space.
+#define HEADEND_L2_VLAN_ANY 0xFFFF
+
+// Up to AC_VLAN_RANGES_MAX VLAN range ACs per port, matched after the
+// exact (ifindex, vlan_id) lookup misses.
+#define AC_VLAN_RANGES_MAX 8
+
+struct ac_vlan_range {
+    __u16 first;                  // First VLAN of the range (0 = unused slot)
+    __u16 last;                   // Last VLAN of the range (inclusive)
 } __attribute__((packed));
 
+// Value for ac_vlan_range_map (key: ifindex)
+struct ac_vlan_ranges {
+    struct ac_vlan_range range[AC_VLAN_RANGES_MAX];
+} __attribute__((packed));
+
+// VLAN tag rewrite applied to a frame leaving through an AC
+enum vlan_rewrite_op {
+    VLAN_REWRITE_NONE      = 0,
+    VLAN_REWRITE_POP       = 1,   // Remove the outer tag
+    VLAN_REWRITE_PUSH      = 2,   // Add an outer tag (802.1ad when the frame is already tagged)
+    VLAN_REWRITE_TRANSLATE = 3,   // Replace the outer (and optionally inner) VLAN ID
+};
+
+struct vlan_rewrite {
+    __u8 op;                      // enum vlan_rewrite_op
+    __u8 _pad;
+    __u16 vlan_id;                // PUSH / TRANSLATE: new outer VLAN ID
+    __u16 inner_vlan_id;          // TRANSLATE: new inner VLAN ID (0 = keep)
+} __attribute__((packed));        // 6 bytes
+
 // Key for VLAN cross-connect table (End.DX2V)
 struct dx2v_key {
     __u16 table_id;    // VLAN table ID (user-configured scope)
@@ -112,6 +147,7 @@ struct dx2v_key {
 // Value for VLAN cross-connect table (End.DX2V)
 struct dx2v_entry {
     __u32 oif;         // Output interface index
+    struct vlan_rewrite rewrite; // Applied before the frame leaves on oif
 } __attribute__((packed));
 
 // Headend entry (for H.Encaps, H.Insert, etc.)
@@ -119,18 +155,22 @@ struct dx2v_entry {
 struct headend_entry {
     __u8 mode;                              // srv6_headend_behavior enum
     __u8 num_segments;                      // Number of segments (1-10)
-    __u8 _pad[2];                           // Padding for alignment
+    __u16 mtu;                              // Egress MTU for H.Encaps (0 = interface MTU only)
     __u8 src_addr[IPV6_ADDR_LEN];           // Outer IPv6 source address
     __u8 dst_addr[IPV6_ADDR_LEN];           // Unused for H.Encaps (reserved)
     __u8 segments[MAX_SEGMENTS][IPV6_ADDR_LEN]; // SID list (up to 10 segments)
     __u16 bd_id;                            // Bridge Domain ID (for H.Encaps.L2)
     __u8 args_offset;                       // Args byte offset within SID (RFC 9433 Args.Mob.Session)
-    __u8 _pad_gtp;
+    __u8 tc_mode;                           // srv6_encap_tc_mode enum
+    __u8 tc;                                // Outer Traffic Class for SRV6_ENCAP_TC_MODE_SET
+    __u8 hop_limit_mode;                    // srv6_encap_hop_limit_mode enum
+    __u8 hop_limit;                         // Fixed outer Hop Limit (0 = 64)
+    __u8 flow_label_mode;                   // srv6_encap_flow_label_mode enum
 } __attribute__((packed));
 
 // Capacity of the plugin_raw variant in sid_aux_entry. Mirrored on the Go
 // side as bpf.SidAuxPluginRawMax.
-#define SID_AUX_PLUGIN_RAW_MAX 196
+#define SID_AUX_PLUGIN_RAW_MAX 200
 
 // SID Function entry – generic fields (LPM trie value, kept small).
 // aux_index == 0 is the sentinel for "no aux data"; action-specific fields
@@ -139,11 +179,16 @@ struct sid_function_entry {
     __u8 action;                  // srv6_local_action enum
     __u8 flavor;                  // srv6_local_flavor enum (PSP, USP, USD)
     __u16 aux_index;              // Index into sid_aux_map (0 = no aux)
+    __u8 flags;                   // SID_FLAG_* bits
+    __u8 _pad[3];
 } __attribute__((packed));
 
+// sid_function_entry.flags
+#define SID_FLAG_ECN_PROPAGATE (1 << 0) // Decap: copy outer ECN-CE into the inner header (RFC 6040)
+
 // SID Auxiliary entry – action-specific fields (ARRAY map value)
 // Discriminated by sid_function_entry.action.
-// Max size = headend_entry (196 bytes) for End.B6/B6.Encaps policy.
+// Max size = headend_entry (200 bytes) for End.B6/B6.Encaps policy.
 struct sid_aux_entry {
     union {
         // End.X, End.DX2: nexthop address (DX2 stores OIF in first 4 bytes)
@@ -187,7 +232,7 @@ struct sid_aux_entry {
 
         // End.B6/End.B6.Encaps: policy headend configuration
         // Replaces the former end_b6_policy_map (LPM trie).
-        struct headend_entry b6_policy;                    // 196 bytes
+        struct headend_entry b6_policy;                    // 200 bytes
 
         // End.T/DT4/DT6/DT46: VRF-aware FIB lookup target.
         struct {
@@ -218,14 +263,112 @@ struct fdb_entry {
     __u8 is_static;                // 1=static (never aged out), 0=dynamic (BPF-learned)
     __u16 peer_index;              // bd_peer_map index (when is_remote=1)
     __u16 bd_id;                   // BD ID for bd_peer_map lookup (when is_remote=1)
-    __u8 _pad[2];
+    __u8 aging_armed;              // 1=aging timer running (set by the data plane)
+    __u8 vtep;                     // Remote only: 1=peer_index is a vtep_map index (VXLAN gateway)
     __u64 last_seen;               // bpf_ktime_get_ns() timestamp (0=static entry)
     __u8 esi[ESI_LEN];             // Remote only: ES this MAC was learned from (all-zero = single-homing)
-    __u8 _pad_esi[2];
-} __attribute__((packed));         // 32 bytes total
+    __u16 ac_vlan_id;              // Local only: VLAN of the learning AC (oif, ac_vlan_id) for MAC limits
+    __u16 ac_inner_vlan_id;        // Local only: inner VLAN of the learning AC
+    struct vlan_rewrite rewrite;   // Local only: egress rewrite overriding the AC's (op NONE = use the AC's)
+    struct bpf_timer timer;        // Aging timer (core/fdb_aging.h)
+} __attribute__((packed, aligned(8)));  // 56 bytes total; aligned so timer sits at an 8-byte offset
+
+// Event written to fdb_aging_events when the data plane ages out a
+// dynamic FDB entry, so userspace can withdraw the MAC (e.g. EVPN RT2).
+struct fdb_aged_event {
+    __u64 last_seen;               // last_seen of the removed entry
+    __u32 oif;
+    __u16 bd_id;
+    __u16 peer_index;
+    __u8 mac[ETH_ALEN];
+    __u8 is_remote;
+    __u8 _pad;
+    __u8 esi[ESI_LEN];
+    __u8 _pad_esi[6];
+} __attribute__((packed));         // 40 bytes total
+
+// Action when a BD or AC is at its MAC limit
+enum mac_limit_action {
+    MAC_LIMIT_ACTION_DROP  = 0,    // Drop frames from new source MACs
+    MAC_LIMIT_ACTION_FLOOD = 1,    // Forward them without learning
+};
+
+// Key for mac_limit_map / mac_count_map. ifindex == 0 selects a whole
+// bridge domain (bd_id); otherwise the AC (ifindex, vlan_id) with bd_id 0.
+struct mac_limit_key {
+    __u32 ifindex;
+    __u16 vlan_id;
+    __u16 bd_id;
+} __attribute__((packed));
+
+// Value for mac_limit_map: learning controls of one BD or AC
+struct mac_limit_entry {
+    __u32 max_macs;                // Dynamic MACs allowed (0 = unlimited)
+    __u8 learning_disabled;        // 1 = never learn source MACs
+    __u8 limit_action;             // enum mac_limit_action
+    __u8 _pad[2];
+} __attribute__((packed));
+
+// Value for storm_ctl_map: BUM rate limit of one BD or AC (same keys as
+// mac_limit_map). Userspace writes the rates with the state zeroed, which
+// starts both buckets full. Not packed: bpf_spin_lock must be aligned.
+struct storm_ctl_entry {
+    struct bpf_spin_lock lock;
+    __u32 _pad;
+    __u64 pps;                     // BUM packets per second (0 = unlimited)
+    __u64 bps;                     // BUM bytes per second (0 = unlimited)
+    __u64 last_ns;                 // bpf_ktime_get_ns() of the last refill
+    __u64 pkt_credit_ps;           // Packet bucket, in picoseconds of rate
+    __u64 byte_credit_ps;          // Byte bucket, in picoseconds of rate
+    __u64 drop_packets;            // Frames dropped by this limit
+    __u64 drop_bytes;
+};
+
+// Where a neigh_map entry came from. Snooping never overwrites STATIC.
+enum neigh_origin {
+    NEIGH_ORIGIN_SNOOP  = 0,       // Local ARP reply / gratuitous ARP / NA
+    NEIGH_ORIGIN_EVPN   = 1,       // RT2 MAC+IP route
+    NEIGH_ORIGIN_STATIC = 2,       // Configured via API
+};
+
+// Key for neigh_map: Bridge Domain ID + IP address. IPv4 addresses are
+// stored IPv4-mapped (::ffff:a.b.c.d).
+struct neigh_key {
+    __u16 bd_id;
+    __u8 _pad[2];
+    __u8 addr[16];
+} __attribute__((packed));
+
+// Value for neigh_map: MAC that answers for the address
+struct neigh_entry {
+    __u8 mac[6];
+    __u8 origin;                   // enum neigh_origin
+    __u8 _pad;
+    __u64 updated_ns;              // bpf_ktime_get_ns() of the last write
+} __attribute__((packed));
+
+// Value for bd_irb_map: the VRF a BD routes into and its anycast gateway
+// MAC. Frames from an AC addressed to gw_mac are routed, not bridged.
+struct bd_irb_entry {
+    __u32 vrf_ifindex;             // VRF device of the BD's IP subnets
+    __u8 gw_mac[6];                // Anycast gateway MAC, the same on every PE
+    __u8 _pad[2];
+} __attribute__((packed));
+
+// LPM keys for irb_route_v4_map / irb_route_v6_map. prefixlen counts the
+// 32 bits of vrf_ifindex plus the address prefix, so a lookup never
+// crosses VRFs.
+struct irb_route_key_v4 {
+    __u32 prefixlen;               // 32 + IPv4 prefix length
+    __u32 vrf_ifindex;
+    __u8 addr[IPV4_ADDR_LEN];
+} __attribute__((packed));
 
-// Maximum number of remote PEs per Bridge Domain for BUM flooding
-#define MAX_BUM_NEXTHOPS 8
+struct irb_route_key_v6 {
+    __u32 prefixlen;               // 32 + IPv6 prefix length
+    __u32 vrf_ifindex;
+    __u8 addr[IPV6_ADDR_LEN];
+} __attribute__((packed));
 
 // Sentinel value for "peer index not found" (find_peer_index_by_src)
 #define BD_PEER_INDEX_INVALID 0xFFFF
@@ -233,7 +376,7 @@ struct fdb_entry {
 // Key for bd_peer_map: Bridge Domain ID + peer index
 struct bd_peer_key {
     __u16 bd_id;
-    __u16 index;                   // 0..MAX_BUM_NEXTHOPS-1
+    __u16 index;                   // 0..bum_peers_per_bd-1
 } __attribute__((packed));
 
 // Value: headend_entry (reuses existing struct for segments, src_addr, etc.)
@@ -255,6 +398,41 @@ struct bd_peer_reverse_val {
     __u8 _pad[4];
 } __attribute__((packed));
 
+// ========== VXLAN Gateway (VXLAN <-> SRv6 L2 interworking) ==========
+
+// Remote VTEPs per VNI mapping (vtep_map indexes 0..VXLAN_MAX_VTEPS-1)
+#define VXLAN_MAX_VTEPS 64
+
+// Value for vni_map (key: __u32 VNI): the BD a VNI is bridged into and the
+// local VTEP. VTEP addresses are stored IPv4-mapped (::ffff:a.b.c.d) for
+// an IPv4 underlay.
+struct vni_entry {
+    __u16 bd_id;
+    __u8 is_ipv4;                  // Underlay family of the local and remote VTEPs
+    __u8 num_vteps;                // vtep_map holds indexes 0..num_vteps-1
+    __u32 bridge_ifindex;          // Bridge receiving gateway BUM for local ACs (0 = none)
+    __u8 local_addr[IPV6_ADDR_LEN];
+} __attribute__((packed));         // 24 bytes total
+
+// Key for vtep_map: Bridge Domain ID + VTEP index
+struct vtep_key {
+    __u16 bd_id;
+    __u16 index;
+} __attribute__((packed));
+
+// Value for vtep_map: remote VTEP address
+struct vtep_entry {
+    __u8 addr[IPV6_ADDR_LEN];
+} __attribute__((packed));
+
+// Reverse-lookup key for vtep_reverse_map: {bd_id, addr} -> VTEP index.
+// Used to learn MACs behind the VTEP a VXLAN packet came from.
+struct vtep_reverse_key {
+    __u16 bd_id;
+    __u8 addr[IPV6_ADDR_LEN];
+    __u8 _pad[2];
+} __attribute__((packed));
+
 // RFC 7432 Ethernet Segment master table key.
 struct esi_key {
     __u8 esi[ESI_LEN];
@@ -292,11 +470,11 @@ struct bd_peer_l2_ext_val {
     __u8 _pad[6];
 } __attribute__((packed));
 
-// Side table: (ifindex, vlan_id) → local AC's source ESI.
+// Side table: AC → local AC's source ESI and egress VLAN rewrite.
 // Reuses headend_l2_key so Go-side updates parallel headend_l2_map.
 struct headend_l2_ext_val {
     __u8 esi[ESI_LEN];
-    __u8 _pad[6];
+    struct vlan_rewrite egress_rewrite;
 } __attribute__((packed));
 
 // BD → local ES lookup for DF judgement. Populated from HeadendL2 side-table
@@ -308,4 +486,91 @@ struct bd_local_esi_val {
     __u8 _pad[6];
 } __attribute__((packed));
 
+// SR domain boundary protection (RFC 8754 Section 5.1).
+// sr_domain_map (LPM on the locator prefix) yields a policy_id that scopes
+// the trusted-source and trusted-ingress side tables below. Locators with
+// no sr_domain_map entry are not filtered.
+struct sr_domain_entry {
+    __u32 policy_id;               // Scope key for sr_domain_src_map / sr_domain_iif_map (never 0)
+} __attribute__((packed));
+
+// Trusted source prefix key. prefixlen covers policy_id (32 bits) plus the
+// IPv6 source prefix, so one LPM trie serves every locator policy.
+struct sr_domain_src_key {
+    __u32 prefixlen;               // 32 + source prefix length (32-160)
+    __u32 policy_id;
+    __u8 addr[IPV6_ADDR_LEN];
+} __attribute__((packed));
+
+// Trusted ingress interface key.
+struct sr_domain_iif_key {
+    __u32 policy_id;
+    __u32 ifindex;
+} __attribute__((packed));
+
+// ========== SRv6 OAM (RFC 9259) ==========
+
+// Punt reasons carried in oam_punt_meta.reason
+#define OAM_PUNT_O_FLAG  1  // SRH O-flag set on a packet for a local SID
+#define OAM_PUNT_END_OP  2  // Packet hit an End.OP SID
+
+// Metadata prepended to every frame sample written to oam_punt_events.
+// The captured frame (cap_len bytes from the Ethernet header) follows it.
+struct oam_punt_meta {
+    __u64 timestamp_ns;  // bpf_ktime_get_ns() at punt time
+    __u32 ifindex;       // ingress interface
+    __u16 pkt_len;       // original frame length
+    __u16 cap_len;       // captured bytes following this header
+    __u8 reason;         // OAM_PUNT_*
+    __u8 action;         // srv6_local_action of the matched SID
+    __u8 _pad[6];
+} __attribute__((packed));
+
+// ========== STAMP session reflector (RFC 8762 / RFC 9503) ==========
+
+#define STAMP_DEFAULT_PORT 862
+
+// Reflector key: the session-reflector address and UDP port the XDP path
+// answers on. Test packets to other address/port pairs pass to the stack.
+struct stamp_reflector_key {
+    __u8 addr[IPV6_ADDR_LEN];
+    __u16 port;                    // Host byte order
+    __u8 _pad[2];
+} __attribute__((packed));
+
+// Not packed: reflected is bumped with __sync_fetch_and_add, which needs
+// a naturally aligned member.
+struct stamp_reflector_entry {
+    __s64 clock_offset_ns;         // Unix time minus bpf_ktime_get_ns(), set by userspace
+    __u64 reflected;               // Test packets answered
+};
+
+// Unauthenticated Session-Sender test packet (RFC 8762 Section 4.2.1).
+// Timestamps use the PTPv2 truncated format (Z flag set in err_est).
+struct stamp_test_pkt {
+    __be32 seq;
+    __be32 ts_sec;
+    __be32 ts_nsec;
+    __be16 err_est;
+    __u8 mbz[30];
+} __attribute__((packed));
+
+// Unauthenticated Session-Reflector test packet (RFC 8762 Section 4.3.1).
+struct stamp_reflect_pkt {
+    __be32 seq;
+    __be32 ts_sec;                 // Transmit timestamp (T3)
+    __be32 ts_nsec;
+    __be16 err_est;
+    __be16 mbz0;
+    __be32 rx_sec;                 // Receive timestamp (T2)
+    __be32 rx_nsec;
+    __be32 sender_seq;
+    __be32 sender_ts_sec;          // Session-Sender timestamp (T1)
+    __be32 sender_ts_nsec;
+    __be16 sender_err_est;
+    __be16 mbz1;
+    __u8 sender_ttl;
+    __u8 mbz2[3];
+} __attribute__((packed));
+
 #endif // XDP_PROG_H
@@ -20,6 +20,19 @@ enum stats_counter {
     STATS_SPLIT_HORIZON_TX   = 5,   // BUM clones skipped by send-side split-horizon
     STATS_SPLIT_HORIZON_RX   = 6,   // DT2M receives dropped by recv-side split-horizon
     STATS_NON_DF_DROP        = 7,   // DT2M BUM dropped on a non-DF PE
+    STATS_SR_DOMAIN_DROP     = 8,   // Packets to a local SID from outside the SR domain
+    STATS_OAM_PUNT           = 9,   // Timestamped copies punted for O-flag / End.OP
+    STATS_HOP_LIMIT_EXCEEDED = 10,  // End* hop limit expiry (ICMPv6 Time Exceeded sent)
+    STATS_STAMP_REFLECT      = 11,  // STAMP test packets reflected in XDP
+    STATS_PMTU_EXCEEDED      = 12,  // H.Encaps output over the egress MTU (ICMP error sent or dropped)
+    STATS_ECN_DROP           = 13,  // Decap of outer ECN-CE over a Not-ECT inner packet (RFC 6040)
+    STATS_BUM_REPLICA        = 14,  // BUM clones handed to TC encap, one per remote PE
+    STATS_MAC_LIMIT          = 15,  // New source MACs not learned because a BD/AC is at its MAC limit
+    STATS_NEIGH_SUPPRESS     = 16,  // ARP requests / NSes answered from neigh_map instead of flooded
+    STATS_IRB_ROUTED         = 17,  // Frames to a BD's anycast gateway routed into an irb_route_* entry
+    STATS_STORM_DROP         = 18,  // BUM frames dropped by a BD/AC storm control limit
+    STATS_VXLAN_DECAP        = 19,  // VXLAN packets of a mapped VNI decapsulated by the gateway
+    STATS_VXLAN_ENCAP        = 20,  // Frames VXLAN-encapsulated toward a remote VTEP
     STATS_MAX,
 };
 
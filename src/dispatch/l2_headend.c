@@ -1,12 +1,19 @@
 // L2 headend pipeline — BD forwarding and L2 encapsulation dispatch.
 // Included from xdp_prog.c — not compiled standalone.
-// Depends on headend/srv6_encaps_l2.h (must be included before this file).
+// Depends on headend/srv6_encaps_l2.h, core/ac_vlan.h, core/fdb_aging.h,
+// core/neigh_suppress.h, core/irb.h and core/vxlan.h (must be included before
+// this file).
 
+// ac is the matched AC; vlan_id / inner_vlan_id are the frame's own tags,
+// which differ from the AC's for VLAN range ACs.
 static __always_inline int process_bd_forwarding(
     struct xdp_md *ctx,
     struct headend_entry *l2_entry,
+    const struct headend_l2_key *ac,
     __u16 vlan_id,
-    __u64 pkt_len)
+    __u16 inner_vlan_id,
+    __u64 pkt_len,
+    const struct encap_outer_fields *of)
 {
     if (l2_entry->bd_id == 0)
         return -1;
@@ -22,38 +29,70 @@ static __always_inline int process_bd_forwarding(
     __builtin_memcpy(key.mac, eth->h_source, ETH_ALEN);
     struct fdb_entry *existing = bpf_map_lookup_elem(&fdb_map, &key);
     if (!existing ||
-        (!existing->is_remote && !existing->is_static && existing->oif != ctx->ingress_ifindex)) {
-        struct fdb_entry learn_val = {
-            .oif = ctx->ingress_ifindex,
-            .last_seen = bpf_ktime_get_ns(),
-        };
-        bpf_map_update_elem(&fdb_map, &key, &learn_val, BPF_ANY);
+        (!existing->is_remote && !existing->is_static &&
+         (existing->oif != ac->ifindex || existing->ac_vlan_id != ac->vlan_id ||
+          existing->ac_inner_vlan_id != ac->inner_vlan_id))) {
+        // A non-NULL existing here is a dynamic local entry moving ACs
+        bool moved = existing != NULL;
+        __u32 old_oif = existing ? existing->oif : 0;
+        __u16 old_vlan = existing ? existing->ac_vlan_id : 0;
+
+        int verdict = mac_learn_verdict(key.bd_id, ac->ifindex, ac->vlan_id, moved);
+        if (verdict == MAC_LEARN_OK) {
+            struct fdb_entry learn_val = {
+                .oif = ac->ifindex,
+                .last_seen = bpf_ktime_get_ns(),
+                .ac_vlan_id = ac->vlan_id,
+                .ac_inner_vlan_id = ac->inner_vlan_id,
+            };
+            if (fdb_learn(&key, &learn_val) == 0 && moved)
+                mac_count_fdb(key.bd_id, old_oif, old_vlan, false, -1);
+        } else if (verdict != MAC_LEARN_OFF) {
+            STATS_INC(STATS_MAC_LIMIT, pkt_len);
+            if (verdict == MAC_LEARN_LIMIT_DROP)
+                return XDP_DROP;
+        }
     } else if (existing && !existing->is_static) {
         existing->last_seen = bpf_ktime_get_ns();
+        fdb_aging_arm(existing);
     }
 
+    int nd_action = neigh_process(ctx, key.bd_id, pkt_len);
+    if (nd_action >= 0)
+        return nd_action;
+    int irb_action = irb_route(ctx, key.bd_id, pkt_len);
+    if (irb_action >= 0)
+        return irb_action;
+    data = (void *)(long)ctx->data;
+    data_end = (void *)(long)ctx->data_end;
+    eth = data;
+    if ((void *)(eth + 1) > data_end)
+        return XDP_PASS;
+
     if (eth->h_dest[0] & 0x01) {
-        xdp_write_bum_meta(ctx, vlan_id);
+        xdp_write_bum_meta(ctx, vlan_id, inner_vlan_id);
         return XDP_PASS;
     }
 
     __builtin_memcpy(key.mac, eth->h_dest, ETH_ALEN);
     struct fdb_entry *dst_fdb = bpf_map_lookup_elem(&fdb_map, &key);
     if (dst_fdb) {
+        if (dst_fdb->is_remote && dst_fdb->vtep)
+            return vxlan_encap_xdp(ctx, dst_fdb->bd_id, dst_fdb->peer_index);
         if (dst_fdb->is_remote) {
             struct bd_peer_key pk = { .bd_id = dst_fdb->bd_id, .index = dst_fdb->peer_index };
             struct headend_entry *pe = bpf_map_lookup_elem(&bd_peer_map, &pk);
             if (pe) {
                 __u16 l2_frame_len = (__u16)pkt_len;
                 if (pe->mode == SRV6_HEADEND_BEHAVIOR_H_ENCAPS_L2_RED)
-                    return do_h_encaps_l2_red(ctx, pe, l2_frame_len);
-                return do_h_encaps_l2(ctx, pe, l2_frame_len);
+                    return do_h_encaps_l2_red(ctx, pe, l2_frame_len, of);
+                return do_h_encaps_l2(ctx, pe, l2_frame_len, of);
             }
         }
         return XDP_PASS;
     }
 
-    xdp_write_bum_meta(ctx, vlan_id);
+    xdp_write_bum_meta(ctx, vlan_id, inner_vlan_id);
     return XDP_PASS;
 }
 
@@ -61,18 +100,24 @@ static __noinline int try_l2_headend(
     struct xdp_md *ctx,
     __u32 ifindex,
     __u16 vlan_id,
+    __u16 inner_vlan_id,
     __u64 pkt_len)
 {
-    struct headend_l2_key l2_key = { .ifindex = ifindex, .vlan_id = vlan_id };
-    struct headend_entry *l2_entry = bpf_map_lookup_elem(&headend_l2_map, &l2_key);
+    struct headend_l2_key l2_key;
+    struct headend_entry *l2_entry = ac_lookup(ifindex, vlan_id, inner_vlan_id, &l2_key);
     if (!headend_should_encaps_l2_any(l2_entry))
         return -1;
 
-    int bd_action = process_bd_forwarding(ctx, l2_entry, vlan_id, pkt_len);
+    // Outer header fields follow the AC, also when a remote FDB entry
+    // picks a BD peer's segment list
+    struct encap_outer_fields of;
+    encap_outer_fields_get(ctx, l2_entry, 0, true, &of);
+
+    int bd_action = process_bd_forwarding(ctx, l2_entry, &l2_key, vlan_id, inner_vlan_id, pkt_len, &of);
     if (bd_action >= 0)
         return bd_action;
 
     if (l2_entry->mode == SRV6_HEADEND_BEHAVIOR_H_ENCAPS_L2_RED)
-        return do_h_encaps_l2_red(ctx, l2_entry, (__u16)pkt_len);
-    return do_h_encaps_l2(ctx, l2_entry, (__u16)pkt_len);
+        return do_h_encaps_l2_red(ctx, l2_entry, (__u16)pkt_len, &of);
+    return do_h_encaps_l2(ctx, l2_entry, (__u16)pkt_len, &of);
 }
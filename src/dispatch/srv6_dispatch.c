@@ -54,6 +54,9 @@ static __always_inline int process_srv6_decap_nosrh(
     if (!entry)
         return XDP_PASS;
 
+    if (sr_domain_reject(ctx, ip6h))
+        return XDP_DROP;
+
     if (tailcall_ctx_write_sid(entry, l3_offset, DISPATCH_NOSRH, nh, entry->action) == 0)
         bpf_tail_call(ctx, &sid_endpoint_progs, entry->action);
 
@@ -85,6 +88,14 @@ static __always_inline int process_srv6_localsid(
     if (!entry)
         return XDP_PASS;
 
+    if (sr_domain_reject(ctx, ip6h))
+        return XDP_DROP;
+
+    // RFC 9259 Section 2.1: O-flag asks every SID on the path for a
+    // timestamped copy; processing of the original continues unchanged.
+    if (srh->flags & SR6_FLAG1_OAM)
+        oam_punt(ctx, OAM_PUNT_O_FLAG, entry->action);
+
     if (tailcall_ctx_write_sid(entry, l3_offset, DISPATCH_LOCALSID, 0, entry->action) == 0)
         bpf_tail_call(ctx, &sid_endpoint_progs, entry->action);
 
@@ -3,10 +3,76 @@
 
 #include <linux/types.h>
 #include <linux/if_ether.h>
+#include <linux/ip.h>
 #include <linux/ipv6.h>
 #include <bpf/bpf_helpers.h>
+#include <bpf/bpf_endian.h>
 
 #include "core/srv6.h"
+#include "core/xdp_prog.h"
+#include "core/xdp_stats.h"
+
+#define ECN_MASK   0x03
+#define ECN_CE     0x03
+
+// Largest outer IPv6 offset: Eth + QinQ
+#define DECAP_L3_OFF_MAX (ETH_HLEN + 8)
+
+// Whether the outer IPv6 header at l3_offset is marked CE. Only read when
+// the SID has SID_FLAG_ECN_PROPAGATE.
+static __always_inline bool srv6_outer_ecn_ce(
+    void *data, void *data_end, __u8 sid_flags, __u16 l3_offset)
+{
+    if (!(sid_flags & SID_FLAG_ECN_PROPAGATE) || l3_offset > DECAP_L3_OFF_MAX)
+        return false;
+    struct ipv6hdr *ip6h = data + l3_offset;
+    if ((void *)(ip6h + 1) > data_end)
+        return false;
+    return ((ip6h->flow_lbl[0] >> 4) & ECN_MASK) == ECN_CE;
+}
+
+// Copy an outer CE mark into the inner IPv4/IPv6 header right after the
+// Ethernet header (RFC 6040 Section 4.2). An ECN-capable inner header is
+// set to CE; a Not-ECT one cannot carry the mark and the packet is
+// dropped and counted in STATS_ECN_DROP. Returns 0 to forward, -1 to drop.
+static __always_inline int srv6_decap_ecn_ce(void *data, void *data_end)
+{
+    __u8 *ver = data + ETH_HLEN;
+    if ((void *)(ver + 1) > data_end)
+        return -1;
+
+    if ((*ver >> 4) == 6) {
+        struct ipv6hdr *ip6h = (struct ipv6hdr *)ver;
+        if ((void *)(ip6h + 1) > data_end)
+            return -1;
+        if (((ip6h->flow_lbl[0] >> 4) & ECN_MASK) == 0)
+            goto not_ect;
+        ip6h->flow_lbl[0] |= ECN_CE << 4;
+        return 0;
+    }
+
+    struct iphdr *iph = (struct iphdr *)ver;
+    if ((void *)(iph + 1) > data_end)
+        return -1;
+    if ((iph->tos & ECN_MASK) == 0)
+        goto not_ect;
+    if ((iph->tos & ECN_MASK) == ECN_CE)
+        return 0;
+
+    // Incremental checksum update (RFC 1624) of the version/IHL/TOS word
+    __u16 *word = (__u16 *)iph;
+    __u16 old = *word;
+    iph->tos |= ECN_CE;
+    __u32 sum = (__u16)~iph->check + (__u16)~old + *word;
+    sum = (sum & 0xffff) + (sum >> 16);
+    sum = (sum & 0xffff) + (sum >> 16);
+    iph->check = ~sum;
+    return 0;
+
+not_ect:
+    STATS_INC(STATS_ECN_DROP, data_end - data);
+    return -1;
+}
 
 // Calculate total headers to strip for decapsulation (L2 + IPv6 + SRH)
 // l3_offset: distance from packet start to IPv6 header (14, 18, or 22 for VLAN)
@@ -29,6 +95,7 @@ static __always_inline int calc_decap_strip_len(struct ipv6_sr_hdr *srh, __u16 l
 //   ctx: XDP context
 //   srh: Pointer to SRH (must be valid before call)
 //   expected_inner_proto: Expected inner protocol (IPPROTO_IPIP or IPPROTO_IPV6)
+//   sid_flags: SID_FLAG_* of the SID; SID_FLAG_ECN_PROPAGATE copies outer CE inward
 //   l3_offset: Distance from packet start to IPv6 header (14, 18, or 22)
 //
 // Returns: 0 on success, -1 on failure
@@ -37,6 +104,7 @@ static __always_inline int srv6_decap(
     struct xdp_md *ctx,
     struct ipv6_sr_hdr *srh,
     __u8 expected_inner_proto,
+    __u8 sid_flags,
     __u16 l3_offset)
 {
     void *data = (void *)(long)ctx->data;
@@ -49,6 +117,8 @@ static __always_inline int srv6_decap(
     if (srh->nexthdr != expected_inner_proto)
         return -1;
 
+    bool ecn_ce = srv6_outer_ecn_ce(data, data_end, sid_flags, l3_offset);
+
     // Save original Ethernet header (14 bytes, MACs will be rewritten by FIB)
     struct ethhdr saved_eth;
     __builtin_memcpy(&saved_eth, eth, sizeof(struct ethhdr));
@@ -70,6 +140,8 @@ static __always_inline int srv6_decap(
         return -1;
 
     __builtin_memcpy(eth, &saved_eth, sizeof(struct ethhdr));
+    if (ecn_ce)
+        return srv6_decap_ecn_ce(data, data_end);
     return 0;
 }
 
@@ -81,6 +153,7 @@ static __always_inline int srv6_decap_nosrh(
     struct xdp_md *ctx,
     __u8 expected_inner_proto,
     __u8 actual_nexthdr,
+    __u8 sid_flags,
     __u16 l3_offset)
 {
     if (actual_nexthdr != expected_inner_proto)
@@ -93,6 +166,8 @@ static __always_inline int srv6_decap_nosrh(
     if ((void *)(eth + 1) > data_end)
         return -1;
 
+    bool ecn_ce = srv6_outer_ecn_ce(data, data_end, sid_flags, l3_offset);
+
     struct ethhdr saved_eth;
     __builtin_memcpy(&saved_eth, eth, sizeof(struct ethhdr));
 
@@ -113,6 +188,8 @@ static __always_inline int srv6_decap_nosrh(
         return -1;
 
     __builtin_memcpy(eth, &saved_eth, sizeof(struct ethhdr));
+    if (ecn_ce)
+        return srv6_decap_ecn_ce(data, data_end);
     return 0;
 }
 
@@ -58,4 +58,18 @@ static __always_inline int process_end_t(
     return endpoint_fib_redirect(&ectx, fib_ifindex);
 }
 
+// End.OP: OAM endpoint with punt (RFC 9259 Section 2.1.1). A timestamped
+// copy goes to the OAM punt reader; the packet itself is handed to the
+// local stack, which answers ICMPv6 echo probes addressed to the SID.
+static __always_inline int process_end_op(
+    struct xdp_md *ctx,
+    struct ipv6hdr *ip6h,
+    struct ipv6_sr_hdr *srh,
+    struct sid_function_entry *entry,
+    __u16 l3_offset)
+{
+    oam_punt(ctx, OAM_PUNT_END_OP, entry->action);
+    return XDP_PASS;
+}
+
 #endif // SRV6_ENDPOINT_BASIC_H
@@ -13,6 +13,7 @@
 #include "core/srv6_fib.h"
 #include "endpoint/srv6_decaps.h"
 #include "core/xdp_stats.h"
+#include "core/oam.h"
 
 // Pick the VRF ifindex from aux->l3vrf, falling back to the ingress
 // interface when aux is absent or carries a zero ifindex (e.g. End.T
@@ -227,7 +228,7 @@ static __always_inline int endpoint_handle_usd(
 
     if (inner_proto == IPPROTO_IPIP) {
         DEBUG_PRINT("USD: Decapsulating inner IPv4\n");
-        if (srv6_decap(ctx, srh, IPPROTO_IPIP, l3_offset) != 0)
+        if (srv6_decap(ctx, srh, IPPROTO_IPIP, entry->flags, l3_offset) != 0)
             return XDP_DROP;
 
         void *data = (void *)(long)ctx->data;
@@ -243,7 +244,7 @@ static __always_inline int endpoint_handle_usd(
 
     if (inner_proto == IPPROTO_IPV6) {
         DEBUG_PRINT("USD: Decapsulating inner IPv6\n");
-        if (srv6_decap(ctx, srh, IPPROTO_IPV6, l3_offset) != 0)
+        if (srv6_decap(ctx, srh, IPPROTO_IPV6, entry->flags, l3_offset) != 0)
             return XDP_DROP;
 
         void *data = (void *)(long)ctx->data;
@@ -292,7 +293,7 @@ static __always_inline int endpoint_handle_usp(
 // ========================================================================
 
 // Shared processing for End, End.X, End.T:
-//   init → SL=0 flavor handling (USD/USP) → DA update → PSP strip
+//   init → SL=0 flavor handling (USD/USP) → hop limit → DA update → PSP strip
 //
 // Returns >= 0: final XDP action (packet handled by SL=0 flavor or error).
 // Returns -1:   caller should perform variant-specific FIB redirect.
@@ -319,6 +320,12 @@ static __always_inline int endpoint_common_processing(
     if (ret == -2)
         return XDP_DROP;
 
+    // RFC 8986 Section 4.1 S03/S09: expire or decrement the hop limit
+    // before the DA update so the ICMPv6 error quotes the received packet.
+    if (ip6h->hop_limit <= 1)
+        return oam_icmp6_time_exceeded(ctx, srh->nexthdr, 8 + srh->hdrlen * 8, l3_offset);
+    ip6h->hop_limit--;
+
     if (endpoint_update_da(ectx) != 0)
         return XDP_DROP;
 
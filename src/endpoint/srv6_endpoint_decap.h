@@ -14,12 +14,13 @@ static __always_inline int decap_and_fib_v4(
     struct xdp_md *ctx,
     struct ipv6_sr_hdr *srh,
     __u32 fib_ifindex,
+    __u8 sid_flags,
     __u16 l3_offset)
 {
     if (srh->segments_left != 0)
         return XDP_PASS;
 
-    if (srv6_decap(ctx, srh, IPPROTO_IPIP, l3_offset) != 0)
+    if (srv6_decap(ctx, srh, IPPROTO_IPIP, sid_flags, l3_offset) != 0)
         return XDP_DROP;
 
     void *data = (void *)(long)ctx->data;
@@ -40,12 +41,13 @@ static __always_inline int decap_and_fib_v6(
     struct xdp_md *ctx,
     struct ipv6_sr_hdr *srh,
     __u32 fib_ifindex,
+    __u8 sid_flags,
     __u16 l3_offset)
 {
     if (srh->segments_left != 0)
         return XDP_PASS;
 
-    if (srv6_decap(ctx, srh, IPPROTO_IPV6, l3_offset) != 0)
+    if (srv6_decap(ctx, srh, IPPROTO_IPV6, sid_flags, l3_offset) != 0)
         return XDP_DROP;
 
     void *data = (void *)(long)ctx->data;
@@ -72,7 +74,7 @@ static __always_inline int process_end_dx4(
     struct sid_function_entry *entry,
     __u16 l3_offset)
 {
-    return decap_and_fib_v4(ctx, srh, ctx->ingress_ifindex, l3_offset);
+    return decap_and_fib_v4(ctx, srh, ctx->ingress_ifindex, entry->flags, l3_offset);
 }
 
 // End.DX6: Decap to IPv6 cross-connect (RFC 8986 Section 4.5)
@@ -83,7 +85,7 @@ static __always_inline int process_end_dx6(
     struct sid_function_entry *entry,
     __u16 l3_offset)
 {
-    return decap_and_fib_v6(ctx, srh, ctx->ingress_ifindex, l3_offset);
+    return decap_and_fib_v6(ctx, srh, ctx->ingress_ifindex, entry->flags, l3_offset);
 }
 
 // End.DX2: Decap to L2 cross-connect (RFC 8986 Section 4.10)
@@ -142,7 +144,7 @@ static __always_inline int process_end_dt4(
     __u16 l3_offset)
 {
     __u32 fib_ifindex = aux_vrf_or_ingress_ifindex(aux, ctx);
-    return decap_and_fib_v4(ctx, srh, fib_ifindex, l3_offset);
+    return decap_and_fib_v4(ctx, srh, fib_ifindex, entry->flags, l3_offset);
 }
 
 // End.DT6: Decap to IPv6 with VRF table lookup (RFC 8986 Section 4.7)
@@ -155,7 +157,7 @@ static __always_inline int process_end_dt6(
     __u16 l3_offset)
 {
     __u32 fib_ifindex = aux_vrf_or_ingress_ifindex(aux, ctx);
-    return decap_and_fib_v6(ctx, srh, fib_ifindex, l3_offset);
+    return decap_and_fib_v6(ctx, srh, fib_ifindex, entry->flags, l3_offset);
 }
 
 // End.DT46: Dispatch to DT4 or DT6 based on inner protocol (RFC 8986 Section 4.9)
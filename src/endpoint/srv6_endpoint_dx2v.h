@@ -3,12 +3,14 @@
 
 #include "endpoint/srv6_endpoint_core.h"
 #include "core/xdp_map.h"
+#include "core/ac_vlan.h"
 
 // ========================================================================
 // End.DX2V: Decapsulation with VLAN L2 cross-connect (RFC 8986 Section 4.10)
 // ========================================================================
 
-// VLAN cross-connect forwarding: extract inner VLAN, lookup (table_id, vlan_id) -> oif
+// VLAN cross-connect forwarding: extract inner VLAN, lookup (table_id, vlan_id) -> oif,
+// then apply the entry's VLAN rewrite
 static __always_inline int dx2v_forward_l2(
     struct xdp_md *ctx,
     struct ethhdr *inner_eth,
@@ -35,8 +37,13 @@ static __always_inline int dx2v_forward_l2(
         return XDP_DROP;
     }
 
-    DEBUG_PRINT("End.DX2V: VLAN %d -> oif %d\n", vlan_id, entry->oif);
-    return bpf_redirect(entry->oif, 0);
+    __u32 oif = entry->oif;
+    struct vlan_rewrite rw = entry->rewrite;
+    if (vlan_rewrite_xdp(ctx, &rw) != 0)
+        return XDP_DROP;
+
+    DEBUG_PRINT("End.DX2V: VLAN %d -> oif %d\n", vlan_id, oif);
+    return bpf_redirect(oif, 0);
 }
 
 // End.DX2V with SRH: decap + VLAN cross-connect lookup
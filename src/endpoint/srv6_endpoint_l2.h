@@ -5,13 +5,16 @@
 #include "core/xdp_map.h"
 #include "core/xdp_stats.h"
 #include "core/esi.h"
+#include "core/fdb_aging.h"
+#include "core/ac_vlan.h"
+#include "core/vxlan.h"
 
 // ========================================================================
 // L2 Bridge Domain Endpoint Functions (End.DT2)
 // ========================================================================
 
 // Find peer_index by {bd_id, outer_src} via bd_peer_reverse_map.
-// O(1) single hash lookup instead of iterating up to MAX_BUM_NEXTHOPS entries.
+// O(1) single hash lookup instead of iterating the BD's bd_peer_map entries.
 // Returns BD_PEER_INDEX_INVALID if not found.
 static __always_inline __u16 find_peer_index_by_src(
     __u16 bd_id,
@@ -37,43 +40,73 @@ static __always_inline __u16 find_peer_index_by_src(
 // Parameters:
 //   inner_eth: pointer to inner Ethernet header (must be bounds-checked by caller)
 //   bd_id:     Bridge Domain ID (must be non-zero)
-//   peer_idx:  peer index from find_peer_index_by_src / bd_peer_reverse_map
-static __always_inline void fdb_learn_remote_mac(
+//   peer_idx:  peer index from find_peer_index_by_src / bd_peer_reverse_map,
+//              or a vtep_map index when vtep is set
+//   vtep:      the MAC sits behind a VXLAN VTEP (gateway decap)
+//   pkt_len:   inner frame length, for STATS_MAC_LIMIT
+//
+// Returns false when the BD is at its MAC limit with the drop action and
+// the caller must drop the frame.
+static __always_inline bool fdb_learn_remote_mac(
     struct ethhdr *inner_eth,
     __u16 bd_id,
-    __u16 peer_idx)
+    __u16 peer_idx,
+    bool vtep,
+    __u64 pkt_len)
 {
     // Only learn unicast source MACs (bit 0 of first octet = multicast flag)
     if (inner_eth->h_source[0] & 0x01)
-        return;
+        return true;
 
     struct fdb_key learn_key = { .bd_id = bd_id };
     __builtin_memcpy(learn_key.mac, inner_eth->h_source, ETH_ALEN);
 
     // Check if existing entry already matches — avoid redundant map write
     struct fdb_entry *existing = bpf_map_lookup_elem(&fdb_map, &learn_key);
-    if (existing && existing->is_remote && existing->peer_index == peer_idx) {
+    if (existing && existing->is_remote && existing->peer_index == peer_idx &&
+        existing->vtep == vtep) {
         // Refresh timestamp for existing dynamic entry
-        if (!existing->is_static)
+        if (!existing->is_static) {
             existing->last_seen = bpf_ktime_get_ns();
-        return;
+            fdb_aging_arm(existing);
+        }
+        return true;
+    }
+
+    // Replacing a dynamic entry moves the MAC inside the BD
+    bool moved = existing && !existing->is_static;
+    __u32 old_oif = moved ? existing->oif : 0;
+    __u16 old_vlan = moved ? existing->ac_vlan_id : 0;
+    bool old_remote = moved ? existing->is_remote : false;
+
+    int verdict = mac_learn_verdict(bd_id, 0, 0, moved);
+    if (verdict != MAC_LEARN_OK) {
+        if (verdict == MAC_LEARN_OFF)
+            return true;
+        STATS_INC(STATS_MAC_LIMIT, pkt_len);
+        return verdict != MAC_LEARN_LIMIT_DROP;
     }
 
     struct fdb_entry learn_val = {
         .is_remote = 1,
         .peer_index = peer_idx,
         .bd_id = bd_id,
+        .vtep = vtep,
         .last_seen = bpf_ktime_get_ns(),
     };
-    bpf_map_update_elem(&fdb_map, &learn_key, &learn_val, BPF_ANY);
+    if (fdb_learn(&learn_key, &learn_val) == 0 && moved)
+        mac_count_fdb(bd_id, old_oif, old_vlan, old_remote, -1);
     DEBUG_PRINT("FDB: learned remote MAC, bd_id=%d peer_index=%d\n", bd_id, peer_idx);
+    return true;
 }
 
 // FDB-based L2 forwarding decision after decapsulation.
 // Looks up destination MAC in fdb_map and returns the appropriate XDP action.
 //
 // Returns:
-//   bpf_redirect(oif)            on local FDB hit (fast path)
+//   bpf_redirect(oif)            on local FDB hit (fast path, after the egress VLAN rewrite)
+//   vxlan_encap_xdp()            on a remote hit behind a VXLAN VTEP (gateway)
+//   XDP_PASS with gateway meta   on FDB miss in a VNI-mapped BD (TC floods the VTEPs)
 //   bpf_redirect(bridge_ifindex) on FDB miss or remote hit (when bridge configured)
 //   XDP_PASS                     when no bridge configured
 static __always_inline int fdb_forward_l2(
@@ -87,6 +120,12 @@ static __always_inline int fdb_forward_l2(
 
     struct fdb_entry *fdb = bpf_map_lookup_elem(&fdb_map, &dk);
     if (!fdb) {
+        // FDB miss in a gateway BD: TC replicates to the VTEPs and hands
+        // the frame to the bridge.
+        if (bd_id != 0 && vxlan_bd_mapped(bd_id)) {
+            xdp_write_gw_meta(ctx, bd_id, eth_frame_vlan(ctx), BUM_GW_TO_VTEPS);
+            return XDP_PASS;
+        }
         // FDB miss: redirect to bridge device for flooding.
         if (bridge_ifindex != 0) {
             DEBUG_PRINT("FDB: miss, redirect to bridge ifindex %d\n", bridge_ifindex);
@@ -97,11 +136,18 @@ static __always_inline int fdb_forward_l2(
     }
 
     if (!fdb->is_remote) {
-        // Local FDB hit: fast-path redirect
-        DEBUG_PRINT("FDB: hit local, redirect to ifindex %d\n", fdb->oif);
-        return bpf_redirect(fdb->oif, 0);
+        // Local FDB hit: fast-path redirect through the AC's egress rewrite
+        __u32 oif = fdb->oif;
+        if (vlan_rewrite_fdb_egress(ctx, fdb) != 0)
+            return XDP_DROP;
+        DEBUG_PRINT("FDB: hit local, redirect to ifindex %d\n", oif);
+        return bpf_redirect(oif, 0);
     }
 
+    // Remote hit behind a VTEP: SRv6 -> VXLAN gateway
+    if (fdb->vtep)
+        return vxlan_encap_xdp(ctx, fdb->bd_id, fdb->peer_index);
+
     // Remote FDB hit on receiving PE — routing loop or stale entry
     DEBUG_PRINT("FDB: hit remote on receiver, redirect to bridge\n");
     if (bridge_ifindex != 0)
@@ -163,8 +209,9 @@ static __always_inline int process_end_dt2(
     // 6. Remote MAC learning
     if (bd_id != 0) {
         __u16 peer_idx = find_peer_index_by_src(bd_id, &outer_src);
-        if (peer_idx != BD_PEER_INDEX_INVALID)
-            fdb_learn_remote_mac(inner_eth, bd_id, peer_idx);
+        if (peer_idx != BD_PEER_INDEX_INVALID &&
+            !fdb_learn_remote_mac(inner_eth, bd_id, peer_idx, false, data_end - data))
+            return XDP_DROP;
     }
 
     // 7. FDB forwarding decision
@@ -207,8 +254,9 @@ static __always_inline int process_end_dt2_nosrh(
         return XDP_DROP;
 
     // 5. Remote MAC learning (if BD configured and peer was resolved)
-    if (bd_id != 0 && peer_idx != BD_PEER_INDEX_INVALID)
-        fdb_learn_remote_mac(inner_eth, bd_id, peer_idx);
+    if (bd_id != 0 && peer_idx != BD_PEER_INDEX_INVALID &&
+        !fdb_learn_remote_mac(inner_eth, bd_id, peer_idx, false, data_end - data))
+        return XDP_DROP;
 
     // 6. FDB forwarding decision
     return fdb_forward_l2(ctx, inner_eth, bd_id, bridge_ifindex);
@@ -76,7 +76,7 @@ static __always_inline int process_end_m_gtp4_e(
     if (inner_nexthdr != IPPROTO_IPIP && inner_nexthdr != IPPROTO_IPV6)
         return XDP_DROP;
 
-    if (srv6_decap(ctx, srh, inner_nexthdr, l3_offset) != 0)
+    if (srv6_decap(ctx, srh, inner_nexthdr, 0, l3_offset) != 0)
         return XDP_DROP;
 
     // 5. Re-derive pointers, get inner packet length
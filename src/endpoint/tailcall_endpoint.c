@@ -1,4 +1,4 @@
-// Endpoint tail call targets (16 SEC("xdp") programs).
+// Endpoint tail call targets (19 SEC("xdp") programs).
 // Included from xdp_prog.c — not compiled standalone.
 
 // ========== Helpers shared by tail call targets (nosrh path) ==========
@@ -51,6 +51,7 @@ static __always_inline int nosrh_fib_v6(
 
 DEFINE_ENDPOINT_LOCALSID(tailcall_endpoint_end, process_end)
 DEFINE_ENDPOINT_LOCALSID(tailcall_endpoint_end_m_gtp6_d_di, process_end_m_gtp6_d_di)
+DEFINE_ENDPOINT_LOCALSID(tailcall_endpoint_end_op, process_end_op)
 
 DEFINE_ENDPOINT_LOCALSID_AUX(tailcall_endpoint_end_t, process_end_t)
 DEFINE_ENDPOINT_LOCALSID_AUX(tailcall_endpoint_end_x, process_end_x)
@@ -100,7 +101,8 @@ int tailcall_endpoint_end_dx4(struct xdp_md *ctx)
     TAILCALL_AUX_LOOKUP(tctx, aux);
 
     if (tctx->dispatch_type == DISPATCH_NOSRH) {
-        if (CALL_WITH_CONST_L3(l3_off, srv6_decap_nosrh, ctx, IPPROTO_IPIP, tctx->inner_proto) != 0)
+        if (CALL_WITH_CONST_L3(l3_off, srv6_decap_nosrh, ctx, IPPROTO_IPIP, tctx->inner_proto,
+                               tctx->sid_entry.flags) != 0)
             TAILCALL_RETURN(ctx,XDP_DROP);
         TAILCALL_RETURN(ctx,nosrh_fib_v4(ctx, aux));
     }
@@ -124,7 +126,8 @@ int tailcall_endpoint_end_dx6(struct xdp_md *ctx)
     TAILCALL_AUX_LOOKUP(tctx, aux);
 
     if (tctx->dispatch_type == DISPATCH_NOSRH) {
-        if (CALL_WITH_CONST_L3(l3_off, srv6_decap_nosrh, ctx, IPPROTO_IPV6, tctx->inner_proto) != 0)
+        if (CALL_WITH_CONST_L3(l3_off, srv6_decap_nosrh, ctx, IPPROTO_IPV6, tctx->inner_proto,
+                               tctx->sid_entry.flags) != 0)
             TAILCALL_RETURN(ctx,XDP_DROP);
         TAILCALL_RETURN(ctx,nosrh_fib_v6(ctx, aux));
     }
@@ -148,7 +151,8 @@ int tailcall_endpoint_end_dt4(struct xdp_md *ctx)
     TAILCALL_AUX_LOOKUP(tctx, aux);
 
     if (tctx->dispatch_type == DISPATCH_NOSRH) {
-        if (CALL_WITH_CONST_L3(l3_off, srv6_decap_nosrh, ctx, IPPROTO_IPIP, tctx->inner_proto) != 0)
+        if (CALL_WITH_CONST_L3(l3_off, srv6_decap_nosrh, ctx, IPPROTO_IPIP, tctx->inner_proto,
+                               tctx->sid_entry.flags) != 0)
             TAILCALL_RETURN(ctx,XDP_DROP);
         TAILCALL_RETURN(ctx,nosrh_fib_v4(ctx, aux));
     }
@@ -172,7 +176,8 @@ int tailcall_endpoint_end_dt6(struct xdp_md *ctx)
     TAILCALL_AUX_LOOKUP(tctx, aux);
 
     if (tctx->dispatch_type == DISPATCH_NOSRH) {
-        if (CALL_WITH_CONST_L3(l3_off, srv6_decap_nosrh, ctx, IPPROTO_IPV6, tctx->inner_proto) != 0)
+        if (CALL_WITH_CONST_L3(l3_off, srv6_decap_nosrh, ctx, IPPROTO_IPV6, tctx->inner_proto,
+                               tctx->sid_entry.flags) != 0)
             TAILCALL_RETURN(ctx,XDP_DROP);
         TAILCALL_RETURN(ctx,nosrh_fib_v6(ctx, aux));
     }
@@ -198,12 +203,14 @@ int tailcall_endpoint_end_dt46(struct xdp_md *ctx)
     if (tctx->dispatch_type == DISPATCH_NOSRH) {
         __u8 nh = tctx->inner_proto;
         if (nh == IPPROTO_IPIP) {
-            if (CALL_WITH_CONST_L3(l3_off, srv6_decap_nosrh, ctx, IPPROTO_IPIP, nh) != 0)
+            if (CALL_WITH_CONST_L3(l3_off, srv6_decap_nosrh, ctx, IPPROTO_IPIP, nh,
+                                   tctx->sid_entry.flags) != 0)
                 TAILCALL_RETURN(ctx,XDP_DROP);
             TAILCALL_RETURN(ctx,nosrh_fib_v4(ctx, aux));
         }
         if (nh == IPPROTO_IPV6) {
-            if (CALL_WITH_CONST_L3(l3_off, srv6_decap_nosrh, ctx, IPPROTO_IPV6, nh) != 0)
+            if (CALL_WITH_CONST_L3(l3_off, srv6_decap_nosrh, ctx, IPPROTO_IPV6, nh,
+                                   tctx->sid_entry.flags) != 0)
                 TAILCALL_RETURN(ctx,XDP_DROP);
             TAILCALL_RETURN(ctx,nosrh_fib_v6(ctx, aux));
         }
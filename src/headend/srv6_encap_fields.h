@@ -0,0 +1,171 @@
+#ifndef SRV6_ENCAP_FIELDS_H
+#define SRV6_ENCAP_FIELDS_H
+
+#include <linux/types.h>
+#include <linux/if_ether.h>
+#include <linux/ip.h>
+#include <linux/ipv6.h>
+#include <linux/in.h>
+#include <bpf/bpf_endian.h>
+#include <bpf/bpf_helpers.h>
+
+#include "core/xdp_prog.h"
+#include "core/srv6.h"
+
+#define ENCAP_DEFAULT_HOP_LIMIT 64
+#define ENCAP_FLOW_LABEL_MASK   0xfffff
+
+// Largest inner offset: Eth + QinQ
+#define ENCAP_INNER_OFF_MAX (ETH_HLEN + 8)
+
+// Outer IPv6 header fields for one encapsulated packet
+struct encap_outer_fields {
+    __u8 tc;
+    __u8 hop_limit;
+    __u16 _pad;
+    __u32 flow_label;   // host order, low 20 bits
+};
+
+static __always_inline __u32 encap_hash_mix(__u32 h, __u32 v)
+{
+    h ^= v;
+    h *= 0x9e3779b1;
+    return h ^ (h >> 15);
+}
+
+// Mix the transport ports at l4 into h for TCP, UDP and SCTP
+static __always_inline __u32 encap_hash_ports(__u32 h, __u8 proto, void *l4, void *data_end)
+{
+    if (proto != IPPROTO_TCP && proto != IPPROTO_UDP && proto != IPPROTO_SCTP)
+        return h;
+    __u32 *ports = l4;
+    if ((void *)(ports + 1) > data_end)
+        return h;
+    return encap_hash_mix(h, *ports);
+}
+
+// Non-zero flow label from a hash (RFC 6437 Section 3: 0 means unlabeled)
+static __always_inline __u32 encap_flow_label(__u32 h)
+{
+    __u32 fl = (h ^ (h >> 20)) & ENCAP_FLOW_LABEL_MASK;
+    return fl ? fl : 1;
+}
+
+// encap_outer_fields_get fills f from the headend entry and the inner
+// packet at inner_off:
+//   - tc: 0, the inner DSCP/ECN (TC copy, which also keeps ECN for
+//     RFC 6040 normal mode), or entry->tc
+//   - hop_limit: entry->hop_limit (64 when 0) or the inner TTL/Hop Limit
+//   - flow_label: 0 or a hash of the inner 5-tuple (RFC 6437)
+//
+// With l2 set the inner packet is an Ethernet frame (H.Encaps.L2) and its
+// IPv4/IPv6 payload, behind at most one VLAN tag, is used. Other frames
+// hash the MAC addresses and EtherType, and copy/propagate fall back to
+// TC 0 and the fixed hop limit.
+//
+// Out of line so every encap flavour shares one copy.
+static __noinline int encap_outer_fields_get(
+    struct xdp_md *ctx,
+    struct headend_entry *entry,
+    __u32 inner_off,
+    bool l2,
+    struct encap_outer_fields *f)
+{
+    f->tc = entry->tc_mode == SRV6_ENCAP_TC_MODE_SET ? entry->tc : 0;
+    f->hop_limit = entry->hop_limit ? entry->hop_limit : ENCAP_DEFAULT_HOP_LIMIT;
+    f->flow_label = 0;
+
+    if (entry->tc_mode != SRV6_ENCAP_TC_MODE_COPY &&
+        entry->hop_limit_mode != SRV6_ENCAP_HOP_LIMIT_MODE_PROPAGATE &&
+        entry->flow_label_mode != SRV6_ENCAP_FLOW_LABEL_MODE_HASH)
+        return 0;
+
+    void *data = (void *)(long)ctx->data;
+    void *data_end = (void *)(long)ctx->data_end;
+
+    if (inner_off > ENCAP_INNER_OFF_MAX)
+        return 0;
+    void *inner = data + inner_off;
+    __u32 h = 0;
+
+    if (l2) {
+        struct ethhdr *eth = inner;
+        if ((void *)(eth + 1) > data_end)
+            return 0;
+        __u16 proto = eth->h_proto;
+        inner = eth + 1;
+        if (proto == bpf_htons(ETH_P_8021Q) || proto == bpf_htons(ETH_P_8021AD)) {
+            __be16 *vlan = inner;
+            if ((void *)(vlan + 2) > data_end)
+                return 0;
+            proto = vlan[1];
+            inner = vlan + 2;
+        }
+        if (proto != bpf_htons(ETH_P_IP) && proto != bpf_htons(ETH_P_IPV6)) {
+            __u32 *w = (__u32 *)eth;
+            h = encap_hash_mix(h, w[0]);
+            h = encap_hash_mix(h, w[1]);
+            h = encap_hash_mix(h, w[2]);
+            h = encap_hash_mix(h, proto);
+            if (entry->flow_label_mode == SRV6_ENCAP_FLOW_LABEL_MODE_HASH)
+                f->flow_label = encap_flow_label(h);
+            return 0;
+        }
+    }
+
+    __u8 *ver = inner;
+    if ((void *)(ver + 1) > data_end)
+        return 0;
+
+    __u8 tc, hop_limit;
+    if ((*ver >> 4) == 6) {
+        struct ipv6hdr *ip6h = inner;
+        if ((void *)(ip6h + 1) > data_end)
+            return 0;
+        tc = (ip6h->priority << 4) | (ip6h->flow_lbl[0] >> 4);
+        hop_limit = ip6h->hop_limit;
+
+        __u32 *addr = (__u32 *)&ip6h->saddr;
+        #pragma unroll
+        for (int i = 0; i < 8; i++)
+            h = encap_hash_mix(h, addr[i]);
+        h = encap_hash_mix(h, ip6h->nexthdr);
+        h = encap_hash_ports(h, ip6h->nexthdr, ip6h + 1, data_end);
+    } else {
+        struct iphdr *iph = inner;
+        if ((void *)(iph + 1) > data_end)
+            return 0;
+        tc = iph->tos;
+        hop_limit = iph->ttl;
+
+        h = encap_hash_mix(h, iph->saddr);
+        h = encap_hash_mix(h, iph->daddr);
+        h = encap_hash_mix(h, iph->protocol);
+        // Fragments hash on addresses alone so every fragment of a
+        // packet takes the same path. Options are skipped by IHL.
+        if (!(iph->frag_off & bpf_htons(0x3fff)) && iph->ihl >= 5)
+            h = encap_hash_ports(h, iph->protocol, (void *)iph + (iph->ihl & 0xf) * 4, data_end);
+    }
+
+    if (entry->tc_mode == SRV6_ENCAP_TC_MODE_COPY)
+        f->tc = tc;
+    if (entry->hop_limit_mode == SRV6_ENCAP_HOP_LIMIT_MODE_PROPAGATE)
+        f->hop_limit = hop_limit;
+    if (entry->flow_label_mode == SRV6_ENCAP_FLOW_LABEL_MODE_HASH)
+        f->flow_label = encap_flow_label(h);
+    return 0;
+}
+
+// Write f into an outer IPv6 header built by build_outer_ipv6
+static __always_inline void encap_outer_fields_apply(
+    struct ipv6hdr *ip6h,
+    const struct encap_outer_fields *f)
+{
+    ip6h->priority = f->tc >> 4;
+    ip6h->flow_lbl[0] = ((f->tc & 0x0f) << 4) | ((f->flow_label >> 16) & 0x0f);
+    ip6h->flow_lbl[1] = (f->flow_label >> 8) & 0xff;
+    ip6h->flow_lbl[2] = f->flow_label & 0xff;
+    ip6h->hop_limit = f->hop_limit;
+}
+
+#endif // SRV6_ENCAP_FIELDS_H
@@ -13,6 +13,8 @@
 #include "headend/srv6_headend_utils.h"
 #include <linux/ip.h>
 #include "core/srv6_fib.h"
+#include "headend/srv6_pmtu.h"
+#include "headend/srv6_encap_fields.h"
 
 // Unified H.Encaps / H.Encaps.Red core implementation (RFC 8986 Section 5.1)
 //
@@ -24,6 +26,13 @@
 //   N>=2: Reduced SRH with N-1 entries (S1 omitted).
 //         segments_left = N-1, first_segment = N-2.
 //
+// The outer Traffic Class, Hop Limit and Flow Label follow the entry's
+// tc_mode/hop_limit_mode/flow_label_mode (see encap_outer_fields_get).
+//
+// The encapsulated packet is checked against entry->mtu before the packet
+// is touched and against the egress interface MTU during the FIB lookup;
+// either failure is answered by headend_pmtu_exceeded.
+//
 // Returns: XDP action (XDP_REDIRECT, XDP_DROP, or XDP_PASS)
 static __always_inline int do_h_encaps_impl(
     struct xdp_md *ctx,
@@ -46,6 +55,16 @@ static __always_inline int do_h_encaps_impl(
     }
 
     int new_headers_len = (int)sizeof(struct ipv6hdr) + srh_len;
+    __u32 outer_len = new_headers_len + inner_total_len;
+
+    if (entry->mtu && outer_len > entry->mtu)
+        return headend_pmtu_exceeded(ctx, saved_eth, l3_offset, entry->src_addr,
+                                     entry->mtu - new_headers_len);
+
+    // Outer TC/Hop Limit/Flow Label come from the inner header, read
+    // before it moves
+    struct encap_outer_fields of;
+    encap_outer_fields_get(ctx, entry, l3_offset, false, &of);
 
     // Make room for new headers, reclaiming VLAN tag space
     int vlan_len = l3_offset - ETH_HLEN;
@@ -66,6 +85,7 @@ static __always_inline int do_h_encaps_impl(
                      no_srh ? inner_proto : IPPROTO_ROUTING,
                      srh_len + inner_total_len,
                      entry->src_addr, &entry->segments[0]);
+    encap_outer_fields_apply(outer_ip6h, &of);
 
     // Build SRH (if present)
     if (!no_srh) {
@@ -97,9 +117,16 @@ static __always_inline int do_h_encaps_impl(
     __builtin_memcpy(new_eth, saved_eth, sizeof(struct ethhdr));
     new_eth->h_proto = bpf_htons(ETH_P_IPV6);
 
-    // FIB lookup and redirect
+    // FIB lookup and redirect, checking the egress interface MTU
     __u32 ifindex;
-    int fib_result = srv6_fib_lookup_and_update(ctx, outer_ip6h, new_eth, &ifindex, ctx->ingress_ifindex);
+    __u32 egress_mtu = 0;
+    int fib_result = srv6_fib_lookup_v6_mtu(ctx, outer_ip6h, new_eth, &ifindex,
+                                            &outer_ip6h->daddr, ctx->ingress_ifindex,
+                                            outer_len > 0xffff ? 0xffff : outer_len,
+                                            &egress_mtu);
+    if (fib_result == FIB_RESULT_FRAG_NEEDED)
+        return headend_pmtu_exceeded(ctx, saved_eth, ETH_HLEN + new_headers_len,
+                                     entry->src_addr, egress_mtu - new_headers_len);
     return fib_result_to_xdp_action(fib_result, ifindex);
 }
 
@@ -12,12 +12,16 @@
 #include "core/srv6.h"
 #include "headend/srv6_headend_utils.h"
 #include "core/srv6_fib.h"
+#include "headend/srv6_encap_fields.h"
 
 // H.Encaps.L2: Prepend [Outer Eth][Outer IPv6][SRH] before the L2 frame (RFC 8986 Section 5.1)
+// of carries the outer TC/Hop Limit/Flow Label computed from the AC's
+// headend entry before the frame moved (encap_outer_fields_get).
 static __noinline int do_h_encaps_l2(
     struct xdp_md *ctx,
     struct headend_entry *entry,
-    __u16 l2_frame_len)
+    __u16 l2_frame_len,
+    const struct encap_outer_fields *of)
 {
     if (entry->num_segments < 1 || entry->num_segments > MAX_SEGMENTS) {
         return XDP_DROP;
@@ -49,6 +53,7 @@ static __noinline int do_h_encaps_l2(
 
     build_outer_ipv6(outer_ip6h, IPPROTO_ROUTING, srh_len + l2_frame_len,
                      entry->src_addr, &entry->segments[0]);
+    encap_outer_fields_apply(outer_ip6h, of);
 
     srh->nexthdr = IPPROTO_ETHERNET;
     srh->hdrlen = (srh_len >> 3) - 1;
@@ -74,7 +79,8 @@ static __noinline int do_h_encaps_l2(
 static __always_inline int do_h_encaps_l2_red_1seg(
     struct xdp_md *ctx,
     struct headend_entry *entry,
-    __u16 l2_frame_len)
+    __u16 l2_frame_len,
+    const struct encap_outer_fields *of)
 {
     int new_headers_len = sizeof(struct ethhdr) + sizeof(struct ipv6hdr);
 
@@ -96,6 +102,7 @@ static __always_inline int do_h_encaps_l2_red_1seg(
 
     build_outer_ipv6(outer_ip6h, IPPROTO_ETHERNET, l2_frame_len,
                      entry->src_addr, &entry->segments[0]);
+    encap_outer_fields_apply(outer_ip6h, of);
 
     __u32 ifindex;
     int fib_result = srv6_fib_lookup_and_update(ctx, outer_ip6h, new_eth, &ifindex, ctx->ingress_ifindex);
@@ -107,7 +114,8 @@ static __always_inline int do_h_encaps_l2_red_1seg(
 static __always_inline int do_h_encaps_l2_red_multi(
     struct xdp_md *ctx,
     struct headend_entry *entry,
-    __u16 l2_frame_len)
+    __u16 l2_frame_len,
+    const struct encap_outer_fields *of)
 {
     if (entry->num_segments < 2 || entry->num_segments > MAX_SEGMENTS)
         return XDP_DROP;
@@ -143,6 +151,7 @@ static __always_inline int do_h_encaps_l2_red_multi(
 
     build_outer_ipv6(outer_ip6h, IPPROTO_ROUTING, srh_len + l2_frame_len,
                      entry->src_addr, &entry->segments[0]);
+    encap_outer_fields_apply(outer_ip6h, of);
 
     srh->nexthdr = IPPROTO_ETHERNET;
     srh->hdrlen = (srh_len >> 3) - 1;
@@ -166,15 +175,16 @@ static __always_inline int do_h_encaps_l2_red_multi(
 static __noinline int do_h_encaps_l2_red(
     struct xdp_md *ctx,
     struct headend_entry *entry,
-    __u16 l2_frame_len)
+    __u16 l2_frame_len,
+    const struct encap_outer_fields *of)
 {
     if (entry->num_segments < 1 || entry->num_segments > MAX_SEGMENTS)
         return XDP_DROP;
 
     if (entry->num_segments == 1)
-        return do_h_encaps_l2_red_1seg(ctx, entry, l2_frame_len);
+        return do_h_encaps_l2_red_1seg(ctx, entry, l2_frame_len, of);
 
-    return do_h_encaps_l2_red_multi(ctx, entry, l2_frame_len);
+    return do_h_encaps_l2_red_multi(ctx, entry, l2_frame_len, of);
 }
 
 #endif // SRV6_ENCAPS_L2_H
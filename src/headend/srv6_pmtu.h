@@ -0,0 +1,223 @@
+#ifndef SRV6_PMTU_H
+#define SRV6_PMTU_H
+
+#include <linux/types.h>
+#include <linux/if_ether.h>
+#include <linux/ip.h>
+#include <linux/ipv6.h>
+#include <linux/in.h>
+#include <bpf/bpf_endian.h>
+#include <bpf/bpf_helpers.h>
+
+#include "core/xdp_prog.h"
+#include "core/xdp_stats.h"
+#include "core/srv6_fib.h"
+#include "core/oam.h"        // oam_icmp6_pseudo, oam_csum_fold, oam_icmp6_sum
+#include "headend/srv6_headend_utils.h"
+
+// ICMPv6 Packet Too Big (RFC 4443 Section 3.2)
+#define PMTU_ICMP6_PKT_TOO_BIG 2
+
+// ICMPv4 Destination Unreachable / Fragmentation Needed and DF set
+// (RFC 792, RFC 1191)
+#define PMTU_ICMP4_DEST_UNREACH 3
+#define PMTU_ICMP4_FRAG_NEEDED  4
+
+#define PMTU_ICMP_HDR_LEN 8
+#define PMTU_IPV4_HDR_LEN 20
+#define PMTU_IP_DF        0x4000
+
+// Quote limits: the ICMPv6 error fits in the IPv6 minimum MTU (RFC 4443
+// Section 2.4(c)); the ICMPv4 error in the 576-byte minimum datagram
+// (RFC 1812 Section 4.3.2.3).
+#define PMTU_ICMP6_QUOTE_MAX (1280 - 40 - PMTU_ICMP_HDR_LEN)
+#define PMTU_ICMP4_QUOTE_MAX (576 - PMTU_IPV4_HDR_LEN - PMTU_ICMP_HDR_LEN)
+
+// Largest offset of the inner packet: Eth + QinQ before encapsulation, or
+// Eth + outer IPv6 + a full SRH after it.
+#define PMTU_INNER_OFF_MAX (ETH_HLEN + 40 + 8 + MAX_SEGMENTS * 16)
+
+// ICMP error types that must never trigger another error
+static __always_inline bool pmtu_icmp4_is_error(__u8 type)
+{
+    return type == 3 || type == 4 || type == 5 || type == 11 || type == 12;
+}
+
+// headend_pmtu_exceeded handles an inner packet at inner_off that does
+// not fit the egress MTU once encapsulated. XDP cannot fragment, so the
+// packet is turned in place into an ICMPv6 Packet Too Big or ICMPv4
+// Fragmentation Needed carrying mtu (the egress MTU minus the encap
+// overhead) and routed back to the inner source. IPv4 packets without DF
+// are dropped.
+//
+// The ICMPv6 error is sourced from the headend's encap source address.
+// The headend has no IPv4 address of its own, so the ICMPv4 error is
+// sourced from the inner destination; hosts match it to the flow by the
+// quoted header.
+//
+// Works both before encapsulation (inner_off = l3_offset) and after it
+// (inner_off past the outer IPv6 header and SRH). VLAN tags are not kept.
+//
+// Before: [Eth][...][Inner IP][payload]
+// After:  [Eth][IP'][ICMP PTB/Frag Needed][Inner IP][payload] (truncated)
+//
+// Out of line so both call sites in do_h_encaps_impl share one copy.
+static __noinline int headend_pmtu_exceeded(
+    struct xdp_md *ctx,
+    struct ethhdr *saved_eth,
+    __u32 inner_off,
+    __u8 *src_addr,
+    __u32 mtu)
+{
+    void *data = (void *)(long)ctx->data;
+    void *data_end = (void *)(long)ctx->data_end;
+
+    STATS_INC(STATS_PMTU_EXCEEDED, data_end - data);
+
+    if (inner_off > PMTU_INNER_OFF_MAX)
+        return XDP_DROP;
+    __u8 *inner = data + inner_off;
+    if ((void *)(inner + 1) > data_end)
+        return XDP_DROP;
+
+    bool is_v6 = (*inner >> 4) == 6;
+    __u32 hdr_len;
+    __u32 quote_max;
+
+    if (is_v6) {
+        struct ipv6hdr *ip6h = (struct ipv6hdr *)inner;
+        if ((void *)(ip6h + 1) > data_end)
+            return XDP_DROP;
+        // No errors for multicast/unspecified sources or ICMPv6 errors
+        if (ip6h->saddr.in6_u.u6_addr8[0] == 0xff)
+            return XDP_DROP;
+        if (ip6h->saddr.in6_u.u6_addr32[0] == 0 && ip6h->saddr.in6_u.u6_addr32[1] == 0 &&
+            ip6h->saddr.in6_u.u6_addr32[2] == 0 && ip6h->saddr.in6_u.u6_addr32[3] == 0)
+            return XDP_DROP;
+        if (ip6h->nexthdr == IPPROTO_ICMPV6) {
+            __u8 *type = (__u8 *)(ip6h + 1);
+            if ((void *)(type + 1) > data_end || *type < 128)
+                return XDP_DROP;
+        }
+        hdr_len = sizeof(struct ipv6hdr) + PMTU_ICMP_HDR_LEN;
+        quote_max = PMTU_ICMP6_QUOTE_MAX;
+    } else {
+        struct iphdr *iph = (struct iphdr *)inner;
+        if ((void *)(iph + 1) > data_end)
+            return XDP_DROP;
+        if (!(iph->frag_off & bpf_htons(PMTU_IP_DF)))
+            return XDP_DROP;
+        if (iph->protocol == IPPROTO_ICMP) {
+            __u8 *type = (__u8 *)iph + iph->ihl * 4;
+            if (iph->ihl < 5 || (void *)(type + 1) > data_end ||
+                pmtu_icmp4_is_error(*type))
+                return XDP_DROP;
+        }
+        hdr_len = PMTU_IPV4_HDR_LEN + PMTU_ICMP_HDR_LEN;
+        quote_max = PMTU_ICMP4_QUOTE_MAX;
+    }
+
+    // Quote a whole number of 32-bit words for the checksum loop
+    __u32 quote_len = (__u32)(data_end - (void *)inner);
+    if (quote_len > quote_max)
+        quote_len = quote_max;
+    quote_len &= ~3U;
+
+    int trim = (int)(inner_off + quote_len) - (int)(data_end - data);
+    if (trim < 0 && bpf_xdp_adjust_tail(ctx, trim))
+        return XDP_DROP;
+    if (bpf_xdp_adjust_head(ctx, (int)inner_off - (int)(ETH_HLEN + hdr_len)))
+        return XDP_DROP;
+
+    data = (void *)(long)ctx->data;
+    data_end = (void *)(long)ctx->data_end;
+    struct ethhdr *eth = data;
+    if ((void *)(eth + 1) > data_end)
+        return XDP_DROP;
+
+    // Reply goes back toward the source: swap MACs
+    __builtin_memcpy(eth->h_dest, saved_eth->h_source, ETH_ALEN);
+    __builtin_memcpy(eth->h_source, saved_eth->h_dest, ETH_ALEN);
+
+    __u16 icmp_len = PMTU_ICMP_HDR_LEN + quote_len;
+    __u32 out_ifindex;
+    int fib_result;
+
+    if (is_v6) {
+        struct ipv6hdr *ip6h = (struct ipv6hdr *)(eth + 1);
+        if ((void *)(ip6h + 1) > data_end)
+            return XDP_DROP;
+        __u8 *icmp = (__u8 *)(ip6h + 1);
+        struct ipv6hdr *quoted = (struct ipv6hdr *)(icmp + PMTU_ICMP_HDR_LEN);
+        if ((void *)(quoted + 1) > data_end)
+            return XDP_DROP;
+
+        eth->h_proto = bpf_htons(ETH_P_IPV6);
+        build_outer_ipv6(ip6h, IPPROTO_ICMPV6, icmp_len, src_addr, &quoted->saddr);
+
+        __builtin_memset(icmp, 0, PMTU_ICMP_HDR_LEN);
+        icmp[0] = PMTU_ICMP6_PKT_TOO_BIG;
+        __be32 mtu_be = bpf_htonl(mtu);
+        __builtin_memcpy(icmp + 4, &mtu_be, sizeof(mtu_be));
+
+        struct oam_icmp6_pseudo ph = {};
+        __builtin_memcpy(&ph.saddr, &ip6h->saddr, sizeof(ph.saddr));
+        __builtin_memcpy(&ph.daddr, &ip6h->daddr, sizeof(ph.daddr));
+        ph.len = bpf_htonl(icmp_len);
+        ph.nexthdr = IPPROTO_ICMPV6;
+        __u64 sum = 0;
+        __u32 *pw = (__u32 *)&ph;
+        #pragma unroll
+        for (int i = 0; i < (int)(sizeof(ph) / 4); i++)
+            sum += pw[i];
+        sum += oam_icmp6_sum(ctx, ETH_HLEN + sizeof(struct ipv6hdr), icmp_len);
+        __u16 csum = oam_csum_fold(sum);
+        __builtin_memcpy(icmp + 2, &csum, sizeof(csum));
+
+        fib_result = srv6_fib_lookup_and_update(ctx, ip6h, eth, &out_ifindex,
+                                                ctx->ingress_ifindex);
+    } else {
+        struct iphdr *iph = (struct iphdr *)(eth + 1);
+        if ((void *)(iph + 1) > data_end)
+            return XDP_DROP;
+        __u8 *icmp = (__u8 *)(iph + 1);
+        struct iphdr *quoted = (struct iphdr *)(icmp + PMTU_ICMP_HDR_LEN);
+        if ((void *)(quoted + 1) > data_end)
+            return XDP_DROP;
+
+        eth->h_proto = bpf_htons(ETH_P_IP);
+        iph->version = 4;
+        iph->ihl = 5;
+        iph->tos = 0;
+        iph->tot_len = bpf_htons(PMTU_IPV4_HDR_LEN + icmp_len);
+        iph->id = 0;
+        iph->frag_off = 0;
+        iph->ttl = 64;
+        iph->protocol = IPPROTO_ICMP;
+        iph->check = 0;
+        iph->saddr = quoted->daddr;
+        iph->daddr = quoted->saddr;
+
+        __u64 sum = 0;
+        __u32 *iw = (__u32 *)iph;
+        #pragma unroll
+        for (int i = 0; i < PMTU_IPV4_HDR_LEN / 4; i++)
+            sum += iw[i];
+        iph->check = oam_csum_fold(sum);
+
+        __builtin_memset(icmp, 0, PMTU_ICMP_HDR_LEN);
+        icmp[0] = PMTU_ICMP4_DEST_UNREACH;
+        icmp[1] = PMTU_ICMP4_FRAG_NEEDED;
+        __be16 mtu_be = bpf_htons((__u16)mtu);
+        __builtin_memcpy(icmp + 6, &mtu_be, sizeof(mtu_be));
+
+        __u16 csum = oam_csum_fold(oam_icmp6_sum(ctx, ETH_HLEN + PMTU_IPV4_HDR_LEN, icmp_len));
+        __builtin_memcpy(icmp + 2, &csum, sizeof(csum));
+
+        fib_result = srv6_fib_lookup_and_update_v4(ctx, iph, eth, &out_ifindex,
+                                                   ctx->ingress_ifindex);
+    }
+    return fib_result_to_xdp_action(fib_result, out_ifindex);
+}
+
+#endif // SRV6_PMTU_H
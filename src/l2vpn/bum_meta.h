@@ -10,20 +10,21 @@
 
 // XDP: Write BUM metadata before XDP_PASS
 // Signals to TC ingress that this packet needs SRv6 encap + clone to remote PE.
+// Layout: marker (bits 63-32) | inner VLAN ID (bits 31-16) | VLAN ID (bits 15-0).
 // On failure, XDP_PASS still proceeds (local bridge flood works, remote forwarding skipped).
-static __always_inline void xdp_write_bum_meta(struct xdp_md *ctx, __u16 vlan_id)
+static __always_inline void xdp_write_bum_meta(struct xdp_md *ctx, __u16 vlan_id, __u16 inner_vlan_id)
 {
     if (bpf_xdp_adjust_meta(ctx, -(int)sizeof(__u64)) == 0) {
         void *data = (void *)(long)ctx->data;
         __u64 *meta = (void *)(long)ctx->data_meta;
         if ((void *)(meta + 1) <= data)
-            *meta = ((__u64)BUM_META_MARKER << 32) | vlan_id;
+            *meta = ((__u64)BUM_META_MARKER << 32) | ((__u32)inner_vlan_id << 16) | vlan_id;
     }
 }
 
 // TC: Read BUM metadata from skb->data_meta
-// Returns true if BUM marker is present, with vlan_id extracted.
-static __always_inline bool tc_read_bum_meta(struct __sk_buff *skb, __u16 *vlan_id)
+// Returns true if BUM marker is present, with vlan_id and inner_vlan_id extracted.
+static __always_inline bool tc_read_bum_meta(struct __sk_buff *skb, __u16 *vlan_id, __u16 *inner_vlan_id)
 {
     void *data_meta = (void *)(long)skb->data_meta;
     void *data = (void *)(long)skb->data;
@@ -33,6 +34,48 @@ static __always_inline bool tc_read_bum_meta(struct __sk_buff *skb, __u16 *vlan_
     if ((meta >> 32) != BUM_META_MARKER)
         return false;
     *vlan_id = (__u16)(meta & 0xFFFF);
+    *inner_vlan_id = (__u16)((meta >> 16) & 0xFFFF);
+    return true;
+}
+
+// Gateway BUM marker: a BUM frame of a VNI-mapped BD crossing between the
+// VXLAN and SRv6 sides of the gateway. "VNBG" in ASCII.
+#define BUM_GW_META_MARKER 0x564E4247
+
+// Direction of a gateway BUM frame (bit 31 of the metadata)
+#define BUM_GW_TO_PEERS 0  // Arrived over VXLAN: replicate to the BD's SRv6 peers
+#define BUM_GW_TO_VTEPS 1  // Arrived over SRv6: replicate to the VNI's remote VTEPs
+
+// XDP: Write gateway BUM metadata before XDP_PASS.
+// Layout: marker (bits 63-32) | direction (bit 31) | VLAN ID (bits 27-16) | bd_id (bits 15-0).
+// The VLAN ID is the frame's outer tag, which the kernel moves to
+// skb->vlan_tci and the TC encap re-inserts.
+static __always_inline void xdp_write_gw_meta(
+    struct xdp_md *ctx, __u16 bd_id, __u16 vlan_id, __u8 dir)
+{
+    if (bpf_xdp_adjust_meta(ctx, -(int)sizeof(__u64)) == 0) {
+        void *data = (void *)(long)ctx->data;
+        __u64 *meta = (void *)(long)ctx->data_meta;
+        if ((void *)(meta + 1) <= data)
+            *meta = ((__u64)BUM_GW_META_MARKER << 32) | ((__u32)(dir & 1) << 31) |
+                    ((__u32)(vlan_id & 0x0FFF) << 16) | bd_id;
+    }
+}
+
+// TC: Read gateway BUM metadata from skb->data_meta
+static __always_inline bool tc_read_gw_meta(
+    struct __sk_buff *skb, __u16 *bd_id, __u16 *vlan_id, __u8 *dir)
+{
+    void *data_meta = (void *)(long)skb->data_meta;
+    void *data = (void *)(long)skb->data;
+    if (data_meta + sizeof(__u64) > data)
+        return false;
+    __u64 meta = *(__u64 *)data_meta;
+    if ((meta >> 32) != BUM_GW_META_MARKER)
+        return false;
+    *bd_id = (__u16)(meta & 0xFFFF);
+    *vlan_id = (__u16)((meta >> 16) & 0x0FFF);
+    *dir = (__u8)((meta >> 31) & 1);
     return true;
 }
 
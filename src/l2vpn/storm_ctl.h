@@ -0,0 +1,76 @@
+#ifndef VINBERO_STORM_CTL_H
+#define VINBERO_STORM_CTL_H
+
+#include <linux/types.h>
+#include <linux/bpf.h>
+#include <bpf/bpf_helpers.h>
+#include <stdbool.h>
+
+#include "core/xdp_prog.h"  // struct mac_limit_key, struct storm_ctl_entry
+#include "core/xdp_map.h"   // storm_ctl_map
+
+// Bucket depth: one second of rate. Credit is kept in picoseconds so one
+// packet costs 1e12 / pps and one byte 1e12 / bps without floating point.
+#define STORM_CTL_BURST_PS 1000000000000ULL
+#define STORM_CTL_BURST_NS 1000000000ULL
+
+static __always_inline __u64 storm_ctl_refill(__u64 credit, __u64 elapsed_ps)
+{
+    credit += elapsed_ps;
+    return credit > STORM_CTL_BURST_PS ? STORM_CTL_BURST_PS : credit;
+}
+
+// Charge one BUM frame of len bytes to the limiter at key. Returns false,
+// counting the drop on the limiter, when either bucket lacks the credit;
+// nothing is taken from the buckets in that case. No entry means no limit.
+static __always_inline bool storm_ctl_charge(
+    struct mac_limit_key *key, __u32 len, __u64 now)
+{
+    struct storm_ctl_entry *sc = bpf_map_lookup_elem(&storm_ctl_map, key);
+    if (!sc)
+        return true;
+
+    bool ok;
+    bpf_spin_lock(&sc->lock);
+    // now was read before taking the lock, so another CPU may already have
+    // refilled up to a later time: never move last_ns backwards.
+    __u64 elapsed = 0;
+    if (now > sc->last_ns) {
+        elapsed = now - sc->last_ns;
+        sc->last_ns = now;
+    }
+    if (elapsed > STORM_CTL_BURST_NS)
+        elapsed = STORM_CTL_BURST_NS;
+    __u64 pkt = storm_ctl_refill(sc->pkt_credit_ps, elapsed * 1000);
+    __u64 byte = storm_ctl_refill(sc->byte_credit_ps, elapsed * 1000);
+    __u64 pkt_cost = sc->pps ? STORM_CTL_BURST_PS / sc->pps : 0;
+    __u64 byte_cost = sc->bps ? (__u64)len * STORM_CTL_BURST_PS / sc->bps : 0;
+    ok = pkt >= pkt_cost && byte >= byte_cost;
+    if (ok) {
+        pkt -= pkt_cost;
+        byte -= byte_cost;
+    } else {
+        sc->drop_packets++;
+        sc->drop_bytes += len;
+    }
+    sc->pkt_credit_ps = pkt;
+    sc->byte_credit_ps = byte;
+    bpf_spin_unlock(&sc->lock);
+    return ok;
+}
+
+// Storm control of a BUM frame from the AC (ifindex, ac_vlan_id) in bd_id:
+// the AC limit is charged first, so a storm on one AC is cut there without
+// draining the budget the BD shares with its other ACs.
+static __always_inline bool storm_ctl_admit(
+    __u32 ifindex, __u16 ac_vlan_id, __u16 bd_id, __u32 len)
+{
+    __u64 now = bpf_ktime_get_ns();
+    struct mac_limit_key ac = { .ifindex = ifindex, .vlan_id = ac_vlan_id };
+    if (!storm_ctl_charge(&ac, len, now))
+        return false;
+    struct mac_limit_key bd = { .bd_id = bd_id };
+    return storm_ctl_charge(&bd, len, now);
+}
+
+#endif // VINBERO_STORM_CTL_H
@@ -13,15 +13,26 @@
 #include "core/xdp_map.h"
 #include "core/srv6.h"
 #include "core/esi.h"
+#include "core/ac_vlan.h"
 #include "core/xdp_stats.h"
 #include "headend/srv6_headend.h"
 #include "headend/srv6_headend_utils.h"
 #include "l2vpn/bum_meta.h"
+#include "l2vpn/storm_ctl.h"
+#include "core/vxlan.h"
 
 // Magic value in skb->cb[0] to identify clone-to-self encap requests.
 // Reuses BUM_META_MARKER so both XDP meta and TC cb[] use the same sentinel.
 #define TC_CB_ENCAP_MAGIC BUM_META_MARKER
 
+// Magic value in skb->cb[0] for flood continuation clones: replicate the
+// remaining peers of BD cb[1] from index cb[2] on. "VNBF" in ASCII.
+#define TC_CB_FLOOD_MAGIC 0x564E4246
+
+// Magic value in skb->cb[0] for VXLAN gateway clones: encapsulate toward
+// VTEP cb[2] of BD cb[1]. "VNBX" in ASCII.
+#define TC_CB_VXLAN_MAGIC 0x564E4258
+
 // Materialize 802.1Q VLAN tag in the inner L2 frame after encapsulation.
 // Generic XDP on veth moves VLAN from packet data to skb->vlan_tci,
 // so by TC time the inner frame is untagged. This re-inserts the tag.
@@ -72,6 +83,29 @@ static __always_inline int tc_srv6_fib_lookup_and_update(
     return 0;
 }
 
+// TC FIB lookup for IPv4 and update Ethernet header
+static __always_inline int tc_fib_lookup_and_update_v4(
+    struct __sk_buff *skb,
+    struct iphdr *iph,
+    struct ethhdr *eth,
+    __u32 *out_ifindex)
+{
+    struct bpf_fib_lookup fib_params = {};
+    fib_params.family = AF_INET;
+    fib_params.ifindex = skb->ifindex;
+    fib_params.ipv4_src = iph->saddr;
+    fib_params.ipv4_dst = iph->daddr;
+
+    int ret = bpf_fib_lookup(skb, &fib_params, sizeof(fib_params), 0);
+    if (ret != BPF_FIB_LKUP_RET_SUCCESS)
+        return -1;
+
+    __builtin_memcpy(eth->h_dest, fib_params.dmac, ETH_ALEN);
+    __builtin_memcpy(eth->h_source, fib_params.smac, ETH_ALEN);
+    *out_ifindex = fib_params.ifindex;
+    return 0;
+}
+
 // Unified Mode 2 encap: single clone for one PE, then redirect.
 // Handles both full SRH (reduced=false) and reduced SRH (reduced=true).
 // Uses bpf_skb_change_head (no skb->protocol restriction).
@@ -200,56 +234,282 @@ static __noinline int tc_do_single_pe_encap_red(
     return tc_do_single_pe_encap_impl(skb, cb_bd_id, cb_pe_index, true);
 }
 
-// Mode 1: clone-to-self, one clone per remote PE in the BD. RFC 9252
-// split-horizon: if the source AC's ESI matches a peer's ESI, the peer is on
-// the same Ethernet Segment and would re-flood to the shared CE — skip it.
-static __noinline int tc_dispatch_bum_clones(
+// Upper bound on peers per Bridge Domain, rewritten from
+// settings.entries.bd_peer.per_bd. Clamps the bd_peer_span_map value so a
+// stale span can never make the flood walk past the configured range.
+const volatile __u32 bum_peers_per_bd = 256;
+
+// Peers replicated per TC invocation. bpf_clone_redirect(BPF_F_INGRESS)
+// queues every replica on the per-CPU backlog, so a BD with hundreds of
+// peers is flooded in batches: each pass ends with a continuation clone
+// (Mode 3) that resumes at the next index once the backlog has drained,
+// instead of overflowing netdev_max_backlog in one burst.
+#define BUM_PEERS_PER_PASS 64
+
+// State shared with the bpf_loop callback for one flood pass
+struct bum_flood_ctx {
+    struct __sk_buff *skb;
+    __u32 start;
+    __u16 bd_id;
+    __u16 vlan_id;
+    __u8 src_esi[ESI_LEN];  // 8-byte aligned for the memcpy below
+    bool src_esi_set;
+};
+
+// One bpf_loop iteration: replicate to peer fc->start + i. RFC 9252
+// split-horizon: if the source AC's ESI matches the peer's ESI, the peer is
+// on the same Ethernet Segment and would re-flood to the shared CE — skip it.
+static long tc_bum_flood_peer(__u32 i, void *data)
+{
+    struct bum_flood_ctx *fc = data;
+    struct __sk_buff *skb = fc->skb;
+    __u16 index = (__u16)(fc->start + i);
+
+    struct bd_peer_key key = { .bd_id = fc->bd_id, .index = index };
+    if (!bpf_map_lookup_elem(&bd_peer_map, &key))
+        return 0; // Slot may be empty due to deletion; keep scanning
+
+    if (fc->src_esi_set) {
+        struct bd_peer_l2_ext_key ext_key = { .bd_id = fc->bd_id, .index = index };
+        struct bd_peer_l2_ext_val *peer_ext =
+            bpf_map_lookup_elem(&bd_peer_l2_ext_map, &ext_key);
+        if (peer_ext && esi_equal(peer_ext->esi, fc->src_esi)) {
+            STATS_INC(STATS_SPLIT_HORIZON_TX, skb->len);
+            DEBUG_PRINT("TC dispatch: split-horizon skip peer=%d\n", index);
+            return 0;
+        }
+    }
+
+    skb->cb[0] = TC_CB_ENCAP_MAGIC;
+    skb->cb[1] = fc->bd_id;
+    skb->cb[2] = index;
+    skb->cb[3] = fc->vlan_id;
+    long clone_ret = bpf_clone_redirect(skb, skb->ifindex, BPF_F_INGRESS);
+    if (clone_ret == 0)
+        STATS_INC(STATS_BUM_REPLICA, skb->len);
+    DEBUG_PRINT("TC dispatch: clone pe=%d ret=%ld\n", index, clone_ret);
+    return 0;
+}
+
+// Replicate to the peers of bd_id from index start on, at most
+// BUM_PEERS_PER_PASS of them, and hand the rest to a continuation clone.
+// The span bounds the walk to the occupied index range, so the per-packet
+// cost follows the number of peers rather than the configured capacity.
+static __noinline int tc_flood_bum_peers(
     struct __sk_buff *skb,
-    __u16 vlan_id)
+    __u16 bd_id,
+    __u16 vlan_id,
+    __u16 inner_vlan_id,
+    __u32 start)
 {
-    struct headend_l2_key l2_key = {
-        .ifindex = skb->ifindex,
+    __u16 *span = bpf_map_lookup_elem(&bd_peer_span_map, &bd_id);
+    if (!span)
+        return 0;
+    __u32 end = *span;
+    if (end > bum_peers_per_bd)
+        end = bum_peers_per_bd;
+    if (start >= end)
+        return 0;
+
+    __u32 count = end - start;
+    bool more = count > BUM_PEERS_PER_PASS;
+    if (more)
+        count = BUM_PEERS_PER_PASS;
+
+    struct bum_flood_ctx fc = {
+        .skb = skb,
+        .bd_id = bd_id,
         .vlan_id = vlan_id,
+        .start = start,
     };
-    struct headend_entry *l2 = bpf_map_lookup_elem(&headend_l2_map, &l2_key);
-    if (!headend_should_encaps_l2_any(l2) || l2->bd_id == 0)
-        return TC_ACT_OK;
-
-    __u16 bd_id = l2->bd_id;
-    DEBUG_PRINT("TC dispatch: bd_id=%d\n", bd_id);
 
     // Resolve source AC's ESI once, out of the per-peer loop.
-    struct headend_l2_ext_val *src_ext = bpf_map_lookup_elem(&headend_l2_ext_map, &l2_key);
-    bool src_esi_set = src_ext && !esi_is_zero(src_ext->esi);
-
-    for (__u16 i = 0; i < MAX_BUM_NEXTHOPS; i++) {
-        struct bd_peer_key key = { .bd_id = bd_id, .index = i };
-        struct headend_entry *peer = bpf_map_lookup_elem(&bd_peer_map, &key);
-        if (!peer)
-            continue; // Slot may be empty due to deletion; keep scanning
-
-        if (src_esi_set) {
-            struct bd_peer_l2_ext_key ext_key = { .bd_id = bd_id, .index = i };
-            struct bd_peer_l2_ext_val *peer_ext =
-                bpf_map_lookup_elem(&bd_peer_l2_ext_map, &ext_key);
-            if (peer_ext && esi_equal(peer_ext->esi, src_ext->esi)) {
-                STATS_INC(STATS_SPLIT_HORIZON_TX, skb->len);
-                DEBUG_PRINT("TC dispatch: split-horizon skip peer=%d\n", i);
-                continue;
-            }
-        }
+    struct headend_l2_key l2_key;
+    struct headend_l2_ext_val *src_ext = NULL;
+    if (ac_lookup(skb->ifindex, vlan_id, inner_vlan_id, &l2_key))
+        src_ext = bpf_map_lookup_elem(&headend_l2_ext_map, &l2_key);
+    if (src_ext && !esi_is_zero(src_ext->esi)) {
+        fc.src_esi_set = true;
+        __builtin_memcpy(fc.src_esi, src_ext->esi, ESI_LEN);
+    }
+
+    bpf_loop(count, tc_bum_flood_peer, &fc, 0);
 
-        skb->cb[0] = TC_CB_ENCAP_MAGIC;
+    if (more) {
+        skb->cb[0] = TC_CB_FLOOD_MAGIC;
         skb->cb[1] = bd_id;
-        skb->cb[2] = i;
+        skb->cb[2] = start + count;
         skb->cb[3] = vlan_id;
+        skb->cb[4] = inner_vlan_id;
         long clone_ret = bpf_clone_redirect(skb, skb->ifindex, BPF_F_INGRESS);
         (void)clone_ret;
-        DEBUG_PRINT("TC dispatch: clone pe=%d ret=%ld\n", i, clone_ret);
+        DEBUG_PRINT("TC dispatch: continue at pe=%d ret=%ld\n", start + count, clone_ret);
+    }
+
+    // Clear cb so the caller's packet doesn't trigger Mode 2 or Mode 3
+    skb->cb[0] = 0;
+    return 0;
+}
+
+// Mode 4: VXLAN encap of one gateway clone toward VTEP cb_index of BD
+// cb_bd_id, then redirect. The VLAN is materialized as in Mode 2.
+static __noinline int tc_do_vxlan_encap(
+    struct __sk_buff *skb,
+    __u32 cb_bd_id,
+    __u32 cb_index)
+{
+    __u16 vlan_id = (__u16)skb->cb[3];
+
+    __u32 vni;
+    struct vni_entry *vn;
+    struct vtep_entry *vt = vxlan_vtep_lookup((__u16)cb_bd_id, (__u16)cb_index, &vni, &vn);
+    if (!vt)
+        return TC_ACT_SHOT;
+
+    if (skb->vlan_present)
+        bpf_skb_vlan_pop(skb);
+
+    bool needs_vlan = (vlan_id > 0);
+    int vlan_extra = needs_vlan ? 4 : 0;
+    __u16 inner_len = (__u16)(skb->len) + (__u16)vlan_extra;
+
+    __u8 macs[12];
+    if (bpf_skb_load_bytes(skb, 0, macs, sizeof(macs)))
+        return TC_ACT_SHOT;
+    __be16 sport = vxlan_src_port(macs);
+
+    bool ipv4 = vn->is_ipv4;
+    int hdr_len = ipv4 ? VXLAN_ENCAP_LEN_V4 : VXLAN_ENCAP_LEN_V6;
+    if (bpf_skb_change_head(skb, hdr_len + vlan_extra, 0))
+        return TC_ACT_SHOT;
+
+    void *data = (void *)(long)skb->data;
+    void *data_end = (void *)(long)skb->data_end;
+    if (vxlan_build_outer(data, data_end, vn, vt->addr, vni, inner_len, sport) != 0)
+        return TC_ACT_SHOT;
+
+    struct ethhdr *eth = data;
+    __u32 ifindex;
+    if (ipv4) {
+        struct iphdr *iph = (struct iphdr *)(eth + 1);
+        if ((void *)(iph + 1) > data_end)
+            return TC_ACT_SHOT;
+        if (tc_fib_lookup_and_update_v4(skb, iph, eth, &ifindex) != 0)
+            return TC_ACT_SHOT;
+    } else {
+        struct ipv6hdr *ip6h = (struct ipv6hdr *)(eth + 1);
+        if ((void *)(ip6h + 1) > data_end)
+            return TC_ACT_SHOT;
+        if (tc_srv6_fib_lookup_and_update(skb, ip6h, eth, &ifindex) != 0)
+            return TC_ACT_SHOT;
     }
 
-    // Clear cb so the original packet doesn't trigger Mode 2
+    if (needs_vlan && tc_materialize_vlan(skb, hdr_len, vlan_id) != 0)
+        return TC_ACT_SHOT;
+
+    STATS_INC(STATS_VXLAN_ENCAP, inner_len);
+    return bpf_redirect(ifindex, 0);
+}
+
+// State shared with the bpf_loop callback for one VTEP flood
+struct vtep_flood_ctx {
+    struct __sk_buff *skb;
+    __u16 bd_id;
+    __u16 vlan_id;
+};
+
+// One bpf_loop iteration: clone to self for VTEP i (Mode 4)
+static long tc_vtep_flood_one(__u32 i, void *data)
+{
+    struct vtep_flood_ctx *fc = data;
+    struct __sk_buff *skb = fc->skb;
+
+    struct vtep_key key = { .bd_id = fc->bd_id, .index = (__u16)i };
+    if (!bpf_map_lookup_elem(&vtep_map, &key))
+        return 0;
+
+    skb->cb[0] = TC_CB_VXLAN_MAGIC;
+    skb->cb[1] = fc->bd_id;
+    skb->cb[2] = i;
+    skb->cb[3] = fc->vlan_id;
+    long clone_ret = bpf_clone_redirect(skb, skb->ifindex, BPF_F_INGRESS);
+    if (clone_ret == 0)
+        STATS_INC(STATS_BUM_REPLICA, skb->len);
+    DEBUG_PRINT("TC dispatch: clone vtep=%d ret=%ld\n", i, clone_ret);
+    return 0;
+}
+
+// Replicate a BUM frame of bd_id to every remote VTEP of its VNI. A BD
+// without a VNI mapping has nothing to do here.
+static __noinline int tc_flood_vteps(struct __sk_buff *skb, __u16 bd_id, __u16 vlan_id)
+{
+    __u32 *vni = bpf_map_lookup_elem(&bd_vni_map, &bd_id);
+    if (!vni)
+        return 0;
+    struct vni_entry *vn = bpf_map_lookup_elem(&vni_map, vni);
+    if (!vn)
+        return 0;
+    __u32 count = vn->num_vteps;
+    if (count > VXLAN_MAX_VTEPS)
+        count = VXLAN_MAX_VTEPS;
+
+    struct vtep_flood_ctx fc = {
+        .skb = skb,
+        .bd_id = bd_id,
+        .vlan_id = vlan_id,
+    };
+    bpf_loop(count, tc_vtep_flood_one, &fc, 0);
+
     skb->cb[0] = 0;
+    return 0;
+}
+
+// Gateway BUM (XDP wrote VNBG meta after a VXLAN or End.DT2 decap):
+// replicate to the other side of the gateway, then hand the frame to the
+// VNI's bridge for the local ACs. Without a bridge the frame is consumed,
+// since it arrived on the underlay and has no business in its stack.
+static __noinline int tc_dispatch_gw_bum(
+    struct __sk_buff *skb,
+    __u16 bd_id,
+    __u16 vlan_id,
+    __u8 dir)
+{
+    if (dir == BUM_GW_TO_PEERS)
+        tc_flood_bum_peers(skb, bd_id, vlan_id, 0, 0);
+    else
+        tc_flood_vteps(skb, bd_id, vlan_id);
+
+    __u32 *vni = bpf_map_lookup_elem(&bd_vni_map, &bd_id);
+    if (!vni)
+        return TC_ACT_SHOT;
+    struct vni_entry *vn = bpf_map_lookup_elem(&vni_map, vni);
+    if (!vn || vn->bridge_ifindex == 0)
+        return TC_ACT_SHOT;
+    return bpf_redirect(vn->bridge_ifindex, 0);
+}
+
+// Mode 1: clone-to-self, one clone per remote PE in the source AC's BD and
+// per remote VTEP of its VNI, after the BD's and AC's storm control.
+static __noinline int tc_dispatch_bum_clones(
+    struct __sk_buff *skb,
+    __u16 vlan_id,
+    __u16 inner_vlan_id)
+{
+    struct headend_l2_key l2_key;
+    struct headend_entry *l2 = ac_lookup(skb->ifindex, vlan_id, inner_vlan_id, &l2_key);
+    if (!headend_should_encaps_l2_any(l2) || l2->bd_id == 0)
+        return TC_ACT_OK;
+
+    // Storm control: a frame over its AC's or BD's BUM rate is dropped
+    // before replication, and with it the local flood.
+    if (!storm_ctl_admit(skb->ifindex, l2_key.vlan_id, l2->bd_id, skb->len)) {
+        STATS_INC(STATS_STORM_DROP, skb->len);
+        return TC_ACT_SHOT;
+    }
+
+    DEBUG_PRINT("TC dispatch: bd_id=%d\n", l2->bd_id);
+    tc_flood_bum_peers(skb, l2->bd_id, vlan_id, inner_vlan_id, 0);
+    tc_flood_vteps(skb, l2->bd_id, vlan_id);
 
     // Original frame continues to bridge for local flood
     return TC_ACT_OK;
@@ -14,10 +14,27 @@ int vinbero_tc_ingress(struct __sk_buff *skb)
         return tc_do_single_pe_encap(skb, skb->cb[1], skb->cb[2]);
     }
 
+    // Mode 3: Flood continuation — the remaining peers of a large BD.
+    // The continuation clone itself is only a carrier and is consumed here.
+    if (skb->cb[0] == TC_CB_FLOOD_MAGIC) {
+        tc_flood_bum_peers(skb, (__u16)skb->cb[1], (__u16)skb->cb[3], (__u16)skb->cb[4], skb->cb[2]);
+        return TC_ACT_SHOT;
+    }
+
+    // Mode 4: VXLAN encap — gateway clone toward one remote VTEP
+    if (skb->cb[0] == TC_CB_VXLAN_MAGIC)
+        return tc_do_vxlan_encap(skb, skb->cb[1], skb->cb[2]);
+
+    // Gateway BUM — replicate across the VXLAN <-> SRv6 gateway
+    __u16 gw_bd_id, gw_vlan_id;
+    __u8 gw_dir;
+    if (tc_read_gw_meta(skb, &gw_bd_id, &gw_vlan_id, &gw_dir))
+        return tc_dispatch_gw_bum(skb, gw_bd_id, gw_vlan_id, gw_dir);
+
     // Mode 1: Dispatch — XDP wrote BUM meta, clone to self for each PE
-    __u16 vlan_id;
-    if (!tc_read_bum_meta(skb, &vlan_id))
+    __u16 vlan_id, inner_vlan_id;
+    if (!tc_read_bum_meta(skb, &vlan_id, &inner_vlan_id))
         return TC_ACT_OK;
 
-    return tc_dispatch_bum_clones(skb, vlan_id);
+    return tc_dispatch_bum_clones(skb, vlan_id, inner_vlan_id);
 }
@@ -0,0 +1,151 @@
+#ifndef VXLAN_GW_H
+#define VXLAN_GW_H
+
+#include <linux/types.h>
+#include <linux/if_ether.h>
+#include <linux/ip.h>
+#include <linux/ipv6.h>
+#include <linux/in.h>
+#include <linux/udp.h>
+#include <bpf/bpf_helpers.h>
+#include <bpf/bpf_endian.h>
+
+#include "core/xdp_prog.h"
+#include "core/xdp_map.h"
+#include "core/xdp_stats.h"
+#include "core/esi.h"
+#include "core/vxlan.h"
+#include "headend/srv6_encaps_l2.h"
+#include "endpoint/srv6_endpoint_l2.h"
+#include "l2vpn/bum_meta.h"
+
+// VXLAN -> SRv6 direction of the gateway: a VXLAN packet to the local VTEP
+// of a mapped VNI is decapsulated, its source MAC is learned behind the
+// sending VTEP, and the inner frame is forwarded in the mapped BD:
+//   - remote FDB hit on an SRv6 peer: H.Encaps.L2 with the peer's segments
+//   - local FDB hit:                  redirect to the AC (egress rewrite)
+//   - remote FDB hit on a VTEP:       drop (VTEPs already flood to each other)
+//   - BUM or FDB miss:                XDP_PASS with gateway meta; TC
+//                                     replicates to the SRv6 peers and
+//                                     hands the frame to the bridge
+static __always_inline int vxlan_gw_forward(
+    struct xdp_md *ctx,
+    struct ethhdr *inner_eth,
+    __u16 bd_id,
+    __u64 frame_len)
+{
+    struct fdb_entry *fdb = NULL;
+    if (!(inner_eth->h_dest[0] & 0x01)) {
+        struct fdb_key dk = { .bd_id = bd_id };
+        __builtin_memcpy(dk.mac, inner_eth->h_dest, ETH_ALEN);
+        fdb = bpf_map_lookup_elem(&fdb_map, &dk);
+    }
+
+    if (!fdb) {
+        xdp_write_gw_meta(ctx, bd_id, eth_frame_vlan(ctx), BUM_GW_TO_PEERS);
+        return XDP_PASS;
+    }
+
+    if (!fdb->is_remote) {
+        __u32 oif = fdb->oif;
+        if (vlan_rewrite_fdb_egress(ctx, fdb) != 0)
+            return XDP_DROP;
+        return bpf_redirect(oif, 0);
+    }
+
+    if (fdb->vtep)
+        return XDP_DROP;
+
+    struct bd_peer_key pk = { .bd_id = fdb->bd_id, .index = fdb->peer_index };
+    struct headend_entry *pe = bpf_map_lookup_elem(&bd_peer_map, &pk);
+    if (!pe)
+        return XDP_DROP;
+
+    struct encap_outer_fields of;
+    encap_outer_fields_get(ctx, pe, 0, true, &of);
+    if (pe->mode == SRV6_HEADEND_BEHAVIOR_H_ENCAPS_L2_RED)
+        return do_h_encaps_l2_red(ctx, pe, (__u16)frame_len, &of);
+    return do_h_encaps_l2(ctx, pe, (__u16)frame_len, &of);
+}
+
+// Gateway ingress for an IPv4 or IPv6 packet at l3_offset. Returns an XDP
+// action, or -1 (packet untouched) for anything but VXLAN to a mapped VNI's
+// local VTEP.
+static __noinline int process_vxlan_gw(struct xdp_md *ctx, __u16 l3_offset, bool ipv4)
+{
+    void *data = (void *)(long)ctx->data;
+    void *data_end = (void *)(long)ctx->data_end;
+
+    if (l3_offset > 22)
+        return -1;
+
+    __u8 src[IPV6_ADDR_LEN] = {};
+    __u8 dst[IPV6_ADDR_LEN] = {};
+    struct udphdr *udph;
+    __u32 strip_len;
+
+    if (ipv4) {
+        struct iphdr *iph = data + l3_offset;
+        if ((void *)(iph + 1) > data_end)
+            return -1;
+        if (iph->ihl != 5 || iph->protocol != IPPROTO_UDP ||
+            (iph->frag_off & bpf_htons(0x3FFF)))
+            return -1;
+        // IPv4-mapped, as vni_entry.local_addr and vtep_map store them
+        src[10] = src[11] = 0xff;
+        dst[10] = dst[11] = 0xff;
+        __builtin_memcpy(src + 12, &iph->saddr, 4);
+        __builtin_memcpy(dst + 12, &iph->daddr, 4);
+        udph = (struct udphdr *)(iph + 1);
+        strip_len = l3_offset + sizeof(struct iphdr);
+    } else {
+        struct ipv6hdr *ip6h = data + l3_offset;
+        if ((void *)(ip6h + 1) > data_end)
+            return -1;
+        if (ip6h->nexthdr != IPPROTO_UDP)
+            return -1;
+        __builtin_memcpy(src, &ip6h->saddr, IPV6_ADDR_LEN);
+        __builtin_memcpy(dst, &ip6h->daddr, IPV6_ADDR_LEN);
+        udph = (struct udphdr *)(ip6h + 1);
+        strip_len = l3_offset + sizeof(struct ipv6hdr);
+    }
+
+    struct vxlan_hdr *vxh = (struct vxlan_hdr *)(udph + 1);
+    if ((void *)(vxh + 1) > data_end)
+        return -1;
+    if (udph->dest != bpf_htons(VXLAN_PORT) || !(vxh->flags & VXLAN_FLAG_I))
+        return -1;
+
+    __u32 vni = bpf_ntohl(vxh->vni) >> 8;
+    struct vni_entry *vn = bpf_map_lookup_elem(&vni_map, &vni);
+    if (!vn || vn->is_ipv4 != ipv4 || !ipv6_equal(vn->local_addr, dst))
+        return -1;
+    __u16 bd_id = vn->bd_id;
+
+    // MACs are only learned behind configured VTEPs
+    struct vtep_reverse_key rk = { .bd_id = bd_id };
+    __builtin_memcpy(rk.addr, src, IPV6_ADDR_LEN);
+    __u16 *vtep_idx = bpf_map_lookup_elem(&vtep_reverse_map, &rk);
+    __u16 vtep_index = vtep_idx ? *vtep_idx : BD_PEER_INDEX_INVALID;
+
+    strip_len += sizeof(struct udphdr) + sizeof(struct vxlan_hdr);
+    if (bpf_xdp_adjust_head(ctx, strip_len))
+        return XDP_DROP;
+
+    data = (void *)(long)ctx->data;
+    data_end = (void *)(long)ctx->data_end;
+    struct ethhdr *inner_eth = data;
+    if ((void *)(inner_eth + 1) > data_end)
+        return XDP_DROP;
+    __u64 frame_len = data_end - data;
+
+    STATS_INC(STATS_VXLAN_DECAP, frame_len);
+
+    if (vtep_index != BD_PEER_INDEX_INVALID &&
+        !fdb_learn_remote_mac(inner_eth, bd_id, vtep_index, true, frame_len))
+        return XDP_DROP;
+
+    return vxlan_gw_forward(ctx, inner_eth, bd_id, frame_len);
+}
+
+#endif // VXLAN_GW_H
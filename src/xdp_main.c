@@ -9,9 +9,14 @@ static __always_inline int process_l3(struct xdp_md *ctx, __u16 l3_offset, __u16
         struct ethhdr *eth;
         struct ipv6hdr *ip6h;
 
+        // Stage 0: STAMP session reflector
+        REDERIVE_ETH_IP6(ctx, l3_offset, eth, ip6h);
+        int action = process_stamp_reflect(ctx, ip6h, l3_offset);
+        if (action != XDP_PASS) return action;
+
         // Stage 1: SRH-based endpoint processing
         REDERIVE_ETH_IP6(ctx, l3_offset, eth, ip6h);
-        int action = process_srv6_localsid(ctx, eth, ip6h, l3_offset);
+        action = process_srv6_localsid(ctx, eth, ip6h, l3_offset);
         if (action != XDP_PASS) return action;
 
         // Stage 2: Reduced SRH decap (no SRH present)
@@ -19,7 +24,11 @@ static __always_inline int process_l3(struct xdp_md *ctx, __u16 l3_offset, __u16
         action = process_srv6_decap_nosrh(ctx, ip6h, l3_offset);
         if (action != XDP_PASS) return action;
 
-        // Stage 3: Headend encapsulation
+        // Stage 3: VXLAN gateway decap (VNI-mapped BDs)
+        action = process_vxlan_gw(ctx, l3_offset, false);
+        if (action >= 0) return action;
+
+        // Stage 4: Headend encapsulation
         REDERIVE_ETH_IP6(ctx, l3_offset, eth, ip6h);
         return process_headend_v6(ctx, eth, ip6h, l3_offset);
     }
@@ -27,6 +36,9 @@ static __always_inline int process_l3(struct xdp_md *ctx, __u16 l3_offset, __u16
     if (proto == bpf_htons(ETH_P_IP)) {
         struct ethhdr *eth;
         struct iphdr *iph;
+        int action = process_vxlan_gw(ctx, l3_offset, true);
+        if (action >= 0) return action;
+
         REDERIVE_ETH_IP4(ctx, l3_offset, eth, iph);
         return process_headend_v4(ctx, eth, iph, l3_offset);
     }
@@ -60,12 +72,21 @@ int vinbero_main(struct xdp_md *ctx)
         if ((void *)(vhdr + 1) > data_end)
             goto out;
 
-        __u16 vlan_id = bpf_ntohs(vhdr->h_vlan_TCI) & 0x0FFF;
+        __u16 vlan_id = bpf_ntohs(vhdr->h_vlan_TCI) & VLAN_VID_MASK;
         __u16 inner_proto = vhdr->h_vlan_encapsulated_proto;
 
-        int l2_action = try_l2_headend(ctx, ctx->ingress_ifindex, vlan_id, pkt_len);
+        // Q-in-Q: the C-tag selects a double-tagged AC
+        __u16 inner_vlan_id = 0;
+        if (eth_proto_is_vlan(inner_proto)) {
+            struct vlan_hdr *ivhdr = vhdr + 1;
+            if ((void *)(ivhdr + 1) > data_end)
+                goto out;
+            inner_vlan_id = bpf_ntohs(ivhdr->h_vlan_TCI) & VLAN_VID_MASK;
+        }
+
+        int l2_action = try_l2_headend(ctx, ctx->ingress_ifindex, vlan_id, inner_vlan_id, pkt_len);
         if (l2_action >= 0) {
-            action = l2_action;
+            action = irb_tail_call(ctx, l2_action);
             goto out;
         }
 
@@ -92,9 +113,9 @@ int vinbero_main(struct xdp_md *ctx)
 
     // ========== Non-VLAN packets ==========
     {
-        int l2_action = try_l2_headend(ctx, ctx->ingress_ifindex, 0, pkt_len);
+        int l2_action = try_l2_headend(ctx, ctx->ingress_ifindex, 0, 0, pkt_len);
         if (l2_action >= 0) {
-            action = l2_action;
+            action = irb_tail_call(ctx, l2_action);
             goto out;
         }
     }
@@ -22,8 +22,10 @@
 #include "headend/srv6_encaps.h"
 #include "headend/srv6_insert.h"
 #include "core/xdp_stats.h"
+#include "core/sr_domain.h"
 #include "endpoint/srv6_endpoint.h"
 #include "endpoint/srv6_end_b6.h"
+#include "core/stamp.h"
 #include "l2vpn/bum_meta.h"
 #include "core/srv6_gtp.h"
 #include "endpoint/srv6_gtp_endpoint.h"
@@ -40,7 +42,12 @@ char _license[] SEC("license") = "GPL";
 #include "headend/tailcall_headend.c"
 #include "dispatch/srv6_dispatch.c"
 #include "headend/srv6_encaps_l2.h"
+#include "core/ac_vlan.h"
+#include "core/neigh_suppress.h"
+#include "core/irb.h"
+#include "core/vxlan.h"
 #include "dispatch/l2_headend.c"
+#include "l2vpn/vxlan_gw.h"
 #include "xdp_main.c"
 #include "l2vpn/tc_bum.h"
 #include "l2vpn/tc_prog.c"